@@ -0,0 +1,49 @@
+import { BuilderContext, BuilderOutput, createBuilder } from '@angular-devkit/architect';
+import { JsonObject } from '@angular-devkit/core';
+import { join } from 'path';
+import { Observable, from } from 'rxjs';
+import { finalize, switchMap } from 'rxjs/operators';
+
+interface Options extends JsonObject {
+  devServerBuilder: string;
+  gomadUrl: string;
+  definitions: string;
+}
+
+/** @gomad/client/sync modülünün kullanılan kısmı. */
+interface SyncModule {
+  startTypeSync(options: { url?: string; out?: string; log?: (message: string) => void }): { stop(): void };
+}
+
+/**
+ * @gomad/client:dev-server
+ *
+ * Angular dev sunucusunu (devServerBuilder) olduğu gibi çalıştırır ve bu
+ * sürede "gomad dev" ile başlatılmış uygulamadan binding tanımlarını alıp
+ * definitions dosyasını güncel tutar.
+ */
+export default createBuilder<Options>((options: Options, context: BuilderContext): Observable<BuilderOutput> => {
+  const { devServerBuilder, gomadUrl, definitions, ...devServerOptions } = options;
+
+  return from(importEsm<SyncModule>('@gomad/client/sync')).pipe(
+    switchMap(({ startTypeSync }) => {
+      const sync = startTypeSync({
+        url: gomadUrl,
+        out: join(context.workspaceRoot, definitions),
+        log: (message) => context.logger.info(`[gomad] ${message}`),
+      });
+      return from(context.scheduleBuilder(devServerBuilder, devServerOptions, { target: context.target })).pipe(
+        switchMap((run) => run.output),
+        finalize(() => sync.stop()),
+      );
+    }),
+  );
+});
+
+/**
+ * ESM modülünü CommonJS builder'dan yükler. TypeScript import()'u require()'a
+ * çevireceği için çağrı Function ile gizlenir.
+ */
+function importEsm<T>(specifier: string): Promise<T> {
+  return new Function('specifier', 'return import(specifier)')(specifier) as Promise<T>;
+}
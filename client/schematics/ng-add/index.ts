@@ -0,0 +1,129 @@
+import { Rule, SchematicContext, SchematicsException, Tree, chain } from '@angular-devkit/schematics';
+import { JSONFile } from '@schematics/angular/utility/json-file';
+import { getWorkspace, updateWorkspace } from '@schematics/angular/utility/workspace';
+import { posix } from 'path';
+
+import { Schema } from './schema';
+
+/**
+ * ng add @gomad/client
+ *
+ * 1. Projenin kaynak köküne boş bir tanım dosyası (gomad.d.ts) ekler; Go
+ *    binding'lerinden üretilen tanımlar bu dosyanın yerini alır.
+ * 2. Dosyayı uygulamanın tsconfig'ine ekler (include kapsamında değilse).
+ * 3. "serve" hedefini @gomad/client:dev-server'a çevirir; "gomad dev" altında
+ *    tanımlar çalışan uygulamadan otomatik güncellenir.
+ * 4. package.json'a tanımları tek seferde üreten "gomad:types" script'ini ekler.
+ */
+export function ngAdd(options: Schema): Rule {
+  return async (tree: Tree) => {
+    const workspace = await getWorkspace(tree);
+    const name = options.project || firstApplication(workspace.projects);
+    const project = name ? workspace.projects.get(name) : undefined;
+    if (!project) {
+      throw new SchematicsException(`Angular project "${name ?? ''}" not found`);
+    }
+
+    const sourceRoot = project.sourceRoot ?? posix.join(project.root, 'src');
+    const definitions = posix.join(sourceRoot, options.definitions);
+    const tsConfig = project.targets.get('build')?.options?.['tsConfig'];
+
+    return chain([
+      addDefinitions(definitions),
+      typeof tsConfig === 'string' ? includeDefinitions(tsConfig, definitions) : noopWarn(),
+      wrapDevServer(name as string, definitions),
+      addTypesScript(options.app, definitions),
+    ]);
+  };
+}
+
+/** Workspace'teki ilk uygulama projesinin adını döner. */
+function firstApplication(projects: Iterable<[string, { extensions: Record<string, unknown> }]>): string | undefined {
+  for (const [name, project] of projects) {
+    if (project.extensions['projectType'] === 'application') {
+      return name;
+    }
+  }
+  return undefined;
+}
+
+/** Tanım dosyası yoksa boş bir augmentation iskeleti oluşturur. */
+function addDefinitions(path: string): Rule {
+  return (tree: Tree, context: SchematicContext) => {
+    if (tree.exists(path)) {
+      context.logger.info(`${path} already exists; keeping it.`);
+      return;
+    }
+    tree.create(path, definitionsStub);
+    context.logger.info(`Created ${path}.`);
+  };
+}
+
+/** Tanım dosyasını tsconfig'e ekler; include/files zaten kapsıyorsa dokunmaz. */
+function includeDefinitions(tsConfigPath: string, definitions: string): Rule {
+  return (tree: Tree, context: SchematicContext) => {
+    if (!tree.exists(tsConfigPath)) {
+      context.logger.warn(`${tsConfigPath} not found; add ${definitions} to your tsconfig manually.`);
+      return;
+    }
+    const json = new JSONFile(tree, tsConfigPath);
+    const relative = posix.relative(posix.dirname(tsConfigPath), definitions);
+    const include = json.get(['include']);
+    const files = json.get(['files']);
+    const listed = (list: unknown) => Array.isArray(list) && list.includes(relative);
+    const coveredByGlob = Array.isArray(include) && include.some((p) => p === 'src/**/*.d.ts' || p === 'src/**/*.ts');
+
+    if (listed(include) || listed(files) || (coveredByGlob && relative.startsWith('src/'))) {
+      return;
+    }
+    json.modify(['include'], [...(Array.isArray(include) ? include : []), relative]);
+    context.logger.info(`Added ${relative} to ${tsConfigPath}.`);
+  };
+}
+
+/** serve hedefinin builder'ını tanımları güncel tutan sarmalayıcıyla değiştirir. */
+function wrapDevServer(projectName: string, definitions: string): Rule {
+  return updateWorkspace((workspace) => {
+    const serve = workspace.projects.get(projectName)?.targets.get('serve');
+    if (!serve || serve.builder === devServerBuilder) {
+      return;
+    }
+    serve.options = {
+      ...serve.options,
+      devServerBuilder: serve.builder,
+      definitions,
+    };
+    serve.builder = devServerBuilder;
+  });
+}
+
+/** package.json'a "gomad:types" script'ini ekler (Go modülü app dizinindedir). */
+function addTypesScript(app: string, definitions: string): Rule {
+  return (tree: Tree) => {
+    if (!tree.exists('package.json')) {
+      return;
+    }
+    const json = new JSONFile(tree, 'package.json');
+    if (json.get(['scripts', 'gomad:types']) === undefined) {
+      json.modify(['scripts', 'gomad:types'], `gomad types -app ${app} -out ${definitions}`);
+    }
+  };
+}
+
+function noopWarn(): Rule {
+  return (_tree: Tree, context: SchematicContext) => {
+    context.logger.warn('No build tsConfig found; add the GOMAD definitions file to your tsconfig manually.');
+  };
+}
+
+const devServerBuilder = '@gomad/client:dev-server';
+
+const definitionsStub = `// GOMAD bridge definitions.
+// Go binding'lerinden üretmek için: npm run gomad:types
+import '@gomad/client';
+
+declare module '@gomad/client' {
+  interface GomadBindings {}
+  interface GomadEvents {}
+}
+`;
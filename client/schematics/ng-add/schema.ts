@@ -0,0 +1,8 @@
+export interface Schema {
+  /** Yapılandırılacak Angular projesi; boşsa ilk uygulama. */
+  project?: string;
+  /** Üretilen köprü tanımlarının yolu (projenin kaynak köküne göre). */
+  definitions: string;
+  /** Go main paketinin frontend dizinine göre yolu ("gomad:types" için). */
+  app: string;
+}
@@ -0,0 +1,123 @@
+import { toGomadError, BridgeUnavailableError } from './errors.js';
+import type { Args, EventData, EventName, Method, Result } from './types.js';
+
+/** GOMAD'ın sayfaya enjekte ettiği window.gomad çalışma zamanı. */
+export interface GomadRuntime {
+  call(method: string, ...args: unknown[]): Promise<unknown>;
+  on(event: string, callback: (data: unknown) => void): () => void;
+  off(event: string, callback?: (data: unknown) => void): void;
+  ready(): Promise<unknown>;
+  onStream(name: string, callback: (stream: ReadableStream<Uint8Array>) => void): () => void;
+  setMetaProvider(provider: (() => Record<string, string> | undefined) | null): void;
+  [builtin: string]: unknown;
+}
+
+declare global {
+  interface Window {
+    gomad?: GomadRuntime;
+  }
+}
+
+/** Sayfanın bir GOMAD penceresinde (ya da headless modda) çalışıp çalışmadığını döner. */
+export function isAvailable(): boolean {
+  return typeof window !== 'undefined' && window.gomad !== undefined;
+}
+
+/**
+ * window.gomad çalışma zamanını döner. Köprü yoksa BridgeUnavailableError fırlatır;
+ * yerleşik modüllere (gomad.tray, gomad.dialog…) erişmek için kullanılır.
+ */
+export function runtime(): GomadRuntime {
+  if (!isAvailable()) {
+    throw new BridgeUnavailableError();
+  }
+  return window.gomad as GomadRuntime;
+}
+
+/**
+ * Bir Go binding'ini çağırır. Tanım dosyası varsa ad, argümanlar ve sonuç tiplidir;
+ * hatalar kodlarına göre GomadError alt sınıflarına çevrilir.
+ *
+ * ```ts
+ * const greeting = await call('greet', 'Ahmet');
+ * ```
+ */
+export async function call<M extends Method>(method: M, ...args: Args<M>): Promise<Result<M>> {
+  try {
+    return (await runtime().call(method, ...args)) as Result<M>;
+  } catch (err) {
+    throw toGomadError(err, method);
+  }
+}
+
+/**
+ * Go'dan gelen bir olaya abone olur. Dönen fonksiyon aboneliği kaldırır.
+ *
+ * ```ts
+ * const off = on('user:saved', (user) => console.log(user.id));
+ * ```
+ */
+export function on<E extends EventName>(event: E, callback: (data: EventData<E>) => void): () => void {
+  return runtime().on(event, callback as (data: unknown) => void);
+}
+
+/** Olayın ilk gelişinde çözülen bir Promise döner. */
+export function once<E extends EventName>(event: E): Promise<EventData<E>> {
+  return new Promise((resolve) => {
+    const off = on(event, (data) => {
+      off();
+      resolve(data);
+    });
+  });
+}
+
+/** Olayın tüm dinleyicilerini (ya da yalnızca verilen callback'i) kaldırır. */
+export function off<E extends EventName>(event: E, callback?: (data: EventData<E>) => void): void {
+  runtime().off(event, callback as ((data: unknown) => void) | undefined);
+}
+
+/**
+ * Go'nun SendStream ile gönderdiği akışı alır. Parçalar Uint8Array'dir; okuma
+ * hızı Go tarafını yavaşlatır (backpressure), stream.cancel() Go'daki
+ * gönderimi durdurur. Dönen fonksiyon dinleyiciyi kaldırır.
+ *
+ * ```ts
+ * onStream('export', async (stream) => {
+ *   const blob = await new Response(stream).blob();
+ * });
+ * ```
+ */
+export function onStream(name: string, callback: (stream: ReadableStream<Uint8Array>) => void): () => void {
+  return runtime().onStream(name, callback);
+}
+
+/**
+ * Go tarafına frontend'in hazır olduğunu bildirir (OnFrontendReady callback'leri
+ * çalışır). Uygulama açılışında, olay dinleyicileri kurulduktan sonra çağrılmalıdır.
+ */
+export async function ready(): Promise<void> {
+  try {
+    await runtime().ready();
+  } catch (err) {
+    throw toGomadError(err, 'gomad.ready');
+  }
+}
+
+/**
+ * Her çağrıya eklenecek meta başlığını üreten fonksiyonu ayarlar; null kaldırır.
+ * Go tarafındaki Tracer W3C trace context'i ("traceparent", "tracestate") buradan
+ * okur, böylece frontend'deki span'ler Go binding'lerinin span'lerine bağlanır.
+ *
+ * ```ts
+ * import { propagation, context } from '@opentelemetry/api';
+ *
+ * setMetaProvider(() => {
+ *   const carrier: Record<string, string> = {};
+ *   propagation.inject(context.active(), carrier);
+ *   return carrier;
+ * });
+ * ```
+ */
+export function setMetaProvider(provider: (() => Record<string, string> | undefined) | null): void {
+  runtime().setMetaProvider(provider);
+}
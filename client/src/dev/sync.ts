@@ -0,0 +1,92 @@
+import { mkdir, readFile, writeFile } from 'node:fs/promises';
+import { dirname } from 'node:path';
+
+/** "gomad dev" altında uygulamanın tanım uç noktası (bkz. gomad dev -types-addr). */
+export const DEFAULT_TYPES_URL = 'http://127.0.0.1:34115/types.d.ts';
+
+/** Varsayılan tanım dosyası (build aracının çalışma dizinine göre). */
+export const DEFAULT_DEFINITIONS = 'src/gomad.d.ts';
+
+export interface TypeSyncOptions {
+  /** Tanım uç noktası; varsayılan DEFAULT_TYPES_URL. */
+  url?: string;
+  /** Güncellenecek tanım dosyası; varsayılan DEFAULT_DEFINITIONS. */
+  out?: string;
+  /** Güncelleme bildirimleri; varsayılan console.log. */
+  log?: (message: string) => void;
+}
+
+export interface TypeSync {
+  /** Yoklamayı durdurur. */
+  stop(): void;
+}
+
+/** Uç nokta yanıt vermezse (uygulama yeniden derleniyor) tekrar deneme aralığı. */
+const RETRY_MS = 1000;
+
+/**
+ * Çalışan GOMAD uygulamasından binding tanımlarını alır ve değiştikçe dosyaya
+ * yazar. Uç nokta uzun yoklama (If-None-Match + ?wait) destekler; uygulama
+ * "gomad dev" ile yeniden başlatıldığında bağlantı kopar ve yeni süreçten
+ * tanımlar yeniden alınır. Dosya yalnızca içerik değiştiğinde yazılır, böylece
+ * derleyici gereksiz yere yeniden tetiklenmez.
+ */
+export function startTypeSync(options: TypeSyncOptions = {}): TypeSync {
+  const url = options.url ?? DEFAULT_TYPES_URL;
+  const out = options.out ?? DEFAULT_DEFINITIONS;
+  const log = options.log ?? ((message: string) => console.log(`[gomad] ${message}`));
+  const controller = new AbortController();
+  const { signal } = controller;
+
+  const loop = async () => {
+    let etag = '';
+    while (!signal.aborted) {
+      try {
+        const res = await fetch(etag ? `${url}?wait=1` : url, {
+          headers: etag ? { 'If-None-Match': etag } : {},
+          signal,
+        });
+        if (res.status === 200) {
+          const body = await res.text();
+          etag = res.headers.get('etag') ?? '';
+          if (await writeIfChanged(out, body)) {
+            log(`updated ${out}`);
+          }
+        } else if (res.status !== 304) {
+          await delay(RETRY_MS, signal);
+        }
+      } catch {
+        await delay(RETRY_MS, signal);
+      }
+    }
+  };
+  void loop();
+
+  return { stop: () => controller.abort() };
+}
+
+/** İçerik farklıysa dosyayı yazar; yazıldıysa true döner. */
+async function writeIfChanged(path: string, content: string): Promise<boolean> {
+  const current = await readFile(path, 'utf8').catch(() => undefined);
+  if (current === content) {
+    return false;
+  }
+  await mkdir(dirname(path), { recursive: true });
+  await writeFile(path, content);
+  return true;
+}
+
+/** Süre dolunca ya da iptal edilince çözülen bekleme. */
+function delay(ms: number, signal: AbortSignal): Promise<void> {
+  return new Promise((resolve) => {
+    const timer = setTimeout(resolve, ms);
+    signal.addEventListener(
+      'abort',
+      () => {
+        clearTimeout(timer);
+        resolve();
+      },
+      { once: true },
+    );
+  });
+}
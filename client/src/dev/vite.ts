@@ -0,0 +1,29 @@
+import { startTypeSync, type TypeSyncOptions } from './sync.js';
+
+export type { TypeSyncOptions } from './sync.js';
+
+/** Eklentinin kullandığı Vite dev sunucusu alanları. */
+interface ViteDevServer {
+  httpServer: { once(event: 'close', listener: () => void): unknown } | null;
+}
+
+/**
+ * Vite eklentisi: dev sunucusu çalıştığı sürece GOMAD binding tanımlarını
+ * güncel tutar.
+ *
+ * ```ts
+ * // vite.config.ts
+ * import gomadTypes from '@gomad/client/vite';
+ * export default defineConfig({ plugins: [gomadTypes({ out: 'src/gomad.d.ts' })] });
+ * ```
+ */
+export default function gomadTypes(options: TypeSyncOptions = {}) {
+  return {
+    name: 'gomad-types',
+    apply: 'serve' as const,
+    configureServer(server: ViteDevServer) {
+      const sync = startTypeSync(options);
+      server.httpServer?.once('close', () => sync.stop());
+    },
+  };
+}
@@ -0,0 +1,37 @@
+import { startTypeSync, type TypeSync, type TypeSyncOptions } from './sync.js';
+
+export type { TypeSyncOptions } from './sync.js';
+
+/** Eklentinin kullandığı webpack Compiler kancaları. */
+interface Compiler {
+  hooks: {
+    watchRun: { tap(name: string, fn: () => void): void };
+    watchClose: { tap(name: string, fn: () => void): void };
+  };
+}
+
+/**
+ * webpack eklentisi: watch modunda (webpack serve / --watch) GOMAD binding
+ * tanımlarını güncel tutar; tek seferlik derlemelerde etkisizdir.
+ *
+ * ```js
+ * // webpack.config.mjs
+ * import { GomadTypesPlugin } from '@gomad/client/webpack';
+ * export default { plugins: [new GomadTypesPlugin({ out: 'src/gomad.d.ts' })] };
+ * ```
+ */
+export class GomadTypesPlugin {
+  private sync?: TypeSync;
+
+  constructor(private readonly options: TypeSyncOptions = {}) {}
+
+  apply(compiler: Compiler): void {
+    compiler.hooks.watchRun.tap('GomadTypesPlugin', () => {
+      this.sync ??= startTypeSync(this.options);
+    });
+    compiler.hooks.watchClose.tap('GomadTypesPlugin', () => {
+      this.sync?.stop();
+      this.sync = undefined;
+    });
+  }
+}
@@ -0,0 +1,219 @@
+import type { ErrorName } from './types.js';
+
+/**
+ * Köprü hata kodları; Go tarafındaki bridge.ErrCode* sabitleriyle aynıdır.
+ */
+export const ErrorCode = {
+  Unknown: -1,
+  MethodNotFound: -2,
+  InvalidArgs: -3,
+  Execution: -4,
+  Forbidden: -5,
+  Overloaded: -6,
+  PayloadTooLarge: -7,
+  ShuttingDown: -8,
+} as const;
+
+export type ErrorCode = (typeof ErrorCode)[keyof typeof ErrorCode];
+
+/**
+ * Go tarafından dönen (ya da köprüde oluşan) tüm hataların temel sınıfı.
+ *
+ * ```ts
+ * try {
+ *   await call('saveUser', user);
+ * } catch (e) {
+ *   if (e instanceof InvalidArgumentsError) showValidation(e.fields);
+ * }
+ * ```
+ */
+export class GomadError extends Error {
+  /** Köprü hata kodu (bkz. ErrorCode). */
+  readonly code: number;
+  /** Go tarafındaki ek açıklama (sarılmış hata, stack). */
+  readonly details?: string;
+  /** Hatanın oluştuğu binding adı. */
+  readonly method?: string;
+  /** Go hata zinciri, dıştan içe (details yapısal JSON ise). */
+  readonly chain?: ErrorLink[];
+  /** Zincirin errors.Is ile eşleştiği sentinel ve hata kodu adları. */
+  readonly sentinels: string[];
+  /** Panic'in Go yığın dökümü (yalnızca debug modunda). */
+  readonly goStack?: string;
+  /** Go logundaki kaydın referansı; iç hatalar yalnızca bununla gelir (ErrorPolicyGeneric). */
+  readonly ref?: string;
+
+  constructor(message: string, code: number = ErrorCode.Unknown, details?: string, method?: string, ref?: string) {
+    super(message);
+    this.name = new.target.name;
+    this.code = code;
+    this.details = details;
+    this.method = method;
+    this.ref = ref;
+    const parsed = parseDetails(details);
+    this.chain = parsed?.chain;
+    this.sentinels = parsed?.is ?? [];
+    this.goStack = parsed?.stack;
+    Object.setPrototypeOf(this, new.target.prototype);
+  }
+
+  /**
+   * Go'daki hata zinciri verilen sentinel'i (ör. 'fs.ErrNotExist') ya da
+   * kayıtlı hata kodunu içeriyorsa true döner; Go'daki errors.Is karşılığı.
+   */
+  is(sentinel: string): boolean {
+    return this.sentinels.includes(sentinel);
+  }
+}
+
+/** Go hata zincirindeki tek bir hata. */
+export interface ErrorLink {
+  message: string;
+  /** Go tipi, ör. '*fs.PathError'. */
+  type: string;
+  /** Kayıtlı hata kodu ve adı (gomad.NewErrorCode). */
+  code?: number;
+  name?: string;
+}
+
+/** Köprünün details alanına yazdığı yapısal hata zinciri. */
+interface ErrorDetails {
+  chain: ErrorLink[];
+  is?: string[];
+  stack?: string;
+}
+
+function parseDetails(details?: string): ErrorDetails | undefined {
+  if (!details || details[0] !== '{') {
+    return undefined;
+  }
+  try {
+    const parsed = JSON.parse(details) as ErrorDetails;
+    return Array.isArray(parsed.chain) ? parsed : undefined;
+  } catch {
+    return undefined;
+  }
+}
+
+/** Çağrılan binding Go tarafında kayıtlı değil. */
+export class MethodNotFoundError extends GomadError {}
+
+/** Argüman sayısı ya da tipi binding imzasıyla uyuşmuyor ya da argüman doğrulanamadı. */
+export class InvalidArgumentsError extends GomadError {
+  /** Go'daki `validate` tag'lerinden geçemeyen alanlar; yoksa boş. */
+  fields: FieldError[] = [];
+}
+
+/** Doğrulamadan geçemeyen tek bir argüman alanı (bridge.FieldError). */
+export interface FieldError {
+  /** Argüman sırası. */
+  arg: number;
+  /** JSON yolu, ör. 'address.zip', 'items[2].qty'. */
+  field: string;
+  /** Başarısız kural, ör. 'required', 'min=3'. */
+  rule: string;
+  message: string;
+}
+
+/** Binding çalıştı ama hata döndü (ya da panic oldu). */
+export class ExecutionError extends GomadError {}
+
+/** Sayfa, binding çağırmasına izin verilen bir origin'de ya da en üst çerçevede değil. */
+export class ForbiddenError extends GomadError {}
+
+/** Köprünün çağrı sınırları aşıldı; çağrı çalıştırılmadı, daha sonra tekrar denenebilir. */
+export class OverloadedError extends GomadError {}
+
+/** Argümanlar ya da sonuç köprünün boyut sınırını aştı; büyük veriler için onStream kullanılmalı. */
+export class PayloadTooLargeError extends GomadError {}
+
+/** Uygulama kapanıyor; çağrı çalıştırılmadı. Kaydedilmemiş veriler 'app:will-quit' olayında gönderilmeli. */
+export class ShuttingDownError extends GomadError {}
+
+// Uygulama hata kodlarının sınıfları; ilk kullanımda oluşturulur
+const errorClasses = new Map<string, typeof GomadError>();
+
+/**
+ * Go'da gomad.NewErrorCode ile kaydedilmiş bir hata kodunun sınıfını döner.
+ * Sınıfın adı `<name>Error`'dur ve aynı ad için her zaman aynı sınıf döner;
+ * köprü bu koddaki hataları bu sınıfla reddeder.
+ *
+ * ```ts
+ * const QuotaExceededError = errorClass('QuotaExceeded');
+ * try {
+ *   await call('upload', file);
+ * } catch (e) {
+ *   if (e instanceof QuotaExceededError) showUpgrade();
+ * }
+ * ```
+ */
+export function errorClass(name: ErrorName): typeof GomadError {
+  let cls = errorClasses.get(name);
+  if (!cls) {
+    const className = name + 'Error';
+    cls = { [className]: class extends GomadError {} }[className];
+    errorClasses.set(name, cls);
+  }
+  return cls;
+}
+
+/** Sayfa bir GOMAD penceresinde çalışmıyor (window.gomad yok). */
+export class BridgeUnavailableError extends GomadError {
+  constructor(message = 'GOMAD bridge is not available') {
+    super(message, ErrorCode.Unknown);
+  }
+}
+
+/** Hatanın GomadError olup olmadığını döner. */
+export function isGomadError(err: unknown): err is GomadError {
+  return err instanceof GomadError;
+}
+
+/**
+ * Köprünün reddettiği değeri kodu eşleşen GomadError alt sınıfına çevirir.
+ * Zaten GomadError ise olduğu gibi döner.
+ */
+export function toGomadError(err: unknown, method?: string): GomadError {
+  if (err instanceof GomadError) {
+    return err;
+  }
+  const raw = (err ?? {}) as {
+    message?: unknown;
+    code?: unknown;
+    details?: unknown;
+    codeName?: unknown;
+    ref?: unknown;
+    fields?: unknown;
+  };
+  const message = typeof raw.message === 'string' ? raw.message : String(err);
+  const code = typeof raw.code === 'number' ? raw.code : ErrorCode.Unknown;
+  const details = typeof raw.details === 'string' && raw.details !== '' ? raw.details : undefined;
+  const ref = typeof raw.ref === 'string' && raw.ref !== '' ? raw.ref : undefined;
+
+  switch (code) {
+    case ErrorCode.MethodNotFound:
+      return new MethodNotFoundError(message, code, details, method, ref);
+    case ErrorCode.InvalidArgs: {
+      const invalid = new InvalidArgumentsError(message, code, details, method, ref);
+      if (Array.isArray(raw.fields)) invalid.fields = raw.fields as FieldError[];
+      return invalid;
+    }
+    case ErrorCode.Execution:
+      return new ExecutionError(message, code, details, method, ref);
+    case ErrorCode.Forbidden:
+      return new ForbiddenError(message, code, details, method, ref);
+    case ErrorCode.Overloaded:
+      return new OverloadedError(message, code, details, method, ref);
+    case ErrorCode.PayloadTooLarge:
+      return new PayloadTooLargeError(message, code, details, method, ref);
+    case ErrorCode.ShuttingDown:
+      return new ShuttingDownError(message, code, details, method, ref);
+    default:
+      if (typeof raw.codeName === 'string' && raw.codeName !== '') {
+        // Uygulamanın kayıtlı hata kodu (gomad.NewErrorCode)
+        const cls = errorClass(raw.codeName);
+        return new cls(message, code, details, method, ref);
+      }
+      return new GomadError(message, code, details, method, ref);
+  }
+}
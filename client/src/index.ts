@@ -0,0 +1,44 @@
+/**
+ * @gomad/client — GOMAD Go ↔ JavaScript köprüsü için tipli frontend istemcisi.
+ *
+ * ```ts
+ * import { call, on, ready, ExecutionError } from '@gomad/client';
+ *
+ * on('progress', (p) => render(p));
+ * await ready();
+ * const user = await call('getUser', 42);
+ * ```
+ *
+ * Tipler, Go binding'lerinden üretilen gomad.d.ts ile gelir (bkz. GomadBindings).
+ * RxJS yardımcıları için: import { fromGomadEvent } from '@gomad/client/rxjs'.
+ */
+export { call, on, once, off, onStream, ready, setMetaProvider, isAvailable, runtime } from './bridge.js';
+export type { GomadRuntime } from './bridge.js';
+export { subscribeState, patchState, currentState } from './state.js';
+export {
+  ErrorCode,
+  GomadError,
+  MethodNotFoundError,
+  InvalidArgumentsError,
+  ExecutionError,
+  ForbiddenError,
+  OverloadedError,
+  PayloadTooLargeError,
+  ShuttingDownError,
+  BridgeUnavailableError,
+  isGomadError,
+  toGomadError,
+  errorClass,
+} from './errors.js';
+export type { ErrorLink, FieldError } from './errors.js';
+export type {
+  GomadBindings,
+  GomadEvents,
+  GomadErrorCodes,
+  Method,
+  Args,
+  Result,
+  EventName,
+  EventData,
+  ErrorName,
+} from './types.js';
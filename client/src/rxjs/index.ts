@@ -0,0 +1,41 @@
+import { Observable, defer, from } from 'rxjs';
+
+import { call, on } from '../bridge.js';
+import { subscribeState } from '../state.js';
+import type { Args, EventData, EventName, Method, Result } from '../types.js';
+
+/**
+ * Go olayını bir Observable'a çevirir. Abonelik bitince köprü dinleyicisi kaldırılır.
+ *
+ * ```ts
+ * fromGomadEvent('download:progress')
+ *   .pipe(throttleTime(100))
+ *   .subscribe((p) => (this.progress = p.percent));
+ * ```
+ */
+export function fromGomadEvent<E extends EventName>(event: E): Observable<EventData<E>> {
+  return new Observable<EventData<E>>((subscriber) => on(event, (data) => subscriber.next(data)));
+}
+
+/**
+ * Binding çağrısını soğuk bir Observable olarak döner: çağrı her abonelikte
+ * yeniden yapılır, hatalar GomadError olarak iletilir.
+ *
+ * ```ts
+ * this.user$ = call$('getUser', id);
+ * ```
+ */
+export function call$<M extends Method>(method: M, ...args: Args<M>): Observable<Result<M>> {
+  return defer(() => from(call(method, ...args)));
+}
+
+/**
+ * Go'nun sahip olduğu bir durumu Observable olarak döner (bkz. subscribeState).
+ *
+ * ```ts
+ * this.user$ = fromGomadState<User>('user');
+ * ```
+ */
+export function fromGomadState<T = unknown>(name: string): Observable<T> {
+  return new Observable<T>((subscriber) => subscribeState<T>(name, (value) => subscriber.next(value)));
+}
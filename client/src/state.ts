@@ -0,0 +1,48 @@
+import { runtime } from './bridge.js';
+import { toGomadError } from './errors.js';
+
+/** window.gomad.state yerleşik modülü (bkz. Go: app.State()). */
+interface StateRuntime {
+  subscribe(name: string, callback: (value: unknown) => void): () => void;
+  patch(name: string, patch: unknown): Promise<number>;
+  current(name: string): unknown;
+}
+
+function stateRuntime(): StateRuntime {
+  return runtime().state as StateRuntime;
+}
+
+/**
+ * Go'nun sahip olduğu bir duruma abone olur. Callback ilk değerle ve her
+ * değişiklikte çağrılır; Go yalnızca değişen alanları gönderir. Dönen
+ * fonksiyon aboneliği kaldırır.
+ *
+ * ```ts
+ * const off = subscribeState<User>('user', (user) => (this.user = user));
+ * ```
+ */
+export function subscribeState<T = unknown>(name: string, callback: (value: T) => void): () => void {
+  return stateRuntime().subscribe(name, callback as (value: unknown) => void);
+}
+
+/**
+ * Durumda değişiklik ister (JSON Merge Patch: null alanı siler). Go tarafında
+ * OnPatch ile yazılabilir yapılmamış durumlar için çağrı reddedilir
+ * (e.is('gomad.ErrPermissionDenied')). Yeni sürümü döner.
+ *
+ * ```ts
+ * await patchState('settings', { theme: 'dark' });
+ * ```
+ */
+export async function patchState<T = unknown>(name: string, patch: Partial<T>): Promise<number> {
+  try {
+    return await stateRuntime().patch(name, patch);
+  } catch (err) {
+    throw toGomadError(err, 'gomad.state.patch');
+  }
+}
+
+/** Durumun yerel store'daki son değerini döner; abone olunmamışsa undefined. */
+export function currentState<T = unknown>(name: string): T | undefined {
+  return stateRuntime().current(name) as T | undefined;
+}
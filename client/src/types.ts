@@ -0,0 +1,62 @@
+/**
+ * Go binding'lerinin ve olaylarının tip haritaları.
+ *
+ * Boş bırakılırlar; üretilen tanım dosyası (gomad.d.ts) bu arayüzleri
+ * module augmentation ile doldurur:
+ *
+ * ```ts
+ * declare module '@gomad/client' {
+ *   interface GomadBindings {
+ *     greet(name: string): string;
+ *   }
+ *   interface GomadEvents {
+ *     'user:saved': { id: number };
+ *   }
+ *   interface GomadErrorCodes {
+ *     QuotaExceeded: 1001;
+ *   }
+ * }
+ * ```
+ *
+ * Tanım yoksa call/on serbest tiplidir (string ad, unknown sonuç).
+ */
+// eslint-disable-next-line @typescript-eslint/no-empty-interface
+export interface GomadBindings {}
+
+// eslint-disable-next-line @typescript-eslint/no-empty-interface
+export interface GomadEvents {}
+
+/**
+ * Uygulamanın Go'da kaydettiği hata kodları (gomad.NewErrorCode): ad → kod.
+ * Üretilen tanım dosyası doldurur; errorClass yalnızca bu adları kabul eder.
+ */
+// eslint-disable-next-line @typescript-eslint/no-empty-interface
+export interface GomadErrorCodes {}
+
+type AnyFn = (...args: any[]) => any;
+
+/** Çağrılabilir binding adı. */
+export type Method = [keyof GomadBindings] extends [never] ? string : Extract<keyof GomadBindings, string>;
+
+/** Binding'in argüman listesi. */
+export type Args<M extends string> = M extends keyof GomadBindings
+  ? GomadBindings[M] extends AnyFn
+    ? Parameters<GomadBindings[M]>
+    : unknown[]
+  : unknown[];
+
+/** Binding'in (Promise'ten çözülmüş) sonucu. */
+export type Result<M extends string> = M extends keyof GomadBindings
+  ? GomadBindings[M] extends AnyFn
+    ? Awaited<ReturnType<GomadBindings[M]>>
+    : unknown
+  : unknown;
+
+/** Kayıtlı hata kodu adı. */
+export type ErrorName = [keyof GomadErrorCodes] extends [never] ? string : Extract<keyof GomadErrorCodes, string>;
+
+/** Dinlenebilir olay adı. */
+export type EventName = [keyof GomadEvents] extends [never] ? string : Extract<keyof GomadEvents, string>;
+
+/** Olayın veri tipi. */
+export type EventData<E extends string> = E extends keyof GomadEvents ? GomadEvents[E] : unknown;
@@ -0,0 +1,412 @@
+package main
+
+import (
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"html"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// gomad build
+// 1. Frontend'i derler (ör. "npm run build"); uygulama çıktıyı
+//    //go:embed + gomad.WithAssets ile gömer. -bundle verilirse çıktı
+//    gzip ile sıkıştırılmış bir kopyaya dönüştürülür (bkz. gomad bundle).
+// 2. Her hedef (GOOS/GOARCH) için Go uygulamasını derler; sürüm, commit ve
+//    tarih -ldflags "-X" ile pkg/gomad'a enjekte edilir (bkz. gomad.Build).
+// 3. Windows: GUI alt sistemi (-H=windowsgui), manifest ve ikon .syso kaynağı.
+//    macOS: <Name>.app paket yapısı ve Info.plist. Linux: tek çalıştırılabilir.
+//
+// Çıktı: <out>/<goos>-<goarch>/...
+//
+// WebView cgo gerektirdiğinden başka bir platform için derlerken o hedefin C
+// derleyicisi CC ortam değişkeniyle verilmelidir.
+// ============================================================================
+
+// gomadPkg, ldflags -X hedeflerinin paket yoludur.
+const gomadPkg = "github.com/biyonik/gomad/pkg/gomad"
+
+// buildOptions, "gomad build" ayarlarıdır.
+type buildOptions struct {
+	app          string
+	name         string
+	appID        string
+	version      string
+	iconWindows  string
+	iconMacOS    string
+	frontend     string
+	frontendCmd  string
+	skipFrontend bool
+	dist         string
+	bundle       string
+	targets      string
+	out          string
+	console      bool
+	tags         string
+
+	// gomad.yaml'dan gelir; macOS Info.plist'e yazılır
+	fileAssociations []FileAssociation
+	protocols        []Protocol
+}
+
+// target, bir derleme hedefidir.
+type target struct {
+	goos, goarch string
+}
+
+func (t target) String() string { return t.goos + "-" + t.goarch }
+
+func runBuild(args []string) error {
+	m, err := loadManifest(manifestFile)
+	if err != nil {
+		return err
+	}
+	opts := m.buildOptions()
+	fs := flag.NewFlagSet("build", flag.ExitOnError)
+	opts.register(fs)
+	fs.Parse(args)
+
+	_, err = opts.build()
+	return err
+}
+
+// register, derleme bayraklarını tanımlar ("gomad package" de kullanır).
+// Alanlarda (gomad.yaml'dan gelen) değer varsa bayrağın varsayılanı olur.
+func (o *buildOptions) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.app, "app", or(o.app, "."), "Go main package of the application")
+	fs.StringVar(&o.name, "name", o.name, "application name (default: main package directory name)")
+	fs.StringVar(&o.appID, "app-id", o.appID, "reverse-DNS application ID, e.g. com.example.notes")
+	fs.StringVar(&o.version, "version", o.version, "version (default: git describe, or 0.0.0)")
+	fs.StringVar(&o.iconWindows, "icon-windows", o.iconWindows, ".ico file embedded into Windows executables")
+	fs.StringVar(&o.iconMacOS, "icon-macos", o.iconMacOS, ".icns file copied into the macOS bundle")
+	fs.StringVar(&o.frontend, "frontend", or(o.frontend, "frontend"), "frontend directory (skipped if missing)")
+	fs.StringVar(&o.frontendCmd, "frontend-cmd", or(o.frontendCmd, "npm run build"), "command that builds the frontend")
+	fs.BoolVar(&o.skipFrontend, "skip-frontend", false, "do not build the frontend")
+	fs.StringVar(&o.dist, "dist", or(o.dist, filepath.Join("frontend", "dist")), "frontend build output compressed by -bundle")
+	fs.StringVar(&o.bundle, "bundle", o.bundle, "write a gzip-compressed copy of -dist here for embedding (see gomad bundle)")
+	fs.StringVar(&o.targets, "target", runtime.GOOS+"/"+runtime.GOARCH, "comma-separated GOOS/GOARCH targets")
+	fs.StringVar(&o.out, "out", "dist", "output directory")
+	fs.BoolVar(&o.console, "console", false, "keep the console window on Windows")
+	fs.StringVar(&o.tags, "tags", "", "Go build tags")
+}
+
+// buildResult, bir hedefin derleme çıktısıdır.
+type buildResult struct {
+	target target
+	dir    string // <out>/<goos>-<goarch>
+	path   string // Çalıştırılabilir dosya ya da .app paketi
+}
+
+// build, frontend'i ve tüm hedefleri derler.
+func (o *buildOptions) build() ([]buildResult, error) {
+	targets, err := parseTargets(o.targets)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.defaults(); err != nil {
+		return nil, err
+	}
+
+	if !o.skipFrontend {
+		if info, err := os.Stat(o.frontend); err == nil && info.IsDir() && o.frontendCmd != "" {
+			logger.Info("building frontend", "cmd", o.frontendCmd, "dir", o.frontend)
+			if err := runIn(o.frontend, nil, o.frontendCmd); err != nil {
+				return nil, fmt.Errorf("frontend build failed: %w", err)
+			}
+		}
+	}
+	if o.bundle != "" {
+		if err := bundleAssets(o.dist, o.bundle, gzip.BestCompression); err != nil {
+			return nil, err
+		}
+	}
+
+	var results []buildResult
+	for _, t := range targets {
+		logger.Info("building", "target", t, "version", o.version)
+		result, err := o.buildTarget(t)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", t, err)
+		}
+		logger.Info("built", "target", t, "path", result.path)
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// defaults, boş bırakılan ayarları tamamlar.
+func (o *buildOptions) defaults() error {
+	if o.name == "" {
+		dir, err := packageDir(o.app)
+		if err != nil {
+			return err
+		}
+		o.name = filepath.Base(dir)
+	}
+	if o.appID == "" {
+		o.appID = "com.gomad." + strings.ToLower(regexp.MustCompile(`[^A-Za-z0-9]+`).ReplaceAllString(o.name, ""))
+	}
+	if o.version == "" {
+		o.version = gitVersion()
+	}
+	return nil
+}
+
+// buildTarget, tek bir hedefi derler ve platform yapısını oluşturur.
+func (o *buildOptions) buildTarget(t target) (buildResult, error) {
+	dir := filepath.Join(o.out, t.String())
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return buildResult{}, err
+	}
+
+	binary := filepath.Join(dir, o.name)
+	ldflags := []string{
+		"-s", "-w",
+		"-X", gomadPkg + ".buildVersion=" + o.version,
+		"-X", gomadPkg + ".buildCommit=" + gitCommit(),
+		"-X", gomadPkg + ".buildDate=" + time.Now().UTC().Format(time.RFC3339),
+	}
+
+	switch t.goos {
+	case "windows":
+		binary += ".exe"
+		if !o.console {
+			ldflags = append(ldflags, "-H=windowsgui")
+		}
+		cleanup, err := o.writeWindowsResources(t)
+		if err != nil {
+			return buildResult{}, err
+		}
+		defer cleanup()
+
+	case "darwin":
+		bundle := filepath.Join(dir, o.name+".app")
+		if err := os.RemoveAll(bundle); err != nil {
+			return buildResult{}, err
+		}
+		binary = filepath.Join(bundle, "Contents", "MacOS", o.name)
+		if err := os.MkdirAll(filepath.Dir(binary), 0o755); err != nil {
+			return buildResult{}, err
+		}
+	}
+
+	args := []string{"build", "-trimpath", "-ldflags", strings.Join(ldflags, " "), "-o", binary}
+	if o.tags != "" {
+		args = append(args, "-tags", o.tags)
+	}
+	args = append(args, o.app)
+
+	cmd := exec.Command("go", args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), "GOOS="+t.goos, "GOARCH="+t.goarch, "CGO_ENABLED=1")
+	if err := cmd.Run(); err != nil {
+		return buildResult{}, err
+	}
+
+	result := buildResult{target: t, dir: dir, path: binary}
+	if t.goos == "darwin" {
+		bundle := filepath.Join(dir, o.name+".app")
+		if err := o.writeBundle(bundle); err != nil {
+			return buildResult{}, err
+		}
+		result.path = bundle
+	}
+	return result, nil
+}
+
+// writeWindowsResources, manifest ve ikonu main paket dizinine .syso olarak
+// yazar. Dönen fonksiyon dosyayı derlemeden sonra siler.
+func (o *buildOptions) writeWindowsResources(t target) (cleanup func(), err error) {
+	manifest := fmt.Sprintf(defaultManifest, html.EscapeString(o.appID), winVersion(o.version))
+	resources := []resource{{typ: rtManifest, id: 1, data: []byte(manifest)}}
+
+	if o.iconWindows != "" {
+		ico, err := os.ReadFile(o.iconWindows)
+		if err != nil {
+			return nil, err
+		}
+		icons, err := iconResources(ico)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, icons...)
+	}
+
+	syso, err := buildSyso(t.goarch, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := packageDir(o.app)
+	if err != nil {
+		return nil, err
+	}
+	path := filepath.Join(dir, "zz_gomad_windows_"+t.goarch+".syso")
+	if err := os.WriteFile(path, syso, 0o644); err != nil {
+		return nil, err
+	}
+	return func() { os.Remove(path) }, nil
+}
+
+// writeBundle, macOS .app paketinin Info.plist ve kaynaklarını yazar.
+func (o *buildOptions) writeBundle(bundle string) error {
+	contents := filepath.Join(bundle, "Contents")
+	resources := filepath.Join(contents, "Resources")
+	if err := os.MkdirAll(resources, 0o755); err != nil {
+		return err
+	}
+
+	var iconEntry string
+	if o.iconMacOS != "" {
+		data, err := os.ReadFile(o.iconMacOS)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(resources, "icon.icns"), data, 0o644); err != nil {
+			return err
+		}
+		iconEntry = "\n    <key>CFBundleIconFile</key>\n    <string>icon.icns</string>"
+	}
+
+	esc := html.EscapeString
+	plist := `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+    <key>CFBundlePackageType</key>
+    <string>APPL</string>
+    <key>CFBundleName</key>
+    <string>` + esc(o.name) + `</string>
+    <key>CFBundleExecutable</key>
+    <string>` + esc(o.name) + `</string>
+    <key>CFBundleIdentifier</key>
+    <string>` + esc(o.appID) + `</string>
+    <key>CFBundleShortVersionString</key>
+    <string>` + esc(o.version) + `</string>
+    <key>CFBundleVersion</key>
+    <string>` + esc(o.version) + `</string>` + iconEntry + o.plistAssociations() + `
+    <key>LSMinimumSystemVersion</key>
+    <string>10.15</string>
+    <key>NSHighResolutionCapable</key>
+    <true/>
+</dict>
+</plist>
+`
+	return os.WriteFile(filepath.Join(contents, "Info.plist"), []byte(plist), 0o644)
+}
+
+// or, s boşsa def döner.
+func or(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+// parseTargets, "windows/amd64,darwin/arm64" listesini çözümler.
+func parseTargets(s string) ([]target, error) {
+	var targets []target
+	for _, item := range strings.Split(s, ",") {
+		goos, goarch, ok := strings.Cut(strings.TrimSpace(item), "/")
+		if !ok || goos == "" || goarch == "" {
+			return nil, fmt.Errorf("invalid target %q (want GOOS/GOARCH)", item)
+		}
+		targets = append(targets, target{goos: goos, goarch: goarch})
+	}
+	return targets, nil
+}
+
+// packageDir, Go paketinin dizinini döner.
+func packageDir(pkg string) (string, error) {
+	out, err := exec.Command("go", "list", "-f", "{{.Dir}}", pkg).Output()
+	if err != nil {
+		return "", fmt.Errorf("go list %s: %w", pkg, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// runIn, komutu verilen dizinde çalıştırır; çıktı terminale gider.
+func runIn(dir string, env []string, command string) error {
+	fields := strings.Fields(command)
+	cmd := exec.Command(fields[0], fields[1:]...)
+	cmd.Dir = dir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if env != nil {
+		cmd.Env = append(os.Environ(), env...)
+	}
+	return cmd.Run()
+}
+
+// gitVersion, en yakın etiketten sürüm üretir ("v1.2.0" → "1.2.0").
+func gitVersion() string {
+	out, err := exec.Command("git", "describe", "--tags", "--always", "--dirty").Output()
+	if err != nil {
+		return "0.0.0"
+	}
+	v := strings.TrimPrefix(strings.TrimSpace(string(out)), "v")
+	if v == "" || !regexp.MustCompile(`^\d`).MatchString(v) {
+		return "0.0.0-" + v
+	}
+	return v
+}
+
+// gitCommit, kısa commit kimliğini döner; git yoksa "".
+func gitCommit() string {
+	out, err := exec.Command("git", "rev-parse", "--short", "HEAD").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// winVersion, sürümü Windows'un beklediği dört parçalı sayısal biçime çevirir
+// ("1.2.0-beta" → "1.2.0.0").
+func winVersion(v string) string {
+	parts := regexp.MustCompile(`\d+`).FindAllString(strings.SplitN(v, "-", 2)[0], 4)
+	for len(parts) < 4 {
+		parts = append(parts, "0")
+	}
+	return strings.Join(parts, ".")
+}
+
+// plistAssociations, dosya ilişkilendirmeleri ve URL şemaları için
+// CFBundleDocumentTypes / CFBundleURLTypes girdilerini üretir.
+func (o *buildOptions) plistAssociations() string {
+	esc := html.EscapeString
+	var sb strings.Builder
+	if len(o.fileAssociations) > 0 {
+		sb.WriteString("\n    <key>CFBundleDocumentTypes</key>\n    <array>")
+		for _, fa := range o.fileAssociations {
+			role := or(fa.Role, "Editor")
+			sb.WriteString("\n        <dict>")
+			sb.WriteString("\n            <key>CFBundleTypeName</key>\n            <string>" + esc(or(fa.Name, fa.Ext)) + "</string>")
+			sb.WriteString("\n            <key>CFBundleTypeRole</key>\n            <string>" + esc(role) + "</string>")
+			sb.WriteString("\n            <key>CFBundleTypeExtensions</key>\n            <array><string>" + esc(fa.Ext) + "</string></array>")
+			if fa.MimeType != "" {
+				sb.WriteString("\n            <key>CFBundleTypeMIMETypes</key>\n            <array><string>" + esc(fa.MimeType) + "</string></array>")
+			}
+			sb.WriteString("\n        </dict>")
+		}
+		sb.WriteString("\n    </array>")
+	}
+	if len(o.protocols) > 0 {
+		sb.WriteString("\n    <key>CFBundleURLTypes</key>\n    <array>")
+		for _, p := range o.protocols {
+			sb.WriteString("\n        <dict>")
+			sb.WriteString("\n            <key>CFBundleURLName</key>\n            <string>" + esc(or(p.Name, o.appID+"."+p.Scheme)) + "</string>")
+			sb.WriteString("\n            <key>CFBundleURLSchemes</key>\n            <array><string>" + esc(p.Scheme) + "</string></array>")
+			sb.WriteString("\n        </dict>")
+		}
+		sb.WriteString("\n    </array>")
+	}
+	return sb.String()
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// ============================================================================
+// gomad bundle
+// Frontend derleme çıktısını (ör. Angular dist) sıkıştırılmış bir kopyaya
+// dönüştürür; uygulama dist yerine bu dizini gömer:
+//
+//	gomad bundle -in frontend/dist/browser -out frontend/bundle
+//
+//	//go:embed all:frontend/bundle
+//	var assets embed.FS
+//	bundle, _ := fs.Sub(assets, "frontend/bundle")
+//	app := gomad.New(gomad.WithAssets(bundle))
+//
+// Sıkışan her dosya <ad>.gz olarak yazılır; zaten sıkıştırılmış biçimler
+// (png, woff2 vb.) ve küçük dosyalar kazanç sağlamadığından olduğu gibi
+// kopyalanır. gomad.WithAssets .gz dosyalarını tanır: WebView gzip kabul
+// ettiği için içerik çözülmeden Content-Encoding: gzip ile aktarılır, kabul
+// etmeyen istemciler için akış halinde çözülür.
+//
+// Yalnızca standart kütüphanedeki gzip kullanılır; zstd ve brotli harici
+// codec gerektirdiğinden desteklenmez. Dosyalar özel şema yerine WithAssets'in
+// loopback sunucusundan verilir (webview_go şema işleyicisi sunmaz).
+// ============================================================================
+
+// bundleMarker, çıktı dizininin bu komutla üretildiğini işaretler; dizin
+// yalnızca bu dosya varsa silinip yeniden oluşturulur.
+const bundleMarker = ".gomad-bundle"
+
+// bundleMinSize, bu boyutun altındaki dosyalar sıkıştırılmaz.
+const bundleMinSize = 512
+
+func runBundle(args []string) error {
+	fs := flag.NewFlagSet("bundle", flag.ExitOnError)
+	in := fs.String("in", "", "frontend build output directory, e.g. frontend/dist/browser")
+	out := fs.String("out", "", "output directory to embed, e.g. frontend/bundle")
+	level := fs.Int("level", gzip.BestCompression, "gzip level (1-9)")
+	fs.Parse(args)
+	if *in == "" || *out == "" {
+		fs.Usage()
+		return errors.New("-in and -out are required")
+	}
+	return bundleAssets(*in, *out, *level)
+}
+
+// bundleAssets, in dizinini sıkıştırarak out'a yazar.
+func bundleAssets(in, out string, level int) error {
+	if info, err := os.Stat(in); err != nil || !info.IsDir() {
+		return fmt.Errorf("bundle: %s is not a directory", in)
+	}
+	if _, err := os.Stat(out); err == nil {
+		if _, err := os.Stat(filepath.Join(out, bundleMarker)); err != nil {
+			return fmt.Errorf("bundle: %s exists and was not created by gomad bundle", out)
+		}
+		if err := os.RemoveAll(out); err != nil {
+			return err
+		}
+	}
+	if err := os.MkdirAll(out, 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(out, bundleMarker), nil, 0o644); err != nil {
+		return err
+	}
+
+	var before, after int64
+	var compressed, stored int
+	err := filepath.WalkDir(in, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(in, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(out, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		before += int64(len(data))
+		if gz, ok := gzipAsset(data, level); ok {
+			compressed++
+			after += int64(len(gz))
+			return os.WriteFile(target+".gz", gz, 0o644)
+		}
+		stored++
+		after += int64(len(data))
+		return os.WriteFile(target, data, 0o644)
+	})
+	if err != nil {
+		return fmt.Errorf("bundle: %w", err)
+	}
+
+	logger.Info("assets bundled", "dir", out, "compressed", compressed, "stored", stored,
+		"size", fmt.Sprintf("%.1f KB → %.1f KB", float64(before)/1024, float64(after)/1024))
+	return nil
+}
+
+// gzipAsset, veriyi sıkıştırır; kazanç %10'dan azsa false döner.
+func gzipAsset(data []byte, level int) ([]byte, bool) {
+	if len(data) < bundleMinSize {
+		return nil, false
+	}
+	var buf bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&buf, level)
+	if err != nil {
+		return nil, false
+	}
+	if _, err := zw.Write(data); err != nil {
+		return nil, false
+	}
+	if err := zw.Close(); err != nil {
+		return nil, false
+	}
+	if buf.Len() > len(data)*9/10 {
+		return nil, false
+	}
+	return buf.Bytes(), true
+}
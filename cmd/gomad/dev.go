@@ -0,0 +1,371 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/biyonik/gomad/pkg/fswatch"
+)
+
+// ============================================================================
+// gomad dev
+// 1. Frontend dev sunucusunu başlatır (ör. "npm run start") ve yanıt
+//    vermesini bekler.
+// 2. Go uygulamasını derler ve GOMAD_DEV_URL ile dev sunucusuna yönlendirerek
+//    çalıştırır.
+// 3. Go kaynakları değişince yeniden derler; derleme başarılıysa eski süreci
+//    stdin üzerinden ("quit") kapatıp yenisini başlatır. Derleme hatasında
+//    çalışan uygulama yerinde kalır.
+// 4. -reload dizinindeki (ör. statik asset'ler) değişikliklerde ve terminalde
+//    "r" + Enter ile WebView'i yeniler. Angular dev sunucusu kendi canlı
+//    yenilemesini yaptığı için frontend dizini varsayılan olarak izlenmez.
+// 5. Uygulama -types-addr adresinde binding tanımlarını (.d.ts) sunar;
+//    @gomad/client build eklentileri buradan tipleri günceller.
+// 6. -hot ile uygulama iki süreç olarak çalışır: pencere (shell) bir kez
+//    başlatılır ve açık kalır, binding'ler ayrı bir backend sürecinde çalışır.
+//    Go değişikliğinde yalnızca backend yeniden derlenip değiştirilir; sayfa
+//    ve UI durumu korunur. Pencere ayarları ve yerleşik modüllerdeki
+//    değişiklikler için "w" + Enter ile pencere de yeniden başlatılır.
+// ============================================================================
+
+const (
+	// serverTimeout, frontend dev sunucusunun ayağa kalkması için beklenen süredir.
+	serverTimeout = 2 * time.Minute
+
+	// quitTimeout, "quit" komutundan sonra sürecin kapanması için beklenen süredir.
+	quitTimeout = 3 * time.Second
+)
+
+// devOptions, "gomad dev" ayarlarıdır.
+type devOptions struct {
+	app         string
+	frontend    string
+	frontendCmd string
+	url         string
+	reloadDir   string
+	typesAddr   string
+	hot         bool
+	appArgs     []string
+}
+
+func runDev(args []string) error {
+	var opts devOptions
+	fs := flag.NewFlagSet("dev", flag.ExitOnError)
+	fs.StringVar(&opts.app, "app", ".", "Go main package of the application")
+	fs.StringVar(&opts.frontend, "frontend", "frontend", "frontend directory (skipped if missing)")
+	fs.StringVar(&opts.frontendCmd, "frontend-cmd", "npm run start", "command that starts the frontend dev server")
+	fs.StringVar(&opts.url, "url", "http://localhost:4200", "frontend dev server URL")
+	fs.StringVar(&opts.reloadDir, "reload", "", "directory whose changes reload the webview (e.g. static assets)")
+	fs.StringVar(&opts.typesAddr, "types-addr", "127.0.0.1:34115", "address where the app serves binding type definitions (empty disables)")
+	fs.BoolVar(&opts.hot, "hot", false, "keep the window open and hot-swap only the Go bindings process on changes")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: gomad dev [flags] [-- app args]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	opts.appArgs = fs.Args()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// 1. Frontend dev sunucusu
+	if info, err := os.Stat(opts.frontend); err == nil && info.IsDir() && opts.frontendCmd != "" {
+		server, err := startFrontend(ctx, opts)
+		if err != nil {
+			return err
+		}
+		defer stopProcess(server)
+
+		logger.Info("waiting for frontend dev server", "url", opts.url)
+		if err := waitForURL(ctx, opts.url, serverTimeout); err != nil {
+			return err
+		}
+	}
+
+	tmp, err := os.MkdirTemp("", "gomad-dev-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	r := &devRunner{opts: opts, binary: filepath.Join(tmp, "app"+exeSuffix())}
+
+	// 2. İlk derleme ve çalıştırma
+	if err := r.build(); err != nil {
+		return err
+	}
+
+	// -hot: pencere süreci (ui) ayrı, r yalnızca backend'dir
+	ui := r
+	if opts.hot {
+		shell, err := startShell(r, tmp)
+		if err != nil {
+			return err
+		}
+		defer shell.stop()
+		ui = shell
+	}
+	r.start()
+	defer r.stop()
+
+	// 3. Go kaynaklarını izle
+	ignore := []string{".git", "node_modules", "dist", "bin", filepath.Base(opts.frontend)}
+	rebuild := make(chan struct{}, 1)
+	cancelGo, err := fswatch.Watch(".", fswatch.Options{Recursive: true, Ignore: ignore}, func(changes []fswatch.Change) {
+		for _, c := range changes {
+			if isGoSource(c.Path) {
+				select {
+				case rebuild <- struct{}{}:
+				default:
+				}
+				return
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+	defer cancelGo()
+
+	// 4. WebView yenileme tetikleyicileri
+	if opts.reloadDir != "" {
+		cancelAssets, err := fswatch.Watch(opts.reloadDir, fswatch.Options{Recursive: true}, func([]fswatch.Change) {
+			ui.send("reload")
+		})
+		if err != nil {
+			return err
+		}
+		defer cancelAssets()
+	}
+	restartUI := make(chan struct{}, 1)
+	go readKeys(ui, rebuild, restartUI)
+
+	if opts.hot {
+		logger.Info("watching for changes (r = reload webview, b = rebuild backend, w = restart window, Ctrl+C = exit)")
+	} else {
+		logger.Info("watching for changes (r = reload webview, b = rebuild, Ctrl+C = exit)")
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-rebuild:
+			logger.Info("go sources changed, rebuilding")
+			if err := r.build(); err != nil {
+				logger.Error("build failed; keeping the running app", "error", err)
+				continue
+			}
+			r.stop()
+			r.start()
+			if opts.hot {
+				logger.Info("backend swapped")
+			}
+		case <-restartUI:
+			if ui == r {
+				continue
+			}
+			if err := copyFile(r.binary, ui.binary, 0o755); err != nil {
+				logger.Error("failed to update window binary", "error", err)
+				continue
+			}
+			// Backend, shell kapanınca kendiliğinden çıkar; yenisi yeni shell'e bağlanır
+			ui.stop()
+			r.stop()
+			ui.start()
+			r.start()
+		}
+	}
+}
+
+// startShell, -hot modunda pencere sürecini backend'in ilk derlemesinin
+// bir kopyasıyla başlatır (backend yeniden derlenirken dosya kilitli kalmasın).
+// Backend, shell'in dinlediği adrese bağlanır.
+func startShell(backend *devRunner, tmp string) (*devRunner, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	shell := &devRunner{
+		opts:   backend.opts,
+		binary: filepath.Join(tmp, "shell"+exeSuffix()),
+		env:    []string{"GOMAD_HOT_SHELL=" + addr},
+	}
+	if err := copyFile(backend.binary, shell.binary, 0o755); err != nil {
+		return nil, err
+	}
+	backend.env = []string{"GOMAD_HOT_BACKEND=" + addr}
+	shell.start()
+	return shell, nil
+}
+
+// devRunner, derlenen uygulama sürecini yönetir.
+type devRunner struct {
+	opts   devOptions
+	binary string
+	env    []string // Ek ortam değişkenleri (-hot: shell/backend rolü)
+
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+	exit  chan struct{}
+	mu    sync.Mutex
+}
+
+// build, uygulamayı geçici dizine derler. Derleyici çıktısı terminale gider.
+func (r *devRunner) build() error {
+	cmd := exec.Command("go", "build", "-o", r.binary, r.opts.app)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// start, derlenmiş uygulamayı dev ortam değişkenleriyle başlatır.
+func (r *devRunner) start() {
+	cmd := exec.Command(r.binary, r.opts.appArgs...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), "GOMAD_DEV_URL="+r.opts.url, "GOMAD_DEV_CONTROL=1")
+	cmd.Env = append(cmd.Env, r.env...)
+	if r.opts.typesAddr != "" {
+		cmd.Env = append(cmd.Env, "GOMAD_DEV_TYPES="+r.opts.typesAddr)
+	}
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		logger.Error("failed to start app", "error", err)
+		return
+	}
+	if err := cmd.Start(); err != nil {
+		logger.Error("failed to start app", "error", err)
+		return
+	}
+
+	exit := make(chan struct{})
+	go func() {
+		cmd.Wait()
+		close(exit)
+	}()
+
+	r.mu.Lock()
+	r.cmd, r.stdin, r.exit = cmd, stdin, exit
+	r.mu.Unlock()
+	logger.Info("app started", "pid", cmd.Process.Pid)
+}
+
+// stop, uygulamadan kapanmasını ister; süre aşılırsa süreci sonlandırır.
+func (r *devRunner) stop() {
+	r.mu.Lock()
+	cmd, stdin, exit := r.cmd, r.stdin, r.exit
+	r.cmd, r.stdin, r.exit = nil, nil, nil
+	r.mu.Unlock()
+	if cmd == nil {
+		return
+	}
+
+	fmt.Fprintln(stdin, "quit")
+	select {
+	case <-exit:
+	case <-time.After(quitTimeout):
+		cmd.Process.Kill()
+		<-exit
+	}
+	stdin.Close()
+}
+
+// send, çalışan uygulamaya bir dev komutu gönderir.
+func (r *devRunner) send(command string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stdin != nil {
+		fmt.Fprintln(r.stdin, command)
+	}
+}
+
+// readKeys, terminal kısayollarını okur.
+func readKeys(r *devRunner, rebuild, restartUI chan<- struct{}) {
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		switch strings.TrimSpace(scanner.Text()) {
+		case "r":
+			r.send("reload")
+		case "b":
+			select {
+			case rebuild <- struct{}{}:
+			default:
+			}
+		case "w":
+			select {
+			case restartUI <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// startFrontend, frontend dev sunucusunu başlatır.
+func startFrontend(ctx context.Context, opts devOptions) (*exec.Cmd, error) {
+	fields := strings.Fields(opts.frontendCmd)
+	cmd := exec.CommandContext(ctx, fields[0], fields[1:]...)
+	cmd.Dir = opts.frontend
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start frontend dev server: %w", err)
+	}
+	logger.Info("frontend dev server started", "cmd", opts.frontendCmd, "dir", opts.frontend)
+	return cmd, nil
+}
+
+// stopProcess, bir yardımcı süreci sonlandırır.
+func stopProcess(cmd *exec.Cmd) {
+	if cmd.Process != nil {
+		cmd.Process.Kill()
+		cmd.Wait()
+	}
+}
+
+// waitForURL, adres HTTP yanıtı verene kadar bekler.
+func waitForURL(ctx context.Context, url string, timeout time.Duration) error {
+	client := &http.Client{Timeout: 2 * time.Second}
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if resp, err := client.Get(url); err == nil {
+			resp.Body.Close()
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+	return errors.New("frontend dev server did not respond at " + url)
+}
+
+// isGoSource, değişikliğin yeniden derleme gerektirip gerektirmediğini döner.
+func isGoSource(path string) bool {
+	base := filepath.Base(path)
+	return strings.HasSuffix(base, ".go") || base == "go.mod" || base == "go.sum"
+}
+
+func exeSuffix() string {
+	if runtime.GOOS == "windows" {
+		return ".exe"
+	}
+	return ""
+}
@@ -0,0 +1,87 @@
+// Command gomad, GOMAD uygulamalarının geliştirme ve derleme aracıdır.
+//
+// Kullanım:
+//
+//	gomad dev      Frontend dev sunucusu + Go uygulaması, değişiklikte yeniden başlatma
+//	gomad build    Frontend + hedef başına dağıtılabilir uygulama (exe, .app)
+//	gomad bundle   Frontend çıktısını gömmek için gzip ile sıkıştırma
+//	gomad package  gomad.yaml'a göre kurulum paketleri (NSIS/MSIX, dmg, deb/AppImage)
+//	gomad replay   Köprü kaydını (GOMAD_RECORD) uygulamaya geri verip farkları raporlama
+//	gomad types    Binding'lerden @gomad/client tanım dosyası (.d.ts) üretme
+//	gomad proto    .proto sözleşmesinden Go binding iskeleti ve .d.ts üretme
+//
+// Her komutun ayarları için: gomad <komut> -h
+//
+// Kurulum:
+//
+//	go install github.com/biyonik/gomad/cmd/gomad@latest
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+)
+
+// command, bir CLI alt komutudur.
+type command struct {
+	name  string
+	usage string
+	run   func(args []string) error
+}
+
+// commands, desteklenen alt komutlardır.
+var commands = []command{
+	{name: "dev", usage: "run the app against the frontend dev server with hot reload", run: runDev},
+	{name: "build", usage: "build the frontend and a distributable app per target OS/arch", run: runBuild},
+	{name: "bundle", usage: "compress the frontend build output for embedding with gomad.WithAssets", run: runBundle},
+	{name: "package", usage: "build and create installers (nsis, msix, dmg, deb, appimage) from gomad.yaml", run: runPackage},
+	{name: "replay", usage: "replay a recorded bridge session against the app and report differences", run: runReplay},
+	{name: "types", usage: "generate @gomad/client TypeScript definitions from the app's bindings", run: runTypes},
+	{name: "proto", usage: "generate Go binding stubs and TypeScript definitions from a .proto contract", run: runProto},
+}
+
+// logger, CLI çıktısıdır; kullanıcıya yönelik mesajlar için sade metin formatı.
+var logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+	ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+		if a.Key == slog.TimeKey && len(groups) == 0 {
+			return slog.Attr{}
+		}
+		return a
+	},
+}))
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] == "-h" || os.Args[1] == "help" {
+		usage()
+		os.Exit(2)
+	}
+
+	for _, cmd := range commands {
+		if cmd.name == os.Args[1] {
+			if err := cmd.run(os.Args[2:]); err != nil {
+				logger.Error(cmd.name+" failed", "error", err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "gomad: unknown command %q\n\n", os.Args[1])
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage: gomad <command> [flags]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", cmd.name, cmd.usage)
+	}
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// manifestFile, proje kökündeki GOMAD manifestinin adıdır.
+const manifestFile = "gomad.yaml"
+
+// Manifest, gomad.yaml içeriğidir. "gomad build" ve "gomad package" ayarlarını
+// tek yerde toplar; komut satırı bayrakları manifestteki değerleri geçersiz kılar.
+//
+//	name: Notes
+//	appId: com.example.notes
+//	version: 1.4.0
+//	publisher: Example Ltd
+//	description: Markdown notes
+//	app: ./cmd/notes
+//	frontend:
+//	  dir: frontend
+//	  build: npm run build
+//	  dist: frontend/dist/browser
+//	  bundle: frontend/bundle
+//	icons:
+//	  windows: build/icon.ico
+//	  macos: build/icon.icns
+//	  png: build/icon.png
+//	fileAssociations:
+//	  - ext: note
+//	    name: Notes Document
+//	    mimeType: application/x-notes
+//	protocols:
+//	  - scheme: notes
+//	    name: Notes Link
+//	windows:
+//	  installer: [nsis, msix]
+//	  certificate: build/cert.pfx
+//	macos:
+//	  signIdentity: "Developer ID Application: Example Ltd (ABCDE12345)"
+//	linux:
+//	  formats: [deb, appimage]
+//	  categories: Office
+type Manifest struct {
+	Name        string `json:"name"`
+	AppID       string `json:"appId"`
+	Version     string `json:"version"`
+	Publisher   string `json:"publisher"`
+	Description string `json:"description"`
+	App         string `json:"app"`
+
+	Frontend struct {
+		Dir    string `json:"dir"`
+		Build  string `json:"build"`
+		Dev    string `json:"dev"`
+		URL    string `json:"url"`
+		Dist   string `json:"dist"`   // Derleme çıktısı
+		Bundle string `json:"bundle"` // Sıkıştırılmış kopya (bkz. gomad bundle)
+	} `json:"frontend"`
+
+	Icons struct {
+		Windows string `json:"windows"` // .ico
+		MacOS   string `json:"macos"`   // .icns
+		PNG     string `json:"png"`     // 256x256+ PNG (Linux, MSIX)
+	} `json:"icons"`
+
+	FileAssociations []FileAssociation `json:"fileAssociations"`
+	Protocols        []Protocol        `json:"protocols"`
+
+	Windows struct {
+		Installer           []string `json:"installer"` // "nsis", "msix"
+		Certificate         string   `json:"certificate"`
+		CertificatePassword string   `json:"certificatePassword"` // Boşsa GOMAD_CERT_PASSWORD
+	} `json:"windows"`
+
+	MacOS struct {
+		SignIdentity string `json:"signIdentity"`
+		Entitlements string `json:"entitlements"`
+	} `json:"macos"`
+
+	Linux struct {
+		Formats    []string `json:"formats"` // "deb", "appimage"
+		Categories string   `json:"categories"`
+		Maintainer string   `json:"maintainer"`
+		Depends    []string `json:"depends"`
+	} `json:"linux"`
+}
+
+// FileAssociation, uygulamanın açtığı bir dosya türüdür.
+type FileAssociation struct {
+	Ext         string `json:"ext"` // Noktasız uzantı
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	MimeType    string `json:"mimeType"`
+	Role        string `json:"role"` // macOS: "Editor" (varsayılan) | "Viewer"
+}
+
+// Protocol, uygulamanın işlediği bir URL şemasıdır (ör. notes://open/42).
+type Protocol struct {
+	Scheme string `json:"scheme"`
+	Name   string `json:"name"`
+}
+
+// loadManifest, gomad.yaml dosyasını okur. Dosya yoksa boş manifest döner.
+func loadManifest(path string) (*Manifest, error) {
+	m := &Manifest{}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := parseYAML(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	encoded, err := json.Marshal(tree)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(encoded))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(m); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return m, nil
+}
+
+// buildOptions, manifestten derleme ayarlarını üretir; bayraklar bunların
+// üzerine yazılır.
+func (m *Manifest) buildOptions() buildOptions {
+	return buildOptions{
+		app:              m.App,
+		name:             m.Name,
+		appID:            m.AppID,
+		version:          m.Version,
+		iconWindows:      m.Icons.Windows,
+		iconMacOS:        m.Icons.MacOS,
+		frontend:         m.Frontend.Dir,
+		frontendCmd:      m.Frontend.Build,
+		dist:             m.Frontend.Dist,
+		bundle:           m.Frontend.Bundle,
+		fileAssociations: m.FileAssociations,
+		protocols:        m.Protocols,
+	}
+}
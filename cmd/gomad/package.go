@@ -0,0 +1,191 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/biyonik/gomad/pkg/update"
+)
+
+// ============================================================================
+// gomad package
+// "gomad build" çıktısından kurulum paketleri üretir. Biçimler, ikonlar,
+// dosya ilişkilendirmeleri ve URL şemaları gomad.yaml'dan okunur.
+//
+//	Windows → nsis (makensis), msix (makeappx, signtool)
+//	macOS   → dmg  (codesign, hdiutil)
+//	Linux   → deb  (dpkg-deb), appimage (appimagetool)
+//
+// Her biçim kendi aracını PATH'te bekler; araç yoksa hata, kurulum
+// ipucuyla birlikte döner. Paketler <out>/ altına yazılır.
+//
+// -delta-from ile önceki sürümün <out> dizini verilirse her hedef için
+// çalıştırılabilirin delta yaması da (<name>-<version>-<target>.patch) üretilir.
+// ============================================================================
+
+// packager, bir kurulum biçimini üreten fonksiyondur.
+type packager func(p *packageContext, b buildResult) (string, error)
+
+// packagers, hedef işletim sistemine göre desteklenen biçimlerdir.
+var packagers = map[string]map[string]packager{
+	"windows": {"nsis": packageNSIS, "msix": packageMSIX},
+	"darwin":  {"dmg": packageDMG},
+	"linux":   {"deb": packageDeb, "appimage": packageAppImage},
+}
+
+// defaultFormats, manifestte biçim belirtilmemişse kullanılanlardır.
+var defaultFormats = map[string][]string{
+	"windows": {"nsis"},
+	"darwin":  {"dmg"},
+	"linux":   {"deb", "appimage"},
+}
+
+// packageContext, paketleyicilerin ortak girdisidir.
+type packageContext struct {
+	manifest *Manifest
+	opts     buildOptions
+}
+
+func runPackage(args []string) error {
+	m, err := loadManifest(manifestFile)
+	if err != nil {
+		return err
+	}
+	opts := m.buildOptions()
+	fs := flag.NewFlagSet("package", flag.ExitOnError)
+	opts.register(fs)
+	formats := fs.String("format", "", "comma-separated formats (default: from gomad.yaml, or nsis/dmg/deb+appimage)")
+	deltaFrom := fs.String("delta-from", "", "previous release's output directory; writes binary delta patches against it")
+	fs.Parse(args)
+
+	results, err := opts.build()
+	if err != nil {
+		return err
+	}
+
+	ctx := &packageContext{manifest: m, opts: opts}
+	for _, b := range results {
+		for _, format := range ctx.formats(b.target.goos, *formats) {
+			pack, ok := packagers[b.target.goos][format]
+			if !ok {
+				return fmt.Errorf("format %q is not available for %s", format, b.target.goos)
+			}
+			logger.Info("packaging", "target", b.target, "format", format)
+			out, err := pack(ctx, b)
+			if err != nil {
+				return fmt.Errorf("%s %s: %w", b.target, format, err)
+			}
+			logger.Info("packaged", "path", out)
+		}
+		if *deltaFrom != "" {
+			if err := ctx.writeDelta(b, *deltaFrom); err != nil {
+				return fmt.Errorf("%s delta: %w", b.target, err)
+			}
+		}
+	}
+	return nil
+}
+
+// writeDelta, önceki sürümün aynı hedefteki çalıştırılabiliri ile yenisi
+// arasındaki yamayı yazar (bkz. pkg/update). İmzalama paketleyicilerde
+// yapıldığından yama, kullanıcıdaki imzalı dosyaya göre üretilir.
+func (p *packageContext) writeDelta(b buildResult, previous string) error {
+	exe := b.path
+	if b.target.goos == "darwin" {
+		exe = filepath.Join(b.path, "Contents", "MacOS", p.opts.name)
+	}
+	rel, err := filepath.Rel(b.dir, exe)
+	if err != nil {
+		return err
+	}
+	old := filepath.Join(previous, b.target.String(), rel)
+	if _, err := os.Stat(old); err != nil {
+		logger.Warn("no previous build for target, skipping delta", "path", old)
+		return nil
+	}
+
+	out := p.artifactName(b, "patch")
+	if err := update.DiffFile(old, exe, out); err != nil {
+		return err
+	}
+	logger.Info("delta", "from", old, "path", out)
+	return nil
+}
+
+// formats, hedef için üretilecek biçimleri döner: bayrak > manifest > varsayılan.
+func (p *packageContext) formats(goos, flagValue string) []string {
+	var list []string
+	switch {
+	case flagValue != "":
+		for _, f := range strings.Split(flagValue, ",") {
+			// Bayrak tüm hedeflere uygulanır; hedefe ait olmayanlar atlanır
+			if _, ok := packagers[goos][strings.TrimSpace(f)]; ok {
+				list = append(list, strings.TrimSpace(f))
+			}
+		}
+		return list
+	case goos == "windows" && len(p.manifest.Windows.Installer) > 0:
+		return p.manifest.Windows.Installer
+	case goos == "linux" && len(p.manifest.Linux.Formats) > 0:
+		return p.manifest.Linux.Formats
+	}
+	return defaultFormats[goos]
+}
+
+// artifactName, paket dosyasının adını üretir: <name>-<version>-<goos>-<goarch>.<ext>
+func (p *packageContext) artifactName(b buildResult, ext string) string {
+	return filepath.Join(p.opts.out, fmt.Sprintf("%s-%s-%s.%s", p.opts.name, p.opts.version, b.target, ext))
+}
+
+// requireTool, aracın PATH'te olduğunu doğrular.
+func requireTool(name, hint string) (string, error) {
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return "", fmt.Errorf("%s not found in PATH (%s)", name, hint)
+	}
+	return path, nil
+}
+
+// run, aracı çalıştırır; çıktı terminale gider.
+func run(dir, name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// runEnv, aracı ek ortam değişkenleriyle çalıştırır.
+func runEnv(env []string, name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// copyFile, dosyayı kopyalar ve izinleri ayarlar.
+func copyFile(src, dst string, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
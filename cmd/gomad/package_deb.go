@@ -0,0 +1,175 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// debArch ve appImageArch, GOARCH'ın paket araçlarındaki karşılıklarıdır.
+var (
+	debArch      = map[string]string{"amd64": "amd64", "386": "i386", "arm64": "arm64", "arm": "armhf"}
+	appImageArch = map[string]string{"amd64": "x86_64", "386": "i686", "arm64": "aarch64", "arm": "armhf"}
+)
+
+// packageDeb, /usr/bin altına ikili, .desktop girdisi, hicolor ikonu ve
+// dosya türleri için shared-mime-info tanımı içeren bir .deb üretir.
+func packageDeb(p *packageContext, b buildResult) (string, error) {
+	dpkgDeb, err := requireTool("dpkg-deb", "install dpkg")
+	if err != nil {
+		return "", err
+	}
+	arch, ok := debArch[b.target.goarch]
+	if !ok {
+		return "", fmt.Errorf("deb: unsupported architecture %s", b.target.goarch)
+	}
+
+	root := filepath.Join(b.dir, "deb")
+	if err := os.RemoveAll(root); err != nil {
+		return "", err
+	}
+	bin := p.binName()
+	if err := copyFile(b.path, filepath.Join(root, "usr", "bin", bin), 0o755); err != nil {
+		return "", err
+	}
+	if err := p.writeDesktopFiles(filepath.Join(root, "usr", "share"), "/usr/bin/"+bin); err != nil {
+		return "", err
+	}
+
+	depends := append([]string{"libgtk-3-0", "libwebkit2gtk-4.1-0 | libwebkit2gtk-4.0-37"}, p.manifest.Linux.Depends...)
+	control := fmt.Sprintf(`Package: %s
+Version: %s
+Architecture: %s
+Maintainer: %s
+Depends: %s
+Section: misc
+Priority: optional
+Description: %s
+`, bin, strings.TrimPrefix(p.opts.version, "v"), arch,
+		or(p.manifest.Linux.Maintainer, or(p.manifest.Publisher, "unknown")),
+		strings.Join(depends, ", "), or(p.manifest.Description, p.opts.name))
+	if err := os.MkdirAll(filepath.Join(root, "DEBIAN"), 0o755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filepath.Join(root, "DEBIAN", "control"), []byte(control), 0o644); err != nil {
+		return "", err
+	}
+	// Masaüstü ve MIME veritabanlarını kurulum/kaldırma sonrası güncelle
+	hook := "#!/bin/sh\nset -e\nupdate-desktop-database -q || true\nupdate-mime-database /usr/share/mime || true\n"
+	for _, name := range []string{"postinst", "postrm"} {
+		if err := os.WriteFile(filepath.Join(root, "DEBIAN", name), []byte(hook), 0o755); err != nil {
+			return "", err
+		}
+	}
+
+	out := p.artifactName(b, "deb")
+	if err := run("", dpkgDeb, "--build", "--root-owner-group", root, out); err != nil {
+		return "", err
+	}
+	return out, nil
+}
+
+// packageAppImage, AppDir yapısını kurup appimagetool ile tek dosyalık bir
+// AppImage üretir. Dosya ilişkilendirmeleri AppImage'i masaüstüne entegre eden
+// araçlar (ör. appimaged) tarafından .desktop girdisinden okunur.
+func packageAppImage(p *packageContext, b buildResult) (string, error) {
+	tool, err := requireTool("appimagetool", "download from https://github.com/AppImage/appimagetool")
+	if err != nil {
+		return "", err
+	}
+	arch, ok := appImageArch[b.target.goarch]
+	if !ok {
+		return "", fmt.Errorf("appimage: unsupported architecture %s", b.target.goarch)
+	}
+
+	appDir := filepath.Join(b.dir, p.opts.name+".AppDir")
+	if err := os.RemoveAll(appDir); err != nil {
+		return "", err
+	}
+	bin := p.binName()
+	if err := copyFile(b.path, filepath.Join(appDir, "usr", "bin", bin), 0o755); err != nil {
+		return "", err
+	}
+	if err := p.writeDesktopFiles(filepath.Join(appDir, "usr", "share"), bin); err != nil {
+		return "", err
+	}
+	// appimagetool, .desktop ve ikonu AppDir kökünde bekler
+	desktop := filepath.Join(appDir, "usr", "share", "applications", p.opts.appID+".desktop")
+	if err := copyFile(desktop, filepath.Join(appDir, p.opts.appID+".desktop"), 0o644); err != nil {
+		return "", err
+	}
+	if p.manifest.Icons.PNG != "" {
+		if err := copyFile(p.manifest.Icons.PNG, filepath.Join(appDir, p.opts.appID+".png"), 0o644); err != nil {
+			return "", err
+		}
+	}
+	appRun := "#!/bin/sh\nHERE=\"$(dirname \"$(readlink -f \"$0\")\")\"\nexec \"$HERE/usr/bin/" + bin + "\" \"$@\"\n"
+	if err := os.WriteFile(filepath.Join(appDir, "AppRun"), []byte(appRun), 0o755); err != nil {
+		return "", err
+	}
+
+	out := p.artifactName(b, "AppImage")
+	cmd := []string{"--no-appstream", appDir, out}
+	if err := runEnv([]string{"ARCH=" + arch}, tool, cmd...); err != nil {
+		return "", err
+	}
+	return out, nil
+}
+
+// binName, Linux paketlerinde kullanılan küçük harfli, boşluksuz ikili adıdır.
+func (p *packageContext) binName() string {
+	return strings.ToLower(strings.ReplaceAll(p.opts.name, " ", "-"))
+}
+
+// writeDesktopFiles, share/ altına .desktop girdisini, ikonu ve dosya
+// ilişkilendirmeleri için MIME tanımını yazar. URL şemaları
+// x-scheme-handler/<şema> MIME türüyle kaydedilir.
+func (p *packageContext) writeDesktopFiles(share, exec string) error {
+	var mimeTypes []string
+	var mimeXML strings.Builder
+	for _, fa := range p.manifest.FileAssociations {
+		mime := or(fa.MimeType, "application/x-"+fa.Ext)
+		mimeTypes = append(mimeTypes, mime)
+		fmt.Fprintf(&mimeXML, "  <mime-type type=\"%s\">\n    <comment>%s</comment>\n    <glob pattern=\"*.%s\"/>\n  </mime-type>\n",
+			html.EscapeString(mime), html.EscapeString(or(fa.Description, or(fa.Name, fa.Ext))), html.EscapeString(fa.Ext))
+	}
+	for _, proto := range p.manifest.Protocols {
+		mimeTypes = append(mimeTypes, "x-scheme-handler/"+proto.Scheme)
+	}
+
+	icon := p.opts.appID
+	if p.manifest.Icons.PNG != "" {
+		dst := filepath.Join(share, "icons", "hicolor", "256x256", "apps", icon+".png")
+		if err := copyFile(p.manifest.Icons.PNG, dst, 0o644); err != nil {
+			return err
+		}
+	}
+
+	desktop := "[Desktop Entry]\nType=Application\nName=" + p.opts.name +
+		"\nComment=" + or(p.manifest.Description, p.opts.name) +
+		"\nExec=" + exec + " %U\nIcon=" + icon +
+		"\nCategories=" + strings.TrimSuffix(or(p.manifest.Linux.Categories, "Utility"), ";") + ";\nTerminal=false\n"
+	if len(mimeTypes) > 0 {
+		desktop += "MimeType=" + strings.Join(mimeTypes, ";") + ";\n"
+	}
+	dir := filepath.Join(share, "applications")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, p.opts.appID+".desktop"), []byte(desktop), 0o644); err != nil {
+		return err
+	}
+
+	if mimeXML.Len() == 0 {
+		return nil
+	}
+	dir = filepath.Join(share, "mime", "packages")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	xml := "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<mime-info xmlns=\"http://www.freedesktop.org/standards/shared-mime-info\">\n" +
+		mimeXML.String() + "</mime-info>\n"
+	return os.WriteFile(filepath.Join(dir, p.opts.appID+".xml"), []byte(xml), 0o644)
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// packageDMG, .app paketini (manifestte kimlik varsa) hardened runtime ile
+// imzalar ve "Applications" kısayolu içeren sıkıştırılmış bir disk imajına
+// koyar. Notarization (xcrun notarytool) imzalı imaj üzerinde ayrıca yapılır.
+func packageDMG(p *packageContext, b buildResult) (string, error) {
+	hdiutil, err := requireTool("hdiutil", "dmg images can only be created on macOS")
+	if err != nil {
+		return "", err
+	}
+
+	if identity := p.manifest.MacOS.SignIdentity; identity != "" {
+		codesign, err := requireTool("codesign", "install the Xcode command line tools")
+		if err != nil {
+			return "", err
+		}
+		args := []string{"--force", "--deep", "--options", "runtime", "--timestamp", "--sign", identity}
+		if p.manifest.MacOS.Entitlements != "" {
+			args = append(args, "--entitlements", p.manifest.MacOS.Entitlements)
+		}
+		if err := run("", codesign, append(args, b.path)...); err != nil {
+			return "", err
+		}
+	}
+
+	staging := filepath.Join(b.dir, "dmg")
+	if err := os.RemoveAll(staging); err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(staging, 0o755); err != nil {
+		return "", err
+	}
+	// ditto, .app içindeki symlink ve imza özniteliklerini korur
+	if err := run("", "ditto", b.path, filepath.Join(staging, filepath.Base(b.path))); err != nil {
+		return "", err
+	}
+	if err := os.Symlink("/Applications", filepath.Join(staging, "Applications")); err != nil {
+		return "", err
+	}
+
+	out := p.artifactName(b, "dmg")
+	os.Remove(out)
+	if err := run("", hdiutil, "create", "-volname", p.opts.name, "-srcfolder", staging, "-ov", "-format", "UDZO", out); err != nil {
+		return "", err
+	}
+	return out, nil
+}
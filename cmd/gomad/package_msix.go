@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// packageMSIX, MSIX paketi üretir ve sertifika verilmişse imzalar. Paket
+// yayıncısı (Publisher) imzalayan sertifikanın konusu ile aynı olmalıdır;
+// manifestteki publisher "CN=..." ile başlamıyorsa başına eklenir.
+func packageMSIX(p *packageContext, b buildResult) (string, error) {
+	makeappx, err := requireTool("makeappx", "install the Windows SDK")
+	if err != nil {
+		return "", err
+	}
+	if p.manifest.Icons.PNG == "" {
+		return "", fmt.Errorf("msix requires icons.png in %s", manifestFile)
+	}
+
+	layout := filepath.Join(b.dir, "msix")
+	if err := os.RemoveAll(layout); err != nil {
+		return "", err
+	}
+	exe := filepath.Base(b.path)
+	if err := copyFile(b.path, filepath.Join(layout, exe), 0o755); err != nil {
+		return "", err
+	}
+	for _, logo := range []string{"Square44x44Logo.png", "Square150x150Logo.png", "StoreLogo.png"} {
+		if err := copyFile(p.manifest.Icons.PNG, filepath.Join(layout, "Assets", logo), 0o644); err != nil {
+			return "", err
+		}
+	}
+
+	esc := html.EscapeString
+	publisher := or(p.manifest.Publisher, p.opts.name)
+	if !strings.HasPrefix(publisher, "CN=") {
+		publisher = "CN=" + publisher
+	}
+	// MSIX sürümünün dördüncü parçası Store için 0 olmalıdır
+	parts := strings.Split(winVersion(p.opts.version), ".")
+	parts[3] = "0"
+
+	var extensions strings.Builder
+	for _, fa := range p.manifest.FileAssociations {
+		fmt.Fprintf(&extensions, `
+        <uap:Extension Category="windows.fileTypeAssociation">
+          <uap:FileTypeAssociation Name="%s">
+            <uap:DisplayName>%s</uap:DisplayName>
+            <uap:SupportedFileTypes><uap:FileType>.%s</uap:FileType></uap:SupportedFileTypes>
+          </uap:FileTypeAssociation>
+        </uap:Extension>`, esc(strings.ToLower(fa.Ext)), esc(or(fa.Name, fa.Ext)), esc(fa.Ext))
+	}
+	for _, proto := range p.manifest.Protocols {
+		fmt.Fprintf(&extensions, `
+        <uap:Extension Category="windows.protocol">
+          <uap:Protocol Name="%s"><uap:DisplayName>%s</uap:DisplayName></uap:Protocol>
+        </uap:Extension>`, esc(proto.Scheme), esc(or(proto.Name, proto.Scheme)))
+	}
+	var extensionsXML string
+	if extensions.Len() > 0 {
+		extensionsXML = "\n      <Extensions>" + extensions.String() + "\n      </Extensions>"
+	}
+
+	arch := map[string]string{"amd64": "x64", "386": "x86", "arm64": "arm64"}[b.target.goarch]
+	manifest := `<?xml version="1.0" encoding="utf-8"?>
+<Package xmlns="http://schemas.microsoft.com/appx/manifest/foundation/windows10"
+         xmlns:uap="http://schemas.microsoft.com/appx/manifest/uap/windows10"
+         xmlns:rescap="http://schemas.microsoft.com/appx/manifest/foundation/windows10/restrictedcapabilities"
+         IgnorableNamespaces="uap rescap">
+  <Identity Name="` + esc(p.opts.appID) + `" Publisher="` + esc(publisher) + `" Version="` + strings.Join(parts, ".") + `" ProcessorArchitecture="` + arch + `"/>
+  <Properties>
+    <DisplayName>` + esc(p.opts.name) + `</DisplayName>
+    <PublisherDisplayName>` + esc(or(p.manifest.Publisher, p.opts.name)) + `</PublisherDisplayName>
+    <Logo>Assets\StoreLogo.png</Logo>
+  </Properties>
+  <Dependencies>
+    <TargetDeviceFamily Name="Windows.Desktop" MinVersion="10.0.17763.0" MaxVersionTested="10.0.22621.0"/>
+  </Dependencies>
+  <Resources><Resource Language="en-us"/></Resources>
+  <Applications>
+    <Application Id="App" Executable="` + esc(exe) + `" EntryPoint="Windows.FullTrustApplication">
+      <uap:VisualElements DisplayName="` + esc(p.opts.name) + `" Description="` + esc(or(p.manifest.Description, p.opts.name)) + `"
+          BackgroundColor="transparent" Square150x150Logo="Assets\Square150x150Logo.png" Square44x44Logo="Assets\Square44x44Logo.png"/>` + extensionsXML + `
+    </Application>
+  </Applications>
+  <Capabilities><rescap:Capability Name="runFullTrust"/></Capabilities>
+</Package>
+`
+	if err := os.WriteFile(filepath.Join(layout, "AppxManifest.xml"), []byte(manifest), 0o644); err != nil {
+		return "", err
+	}
+
+	out := p.artifactName(b, "msix")
+	if err := run("", makeappx, "pack", "/o", "/d", layout, "/p", out); err != nil {
+		return "", err
+	}
+	if err := signWindows(p, out); err != nil {
+		return "", err
+	}
+	return out, nil
+}
+
+// signWindows, manifestte sertifika varsa dosyayı signtool ile imzalar.
+// Parola verilmemişse GOMAD_CERT_PASSWORD ortam değişkeni kullanılır.
+func signWindows(p *packageContext, path string) error {
+	cert := p.manifest.Windows.Certificate
+	if cert == "" {
+		return nil
+	}
+	signtool, err := requireTool("signtool", "install the Windows SDK")
+	if err != nil {
+		return err
+	}
+	password := or(p.manifest.Windows.CertificatePassword, os.Getenv("GOMAD_CERT_PASSWORD"))
+	args := []string{"sign", "/fd", "SHA256", "/tr", "http://timestamp.digicert.com", "/td", "SHA256", "/f", cert}
+	if password != "" {
+		args = append(args, "/p", password)
+	}
+	return run("", signtool, append(args, path)...)
+}
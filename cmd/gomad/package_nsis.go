@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// packageNSIS, kullanıcı başına (yönetici izni istemeyen) bir NSIS kurulum
+// sihirbazı üretir. Kurulum; Başlat menüsü kısayolu, Programlar ve Özellikler
+// kaydı, dosya ilişkilendirmeleri ve URL şemalarını HKCU altına yazar.
+func packageNSIS(p *packageContext, b buildResult) (string, error) {
+	makensis, err := requireTool("makensis", "install NSIS: https://nsis.sourceforge.io")
+	if err != nil {
+		return "", err
+	}
+
+	out, err := filepath.Abs(p.artifactName(b, "setup.exe"))
+	if err != nil {
+		return "", err
+	}
+	exe := filepath.Base(b.path)
+
+	var icon string
+	if p.opts.iconWindows != "" {
+		abs, err := filepath.Abs(p.opts.iconWindows)
+		if err != nil {
+			return "", err
+		}
+		icon = fmt.Sprintf("!define MUI_ICON %q\n!define MUI_UNICON %q\n", abs, abs)
+	}
+
+	name := p.opts.name
+	uninstallKey := `Software\Microsoft\Windows\CurrentVersion\Uninstall\` + p.opts.appID
+
+	var register, unregister strings.Builder
+	for _, fa := range p.manifest.FileAssociations {
+		progID := p.opts.appID + "." + fa.Ext
+		fmt.Fprintf(&register, "  WriteRegStr HKCU \"Software\\Classes\\.%s\" \"\" \"%s\"\n", fa.Ext, progID)
+		fmt.Fprintf(&register, "  WriteRegStr HKCU \"Software\\Classes\\%s\" \"\" \"%s\"\n", progID, nsisEscape(or(fa.Name, fa.Ext)))
+		fmt.Fprintf(&register, "  WriteRegStr HKCU \"Software\\Classes\\%s\\DefaultIcon\" \"\" \"$INSTDIR\\%s,0\"\n", progID, exe)
+		fmt.Fprintf(&register, "  WriteRegStr HKCU \"Software\\Classes\\%s\\shell\\open\\command\" \"\" '\"$INSTDIR\\%s\" \"%%1\"'\n", progID, exe)
+		fmt.Fprintf(&unregister, "  DeleteRegKey HKCU \"Software\\Classes\\%s\"\n", progID)
+		fmt.Fprintf(&unregister, "  DeleteRegValue HKCU \"Software\\Classes\\.%s\" \"\"\n", fa.Ext)
+	}
+	for _, proto := range p.manifest.Protocols {
+		key := `Software\Classes\` + proto.Scheme
+		fmt.Fprintf(&register, "  WriteRegStr HKCU \"%s\" \"\" \"URL:%s\"\n", key, nsisEscape(or(proto.Name, proto.Scheme)))
+		fmt.Fprintf(&register, "  WriteRegStr HKCU \"%s\" \"URL Protocol\" \"\"\n", key)
+		fmt.Fprintf(&register, "  WriteRegStr HKCU \"%s\\shell\\open\\command\" \"\" '\"$INSTDIR\\%s\" \"%%1\"'\n", key, exe)
+		fmt.Fprintf(&unregister, "  DeleteRegKey HKCU \"%s\"\n", key)
+	}
+	if register.Len() > 0 {
+		// Explorer'a ilişkilendirmelerin değiştiğini bildir (SHCNE_ASSOCCHANGED)
+		register.WriteString("  System::Call 'shell32::SHChangeNotify(i 0x08000000, i 0, p 0, p 0)'\n")
+	}
+
+	script := `Unicode true
+!include "MUI2.nsh"
+` + icon + `
+Name "` + nsisEscape(name) + `"
+OutFile "` + out + `"
+InstallDir "$LOCALAPPDATA\Programs\` + nsisEscape(name) + `"
+RequestExecutionLevel user
+SetCompressor /SOLID lzma
+
+!insertmacro MUI_PAGE_DIRECTORY
+!insertmacro MUI_PAGE_INSTFILES
+!define MUI_FINISHPAGE_RUN "$INSTDIR\` + exe + `"
+!insertmacro MUI_PAGE_FINISH
+!insertmacro MUI_UNPAGE_CONFIRM
+!insertmacro MUI_UNPAGE_INSTFILES
+!insertmacro MUI_LANGUAGE "English"
+
+Section "Install"
+  SetOutPath "$INSTDIR"
+  File "` + b.path + `"
+  WriteUninstaller "$INSTDIR\uninstall.exe"
+  CreateShortCut "$SMPROGRAMS\` + nsisEscape(name) + `.lnk" "$INSTDIR\` + exe + `"
+  WriteRegStr HKCU "` + uninstallKey + `" "DisplayName" "` + nsisEscape(name) + `"
+  WriteRegStr HKCU "` + uninstallKey + `" "DisplayVersion" "` + nsisEscape(p.opts.version) + `"
+  WriteRegStr HKCU "` + uninstallKey + `" "Publisher" "` + nsisEscape(p.manifest.Publisher) + `"
+  WriteRegStr HKCU "` + uninstallKey + `" "DisplayIcon" "$INSTDIR\` + exe + `"
+  WriteRegStr HKCU "` + uninstallKey + `" "UninstallString" '"$INSTDIR\uninstall.exe"'
+  WriteRegDWORD HKCU "` + uninstallKey + `" "NoModify" 1
+  WriteRegDWORD HKCU "` + uninstallKey + `" "NoRepair" 1
+` + register.String() + `SectionEnd
+
+Section "Uninstall"
+  Delete "$INSTDIR\` + exe + `"
+  Delete "$INSTDIR\uninstall.exe"
+  RMDir "$INSTDIR"
+  Delete "$SMPROGRAMS\` + nsisEscape(name) + `.lnk"
+  DeleteRegKey HKCU "` + uninstallKey + `"
+` + unregister.String() + `SectionEnd
+`
+
+	nsi := filepath.Join(b.dir, "installer.nsi")
+	if err := os.WriteFile(nsi, []byte(script), 0o644); err != nil {
+		return "", err
+	}
+	if err := run("", makensis, "-V2", nsi); err != nil {
+		return "", err
+	}
+	if err := signWindows(p, out); err != nil {
+		return "", err
+	}
+	return out, nil
+}
+
+// nsisEscape, NSIS string'i içindeki çift tırnak ve değişken işaretlerini kaçırır.
+func nsisEscape(s string) string {
+	return strings.NewReplacer(`"`, `$\"`, `$`, `$$`).Replace(s)
+}
@@ -0,0 +1,660 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ============================================================================
+// gomad proto
+// Köprü API'sini protobuf ile tanımlayan ekipler için aynı .proto'dan Go
+// binding iskeleti ve @gomad/client tanımları üretir:
+//
+//	gomad proto -go internal/api/notes.gomad.go -ts frontend/src/notes.d.ts api/notes.proto
+//
+// Go çıktısı message'lar için struct'lar, enum'lar için sabitler, her
+// service için bir <Service>Server arayüzü ve metodları
+// "<package>.<Service>.<Method>" adıyla bağlayan Register<Service> içerir:
+//
+//	api.RegisterNotes(app, &notesServer{})
+//	// JS: await gomad.call("notes.v1.Notes.Create", { title: "a" })
+//
+// Varsayılan taşıma protobuf'ın JSON eşlemesidir (lowerCamel alan adları,
+// 64 bit tamsayılar string). -binary ile message'lara MarshalProto /
+// UnmarshalProto metodları ve Register<Service>Binary eklenir; bu bağlamalar
+// base64 kodlu ikili protobuf alır ve döner, frontend kendi protobuf
+// kütüphanesiyle (ör. protobuf-es) kodlar.
+//
+// google.protobuf.Empty girdisi argümansız, çıktısı dönüşsüz metoda
+// karşılık gelir. Desteklenen proto alt kümesi için bkz. proto_parse.go.
+// ============================================================================
+
+func runProto(args []string) error {
+	fs := flag.NewFlagSet("proto", flag.ExitOnError)
+	goOut := fs.String("go", "", "output Go file (default: <proto>.gomad.go next to the .proto)")
+	tsOut := fs.String("ts", "", "output TypeScript definitions file (default: frontend/src/<proto>.d.ts; \"-\" to skip)")
+	pkg := fs.String("package", "", "Go package name (default: from go_package or the output directory)")
+	binary := fs.Bool("binary", false, "carry binary protobuf (base64) payloads: adds MarshalProto/UnmarshalProto and Register<Service>Binary")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: gomad proto [flags] <file.proto>")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("expected one .proto file")
+	}
+
+	src := fs.Arg(0)
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	file, err := parseProto(string(data))
+	if err != nil {
+		return fmt.Errorf("%s: %w", src, err)
+	}
+
+	base := strings.TrimSuffix(filepath.Base(src), filepath.Ext(src))
+	if *goOut == "" {
+		*goOut = filepath.Join(filepath.Dir(src), base+".gomad.go")
+	}
+	if *tsOut == "" {
+		*tsOut = filepath.Join("frontend", "src", base+".d.ts")
+	}
+	if *pkg == "" {
+		*pkg = protoGoPackage(file.goPackage, *goOut)
+	}
+
+	g := &protoGen{file: file, source: filepath.Base(src), binary: *binary}
+	code, err := g.goSource(*pkg)
+	if err != nil {
+		return err
+	}
+	if err := writeGenerated(*goOut, code); err != nil {
+		return err
+	}
+	logger.Info("go bindings written", "file", *goOut)
+
+	if *tsOut != "-" {
+		if err := writeGenerated(*tsOut, []byte(g.tsSource())); err != nil {
+			return err
+		}
+		logger.Info("definitions written", "file", *tsOut)
+	}
+	return nil
+}
+
+// writeGenerated, üretilen dosyayı gerekirse dizinini oluşturarak yazar.
+func writeGenerated(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// protoGoPackage, Go paket adını go_package seçeneğinden ("path;name" ya da
+// "path") ya da çıktı dizininin adından çıkarır.
+func protoGoPackage(goPackage, goOut string) string {
+	name := ""
+	if path, pkg, ok := strings.Cut(goPackage, ";"); ok {
+		name = pkg
+	} else if path != "" {
+		name = filepath.Base(path)
+	}
+	if name == "" {
+		if abs, err := filepath.Abs(goOut); err == nil {
+			name = filepath.Base(filepath.Dir(abs))
+		}
+	}
+	name = strings.Map(func(r rune) rune {
+		if isProtoIdent(r) {
+			return r
+		}
+		return -1
+	}, strings.ToLower(name))
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "api"
+	}
+	return name
+}
+
+// protoGen, çözümlenmiş dosyadan kod üretir.
+type protoGen struct {
+	file   *protoFile
+	source string
+	binary bool
+}
+
+// protoScalar, skaler tipin Go, TS ve wire karşılıklarıdır.
+type protoScalar struct {
+	goType string
+	wire   string // "Varint", "Fixed32", "Fixed64" ya da "" (uzunluk önekli)
+	toRaw  string // Go değerinden wire değerine, %s = değer
+	conv   string // Wire değerinden Go değerine, %s = okunan değer
+	wide   bool   // 64 bit tamsayı: JSON'da string
+}
+
+var protoScalarTypes = map[string]protoScalar{
+	"double":   {"float64", "Fixed64", "math.Float64bits(%s)", "math.Float64frombits(%s)", false},
+	"float":    {"float32", "Fixed32", "math.Float32bits(%s)", "math.Float32frombits(%s)", false},
+	"int32":    {"int32", "Varint", "uint64(int64(%s))", "int32(%s)", false},
+	"int64":    {"int64", "Varint", "uint64(%s)", "int64(%s)", true},
+	"uint32":   {"uint32", "Varint", "uint64(%s)", "uint32(%s)", false},
+	"uint64":   {"uint64", "Varint", "%s", "%s", true},
+	"sint32":   {"int32", "Varint", "protowire.ZigZag32(%s)", "protowire.UnZigZag32(%s)", false},
+	"sint64":   {"int64", "Varint", "protowire.ZigZag64(%s)", "protowire.UnZigZag64(%s)", true},
+	"fixed32":  {"uint32", "Fixed32", "%s", "%s", false},
+	"fixed64":  {"uint64", "Fixed64", "%s", "%s", true},
+	"sfixed32": {"int32", "Fixed32", "uint32(%s)", "int32(%s)", false},
+	"sfixed64": {"int64", "Fixed64", "uint64(%s)", "int64(%s)", true},
+	"bool":     {"bool", "Varint", "protowire.EncodeBool(%s)", "%s != 0", false},
+	"string":   {"string", "", "", "", false},
+	"bytes":    {"[]byte", "", "", "", false},
+}
+
+// scalar, tipin skaler bilgisini döner; enum'lar int32 varint'tir.
+func (g *protoGen) scalar(typ string) (protoScalar, bool) {
+	if s, ok := protoScalarTypes[typ]; ok {
+		return s, true
+	}
+	if g.isEnum(typ) {
+		return protoScalar{typ, "Varint", "uint64(int64(%s))", typ + "(%s)", false}, true
+	}
+	return protoScalar{}, false
+}
+
+func (g *protoGen) isEnum(typ string) bool {
+	for _, e := range g.file.enums {
+		if e.name == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// bindingName, metodun JS'teki adıdır.
+func (g *protoGen) bindingName(svc *protoService, m protoMethod) string {
+	return joinProtoName(joinProtoName(g.file.pkg, svc.name), m.name)
+}
+
+// ----------------------------------------------------------------------------
+// Go
+// ----------------------------------------------------------------------------
+
+func (g *protoGen) goSource(pkg string) ([]byte, error) {
+	var body strings.Builder
+	for _, e := range g.file.enums {
+		g.goEnum(&body, e)
+	}
+	for _, m := range g.file.messages {
+		g.goMessage(&body, m)
+		if g.binary {
+			g.goMarshal(&body, m)
+			g.goUnmarshal(&body, m)
+		}
+	}
+	if len(g.file.services) > 0 {
+		body.WriteString("// Binder, bağlamaların kaydedildiği hedeftir: *gomad.Application ya da *gomad.Window.\n")
+		body.WriteString("type Binder interface {\n\tBind(name string, fn interface{}) error\n}\n\n")
+	}
+	for _, svc := range g.file.services {
+		g.goService(&body, svc)
+	}
+
+	code := body.String()
+	var std, mod []string
+	for _, imp := range []struct{ path, use string }{
+		{"fmt", "fmt."},
+		{"math", "math."},
+		{"strconv", "strconv."},
+		{"github.com/biyonik/gomad/pkg/gomad", "gomad."},
+		{"github.com/biyonik/gomad/pkg/protowire", "protowire."},
+	} {
+		if !strings.Contains(code, imp.use) {
+			continue
+		}
+		if strings.Contains(imp.path, ".") {
+			mod = append(mod, strconv.Quote(imp.path))
+		} else {
+			std = append(std, strconv.Quote(imp.path))
+		}
+	}
+	imports := strings.Join(std, "\n\t")
+	if len(std) > 0 && len(mod) > 0 {
+		imports += "\n\n\t"
+	}
+	imports += strings.Join(mod, "\n\t")
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "// Code generated by gomad proto from %s; DO NOT EDIT.\n\n", g.source)
+	fmt.Fprintf(&out, "package %s\n\n", pkg)
+	if imports != "" {
+		fmt.Fprintf(&out, "import (\n\t%s\n)\n\n", imports)
+	}
+	out.WriteString(code)
+
+	formatted, err := format.Source([]byte(out.String()))
+	if err != nil {
+		return nil, fmt.Errorf("generated code does not compile: %w", err)
+	}
+	return formatted, nil
+}
+
+// goDoc, proto yorumunu Go yorumu olarak yazar.
+func goDoc(sb *strings.Builder, doc, indent string) {
+	if doc == "" {
+		return
+	}
+	for _, line := range strings.Split(doc, "\n") {
+		fmt.Fprintf(sb, "%s// %s\n", indent, line)
+	}
+}
+
+func (g *protoGen) goEnum(sb *strings.Builder, e *protoEnum) {
+	goDoc(sb, e.doc, "")
+	fmt.Fprintf(sb, "type %s int32\n\nconst (\n", e.name)
+	for _, v := range e.values {
+		goDoc(sb, v.doc, "\t")
+		fmt.Fprintf(sb, "\t%s_%s %s = %d\n", e.name, v.name, e.name, v.number)
+	}
+	sb.WriteString(")\n\n")
+
+	// allow_alias: aynı numaranın ilk adı kullanılır
+	fmt.Fprintf(sb, "var %s_name = map[int32]string{\n", e.name)
+	seen := map[int]bool{}
+	for _, v := range e.values {
+		if !seen[v.number] {
+			seen[v.number] = true
+			fmt.Fprintf(sb, "\t%d: %q,\n", v.number, v.name)
+		}
+	}
+	fmt.Fprintf(sb, "}\n\nvar %s_value = map[string]int32{\n", e.name)
+	for _, v := range e.values {
+		fmt.Fprintf(sb, "\t%q: %d,\n", v.name, v.number)
+	}
+	sb.WriteString("}\n\n")
+
+	fmt.Fprintf(sb, `func (x %[1]s) String() string {
+	if name, ok := %[1]s_name[int32(x)]; ok {
+		return name
+	}
+	return strconv.Itoa(int(x))
+}
+
+// MarshalText, enum'u protobuf JSON eşlemesindeki gibi adıyla yazar.
+func (x %[1]s) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText, enum'u adından ya da numarasından çözer.
+func (x *%[1]s) UnmarshalText(b []byte) error {
+	if v, ok := %[1]s_value[string(b)]; ok {
+		*x = %[1]s(v)
+		return nil
+	}
+	n, err := strconv.ParseInt(string(b), 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid %[1]s %%q", b)
+	}
+	*x = %[1]s(n)
+	return nil
+}
+
+`, e.name)
+}
+
+// goFieldType, alanın Go tipidir.
+func (g *protoGen) goFieldType(f *protoField) string {
+	elem := func(typ string) string {
+		if s, ok := g.scalar(typ); ok {
+			return s.goType
+		}
+		return "*" + typ
+	}
+	switch {
+	case f.keyType != "":
+		return "map[" + elem(f.keyType) + "]" + elem(f.typ)
+	case f.repeated:
+		return "[]" + elem(f.typ)
+	case f.optional && f.typ != "bytes" && !strings.HasPrefix(elem(f.typ), "*"):
+		return "*" + elem(f.typ)
+	}
+	return elem(f.typ)
+}
+
+func (g *protoGen) goMessage(sb *strings.Builder, m *protoMessage) {
+	goDoc(sb, m.doc, "")
+	fmt.Fprintf(sb, "type %s struct {\n", m.name)
+	for _, f := range m.fields {
+		goDoc(sb, f.doc, "\t")
+		tag := f.jsonName + ",omitempty"
+		if s, ok := g.scalar(f.typ); ok && s.wide && !f.repeated && f.keyType == "" {
+			tag += ",string" // protobuf JSON eşlemesi: 64 bit tamsayılar string
+		}
+		fmt.Fprintf(sb, "\t%s %s `json:%q`\n", protoGoName(f.name), g.goFieldType(f), tag)
+	}
+	sb.WriteString("}\n\n")
+}
+
+func (g *protoGen) goService(sb *strings.Builder, svc *protoService) {
+	goDoc(sb, svc.doc, "")
+	fmt.Fprintf(sb, "type %sServer interface {\n", svc.name)
+	for _, m := range svc.methods {
+		goDoc(sb, m.doc, "\t")
+		fmt.Fprintf(sb, "\t%s%s\n", m.name, g.goSignature(m))
+	}
+	sb.WriteString("}\n\n")
+
+	fmt.Fprintf(sb, "// Register%s, %sServer'ın metodlarını JSON taşımayla bağlar.\n", svc.name, svc.name)
+	fmt.Fprintf(sb, "func Register%s(b Binder, srv %sServer) error {\n", svc.name, svc.name)
+	sb.WriteString("\tbindings := []struct {\n\t\tname string\n\t\tfn   interface{}\n\t}{\n")
+	for _, m := range svc.methods {
+		fn := "srv." + m.name
+		switch {
+		case m.output == protoEmpty:
+		case m.input == protoEmpty:
+			fn = "gomad.Func0(" + fn + ")"
+		default:
+			fn = "gomad.Func1(" + fn + ")"
+		}
+		fmt.Fprintf(sb, "\t\t{%q, %s},\n", g.bindingName(svc, m), fn)
+	}
+	sb.WriteString("\t}\n\treturn bindAll(b, bindings)\n}\n\n")
+
+	if g.binary {
+		g.goBinaryService(sb, svc)
+	}
+	if svc == g.file.services[0] {
+		sb.WriteString(`func bindAll(b Binder, bindings []struct {
+	name string
+	fn   interface{}
+}) error {
+	for _, binding := range bindings {
+		if err := b.Bind(binding.name, binding.fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+`)
+	}
+}
+
+// goSignature, rpc'nin Go metod imzasıdır.
+func (g *protoGen) goSignature(m protoMethod) string {
+	in := "(req *" + m.input + ")"
+	if m.input == protoEmpty {
+		in = "()"
+	}
+	if m.output == protoEmpty {
+		return in + " error"
+	}
+	return in + " (*" + m.output + ", error)"
+}
+
+func (g *protoGen) goBinaryService(sb *strings.Builder, svc *protoService) {
+	fmt.Fprintf(sb, "// Register%sBinary, %sServer'ın metodlarını ikili protobuf (base64) taşımayla bağlar.\n", svc.name, svc.name)
+	fmt.Fprintf(sb, "func Register%sBinary(b Binder, srv %sServer) error {\n", svc.name, svc.name)
+	sb.WriteString("\tbindings := []struct {\n\t\tname string\n\t\tfn   interface{}\n\t}{\n")
+	for _, m := range svc.methods {
+		fmt.Fprintf(sb, "\t\t{%q, ", g.bindingName(svc, m))
+		if m.input == protoEmpty {
+			sb.WriteString("gomad.Func0(func() ([]byte, error) {\n")
+		} else {
+			sb.WriteString("gomad.Func1(func(in []byte) ([]byte, error) {\n")
+			fmt.Fprintf(sb, "\t\t\treq := new(%s)\n", m.input)
+			sb.WriteString("\t\t\tif err := req.UnmarshalProto(in); err != nil {\n")
+			sb.WriteString("\t\t\t\treturn nil, fmt.Errorf(\"%w: %v\", gomad.ErrInvalidArgument, err)\n\t\t\t}\n")
+		}
+		call := "srv." + m.name + "(req)"
+		if m.input == protoEmpty {
+			call = "srv." + m.name + "()"
+		}
+		if m.output == protoEmpty {
+			fmt.Fprintf(sb, "\t\t\treturn []byte{}, %s\n", call)
+		} else {
+			fmt.Fprintf(sb, "\t\t\tresp, err := %s\n", call)
+			sb.WriteString("\t\t\tif err != nil {\n\t\t\t\treturn nil, err\n\t\t\t}\n")
+			sb.WriteString("\t\t\treturn resp.MarshalProto()\n")
+		}
+		sb.WriteString("\t\t})},\n")
+	}
+	sb.WriteString("\t}\n\treturn bindAll(b, bindings)\n}\n\n")
+}
+
+// goMarshal, message'ın ikili kodlama metodlarını yazar.
+func (g *protoGen) goMarshal(sb *strings.Builder, m *protoMessage) {
+	fmt.Fprintf(sb, "// MarshalProto, mesajı ikili protobuf olarak kodlar.\n")
+	fmt.Fprintf(sb, "func (m *%s) MarshalProto() ([]byte, error) {\n\treturn m.AppendProto([]byte{}), nil\n}\n\n", m.name)
+	fmt.Fprintf(sb, "// AppendProto, mesajın ikili kodlamasını b'ye ekler.\n")
+	fmt.Fprintf(sb, "func (m *%s) AppendProto(b []byte) []byte {\n\tif m == nil {\n\t\treturn b\n\t}\n", m.name)
+	for _, f := range m.fields {
+		g.goMarshalField(sb, f)
+	}
+	sb.WriteString("\treturn b\n}\n\n")
+}
+
+// appendValue, tek bir değerin alan olarak yazılmasıdır.
+func (g *protoGen) appendValue(buf string, num int, typ, value string) string {
+	if s, ok := g.scalar(typ); ok {
+		switch {
+		case typ == "string":
+			return fmt.Sprintf("%s = protowire.AppendString(%s, %d, %s)", buf, buf, num, value)
+		case typ == "bytes":
+			return fmt.Sprintf("%s = protowire.AppendBytes(%s, %d, %s)", buf, buf, num, value)
+		default:
+			return fmt.Sprintf("%s = protowire.Append%s(%s, %d, %s)", buf, s.wire, buf, num, fmt.Sprintf(s.toRaw, value))
+		}
+	}
+	return fmt.Sprintf("%s = protowire.AppendMessage(%s, %d, %s)", buf, buf, num, value)
+}
+
+func (g *protoGen) goMarshalField(sb *strings.Builder, f *protoField) {
+	field := "m." + protoGoName(f.name)
+	s, isScalar := g.scalar(f.typ)
+	switch {
+	case f.keyType != "":
+		fmt.Fprintf(sb, "\tfor k, v := range %s {\n\t\tvar e []byte\n", field)
+		fmt.Fprintf(sb, "\t\t%s\n", g.appendValue("e", 1, f.keyType, "k"))
+		if isScalar {
+			fmt.Fprintf(sb, "\t\t%s\n", g.appendValue("e", 2, f.typ, "v"))
+		} else {
+			fmt.Fprintf(sb, "\t\tif v != nil {\n\t\t\t%s\n\t\t}\n", g.appendValue("e", 2, f.typ, "v"))
+		}
+		fmt.Fprintf(sb, "\t\tb = protowire.AppendBytes(b, %d, e)\n\t}\n", f.number)
+	case f.repeated && isScalar && s.wire != "":
+		fmt.Fprintf(sb, "\tb = protowire.AppendPacked(b, %d, func(p []byte) []byte {\n", f.number)
+		fmt.Fprintf(sb, "\t\tfor _, v := range %s {\n\t\t\tp = protowire.AppendRaw%s(p, %s)\n\t\t}\n\t\treturn p\n\t})\n",
+			field, s.wire, fmt.Sprintf(s.toRaw, "v"))
+	case f.repeated:
+		fmt.Fprintf(sb, "\tfor _, v := range %s {\n\t\t%s\n\t}\n", field, g.appendValue("b", f.number, f.typ, "v"))
+	case !isScalar:
+		fmt.Fprintf(sb, "\tif %s != nil {\n\t\t%s\n\t}\n", field, g.appendValue("b", f.number, f.typ, field))
+	case f.optional && f.typ != "bytes":
+		fmt.Fprintf(sb, "\tif %s != nil {\n\t\t%s\n\t}\n", field, g.appendValue("b", f.number, f.typ, "*"+field))
+	default:
+		cond := field + " != 0"
+		switch f.typ {
+		case "bool":
+			cond = field
+		case "string":
+			cond = field + ` != ""`
+		case "bytes":
+			cond = "len(" + field + ") > 0"
+		}
+		if f.optional {
+			cond = field + " != nil" // optional bytes: nil yok demektir
+		}
+		fmt.Fprintf(sb, "\tif %s {\n\t\t%s\n\t}\n", cond, g.appendValue("b", f.number, f.typ, field))
+	}
+}
+
+// readValue, d'nin geçerli alanını typ olarak okuyan ifadedir.
+func (g *protoGen) readValue(d, typ string) string {
+	switch typ {
+	case "string":
+		return d + ".String()"
+	case "bytes":
+		return "append([]byte{}, " + d + ".Bytes()...)"
+	}
+	s, _ := g.scalar(typ)
+	return fmt.Sprintf(s.conv, d+"."+s.wire+"()")
+}
+
+func (g *protoGen) goUnmarshal(sb *strings.Builder, m *protoMessage) {
+	fmt.Fprintf(sb, "// UnmarshalProto, ikili protobuf'u mesaja çözer; bilinmeyen alanlar atlanır.\n")
+	fmt.Fprintf(sb, "func (m *%s) UnmarshalProto(b []byte) error {\n\t*m = %s{}\n", m.name, m.name)
+	sb.WriteString("\td := protowire.NewDecoder(b)\n\tfor d.Next() {\n\t\tswitch d.Field() {\n")
+	for _, f := range m.fields {
+		fmt.Fprintf(sb, "\t\tcase %d:\n", f.number)
+		g.goUnmarshalField(sb, f)
+	}
+	sb.WriteString("\t\t}\n\t}\n\treturn d.Err()\n}\n\n")
+}
+
+func (g *protoGen) goUnmarshalField(sb *strings.Builder, f *protoField) {
+	field := "m." + protoGoName(f.name)
+	s, isScalar := g.scalar(f.typ)
+	const in = "\t\t\t"
+	switch {
+	case f.keyType != "":
+		keyType := protoScalarTypes[f.keyType].goType
+		valueType := "*" + f.typ
+		if isScalar {
+			valueType = s.goType
+		}
+		fmt.Fprintf(sb, "%se := protowire.NewDecoder(d.Bytes())\n%svar k %s\n%svar v %s\n", in, in, keyType, in, valueType)
+		fmt.Fprintf(sb, "%sfor e.Next() {\n%s\tswitch e.Field() {\n%s\tcase 1:\n%s\t\tk = %s\n%s\tcase 2:\n",
+			in, in, in, in, g.readValue("e", f.keyType), in)
+		if isScalar {
+			fmt.Fprintf(sb, "%s\t\tv = %s\n", in, g.readValue("e", f.typ))
+		} else {
+			fmt.Fprintf(sb, "%s\t\tv = new(%s)\n%s\t\te.Fail(v.UnmarshalProto(e.Bytes()))\n", in, f.typ, in)
+		}
+		fmt.Fprintf(sb, "%s\t}\n%s}\n%sd.Fail(e.Err())\n", in, in, in)
+		fmt.Fprintf(sb, "%sif %s == nil {\n%s\t%s = make(%s)\n%s}\n%s%s[k] = v\n",
+			in, field, in, field, g.goFieldType(f), in, in, field)
+	case f.repeated && isScalar && s.wire != "":
+		raw := "uint64"
+		if s.wire == "Fixed32" {
+			raw = "uint32"
+		}
+		fmt.Fprintf(sb, "%sd.%ss(func(v %s) { %s = append(%s, %s) })\n", in, s.wire, raw, field, field, fmt.Sprintf(s.conv, "v"))
+	case f.repeated && isScalar:
+		fmt.Fprintf(sb, "%s%s = append(%s, %s)\n", in, field, field, g.readValue("d", f.typ))
+	case f.repeated:
+		fmt.Fprintf(sb, "%sv := new(%s)\n%sd.Fail(v.UnmarshalProto(d.Bytes()))\n%s%s = append(%s, v)\n", in, f.typ, in, in, field, field)
+	case !isScalar:
+		fmt.Fprintf(sb, "%s%s = new(%s)\n%sd.Fail(%s.UnmarshalProto(d.Bytes()))\n", in, field, f.typ, in, field)
+	case f.optional && f.typ != "bytes":
+		fmt.Fprintf(sb, "%sv := %s\n%s%s = &v\n", in, g.readValue("d", f.typ), in, field)
+	default:
+		fmt.Fprintf(sb, "%s%s = %s\n", in, field, g.readValue("d", f.typ))
+	}
+}
+
+// ----------------------------------------------------------------------------
+// TypeScript
+// ----------------------------------------------------------------------------
+
+func (g *protoGen) tsSource() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "// Code generated by gomad proto from %s; DO NOT EDIT.\n", g.source)
+	sb.WriteString("import '@gomad/client';\n\n")
+	sb.WriteString("declare module '@gomad/client' {\n")
+	if !g.binary {
+		for _, e := range g.file.enums {
+			tsDoc(&sb, e.doc, "  ")
+			names := make([]string, len(e.values))
+			for i, v := range e.values {
+				names[i] = "'" + v.name + "'"
+			}
+			fmt.Fprintf(&sb, "  export type %s = %s;\n\n", e.name, strings.Join(names, " | "))
+		}
+		for _, m := range g.file.messages {
+			tsDoc(&sb, m.doc, "  ")
+			fmt.Fprintf(&sb, "  export interface %s {\n", m.name)
+			for _, f := range m.fields {
+				tsDoc(&sb, f.doc, "    ")
+				fmt.Fprintf(&sb, "    %s?: %s;\n", tsProtoKey(f.jsonName), g.tsFieldType(f))
+			}
+			sb.WriteString("  }\n\n")
+		}
+	}
+
+	var methods []string
+	for _, svc := range g.file.services {
+		for _, m := range svc.methods {
+			in, out := "arg0: "+m.input, m.output
+			if g.binary {
+				in, out = "arg0: string", "string" // base64 ikili protobuf
+			}
+			if m.input == protoEmpty {
+				in = ""
+			}
+			if m.output == protoEmpty && !g.binary {
+				out = "void"
+			}
+			methods = append(methods, fmt.Sprintf("%s(%s): %s;", strconv.Quote(g.bindingName(svc, m)), in, out))
+		}
+	}
+	sort.Strings(methods)
+	sb.WriteString("  interface GomadBindings {\n")
+	for _, m := range methods {
+		sb.WriteString("    " + m + "\n")
+	}
+	sb.WriteString("  }\n}\n")
+	return sb.String()
+}
+
+// tsFieldType, alanın JSON eşlemesindeki TS tipidir.
+func (g *protoGen) tsFieldType(f *protoField) string {
+	elem := func(typ string) string {
+		switch typ {
+		case "bool":
+			return "boolean"
+		case "string", "bytes": // bytes: base64
+			return "string"
+		}
+		if s, ok := protoScalarTypes[typ]; ok {
+			if s.wide && !f.repeated && f.keyType == "" {
+				return "string"
+			}
+			return "number"
+		}
+		return typ
+	}
+	switch {
+	case f.keyType != "":
+		return "Record<string, " + elem(f.typ) + ">"
+	case f.repeated:
+		return elem(f.typ) + "[]"
+	}
+	return elem(f.typ)
+}
+
+// tsDoc, proto yorumunu JSDoc olarak yazar.
+func tsDoc(sb *strings.Builder, doc, indent string) {
+	if doc == "" {
+		return
+	}
+	fmt.Fprintf(sb, "%s/** %s */\n", indent, strings.ReplaceAll(strings.ReplaceAll(doc, "*/", "* /"), "\n", " "))
+}
+
+// tsProtoKey, geçerli bir TS tanımlayıcısı değilse adı tırnaklar.
+func tsProtoKey(name string) string {
+	for i, r := range name {
+		if !(r == '_' || r == '$' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (i > 0 && r >= '0' && r <= '9')) {
+			return strconv.Quote(name)
+		}
+	}
+	return name
+}
@@ -0,0 +1,746 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ============================================================================
+// PROTO ALT KÜMESİ
+// Köprü sözleşmelerini üretmek için harici bağımlılık eklemeden proto3
+// dosyalarının ihtiyaç duyulan alt kümesi çözümlenir:
+//   - syntax, package, option go_package
+//   - message (iç içe message/enum dahil), enum, service/rpc
+//   - skaler tipler, repeated, optional, map<K, V>, oneof (üyeler ayrı
+//     opsiyonel alanlar olarak), [json_name = "..."] seçeneği
+//   - import yalnızca "google/protobuf/empty.proto"
+//
+// Diğer import'lar, stream rpc'ler, extend ve proto2 desteklenmez.
+// Bildirimlerden hemen önceki // yorumları üretilen koda taşınır.
+// ============================================================================
+
+// protoFile, çözümlenmiş bir .proto dosyasıdır.
+type protoFile struct {
+	pkg       string
+	goPackage string
+	messages  []*protoMessage
+	enums     []*protoEnum
+	services  []*protoService
+}
+
+// protoMessage, bir message'dır; iç içe olanlar Outer_Inner adını alır.
+type protoMessage struct {
+	name   string
+	doc    string
+	fields []*protoField
+}
+
+// protoField, message alanıdır.
+type protoField struct {
+	name     string
+	number   int
+	typ      string // Skaler tip ya da çözülmüş message/enum adı
+	keyType  string // map<K, V> için K; değilse ""
+	repeated bool
+	optional bool
+	jsonName string
+	doc      string
+}
+
+// protoEnum, bir enum'dur.
+type protoEnum struct {
+	name   string
+	doc    string
+	values []protoEnumValue
+}
+
+type protoEnumValue struct {
+	name   string
+	number int
+	doc    string
+}
+
+// protoService, bir service'tir.
+type protoService struct {
+	name    string
+	doc     string
+	methods []protoMethod
+}
+
+type protoMethod struct {
+	name   string
+	input  string
+	output string
+	doc    string
+}
+
+// protoEmpty, google.protobuf.Empty'nin çözülmüş adıdır.
+const protoEmpty = "google.protobuf.Empty"
+
+// protoScalars, desteklenen skaler tiplerdir.
+var protoScalars = map[string]bool{
+	"double": true, "float": true, "int32": true, "int64": true, "uint32": true, "uint64": true,
+	"sint32": true, "sint64": true, "fixed32": true, "fixed64": true, "sfixed32": true, "sfixed64": true,
+	"bool": true, "string": true, "bytes": true,
+}
+
+// protoToken, bir sözcük birimidir.
+type protoToken struct {
+	text string
+	line int
+	doc  string // Hemen önceki satır yorumları
+	str  bool   // Tırnaklı string
+}
+
+// protoParser, token'lar üzerinde ilerleyen özyinelemeli çözümleyicidir.
+type protoParser struct {
+	toks []protoToken
+	pos  int
+	file *protoFile
+
+	// Tip adlarının çözülmesi için: tam ad (Outer.Inner) → Go adı (Outer_Inner)
+	types    map[string]string
+	pending  []pendingType
+	hasEmpty bool
+}
+
+// pendingType, tüm dosya okunduktan sonra çözülecek bir tip referansıdır.
+type pendingType struct {
+	scope string // Referansın bulunduğu message'ın tam adı
+	ref   *string
+	where string // Hata mesajı için konum, ör. "line 12"
+}
+
+// parseProto, proto3 alt kümesini çözümler.
+func parseProto(src string) (*protoFile, error) {
+	toks, err := tokenizeProto(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &protoParser{toks: toks, file: &protoFile{}, types: map[string]string{}}
+	if err := p.parseFile(); err != nil {
+		return nil, err
+	}
+	if err := p.resolve(); err != nil {
+		return nil, err
+	}
+	return p.file, nil
+}
+
+// tokenizeProto, kaynağı token'lara böler ve satır yorumlarını izleyen
+// token'a bağlar.
+func tokenizeProto(src string) ([]protoToken, error) {
+	var toks []protoToken
+	var doc []string
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case strings.HasPrefix(src[i:], "//"):
+			end := strings.IndexByte(src[i:], '\n')
+			if end < 0 {
+				end = len(src) - i
+			}
+			// Satır sonu yorumu önceki bildirime aittir
+			if len(toks) == 0 || toks[len(toks)-1].line != line {
+				doc = append(doc, strings.TrimSpace(src[i+2:i+end]))
+			}
+			i += end
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			}
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(src) && src[j] != c {
+				if src[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(src) {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+			s, err := strconv.Unquote(`"` + strings.ReplaceAll(src[i+1:j], `"`, `\"`) + `"`)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid string: %v", line, err)
+			}
+			toks = append(toks, protoToken{text: s, line: line, str: true})
+			i = j + 1
+		case strings.ContainsRune("{}()<>;=,[]", rune(c)):
+			toks = append(toks, protoToken{text: string(c), line: line, doc: strings.Join(doc, "\n")})
+			doc = nil
+			i++
+		default:
+			j := i
+			for j < len(src) && (isProtoIdent(rune(src[j])) || src[j] == '.' || src[j] == '-' || src[j] == '+') {
+				j++
+			}
+			if j == i {
+				return nil, fmt.Errorf("line %d: unexpected character %q", line, c)
+			}
+			toks = append(toks, protoToken{text: src[i:j], line: line, doc: strings.Join(doc, "\n")})
+			doc = nil
+			i = j
+		}
+		// Boş satır yorumu bildirimden ayırır
+		if c == '\n' && i < len(src) && src[i] == '\n' {
+			doc = nil
+		}
+	}
+	return toks, nil
+}
+
+func isProtoIdent(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// ----------------------------------------------------------------------------
+// Token yardımcıları
+// ----------------------------------------------------------------------------
+
+func (p *protoParser) peek() protoToken {
+	if p.pos >= len(p.toks) {
+		return protoToken{line: p.lastLine()}
+	}
+	return p.toks[p.pos]
+}
+
+func (p *protoParser) next() protoToken {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *protoParser) lastLine() int {
+	if len(p.toks) == 0 {
+		return 1
+	}
+	return p.toks[len(p.toks)-1].line
+}
+
+func (p *protoParser) expect(text string) error {
+	if t := p.next(); t.text != text || t.str {
+		return fmt.Errorf("line %d: expected %q, got %q", t.line, text, t.text)
+	}
+	return nil
+}
+
+func (p *protoParser) ident() (protoToken, error) {
+	t := p.next()
+	if t.text == "" || t.str || !isProtoIdent(rune(t.text[0])) && t.text[0] != '.' {
+		return t, fmt.Errorf("line %d: expected identifier, got %q", t.line, t.text)
+	}
+	return t, nil
+}
+
+func (p *protoParser) number() (int, error) {
+	t := p.next()
+	n, err := strconv.ParseInt(t.text, 0, 32)
+	if err != nil || t.str {
+		return 0, fmt.Errorf("line %d: expected number, got %q", t.line, t.text)
+	}
+	return int(n), nil
+}
+
+// skipStatement, ';' ya da dengeli bir '{...}' bloğuna kadar atlar.
+func (p *protoParser) skipStatement() {
+	depth := 0
+	for p.pos < len(p.toks) {
+		t := p.next()
+		switch {
+		case t.str:
+		case t.text == "{":
+			depth++
+		case t.text == "}":
+			depth--
+			if depth <= 0 {
+				return
+			}
+		case t.text == ";" && depth == 0:
+			return
+		}
+	}
+}
+
+// skipOptions, "[...]" seçenek listesini atlar.
+func (p *protoParser) skipOptions() {
+	for p.pos < len(p.toks) {
+		if t := p.next(); t.text == "]" && !t.str {
+			return
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Bildirimler
+// ----------------------------------------------------------------------------
+
+func (p *protoParser) parseFile() error {
+	for p.pos < len(p.toks) {
+		t := p.next()
+		switch t.text {
+		case "syntax":
+			if err := p.expect("="); err != nil {
+				return err
+			}
+			if s := p.next(); s.text != "proto3" {
+				return fmt.Errorf("line %d: only proto3 is supported, got %q", s.line, s.text)
+			}
+			if err := p.expect(";"); err != nil {
+				return err
+			}
+		case "package":
+			name, err := p.ident()
+			if err != nil {
+				return err
+			}
+			p.file.pkg = name.text
+			if err := p.expect(";"); err != nil {
+				return err
+			}
+		case "import":
+			path := p.next()
+			if path.text == "public" || path.text == "weak" {
+				path = p.next()
+			}
+			if path.text != "google/protobuf/empty.proto" {
+				return fmt.Errorf("line %d: import %q is not supported (only google/protobuf/empty.proto)", path.line, path.text)
+			}
+			p.hasEmpty = true
+			if err := p.expect(";"); err != nil {
+				return err
+			}
+		case "option":
+			if p.peek().text != "go_package" {
+				p.skipStatement() // Diğer dosya seçenekleri üretimi etkilemez
+				continue
+			}
+			p.next()
+			if err := p.expect("="); err != nil {
+				return err
+			}
+			p.file.goPackage = p.next().text
+			if err := p.expect(";"); err != nil {
+				return err
+			}
+		case "message":
+			if err := p.parseMessage(t.doc, "", ""); err != nil {
+				return err
+			}
+		case "enum":
+			if err := p.parseEnum(t.doc, "", ""); err != nil {
+				return err
+			}
+		case "service":
+			if err := p.parseService(t.doc); err != nil {
+				return err
+			}
+		case ";":
+		default:
+			return fmt.Errorf("line %d: unsupported declaration %q", t.line, t.text)
+		}
+	}
+	return nil
+}
+
+// parseMessage, "message" anahtar kelimesinden sonrasını çözümler. scope
+// dıştaki message'ın tam adı, goScope Go adıdır.
+func (p *protoParser) parseMessage(doc, scope, goScope string) error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	full, goName := joinProtoName(scope, name.text), joinGoName(goScope, name.text)
+	if _, dup := p.types[full]; dup {
+		return fmt.Errorf("line %d: %s is already defined", name.line, full)
+	}
+	p.types[full] = goName
+	msg := &protoMessage{name: goName, doc: doc}
+	p.file.messages = append(p.file.messages, msg)
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	return p.parseMessageBody(msg, full, goName, false)
+}
+
+// parseMessageBody, '{' sonrasından eşleşen '}'ye kadar alanları okur.
+// oneof gövdesi de bununla okunur; üyeleri opsiyoneldir.
+func (p *protoParser) parseMessageBody(msg *protoMessage, full, goName string, oneof bool) error {
+	for {
+		t := p.peek()
+		switch t.text {
+		case "":
+			return fmt.Errorf("line %d: unexpected end of file in message %s", t.line, full)
+		case "}":
+			p.next()
+			return nil
+		case ";":
+			p.next()
+		case "message":
+			p.next()
+			if err := p.parseMessage(t.doc, full, goName); err != nil {
+				return err
+			}
+		case "enum":
+			p.next()
+			if err := p.parseEnum(t.doc, full, goName); err != nil {
+				return err
+			}
+		case "option", "reserved", "extensions":
+			p.skipStatement()
+		case "oneof":
+			p.next()
+			if _, err := p.ident(); err != nil {
+				return err
+			}
+			if err := p.expect("{"); err != nil {
+				return err
+			}
+			if err := p.parseMessageBody(msg, full, goName, true); err != nil {
+				return err
+			}
+		case "extend", "group", "required":
+			return fmt.Errorf("line %d: %q is not supported", t.line, t.text)
+		default:
+			f, err := p.parseField(full)
+			if err != nil {
+				return err
+			}
+			f.optional = f.optional || oneof
+			msg.fields = append(msg.fields, f)
+		}
+	}
+}
+
+// parseField, "[repeated|optional] type name = N [opts];" ya da
+// "map<K, V> name = N;" satırını okur.
+func (p *protoParser) parseField(scope string) (*protoField, error) {
+	first := p.peek()
+	f := &protoField{doc: first.doc}
+	switch first.text {
+	case "repeated":
+		f.repeated = true
+		p.next()
+	case "optional":
+		f.optional = true
+		p.next()
+	}
+
+	typ, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	if typ.text == "map" && p.peek().text == "<" {
+		p.next()
+		key, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		if !protoScalars[key.text] || key.text == "double" || key.text == "float" || key.text == "bytes" {
+			return nil, fmt.Errorf("line %d: invalid map key type %q", key.line, key.text)
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+		value, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(">"); err != nil {
+			return nil, err
+		}
+		f.keyType, f.typ = key.text, value.text
+	} else {
+		f.typ = typ.text
+	}
+
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	f.name = name.text
+	if err := p.expect("="); err != nil {
+		return nil, err
+	}
+	if f.number, err = p.number(); err != nil {
+		return nil, err
+	}
+	if f.number < 1 || f.number > 1<<29-1 {
+		return nil, fmt.Errorf("line %d: field number %d out of range", name.line, f.number)
+	}
+	if p.peek().text == "[" {
+		if err := p.parseFieldOptions(f); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.expect(";"); err != nil {
+		return nil, err
+	}
+	if f.jsonName == "" {
+		f.jsonName = protoJSONName(f.name)
+	}
+	if !protoScalars[f.typ] {
+		p.pending = append(p.pending, pendingType{scope: scope, ref: &f.typ, where: fmt.Sprintf("line %d", name.line)})
+	}
+	return f, nil
+}
+
+// parseFieldOptions, "[json_name = "x", deprecated = true]" okur.
+func (p *protoParser) parseFieldOptions(f *protoField) error {
+	p.next()
+	for {
+		name := p.next()
+		if err := p.expect("="); err != nil {
+			return err
+		}
+		value := p.next()
+		if name.text == "json_name" {
+			f.jsonName = value.text
+		}
+		switch sep := p.next(); sep.text {
+		case ",":
+		case "]":
+			return nil
+		default:
+			return fmt.Errorf("line %d: expected ',' or ']' in field options, got %q", sep.line, sep.text)
+		}
+	}
+}
+
+func (p *protoParser) parseEnum(doc, scope, goScope string) error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	full, goName := joinProtoName(scope, name.text), joinGoName(goScope, name.text)
+	if _, dup := p.types[full]; dup {
+		return fmt.Errorf("line %d: %s is already defined", name.line, full)
+	}
+	p.types[full] = goName
+	e := &protoEnum{name: goName, doc: doc}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for {
+		t := p.peek()
+		switch t.text {
+		case "":
+			return fmt.Errorf("line %d: unexpected end of file in enum %s", t.line, full)
+		case "}":
+			p.next()
+			if len(e.values) == 0 || e.values[0].number != 0 {
+				return fmt.Errorf("line %d: first value of enum %s must be 0", name.line, full)
+			}
+			p.file.enums = append(p.file.enums, e)
+			return nil
+		case ";":
+			p.next()
+		case "option", "reserved":
+			p.skipStatement()
+		default:
+			value, err := p.ident()
+			if err != nil {
+				return err
+			}
+			if err := p.expect("="); err != nil {
+				return err
+			}
+			n, err := p.number()
+			if err != nil {
+				return err
+			}
+			if p.peek().text == "[" {
+				p.skipOptions()
+			}
+			if err := p.expect(";"); err != nil {
+				return err
+			}
+			e.values = append(e.values, protoEnumValue{name: value.text, number: n, doc: value.doc})
+		}
+	}
+}
+
+func (p *protoParser) parseService(doc string) error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	svc := &protoService{name: name.text, doc: doc}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for {
+		t := p.next()
+		switch t.text {
+		case "":
+			return fmt.Errorf("line %d: unexpected end of file in service %s", t.line, name.text)
+		case "}":
+			p.file.services = append(p.file.services, svc)
+			return nil
+		case ";":
+		case "option":
+			p.skipStatement()
+		case "rpc":
+			m := protoMethod{doc: t.doc}
+			method, err := p.ident()
+			if err != nil {
+				return err
+			}
+			m.name = method.text
+			if m.input, err = p.rpcType(); err != nil {
+				return err
+			}
+			if ret := p.next(); ret.text != "returns" {
+				return fmt.Errorf("line %d: expected \"returns\", got %q", ret.line, ret.text)
+			}
+			if m.output, err = p.rpcType(); err != nil {
+				return err
+			}
+			if p.peek().text == "{" {
+				p.skipStatement()
+			} else if err := p.expect(";"); err != nil {
+				return err
+			}
+			svc.methods = append(svc.methods, m)
+		default:
+			return fmt.Errorf("line %d: unexpected %q in service %s", t.line, t.text, name.text)
+		}
+	}
+}
+
+// rpcType, "(Type)" okur; stream desteklenmez.
+func (p *protoParser) rpcType() (string, error) {
+	if err := p.expect("("); err != nil {
+		return "", err
+	}
+	t, err := p.ident()
+	if err != nil {
+		return "", err
+	}
+	if t.text == "stream" {
+		return "", fmt.Errorf("line %d: streaming rpcs are not supported", t.line)
+	}
+	if err := p.expect(")"); err != nil {
+		return "", err
+	}
+	return t.text, nil
+}
+
+// resolve, alan ve rpc tip referanslarını Go adlarına çevirir.
+func (p *protoParser) resolve() error {
+	for _, svc := range p.file.services {
+		for i := range svc.methods {
+			m := &svc.methods[i]
+			where := fmt.Sprintf("rpc %s.%s", svc.name, m.name)
+			p.pending = append(p.pending, pendingType{ref: &m.input, where: where}, pendingType{ref: &m.output, where: where})
+		}
+	}
+	for _, ref := range p.pending {
+		name, ok := p.lookup(ref.scope, *ref.ref)
+		if !ok {
+			return fmt.Errorf("%s: unknown type %q", ref.where, *ref.ref)
+		}
+		*ref.ref = name
+	}
+	for _, svc := range p.file.services {
+		for _, m := range svc.methods {
+			for _, typ := range []string{m.input, m.output} {
+				if typ != protoEmpty && p.enumNamed(typ) {
+					return fmt.Errorf("rpc %s.%s: %s is not a message", svc.name, m.name, typ)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// lookup, protobuf kapsam kurallarıyla (içten dışa) tip adını çözer.
+func (p *protoParser) lookup(scope, ref string) (string, bool) {
+	if ref == protoEmpty || ref == "."+protoEmpty {
+		return protoEmpty, p.hasEmpty
+	}
+	if strings.HasPrefix(ref, ".") {
+		ref = strings.TrimPrefix(strings.TrimPrefix(ref, "."+p.file.pkg), ".")
+		name, ok := p.types[ref]
+		return name, ok
+	}
+	if p.file.pkg != "" {
+		ref = strings.TrimPrefix(ref, p.file.pkg+".")
+	}
+	for {
+		if name, ok := p.types[joinProtoName(scope, ref)]; ok {
+			return name, true
+		}
+		if scope == "" {
+			return "", false
+		}
+		if i := strings.LastIndexByte(scope, '.'); i >= 0 {
+			scope = scope[:i]
+		} else {
+			scope = ""
+		}
+	}
+}
+
+func (p *protoParser) enumNamed(name string) bool {
+	for _, e := range p.file.enums {
+		if e.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func joinProtoName(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+func joinGoName(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "_" + name
+}
+
+// protoJSONName, protobuf'ın JSON adı kuralıdır: user_id → userId.
+func protoJSONName(name string) string {
+	var sb strings.Builder
+	upper := false
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// protoGoName, protoc-gen-go'nun alan adı kuralıdır: user_id → UserId.
+func protoGoName(name string) string {
+	s := protoJSONName(name)
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// ============================================================================
+// gomad replay
+// Bir hata raporuna eklenmiş köprü kaydını (GOMAD_RECORD veya
+// gomad.WithBridgeRecording ile alınmış) uygulamaya geri verir:
+// 1. Uygulamayı geçici bir dizine derler.
+// 2. GOMAD_REPLAY=<kayıt> ile (varsayılan olarak pencere açmadan,
+//    GOMAD_HEADLESS=1) başlatır; uygulama kayıttaki JS → Go mesajlarını
+//    sırayla kendi köprüsünden geçirir.
+// 3. Cevabı kayıttakinden farklı olan çağrılar yazdırılır ve komut hata ile
+//    çıkar; fark yoksa hata yeniden üretilememiş demektir.
+// ============================================================================
+
+func runReplay(args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	app := fs.String("app", ".", "Go main package of the application")
+	realtime := fs.Bool("realtime", false, "keep the recorded delays between messages")
+	window := fs.Bool("window", false, "open the real window instead of running headless")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: gomad replay [flags] <recording.jsonl> [-- app args]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() < 1 {
+		fs.Usage()
+		return errors.New("missing recording file")
+	}
+	recording, err := filepath.Abs(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(recording); err != nil {
+		return err
+	}
+
+	tmp, err := os.MkdirTemp("", "gomad-replay-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	binary := filepath.Join(tmp, "app"+exeSuffix())
+
+	logger.Info("building", "app", *app)
+	build := exec.Command("go", "build", "-o", binary, *app)
+	build.Stdout = os.Stdout
+	build.Stderr = os.Stderr
+	if err := build.Run(); err != nil {
+		return err
+	}
+
+	env := append(os.Environ(), "GOMAD_REPLAY="+recording)
+	if *realtime {
+		env = append(env, "GOMAD_REPLAY_REALTIME=1")
+	}
+	if !*window {
+		env = append(env, "GOMAD_HEADLESS=1")
+	}
+
+	logger.Info("replaying", "recording", recording)
+	cmd := exec.Command(binary, fs.Args()[1:]...)
+	cmd.Env = env
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("replay reproduced a difference: %w", err)
+	}
+	logger.Info("replay finished without differences")
+	return nil
+}
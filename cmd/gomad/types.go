@@ -0,0 +1,70 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// ============================================================================
+// gomad types
+// Uygulamanın binding'lerinden @gomad/client tanım dosyasını (.d.ts) üretir:
+// uygulama geçici bir dizine derlenir ve GOMAD_TYPES_OUT ile pencere açmadan
+// başlatılır; binding'ler bağlandıktan sonra tanımlar yazılır ve süreç çıkar.
+// Geliştirme sırasında tanımlar "gomad dev" altında build eklentileriyle
+// otomatik güncellenir; bu komut CI ve ilk kurulum içindir.
+// ============================================================================
+
+func runTypes(args []string) error {
+	fs := flag.NewFlagSet("types", flag.ExitOnError)
+	app := fs.String("app", ".", "Go main package of the application")
+	out := fs.String("out", "frontend/src/gomad.d.ts", "output definitions file")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: gomad types [flags] [-- app args]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	path, err := filepath.Abs(*out)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.MkdirTemp("", "gomad-types-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	binary := filepath.Join(tmp, "app"+exeSuffix())
+
+	logger.Info("building", "app", *app)
+	build := exec.Command("go", "build", "-o", binary, *app)
+	build.Stdout = os.Stdout
+	build.Stderr = os.Stderr
+	if err := build.Run(); err != nil {
+		return err
+	}
+
+	generated := filepath.Join(tmp, "gomad.d.ts")
+	cmd := exec.Command(binary, fs.Args()...)
+	cmd.Env = append(os.Environ(), "GOMAD_TYPES_OUT="+generated, "GOMAD_HEADLESS=1")
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to generate definitions: %w", err)
+	}
+	data, err := os.ReadFile(generated)
+	if err != nil {
+		return fmt.Errorf("app exited without writing definitions (does it call Run?): %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return err
+	}
+	logger.Info("definitions written", "file", *out)
+	return nil
+}
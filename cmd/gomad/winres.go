@@ -0,0 +1,248 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ============================================================================
+// WINDOWS KAYNAK DOSYASI (.syso)
+// Go linker'ı paket dizinindeki .syso nesne dosyalarını çalıştırılabilir
+// dosyaya bağlar. Burada tek bir .rsrc bölümü içeren bir COFF nesnesi
+// üretilir: uygulama manifesti (RT_MANIFEST) ve ikon (RT_ICON + RT_GROUP_ICON).
+// Harici bir kaynak derleyicisi (windres, rc.exe) gerekmez.
+//
+// Kaynak dizini üç seviyelidir: tür → kimlik → dil. Yaprak düğümlerdeki
+// veri adresleri RVA olduğundan her biri için bir relocation yazılır.
+// ============================================================================
+
+const (
+	rtIcon      = 3
+	rtGroupIcon = 14
+	rtManifest  = 24
+
+	langEnUS = 0x0409
+)
+
+// resource, .rsrc bölümündeki tek bir kaynaktır.
+type resource struct {
+	typ  uint32
+	id   uint32
+	data []byte
+}
+
+// defaultManifest, GOMAD uygulamaları için Windows manifestidir: modern
+// ortak kontroller, per-monitor DPI farkındalığı ve yönetici izni istemeyen
+// çalıştırma seviyesi.
+const defaultManifest = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<assembly xmlns="urn:schemas-microsoft-com:asm.v1" manifestVersion="1.0">
+  <assemblyIdentity type="win32" name="%s" version="%s" processorArchitecture="*"/>
+  <dependency>
+    <dependentAssembly>
+      <assemblyIdentity type="win32" name="Microsoft.Windows.Common-Controls" version="6.0.0.0" processorArchitecture="*" publicKeyToken="6595b64144ccf1df" language="*"/>
+    </dependentAssembly>
+  </dependency>
+  <application xmlns="urn:schemas-microsoft-com:asm.v3">
+    <windowsSettings>
+      <dpiAware xmlns="http://schemas.microsoft.com/SMI/2005/WindowsSettings">true/pm</dpiAware>
+      <dpiAwareness xmlns="http://schemas.microsoft.com/SMI/2016/WindowsSettings">PerMonitorV2</dpiAwareness>
+    </windowsSettings>
+  </application>
+  <trustInfo xmlns="urn:schemas-microsoft-com:asm.v3">
+    <security>
+      <requestedPrivileges>
+        <requestedExecutionLevel level="asInvoker" uiAccess="false"/>
+      </requestedPrivileges>
+    </security>
+  </trustInfo>
+</assembly>
+`
+
+// iconResources, .ico dosyasını RT_ICON görüntülerine ve bir RT_GROUP_ICON
+// dizinine çevirir.
+func iconResources(ico []byte) ([]resource, error) {
+	if len(ico) < 6 || binary.LittleEndian.Uint16(ico[2:]) != 1 {
+		return nil, errors.New("icon: not a .ico file")
+	}
+	count := int(binary.LittleEndian.Uint16(ico[4:]))
+	if len(ico) < 6+count*16 {
+		return nil, errors.New("icon: truncated directory")
+	}
+
+	var resources []resource
+	group := new(bytes.Buffer)
+	binary.Write(group, binary.LittleEndian, [3]uint16{0, 1, uint16(count)})
+
+	for i := 0; i < count; i++ {
+		entry := ico[6+i*16 : 6+(i+1)*16]
+		size := binary.LittleEndian.Uint32(entry[8:])
+		offset := binary.LittleEndian.Uint32(entry[12:])
+		if uint64(offset)+uint64(size) > uint64(len(ico)) {
+			return nil, fmt.Errorf("icon: image %d out of range", i)
+		}
+
+		id := uint32(i + 1)
+		resources = append(resources, resource{typ: rtIcon, id: id, data: ico[offset : offset+size]})
+
+		// GRPICONDIRENTRY: ICONDIRENTRY'nin ilk 12 baytı + 16 bit kaynak kimliği
+		group.Write(entry[:12])
+		binary.Write(group, binary.LittleEndian, uint16(id))
+	}
+
+	resources = append(resources, resource{typ: rtGroupIcon, id: 1, data: group.Bytes()})
+	return resources, nil
+}
+
+// coffMachine, GOARCH için COFF makine türü ve ADDR32NB relocation türüdür.
+func coffMachine(goarch string) (machine, relocType uint16, err error) {
+	switch goarch {
+	case "amd64":
+		return 0x8664, 0x0003, nil // IMAGE_REL_AMD64_ADDR32NB
+	case "386":
+		return 0x014C, 0x0007, nil // IMAGE_REL_I386_DIR32NB
+	case "arm64":
+		return 0xAA64, 0x0002, nil // IMAGE_REL_ARM64_ADDR32NB
+	}
+	return 0, 0, fmt.Errorf("winres: unsupported architecture %q", goarch)
+}
+
+// buildSyso, kaynakları içeren COFF nesne dosyasını üretir.
+func buildSyso(goarch string, resources []resource) ([]byte, error) {
+	machine, relocType, err := coffMachine(goarch)
+	if err != nil {
+		return nil, err
+	}
+
+	// Türlere göre grupla; Windows kimliklerin artan sırada olmasını bekler
+	byType := make(map[uint32][]resource)
+	var types []uint32
+	for _, r := range resources {
+		if _, ok := byType[r.typ]; !ok {
+			types = append(types, r.typ)
+		}
+		byType[r.typ] = append(byType[r.typ], r)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	for _, t := range types {
+		list := byType[t]
+		sort.Slice(list, func(i, j int) bool { return list[i].id < list[j].id })
+	}
+
+	const dirSize, entrySize, dataEntrySize = 16, 8, 16
+
+	// Yerleşim: kök dizin, tür dizinleri, kimlik→dil dizinleri, veri girdileri, veriler
+	offset := uint32(dirSize + entrySize*len(types))
+	typeDirOffset := make(map[uint32]uint32)
+	for _, t := range types {
+		typeDirOffset[t] = offset
+		offset += uint32(dirSize + entrySize*len(byType[t]))
+	}
+	langDirOffset := make(map[[2]uint32]uint32)
+	for _, t := range types {
+		for _, r := range byType[t] {
+			langDirOffset[[2]uint32{t, r.id}] = offset
+			offset += dirSize + entrySize
+		}
+	}
+	dataEntryOffset := make(map[[2]uint32]uint32)
+	for _, t := range types {
+		for _, r := range byType[t] {
+			dataEntryOffset[[2]uint32{t, r.id}] = offset
+			offset += dataEntrySize
+		}
+	}
+	dataOffset := make(map[[2]uint32]uint32)
+	for _, t := range types {
+		for _, r := range byType[t] {
+			offset = align8(offset)
+			dataOffset[[2]uint32{t, r.id}] = offset
+			offset += uint32(len(r.data))
+		}
+	}
+	sectionSize := align8(offset)
+
+	section := make([]byte, sectionSize)
+	le := binary.LittleEndian
+	writeDir := func(at uint32, idEntries int) {
+		le.PutUint16(section[at+14:], uint16(idEntries))
+	}
+	writeEntry := func(at, id, target uint32) {
+		le.PutUint32(section[at:], id)
+		le.PutUint32(section[at+4:], target)
+	}
+	const subdirectory = 0x80000000
+
+	writeDir(0, len(types))
+	var relocs []uint32
+	for i, t := range types {
+		writeEntry(uint32(dirSize+entrySize*i), t, typeDirOffset[t]|subdirectory)
+
+		list := byType[t]
+		writeDir(typeDirOffset[t], len(list))
+		for j, r := range list {
+			key := [2]uint32{t, r.id}
+			writeEntry(typeDirOffset[t]+uint32(dirSize+entrySize*j), r.id, langDirOffset[key]|subdirectory)
+
+			writeDir(langDirOffset[key], 1)
+			writeEntry(langDirOffset[key]+dirSize, langEnUS, dataEntryOffset[key])
+
+			// IMAGE_RESOURCE_DATA_ENTRY: OffsetToData (RVA, relocation ile), Size
+			le.PutUint32(section[dataEntryOffset[key]:], dataOffset[key])
+			le.PutUint32(section[dataEntryOffset[key]+4:], uint32(len(r.data)))
+			relocs = append(relocs, dataEntryOffset[key])
+
+			copy(section[dataOffset[key]:], r.data)
+		}
+	}
+
+	const fileHeaderSize, sectionHeaderSize, relocSize = 20, 40, 10
+	rawOffset := uint32(fileHeaderSize + sectionHeaderSize)
+	relocOffset := rawOffset + sectionSize
+	symbolOffset := relocOffset + uint32(relocSize*len(relocs))
+
+	out := new(bytes.Buffer)
+	// IMAGE_FILE_HEADER
+	binary.Write(out, le, machine)
+	binary.Write(out, le, uint16(1))    // NumberOfSections
+	binary.Write(out, le, uint32(0))    // TimeDateStamp
+	binary.Write(out, le, symbolOffset) // PointerToSymbolTable
+	binary.Write(out, le, uint32(1))    // NumberOfSymbols
+	binary.Write(out, le, uint16(0))    // SizeOfOptionalHeader
+	binary.Write(out, le, uint16(0))    // Characteristics
+	// IMAGE_SECTION_HEADER
+	out.WriteString(".rsrc\x00\x00\x00")
+	binary.Write(out, le, uint32(0))           // VirtualSize
+	binary.Write(out, le, uint32(0))           // VirtualAddress
+	binary.Write(out, le, sectionSize)         // SizeOfRawData
+	binary.Write(out, le, rawOffset)           // PointerToRawData
+	binary.Write(out, le, relocOffset)         // PointerToRelocations
+	binary.Write(out, le, uint32(0))           // PointerToLinenumbers
+	binary.Write(out, le, uint16(len(relocs))) // NumberOfRelocations
+	binary.Write(out, le, uint16(0))           // NumberOfLinenumbers
+	binary.Write(out, le, uint32(0x40000040))  // INITIALIZED_DATA | MEM_READ
+	out.Write(section)
+	// Relocation'lar: her veri girdisinin OffsetToData alanı .rsrc sembolüne göre
+	for _, at := range relocs {
+		binary.Write(out, le, at)
+		binary.Write(out, le, uint32(0)) // Sembol indeksi
+		binary.Write(out, le, relocType)
+	}
+	// Sembol tablosu: bölümün kendisi (STATIC)
+	out.WriteString(".rsrc\x00\x00\x00")
+	binary.Write(out, le, uint32(0)) // Value
+	binary.Write(out, le, uint16(1)) // SectionNumber
+	binary.Write(out, le, uint16(0)) // Type
+	out.WriteByte(3)                 // StorageClass: IMAGE_SYM_CLASS_STATIC
+	out.WriteByte(0)                 // NumberOfAuxSymbols
+	// Boş string tablosu (yalnızca boyut alanı)
+	binary.Write(out, le, uint32(4))
+
+	return out.Bytes(), nil
+}
+
+func align8(n uint32) uint32 {
+	return (n + 7) &^ 7
+}
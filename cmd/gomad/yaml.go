@@ -0,0 +1,264 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ============================================================================
+// YAML ALT KÜMESİ
+// gomad.yaml için harici bağımlılık eklememek adına manifestin ihtiyaç
+// duyduğu YAML alt kümesi çözümlenir:
+//   - girintiyle iç içe map'ler ve "- " listeleri (map öğeli listeler dahil)
+//   - tek satırlık [a, b] listeleri
+//   - tırnaklı/tırnaksız string'ler, true/false, # yorumları
+// Anchor, çok satırlı string (|, >) ve çoklu doküman desteklenmez.
+// Sonuç map[string]any / []any / string / bool ağacıdır.
+// ============================================================================
+
+// yamlLine, yorumlardan arındırılmış anlamlı bir satırdır.
+type yamlLine struct {
+	num    int
+	indent int
+	text   string
+}
+
+// yamlParser, satırlar üzerinde ilerleyen özyinelemeli çözümleyicidir.
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+// parseYAML, YAML alt kümesini çözümler.
+func parseYAML(data []byte) (any, error) {
+	p := &yamlParser{}
+	for i, raw := range strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n") {
+		if lead := raw[:len(raw)-len(strings.TrimLeft(raw, " \t"))]; strings.Contains(lead, "\t") {
+			return nil, fmt.Errorf("line %d: tabs are not allowed for indentation", i+1)
+		}
+		text := stripComment(raw)
+		trimmed := strings.TrimSpace(text)
+		if trimmed == "" || trimmed == "---" {
+			continue
+		}
+		indent := len(text) - len(strings.TrimLeft(text, " "))
+		p.lines = append(p.lines, yamlLine{num: i + 1, indent: indent, text: strings.TrimRight(trimmed, " ")})
+	}
+	if len(p.lines) == 0 {
+		return map[string]any{}, nil
+	}
+
+	value, err := p.block(p.lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.lines) {
+		return nil, fmt.Errorf("line %d: unexpected indentation", p.lines[p.pos].num)
+	}
+	return value, nil
+}
+
+// block, verilen girintideki map ya da listeyi çözümler.
+func (p *yamlParser) block(indent int) (any, error) {
+	if isListItem(p.lines[p.pos].text) {
+		return p.list(indent)
+	}
+	return p.mapping(indent)
+}
+
+func (p *yamlParser) mapping(indent int) (map[string]any, error) {
+	m := make(map[string]any)
+	for p.pos < len(p.lines) {
+		line := p.lines[p.pos]
+		if line.indent < indent {
+			break
+		}
+		if line.indent > indent {
+			return nil, fmt.Errorf("line %d: unexpected indentation", line.num)
+		}
+		if isListItem(line.text) {
+			break
+		}
+
+		key, rest, ok := splitKey(line.text)
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected \"key: value\"", line.num)
+		}
+		p.pos++
+
+		if rest != "" {
+			m[key] = scalar(rest)
+			continue
+		}
+
+		// Değer bir sonraki satırlardaki blok; "key:\n- a" biçiminde liste aynı
+		// girintide de olabilir
+		if p.pos < len(p.lines) {
+			next := p.lines[p.pos]
+			if next.indent > indent || (next.indent == indent && isListItem(next.text)) {
+				value, err := p.block(next.indent)
+				if err != nil {
+					return nil, err
+				}
+				m[key] = value
+				continue
+			}
+		}
+		m[key] = nil
+	}
+	return m, nil
+}
+
+func (p *yamlParser) list(indent int) ([]any, error) {
+	var items []any
+	for p.pos < len(p.lines) {
+		line := p.lines[p.pos]
+		if line.indent != indent || !isListItem(line.text) {
+			if line.indent > indent {
+				return nil, fmt.Errorf("line %d: unexpected indentation", line.num)
+			}
+			break
+		}
+
+		content := strings.TrimSpace(strings.TrimPrefix(line.text, "-"))
+		switch {
+		case content == "":
+			// "-" ardından girintili blok
+			p.pos++
+			if p.pos >= len(p.lines) || p.lines[p.pos].indent <= indent {
+				items = append(items, nil)
+				continue
+			}
+			value, err := p.block(p.lines[p.pos].indent)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, value)
+
+		case isMapEntry(content):
+			// "- key: value" → öğe, içeriğin sütununda başlayan bir map'tir
+			column := indent + len(line.text) - len(strings.TrimLeft(strings.TrimPrefix(line.text, "-"), " "))
+			p.lines[p.pos] = yamlLine{num: line.num, indent: column, text: content}
+			value, err := p.mapping(column)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, value)
+
+		default:
+			p.pos++
+			items = append(items, scalar(content))
+		}
+	}
+	return items, nil
+}
+
+// scalar, satır içi değeri çözümler.
+func scalar(s string) any {
+	switch {
+	case len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"':
+		return strings.NewReplacer(`\"`, `"`, `\\`, `\`, `\n`, "\n", `\t`, "\t").Replace(s[1 : len(s)-1])
+	case len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'':
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'")
+	case len(s) >= 2 && s[0] == '[' && s[len(s)-1] == ']':
+		var items []any
+		for _, item := range splitFlow(s[1 : len(s)-1]) {
+			items = append(items, scalar(item))
+		}
+		return items
+	case s == "true":
+		return true
+	case s == "false":
+		return false
+	case s == "null" || s == "~":
+		return nil
+	}
+	return s
+}
+
+// splitFlow, "[a, 'b, c']" içeriğini tırnaklara dikkat ederek böler.
+func splitFlow(s string) []string {
+	var parts []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == ',':
+			parts = append(parts, strings.TrimSpace(s[start:i]))
+			start = i + 1
+		}
+	}
+	if last := strings.TrimSpace(s[start:]); last != "" {
+		parts = append(parts, last)
+	}
+	return parts
+}
+
+// splitKey, "key: value" satırını ayırır.
+func splitKey(s string) (key, rest string, ok bool) {
+	i := keySeparator(s)
+	if i < 0 {
+		return "", "", false
+	}
+	key = strings.TrimSpace(s[:i])
+	if len(key) >= 2 && (key[0] == '"' || key[0] == '\'') {
+		key = key[1 : len(key)-1]
+	}
+	return key, strings.TrimSpace(s[i+1:]), key != ""
+}
+
+// keySeparator, tırnak dışındaki ilk "key:" iki noktasının konumunu döner.
+// İki noktanın ardından boşluk ya da satır sonu gelmelidir (URL'ler key sayılmaz).
+func keySeparator(s string) int {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			if i == 0 {
+				quote = c
+			}
+		case c == ':' && (i == len(s)-1 || s[i+1] == ' '):
+			return i
+		}
+	}
+	return -1
+}
+
+func isListItem(s string) bool {
+	return s == "-" || strings.HasPrefix(s, "- ")
+}
+
+func isMapEntry(s string) bool {
+	if s == "" || s[0] == '[' || s[0] == '"' && !strings.Contains(s, `":`) {
+		return false
+	}
+	return keySeparator(s) > 0
+}
+
+// stripComment, tırnak dışındaki "#" yorumunu kaldırır.
+func stripComment(s string) string {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case (c == '"' || c == '\'') && (i == 0 || strings.IndexByte(" [,", s[i-1]) >= 0):
+			quote = c
+		case c == '#' && (i == 0 || s[i-1] == ' '):
+			return s[:i]
+		}
+	}
+	return s
+}
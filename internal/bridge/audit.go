@@ -0,0 +1,136 @@
+package bridge
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ============================================================
+// AUDIT — Köprü çağrılarının denetim kaydı
+// ------------------------------------------------------------
+// Düzenlemeye tabi sektörlerde (sağlık, finans) hangi sayfanın hangi
+// binding'i ne zaman, hangi sonuçla çağırdığının kaydı tutulmalıdır. Trafik
+// gözlemcilerinden (bkz. Observe) farkı: her çağrı tek bir kayıt olarak,
+// çağıran sayfa, süre ve sonuçla birlikte raporlanır; doğrulamada reddedilen
+// çağrılar da kayda girer. Argümanlar yalnızca açıkça istenirse (ve
+// istenirse maskelenerek) kaydedilir.
+//
+// Köprünün temel yerleşikleri (gomad.ready, gomad.log, gomad.page,
+// gomad.navigation, akış çekme) uygulama API'si değil altyapı olduğundan
+// kaydedilmez.
+// ============================================================
+
+// Denetim kaydındaki çağrı sonuçları.
+const (
+	AuditOK       = "ok"       // Binding çalıştı ve sonuç döndü
+	AuditError    = "error"    // Binding hata döndü, bulunamadı ya da argümanlar geçersizdi
+	AuditRejected = "rejected" // Çağrı doğrulamadan (origin, imza, capability) geçemedi
+)
+
+// AuditRecord, tek bir çağrının denetim kaydıdır.
+type AuditRecord struct {
+	Time      time.Time       `json:"time"`
+	ID        string          `json:"id"`
+	Method    string          `json:"method"`
+	Origin    string          `json:"origin,omitempty"` // Çağıran sayfanın origin'i
+	Page      string          `json:"page,omitempty"`   // Çağıran sayfa (origin + yol)
+	Duration  time.Duration   `json:"duration"`
+	Outcome   string          `json:"outcome"` // AuditOK, AuditError ya da AuditRejected
+	ErrorCode int             `json:"errorCode,omitempty"`
+	Error     string          `json:"error,omitempty"`
+	Args      json.RawMessage `json:"args,omitempty"` // Yalnızca Audit.Args verilmişse
+}
+
+// AuditSink, denetim kayıtlarını alan hedeftir (dosya, SIEM, veritabanı).
+// Audit çağrıyı işleyen goroutine'de senkron çağrılır; hızlı dönmelidir.
+type AuditSink interface {
+	Audit(rec AuditRecord)
+}
+
+// AuditFunc, bir fonksiyonu AuditSink olarak kullanmayı sağlar.
+type AuditFunc func(rec AuditRecord)
+
+// Audit, AuditSink'i uygular.
+func (f AuditFunc) Audit(rec AuditRecord) { f(rec) }
+
+// Audit, denetim kaydı ayarlarıdır.
+type Audit struct {
+	// Sink, kayıtların gönderildiği hedef; nil ise kayıt tutulmaz.
+	Sink AuditSink
+
+	// Args, argümanların kayda nasıl gireceğini belirler: dönen değer kayda
+	// yazılır (ör. parola alanları maskelenmiş kopya), nil dönerse argüman
+	// yazılmaz. Args nil ise hiçbir çağrının argümanı kaydedilmez.
+	Args func(method string, args json.RawMessage) json.RawMessage
+}
+
+// SetAudit() → Denetim kaydını ayarlar; boş Audit kaydı kapatır.
+func (b *Bridge) SetAudit(a Audit) {
+	b.auditMu.Lock()
+	b.audit = a
+	b.auditMu.Unlock()
+}
+
+// notifyAudit() → Çağrı ve cevabından denetim kaydını üretip sink'e verir.
+// rejected, çağrının doğrulamada reddedildiğini belirtir.
+func (b *Bridge) notifyAudit(msg, response *Message, elapsed time.Duration, rejected bool) {
+	b.auditMu.RLock()
+	a := b.audit
+	b.auditMu.RUnlock()
+	if a.Sink == nil || msg.Type != MessageTypeCall || coreBinding(msg.Method) {
+		return
+	}
+
+	rec := AuditRecord{
+		Time:     time.Now(),
+		ID:       msg.ID,
+		Method:   msg.Method,
+		Origin:   msg.Origin,
+		Page:     msg.Page,
+		Duration: elapsed,
+		Outcome:  AuditOK,
+	}
+	if response.Type == MessageTypeError && response.Error != nil {
+		rec.Outcome = AuditError
+		if rejected {
+			rec.Outcome = AuditRejected
+		}
+		rec.ErrorCode = response.Error.Code
+		rec.Error = response.Error.Message
+		// Yapısal zincir (bkz. ErrorDetails) mesajda zaten düzleştirilmiş hâldedir
+		if d := response.Error.Details; d != "" && !strings.HasPrefix(d, "{") {
+			rec.Error += ": " + d
+		}
+	}
+	if a.Args != nil {
+		rec.Args = a.Args(msg.Method, msg.Args)
+	}
+	a.Sink.Audit(rec)
+}
+
+// JSONAuditSink, kayıtları satır başına bir JSON nesnesi (JSON Lines) olarak
+// yazar. Her kayıt hemen yazılır; uygulama çökse bile kayıp olmaz.
+type JSONAuditSink struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+	mu  sync.Mutex
+}
+
+// NewJSONAuditSink, w'ya yazan bir JSONAuditSink oluşturur.
+func NewJSONAuditSink(w io.Writer) *JSONAuditSink {
+	bw := bufio.NewWriter(w)
+	return &JSONAuditSink{w: bw, enc: json.NewEncoder(bw)}
+}
+
+// Audit, AuditSink'i uygular.
+func (s *JSONAuditSink) Audit(rec AuditRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.enc.Encode(rec); err == nil {
+		s.w.Flush()
+	}
+}
@@ -0,0 +1,100 @@
+package bridge
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// Köprünün sıcak yollarının ölçümleri. Gerilemeleri yakalamak için iki
+// ölçüm benchstat ile karşılaştırılır:
+//
+//	go test -run '^$' -bench . -count 10 ./internal/bridge > new.txt
+//	benchstat old.txt new.txt
+//
+// Alt ölçüm adlarındaki boyut, taşınan string argümanın uzunluğudur; MB/s
+// sütunu yük boyutunun maliyetini gösterir.
+
+// benchPayloads, ölçülen argüman boyutlarıdır.
+var benchPayloads = []struct {
+	name string
+	size int
+}{
+	{"16B", 16},
+	{"1KB", 1 << 10},
+	{"64KB", 64 << 10},
+}
+
+// benchPaths, aynı binding'in reflection ve typed yollarıdır.
+func benchPaths() []struct {
+	name string
+	fn   interface{}
+} {
+	echo := func(s string) (string, error) { return s, nil }
+	return []struct {
+		name string
+		fn   interface{}
+	}{
+		{"reflect", echo},
+		{"typed", Func1(echo)},
+	}
+}
+
+// BenchmarkRegistryCall, argüman çözme + çağrıyı ölçer.
+func BenchmarkRegistryCall(b *testing.B) {
+	for _, path := range benchPaths() {
+		for _, p := range benchPayloads {
+			args, _ := json.Marshal([]string{strings.Repeat("x", p.size)})
+			b.Run(path.name+"/"+p.name, func(b *testing.B) {
+				r := NewRegistry()
+				if err := r.Register("echo", path.fn); err != nil {
+					b.Fatal(err)
+				}
+				b.ReportAllocs()
+				b.SetBytes(int64(p.size))
+				for b.Loop() {
+					if _, err := r.Call("echo", args); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkHandleMessage, JSON çözme + çağrı + cevabın JSON'a çevrilmesini ölçer.
+func BenchmarkHandleMessage(b *testing.B) {
+	for _, path := range benchPaths() {
+		for _, p := range benchPayloads {
+			call := callJSON(b, "1", "echo", strings.Repeat("x", p.size))
+			b.Run(path.name+"/"+p.name, func(b *testing.B) {
+				br := NewBridge(nopEvaluator{})
+				if err := br.Bind("echo", path.fn); err != nil {
+					b.Fatal(err)
+				}
+				b.ReportAllocs()
+				b.SetBytes(int64(p.size))
+				for b.Loop() {
+					br.HandleMessage(call)
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkEmit, olay JSON'unu ve Eval'e verilen scripti üretmeyi ölçer.
+func BenchmarkEmit(b *testing.B) {
+	for _, p := range benchPayloads {
+		payload := map[string]string{"data": strings.Repeat("x", p.size)}
+		b.Run(p.name, func(b *testing.B) {
+			br := NewBridge(nopEvaluator{})
+			b.ReportAllocs()
+			b.SetBytes(int64(p.size))
+			for b.Loop() {
+				if err := br.Emit("bench", payload); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package bridge
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ============================================================
+// BLOB — Büyük payload'lar için loopback kısa yolu
+// ------------------------------------------------------------
+// Go → JS veri Eval ile JS kaynağına gömülerek gider; birkaç MB'lık bir
+// sonuç önce JSON, sonra JS string'i olarak kopyalanır ve WebView bunu kaynak
+// kod gibi ayrıştırır. Eşiği aşan sonuçlar ve olay verileri bunun yerine
+// 127.0.0.1 üzerindeki küçük bir HTTP sunucusuna konur; köprüden yalnızca
+// adres (Message.Blob) geçer ve JS veriyi fetch ile doğrudan okur.
+//
+// Adresler tahmin edilemez (128 bit rastgele), tek kullanımlıktır ve blobTTL
+// sonunda silinir. Yanıtlar yalnızca uygulama sayfasının origin'ine açıktır
+// (CORS); başka bir origin'den gelen istek blob'u tüketmeden reddedilir.
+// Sunucu ilk büyük payload'da başlatılır.
+// ============================================================
+
+// blobTTL, okunmayan bir blob'un tutulduğu süredir.
+const blobTTL = 30 * time.Second
+
+// blobServer, büyük payload'ları loopback üzerinden sunar.
+type blobServer struct {
+	base   string // http://127.0.0.1:port/
+	srv    *http.Server
+	blobs  map[string]blobEntry
+	allows func(origin string) bool // Yanıtı okuyabilecek origin'ler
+	mu     sync.Mutex
+}
+
+type blobEntry struct {
+	data    []byte
+	expires time.Time
+}
+
+// SetLargePayloadThreshold() → Bu boyuttan (bayt) büyük sonuç ve olay
+// verilerini loopback üzerinden gönderir. 0 kapatır; yeni bir Bridge'de eşik
+// kapalıdır. gomad.Application eşiği WithLargePayloadThreshold ile (varsayılan
+// 1 MB) ayarlar.
+func (b *Bridge) SetLargePayloadThreshold(n int) {
+	b.blobMu.Lock()
+	b.blobThreshold = n
+	b.blobMu.Unlock()
+}
+
+// Close() → Köprünün arka plan kaynaklarını (blob sunucusu) kapatır.
+func (b *Bridge) Close() {
+	b.blobMu.Lock()
+	srv := b.blobs
+	b.blobs = nil
+	b.blobMu.Unlock()
+	if srv != nil {
+		srv.srv.Close()
+	}
+}
+
+// offload() → payload eşiği aşıyorsa blob sunucusuna koyup adresini döner.
+// Eşik kapalıysa, payload küçükse ya da sunucu başlatılamazsa "" döner ve
+// payload olağan yoldan gider.
+func (b *Bridge) offload(payload json.RawMessage) string {
+	b.blobMu.Lock()
+	defer b.blobMu.Unlock()
+	if b.blobThreshold <= 0 || len(payload) <= b.blobThreshold {
+		return ""
+	}
+	if b.blobs == nil {
+		srv, err := startBlobServer(b.blobOriginAllowed)
+		if err != nil {
+			b.logger.Warn("large payload endpoint unavailable", "error", err)
+			b.blobThreshold = 0
+			return ""
+		}
+		b.blobs = srv
+	}
+	return b.blobs.put(payload)
+}
+
+// blobOriginAllowed, blob yanıtını okuyabilecek origin'leri seçer: köprüye en
+// son mesaj gönderen sayfanın origin'i ve EnableOriginCheck'e verilenler.
+func (b *Bridge) blobOriginAllowed(origin string) bool {
+	b.capMu.RLock()
+	page := b.page
+	b.capMu.RUnlock()
+	b.guardMu.RLock()
+	g := b.guard
+	b.guardMu.RUnlock()
+
+	if page != "" && pageOrigin(page) == NormalizeOrigin(origin) {
+		return true
+	}
+	return g != nil && g.allows(origin)
+}
+
+// pageOrigin, mesajların page alanındaki (origin + yol) origin'i döner.
+func pageOrigin(page string) string {
+	if strings.HasPrefix(page, "null") {
+		return "null"
+	}
+	return NormalizeOrigin(page)
+}
+
+// startBlobServer, loopback'te rastgele bir portta sunucu başlatır. allows,
+// yanıtı okuyabilecek origin'leri seçer.
+func startBlobServer(allows func(origin string) bool) (*blobServer, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &blobServer{
+		base:   "http://" + ln.Addr().String() + "/",
+		blobs:  make(map[string]blobEntry),
+		allows: allows,
+	}
+	s.srv = &http.Server{Handler: http.HandlerFunc(s.serve)}
+	go func() {
+		if err := s.srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return
+		}
+	}()
+	return s, nil
+}
+
+// put, veriyi saklar ve tek kullanımlık adresini döner.
+func (s *blobServer) put(data []byte) string {
+	var token [16]byte
+	_, _ = rand.Read(token[:])
+	key := hex.EncodeToString(token[:])
+
+	now := time.Now()
+	s.mu.Lock()
+	for k, e := range s.blobs {
+		if now.After(e.expires) {
+			delete(s.blobs, k)
+		}
+	}
+	s.blobs[key] = blobEntry{data: data, expires: now.Add(blobTTL)}
+	s.mu.Unlock()
+	return s.base + key
+}
+
+// serve, blob'u bir kez döner ve siler.
+func (s *blobServer) serve(w http.ResponseWriter, r *http.Request) {
+	// Sayfa farklı bir origin'den (dev sunucusu, gömülü asset'ler) yüklenir;
+	// yalnızca uygulamanın origin'i okuyabilir
+	if origin := r.Header.Get("Origin"); origin != "" {
+		if !s.allows(origin) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Set("Vary", "Origin")
+	}
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	s.mu.Lock()
+	e, ok := s.blobs[key]
+	delete(s.blobs, key)
+	s.mu.Unlock()
+	if !ok || time.Now().After(e.expires) {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Write(e.data)
+}
@@ -0,0 +1,97 @@
+package bridge
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// fetchBlob, blob adresini verilen Origin başlığıyla okur.
+func fetchBlob(t *testing.T, url, origin string) (int, string) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(body)
+}
+
+func TestLargeResultOffload(t *testing.T) {
+	b := NewBridge(nopEvaluator{})
+	defer b.Close()
+	b.SetLargePayloadThreshold(64)
+	if err := b.Bind("echo", func(s string) string { return s }); err != nil {
+		t.Fatal(err)
+	}
+
+	// Eşiğin altındaki sonuç mesajın içinde gider
+	wantResult(t, parseResponse(t, b.HandleMessage(callJSON(t, "1", "echo", "small"))), "1", "small")
+
+	// Sayfa, mesajlarının page alanından öğrenilir
+	msg, _ := NewCallMessage("2", "echo", []string{strings.Repeat("x", 100)})
+	msg.Page = "http://app.test/index.html"
+	data, _ := msg.ToJSON()
+	reply, next := asyncReplies()
+	b.HandleMessageAsync(string(data), nil, reply)
+	resp := next(t)
+	if resp.Type != MessageTypeResult || resp.Blob == "" || resp.Result != nil {
+		t.Fatalf("response = %+v, want offloaded result", resp)
+	}
+
+	// Başka bir origin blob'u tüketmeden reddedilir
+	if code, _ := fetchBlob(t, resp.Blob, "http://evil.test"); code != http.StatusForbidden {
+		t.Fatalf("foreign origin: status %d, want 403", code)
+	}
+	code, body := fetchBlob(t, resp.Blob, "http://app.test")
+	if code != http.StatusOK {
+		t.Fatalf("app origin: status %d, want 200", code)
+	}
+	var got string
+	if err := json.Unmarshal([]byte(body), &got); err != nil || got != strings.Repeat("x", 100) {
+		t.Fatalf("blob = %q, %v", body, err)
+	}
+
+	// Adres tek kullanımlıktır
+	if code, _ := fetchBlob(t, resp.Blob, "http://app.test"); code != http.StatusNotFound {
+		t.Fatalf("second read: status %d, want 404", code)
+	}
+}
+
+func TestLargeEventOffload(t *testing.T) {
+	ev := &recordEvaluator{}
+	b := NewBridge(ev)
+	defer b.Close()
+	b.SetLargePayloadThreshold(64)
+
+	if err := b.Emit("small", "x"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Emit("large", strings.Repeat("y", 100)); err != nil {
+		t.Fatal(err)
+	}
+	events := ev.events()
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2", len(events))
+	}
+	if events[0].Blob != "" || string(events[0].Data) != `"x"` {
+		t.Fatalf("small event = %+v, want inline data", events[0])
+	}
+	if events[1].Blob == "" || events[1].Data != nil {
+		t.Fatalf("large event = %+v, want offloaded data", events[1])
+	}
+	// Origin başlığı taşımayan istekler reddedilmez
+	if code, body := fetchBlob(t, events[1].Blob, ""); code != http.StatusOK || body != `"`+strings.Repeat("y", 100)+`"` {
+		t.Fatalf("blob: status %d, body %q", code, body)
+	}
+}
@@ -1,9 +1,16 @@
 package bridge
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
 )
 
 //
@@ -62,8 +69,9 @@ type Evaluator interface {
 // thread-safe olması için mutex ve atomic sayaçlar kullanılır.
 // ============================================================
 type Bridge struct {
-	evaluator Evaluator // JavaScript çalıştırmak için gerekli eval interface’i
-	registry  *Registry // Kayıtlı Go fonksiyonlarını tutar
+	evaluator Evaluator    // JavaScript çalıştırmak için gerekli eval interface’i
+	registry  *Registry    // Kayıtlı Go fonksiyonlarını tutar
+	logger    *slog.Logger // Lifecycle, call ve hata logları
 
 	eventListeners map[string][]func(data interface{}) // JS event aboneleri
 	eventMu        sync.RWMutex                        // event eşzamanlama
@@ -74,8 +82,69 @@ type Bridge struct {
 
 	initialized bool // JS bridge kodu yüklendi mi?
 	initMu      sync.RWMutex
+
+	frontendReady bool     // Frontend gomad.ready() çağırdı mı?
+	readyHandlers []func() // ready sinyalinde çalışacak callback'ler
+	readyMu       sync.RWMutex
+
+	onPanic func(method string, err *gomerrors.PanicError) // handler panic bildirimi
+	panicMu sync.RWMutex
+
+	traffic observers // Trafik gözlemcileri (bkz. Observe)
+	stats   stats     // Çalışma zamanı sayaçları (bkz. Stats)
+	metrics metrics   // Metod bazında metrikler (bkz. Metrics)
+
+	redaction   atomic.Pointer[redaction] // Trafik logu maskeleme kuralları (bkz. SetLogRedaction)
+	slowCall    atomic.Int64              // Takılan çağrı eşiği, ns (bkz. SetSlowCallThreshold)
+	errorPolicy atomic.Int32              // Hata ayrıntısı politikası (bkz. SetErrorPolicy)
+
+	forward   func(msg *Message) *Message // Yerelde olmayan çağrıların iletildiği köprü (bkz. SetForwarder)
+	forwardMu sync.RWMutex
+
+	streams  map[string]*outStream // Gönderilmekte olan akışlar (bkz. SendStream)
+	streamMu sync.Mutex
+
+	blobs         *blobServer // Büyük payload'lar için loopback sunucu (bkz. SetLargePayloadThreshold)
+	blobThreshold int
+	blobMu        sync.Mutex
+
+	guard   *originGuard // Sayfa mesajlarının doğrulanması (bkz. EnableOriginCheck)
+	signKey []byte       // Mesaj imzası anahtarı (bkz. EnableMessageSigning)
+	guardMu sync.RWMutex
+
+	maxSize int64 // Mesaj boyutu sınırı, atomic (bkz. SetMaxMessageSize)
+
+	tracer   Tracer // Çağrı izleme (bkz. SetTracer)
+	tracerMu sync.RWMutex
+
+	limiter *limiter // Aşırı yük koruması (bkz. SetLimits)
+	limitMu sync.RWMutex
+
+	audit   Audit // Denetim kaydı (bkz. SetAudit)
+	auditMu sync.RWMutex
+
+	caps  []Capability // Sayfa başına izinler (bkz. SetCapabilities)
+	page  string       // Mesajların en son geldiği sayfa
+	capMu sync.RWMutex
+
+	nav navigation // Sayfanın gezinme geçmişindeki konumu (bkz. OnNavigation)
+
+	sticky   map[string]*Message // Olayların son değerleri (bkz. EmitSticky)
+	stickyMu sync.Mutex
+
+	journal journal // Yeniden yüklemeye dayanıklı mesaj günlüğü (bkz. EnableJournal)
+
+	drain drainState // Çalışan çağrılar ve kapanışta boşaltma (bkz. Drain)
 }
 
+// ReadyBinding, frontend'in hazır olduğunu bildirmek için kullandığı
+// yerleşik (built-in) fonksiyonun adıdır. JS tarafında gomad.ready() bunu çağırır.
+const ReadyBinding = "gomad.ready"
+
+// LogBinding, JS tarafındaki gomad.log.* çağrılarını Go log akışına
+// yönlendiren yerleşik fonksiyonun adıdır.
+const LogBinding = "gomad.log"
+
 // ============================================================
 // NewBridge()
 // ------------------------------------------------------------
@@ -86,14 +155,68 @@ type Bridge struct {
 // iletişim protokolü sabit kalmalıdır.
 // ============================================================
 func NewBridge(evaluator Evaluator) *Bridge {
-	return &Bridge{
+	b := &Bridge{
 		evaluator:      evaluator,
 		registry:       NewRegistry(),
+		logger:         slog.New(slog.DiscardHandler),
 		eventListeners: make(map[string][]func(data interface{})),
 		pendingCalls:   make(map[string]chan *Message),
 	}
+
+	// Yerleşik fonksiyonlar
+	_ = b.registry.Register(ReadyBinding, b.handleFrontendReady)
+	_ = b.registry.Register(LogBinding, b.handleFrontendLog)
+	_ = b.registry.Register(PageBinding, b.replaySticky)
+	_ = b.registry.Register(NavigationBinding, b.handleNavigation)
+	_ = b.registry.Register(JournalResumeBinding, b.handleJournalResume)
+	_ = b.registry.Register(StreamPullBinding, b.handleStreamPull)
+	_ = b.registry.Register(StreamCancelBinding, b.handleStreamCancel)
+
+	b.registry.SetPanicHandler(b.handlePanic)
+	b.stats.since = time.Now()
+
+	return b
+}
+
+// OnPanic() → Bağlı bir fonksiyon panic ettiğinde çağrılacak callback'i ayarlar.
+// ------------------------------------------------------------
+// Panic her durumda loglanır ve JS tarafına hata olarak döner; callback
+// ek işlem (ör. çökme raporu yazmak) içindir.
+func (b *Bridge) OnPanic(fn func(method string, err *gomerrors.PanicError)) {
+	b.panicMu.Lock()
+	b.onPanic = fn
+	b.panicMu.Unlock()
+}
+
+// handlePanic() → Registry'den gelen panic bildirimini loglar ve iletir.
+func (b *Bridge) handlePanic(method string, err *gomerrors.PanicError) {
+	b.logger.Error("bound function panicked",
+		"method", method,
+		"panic", fmt.Sprint(err.Value),
+		"stack", string(err.Stack))
+
+	b.panicMu.RLock()
+	fn := b.onPanic
+	b.panicMu.RUnlock()
+	if fn != nil {
+		fn(method, err)
+	}
 }
 
+// SetLogger() → Köprünün kullanacağı logger'ı ayarlar.
+// ------------------------------------------------------------
+// Varsayılan logger her şeyi yutar (discard). Trafik başlamadan önce,
+// yani WebView oluşturulurken ayarlanmalıdır. nil verilirse yok sayılır.
+func (b *Bridge) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		return
+	}
+	b.logger = logger.With("component", "bridge")
+}
+
+// Logger() → Köprünün logger'ını döner.
+func (b *Bridge) Logger() *slog.Logger { return b.logger }
+
 // ============================================================
 // FUNCTION BINDING
 // ------------------------------------------------------------
@@ -125,6 +248,26 @@ func (b *Bridge) IsBound(name string) bool { return b.registry.Has(name) }
 // ------------------------------------------------------------
 func (b *Bridge) ListBindings() []string { return b.registry.List() }
 
+// BindingInfo, kayıtlı bir fonksiyonun adı ve Go imzasıdır.
+type BindingInfo struct {
+	Name      string `json:"name"`
+	Signature string `json:"signature"`
+}
+
+// Describe() → Tüm kayıtlı fonksiyonları imzalarıyla, ada göre sıralı döner.
+// ------------------------------------------------------------
+func (b *Bridge) Describe() []BindingInfo {
+	names := b.registry.List()
+	sort.Strings(names)
+	list := make([]BindingInfo, 0, len(names))
+	for _, name := range names {
+		if sig, ok := b.registry.Signature(name); ok {
+			list = append(list, BindingInfo{Name: name, Signature: sig})
+		}
+	}
+	return list
+}
+
 // ============================================================
 // MESSAGE HANDLING
 // ------------------------------------------------------------
@@ -137,17 +280,162 @@ func (b *Bridge) ListBindings() []string { return b.registry.List() }
 func (b *Bridge) HandleMessage(msgJSON string) string {
 	msg, err := FromJSON([]byte(msgJSON))
 	if err != nil {
+		b.logger.Error("failed to parse message", "error", err)
 		errMsg := NewErrorMessage("", ErrCodeUnknown, "failed to parse message", err.Error())
 		result, _ := errMsg.ToJSON()
 		return string(result)
 	}
+	if msg.Type == MessageTypeCall {
+		if !b.enterCall(msg.Method) {
+			return b.reject(msg, shuttingDown(msg), false)
+		}
+		defer b.exitCall()
+	}
+	return b.handle(msg, len(msgJSON), false)
+}
+
+// ============================================================
+// HandleMessageAsync() → Çağrıyı worker goroutine'de çalıştırır
+// ------------------------------------------------------------
+// HandleMessage, WebView'in binding callback'inde yani UI thread'inde
+// senkron çalışır; uzun süren bir binding (ör. demo'daki longTask) bu sürede
+// pencereyi dondurur. HandleMessageAsync çağrı mesajlarını bir goroutine'de
+// çalıştırır, cevabı reply ile iletir ve boş string döner. reply'ın cevabı
+// JS'e (window.__gomad_reply) UI thread'i üzerinden ulaştırması beklenir.
+// İmzalama açıksa her iki yoldan dönen cevaplar imzalıdır (bkz.
+// EnableMessageSigning).
+//
+// Çağrı dışındaki mesajlar ve inline(method) true dönen çağrılar (ör. UI
+// thread'i gerektiren yerleşik binding'ler) HandleMessage gibi senkron işlenir
+// ve cevap doğrudan döner. Origin ya da imza doğrulamasından geçemeyen
+// mesajlar (bkz. EnableOriginCheck, EnableMessageSigning), sayfaya açık
+// olmayan çağrılar (bkz. SetCapabilities) ve sınırları aşan çağrılar (bkz.
+// SetLimits) işlenmeden reddedilir. Drain başladıktan sonra gelen çağrılar
+// ErrCodeShuttingDown ile reddedilir.
+// ============================================================
+func (b *Bridge) HandleMessageAsync(msgJSON string, inline func(method string) bool, reply func(response string)) string {
+	response := b.handleMessageAsync(msgJSON, inline, func(response string) {
+		reply(b.sealResponse(response))
+	})
+	return b.sealResponse(response)
+}
+
+// handleMessageAsync, HandleMessageAsync'in gövdesidir; cevapları imzasız üretir.
+func (b *Bridge) handleMessageAsync(msgJSON string, inline func(method string) bool, reply func(response string)) string {
+	msg, err := FromJSON([]byte(msgJSON))
+	if err != nil {
+		return b.HandleMessage(msgJSON)
+	}
+	if rejected := b.admit(msg); rejected != nil {
+		return b.reject(msg, rejected, true)
+	}
+	if msg.Type != MessageTypeCall {
+		return b.handle(msg, len(msgJSON), false)
+	}
+
+	var lim *limiter
+	if !coreBinding(msg.Method) {
+		lim = b.currentLimiter()
+	}
+	if lim != nil && !lim.allow() {
+		return b.reject(msg, b.overloaded(lim, msg, "too many calls per second"), false)
+	}
+	// Çağrı kabul edildiği anda çalışan sayılır; goroutine başlamadan gelen
+	// bir Drain onu beklemeden geçmez
+	if !b.enterCall(msg.Method) {
+		return b.reject(msg, shuttingDown(msg), false)
+	}
+	if inline != nil && inline(msg.Method) {
+		defer b.exitCall()
+		return b.handle(msg, len(msgJSON), false)
+	}
+	wait, release := func() {}, func() {}
+	if lim != nil {
+		var ok bool
+		if wait, ok = lim.acquire(); !ok {
+			b.exitCall()
+			return b.reject(msg, b.overloaded(lim, msg, "too many pending calls"), false)
+		}
+		release = lim.release
+	}
+	go func() {
+		defer b.exitCall()
+		wait()
+		defer release()
+		reply(b.handle(msg, len(msgJSON), true))
+	}()
+	return ""
+}
+
+// reject() → Çalıştırılmayan bir çağrının hata cevabını denetim kaydına
+// bildirir ve JSON olarak döner. denied, doğrulamada reddedildiğini belirtir.
+func (b *Bridge) reject(msg, response *Message, denied bool) string {
+	b.notifyAudit(msg, response, 0, denied)
+	result, _ := response.ToJSON()
+	return string(result)
+}
+
+// admit() → Sayfadan gelen mesajı doğrular; reddedilirse hata cevabını döner.
+func (b *Bridge) admit(msg *Message) *Message {
+	if rejected := b.checkOrigin(msg); rejected != nil {
+		return rejected
+	}
+	if rejected := b.checkSignature(msg); rejected != nil {
+		return rejected
+	}
+	b.setPage(msg.Page)
+	return b.checkCapability(msg)
+}
 
+// handle() → Çözülmüş mesajı işler ve JS'e dönecek cevabı üretir.
+// journaled true ise çağrının cevabı günlüğe de yazılır (bkz. EnableJournal).
+func (b *Bridge) handle(msg *Message, size int, journaled bool) string {
 	var response *Message
+	b.notifyTraffic(DirectionIn, msg, 0)
+	b.logTraffic(DirectionIn, "", msg, 0)
 
 	switch msg.Type {
 	case MessageTypeCall:
 		// JS → Go fonksiyon çağrısı
-		response = b.registry.CallWithMessage(msg)
+		start := time.Now()
+		endSpan := b.startSpan(msg, start)
+		if limit := b.oversized(len(msg.Args)); limit > 0 {
+			response = b.payloadTooLarge(msg, "arguments", len(msg.Args), limit)
+		} else {
+			slowDone := b.watchSlowCall(msg, start)
+			response = b.call(msg)
+			slowDone()
+			if limit := b.oversized(len(response.Result)); limit > 0 {
+				response = b.payloadTooLarge(msg, "result", len(response.Result), limit)
+			}
+		}
+		elapsed := time.Since(start)
+		b.assignErrorRef(response)
+		endSpan(response)
+		b.logCall(msg, response, elapsed)
+		b.notifyTraffic(DirectionOut, response, elapsed)
+		b.logTraffic(DirectionOut, msg.Method, response, elapsed)
+		b.notifyAudit(msg, response, elapsed, false)
+
+		response = b.presentError(response)
+		full := response
+		if url := b.offload(response.Result); url != "" {
+			offloaded := *response
+			offloaded.Result, offloaded.Blob = nil, url
+			response = &offloaded
+		}
+		result, _ := response.ToJSON()
+		if journaled && b.journal.enabled() {
+			// Günlük, tek kullanımlık blob adresini değil verinin kendisini tutar
+			entry := result
+			if full != response {
+				entry, _ = full.ToJSON()
+			}
+			b.journal.recordResponse(msg.ID, entry)
+		}
+		b.stats.recordCall(size, len(result), elapsed, response.Type == MessageTypeError)
+		b.metrics.recordCall(msg.Method, elapsed, errorCode(response))
+		return string(result)
 
 	case MessageTypeResult, MessageTypeError:
 		// Go → JS async cevabı
@@ -157,12 +445,64 @@ func (b *Bridge) HandleMessage(msgJSON string) string {
 	default:
 		response = NewErrorMessage(msg.ID, ErrCodeUnknown,
 			fmt.Sprintf("unknown message type: %s", msg.Type), "")
+		b.notifyTraffic(DirectionOut, response, 0)
+		b.logTraffic(DirectionOut, "", response, 0)
 	}
 
 	result, _ := response.ToJSON()
 	return string(result)
 }
 
+// SetForwarder() → Yerelde kayıtlı olmayan çağrıları fn'e iletir.
+// ------------------------------------------------------------
+// Geliştirme modundaki hot-swap'te pencere süreci yalnızca yerleşik
+// binding'leri tutar; kullanıcı binding'leri ayrı bir backend sürecine
+// iletilir. fn, çağrı mesajını alır ve cevap (result ya da error) mesajını
+// döner. nil verilirse iletim kapanır.
+func (b *Bridge) SetForwarder(fn func(msg *Message) *Message) {
+	b.forwardMu.Lock()
+	b.forward = fn
+	b.forwardMu.Unlock()
+}
+
+// call() → Çağrıyı yerel registry'de ya da varsa forwarder'da çalıştırır.
+func (b *Bridge) call(msg *Message) *Message {
+	b.forwardMu.RLock()
+	forward := b.forward
+	b.forwardMu.RUnlock()
+	if forward != nil && !b.registry.Has(msg.Method) {
+		return forward(msg)
+	}
+	return b.registry.CallWithMessage(msg)
+}
+
+// logCall() → Tamamlanan bir çağrıyı loglar.
+// gomad.log çağrıları kendi içinde loglandığı için tekrar yazılmaz.
+func (b *Bridge) logCall(msg, response *Message, elapsed time.Duration) {
+	if msg.Method == LogBinding {
+		return
+	}
+	if response.Type == MessageTypeError && response.Error != nil {
+		args := []any{
+			"method", msg.Method,
+			"id", msg.ID,
+			"code", response.Error.Code,
+			"error", response.Error.Message,
+			"duration", elapsed,
+		}
+		if response.Error.Ref != "" {
+			// JS'e yalnızca ref gider (bkz. ErrorPolicyGeneric)
+			args = append(args, "ref", response.Error.Ref)
+		}
+		b.logger.Warn("bridge call failed", args...)
+		return
+	}
+	b.logger.Debug("bridge call",
+		"method", msg.Method,
+		"id", msg.ID,
+		"duration", elapsed)
+}
+
 // handlePendingResponse()
 // ------------------------------------------------------------
 // JS’e async fonksiyon göndermemiz durumunda gelen cevabı yakalar.
@@ -199,20 +539,121 @@ func (b *Bridge) handlePendingResponse(msg *Message) {
 // Socket gerekmez, WebView üzerinde uçtan uca data akışı.
 // ============================================================
 func (b *Bridge) Emit(event string, data interface{}) error {
-	msg, err := NewEventMessage(event, data)
+	msg, err := newEventMessage(event, data, b.registry.encoding.Load())
 	if err != nil {
 		return fmt.Errorf("failed to create event message: %w", err)
 	}
+	return b.emit(msg)
+}
+
+// emit() → Hazırlanmış olay mesajını sayfaya gönderir.
+func (b *Bridge) emit(msg *Message) error {
+	event := msg.Event
+	if !b.eventAllowed(event) {
+		// Mevcut sayfa bu olayı almaya yetkili değil (bkz. SetCapabilities)
+		return nil
+	}
+	if limit := b.oversized(len(msg.Data)); limit > 0 {
+		b.logger.Warn("bridge payload too large", "event", event, "size", len(msg.Data), "limit", limit)
+		return fmt.Errorf("%w: event %q is %s (limit %s)", ErrPayloadTooLarge, event,
+			formatBytes(int64(len(msg.Data))), formatBytes(limit))
+	}
 
-	msgJSON, err := msg.ToJSON()
+	wire := msg
+	if url := b.offload(msg.Data); url != "" {
+		offloaded := *msg
+		offloaded.Data, offloaded.Blob = nil, url
+		wire = &offloaded
+	}
+	seq := b.journal.nextSeq()
+	if seq > 0 {
+		stamped := *wire
+		stamped.Seq = seq
+		wire = &stamped
+	}
+	msgJSON, err := wire.ToJSON()
 	if err != nil {
 		return fmt.Errorf("failed to serialize event: %w", err)
 	}
+	entry := msgJSON
+	if seq > 0 && wire.Blob != "" {
+		// Günlük, tek kullanımlık blob adresini değil verinin kendisini tutar
+		full := *msg
+		full.Seq = seq
+		if entry, err = full.ToJSON(); err != nil {
+			return fmt.Errorf("failed to serialize event: %w", err)
+		}
+	}
+
+	js := eventScriptPrefix + string(msgJSON) + ")"
+	if err := b.evaluator.Eval(js); err != nil {
+		b.logger.Error("failed to emit event", "event", event, "error", err)
+		return err
+	}
+	if seq > 0 {
+		b.journal.recordEvent(seq, entry)
+	}
+	b.stats.recordEvent(len(js))
+	b.metrics.recordEvent(event)
+	b.notifyTraffic(DirectionOut, msg, 0)
+	b.logTraffic(DirectionOut, "", msg, 0)
+	return nil
+}
+
+// eventScriptPrefix, Emit'in ürettiği JS ifadesinin başıdır.
+const eventScriptPrefix = "window.gomad && window.gomad._handleEvent("
+
+// ParseEventScript() → Emit'in evaluator'a verdiği JS'ten olay mesajını çıkarır.
+// ------------------------------------------------------------
+// JS motoru olmayan evaluator'lar (ör. headless mod) olayları bu sayede
+// çözümler. Script bir olay değilse ok=false döner.
+func ParseEventScript(js string) (msg *Message, ok bool) {
+	if !strings.HasPrefix(js, eventScriptPrefix) || !strings.HasSuffix(js, ")") {
+		return nil, false
+	}
+	msg, err := FromJSON([]byte(js[len(eventScriptPrefix) : len(js)-1]))
+	if err != nil || msg.Type != MessageTypeEvent {
+		return nil, false
+	}
+	return msg, true
+}
 
-	js := fmt.Sprintf("window.gomad && window.gomad._handleEvent(%s)", string(msgJSON))
-	return b.evaluator.Eval(js)
+// CoalesceScripts() → Aynı turda biriken scriptleri birleştirir.
+// ------------------------------------------------------------
+// Art arda gelen Emit scriptleri tek bir window.gomad._dispatch([...])
+// çağrısına dönüşür; JS tarafı olayları dizideki sırayla dağıtır. Olay
+// olmayan scriptler olduğu gibi, aradaki sıraları korunarak döner. Yüksek
+// frekanslı olaylarda (ilerleme çubukları, telemetri grafikleri) her olay
+// için ayrı bir Eval ve JS ayrıştırma maliyeti ödenmez.
+func CoalesceScripts(scripts []string) []string {
+	out := make([]string, 0, len(scripts))
+	var events []string
+	flush := func() {
+		switch len(events) {
+		case 0:
+			return
+		case 1:
+			out = append(out, eventScriptPrefix+events[0]+")")
+		default:
+			out = append(out, dispatchScriptPrefix+strings.Join(events, ",")+"])")
+		}
+		events = events[:0]
+	}
+	for _, js := range scripts {
+		if strings.HasPrefix(js, eventScriptPrefix) && strings.HasSuffix(js, ")") {
+			events = append(events, js[len(eventScriptPrefix):len(js)-1])
+			continue
+		}
+		flush()
+		out = append(out, js)
+	}
+	flush()
+	return out
 }
 
+// dispatchScriptPrefix, CoalesceScripts'in birleştirdiği olayların başıdır.
+const dispatchScriptPrefix = "window.gomad && window.gomad._dispatch(["
+
 // ============================================================
 // INIT() — Köprünün JS Kodunu WebView'e Enjekte Eder
 // ------------------------------------------------------------
@@ -234,9 +675,94 @@ func (b *Bridge) Init() error {
 	}
 
 	b.initialized = true
+	b.logger.Debug("bridge initialized")
 	return nil
 }
 
+// ============================================================
+// FRONTEND READY HANDSHAKE
+// ------------------------------------------------------------
+// Angular uygulaması bootstrap işlemini bitirip gomad.on(...) ile
+// dinleyicilerini kaydettikten sonra:
+//
+//	await window.gomad.ready()
+//
+// çağırır. Go tarafı bu sinyali aldığında başlangıç state'ini Emit etmek
+// artık güvenlidir — JS dinleyicileri kaçırmaz.
+//
+// Sayfa yeniden yüklenirse ready() tekrar çağrılır ve callback'ler
+// yeniden çalışır; böylece yeni sayfa da başlangıç verisini alır.
+// ============================================================
+
+// OnFrontendReady() → ready sinyalinde çalışacak callback ekler.
+func (b *Bridge) OnFrontendReady(fn func()) {
+	if fn == nil {
+		return
+	}
+	b.readyMu.Lock()
+	b.readyHandlers = append(b.readyHandlers, fn)
+	b.readyMu.Unlock()
+}
+
+// IsFrontendReady() → Frontend en az bir kez ready() çağırdı mı?
+func (b *Bridge) IsFrontendReady() bool {
+	b.readyMu.RLock()
+	defer b.readyMu.RUnlock()
+	return b.frontendReady
+}
+
+// handleFrontendReady() → gomad.ready binding'inin Go karşılığı.
+// Handler listesi kopyalanarak kilit dışında çalıştırılır; böylece
+// callback içinden tekrar OnFrontendReady çağrılması deadlock üretmez.
+func (b *Bridge) handleFrontendReady() {
+	b.readyMu.Lock()
+	b.frontendReady = true
+	handlers := make([]func(), len(b.readyHandlers))
+	copy(handlers, b.readyHandlers)
+	b.readyMu.Unlock()
+
+	for _, fn := range handlers {
+		fn()
+	}
+}
+
+// ============================================================
+// FRONTEND LOG FORWARDING
+// ------------------------------------------------------------
+// JS tarafı:
+//
+//	gomad.log.info("user saved", {id: 42})
+//	gomad.log.error("render failed", err)
+//
+// Bu mesajlar Go'daki aynı slog akışına "source=frontend" ile düşer;
+// böylece backend ve UI logları tek dosyada, tek formatta okunur.
+// ============================================================
+
+// handleFrontendLog() → gomad.log binding'inin Go karşılığı.
+func (b *Bridge) handleFrontendLog(level, message string, attrs map[string]interface{}) {
+	args := make([]any, 0, len(attrs)*2+2)
+	args = append(args, "source", "frontend")
+	for k, v := range attrs {
+		args = append(args, k, v)
+	}
+	b.logger.Log(context.Background(), parseLogLevel(level), message, args...)
+}
+
+// parseLogLevel() → JS seviye adını slog.Level'a çevirir.
+// Bilinmeyen seviyeler Info kabul edilir.
+func parseLogLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug", "trace":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
 // IsInitialized() → Bridge aktif mi?
 func (b *Bridge) IsInitialized() bool {
 	b.initMu.RLock()
@@ -271,6 +797,96 @@ const JSBridgeCode = `
     // Event listeners
     const eventListeners = new Map();
     
+    // Last values of sticky events (see Bridge.EmitSticky)
+    const stickyValues = new Map();
+    
+    // Message journal (see Bridge.EnableJournal): the last event sequence
+    // and the calls still pending survive a reload in sessionStorage
+    const journalKey = 'gomad.journal';
+    let journalSeq = 0;
+    let firstLiveSeq = 0;
+    let journalResumed = false;
+    // Taken before this page's own events overwrite it
+    let journalSaved = null;
+    try {
+        journalSaved = JSON.parse(sessionStorage.getItem(journalKey) || 'null');
+        sessionStorage.removeItem(journalKey);
+    } catch (e) {}
+    // Events only schedule a write; a burst of events costs one write
+    let journalTimer = 0;
+    function scheduleJournal() {
+        if (!journalTimer) journalTimer = setTimeout(saveJournal, 250);
+    }
+    function saveJournal() {
+        clearTimeout(journalTimer);
+        journalTimer = 0;
+        try {
+            const calls = [];
+            pendingCalls.forEach((p, id) => calls.push({ id: id, method: p.method }));
+            sessionStorage.setItem(journalKey, JSON.stringify({ seq: journalSeq, calls: calls }));
+        } catch (e) {}
+    }
+    function resumeJournal() {
+        if (journalResumed) return;
+        journalResumed = true;
+        const saved = journalSaved;
+        journalSaved = null;
+        if (!saved || (!saved.seq && !(saved.calls && saved.calls.length))) return;
+        const methods = new Map((saved.calls || []).map(c => [c.id, c.method]));
+        window.gomad.call('gomad.journal.resume', saved.seq || 0, Array.from(methods.keys())).then(r => {
+            // Events that already arrived live on this page are not repeated
+            const events = (r.events || []).filter(m => !firstLiveSeq || m.seq < firstLiveSeq);
+            events.forEach(m => window.gomad._handleEvent(m));
+            const calls = (r.responses || []).map(m => ({
+                id: m.id,
+                method: methods.get(m.id),
+                result: m.type === 'result' ? m.result : undefined,
+                error: m.type === 'error' ? m.error : undefined
+            }));
+            dispatchEvent('gomad:recovered', { events: events.length, calls: calls, complete: !!r.complete });
+        }).catch(e => console.warn('GOMAD: journal resume failed:', e));
+    }
+    window.addEventListener('pagehide', () => {
+        if (journalSeq || pendingCalls.size) saveJournal();
+    });
+    window.addEventListener('load', () => setTimeout(resumeJournal, 0), { once: true });
+    
+    // Stream listeners and open streams (Go → JS, see Bridge.SendStream)
+    const streamListeners = new Map();
+    const streams = new Map();
+    
+    // Large payloads (Message.blob) are fetched from the loopback endpoint
+    function fetchBlob(url) {
+        return fetch(url).then(r => {
+            if (!r.ok) throw new Error('GOMAD: payload unavailable (' + r.status + ')');
+            return r.json();
+        });
+    }
+    
+    // Ordered delivery of events while blob events are in flight
+    let eventQueue = Promise.resolve();
+    let blobEvents = 0;
+    function dispatchEvent(event, data, sticky) {
+        if (sticky) stickyValues.set(event, data);
+        const listeners = eventListeners.get(event);
+        if (listeners) {
+            listeners.slice().forEach(callback => {
+                try {
+                    callback(data);
+                } catch (e) {
+                    console.error('GOMAD: Event listener error:', e);
+                }
+            });
+        }
+    }
+    
+    // Call metadata provider (see setMetaProvider)
+    let metaProvider = null;
+    
+    // Bridge health counters for developer tools (see _health)
+    const health = { calls: 0, events: 0, errors: [] };
+    const maxHealthErrors = 10;
+    
     // Generate unique ID
     let callIdCounter = 0;
     function generateId() {
@@ -294,7 +910,8 @@ const JSBridgeCode = `
                     timestamp: Date.now()
                 };
                 
-                pendingCalls.set(id, { resolve, reject });
+                pendingCalls.set(id, { resolve, reject, method });
+                health.calls++;
                 
                 // Send to Go
                 // WebView kütüphanesine göre bu değişebilir
@@ -316,6 +933,57 @@ const JSBridgeCode = `
             });
         },
         
+        // Attach metadata (e.g. W3C trace context) to every call
+        // Usage: window.gomad.setMetaProvider(() => ({ traceparent: currentTraceparent() }));
+        setMetaProvider: function(fn) {
+            metaProvider = typeof fn === 'function' ? fn : null;
+        },
+        
+        _meta: function() {
+            if (!metaProvider) return undefined;
+            try {
+                const meta = metaProvider();
+                return meta && typeof meta === 'object' ? meta : undefined;
+            } catch (e) {
+                console.error('GOMAD: Meta provider error:', e);
+                return undefined;
+            }
+        },
+        
+        // Internal: Bridge health for developer tools (debug overlay)
+        // calls and events are running totals; errors are the most recent failures
+        _health: function() {
+            return { pending: pendingCalls.size, calls: health.calls, events: health.events, errors: health.errors.slice() };
+        },
+        
+        // Signal Go that the frontend is bootstrapped and listening
+        // Usage: await window.gomad.ready();
+        ready: function() {
+            const ready = window.gomad.call('gomad.ready');
+            // Subscribers are attached now; replay what a reload lost
+            resumeJournal();
+            return ready;
+        },
+        
+        // Forward logs into the Go log stream
+        // Usage: window.gomad.log.info("message", { key: "value" });
+        log: (function() {
+            function send(level) {
+                return function(message, attrs) {
+                    if (typeof message !== 'string') {
+                        try { message = JSON.stringify(message); } catch (e) { message = String(message); }
+                    }
+                    if (attrs instanceof Error) {
+                        attrs = { error: attrs.message, stack: attrs.stack };
+                    } else if (attrs === undefined || attrs === null || typeof attrs !== 'object') {
+                        attrs = attrs === undefined ? {} : { value: attrs };
+                    }
+                    return window.gomad.call('gomad.log', level, message, attrs).catch(() => {});
+                };
+            }
+            return { debug: send('debug'), info: send('info'), warn: send('warn'), error: send('error') };
+        })(),
+        
         // Subscribe to an event
         // Usage: window.gomad.on("eventName", (data) => { ... });
         on: function(event, callback) {
@@ -324,6 +992,21 @@ const JSBridgeCode = `
             }
             eventListeners.get(event).push(callback);
             
+            // A sticky event that already fired is delivered right away
+            // (asynchronously, like any other event)
+            if (stickyValues.has(event)) {
+                const value = stickyValues.get(event);
+                Promise.resolve().then(() => {
+                    const listeners = eventListeners.get(event);
+                    if (!listeners || listeners.indexOf(callback) === -1) return;
+                    try {
+                        callback(value);
+                    } catch (e) {
+                        console.error('GOMAD: Event listener error:', e);
+                    }
+                });
+            }
+            
             // Return unsubscribe function
             return () => {
                 const listeners = eventListeners.get(event);
@@ -351,7 +1034,91 @@ const JSBridgeCode = `
             }
         },
         
-        // Internal: Handle response from Go
+        // Receive a Go stream (Bridge.SendStream) as a ReadableStream of Uint8Array chunks
+        // Usage: window.gomad.onStream("export", async (stream) => { for await (const chunk of stream) { ... } });
+        onStream: function(name, callback) {
+            streamListeners.set(name, callback);
+            return () => {
+                if (streamListeners.get(name) === callback) {
+                    streamListeners.delete(name);
+                }
+            };
+        },
+        
+        // Internal: Go opened a stream; each pull asks Go for exactly one chunk
+        _streamOpen: function(name, id) {
+            const callback = streamListeners.get(name);
+            if (!callback) {
+                window.gomad.call('gomad.stream.cancel', id, 'no listener for stream ' + name).catch(() => {});
+                return;
+            }
+            const state = { controller: null, waiting: null };
+            const stream = new ReadableStream({
+                start(controller) {
+                    state.controller = controller;
+                },
+                pull() {
+                    return new Promise((resolve) => {
+                        state.waiting = resolve;
+                        window.gomad.call('gomad.stream.pull', id).catch(() => {});
+                    });
+                },
+                cancel(reason) {
+                    streams.delete(id);
+                    return window.gomad.call('gomad.stream.cancel', id, String(reason || 'canceled')).catch(() => {});
+                }
+            }, { highWaterMark: 4 });
+            streams.set(id, state);
+            try {
+                callback(stream);
+            } catch (e) {
+                console.error('GOMAD: Stream listener error:', e);
+            }
+        },
+        
+        // Internal: Stream chunk from Go (base64)
+        _streamChunk: function(id, base64) {
+            const state = streams.get(id);
+            if (!state) return;
+            const binary = atob(base64);
+            const bytes = new Uint8Array(binary.length);
+            for (let i = 0; i < binary.length; i++) {
+                bytes[i] = binary.charCodeAt(i);
+            }
+            state.controller.enqueue(bytes);
+            const resolve = state.waiting;
+            state.waiting = null;
+            if (resolve) resolve();
+        },
+        
+        // Internal: Stream finished
+        _streamClose: function(id) {
+            const state = streams.get(id);
+            if (!state) return;
+            streams.delete(id);
+            state.controller.close();
+            if (state.waiting) state.waiting();
+        },
+        
+        // Internal: Stream failed on the Go side
+        _streamError: function(id, message) {
+            const state = streams.get(id);
+            if (!state) return;
+            streams.delete(id);
+            state.controller.error(new Error(message));
+            if (state.waiting) state.waiting();
+        },
+        
+        // Internal: Register a pending call whose response arrives later (see window.__gomad_reply)
+        _expect: function(id, method) {
+            health.calls++;
+            return new Promise((resolve, reject) => {
+                pendingCalls.set(id, { resolve, reject, method });
+            });
+        },
+        
+        // Internal: Handle response from Go. The init script takes this
+        // handler and removes it, so page scripts cannot forge responses
         _handleResponse: function(msgJson) {
             try {
                 const msg = typeof msgJson === 'string' ? JSON.parse(msgJson) : msgJson;
@@ -363,10 +1130,18 @@ const JSBridgeCode = `
                 
                 pendingCalls.delete(msg.id);
                 
-                if (msg.type === 'error') {
+                if (msg.type === 'result' && msg.blob) {
+                    // Large result served over loopback (Bridge.SetLargePayloadThreshold)
+                    fetchBlob(msg.blob).then(pending.resolve, pending.reject);
+                } else if (msg.type === 'error') {
+                    health.errors.push({ method: pending.method, code: msg.error.code, message: msg.error.message, time: Date.now() });
+                    if (health.errors.length > maxHealthErrors) health.errors.shift();
                     const error = new Error(msg.error.message);
                     error.code = msg.error.code;
                     error.details = msg.error.details;
+                    if (msg.error.name) error.codeName = msg.error.name;
+                    if (msg.error.ref) error.ref = msg.error.ref;
+                    if (msg.error.fields) error.fields = msg.error.fields;
                     pending.reject(error);
                 } else if (msg.type === 'result') {
                     pending.resolve(msg.result);
@@ -377,23 +1152,45 @@ const JSBridgeCode = `
         },
         
         // Internal: Handle event from Go
+        // Batched events from Go (see CoalesceScripts), in emit order
+        _dispatch: function(messages) {
+            for (let i = 0; i < messages.length; i++) {
+                window.gomad._handleEvent(messages[i]);
+            }
+        },
+        
+        // Internal: Go dropped a sticky value (see Bridge.ClearSticky)
+        _clearSticky: function(event) {
+            stickyValues.delete(event);
+        },
+        
         _handleEvent: function(msgJson) {
             try {
                 const msg = typeof msgJson === 'string' ? JSON.parse(msgJson) : msgJson;
                 
                 if (msg.type !== 'event' || !msg.event) return;
+                health.events++;
+                if (msg.seq) {
+                    if (!firstLiveSeq) firstLiveSeq = msg.seq;
+                    if (msg.seq > journalSeq) {
+                        journalSeq = msg.seq;
+                        scheduleJournal();
+                    }
+                }
                 
-                const listeners = eventListeners.get(msg.event);
-                if (listeners) {
-                    const data = msg.data;
-                    listeners.forEach(callback => {
-                        try {
-                            callback(data);
-                        } catch (e) {
-                            console.error('GOMAD: Event listener error:', e);
-                        }
-                    });
+                // Events stay in order: while a large (blob) event is being
+                // fetched, later events wait behind it
+                if (msg.blob || blobEvents > 0) {
+                    const data = msg.blob ? fetchBlob(msg.blob) : Promise.resolve(msg.data);
+                    blobEvents++;
+                    eventQueue = eventQueue
+                        .then(() => data)
+                        .then(value => dispatchEvent(msg.event, value, msg.sticky))
+                        .catch(e => console.error('GOMAD: Failed to fetch event data:', e))
+                        .finally(() => { blobEvents--; });
+                    return;
                 }
+                dispatchEvent(msg.event, msg.data, msg.sticky);
             } catch (e) {
                 console.error('GOMAD: Failed to handle event:', e);
             }
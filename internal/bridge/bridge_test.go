@@ -0,0 +1,201 @@
+package bridge
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// nopEvaluator, JS'i çalıştırmadan kabul eder.
+type nopEvaluator struct{}
+
+func (nopEvaluator) Eval(string) error { return nil }
+
+// recordEvaluator, verilen scriptleri sırayla saklar.
+type recordEvaluator struct {
+	mu      sync.Mutex
+	scripts []string
+}
+
+func (e *recordEvaluator) Eval(js string) error {
+	e.mu.Lock()
+	e.scripts = append(e.scripts, js)
+	e.mu.Unlock()
+	return nil
+}
+
+// events, Emit ile gönderilen olay mesajlarını döner.
+func (e *recordEvaluator) events() []*Message {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var out []*Message
+	for _, js := range e.scripts {
+		if msg, ok := ParseEventScript(js); ok {
+			out = append(out, msg)
+		}
+	}
+	return out
+}
+
+// callJSON, sayfanın göndereceği çağrı mesajını üretir.
+func callJSON(t testing.TB, id, method string, args ...interface{}) string {
+	t.Helper()
+	if args == nil {
+		args = []interface{}{}
+	}
+	msg, err := NewCallMessage(id, method, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msg.ToJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+// parseResponse, köprünün döndürdüğü cevabı çözer.
+func parseResponse(t testing.TB, response string) *Message {
+	t.Helper()
+	msg, err := FromJSON([]byte(response))
+	if err != nil {
+		t.Fatalf("invalid response %q: %v", response, err)
+	}
+	return msg
+}
+
+// wantResult, cevabın id'si verilen başarılı bir sonuç olduğunu doğrular.
+func wantResult(t testing.TB, msg *Message, id string, want interface{}) {
+	t.Helper()
+	if msg.Type != MessageTypeResult || msg.ID != id {
+		t.Fatalf("response = %+v (error %+v), want result for %s", msg, msg.Error, id)
+	}
+	got := reflect.New(reflect.TypeOf(want))
+	if err := json.Unmarshal(msg.Result, got.Interface()); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Elem().Interface(), want) {
+		t.Fatalf("result = %v, want %v", got.Elem().Interface(), want)
+	}
+}
+
+// wantError, cevabın verilen koddaki bir hata olduğunu doğrular.
+func wantError(t testing.TB, msg *Message, code int) {
+	t.Helper()
+	if msg.Type != MessageTypeError || msg.Error == nil || msg.Error.Code != code {
+		t.Fatalf("response = %+v (error %+v), want error code %d", msg, msg.Error, code)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	b := NewBridge(nopEvaluator{})
+	if err := b.Bind("zeta", func(a, b int) (int, error) { return a + b, nil }); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Bind("alpha", func(s string) string { return s }); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []BindingInfo
+	for _, info := range b.Describe() {
+		if info.Name == "alpha" || info.Name == "zeta" {
+			got = append(got, info)
+		}
+	}
+	want := []BindingInfo{
+		{Name: "alpha", Signature: "func(string) string"},
+		{Name: "zeta", Signature: "func(int, int) (int, error)"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Describe() = %+v, want %+v", got, want)
+	}
+}
+
+func TestHandleMessage(t *testing.T) {
+	b := NewBridge(nopEvaluator{})
+	if err := b.Bind("add", func(a, b int) int { return a + b }); err != nil {
+		t.Fatal(err)
+	}
+
+	wantResult(t, parseResponse(t, b.HandleMessage(callJSON(t, "1", "add", 2, 3))), "1", 5)
+	wantError(t, parseResponse(t, b.HandleMessage(callJSON(t, "2", "missing"))), ErrCodeMethodNotFound)
+	wantError(t, parseResponse(t, b.HandleMessage("{")), ErrCodeUnknown)
+
+	// Handler'ın döndüğü ErrNotFound metodun yokluğu anlamına gelmez
+	if err := b.Bind("lookup", func(key string) error {
+		return gomerrors.NewOperationError("lookup", key, gomerrors.ErrNotFound)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	wantError(t, parseResponse(t, b.HandleMessage(callJSON(t, "3", "lookup", "x"))), ErrCodeExecution)
+}
+
+// asyncReplies, HandleMessageAsync'in reply ile ilettiği cevapları toplar.
+func asyncReplies() (reply func(string), next func(t *testing.T) *Message) {
+	ch := make(chan string, 16)
+	return func(s string) { ch <- s }, func(t *testing.T) *Message {
+		t.Helper()
+		select {
+		case s := <-ch:
+			return parseResponse(t, s)
+		case <-time.After(5 * time.Second):
+			t.Fatal("no reply")
+			return nil
+		}
+	}
+}
+
+func TestHandleMessageAsyncWorker(t *testing.T) {
+	b := NewBridge(nopEvaluator{})
+	release := make(chan struct{})
+	if err := b.Bind("slow", func() string { <-release; return "done" }); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Bind("fast", func() string { return "fast" }); err != nil {
+		t.Fatal(err)
+	}
+	reply, next := asyncReplies()
+
+	// Uzun süren çağrı worker'da çalışır; çağıran (UI thread) beklemez
+	if got := b.HandleMessageAsync(callJSON(t, "1", "slow"), nil, reply); got != "" {
+		t.Fatalf("worker call returned %q, want empty", got)
+	}
+	if got := b.HandleMessageAsync(callJSON(t, "2", "fast"), nil, reply); got != "" {
+		t.Fatalf("worker call returned %q, want empty", got)
+	}
+	wantResult(t, next(t), "2", "fast")
+
+	close(release)
+	wantResult(t, next(t), "1", "done")
+}
+
+func TestHandleMessageAsyncInline(t *testing.T) {
+	b := NewBridge(nopEvaluator{})
+	if err := b.Bind("ui", func() string { return "inline" }); err != nil {
+		t.Fatal(err)
+	}
+	reply := func(string) { t.Error("inline call must not reply asynchronously") }
+	inline := func(method string) bool { return method == "ui" }
+
+	wantResult(t, parseResponse(t, b.HandleMessageAsync(callJSON(t, "1", "ui"), inline, reply)), "1", "inline")
+
+	// Çağrı olmayan mesajlar da senkron işlenir
+	if got := b.HandleMessageAsync(`{"id":"x","type":"result","result":1}`, nil, reply); got != "" {
+		t.Fatalf("result message returned %q, want empty", got)
+	}
+	wantError(t, parseResponse(t, b.HandleMessageAsync("{", nil, reply)), ErrCodeUnknown)
+}
+
+func TestHandleMessageAsyncPanic(t *testing.T) {
+	b := NewBridge(nopEvaluator{})
+	if err := b.Bind("boom", func() string { panic("boom") }); err != nil {
+		t.Fatal(err)
+	}
+	reply, next := asyncReplies()
+	b.HandleMessageAsync(callJSON(t, "1", "boom"), nil, reply)
+	wantError(t, next(t), ErrCodeExecution)
+}
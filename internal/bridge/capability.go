@@ -0,0 +1,168 @@
+package bridge
+
+import (
+	"strings"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// ============================================================
+// CAPABILITY — Sayfa başına binding ve olay izinleri
+// ------------------------------------------------------------
+// Origin doğrulaması (bkz. EnableOriginCheck) bir sayfanın köprüyü hiç
+// kullanıp kullanamayacağını belirler. Capability'ler ise izin verilen bir
+// sayfanın neleri görebileceğini daraltır: pencere güvenilmeyen ya da uzak
+// içerik gösterdiğinde o sayfa yalnızca kendisi için bildirilen binding'leri
+// çağırabilir ve yalnızca bildirilen olayları alır. Listede olmayan
+// binding'ler kayıtlı değilmiş gibi ErrCodeMethodNotFound döner.
+//
+// Sayfa, her mesajdaki Page alanından (origin + yol) öğrenilir; init scripti
+// her yüklemede gomad.page ile sayfayı hemen bildirir. Kural tanımlandığında
+// hiçbir kurala uymayan sayfalar yalnızca temel yerleşikleri çağırabilir ve
+// WillQuitEvent dışında olay almaz; uygulamanın kendi sayfaları için tam
+// erişim isteniyorsa açık bir kural (ör. Pages: "*", Bindings: "*", Events:
+// "*" en sonda) eklenmelidir.
+// ============================================================
+
+// PageBinding, init scriptinin her sayfa yüklemesinde mevcut sayfayı
+// bildirdiği yerleşik fonksiyondur (bkz. Capability).
+const PageBinding = "gomad.page"
+
+// WillQuitEvent ve QuitReadyBinding, düzgün kapanışın sayfa tarafıdır:
+// kapanışta olay gönderilir, sayfa onWillQuit dinleyicileri bitince binding'i
+// çağırır. Capability'lerden bağımsız olarak her sayfaya açıktır; aksi
+// hâlde sınırlı sayfalar kapanışı zaman aşımına kadar bekletir.
+const (
+	WillQuitEvent    = "app:will-quit"
+	QuitReadyBinding = "gomad.app.quitReady"
+)
+
+// Capability, bir URL desenine uyan sayfaların erişebileceği binding ve
+// olayları tanımlar.
+//
+// Desenlerde "*" herhangi bir karakter dizisine uyar:
+//
+//	bridge.Capability{
+//	    Pages:    "https://docs.example.com/*",
+//	    Bindings: []string{"search", "gomad.clipboard.*"},
+//	    Events:   []string{"theme:*"},
+//	}
+type Capability struct {
+	// Pages, sayfa adresinin (origin + yol, sorgu ve # hariç) deseni.
+	Pages string
+
+	// Bindings, sayfanın çağırabileceği binding desenleri. Köprünün temel
+	// yerleşikleri (gomad.ready, gomad.log, gomad.page, akışlar,
+	// gomad.app.quitReady) her zaman çağrılabilir.
+	Bindings []string
+
+	// Events, sayfaya iletilecek olay (ve akış) adı desenleri.
+	Events []string
+}
+
+// SetCapabilities() → Sayfa başına izinleri ayarlar; ilk uyan kural geçerlidir,
+// hiçbir kurala uymayan sayfalar yalnızca temel yerleşikleri çağırabilir.
+// nil verilirse tüm sayfalar sınırsızdır.
+func (b *Bridge) SetCapabilities(caps []Capability) {
+	b.capMu.Lock()
+	b.caps = append([]Capability(nil), caps...)
+	b.capMu.Unlock()
+}
+
+// setPage() → Mesajın geldiği sayfayı mevcut sayfa olarak kaydeder.
+func (b *Bridge) setPage(page string) {
+	if page == "" {
+		return
+	}
+	b.capMu.Lock()
+	b.page = page
+	b.capMu.Unlock()
+}
+
+// capabilityFor() → Sayfaya uyan ilk kuralı döner. Hiç kural yoksa
+// restricted false'tur (sınırsız); kural var ama sayfa hiçbirine uymuyorsa
+// boş bir kural döner. capMu tutulmalıdır.
+func (b *Bridge) capabilityFor(page string) (c *Capability, restricted bool) {
+	if len(b.caps) == 0 {
+		return nil, false
+	}
+	for i := range b.caps {
+		if matchPattern(b.caps[i].Pages, page) {
+			return &b.caps[i], true
+		}
+	}
+	// Kural tanımlı ama sayfa hiçbirine uymuyor: varsayılan olarak reddedilir
+	return &Capability{}, true
+}
+
+// checkCapability() → Çağrı sayfaya açık değilse JS'e dönecek hata cevabını
+// döner; açıksa nil.
+func (b *Bridge) checkCapability(msg *Message) *Message {
+	if msg.Type != MessageTypeCall || coreBinding(msg.Method) {
+		return nil
+	}
+	b.capMu.RLock()
+	c, restricted := b.capabilityFor(msg.Page)
+	allowed := !restricted || matchAny(c.Bindings, msg.Method)
+	b.capMu.RUnlock()
+	if allowed {
+		return nil
+	}
+
+	b.logger.Warn("binding not exposed to page", "page", msg.Page, "method", msg.Method)
+	// Kayıtlı olmayan bir binding ile aynı cevap; sayfa varlığını öğrenemez
+	err := gomerrors.NewBindingError(msg.Method, "not found", gomerrors.ErrNotFound)
+	response := NewErrorMessage(msg.ID, ErrCodeMethodNotFound, err.Error(), "")
+	return response
+}
+
+// eventAllowed() → Olayın mevcut sayfaya iletilip iletilmeyeceğini döner.
+// WillQuitEvent her sayfaya iletilir.
+func (b *Bridge) eventAllowed(event string) bool {
+	if event == WillQuitEvent {
+		return true
+	}
+	b.capMu.RLock()
+	defer b.capMu.RUnlock()
+	c, restricted := b.capabilityFor(b.page)
+	return !restricted || matchAny(c.Events, event)
+}
+
+// coreBinding, her sayfanın köprüyü kullanabilmesi için gereken yerleşiklerdir.
+func coreBinding(method string) bool {
+	switch method {
+	case ReadyBinding, LogBinding, PageBinding, NavigationBinding, StreamPullBinding, StreamCancelBinding, QuitReadyBinding:
+		return true
+	}
+	return false
+}
+
+// matchAny, s'nin desenlerden birine uyup uymadığını döner.
+func matchAny(patterns []string, s string) bool {
+	for _, p := range patterns {
+		if matchPattern(p, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPattern, "*" joker karakterli deseni s ile eşleştirir.
+func matchPattern(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+	return strings.HasSuffix(s, parts[len(parts)-1])
+}
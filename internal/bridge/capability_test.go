@@ -0,0 +1,117 @@
+package bridge
+
+import "testing"
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern, s string
+		want       bool
+	}{
+		{"search", "search", true},
+		{"search", "searchAll", false},
+		{"gomad.clipboard.*", "gomad.clipboard.read", true},
+		{"gomad.clipboard.*", "gomad.fs.read", false},
+		{"https://docs.example.com/*", "https://docs.example.com/guide/intro", true},
+		{"https://*.example.com/*", "https://api.example.com/v1", true},
+		{"https://*.example.com/*", "https://example.org/", false},
+		{"*", "", true},
+	}
+	for _, tt := range tests {
+		if got := matchPattern(tt.pattern, tt.s); got != tt.want {
+			t.Errorf("matchPattern(%q, %q) = %v, want %v", tt.pattern, tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	ev := &recordEvaluator{}
+	b := NewBridge(ev)
+	for _, name := range []string{"search", "save"} {
+		if err := b.Bind(name, func() string { return "ok" }); err != nil {
+			t.Fatal(err)
+		}
+	}
+	b.SetCapabilities([]Capability{{
+		Pages:    "https://docs.example.com/*",
+		Bindings: []string{"search"},
+		Events:   []string{"theme:*"},
+	}})
+	ui := func(string) bool { return true }
+	call := func(id, method, page string) *Message {
+		msg := pageCallJSON(t, id, method, func(m *Message) { m.Page = page })
+		return parseResponse(t, b.HandleMessageAsync(msg, ui, nil))
+	}
+
+	// Kurala uymayan sayfalar varsayılan olarak reddedilir
+	wantError(t, call("1", "save", "http://localhost/index.html"), ErrCodeMethodNotFound)
+
+	wantResult(t, call("2", "search", "https://docs.example.com/guide"), "2", "ok")
+	wantError(t, call("3", "save", "https://docs.example.com/guide"), ErrCodeMethodNotFound)
+	wantError(t, call("4", "missing", "https://docs.example.com/guide"), ErrCodeMethodNotFound)
+
+	// Olaylar son mesajın geldiği sayfanın kuralına göre süzülür
+	if err := b.Emit("theme:changed", "dark"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Emit("user:changed", "x"); err != nil {
+		t.Fatal(err)
+	}
+	events := ev.events()
+	if len(events) != 1 || events[0].Event != "theme:changed" {
+		t.Fatalf("events = %+v, want only theme:changed", events)
+	}
+}
+
+func TestCapabilitiesAllowShutdown(t *testing.T) {
+	ev := &recordEvaluator{}
+	b := NewBridge(ev)
+	if err := b.Bind(QuitReadyBinding, func() bool { return true }); err != nil {
+		t.Fatal(err)
+	}
+	b.SetCapabilities([]Capability{{Pages: "https://docs.example.com/*", Bindings: []string{"search"}}})
+	ui := func(string) bool { return true }
+
+	// Sınırlı sayfa kapanış olayını alır ve hazır olduğunu bildirebilir
+	msg := pageCallJSON(t, "1", QuitReadyBinding, func(m *Message) { m.Page = "https://docs.example.com/guide" })
+	wantResult(t, parseResponse(t, b.HandleMessageAsync(msg, ui, nil)), "1", true)
+	if err := b.Emit(WillQuitEvent, nil); err != nil {
+		t.Fatal(err)
+	}
+	if events := ev.events(); len(events) != 1 || events[0].Event != WillQuitEvent {
+		t.Fatalf("events = %+v, want %s", events, WillQuitEvent)
+	}
+}
+
+func TestCapabilitiesDenyUnmatchedPage(t *testing.T) {
+	ev := &recordEvaluator{}
+	b := NewBridge(ev)
+	for _, name := range []string{"search", QuitReadyBinding} {
+		if err := b.Bind(name, func() bool { return true }); err != nil {
+			t.Fatal(err)
+		}
+	}
+	b.SetCapabilities([]Capability{{Pages: "https://docs.example.com/*", Bindings: []string{"*"}, Events: []string{"*"}}})
+	ui := func(string) bool { return true }
+	call := func(id, method, page string) *Message {
+		msg := pageCallJSON(t, id, method, func(m *Message) { m.Page = page })
+		return parseResponse(t, b.HandleMessageAsync(msg, ui, nil))
+	}
+
+	// Uymayan sayfa yalnızca temel yerleşikleri çağırabilir ve olay almaz
+	wantError(t, call("1", "search", "https://evil.example.com/"), ErrCodeMethodNotFound)
+	wantResult(t, call("2", QuitReadyBinding, "https://evil.example.com/"), "2", true)
+	if err := b.Emit("user:changed", "x"); err != nil {
+		t.Fatal(err)
+	}
+	if events := ev.events(); len(events) != 0 {
+		t.Fatalf("events = %+v, want none", events)
+	}
+
+	// Açık bir tümünü kapsayan kural erişimi geri verir
+	b.SetCapabilities([]Capability{{Pages: "*", Bindings: []string{"*"}, Events: []string{"*"}}})
+	wantResult(t, call("3", "search", "https://evil.example.com/"), "3", true)
+
+	// Kural yoksa sayfalar sınırsızdır
+	b.SetCapabilities(nil)
+	wantResult(t, call("4", "search", "https://evil.example.com/"), "4", true)
+}
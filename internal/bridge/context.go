@@ -0,0 +1,38 @@
+package bridge
+
+import (
+	"context"
+	"reflect"
+)
+
+// contextType, binding'lerin ilk parametresinde aranan context.Context tipidir.
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+// SetContext() → İlk parametresi context.Context olan binding'lere verilecek
+// kök context'i ayarlar. Uygulama kapanırken bu context iptal edilir; uzun
+// süren çağrılar ctx.Done() ile durur:
+//
+//	app.Bind("export", func(ctx context.Context, path string) error {
+//	    return exportAll(ctx, path)
+//	})
+//
+// Ayarlanmazsa context.Background() verilir.
+func (b *Bridge) SetContext(ctx context.Context) {
+	b.registry.ctx.Store(&ctx)
+}
+
+// context, binding'lere verilecek kök context'i döner.
+func (r *Registry) context() context.Context {
+	if ctx := r.ctx.Load(); ctx != nil {
+		return *ctx
+	}
+	return context.Background()
+}
+
+// ctxParams, fonksiyonun ilk parametresi context.Context ise 1, değilse 0 döner.
+func ctxParams(fnType reflect.Type) int {
+	if fnType.NumIn() > 0 && fnType.In(0) == contextType {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,85 @@
+package bridge
+
+import (
+	"context"
+	"sync"
+)
+
+// ============================================================
+// DRAIN — Kapanışta çağrıların boşaltılması
+// ------------------------------------------------------------
+// Uygulama kapanırken yeni çağrılar kabul edilmez (ErrCodeShuttingDown) ve
+// çalışmakta olan handler'ların bitmesi beklenir; böylece yarıda kalan
+// yazma işlemleri tamamlanır. Çekirdek binding'ler (ready, log, stream
+// çekme) kapanış sırasında da çalışır.
+// ============================================================
+
+// drainState, çalışan çağrıların sayısı ve boşaltma durumudur.
+type drainState struct {
+	mu       sync.Mutex
+	draining bool
+	inflight int
+	idle     chan struct{} // Boşaltma sırasında son çağrı bitince kapanır
+}
+
+// enterCall() → Çağrıyı çalışan olarak sayar; boşaltma başladıysa ve çağrı
+// çekirdek binding değilse false döner.
+func (b *Bridge) enterCall(method string) bool {
+	d := &b.drain
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.draining && !coreBinding(method) {
+		return false
+	}
+	d.inflight++
+	return true
+}
+
+// exitCall() → Biten çağrıyı sayaçtan düşer.
+func (b *Bridge) exitCall() {
+	d := &b.drain
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.inflight--
+	if d.inflight == 0 && d.idle != nil {
+		close(d.idle)
+		d.idle = nil
+	}
+}
+
+// Drain() → Yeni çağrıları reddetmeye başlar ve çalışan çağrıların bitmesini
+// bekler. ctx dolarsa ctx.Err() döner; çalışan handler'lar durdurulmaz
+// (bkz. SetContext). Boşaltma geri alınamaz.
+func (b *Bridge) Drain(ctx context.Context) error {
+	d := &b.drain
+	d.mu.Lock()
+	d.draining = true
+	if d.inflight == 0 {
+		d.mu.Unlock()
+		return nil
+	}
+	if d.idle == nil {
+		d.idle = make(chan struct{})
+	}
+	idle := d.idle
+	d.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// InFlight() → Çalışmakta olan çağrı sayısını döner.
+func (b *Bridge) InFlight() int {
+	b.drain.mu.Lock()
+	defer b.drain.mu.Unlock()
+	return b.drain.inflight
+}
+
+// shuttingDown() → Boşaltma sırasında gelen çağrının hata cevabı.
+func shuttingDown(msg *Message) *Message {
+	return NewErrorMessage(msg.ID, ErrCodeShuttingDown, "application is shutting down", msg.Method)
+}
@@ -0,0 +1,523 @@
+package bridge
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// ============================================================
+// JSON ENCODING — Sonuç ve olayların JSON politikası
+// ------------------------------------------------------------
+// Varsayılan olarak değerler encoding/json ile yazılır. JSONEncoding bunu
+// köprü genelinde değiştirir:
+//
+//	b.SetJSONEncoding(bridge.JSONEncoding{
+//	    Int64AsString: true,              // {"id": "9007199254740993"}
+//	    TimeLayout:    time.DateOnly,     // {"due": "2024-05-01"}
+//	    OmitNil:       true,              // nil pointer/slice/map alanları yazılmaz
+//	    FieldNames:    bridge.CamelCase,  // UserName → "userName"
+//	})
+//
+// Politika binding sonuçlarına, olaylara (sticky dahil) ve TypeScript
+// çıktısına aynı şekilde uygulanır. Argümanlar ters yönde çevrilir: string
+// int64'ler, politikadaki zaman biçimi ve dönüştürülmüş alan adları Go
+// tiplerine çözülebilir; böylece üretilen tipler iki yönde de geçerlidir.
+//
+// json.Marshaler ya da encoding.TextMarshaler uygulayan tipler kendi
+// metodlarıyla yazılır; politika bunların içine uygulanmaz. json tag'inde
+// adı verilmiş alanların adı değiştirilmez.
+// ============================================================
+
+// JSONEncoding, Go değerlerinin JS'e giderken JSON'a çevrilme politikasıdır.
+// Sıfır değeri encoding/json'un davranışıdır.
+type JSONEncoding struct {
+	// Int64AsString, int64 ve uint64 değerleri string olarak yazar; JS'in
+	// number tipi 2^53'ün üstünde hassasiyet kaybeder. 64 bit platformlarda
+	// int, uint ve uintptr da 64 bit olduğundan onlar da string yazılır.
+	Int64AsString bool
+
+	// TimeLayout, time.Time değerlerinin biçimidir (ör. time.DateOnly);
+	// boşsa RFC 3339.
+	TimeLayout string
+
+	// OmitNil, nil pointer, slice, map ve interface alanlarını yazmaz;
+	// TypeScript'te bu alanlar opsiyoneldir.
+	OmitNil bool
+
+	// FieldNames, json tag'inde adı olmayan alanların Go adını dönüştürür
+	// (bkz. CamelCase, SnakeCase).
+	FieldNames func(goName string) string
+}
+
+var (
+	unmarshalerType     = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// SetJSONEncoding() → Sonuç ve olayların JSON politikasını ayarlar.
+func (b *Bridge) SetJSONEncoding(enc JSONEncoding) {
+	b.registry.encoding.Store(&enc)
+}
+
+// active, politikanın encoding/json'dan farklı olup olmadığını döner.
+func (e *JSONEncoding) active() bool {
+	return e != nil && (e.Int64AsString || e.TimeLayout != "" || e.OmitNil || e.FieldNames != nil)
+}
+
+// marshalJSON, v'yi politikaya göre JSON'a çevirir.
+func marshalJSON(v interface{}, enc *JSONEncoding) ([]byte, error) {
+	if !enc.active() {
+		return json.Marshal(v)
+	}
+	var buf bytes.Buffer
+	if err := enc.encode(&buf, reflect.ValueOf(v)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// encode, v'yi buf'a yazar.
+func (e *JSONEncoding) encode(buf *bytes.Buffer, v reflect.Value) error {
+	if !v.IsValid() {
+		buf.WriteString("null")
+		return nil
+	}
+	t := v.Type()
+	if t == timeType && e.TimeLayout != "" && v.CanInterface() {
+		return writeJSON(buf, v.Interface().(time.Time).Format(e.TimeLayout))
+	}
+	if v.CanInterface() && (t.Implements(marshalerType) || t.Implements(textMarshalerType)) {
+		if t.Kind() == reflect.Pointer && v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		return writeJSON(buf, v.Interface())
+	}
+	if v.CanAddr() && v.CanInterface() {
+		if pt := reflect.PointerTo(t); pt.Implements(marshalerType) || pt.Implements(textMarshalerType) {
+			return writeJSON(buf, v.Addr().Interface())
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		return e.encode(buf, v.Elem())
+	case reflect.Struct:
+		return e.encodeStruct(buf, v)
+	case reflect.Map:
+		return e.encodeMap(buf, v)
+	case reflect.Slice:
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		if t.Elem().Kind() == reflect.Uint8 {
+			return writeJSON(buf, v.Bytes()) // base64, encoding/json gibi
+		}
+		fallthrough
+	case reflect.Array:
+		buf.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := e.encode(buf, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+	case reflect.Bool:
+		buf.WriteString(strconv.FormatBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if e.Int64AsString && wideInt(v.Kind()) {
+			buf.WriteString(strconv.Quote(strconv.FormatInt(v.Int(), 10)))
+			return nil
+		}
+		buf.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if e.Int64AsString && wideInt(v.Kind()) {
+			buf.WriteString(strconv.Quote(strconv.FormatUint(v.Uint(), 10)))
+			return nil
+		}
+		buf.WriteString(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32:
+		return writeJSON(buf, float32(v.Float()))
+	case reflect.Float64:
+		return writeJSON(buf, v.Float())
+	case reflect.String:
+		return writeJSON(buf, v.String())
+	default:
+		return &json.UnsupportedTypeError{Type: t}
+	}
+	return nil
+}
+
+// encodeStruct, struct alanlarını json tag kurallarıyla yazar.
+func (e *JSONEncoding) encodeStruct(buf *bytes.Buffer, v reflect.Value) error {
+	buf.WriteByte('{')
+	first := true
+	for _, f := range jsonFields(v.Type()) {
+		fv, err := v.FieldByIndexErr(f.index)
+		if err != nil {
+			continue // nil gömülü pointer
+		}
+		if (f.omitEmpty && emptyValue(fv)) || (f.omitZero && fv.IsZero()) || (e.OmitNil && nilValue(fv)) {
+			continue
+		}
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		_ = writeJSON(buf, e.fieldName(f))
+		buf.WriteByte(':')
+		if !f.quoted {
+			if err := e.encode(buf, fv); err != nil {
+				return err
+			}
+			continue
+		}
+		var inner bytes.Buffer
+		if err := e.encode(&inner, fv); err != nil {
+			return err
+		}
+		switch {
+		case fv.Kind() == reflect.String:
+			_ = writeJSON(buf, inner.String())
+		case bytes.HasPrefix(inner.Bytes(), []byte{'"'}):
+			buf.Write(inner.Bytes()) // Int64AsString zaten string yazdı
+		default:
+			buf.WriteByte('"')
+			buf.Write(inner.Bytes())
+			buf.WriteByte('"')
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+// encodeMap, map'i encoding/json gibi anahtarları sıralayarak yazar.
+func (e *JSONEncoding) encodeMap(buf *bytes.Buffer, v reflect.Value) error {
+	if v.IsNil() {
+		buf.WriteString("null")
+		return nil
+	}
+	type entry struct {
+		key string
+		val reflect.Value
+	}
+	entries := make([]entry, 0, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		k := iter.Key()
+		var key string
+		switch {
+		case k.Kind() == reflect.String:
+			key = k.String()
+		case k.CanInterface() && k.Type().Implements(textMarshalerType):
+			text, err := k.Interface().(encoding.TextMarshaler).MarshalText()
+			if err != nil {
+				return err
+			}
+			key = string(text)
+		case k.CanInt():
+			key = strconv.FormatInt(k.Int(), 10)
+		case k.CanUint():
+			key = strconv.FormatUint(k.Uint(), 10)
+		default:
+			return &json.UnsupportedTypeError{Type: v.Type()}
+		}
+		entries = append(entries, entry{key, iter.Value()})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+
+	buf.WriteByte('{')
+	for i, en := range entries {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		_ = writeJSON(buf, en.key)
+		buf.WriteByte(':')
+		if err := e.encode(buf, en.val); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+// writeJSON, v'yi encoding/json ile buf'a yazar.
+func writeJSON(buf *bytes.Buffer, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	buf.Write(data)
+	return nil
+}
+
+// fieldName, alanın JSON'daki adıdır.
+func (e *JSONEncoding) fieldName(f jsonField) string {
+	if f.tagName != "" {
+		return f.tagName
+	}
+	if e != nil && e.FieldNames != nil {
+		return e.FieldNames(f.goName)
+	}
+	return f.goName
+}
+
+// ------------------------------------------------------------
+// Argümanların ters çevrilmesi
+// ------------------------------------------------------------
+
+// decodes, argümanların Go tiplerine çözülmeden önce çevrilmesi gerekip
+// gerekmediğini döner.
+func (e *JSONEncoding) decodes() bool {
+	return e != nil && (e.Int64AsString || e.TimeLayout != "" || e.FieldNames != nil)
+}
+
+// normalizeArg, politikayla yazılmış bir argümanı encoding/json'un t'ye
+// çözebileceği hâle getirir.
+func (e *JSONEncoding) normalizeArg(raw json.RawMessage, t reflect.Type) (json.RawMessage, error) {
+	if t == rawMessageType || t.Kind() == reflect.Interface || nullArg(raw) {
+		return raw, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber() // Büyük tamsayılar float64'e düşmesin
+	var x interface{}
+	if err := dec.Decode(&x); err != nil {
+		return nil, err
+	}
+	return json.Marshal(e.denormalize(x, t))
+}
+
+// denormalize, x'i t tipinin encoding/json karşılığına çevirir.
+func (e *JSONEncoding) denormalize(x interface{}, t reflect.Type) interface{} {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if x == nil {
+		return nil
+	}
+	if t == timeType {
+		if s, ok := x.(string); ok && e.TimeLayout != "" {
+			if tm, err := time.Parse(e.TimeLayout, s); err == nil {
+				return tm.Format(time.RFC3339Nano)
+			}
+		}
+		return x
+	}
+	if pt := reflect.PointerTo(t); pt.Implements(unmarshalerType) || pt.Implements(textUnmarshalerType) {
+		return x
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		if s, ok := x.(string); ok && e.Int64AsString && wideInt(t.Kind()) {
+			return json.Number(s)
+		}
+	case reflect.Struct:
+		m, ok := x.(map[string]interface{})
+		if !ok {
+			return x
+		}
+		for _, f := range jsonFields(t) {
+			name := e.fieldName(f)
+			val, ok := m[name]
+			if !ok {
+				continue
+			}
+			delete(m, name)
+			goName := f.tagName
+			if goName == "" {
+				goName = f.goName
+			}
+			if !f.quoted { // ",string" alanlar encoding/json'un biçimindedir
+				val = e.denormalize(val, f.typ)
+			}
+			m[goName] = val
+		}
+	case reflect.Slice, reflect.Array:
+		if items, ok := x.([]interface{}); ok {
+			for i := range items {
+				items[i] = e.denormalize(items[i], t.Elem())
+			}
+		}
+	case reflect.Map:
+		if m, ok := x.(map[string]interface{}); ok {
+			for k, val := range m {
+				m[k] = e.denormalize(val, t.Elem())
+			}
+		}
+	}
+	return x
+}
+
+// ------------------------------------------------------------
+// Alan bilgisi
+// ------------------------------------------------------------
+
+// jsonField, encoding/json'un yazacağı bir struct alanıdır.
+type jsonField struct {
+	index     []int
+	typ       reflect.Type
+	goName    string
+	tagName   string // json tag'inde verilen ad; yoksa ""
+	omitEmpty bool
+	omitZero  bool
+	quoted    bool // ",string"
+}
+
+var jsonFieldCache sync.Map // reflect.Type → []jsonField
+
+// jsonFields, t'nin JSON'a yazılan alanlarını (gömülü struct'lar
+// düzleştirilerek) döner.
+func jsonFields(t reflect.Type) []jsonField {
+	if cached, ok := jsonFieldCache.Load(t); ok {
+		return cached.([]jsonField)
+	}
+	var fields []jsonField
+	collectJSONFields(t, nil, &fields)
+	jsonFieldCache.Store(t, fields)
+	return fields
+}
+
+func collectJSONFields(t reflect.Type, index []int, out *[]jsonField) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		idx := append(append([]int(nil), index...), i)
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				collectJSONFields(ft, idx, out)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		jf := jsonField{index: idx, typ: f.Type, goName: f.Name, tagName: name}
+		for _, opt := range strings.Split(opts, ",") {
+			switch opt {
+			case "omitempty":
+				jf.omitEmpty = true
+			case "omitzero":
+				jf.omitZero = true
+			case "string":
+				switch f.Type.Kind() {
+				case reflect.Bool, reflect.String, reflect.Float32, reflect.Float64,
+					reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+					reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+					jf.quoted = true
+				}
+			}
+		}
+		*out = append(*out, jf)
+	}
+}
+
+// emptyValue, encoding/json'un omitempty kuralıdır.
+func emptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	}
+	return false
+}
+
+// nilValue, OmitNil'in atladığı değerleri döner.
+func nilValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice:
+		return v.IsNil()
+	}
+	return false
+}
+
+// nilable, OmitNil'de alanın TypeScript'te opsiyonel olup olmadığını döner.
+func nilable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice:
+		return true
+	}
+	return false
+}
+
+// ------------------------------------------------------------
+// Alan adı dönüşümleri
+// ------------------------------------------------------------
+
+// CamelCase, Go alan adını camelCase'e çevirir: UserName → userName,
+// ID → id, URLPath → urlPath. Argümanlar encoding/json ile büyük-küçük
+// harf duyarsız eşleştiğinden bu dönüşüm iki yönde de çalışır.
+func CamelCase(name string) string {
+	runes := []rune(name)
+	for i := range runes {
+		if !unicode.IsUpper(runes[i]) {
+			break
+		}
+		// Kısaltmanın son harfi sonraki kelimenin başıdır (URLPath → urlPath)
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
+
+// SnakeCase, Go alan adını snake_case'e çevirir: UserName → user_name,
+// UserID → user_id, URLPath → url_path.
+func SnakeCase(name string) string {
+	runes := []rune(name)
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			prevLower := i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]))
+			nextLower := i > 0 && i+1 < len(runes) && unicode.IsUpper(runes[i-1]) && unicode.IsLower(runes[i+1])
+			if prevLower || nextLower {
+				sb.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// wideInt, JS number'ına hassasiyet kaybetmeden sığmayan tamsayı türleri için
+// true döner: int64, uint64 ve 64 bit platformlarda int, uint, uintptr.
+func wideInt(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int64, reflect.Uint64:
+		return true
+	case reflect.Int, reflect.Uint, reflect.Uintptr:
+		return strconv.IntSize == 64
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package bridge
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// ============================================================
+// ERROR DETAILS — Hata zincirinin JS'e taşınması
+// ------------------------------------------------------------
+// Binding'in döndürdüğü hata sarılmış bir zincirse (fmt.Errorf("%w"),
+// errors.Join, typed hatalar) ErrorPayload.Details tek bir düz metin yerine
+// zinciri anlatan JSON'dur:
+//
+//	{
+//	  "chain": [
+//	    {"message": "save failed: open a.txt: permission denied", "type": "*fmt.wrapError"},
+//	    {"message": "open a.txt: permission denied", "type": "*fs.PathError"},
+//	    {"message": "permission denied", "type": "syscall.Errno"}
+//	  ],
+//	  "is": ["fs.ErrPermission"],
+//	  "stack": "goroutine 7 [running]: ..."   // Yalnızca ErrorPolicyDebug ile
+//	}
+//
+// "is", zincirin errors.Is ile eşleştiği kayıtlı sentinel'ler ve hata
+// kodlarıdır (bkz. gomerrors.RegisterSentinel, gomerrors.NewCode). Zincir
+// tek halkaysa ve eşleşen sentinel ya da stack yoksa Details boş kalır.
+// ============================================================
+
+// ErrorDetails, ErrorPayload.Details'e yazılan hata zinciridir.
+type ErrorDetails struct {
+	Chain []ErrorLink `json:"chain"`
+	Is    []string    `json:"is,omitempty"`
+	Stack string      `json:"stack,omitempty"`
+}
+
+// ErrorLink, zincirdeki tek bir hatadır.
+type ErrorLink struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+	Code    int    `json:"code,omitempty"` // Kayıtlı hata kodu (CodedError)
+	Name    string `json:"name,omitempty"` // Kayıtlı hata kodunun adı
+}
+
+// maxErrorChain, Details'e yazılan en fazla halka sayısıdır; döngüsel ya
+// da aşırı derin zincirler kesilir.
+const maxErrorChain = 32
+
+// errorDetails, hatanın zincirini Details için JSON'a çevirir; anlatacak bir
+// şey yoksa boş döner.
+func errorDetails(err error, stacks bool) string {
+	d := ErrorDetails{Is: gomerrors.SentinelsOf(err)}
+	walkErrors(err, func(e error) bool {
+		link := ErrorLink{Message: e.Error(), Type: fmt.Sprintf("%T", e)}
+		switch t := e.(type) {
+		case *gomerrors.CodedError:
+			link.Code, link.Name = t.Code.Value, t.Code.Name
+		case *gomerrors.Code:
+			link.Code, link.Name = t.Value, t.Name
+		case *gomerrors.PanicError:
+			if stacks && d.Stack == "" {
+				d.Stack = string(t.Stack)
+			}
+		}
+		d.Chain = append(d.Chain, link)
+		return len(d.Chain) < maxErrorChain
+	})
+	if code := gomerrors.CodeOf(err); code != nil {
+		d.Is = append(d.Is, code.Name)
+	}
+
+	if len(d.Chain) <= 1 && len(d.Is) == 0 && d.Stack == "" {
+		return ""
+	}
+	data, jerr := json.Marshal(d)
+	if jerr != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// walkErrors, zinciri önce-derinlik sırasıyla gezer (errors.Join dahil);
+// fn false dönerse durur.
+func walkErrors(err error, fn func(error) bool) bool {
+	for err != nil {
+		if !fn(err) {
+			return false
+		}
+		switch u := err.(type) {
+		case interface{ Unwrap() []error }:
+			for _, child := range u.Unwrap() {
+				if !walkErrors(child, fn) {
+					return false
+				}
+			}
+			return true
+		default:
+			err = errors.Unwrap(err)
+		}
+	}
+	return true
+}
@@ -0,0 +1,82 @@
+package bridge
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// ============================================================
+// ERROR POLICY — Köprüden geçen hata ayrıntısının kontrolü
+// ------------------------------------------------------------
+// Binding hataları çoğu zaman uygulamanın iç yapısını (dosya yolları, SQL,
+// servis adresleri) taşır. Politika, JS'e ne kadarının gideceğini belirler:
+//
+//	ErrorPolicyDetailed → Mesaj ve yapısal zincir (bkz. ErrorDetails)
+//	ErrorPolicyDebug    → Detailed + panic yığınları
+//	ErrorPolicyGeneric  → İç hatalar (ErrCodeExecution, ErrCodeUnknown)
+//	                      "internal error (ref …)" olarak gider; asıl hata
+//	                      aynı ref ile Go loguna yazılır
+//
+// Uygulamanın kayıtlı hata kodları (bkz. gomerrors.NewCode) ve köprünün
+// kendi hataları (metod bulunamadı, geçersiz argüman, sınırlar) bilinçli
+// olarak frontend'e yöneliktir; Generic'te mesajları korunur, yalnızca
+// zincir ayrıntısı ve kodlu hatanın sardığı alt hata düşer.
+// ============================================================
+
+// ErrorPolicy, hata ayrıntısının JS'e ne kadar taşınacağıdır.
+type ErrorPolicy int32
+
+const (
+	ErrorPolicyDetailed ErrorPolicy = iota
+	ErrorPolicyDebug
+	ErrorPolicyGeneric
+)
+
+// genericErrorMessage, Generic politikada iç hataların mesajıdır.
+const genericErrorMessage = "internal error"
+
+// SetErrorPolicy() → Hata ayrıntısı politikasını ayarlar (varsayılan Detailed).
+func (b *Bridge) SetErrorPolicy(p ErrorPolicy) {
+	b.errorPolicy.Store(int32(p))
+	b.registry.stacks.Store(p == ErrorPolicyDebug)
+}
+
+// assignErrorRef() → Generic politikada iç hatalara loglarla eşleşecek bir
+// referans verir. Cevap henüz loglanmadan çağrılır.
+func (b *Bridge) assignErrorRef(response *Message) {
+	if ErrorPolicy(b.errorPolicy.Load()) != ErrorPolicyGeneric || !internalError(response) {
+		return
+	}
+	var id [6]byte
+	_, _ = rand.Read(id[:])
+	response.Error.Ref = hex.EncodeToString(id[:])
+}
+
+// presentError() → Cevabın JS'e gidecek hâlini politikaya göre döner;
+// gerekirse kopyalar, loglanan cevap değişmez.
+func (b *Bridge) presentError(response *Message) *Message {
+	if ErrorPolicy(b.errorPolicy.Load()) != ErrorPolicyGeneric ||
+		response.Type != MessageTypeError || response.Error == nil {
+		return response
+	}
+	payload := *response.Error
+	switch {
+	case payload.Ref != "":
+		payload.Message = genericErrorMessage + " (ref " + payload.Ref + ")"
+	case payload.public != "":
+		payload.Message = payload.public // Alt hata (cause) iç ayrıntıdır
+	}
+	payload.Details = ""
+	presented := *response
+	presented.Error = &payload
+	return &presented
+}
+
+// internalError, cevabın frontend'e yönelik olmayan bir iç hata olup
+// olmadığını döner.
+func internalError(response *Message) bool {
+	if response.Type != MessageTypeError || response.Error == nil || response.Error.Name != "" {
+		return false
+	}
+	return response.Error.Code == ErrCodeExecution || response.Error.Code == ErrCodeUnknown
+}
@@ -0,0 +1,154 @@
+package bridge
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// ============================================================
+// JOURNAL — Sayfa yenilenmesine dayanıklı mesaj günlüğü
+// ------------------------------------------------------------
+// WebView yeniden yüklendiğinde (çökme sonrası kurtarma, geliştirmede hot
+// reload) o sırada gönderilen olaylar ve worker'da biten çağrıların cevapları
+// kaybolur; arayüz bağlamını sessizce yitirir. EnableJournal açıkken köprü
+// son olayları (sıra numarasıyla) ve asenkron çağrı cevaplarını saklar:
+//
+//  1. JS her olayın sıra numarasını ve sayfa kapanırken bekleyen çağrıları
+//     sessionStorage'a yazar.
+//  2. Yeni sayfa gomad.ready() çağırdığında (ya da yükleme bittiğinde)
+//     gomad.journal.resume ile kaçırdığı olayları ve cevapları ister.
+//  3. Olaylar sırasıyla yeniden dağıtılır; bekleyen çağrıların cevapları
+//     "gomad:recovered" olayıyla bildirilir (promise'ler eski sayfayla
+//     birlikte gittiği için sonuçlar veri olarak gelir):
+//
+//	gomad.on("gomad:recovered", ({ calls, complete }) => {
+//	    for (const c of calls) if (c.method === "save" && !c.error) markSaved(c.result);
+//	    if (!complete) reloadState(); // günlük kaçırılanların hepsini tutmuyordu
+//	});
+//
+// Günlük bellektedir ve boyutla sınırlıdır; süreç yeniden başlarsa boşalır.
+// Loopback'ten sunulan büyük veriler (bkz. SetLargePayloadThreshold)
+// günlüğe tek kullanımlık adresleriyle değil kendileriyle yazılır; büyük bir
+// resume sonucu da diğer sonuçlar gibi yeni bir adresten okunur.
+// ============================================================
+
+// JournalResumeBinding, yeniden yüklenen sayfanın kaçırdıklarını istediği
+// yerleşik fonksiyondur.
+const JournalResumeBinding = "gomad.journal.resume"
+
+// journalEntry, günlüğe yazılan tek bir mesajdır.
+type journalEntry struct {
+	seq uint64          // Olayın sıra numarası
+	id  string          // Cevabın ait olduğu çağrı
+	msg json.RawMessage // JS'e gönderilen mesaj
+}
+
+// journal, son olayları ve çağrı cevaplarını tutar; size 0 ise kapalıdır.
+type journal struct {
+	size      int
+	seq       uint64
+	dropped   uint64 // Sığmadığı için atılan en yeni olayın sıra numarası
+	events    []journalEntry
+	responses []journalEntry
+	mu        sync.Mutex
+}
+
+// journalResume, gomad.journal.resume'un sonucudur.
+type journalResume struct {
+	Events    []json.RawMessage `json:"events"`
+	Responses []json.RawMessage `json:"responses"`
+	// Complete, kaçırılan olayların tamamının günlükte olup olmadığıdır.
+	Complete bool `json:"complete"`
+}
+
+// EnableJournal() → Son size olayı ve asenkron çağrı cevabını saklar.
+// ------------------------------------------------------------
+// 0 günlüğü kapatır ve içeriğini siler.
+func (b *Bridge) EnableJournal(size int) {
+	if size < 0 {
+		size = 0
+	}
+	b.journal.mu.Lock()
+	defer b.journal.mu.Unlock()
+	b.journal.size = size
+	if size == 0 {
+		b.journal.events, b.journal.responses = nil, nil
+	}
+}
+
+// nextSeq() → Günlük açıksa gönderilecek olayın sıra numarasını ayırır.
+func (j *journal) nextSeq() uint64 {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.size == 0 {
+		return 0
+	}
+	j.seq++
+	return j.seq
+}
+
+// recordEvent() → Gönderilen olayı günlüğe ekler.
+func (j *journal) recordEvent(seq uint64, msg []byte) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.size == 0 {
+		return
+	}
+	j.events = append(j.events, journalEntry{seq: seq, msg: append(json.RawMessage(nil), msg...)})
+	if over := len(j.events) - j.size; over > 0 {
+		j.dropped = j.events[over-1].seq
+		j.events = append(j.events[:0:0], j.events[over:]...)
+	}
+}
+
+// enabled() → Günlüğün açık olup olmadığını döner.
+func (j *journal) enabled() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.size > 0
+}
+
+// recordResponse() → Worker'da biten çağrının cevabını günlüğe ekler.
+func (j *journal) recordResponse(id string, response []byte) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.size == 0 || id == "" || len(response) == 0 {
+		return
+	}
+	j.responses = append(j.responses, journalEntry{id: id, msg: append(json.RawMessage(nil), response...)})
+	if over := len(j.responses) - j.size; over > 0 {
+		j.responses = append(j.responses[:0:0], j.responses[over:]...)
+	}
+}
+
+// handleJournalResume() → gomad.journal.resume binding'inin Go karşılığı:
+// since'ten sonraki olayları ve verilen çağrıların cevaplarını döner.
+func (b *Bridge) handleJournalResume(since uint64, calls []string) journalResume {
+	j := &b.journal
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	result := journalResume{
+		Events:    []json.RawMessage{},
+		Responses: []json.RawMessage{},
+		Complete:  j.size > 0 && j.dropped <= since,
+	}
+	for _, e := range j.events {
+		if e.seq > since {
+			result.Events = append(result.Events, e.msg)
+		}
+	}
+	wanted := make(map[string]bool, len(calls))
+	for _, id := range calls {
+		wanted[id] = true
+	}
+	for _, e := range j.responses {
+		if wanted[e.id] {
+			result.Responses = append(result.Responses, e.msg)
+		}
+	}
+	if len(result.Events) > 0 || len(result.Responses) > 0 {
+		b.logger.Info("bridge journal replayed", "events", len(result.Events), "responses", len(result.Responses), "complete", result.Complete)
+	}
+	return result
+}
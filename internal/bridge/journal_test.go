@@ -0,0 +1,85 @@
+package bridge
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// resumeJournal, yeniden yüklenen sayfanın gomad.journal.resume çağrısını yapar.
+func resumeJournal(t *testing.T, b *Bridge, since uint64, calls ...string) journalResume {
+	t.Helper()
+	if calls == nil {
+		calls = []string{}
+	}
+	msg := parseResponse(t, b.HandleMessage(callJSON(t, "resume", JournalResumeBinding, since, calls)))
+	if msg.Type != MessageTypeResult {
+		t.Fatalf("resume failed: %+v", msg.Error)
+	}
+	var r journalResume
+	if err := json.Unmarshal(msg.Result, &r); err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+// journalEvents, günlükten dönen olayların adlarını ve sıra numaralarını döner.
+func journalEvents(t *testing.T, raw []json.RawMessage) (names []string, seqs []uint64) {
+	t.Helper()
+	for _, data := range raw {
+		msg, err := FromJSON(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		names, seqs = append(names, msg.Event), append(seqs, msg.Seq)
+	}
+	return names, seqs
+}
+
+func TestJournal(t *testing.T) {
+	ev := &recordEvaluator{}
+	b := NewBridge(ev)
+	if err := b.Bind("save", func() string { return "saved" }); err != nil {
+		t.Fatal(err)
+	}
+	b.EnableJournal(2)
+
+	for _, name := range []string{"a", "b", "c"} {
+		if err := b.Emit(name, name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if sent := ev.events(); len(sent) != 3 || sent[2].Seq != 3 {
+		t.Fatalf("sent events = %+v, want seq 1..3", sent)
+	}
+
+	// Worker'da biten çağrının cevabı günlüğe yazılır
+	reply, next := asyncReplies()
+	b.HandleMessageAsync(callJSON(t, "c1", "save"), nil, reply)
+	wantResult(t, next(t), "c1", "saved")
+
+	r := resumeJournal(t, b, 1, "c1", "unknown")
+	names, seqs := journalEvents(t, r.Events)
+	if !r.Complete || len(names) != 2 || names[0] != "b" || seqs[1] != 3 {
+		t.Fatalf("resume(1) = events %v %v, complete %v", names, seqs, r.Complete)
+	}
+	if len(r.Responses) != 1 {
+		t.Fatalf("got %d responses, want 1", len(r.Responses))
+	}
+	wantResult(t, parseResponse(t, string(r.Responses[0])), "c1", "saved")
+
+	// "a" günlüğe sığmadı; baştan isteyen sayfa eksik olduğunu öğrenir
+	if r := resumeJournal(t, b, 0); r.Complete || len(r.Events) != 2 {
+		t.Fatalf("resume(0) = %d events, complete %v, want 2 and incomplete", len(r.Events), r.Complete)
+	}
+
+	b.EnableJournal(0)
+	if err := b.Emit("d", "d"); err != nil {
+		t.Fatal(err)
+	}
+	if sent := ev.events(); sent[len(sent)-1].Seq != 0 {
+		t.Fatalf("event sent with seq %d while the journal is off", sent[len(sent)-1].Seq)
+	}
+	if r := resumeJournal(t, b, 0, "c1"); r.Complete || len(r.Events) != 0 || len(r.Responses) != 0 {
+		t.Fatalf("resume with journal off = %+v", r)
+	}
+}
@@ -0,0 +1,131 @@
+package bridge
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ============================================================
+// LIMITS — Sayfadan gelen çağrılar için aşırı yük koruması
+// ------------------------------------------------------------
+// HandleMessageAsync her çağrı için bir goroutine başlatır; döngüye girmiş
+// bir frontend (ör. her render'da çağrı yapan bir effect) saniyede binlerce
+// çağrıyla goroutine ve belleği tüketebilir. Limits bunu üç sınırla önler:
+//
+//   - MaxCallsPerSecond: token bucket ile çağrı hızı (burst = bir saniyelik)
+//   - MaxConcurrent:     aynı anda çalışan worker çağrıları
+//   - MaxQueued:         worker bekleyen çağrılar
+//
+// Sınırı aşan çağrılar çalıştırılmadan ErrCodeOverloaded ile reddedilir;
+// frontend bunu geri çekilme (backoff) sinyali olarak kullanabilir.
+// Köprünün temel yerleşikleri (bkz. coreBinding) sınırlanmaz.
+// ============================================================
+
+// Limits, sayfadan gelen çağrıların sınırlarıdır. 0 olan sınır uygulanmaz.
+type Limits struct {
+	MaxCallsPerSecond int
+	MaxConcurrent     int
+	MaxQueued         int
+}
+
+// DefaultLimits, olağan bir uygulamayı etkilemeyecek kadar geniş, kaçak bir
+// döngüyü durduracak kadar dar sınırlardır.
+var DefaultLimits = Limits{
+	MaxCallsPerSecond: 2000,
+	MaxConcurrent:     128,
+	MaxQueued:         1024,
+}
+
+// limiter, Limits'in çalışma zamanı durumudur.
+type limiter struct {
+	limits Limits
+	slots  chan struct{} // MaxConcurrent kapasiteli semafor
+	queued int64         // worker bekleyen çağrı sayısı (atomic)
+
+	tokens float64
+	last   time.Time
+	mu     sync.Mutex
+
+	lastWarn int64 // son aşırı yük logu (unix nano, atomic)
+}
+
+// SetLimits() → Sayfadan gelen çağrıların sınırlarını ayarlar.
+// Çalışan çağrılar etkilenmez; boş Limits tüm sınırları kaldırır.
+func (b *Bridge) SetLimits(l Limits) {
+	lim := &limiter{limits: l, tokens: float64(l.MaxCallsPerSecond), last: time.Now()}
+	if l.MaxConcurrent > 0 {
+		lim.slots = make(chan struct{}, l.MaxConcurrent)
+	}
+	b.limitMu.Lock()
+	b.limiter = lim
+	b.limitMu.Unlock()
+}
+
+// currentLimiter, ayarlı limiter'ı döner; yoksa nil.
+func (b *Bridge) currentLimiter() *limiter {
+	b.limitMu.RLock()
+	defer b.limitMu.RUnlock()
+	return b.limiter
+}
+
+// allow, çağrı hızı sınırını uygular.
+func (l *limiter) allow() bool {
+	rate := float64(l.limits.MaxCallsPerSecond)
+	if rate <= 0 {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * rate
+	if l.tokens > rate {
+		l.tokens = rate
+	}
+	l.last = now
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
+
+// acquire, bir worker yeri ayırır. Yer yoksa ve kuyruk doluysa false döner;
+// aksi halde dönen fonksiyon yer açılana kadar bekler (worker goroutine'inde
+// çağrılmalıdır). release, işi biten çağrının yerini bırakır.
+func (l *limiter) acquire() (wait func(), ok bool) {
+	if l.slots == nil {
+		return func() {}, true
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return func() {}, true
+	default:
+	}
+	// MaxQueued 0 ise kuyruk sınırsızdır
+	if max := int64(l.limits.MaxQueued); atomic.AddInt64(&l.queued, 1) > max && max > 0 {
+		atomic.AddInt64(&l.queued, -1)
+		return nil, false
+	}
+	return func() {
+		l.slots <- struct{}{}
+		atomic.AddInt64(&l.queued, -1)
+	}, true
+}
+
+// release, acquire ile ayrılan yeri bırakır.
+func (l *limiter) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+// overloaded() → Çağrıyı reddeden cevabı üretir; logları saniyede bire sınırlar.
+func (b *Bridge) overloaded(l *limiter, msg *Message, reason string) *Message {
+	now := time.Now().UnixNano()
+	if last := atomic.LoadInt64(&l.lastWarn); now-last > int64(time.Second) &&
+		atomic.CompareAndSwapInt64(&l.lastWarn, last, now) {
+		b.logger.Warn("bridge overloaded, rejecting calls", "method", msg.Method, "reason", reason)
+	}
+	return NewErrorMessage(msg.ID, ErrCodeOverloaded, "overloaded", reason)
+}
@@ -0,0 +1,74 @@
+package bridge
+
+import "testing"
+
+// blockingBridge, release kapanana kadar bekleyen "slow" bağlamalı bir köprü döner.
+func blockingBridge(t *testing.T, l Limits) (*Bridge, chan struct{}) {
+	t.Helper()
+	b := NewBridge(nopEvaluator{})
+	release := make(chan struct{})
+	if err := b.Bind("slow", func() string { <-release; return "done" }); err != nil {
+		t.Fatal(err)
+	}
+	b.SetLimits(l)
+	return b, release
+}
+
+func TestLimitsZeroValue(t *testing.T) {
+	b, release := blockingBridge(t, Limits{})
+	reply, next := asyncReplies()
+	for _, id := range []string{"1", "2", "3"} {
+		if got := b.HandleMessageAsync(callJSON(t, id, "slow"), nil, reply); got != "" {
+			t.Fatalf("call %s rejected: %s", id, got)
+		}
+	}
+	close(release)
+	for range 3 {
+		if msg := next(t); msg.Type != MessageTypeResult {
+			t.Fatalf("response = %+v (error %+v), want result", msg, msg.Error)
+		}
+	}
+}
+
+func TestLimitsUnboundedQueue(t *testing.T) {
+	// MaxQueued 0 kuyruğu sınırlamaz; fazladan çağrılar yer açılmasını bekler
+	b, release := blockingBridge(t, Limits{MaxConcurrent: 1})
+	reply, next := asyncReplies()
+	for _, id := range []string{"1", "2", "3"} {
+		if got := b.HandleMessageAsync(callJSON(t, id, "slow"), nil, reply); got != "" {
+			t.Fatalf("call %s rejected: %s", id, got)
+		}
+	}
+	close(release)
+	for range 3 {
+		if msg := next(t); msg.Type != MessageTypeResult {
+			t.Fatalf("response = %+v (error %+v), want result", msg, msg.Error)
+		}
+	}
+}
+
+func TestLimitsQueueFull(t *testing.T) {
+	b, release := blockingBridge(t, Limits{MaxConcurrent: 1, MaxQueued: 1})
+	reply, next := asyncReplies()
+	b.HandleMessageAsync(callJSON(t, "1", "slow"), nil, reply)
+	b.HandleMessageAsync(callJSON(t, "2", "slow"), nil, reply)
+
+	wantError(t, parseResponse(t, b.HandleMessageAsync(callJSON(t, "3", "slow"), nil, reply)), ErrCodeOverloaded)
+
+	close(release)
+	wantResult(t, next(t), "1", "done")
+	wantResult(t, next(t), "2", "done")
+}
+
+func TestLimitsRate(t *testing.T) {
+	b := NewBridge(nopEvaluator{})
+	if err := b.Bind("ping", func() string { return "pong" }); err != nil {
+		t.Fatal(err)
+	}
+	b.SetLimits(Limits{MaxCallsPerSecond: 2})
+	ui := func(string) bool { return true }
+
+	wantResult(t, parseResponse(t, b.HandleMessageAsync(callJSON(t, "1", "ping"), ui, nil)), "1", "pong")
+	wantResult(t, parseResponse(t, b.HandleMessageAsync(callJSON(t, "2", "ping"), ui, nil)), "2", "pong")
+	wantError(t, parseResponse(t, b.HandleMessageAsync(callJSON(t, "3", "ping"), ui, nil)), ErrCodeOverloaded)
+}
@@ -117,6 +117,35 @@ type Message struct {
 	// Data contains event data (only for "event" type").
 	Data json.RawMessage `json:"data,omitempty"`
 
+	// Blob is a loopback URL where a large Result or Data is served instead of
+	// being inlined (see Bridge.SetLargePayloadThreshold).
+	// JS tarafı bu adresi fetch ile okur; eval string'i şişmez.
+	Blob string `json:"blob,omitempty"`
+
+	// Sticky marks an event whose value JS keeps and hands to later
+	// subscribers (see Bridge.EmitSticky).
+	Sticky bool `json:"sticky,omitempty"`
+
+	// Seq is the journal sequence number of an event (see
+	// Bridge.EnableJournal); JS remembers the last one it saw.
+	Seq uint64 `json:"seq,omitempty"`
+
+	// Origin is the page origin a JS → Go message was sent from.
+	// Token proves it came from the init script (see Bridge.EnableOriginCheck);
+	// köprü doğruladıktan sonra siler.
+	Origin string `json:"origin,omitempty"`
+	Token  string `json:"token,omitempty"`
+
+	// Page is the sending page's origin + path (see Bridge.SetCapabilities).
+	Page string `json:"page,omitempty"`
+
+	// Meta carries optional call metadata from the frontend, e.g. W3C trace
+	// context ("traceparent", "tracestate"; see Bridge.SetTracer).
+	Meta map[string]string `json:"meta,omitempty"`
+
+	// Sig is the HMAC-SHA256 of the message (see Bridge.EnableMessageSigning).
+	Sig string `json:"sig,omitempty"`
+
 	// Timestamp is when the message was created (optional, for debugging).
 	Timestamp int64 `json:"timestamp,omitempty"`
 }
@@ -132,15 +161,27 @@ type ErrorPayload struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
 	Details string `json:"details,omitempty"`
+	Name    string `json:"name,omitempty"` // Kayıtlı kodun adı (bkz. gomerrors.NewCode)
+	Ref     string `json:"ref,omitempty"`  // Go logundaki kayda referans (bkz. ErrorPolicyGeneric)
+
+	// Fields, doğrulamadan geçemeyen argüman alanlarıdır (bkz. ValidationError);
+	// frontend'e yönelik olduğundan Generic politikada da gönderilir.
+	Fields []FieldError `json:"fields,omitempty"`
+
+	public string // Kayıtlı kodun alt hata içermeyen mesajı (bkz. presentError)
 }
 
 // ---------------------------------------------------------------------------
 // Standart hata kodları (sistemde evrensel olarak kullanılabilir)
 const (
-	ErrCodeUnknown        = -1
-	ErrCodeMethodNotFound = -2
-	ErrCodeInvalidArgs    = -3
-	ErrCodeExecution      = -4
+	ErrCodeUnknown         = -1
+	ErrCodeMethodNotFound  = -2
+	ErrCodeInvalidArgs     = -3
+	ErrCodeExecution       = -4
+	ErrCodeForbidden       = -5 // Mesaj origin doğrulamasından geçemedi
+	ErrCodeOverloaded      = -6 // Çağrı sınırları aşıldı (bkz. Bridge.SetLimits)
+	ErrCodePayloadTooLarge = -7 // Argüman ya da sonuç boyut sınırını aştı (bkz. Bridge.SetMaxMessageSize)
+	ErrCodeShuttingDown    = -8 // Uygulama kapanıyor; çağrı çalıştırılmadı (bkz. Bridge.Drain)
 )
 
 // ============================================================================
@@ -175,7 +216,12 @@ func NewCallMessage(id, method string, args interface{}) (*Message, error) {
 // Bir fonksiyon çağrısı başarıyla tamamlandığında GO → JS dönüş tipi.
 // Result JSON formatına çevrilerek gönderilir.
 func NewResultMessage(id string, result interface{}) (*Message, error) {
-	resultJSON, err := json.Marshal(result)
+	return newResultMessage(id, result, nil)
+}
+
+// newResultMessage, sonucu köprünün JSON politikasıyla yazar (bkz. JSONEncoding).
+func newResultMessage(id string, result interface{}, enc *JSONEncoding) (*Message, error) {
+	resultJSON, err := marshalJSON(result, enc)
 	if err != nil {
 		return nil, err
 	}
@@ -216,7 +262,12 @@ func NewErrorMessage(id string, code int, message string, details string) *Messa
 // JS'e broadcast event göndermek için kullanılır.
 // Fonksiyon sonucu değildir → bildirimdir.
 func NewEventMessage(event string, data interface{}) (*Message, error) {
-	dataJSON, err := json.Marshal(data)
+	return newEventMessage(event, data, nil)
+}
+
+// newEventMessage, veriyi köprünün JSON politikasıyla yazar (bkz. JSONEncoding).
+func newEventMessage(event string, data interface{}, enc *JSONEncoding) (*Message, error) {
+	dataJSON, err := marshalJSON(data, enc)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,262 @@
+package bridge
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ============================================================
+// METRICS — Metod bazında sayaçlar ve histogramlar
+// ------------------------------------------------------------
+// Stats tüm köprü için özet verir; Metrics ise izleme sistemlerinin
+// beklediği ayrıntıdadır: metod başına çağrı/hata sayısı ve süre
+// histogramı, hata kodu başına sayaç, olay başına gönderim sayısı ve Eval
+// gecikmesi (scriptin kuyruğa girmesinden UI thread'inde çalışmasına kadar).
+// WritePrometheus bunları Prometheus metin formatında yazar.
+//
+// Kayıtlı olmayan metodlara yapılan çağrılar tek bir "(unknown)" etiketinde
+// toplanır; hatalı bir frontend rastgele adlarla etiket sayısını
+// şişiremez.
+// ============================================================
+
+// durationBuckets, süre histogramlarının üst sınırlarıdır (saniye).
+var durationBuckets = []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// unknownMethod, kayıtlı olmayan metodların etiketidir.
+const unknownMethod = "(unknown)"
+
+// Histogram, bir süre dağılımıdır. Counts[i], Buckets[i] saniyeden kısa ya
+// da eşit gözlemlerin sayısıdır (birikimli); Count tüm gözlemlerdir.
+type Histogram struct {
+	Buckets []float64 `json:"buckets"`
+	Counts  []uint64  `json:"counts"`
+	Count   uint64    `json:"count"`
+	Sum     float64   `json:"sum"` // Saniye
+}
+
+// MethodMetrics, tek bir binding'in sayaçlarıdır.
+type MethodMetrics struct {
+	Calls    uint64    `json:"calls"`
+	Errors   uint64    `json:"errors"`
+	Duration Histogram `json:"duration"`
+}
+
+// Metrics, köprü metriklerinin anlık görüntüsüdür.
+type Metrics struct {
+	Methods      map[string]MethodMetrics `json:"methods"`
+	ErrorsByCode map[int]uint64           `json:"errorsByCode"`
+	Events       map[string]uint64        `json:"events"`
+	EvalLatency  Histogram                `json:"evalLatency"`
+	Since        time.Time                `json:"since"`
+}
+
+// histogram, Histogram'ın değiştirilebilir hâlidir.
+type histogram struct {
+	counts []uint64 // Birikimli değil; Snapshot birikimli hale getirir
+	count  uint64
+	sum    float64
+}
+
+func (h *histogram) observe(d time.Duration) {
+	if h.counts == nil {
+		h.counts = make([]uint64, len(durationBuckets))
+	}
+	s := d.Seconds()
+	if i, _ := slices.BinarySearch(durationBuckets, s); i < len(durationBuckets) {
+		h.counts[i]++
+	}
+	h.count++
+	h.sum += s
+}
+
+func (h *histogram) snapshot() Histogram {
+	out := Histogram{Buckets: durationBuckets, Counts: make([]uint64, len(durationBuckets)), Count: h.count, Sum: h.sum}
+	var cum uint64
+	for i := range durationBuckets {
+		if h.counts != nil {
+			cum += h.counts[i]
+		}
+		out.Counts[i] = cum
+	}
+	return out
+}
+
+// metrics, Metrics'in değiştirilebilir hâlidir.
+type metrics struct {
+	methods map[string]*methodMetrics
+	codes   map[int]uint64
+	events  map[string]uint64
+	eval    histogram
+	since   time.Time
+	mu      sync.Mutex
+}
+
+type methodMetrics struct {
+	calls, errors uint64
+	duration      histogram
+}
+
+// recordCall, tamamlanan bir çağrıyı metod sayaçlarına ekler. code 0 ise
+// çağrı başarılıdır.
+func (m *metrics) recordCall(method string, elapsed time.Duration, code int) {
+	if code == ErrCodeMethodNotFound {
+		method = unknownMethod
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.methods == nil {
+		m.methods = make(map[string]*methodMetrics)
+		m.codes = make(map[int]uint64)
+	}
+	mm := m.methods[method]
+	if mm == nil {
+		mm = &methodMetrics{}
+		m.methods[method] = mm
+	}
+	mm.calls++
+	mm.duration.observe(elapsed)
+	if code != 0 {
+		mm.errors++
+		m.codes[code]++
+	}
+}
+
+// errorCode, cevabın hata kodunu döner; başarılıysa 0.
+func errorCode(response *Message) int {
+	if response.Type == MessageTypeError && response.Error != nil {
+		return response.Error.Code
+	}
+	return 0
+}
+
+// recordEvent, gönderilen bir olayı sayar.
+func (m *metrics) recordEvent(event string) {
+	m.mu.Lock()
+	if m.events == nil {
+		m.events = make(map[string]uint64)
+	}
+	m.events[event]++
+	m.mu.Unlock()
+}
+
+// RecordEvalLatency() → Bir Eval'in kuyruğa girmesinden UI thread'inde
+// çalışmasına kadar geçen süreyi kaydeder. Eval'i asenkron çalıştıran
+// evaluator'lar (WebView) tarafından çağrılır.
+func (b *Bridge) RecordEvalLatency(d time.Duration) {
+	b.metrics.mu.Lock()
+	b.metrics.eval.observe(d)
+	b.metrics.mu.Unlock()
+}
+
+// Metrics() → Metod, hata kodu, olay ve Eval metriklerinin anlık görüntüsü.
+func (b *Bridge) Metrics() Metrics {
+	m := &b.metrics
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := Metrics{
+		Methods:      make(map[string]MethodMetrics, len(m.methods)),
+		ErrorsByCode: make(map[int]uint64, len(m.codes)),
+		Events:       make(map[string]uint64, len(m.events)),
+		EvalLatency:  m.eval.snapshot(),
+		Since:        b.stats.since,
+	}
+	for name, mm := range m.methods {
+		out.Methods[name] = MethodMetrics{Calls: mm.calls, Errors: mm.errors, Duration: mm.duration.snapshot()}
+	}
+	for code, n := range m.codes {
+		out.ErrorsByCode[code] = n
+	}
+	for event, n := range m.events {
+		out.Events[event] = n
+	}
+	return out
+}
+
+// resetMetrics, metrikleri sıfırlar (bkz. ResetStats).
+func (b *Bridge) resetMetrics() {
+	b.metrics.mu.Lock()
+	b.metrics.methods, b.metrics.codes, b.metrics.events = nil, nil, nil
+	b.metrics.eval = histogram{}
+	b.metrics.mu.Unlock()
+}
+
+// WritePrometheus() → Metrikleri Prometheus metin formatında (0.0.4) yazar.
+// ------------------------------------------------------------
+//
+//	gomad_bridge_calls_total{method="..."}
+//	gomad_bridge_call_errors_total{method="..."}
+//	gomad_bridge_call_duration_seconds{method="..."}   (histogram)
+//	gomad_bridge_errors_by_code_total{code="-4"}
+//	gomad_bridge_events_total{event="..."}
+//	gomad_bridge_eval_latency_seconds                  (histogram)
+func (b *Bridge) WritePrometheus(w io.Writer) error {
+	m := b.Metrics()
+	var sb strings.Builder
+
+	methods := sortedKeys(m.Methods)
+	promHeader(&sb, "gomad_bridge_calls_total", "counter", "Bridge calls by method.")
+	for _, name := range methods {
+		fmt.Fprintf(&sb, "gomad_bridge_calls_total{method=%s} %d\n", promLabel(name), m.Methods[name].Calls)
+	}
+	promHeader(&sb, "gomad_bridge_call_errors_total", "counter", "Bridge calls that returned an error, by method.")
+	for _, name := range methods {
+		fmt.Fprintf(&sb, "gomad_bridge_call_errors_total{method=%s} %d\n", promLabel(name), m.Methods[name].Errors)
+	}
+	promHeader(&sb, "gomad_bridge_call_duration_seconds", "histogram", "Bridge call duration by method.")
+	for _, name := range methods {
+		writeHistogram(&sb, "gomad_bridge_call_duration_seconds", "method="+promLabel(name)+",", m.Methods[name].Duration)
+	}
+
+	promHeader(&sb, "gomad_bridge_errors_by_code_total", "counter", "Bridge call errors by error code.")
+	codes := sortedKeys(m.ErrorsByCode)
+	for _, code := range codes {
+		fmt.Fprintf(&sb, "gomad_bridge_errors_by_code_total{code=%s} %d\n", promLabel(strconv.Itoa(code)), m.ErrorsByCode[code])
+	}
+
+	promHeader(&sb, "gomad_bridge_events_total", "counter", "Events emitted to the frontend, by name.")
+	for _, event := range sortedKeys(m.Events) {
+		fmt.Fprintf(&sb, "gomad_bridge_events_total{event=%s} %d\n", promLabel(event), m.Events[event])
+	}
+
+	promHeader(&sb, "gomad_bridge_eval_latency_seconds", "histogram", "Time from queuing a script to running it on the UI thread.")
+	writeHistogram(&sb, "gomad_bridge_eval_latency_seconds", "", m.EvalLatency)
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func promHeader(sb *strings.Builder, name, kind, help string) {
+	fmt.Fprintf(sb, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+func writeHistogram(sb *strings.Builder, name, labels string, h Histogram) {
+	for i, le := range h.Buckets {
+		fmt.Fprintf(sb, "%s_bucket{%sle=\"%s\"} %d\n", name, labels, strconv.FormatFloat(le, 'g', -1, 64), h.Counts[i])
+	}
+	fmt.Fprintf(sb, "%s_bucket{%sle=\"+Inf\"} %d\n", name, labels, h.Count)
+	trimmed := strings.TrimSuffix(labels, ",")
+	if trimmed != "" {
+		trimmed = "{" + trimmed + "}"
+	}
+	fmt.Fprintf(sb, "%s_sum%s %s\n", name, trimmed, strconv.FormatFloat(h.Sum, 'g', -1, 64))
+	fmt.Fprintf(sb, "%s_count%s %d\n", name, trimmed, h.Count)
+}
+
+// promLabel, Prometheus etiket değerini tırnaklar ve kaçışlar.
+func promLabel(v string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(v) + `"`
+}
+
+func sortedKeys[K int | string, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
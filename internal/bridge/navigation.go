@@ -0,0 +1,114 @@
+package bridge
+
+import "sync"
+
+// ============================================================
+// NAVIGATION — Sayfanın gezinme geçmişi
+// ------------------------------------------------------------
+// WebView motorları geçmişi Go'ya açmaz; konum sayfadan öğrenilir.
+// NavigationScript her sayfa yüklemesinde ve sayfa içi gezinmede (pushState,
+// popstate, hashchange) konumu gomad.navigation ile bildirir. Navigation
+// API'si (navigation.canGoBack) olan motorlarda geri/ileri bilgisi kesindir;
+// olmayanlarda geri için history.length kullanılır ve ileri false kabul
+// edilir.
+//
+// Bildirim köprü üzerinden geldiğinden origin doğrulamasını geçemeyen
+// sayfalarda durum güncellenmez; geri/ileri komutları yine çalışır.
+// ============================================================
+
+// NavigationBinding, sayfanın geçmişteki konumunu bildirdiği yerleşik
+// fonksiyondur.
+const NavigationBinding = "gomad.navigation"
+
+// NavigationState, sayfanın gezinme geçmişindeki konumudur.
+type NavigationState struct {
+	URL          string `json:"url"`
+	Title        string `json:"title"`
+	CanGoBack    bool   `json:"canGoBack"`
+	CanGoForward bool   `json:"canGoForward"`
+}
+
+// navigation, son bildirilen konum ve aboneleridir.
+type navigation struct {
+	state    NavigationState
+	handlers []func(NavigationState)
+	mu       sync.RWMutex
+}
+
+// Navigation() → Sayfanın en son bildirdiği gezinme durumunu döner.
+func (b *Bridge) Navigation() NavigationState {
+	b.nav.mu.RLock()
+	defer b.nav.mu.RUnlock()
+	return b.nav.state
+}
+
+// OnNavigation() → Gezinme durumu değiştiğinde çağrılacak callback ekler.
+// ------------------------------------------------------------
+// Callback'ler bildirimi yapan çağrının içinde (UI thread'inde) çalışır.
+func (b *Bridge) OnNavigation(fn func(NavigationState)) {
+	if fn == nil {
+		return
+	}
+	b.nav.mu.Lock()
+	b.nav.handlers = append(b.nav.handlers, fn)
+	b.nav.mu.Unlock()
+}
+
+// handleNavigation() → gomad.navigation binding'inin Go karşılığı.
+func (b *Bridge) handleNavigation(state NavigationState) {
+	b.nav.mu.Lock()
+	changed := b.nav.state != state
+	b.nav.state = state
+	handlers := make([]func(NavigationState), len(b.nav.handlers))
+	copy(handlers, b.nav.handlers)
+	b.nav.mu.Unlock()
+
+	if !changed {
+		return
+	}
+	for _, fn := range handlers {
+		fn(state)
+	}
+}
+
+// NavigationScript, sayfanın konumunu her değişimde bildiren init
+// scriptidir; köprü kodundan sonra enjekte edilir.
+const NavigationScript = `
+(function() {
+    if (!window.gomad || !window.gomad.call) return;
+    let last = '';
+    const report = () => {
+        const nav = window.navigation;
+        const state = {
+            url: location.href,
+            title: document.title || '',
+            canGoBack: nav && 'canGoBack' in nav ? nav.canGoBack : history.length > 1,
+            canGoForward: nav && 'canGoForward' in nav ? nav.canGoForward : false
+        };
+        const key = JSON.stringify(state);
+        if (key === last) return;
+        last = key;
+        window.gomad.call('` + NavigationBinding + `', state).catch(function() {});
+    };
+    // pushState/replaceState olay üretmez; sarmalanır
+    for (const name of ['pushState', 'replaceState']) {
+        const original = history[name];
+        history[name] = function(...args) {
+            const result = original.apply(this, args);
+            setTimeout(report, 0);
+            return result;
+        };
+    }
+    window.addEventListener('popstate', () => setTimeout(report, 0));
+    window.addEventListener('hashchange', report);
+    if (window.navigation && window.navigation.addEventListener) {
+        window.navigation.addEventListener('currententrychange', () => setTimeout(report, 0));
+    }
+    // Başlık yüklemeden sonra gelir
+    if (document.readyState === 'loading') {
+        document.addEventListener('DOMContentLoaded', report, { once: true });
+    }
+    window.addEventListener('load', report, { once: true });
+    report();
+})();
+`
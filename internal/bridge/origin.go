@@ -0,0 +1,150 @@
+package bridge
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ============================================================
+// ORIGIN — Sayfadan gelen mesajların doğrulanması
+// ------------------------------------------------------------
+// WebView'in init scriptleri ve __gomad_invoke binding'i her sayfa
+// yüklemesinde yeniden eklenir; pencere uzak bir sayfaya yönlenirse (bir link,
+// bir yönlendirme) o sayfa da yetkili Go binding'lerini çağırabilir. Guard
+// bunu iki katmanda engeller:
+//
+//   - Init scripti yalnızca beklenen origin'de ve en üst çerçevede (iframe
+//     değil) mesajlara oturum token'ını ekler; token bir closure'da tutulur
+//     ve sayfa scriptleri ona erişemez.
+//   - Köprü token'ı ve mesajdaki origin'i doğrular; uymayan mesajlar
+//     ErrCodeForbidden ile reddedilir ve hiçbir binding çalışmaz.
+//
+// Guard yalnızca sayfadan gelen mesajlara (HandleMessageAsync) uygulanır;
+// HandleMessage'ı doğrudan kullanan headless mod, kayıt tekrarı ve ölçümler
+// etkilenmez.
+// ============================================================
+
+// originGuard, EnableOriginCheck ile kurulan doğrulama ayarlarıdır.
+type originGuard struct {
+	token   string
+	origins []string // Boşsa yalnızca token ve çerçeve doğrulanır
+}
+
+// EnableOriginCheck() → Sayfadan gelen mesajlar için origin doğrulamasını açar.
+// ------------------------------------------------------------
+// origins, mesaj kabul edilecek origin'lerdir (ör. "https://app.example.com",
+// "http://localhost:5173"); boşsa ya da "*" içeriyorsa her origin kabul edilir
+// ancak token ve çerçeve doğrulaması yine yapılır. Dönen oturum token'ı init scriptine
+// (GuardScript) gömülmelidir.
+func (b *Bridge) EnableOriginCheck(origins ...string) (token string) {
+	var buf [16]byte
+	_, _ = rand.Read(buf[:])
+	g := &originGuard{token: hex.EncodeToString(buf[:])}
+	for _, o := range origins {
+		if o == "*" {
+			g.origins = nil
+			break
+		}
+		if o = NormalizeOrigin(o); o != "" {
+			g.origins = append(g.origins, o)
+		}
+	}
+
+	b.guardMu.Lock()
+	b.guard = g
+	b.guardMu.Unlock()
+	return g.token
+}
+
+// GuardScript() → Init scriptinin mesajlara token eklemesi için JS ifadesi.
+// ------------------------------------------------------------
+// Dönen ifade, en üst çerçevede ve izin verilen bir origin'de token'ı,
+// aksi halde boş string'i verir. EnableOriginCheck çağrılmadıysa da boş
+// string ifadesi döner.
+func (b *Bridge) GuardScript() string {
+	b.guardMu.RLock()
+	g := b.guard
+	b.guardMu.RUnlock()
+	if g == nil {
+		return `""`
+	}
+	token, _ := json.Marshal(g.token)
+	origins, _ := json.Marshal(g.origins)
+	if len(g.origins) == 0 {
+		origins = []byte("[]")
+	}
+	return fmt.Sprintf("(window === window.top && (%s.length === 0 || %s.indexOf(%s) >= 0) ? %s : \"\")",
+		origins, origins, OriginScript, token)
+}
+
+// OriginScript, sayfanın origin'ini NormalizeOrigin ile aynı biçimde veren JS
+// ifadesidir; init scripti bunu mesajların origin alanına yazar.
+const OriginScript = `(/^https?:$/.test(location.protocol) ? location.origin : "null")`
+
+// checkOrigin() → Mesaj guard'dan geçemezse JS'e dönecek hata cevabını döner.
+// Geçerse token mesajdan silinir (gözlemcilere ve kayıtlara sızmaz) ve nil döner.
+func (b *Bridge) checkOrigin(msg *Message) *Message {
+	b.guardMu.RLock()
+	g := b.guard
+	b.guardMu.RUnlock()
+
+	token := msg.Token
+	msg.Token = ""
+	if g == nil {
+		return nil
+	}
+
+	var reason string
+	switch {
+	case subtle.ConstantTimeCompare([]byte(token), []byte(g.token)) != 1:
+		reason = "message did not come from the application page"
+	case len(g.origins) > 0 && !g.allows(msg.Origin):
+		reason = "origin not allowed: " + msg.Origin
+	default:
+		return nil
+	}
+
+	b.logger.Warn("rejected message from untrusted page",
+		"origin", msg.Origin, "method", msg.Method, "reason", reason)
+	response := NewErrorMessage(msg.ID, ErrCodeForbidden, "forbidden", reason)
+	return response
+}
+
+// allows, origin'in izin listesinde olup olmadığını döner.
+func (g *originGuard) allows(origin string) bool {
+	origin = NormalizeOrigin(origin)
+	for _, o := range g.origins {
+		if o == origin {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeOrigin() → URL ya da origin'i "scheme://host[:port]" biçimine getirir.
+// ------------------------------------------------------------
+// Yol, sorgu ve sondaki "/" atılır; http ve https dışındaki şemalarda (file:,
+// data:, about:) tarayıcı origin'i "null" olduğu için "null" döner. Geçersiz
+// girdide "" döner.
+func NormalizeOrigin(s string) string {
+	if s == "null" {
+		return s
+	}
+	u, err := url.Parse(strings.TrimSpace(s))
+	if err != nil || u.Scheme == "" {
+		return ""
+	}
+	scheme := strings.ToLower(u.Scheme)
+	if scheme != "http" && scheme != "https" {
+		return "null"
+	}
+	if u.Host == "" {
+		return ""
+	}
+	return scheme + "://" + strings.ToLower(u.Host)
+}
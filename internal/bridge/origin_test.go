@@ -0,0 +1,74 @@
+package bridge
+
+import "testing"
+
+// pageCallJSON, init scriptinin sayfa alanlarını doldurduğu bir çağrı mesajı üretir.
+func pageCallJSON(t testing.TB, id, method string, fill func(*Message)) string {
+	t.Helper()
+	msg, err := FromJSON([]byte(callJSON(t, id, method)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fill(msg)
+	data, err := msg.ToJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestNormalizeOrigin(t *testing.T) {
+	tests := map[string]string{
+		"https://App.Example.com/path?q=1": "https://app.example.com",
+		"http://localhost:5173/":           "http://localhost:5173",
+		"file:///index.html":               "null",
+		"data:text/html,hi":                "null",
+		"null":                             "null",
+		"not a url":                        "",
+		"https://":                         "",
+	}
+	for in, want := range tests {
+		if got := NormalizeOrigin(in); got != want {
+			t.Errorf("NormalizeOrigin(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestOriginCheck(t *testing.T) {
+	b := NewBridge(nopEvaluator{})
+	called := 0
+	if err := b.Bind("secret", func() string { called++; return "ok" }); err != nil {
+		t.Fatal(err)
+	}
+	token := b.EnableOriginCheck("http://app.test/")
+	ui := func(string) bool { return true }
+
+	tests := []struct {
+		name          string
+		token, origin string
+		wantCode      int // 0 ise çağrı çalışmalıdır
+	}{
+		{"trusted", token, "http://app.test", 0},
+		{"missing token", "", "http://app.test", ErrCodeForbidden},
+		{"wrong token", "x" + token, "http://app.test", ErrCodeForbidden},
+		{"foreign origin", token, "https://evil.test", ErrCodeForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called = 0
+			msg := pageCallJSON(t, "1", "secret", func(m *Message) { m.Token, m.Origin = tt.token, tt.origin })
+			resp := parseResponse(t, b.HandleMessageAsync(msg, ui, nil))
+			if tt.wantCode == 0 {
+				wantResult(t, resp, "1", "ok")
+				return
+			}
+			wantError(t, resp, tt.wantCode)
+			if called != 0 {
+				t.Fatal("rejected call ran the binding")
+			}
+		})
+	}
+
+	// Doğrudan HandleMessage (headless mod) guard'a tabi değildir
+	wantResult(t, parseResponse(t, b.HandleMessage(callJSON(t, "2", "secret"))), "2", "ok")
+}
@@ -0,0 +1,153 @@
+package bridge
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// ============================================================
+// RECORD & REPLAY
+// ------------------------------------------------------------
+// Recorder köprü trafiğini satır başına bir Traffic olacak şekilde (JSON
+// Lines) yazar. Replay, kaydedilmiş JS → Go mesajlarını aynı sırayla
+// HandleMessage'a geri verir ve üretilen cevapları kayıttakilerle
+// karşılaştırır; böylece bir hata raporu deterministik olarak yeniden
+// üretilebilir.
+// ============================================================
+
+// Recorder, trafiği bir io.Writer'a yazar. Observe ile bağlanır:
+//
+//	rec := bridge.NewRecorder(file)
+//	cancel := b.Observe(rec.Record)
+type Recorder struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+	mu  sync.Mutex
+}
+
+// NewRecorder, w'ya yazan bir Recorder oluşturur.
+func NewRecorder(w io.Writer) *Recorder {
+	bw := bufio.NewWriter(w)
+	return &Recorder{w: bw, enc: json.NewEncoder(bw)}
+}
+
+// Record, tek bir mesajı kaydeder. Her satır hemen diske yazılır; uygulama
+// çökse bile o ana kadarki trafik kaybolmaz.
+func (r *Recorder) Record(t Traffic) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.enc.Encode(t); err == nil {
+		r.w.Flush()
+	}
+}
+
+// ReadRecording, Recorder çıktısını okur.
+func ReadRecording(r io.Reader) ([]Traffic, error) {
+	var list []Traffic
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var t Traffic
+		if err := json.Unmarshal(scanner.Bytes(), &t); err != nil {
+			return nil, fmt.Errorf("recording line %d: %w", line, err)
+		}
+		if t.Message == nil {
+			return nil, fmt.Errorf("recording line %d: missing message", line)
+		}
+		list = append(list, t)
+	}
+	return list, scanner.Err()
+}
+
+// ReplayMismatch, tekrar oynatılan bir çağrının kayıttakinden farklı cevap
+// ürettiği durumdur.
+type ReplayMismatch struct {
+	Call *Message `json:"call"`
+	Want *Message `json:"want"` // Kayıttaki cevap (kayıtta yoksa nil)
+	Got  *Message `json:"got"`
+}
+
+// Replay() → Kaydı köprüden tekrar geçirir.
+// ------------------------------------------------------------
+// JS → Go mesajları kayıttaki sırayla HandleMessage'a verilir. realtime true
+// ise mesajlar arasındaki orijinal süreler beklenir (zamanlamaya bağlı hatalar
+// için); aksi halde art arda gönderilir. Cevabı kayıttakiyle aynı olmayan
+// çağrılar döner; sonuç ve hata mesajları JSON olarak karşılaştırılır.
+func (b *Bridge) Replay(recording []Traffic, realtime bool) []ReplayMismatch {
+	// Kayıttaki cevaplar, çağrı kimliğine göre
+	want := make(map[string]*Message)
+	for _, t := range recording {
+		msg := t.Message
+		if t.Direction == DirectionOut && msg.ID != "" && (msg.Type == MessageTypeResult || msg.Type == MessageTypeError) {
+			want[msg.ID] = msg
+		}
+	}
+
+	var mismatches []ReplayMismatch
+	var last time.Time
+	for _, t := range recording {
+		if t.Direction != DirectionIn {
+			continue
+		}
+		if realtime && !last.IsZero() {
+			time.Sleep(t.Time.Sub(last))
+		}
+		last = t.Time
+
+		data, err := t.Message.ToJSON()
+		if err != nil {
+			continue
+		}
+		b.logger.Debug("replaying message", "id", t.Message.ID, "method", t.Message.Method)
+		response := b.HandleMessage(string(data))
+		if t.Message.Type != MessageTypeCall {
+			continue
+		}
+
+		got, err := FromJSON([]byte(response))
+		if err != nil {
+			got = NewErrorMessage(t.Message.ID, ErrCodeUnknown, "invalid response", err.Error())
+		}
+		if expected := want[t.Message.ID]; !sameResponse(expected, got) {
+			mismatches = append(mismatches, ReplayMismatch{Call: t.Message, Want: expected, Got: got})
+		}
+	}
+	return mismatches
+}
+
+// sameResponse, iki cevabın türü, sonucu ve hata mesajı aynı mı döner.
+// Zaman damgası ve ayrıntılar (stack vb.) karşılaştırılmaz.
+func sameResponse(want, got *Message) bool {
+	if want == nil || got == nil {
+		return want == got
+	}
+	if want.Type != got.Type {
+		return false
+	}
+	if want.Type == MessageTypeError {
+		return want.Error != nil && got.Error != nil && want.Error.Message == got.Error.Message
+	}
+	return jsonEqual(want.Result, got.Result)
+}
+
+// jsonEqual, iki JSON değerini anlamca (alan sırası ve boşluklar hariç) karşılaştırır.
+func jsonEqual(a, b json.RawMessage) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(bytes.TrimSpace(a)) == len(bytes.TrimSpace(b))
+	}
+	var x, y interface{}
+	if json.Unmarshal(a, &x) != nil || json.Unmarshal(b, &y) != nil {
+		return bytes.Equal(a, b)
+	}
+	ax, _ := json.Marshal(x)
+	by, _ := json.Marshal(y)
+	return bytes.Equal(ax, by)
+}
@@ -1,11 +1,15 @@
 package bridge
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
+	"runtime/debug"
 	"sync"
+	"sync/atomic"
 
 	gomerrors "github.com/biyonik/gomad/internal/errors"
 )
@@ -54,14 +58,25 @@ type BoundFunc struct {
 	// Type is the function's reflect.Type.
 	Type reflect.Type
 
-	// NumIn is the number of input parameters.
+	// NumIn is the number of arguments JS passes (context parametresi hariç).
 	NumIn int
 
+	// MinIn is the number of parameters JS must pass. Sondaki pointer,
+	// interface ve json.RawMessage parametreleri atlanabilir; nil gelir.
+	MinIn int
+
 	// NumOut is the number of output values.
 	NumOut int
 
 	// HasError indicates if the last return value is an error.
 	HasError bool
+
+	// Context, ilk parametrenin context.Context olduğunu belirtir; çağrıda
+	// köprünün kök context'i verilir (bkz. Bridge.SetContext).
+	Context bool
+
+	// typed, TypedFunc ile kaydedilmiş binding'lerin reflection'sız çağrısıdır.
+	typed func(args []json.RawMessage) (interface{}, error)
 }
 
 // ======================================================================================================================
@@ -87,6 +102,22 @@ type BoundFunc struct {
 type Registry struct {
 	funcs map[string]*BoundFunc
 	mu    sync.RWMutex
+
+	// Çağrı yolu sayaçları (bkz. Bridge.Stats)
+	reflectCalls atomic.Uint64
+	typedCalls   atomic.Uint64
+
+	// Bağlı fonksiyon panic ettiğinde çağrılır (opsiyonel)
+	onPanic func(name string, err *gomerrors.PanicError)
+
+	// Hata detaylarına panic yığını eklensin mi (bkz. Bridge.SetErrorPolicy)
+	stacks atomic.Bool
+
+	// Sonuçların JSON politikası (bkz. Bridge.SetJSONEncoding); nil → encoding/json
+	encoding atomic.Pointer[JSONEncoding]
+
+	// Context alan binding'lere verilen kök context (bkz. Bridge.SetContext)
+	ctx atomic.Pointer[context.Context]
 }
 
 // NewRegistry creates a new function registry.
@@ -109,6 +140,12 @@ func NewRegistry() *Registry {
 //
 // T: JSON serileştirilebilir her tür olabilir.
 //
+// İlk parametre context.Context ise JS'ten gelmez; köprünün kök context'i
+// verilir (bkz. Bridge.SetContext) ve uygulama kapanırken iptal edilir.
+//
+// Pointer, interface (any) ve json.RawMessage parametreleri null'da nil alır;
+// sondakiler JS'de atlanabilir. json.RawMessage argümanı çözülmeden iletilir.
+//
 // Validasyonlar:
 //
 //	✔ İsim boş olamaz
@@ -125,6 +162,10 @@ func (r *Registry) Register(name string, fn interface{}) error {
 		return gomerrors.NewBindingError(name, "function cannot be nil", nil)
 	}
 
+	if tf, ok := fn.(TypedFunc); ok {
+		return r.registerTyped(name, tf)
+	}
+
 	fnVal := reflect.ValueOf(fn)
 	fnType := fnVal.Type()
 
@@ -158,13 +199,22 @@ func (r *Registry) Register(name string, fn interface{}) error {
 		return gomerrors.NewBindingError(name, "second return value must be error", nil)
 	}
 
+	for i := 0; i < fnType.NumIn(); i++ {
+		if err := checkValidation(fnType.In(i)); err != nil {
+			return gomerrors.NewBindingError(name, "invalid validate tag", err)
+		}
+	}
+
+	skip := ctxParams(fnType)
 	bound := &BoundFunc{
 		Name:     name,
 		Fn:       fnVal,
 		Type:     fnType,
-		NumIn:    fnType.NumIn(),
+		NumIn:    fnType.NumIn() - skip,
+		MinIn:    minIn(fnType),
 		NumOut:   numOut,
 		HasError: hasError,
+		Context:  skip == 1,
 	}
 
 	r.mu.Lock()
@@ -196,6 +246,18 @@ func (r *Registry) Has(name string) bool {
 	return exists
 }
 
+// NumIn returns the number of arguments a registered function takes.
+// Ayrı süreçteki eklentiler metodlarını host'a bu sayılarla bildirir.
+func (r *Registry) NumIn(name string) (int, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	bound, exists := r.funcs[name]
+	if !exists {
+		return 0, false
+	}
+	return bound.NumIn, true
+}
+
 // List returns all registered function names.
 // Debug, inspection veya UI tarafında görüntüleme için kullanılabilir.
 func (r *Registry) List() []string {
@@ -209,6 +271,18 @@ func (r *Registry) List() []string {
 	return names
 }
 
+// Signature returns the Go signature of a registered function (ör. "func(string, int) (main.User, error)").
+// Inspector gibi geliştirici araçlarında binding'leri listelemek için kullanılır.
+func (r *Registry) Signature(name string) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	bound, exists := r.funcs[name]
+	if !exists {
+		return "", false
+	}
+	return bound.Type.String(), true
+}
+
 // Call invokes a registered function with the given arguments.
 // Message içindeki JSON argümanları çözer, fonksiyona uygun tipe çevirir ve çalıştırır.
 //
@@ -220,7 +294,7 @@ func (r *Registry) Call(name string, argsJSON json.RawMessage) (interface{}, err
 	r.mu.RUnlock()
 
 	if !exists {
-		return nil, gomerrors.NewBindingError(name, "not found", gomerrors.ErrNotFound)
+		return nil, &lookupError{gomerrors.NewBindingError(name, "not found", gomerrors.ErrNotFound)}
 	}
 
 	// Argüman çözme
@@ -231,15 +305,43 @@ func (r *Registry) Call(name string, argsJSON json.RawMessage) (interface{}, err
 		}
 	}
 
-	if len(rawArgs) != bound.NumIn {
+	if len(rawArgs) < bound.MinIn || len(rawArgs) > bound.NumIn {
+		expected := fmt.Sprintf("%d", bound.NumIn)
+		if bound.MinIn < bound.NumIn {
+			expected = fmt.Sprintf("%d to %d", bound.MinIn, bound.NumIn)
+		}
 		return nil, gomerrors.NewBindingError(name,
-			fmt.Sprintf("expected %d arguments, got %d", bound.NumIn, len(rawArgs)),
+			fmt.Sprintf("expected %s arguments, got %d", expected, len(rawArgs)),
 			gomerrors.ErrInvalidArgument)
 	}
+	// Atlanan opsiyonel argümanlar nil olarak çözülür (bkz. nullArg)
+	for len(rawArgs) < bound.NumIn {
+		rawArgs = append(rawArgs, nil)
+	}
+	if enc := r.encoding.Load(); enc.decodes() {
+		for i := range rawArgs {
+			normalized, err := enc.normalizeArg(rawArgs[i], bound.in(i))
+			if err != nil {
+				return nil, gomerrors.NewBindingError(name,
+					fmt.Sprintf("failed to convert argument %d to %s", i, bound.in(i).String()), err)
+			}
+			rawArgs[i] = normalized
+		}
+	}
+
+	if bound.typed != nil {
+		r.typedCalls.Add(1)
+		return r.invokeTyped(bound, rawArgs)
+	}
+	r.reflectCalls.Add(1)
 
 	args := make([]reflect.Value, bound.NumIn)
 	for i := 0; i < bound.NumIn; i++ {
-		argType := bound.Type.In(i)
+		argType := bound.in(i)
+		if optionalParam(argType) && nullArg(rawArgs[i]) {
+			args[i] = reflect.Zero(argType)
+			continue
+		}
 		argPtr := reflect.New(argType)
 
 		if err := json.Unmarshal(rawArgs[i], argPtr.Interface()); err != nil {
@@ -251,11 +353,126 @@ func (r *Registry) Call(name string, argsJSON json.RawMessage) (interface{}, err
 		args[i] = argPtr.Elem()
 	}
 
-	results := bound.Fn.Call(args)
+	var verr *ValidationError
+	for i := range args {
+		if e := validateArg(i, args[i]); e != nil {
+			if verr == nil {
+				verr = e
+				continue
+			}
+			verr.Fields = append(verr.Fields, e.Fields...)
+		}
+	}
+	if verr != nil {
+		return nil, gomerrors.NewBindingError(name, "invalid arguments", verr)
+	}
+
+	if bound.Context {
+		args = append([]reflect.Value{reflect.ValueOf(r.context())}, args...)
+	}
+	results, err := r.invoke(bound, args)
+	if err != nil {
+		return nil, err
+	}
 
 	return processResults(bound, results)
 }
 
+// optionalParam, JS'nin atlayabileceği ya da null geçebileceği parametre
+// tiplerini döner: pointer'lar, interface'ler (any) ve json.RawMessage.
+// Bunlar null ya da eksik argümanda nil alır; diğer tipler null'da
+// json.Unmarshal'ın bıraktığı sıfır değeri alır.
+func optionalParam(t reflect.Type) bool {
+	return t.Kind() == reflect.Pointer || t.Kind() == reflect.Interface || t == rawMessageType
+}
+
+// minIn, sondaki opsiyonel parametreler atlandığında gereken argüman
+// sayısıdır; context parametresi sayılmaz.
+func minIn(fnType reflect.Type) int {
+	skip := ctxParams(fnType)
+	n := fnType.NumIn()
+	for n > skip && optionalParam(fnType.In(n-1)) {
+		n--
+	}
+	return n - skip
+}
+
+// in, i'nci JS argümanının Go tipini döner.
+func (f *BoundFunc) in(i int) reflect.Type {
+	if f.Context {
+		i++
+	}
+	return f.Type.In(i)
+}
+
+// nullArg, argümanın atlanmış ya da JSON null olup olmadığını döner.
+func nullArg(raw json.RawMessage) bool {
+	raw = bytes.TrimSpace(raw)
+	return len(raw) == 0 || string(raw) == "null"
+}
+
+// SetPanicHandler sets the callback invoked when a bound function panics.
+// Panic yakalanır ve çağrı ErrCodeExecution hatası olarak döner; süreç çökmez.
+// Handler, stack trace'i loglamak veya çökme raporu yazmak için kullanılır.
+func (r *Registry) SetPanicHandler(fn func(name string, err *gomerrors.PanicError)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.onPanic = fn
+}
+
+// invoke calls the bound function, converting a panic into an error.
+// Tek bir hatalı handler'ın tüm uygulamayı düşürmesini engeller.
+func (r *Registry) invoke(bound *BoundFunc, args []reflect.Value) (results []reflect.Value, err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			perr := gomerrors.NewPanicError(rec, debug.Stack())
+
+			r.mu.RLock()
+			handler := r.onPanic
+			r.mu.RUnlock()
+			if handler != nil {
+				handler(bound.Name, perr)
+			}
+
+			results = nil
+			err = gomerrors.NewBindingError(bound.Name, "handler panicked", perr)
+		}
+	}()
+
+	return bound.Fn.Call(args), nil
+}
+
+// invokeTyped calls a TypedFunc binding, converting a panic into an error.
+// invoke ile aynı panic korumasını reflection olmadan sağlar.
+func (r *Registry) invokeTyped(bound *BoundFunc, args []json.RawMessage) (result interface{}, err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			perr := gomerrors.NewPanicError(rec, debug.Stack())
+
+			r.mu.RLock()
+			handler := r.onPanic
+			r.mu.RUnlock()
+			if handler != nil {
+				handler(bound.Name, perr)
+			}
+
+			result = nil
+			err = gomerrors.NewBindingError(bound.Name, "handler panicked", perr)
+		}
+	}()
+
+	result, err = bound.typed(args)
+	var aerr *argError
+	if errors.As(err, &aerr) {
+		if verr, ok := aerr.err.(*ValidationError); ok {
+			return nil, gomerrors.NewBindingError(bound.Name, "invalid arguments", verr)
+		}
+		return nil, gomerrors.NewBindingError(bound.Name,
+			fmt.Sprintf("failed to convert argument %d to %s", aerr.index, aerr.typ), aerr.err)
+	}
+	return result, err
+}
+
 // processResults converts reflect.Value results to interface{} and error.
 // Fonksiyon dönüş tiplerini çözerek JS'ye uygun hâle getirir.
 func processResults(bound *BoundFunc, results []reflect.Value) (interface{}, error) {
@@ -287,6 +504,16 @@ func processResults(bound *BoundFunc, results []reflect.Value) (interface{}, err
 	}
 }
 
+// lookupError, Registry'nin kendi fonksiyon aramasının başarısız olduğunu
+// işaretler. Handler'ların döndüğü ErrNotFound hataları (ör. bilinmeyen tema)
+// ErrCodeMethodNotFound'a çevrilmez; yalnızca bu tip çevrilir.
+type lookupError struct {
+	err *gomerrors.BindingError
+}
+
+func (e *lookupError) Error() string { return e.err.Error() }
+func (e *lookupError) Unwrap() error { return e.err }
+
 // CallWithMessage is a convenience method that handles a full Message.
 // Call gibi çalışır fakat parametreyi Message alır ve Message döner.
 // Yani JS <-> Go mesaj protokolünün tam döngü wrapper'ıdır.
@@ -297,16 +524,35 @@ func (r *Registry) CallWithMessage(msg *Message) *Message {
 
 	result, err := r.Call(msg.Method, msg.Args)
 	if err != nil {
+		var perr *gomerrors.PanicError
+		if c := gomerrors.CodeOf(err); c != nil && !errors.As(err, &perr) {
+			// Uygulamanın kayıtlı hata kodu (bkz. gomerrors.NewCode); panic'ler
+			// her zaman ErrCodeExecution olarak kalır
+			response := NewErrorMessage(msg.ID, c.Value, err.Error(), errorDetails(err, r.stacks.Load()))
+			response.Error.Name = c.Name
+			response.Error.public = c.Error()
+			var coded *gomerrors.CodedError
+			if errors.As(err, &coded) {
+				response.Error.public = coded.Message
+			}
+			return response
+		}
 		code := ErrCodeExecution
-		if errors.Is(err, gomerrors.ErrNotFound) {
+		var lerr *lookupError
+		if errors.As(err, &lerr) {
 			code = ErrCodeMethodNotFound
 		} else if errors.Is(err, gomerrors.ErrInvalidArgument) {
 			code = ErrCodeInvalidArgs
 		}
-		return NewErrorMessage(msg.ID, code, err.Error(), "")
+		response := NewErrorMessage(msg.ID, code, err.Error(), errorDetails(err, r.stacks.Load()))
+		var verr *ValidationError
+		if errors.As(err, &verr) {
+			response.Error.Fields = verr.Fields
+		}
+		return response
 	}
 
-	resultMsg, err := NewResultMessage(msg.ID, result)
+	resultMsg, err := newResultMessage(msg.ID, result, r.encoding.Load())
 	if err != nil {
 		return NewErrorMessage(msg.ID, ErrCodeExecution, "failed to serialize result", err.Error())
 	}
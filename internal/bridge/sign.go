@@ -0,0 +1,217 @@
+package bridge
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+)
+
+// ============================================================
+// SIGN — Köprü mesajlarının imzalanması
+// ------------------------------------------------------------
+// Origin token'ı (bkz. EnableOriginCheck) her mesajla birlikte taşınır; sayfaya
+// XSS ile sızan bir script WebView'in mesaj kanalını (postMessage) sararak
+// token'ı yakalayabilir ve ardından bekleyen Go → JS çağrılarına sahte
+// result/error mesajları ya da kendi çağrılarını gönderebilir. İmzalama açıkken
+// init scripti her mesajı oturum anahtarıyla HMAC-SHA256 imzalar; anahtar
+// init scriptinin closure'ında kalır ve kanaldan hiç geçmez. İmzası tutmayan
+// mesajlar ErrCodeForbidden ile reddedilir.
+//
+// İmza; id, tip, metod, zaman damgası, argümanlar, sonuç ve hata alanlarını
+// kapsar (bkz. signingInput). Go'nun JS'e döndüğü cevaplar da aynı anahtarla
+// imzalanır (bkz. sealResponse); init scripti imzayı doğrulamadan bekleyen
+// çağrıyı çözmez ve cevap alıcısı window.gomad üzerinde açık değildir.
+//
+// İmza mesajın init scriptinden geçtiğini kanıtlar, hangi scriptin
+// window.gomad.call'ı çağırdığını değil: sayfada çalışan her script sayfanın
+// kendisi kadar çağrı yapabilir. Bir sayfanın çağırabileceği binding'ler
+// SetCapabilities ile daraltılır. Mesajlar şifrelenmez ve şifreleme bu
+// özelliğin kapsamı dışındadır: kanal süreç içidir, anahtar ile aynı sayfada
+// durur ve sayfaya sızan bir script düz metni zaten window.gomad üzerinden
+// görebilir; şifreleme bu tehdide karşı bir şey katmaz.
+// ============================================================
+
+// EnableMessageSigning() → Mesaj imzalamayı ve imza doğrulamasını açar.
+// Anahtarı JS'e gömen ifade SignScript ile alınır.
+func (b *Bridge) EnableMessageSigning() {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+
+	b.guardMu.Lock()
+	b.signKey = key
+	b.guardMu.Unlock()
+}
+
+// SignScript() → Mesajları imzalayan JS nesne ifadesi.
+// ------------------------------------------------------------
+// Dönen nesnenin message(msg) metodu sayfadan giden mesajın, text(s) metodu
+// Go'dan gelen cevap metninin hex imzasını verir; imzalama kapalıysa null.
+// WebCrypto güvenli olmayan origin'lerde (ör. HTML içerik) bulunmadığı ve
+// senkron olmadığı için SHA-256 scriptin içinde hesaplanır.
+func (b *Bridge) SignScript() string {
+	b.guardMu.RLock()
+	key := b.signKey
+	b.guardMu.RUnlock()
+	if key == nil {
+		return "null"
+	}
+	return "(" + signJS + `)("` + hex.EncodeToString(key) + `")`
+}
+
+// checkSignature() → İmza tutmuyorsa JS'e dönecek hata cevabını döner.
+// Geçerse imza mesajdan silinir ve nil döner.
+func (b *Bridge) checkSignature(msg *Message) *Message {
+	b.guardMu.RLock()
+	key := b.signKey
+	b.guardMu.RUnlock()
+
+	sig := msg.Sig
+	msg.Sig = ""
+	if key == nil {
+		return nil
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(signingInput(msg)))
+	want := mac.Sum(nil)
+	got, err := hex.DecodeString(sig)
+	if err == nil && hmac.Equal(got, want) {
+		return nil
+	}
+
+	b.logger.Warn("rejected message with invalid signature", "origin", msg.Origin, "type", msg.Type, "method", msg.Method)
+	response := NewErrorMessage(msg.ID, ErrCodeForbidden, "forbidden", "invalid message signature")
+	return response
+}
+
+// sealResponse() → Cevabı JS'e gideceği biçime getirir.
+// İmzalama açıksa cevap JSON'unun HMAC-SHA256 imzası (64 hex karakter)
+// başına eklenir; init scripti cevabı çözmeden önce imzayı doğrular.
+// Kapalıysa ya da cevap boşsa cevap aynen döner.
+func (b *Bridge) sealResponse(response string) string {
+	b.guardMu.RLock()
+	key := b.signKey
+	b.guardMu.RUnlock()
+	if key == nil || response == "" {
+		return response
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(response))
+	return hex.EncodeToString(mac.Sum(nil)) + response
+}
+
+// signingInput, imzanın kapsadığı alanları JS ile aynı biçimde birleştirir.
+// Args ve Result JSON'dan çözülürken byte'ı byte'ına korunur; JS tarafı
+// aynı alanları JSON.stringify ile üretir.
+func signingInput(msg *Message) string {
+	var sb strings.Builder
+	sb.WriteString(msg.ID)
+	sb.WriteByte('\n')
+	sb.WriteString(string(msg.Type))
+	sb.WriteByte('\n')
+	sb.WriteString(msg.Method)
+	sb.WriteByte('\n')
+	sb.WriteString(strconv.FormatInt(msg.Timestamp, 10))
+	sb.WriteByte('\n')
+	sb.Write(msg.Args)
+	sb.WriteByte('\n')
+	sb.Write(msg.Result)
+	if msg.Error != nil {
+		sb.WriteByte('\n')
+		sb.WriteString(strconv.Itoa(msg.Error.Code))
+		sb.WriteByte('\n')
+		sb.WriteString(msg.Error.Message)
+	}
+	return sb.String()
+}
+
+// signJS, anahtarı (hex) alıp mesaj ve cevap imzalayan nesneyi döner.
+// message, signingInput ile aynı girdiyi üretir; text, sealResponse gibi
+// metnin kendisini imzalar. HMAC-SHA256 saf JS ile hesaplanır.
+const signJS = `function(keyHex) {
+    const R = [
+        0x428a2f98, 0x71374491, 0xb5c0fbcf, 0xe9b5dba5, 0x3956c25b, 0x59f111f1, 0x923f82a4, 0xab1c5ed5,
+        0xd807aa98, 0x12835b01, 0x243185be, 0x550c7dc3, 0x72be5d74, 0x80deb1fe, 0x9bdc06a7, 0xc19bf174,
+        0xe49b69c1, 0xefbe4786, 0x0fc19dc6, 0x240ca1cc, 0x2de92c6f, 0x4a7484aa, 0x5cb0a9dc, 0x76f988da,
+        0x983e5152, 0xa831c66d, 0xb00327c8, 0xbf597fc7, 0xc6e00bf3, 0xd5a79147, 0x06ca6351, 0x14292967,
+        0x27b70a85, 0x2e1b2138, 0x4d2c6dfc, 0x53380d13, 0x650a7354, 0x766a0abb, 0x81c2c92e, 0x92722c85,
+        0xa2bfe8a1, 0xa81a664b, 0xc24b8b70, 0xc76c51a3, 0xd192e819, 0xd6990624, 0xf40e3585, 0x106aa070,
+        0x19a4c116, 0x1e376c08, 0x2748774c, 0x34b0bcb5, 0x391c0cb3, 0x4ed8aa4a, 0x5b9cca4f, 0x682e6ff3,
+        0x748f82ee, 0x78a5636f, 0x84c87814, 0x8cc70208, 0x90befffa, 0xa4506ceb, 0xbef9a3f7, 0xc67178f2
+    ];
+    const encoder = new TextEncoder();
+    const stringify = JSON.stringify;
+
+    function sha256(bytes) {
+        const len = bytes.length;
+        const padded = new Uint8Array(((len + 9 + 63) >> 6) << 6);
+        padded.set(bytes);
+        padded[len] = 0x80;
+        const view = new DataView(padded.buffer);
+        view.setUint32(padded.length - 8, Math.floor(len / 0x20000000));
+        view.setUint32(padded.length - 4, len << 3);
+        const H = [0x6a09e667, 0xbb67ae85, 0x3c6ef372, 0xa54ff53a, 0x510e527f, 0x9b05688c, 0x1f83d9ab, 0x5be0cd19];
+        const W = new Array(64);
+        for (let off = 0; off < padded.length; off += 64) {
+            for (let i = 0; i < 16; i++) W[i] = view.getUint32(off + i * 4);
+            for (let i = 16; i < 64; i++) {
+                const a = W[i - 15], b = W[i - 2];
+                const s0 = ((a >>> 7) | (a << 25)) ^ ((a >>> 18) | (a << 14)) ^ (a >>> 3);
+                const s1 = ((b >>> 17) | (b << 15)) ^ ((b >>> 19) | (b << 13)) ^ (b >>> 10);
+                W[i] = (W[i - 16] + s0 + W[i - 7] + s1) | 0;
+            }
+            let [a, b, c, d, e, f, g, h] = H;
+            for (let i = 0; i < 64; i++) {
+                const S1 = ((e >>> 6) | (e << 26)) ^ ((e >>> 11) | (e << 21)) ^ ((e >>> 25) | (e << 7));
+                const t1 = (h + S1 + ((e & f) ^ (~e & g)) + R[i] + W[i]) | 0;
+                const S0 = ((a >>> 2) | (a << 30)) ^ ((a >>> 13) | (a << 19)) ^ ((a >>> 22) | (a << 10));
+                const t2 = (S0 + ((a & b) ^ (a & c) ^ (b & c))) | 0;
+                h = g; g = f; f = e; e = (d + t1) | 0;
+                d = c; c = b; b = a; a = (t1 + t2) | 0;
+            }
+            H[0] = (H[0] + a) | 0; H[1] = (H[1] + b) | 0; H[2] = (H[2] + c) | 0; H[3] = (H[3] + d) | 0;
+            H[4] = (H[4] + e) | 0; H[5] = (H[5] + f) | 0; H[6] = (H[6] + g) | 0; H[7] = (H[7] + h) | 0;
+        }
+        const out = new Uint8Array(32);
+        const outView = new DataView(out.buffer);
+        H.forEach((v, i) => outView.setUint32(i * 4, v));
+        return out;
+    }
+
+    const key = new Uint8Array(64);
+    for (let i = 0; i < keyHex.length / 2; i++) key[i] = parseInt(keyHex.substr(i * 2, 2), 16);
+    const ipad = key.map(b => b ^ 0x36);
+    const opad = key.map(b => b ^ 0x5c);
+
+    function concat(a, b) {
+        const out = new Uint8Array(a.length + b.length);
+        out.set(a);
+        out.set(b, a.length);
+        return out;
+    }
+
+    function mac(input) {
+        const sum = sha256(concat(opad, sha256(concat(ipad, encoder.encode(input)))));
+        let hex = '';
+        sum.forEach(b => { hex += (b < 16 ? '0' : '') + b.toString(16); });
+        return hex;
+    }
+
+    return {
+        message: function(message) {
+            let input = message.id + '\n' + message.type + '\n' + (message.method || '') + '\n' +
+                (message.timestamp || 0) + '\n' +
+                (message.args !== undefined ? stringify(message.args) : '') + '\n' +
+                (message.result !== undefined ? stringify(message.result) : '');
+            if (message.error) {
+                input += '\n' + message.error.code + '\n' + message.error.message;
+            }
+            return mac(input);
+        },
+        text: mac
+    };
+}`
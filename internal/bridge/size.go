@@ -0,0 +1,64 @@
+package bridge
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// ============================================================
+// SIZE — Mesaj boyutu sınırı
+// ------------------------------------------------------------
+// Yüzlerce MB'lık bir argüman ya da sonuç JSON'u WebView'i çökertir ya da
+// sessizce kesilir; hatanın nereden geldiği anlaşılmaz. Sınır açıkken bu
+// mesajlar hiç gönderilmez, yerine metodu (ya da olayı) ve boyutu söyleyen
+// ErrCodePayloadTooLarge hatası döner. Büyük veriler için SendStream
+// kullanılmalıdır.
+// ============================================================
+
+// ErrPayloadTooLarge, Emit'in boyut sınırını aşan olay verisinde döndüğü
+// hatadır (errors.Is ile kontrol edilir).
+var ErrPayloadTooLarge = errors.New("payload too large")
+
+// SetMaxMessageSize() → Argüman, sonuç ve olay verisi için bayt sınırı.
+// 0 sınırı kaldırır.
+func (b *Bridge) SetMaxMessageSize(n int) {
+	atomic.StoreInt64(&b.maxSize, int64(n))
+}
+
+// oversized() → size sınırı aşıyorsa sınırı, aşmıyorsa 0 döner.
+func (b *Bridge) oversized(size int) int64 {
+	limit := atomic.LoadInt64(&b.maxSize)
+	if limit > 0 && int64(size) > limit {
+		return limit
+	}
+	return 0
+}
+
+// payloadTooLarge() → Sınırı aşan argüman ya da sonuç için hata cevabı.
+// what: "arguments" ya da "result".
+func (b *Bridge) payloadTooLarge(msg *Message, what string, size int, limit int64) *Message {
+	b.logger.Warn("bridge payload too large",
+		"method", msg.Method, "part", what, "size", size, "limit", limit)
+	verb := "is"
+	if what == "arguments" {
+		verb = "are"
+	}
+	return NewErrorMessage(msg.ID, ErrCodePayloadTooLarge,
+		fmt.Sprintf("payload too large: %s of %q %s %s (limit %s)", what, msg.Method, verb, formatBytes(int64(size)), formatBytes(limit)),
+		"send large data with a stream (gomad.SendStream) instead")
+}
+
+// formatBytes, bayt sayısını okunur biçimde verir (ör. "312.4 MB").
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
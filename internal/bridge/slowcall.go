@@ -0,0 +1,109 @@
+package bridge
+
+import (
+	"bytes"
+	"runtime"
+	"time"
+)
+
+// ============================================================
+// SLOW CALL — Takılan binding'lerin tespiti
+// ------------------------------------------------------------
+// Kilitlenen (deadlock) veya beklenmedik şekilde uzun süren bir binding,
+// JS tarafında hiç çözülmeyen bir promise olarak görünür. Çağrı
+// SetSlowCallThreshold ile verilen yumuşak süreyi aşınca:
+//
+//  1. Çağrıyı işleyen goroutine'in yığın dökümü (stack) loglanır
+//  2. JS'e SlowCallEvent ("bridge:slow-call") gönderilir
+//
+// Çağrı iptal edilmez; bittiğinde toplam süresi ayrıca loglanır.
+// Framework'ün kendi binding'leri (bkz. coreBinding) izlenmez.
+// ============================================================
+
+// SlowCallEvent, süreyi aşan çağrılar için JS'e gönderilen olaydır.
+const SlowCallEvent = "bridge:slow-call"
+
+// SlowCall, SlowCallEvent'in verisidir.
+type SlowCall struct {
+	ID        string  `json:"id"`
+	Method    string  `json:"method"`
+	ElapsedMs float64 `json:"elapsedMs"`       // Olay anında geçen süre
+	Stack     string  `json:"stack,omitempty"` // Çağrıyı işleyen goroutine'in yığını
+}
+
+// SetSlowCallThreshold() → Çağrıların takılmış sayılacağı süreyi ayarlar;
+// 0 kapatır (varsayılan).
+func (b *Bridge) SetSlowCallThreshold(d time.Duration) {
+	b.slowCall.Store(int64(d))
+}
+
+// watchSlowCall() → Çağrı süreyi aşarsa raporlayan bir zamanlayıcı kurar;
+// dönen fonksiyon çağrı bittiğinde çağrılır.
+func (b *Bridge) watchSlowCall(msg *Message, start time.Time) (done func()) {
+	threshold := time.Duration(b.slowCall.Load())
+	if threshold <= 0 || coreBinding(msg.Method) {
+		return func() {}
+	}
+
+	goid := currentGoroutine()
+	timer := time.AfterFunc(threshold, func() {
+		slow := SlowCall{
+			ID:        msg.ID,
+			Method:    msg.Method,
+			ElapsedMs: float64(time.Since(start).Microseconds()) / 1000,
+			Stack:     goroutineStack(goid),
+		}
+		b.logger.Warn("bridge call exceeded slow-call threshold",
+			"method", msg.Method,
+			"id", msg.ID,
+			"threshold", threshold,
+			"stack", slow.Stack)
+		_ = b.Emit(SlowCallEvent, slow)
+	})
+	return func() {
+		if !timer.Stop() {
+			// Zamanlayıcı çalıştı: çağrının sonunda geldiğini de bildir
+			b.logger.Info("slow bridge call finished",
+				"method", msg.Method,
+				"id", msg.ID,
+				"duration", time.Since(start))
+		}
+	}
+}
+
+// currentGoroutine, çalışan goroutine'in kimliğini döner ("goroutine N [").
+func currentGoroutine() []byte {
+	var buf [64]byte
+	n := runtime.Stack(buf[:], false)
+	header := buf[:n]
+	if i := bytes.IndexByte(header, '['); i > 0 {
+		return append([]byte(nil), header[:i]...) // "goroutine N "
+	}
+	return nil
+}
+
+// goroutineStack, kimliği verilen goroutine'in yığın dökümünü döner.
+// Goroutine bulunamazsa (ör. çağrı o anda bitti) boş döner.
+func goroutineStack(goid []byte) string {
+	if goid == nil {
+		return ""
+	}
+	buf := make([]byte, 1<<20)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		if len(buf) >= 64<<20 {
+			break // Döküm çok büyük; eldeki kısım yeterli
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+	for _, g := range bytes.Split(buf, []byte("\n\n")) {
+		if bytes.HasPrefix(g, goid) {
+			return string(g)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+package bridge
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// ============================================================
+// STATS — Köprü Çalışma Zamanı Sayaçları
+// ------------------------------------------------------------
+// Her çağrı ve olay için sayaçlar tutulur: toplam çağrı/hata, taşınan
+// bayt, son çağrıların gecikme dağılımı (p50/p99) ve reflection ile typed
+// (bkz. Func1) yollarının kullanım sayısı. Gecikme ve hız, son statsWindow
+// çağrıdan hesaplanır; böylece uzun süre çalışan uygulamada bellek sabit kalır.
+// ============================================================
+
+// statsWindow, gecikme yüzdelikleri ve çağrı hızı için tutulan son çağrı sayısıdır.
+const statsWindow = 1024
+
+// Stats, köprünün çalışma zamanı sayaçlarının anlık görüntüsüdür.
+type Stats struct {
+	Calls        uint64        `json:"calls"`        // JS → Go çağrı sayısı
+	Errors       uint64        `json:"errors"`       // Hata ile dönen çağrılar
+	Events       uint64        `json:"events"`       // Go → JS olay sayısı
+	BytesIn      uint64        `json:"bytesIn"`      // JS'ten gelen mesaj baytları
+	BytesOut     uint64        `json:"bytesOut"`     // JS'e giden cevap ve olay baytları
+	CallsPerSec  float64       `json:"callsPerSec"`  // Son çağrılar üzerinden hız
+	P50          time.Duration `json:"p50"`          // Medyan çağrı süresi
+	P99          time.Duration `json:"p99"`          // %99 yüzdelik çağrı süresi
+	Max          time.Duration `json:"max"`          // Pencere içindeki en uzun çağrı
+	ReflectCalls uint64        `json:"reflectCalls"` // reflect.Call ile yapılan çağrılar
+	TypedCalls   uint64        `json:"typedCalls"`   // TypedFunc ile yapılan çağrılar
+	Since        time.Time     `json:"since"`        // Sayaçların başladığı an
+}
+
+// stats, sayaçların değiştirilebilir hâlidir.
+type stats struct {
+	calls, errors, events uint64
+	bytesIn, bytesOut     uint64
+
+	durations [statsWindow]time.Duration // Halka tampon: son çağrı süreleri
+	times     [statsWindow]time.Time     // Halka tampon: son çağrı zamanları
+	next      int
+	filled    bool
+
+	since time.Time
+	mu    sync.Mutex
+}
+
+// recordCall, tamamlanan bir çağrıyı sayaçlara ekler.
+func (s *stats) recordCall(in, out int, elapsed time.Duration, failed bool) {
+	now := time.Now()
+	s.mu.Lock()
+	s.calls++
+	if failed {
+		s.errors++
+	}
+	s.bytesIn += uint64(in)
+	s.bytesOut += uint64(out)
+	s.durations[s.next] = elapsed
+	s.times[s.next] = now
+	s.next++
+	if s.next == statsWindow {
+		s.next, s.filled = 0, true
+	}
+	s.mu.Unlock()
+}
+
+// recordEvent, JS'e gönderilen bir olayı sayaçlara ekler.
+func (s *stats) recordEvent(out int) {
+	s.mu.Lock()
+	s.events++
+	s.bytesOut += uint64(out)
+	s.mu.Unlock()
+}
+
+// Stats() → Köprü sayaçlarının anlık görüntüsünü döner.
+func (b *Bridge) Stats() Stats {
+	s := &b.stats
+	s.mu.Lock()
+	out := Stats{
+		Calls:        s.calls,
+		Errors:       s.errors,
+		Events:       s.events,
+		BytesIn:      s.bytesIn,
+		BytesOut:     s.bytesOut,
+		ReflectCalls: b.registry.reflectCalls.Load(),
+		TypedCalls:   b.registry.typedCalls.Load(),
+		Since:        s.since,
+	}
+	n := s.next
+	if s.filled {
+		n = statsWindow
+	}
+	durations := slices.Clone(s.durations[:n])
+	var oldest, newest time.Time
+	if n > 0 {
+		newest = s.times[(s.next+statsWindow-1)%statsWindow]
+		oldest = s.times[0]
+		if s.filled {
+			oldest = s.times[s.next]
+		}
+	}
+	s.mu.Unlock()
+
+	if n == 0 {
+		return out
+	}
+	slices.Sort(durations)
+	out.P50 = durations[n*50/100]
+	out.P99 = durations[min(n*99/100, n-1)]
+	out.Max = durations[n-1]
+	if span := newest.Sub(oldest); span > 0 && n > 1 {
+		out.CallsPerSec = float64(n-1) / span.Seconds()
+	}
+	return out
+}
+
+// ResetStats() → Tüm sayaçları sıfırlar.
+func (b *Bridge) ResetStats() {
+	s := &b.stats
+	s.mu.Lock()
+	s.calls, s.errors, s.events, s.bytesIn, s.bytesOut = 0, 0, 0, 0, 0
+	s.next, s.filled = 0, false
+	s.since = time.Now()
+	s.mu.Unlock()
+	b.registry.reflectCalls.Store(0)
+	b.registry.typedCalls.Store(0)
+	b.resetMetrics()
+}
@@ -0,0 +1,37 @@
+package bridge
+
+import (
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	b := NewBridge(nopEvaluator{})
+	if err := b.Bind("echo", func(s string) string { return s }); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Bind("typed", Func1(func(s string) (string, error) { return s, nil })); err != nil {
+		t.Fatal(err)
+	}
+
+	wantResult(t, parseResponse(t, b.HandleMessage(callJSON(t, "1", "echo", "a"))), "1", "a")
+	wantResult(t, parseResponse(t, b.HandleMessage(callJSON(t, "2", "typed", "b"))), "2", "b")
+	wantResult(t, parseResponse(t, b.HandleMessage(callJSON(t, "3", "typed", "c"))), "3", "c")
+	wantError(t, parseResponse(t, b.HandleMessage(callJSON(t, "4", "missing"))), ErrCodeMethodNotFound)
+	if err := b.Emit("tick", 1); err != nil {
+		t.Fatal(err)
+	}
+
+	s := b.Stats()
+	if s.Calls != 4 || s.Errors != 1 || s.Events != 1 {
+		t.Fatalf("calls/errors/events = %d/%d/%d, want 4/1/1", s.Calls, s.Errors, s.Events)
+	}
+	if s.ReflectCalls != 1 || s.TypedCalls != 2 {
+		t.Fatalf("reflect/typed = %d/%d, want 1/2", s.ReflectCalls, s.TypedCalls)
+	}
+	if s.BytesIn == 0 || s.BytesOut == 0 {
+		t.Fatalf("bytes in/out = %d/%d, want both counted", s.BytesIn, s.BytesOut)
+	}
+	if s.Max < s.P99 || s.P99 < s.P50 {
+		t.Fatalf("p50/p99/max = %v/%v/%v, want ordered", s.P50, s.P99, s.Max)
+	}
+}
@@ -0,0 +1,75 @@
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// ============================================================
+// STICKY — Son değeri saklanan olaylar
+// ------------------------------------------------------------
+// Normal bir olay yalnızca o anda abone olanlara ulaşır; durum olayı
+// (ör. "auth:changed") bileşen mount edilmeden gönderilmişse kaybolur.
+// EmitSticky ile gönderilen olayın son değeri hem JS'te hem Go'da saklanır:
+//
+//	Go:  b.EmitSticky("auth:changed", user)
+//	JS:  gomad.on("auth:changed", render) // sonradan abone olsa da son değeri alır
+//
+// Sayfa yeniden yüklendiğinde Go'daki değerler yeni sayfaya tekrar gönderilir
+// (gomad.page bildirimiyle). ClearSticky değeri her iki taraftan da siler.
+// ============================================================
+
+// EmitSticky() → Olayı gönderir ve son değer olarak saklar.
+// ------------------------------------------------------------
+// Değer, sayfa capability'ler nedeniyle olayı alamasa da saklanır; izinli
+// bir sayfa yüklendiğinde gönderilir.
+func (b *Bridge) EmitSticky(event string, data interface{}) error {
+	msg, err := newEventMessage(event, data, b.registry.encoding.Load())
+	if err != nil {
+		return fmt.Errorf("failed to create event message: %w", err)
+	}
+	msg.Sticky = true
+
+	b.stickyMu.Lock()
+	if b.sticky == nil {
+		b.sticky = make(map[string]*Message)
+	}
+	b.sticky[event] = msg
+	b.stickyMu.Unlock()
+
+	return b.emit(msg)
+}
+
+// ClearSticky() → Olayın saklanan son değerini siler; sonraki aboneler
+// değer almaz.
+func (b *Bridge) ClearSticky(event string) {
+	b.stickyMu.Lock()
+	delete(b.sticky, event)
+	b.stickyMu.Unlock()
+
+	name, _ := json.Marshal(event)
+	js := "window.gomad && window.gomad._clearSticky && window.gomad._clearSticky(" + string(name) + ")"
+	if err := b.evaluator.Eval(js); err != nil {
+		b.logger.Warn("failed to clear sticky event", "event", event, "error", err)
+	}
+}
+
+// replaySticky() → gomad.page binding'inin Go karşılığı: yeni yüklenen
+// sayfaya saklanan son değerleri gönderir.
+func (b *Bridge) replaySticky() {
+	b.stickyMu.Lock()
+	msgs := make([]*Message, 0, len(b.sticky))
+	for _, msg := range b.sticky {
+		msgs = append(msgs, msg)
+	}
+	b.stickyMu.Unlock()
+	// Olaylar ilk gönderildikleri sırayla gelir
+	sort.SliceStable(msgs, func(i, j int) bool { return msgs[i].Timestamp < msgs[j].Timestamp })
+
+	for _, msg := range msgs {
+		if err := b.emit(msg); err != nil {
+			b.logger.Warn("failed to replay sticky event", "event", msg.Event, "error", err)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package bridge
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// ============================================================
+// STREAM — Go → JS akış (backpressure'lı)
+// ------------------------------------------------------------
+// Büyük veriyi (ör. 500 MB'lık dışa aktarma) tek bir JSON string'i olarak
+// JS'e göndermek hem Go'da hem WebView'de belleği şişirir. SendStream veriyi
+// streamChunkSize'lık parçalar hâlinde gönderir; JS tarafı bunları bir
+// ReadableStream olarak okur:
+//
+//	Go:  b.SendStream("export", file)
+//	JS:  gomad.onStream("export", async (stream) => {
+//	         for await (const chunk of stream) { ... } // Uint8Array
+//	     })
+//
+// Akış kontrolü JS'ten gelir: ReadableStream her pull'da gomad.stream.pull
+// ile tek parça ister; Go yalnızca istenen kadar okur ve gönderir. JS
+// tüketmeyi bırakırsa Go okumayı da bırakır. Stream JS'te iptal edilirse
+// (reader.cancel()) SendStream ErrStreamCanceled ile döner.
+// ============================================================
+
+const (
+	// StreamPullBinding, JS'in bir sonraki parçayı istediği yerleşik binding'dir.
+	StreamPullBinding = "gomad.stream.pull"
+	// StreamCancelBinding, JS'in akışı iptal ettiği yerleşik binding'dir.
+	StreamCancelBinding = "gomad.stream.cancel"
+
+	// streamChunkSize, tek Eval ile gönderilen ham veri boyutudur.
+	streamChunkSize = 64 << 10
+
+	// streamIdleTimeout, JS yeni parça istemezse akışın terk edildiği süredir
+	// (ör. sayfa yeniden yüklendi).
+	streamIdleTimeout = time.Minute
+)
+
+// ErrStreamCanceled, akış JS tarafında iptal edildiğinde ya da dinleyici
+// olmadığında döner.
+var ErrStreamCanceled = errors.New("stream canceled by the frontend")
+
+// outStream, gönderilmekte olan bir akışın JS'ten gelen kontrol sinyalleridir.
+type outStream struct {
+	credits  chan struct{} // Her pull bir parça hakkı verir
+	canceled chan struct{}
+	reason   string
+	once     sync.Once
+}
+
+// SendStream() → r'yi name adlı akış olarak JS'e gönderir.
+// ------------------------------------------------------------
+// Akış bitene, JS iptal edene ya da okuma hatası olana kadar bloklar; bu
+// yüzden UI thread'inden değil, bir goroutine'den çağrılmalıdır.
+func (b *Bridge) SendStream(name string, r io.Reader) error {
+	return b.SendStreamContext(context.Background(), name, r)
+}
+
+// SendStreamContext() → SendStream'in iptal edilebilir hâli.
+// ctx iptal edilirse JS tarafındaki stream hata ile sonlanır.
+func (b *Bridge) SendStreamContext(ctx context.Context, name string, r io.Reader) error {
+	if !b.eventAllowed(name) {
+		return fmt.Errorf("stream %q is not exposed to the current page", name)
+	}
+	id := b.generateMsgID()
+	s := &outStream{credits: make(chan struct{}, 64), canceled: make(chan struct{})}
+
+	b.streamMu.Lock()
+	if b.streams == nil {
+		b.streams = make(map[string]*outStream)
+	}
+	b.streams[id] = s
+	b.streamMu.Unlock()
+	defer func() {
+		b.streamMu.Lock()
+		delete(b.streams, id)
+		b.streamMu.Unlock()
+	}()
+
+	if err := b.evalStream("_streamOpen", name, id); err != nil {
+		return err
+	}
+
+	buf := make([]byte, streamChunkSize)
+	idle := time.NewTimer(streamIdleTimeout)
+	defer idle.Stop()
+	for {
+		select {
+		case <-s.credits:
+		case <-s.canceled:
+			return fmt.Errorf("%w: %s", ErrStreamCanceled, s.reason)
+		case <-ctx.Done():
+			_ = b.evalStream("_streamError", id, ctx.Err().Error())
+			return ctx.Err()
+		case <-idle.C:
+			_ = b.evalStream("_streamError", id, "stream timed out")
+			return fmt.Errorf("stream %q: frontend stopped reading", name)
+		}
+		idle.Reset(streamIdleTimeout)
+
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			if err := b.evalStream("_streamChunk", id, base64.StdEncoding.EncodeToString(buf[:n])); err != nil {
+				return err
+			}
+		}
+		switch {
+		case err == io.EOF || err == io.ErrUnexpectedEOF:
+			return b.evalStream("_streamClose", id)
+		case err != nil:
+			_ = b.evalStream("_streamError", id, err.Error())
+			return err
+		}
+	}
+}
+
+// evalStream() → window.gomad.<fn>(args...) çağrısını JS'e gönderir.
+func (b *Bridge) evalStream(fn string, args ...string) error {
+	js := "window.gomad." + fn + "("
+	for i, arg := range args {
+		if i > 0 {
+			js += ","
+		}
+		quoted, _ := json.Marshal(arg)
+		js += string(quoted)
+	}
+	js += ")"
+	b.stats.recordEvent(len(js))
+	return b.evaluator.Eval(js)
+}
+
+// handleStreamPull() → gomad.stream.pull binding'inin Go karşılığı.
+func (b *Bridge) handleStreamPull(id string) {
+	b.streamMu.Lock()
+	s := b.streams[id]
+	b.streamMu.Unlock()
+	if s == nil {
+		return
+	}
+	select {
+	case s.credits <- struct{}{}:
+	default:
+	}
+}
+
+// handleStreamCancel() → gomad.stream.cancel binding'inin Go karşılığı.
+func (b *Bridge) handleStreamCancel(id, reason string) {
+	b.streamMu.Lock()
+	s := b.streams[id]
+	b.streamMu.Unlock()
+	if s == nil {
+		return
+	}
+	s.once.Do(func() {
+		s.reason = reason
+		close(s.canceled)
+	})
+}
@@ -0,0 +1,65 @@
+package bridge
+
+import "time"
+
+// ============================================================
+// TRACE — Köprü çağrıları için dağıtık izleme kancası
+// ------------------------------------------------------------
+// Her JS → Go çağrısı için bir span başlatılır; span metodu, çağıran sayfayı
+// ve sonucu (hata kodu) taşır. Frontend izleme bağlamını mesajın meta
+// başlığında (W3C "traceparent"/"tracestate") gönderirse Tracer bunu üst
+// span olarak kullanabilir; böylece tarayıcıdaki bir tıklamadan Go
+// binding'ine kadar tek bir trace oluşur.
+//
+// Köprü OpenTelemetry'ye bağımlı değildir: Tracer küçük bir arayüzdür ve
+// OTel SDK'sı ile birkaç satırda uygulanır (bkz. gomad.WithTracer).
+// ============================================================
+
+// CallInfo, span başlatılan çağrının bilgileridir.
+type CallInfo struct {
+	ID     string
+	Method string
+	Page   string            // Çağıran sayfa (origin + yol); bilinmiyorsa boş
+	Meta   map[string]string // Mesajın meta başlığı (ör. "traceparent")
+	Start  time.Time
+}
+
+// Span, tek bir çağrının izidir.
+type Span interface {
+	// End, çağrı bittiğinde bir kez çağrılır. code 0 ise çağrı başarılıdır;
+	// aksi halde ErrCode* değerlerinden biridir.
+	End(code int, message string)
+}
+
+// Tracer, köprü çağrıları için span başlatır. StartCall çağrıyı işleyen
+// goroutine'de, binding çalışmadan hemen önce çağrılır.
+type Tracer interface {
+	StartCall(info CallInfo) Span
+}
+
+// SetTracer() → Çağrı izleyicisini ayarlar; nil kapatır.
+func (b *Bridge) SetTracer(t Tracer) {
+	b.tracerMu.Lock()
+	b.tracer = t
+	b.tracerMu.Unlock()
+}
+
+// startSpan() → Tracer ayarlıysa çağrı için span başlatır ve bitiren
+// fonksiyonu döner.
+func (b *Bridge) startSpan(msg *Message, start time.Time) func(response *Message) {
+	b.tracerMu.RLock()
+	t := b.tracer
+	b.tracerMu.RUnlock()
+	if t == nil || coreBinding(msg.Method) {
+		return func(*Message) {}
+	}
+
+	span := t.StartCall(CallInfo{ID: msg.ID, Method: msg.Method, Page: msg.Page, Meta: msg.Meta, Start: start})
+	return func(response *Message) {
+		if response.Type == MessageTypeError && response.Error != nil {
+			span.End(response.Error.Code, response.Error.Message)
+			return
+		}
+		span.End(0, "")
+	}
+}
@@ -0,0 +1,73 @@
+package bridge
+
+import (
+	"sync"
+	"time"
+)
+
+// ============================================================
+// TRAFFIC — Köprü Trafiği Gözlemi
+// ------------------------------------------------------------
+// Köprüden geçen her mesaj (JS → Go çağrılar, Go → JS sonuçlar ve olaylar)
+// gözlemcilere bildirilir. Test sürücüsü, kayıt (recorder) ve geliştirici
+// araçları trafiği bu kanca üzerinden izler; gözlemci yoksa maliyet bir
+// RLock'tur.
+// ============================================================
+
+// Direction, mesajın köprüdeki yönüdür.
+type Direction string
+
+const (
+	// DirectionIn → JS'ten Go'ya (çağrılar, Go → JS async cevapları).
+	DirectionIn Direction = "in"
+	// DirectionOut → Go'dan JS'e (sonuçlar, hatalar, olaylar).
+	DirectionOut Direction = "out"
+)
+
+// Traffic, köprüden geçen tek bir mesajdır.
+type Traffic struct {
+	Time      time.Time     `json:"time"`
+	Direction Direction     `json:"direction"`
+	Message   *Message      `json:"message"`
+	Duration  time.Duration `json:"duration,omitempty"` // Yalnızca çağrı cevaplarında: çağrının süresi
+}
+
+// observers, trafik gözlemcilerini tutar.
+type observers struct {
+	fns    map[int]func(Traffic)
+	nextID int
+	mu     sync.RWMutex
+}
+
+// Observe() → Köprüden geçen her mesajda çağrılacak fonksiyonu ekler.
+// ------------------------------------------------------------
+// fn mesajı işleyen goroutine'de senkron çağrılır; hızlı dönmelidir ve
+// mesajı değiştirmemelidir. Dönen fonksiyon gözlemciyi kaldırır.
+func (b *Bridge) Observe(fn func(Traffic)) (cancel func()) {
+	b.traffic.mu.Lock()
+	defer b.traffic.mu.Unlock()
+	if b.traffic.fns == nil {
+		b.traffic.fns = make(map[int]func(Traffic))
+	}
+	id := b.traffic.nextID
+	b.traffic.nextID++
+	b.traffic.fns[id] = fn
+	return func() {
+		b.traffic.mu.Lock()
+		delete(b.traffic.fns, id)
+		b.traffic.mu.Unlock()
+	}
+}
+
+// notifyTraffic() → Mesajı gözlemcilere iletir.
+func (b *Bridge) notifyTraffic(dir Direction, msg *Message, elapsed time.Duration) {
+	b.traffic.mu.RLock()
+	defer b.traffic.mu.RUnlock()
+	if len(b.traffic.fns) == 0 || msg == nil {
+		return
+	}
+	t := Traffic{Time: time.Now(), Direction: dir, Message: msg, Duration: elapsed}
+	for _, fn := range b.traffic.fns {
+		fn(t)
+	}
+}
@@ -0,0 +1,160 @@
+package bridge
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// ============================================================
+// TRAFFIC LOG — Köprü Mesajlarının Loglanması
+// ------------------------------------------------------------
+// Köprüden geçen her mesaj (çağrı argümanları, sonuçlar, hatalar, olay
+// verileri) LevelTrace seviyesinde loglanır. Seviye Debug'ın altındadır;
+// logger'ın handler'ı bu seviyeyi açmadıkça mesajlar serileştirilmez bile.
+//
+// Loglara düşmemesi gereken veriler Redaction kurallarıyla maskelenir:
+//
+//	Methods → Bu metodların (veya olayların) tüm yükü "***" olur
+//	Fields  → Bu adlardaki nesne alanları her derinlikte "***" olur
+//	          (büyük/küçük harf duyarsız)
+//
+// Varsayılan kurallar (DefaultRedaction) yaygın parola ve token alan
+// adlarını maskeler.
+// ============================================================
+
+// LevelTrace, köprü trafiği loglarının seviyesidir (Debug'dan ayrıntılı).
+//
+//	slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: bridge.LevelTrace})
+const LevelTrace = slog.LevelDebug - 4
+
+// redactedValue, maskelenen değerlerin yerine yazılan değerdir.
+const redactedValue = `"***"`
+
+// Redaction, trafik loglarında maskelenecek metod ve alanlardır.
+type Redaction struct {
+	Methods []string // Metod veya olay adları; "*" joker karakteri desteklenir
+	Fields  []string // Nesne alanı adları (büyük/küçük harf duyarsız)
+}
+
+// DefaultRedaction, varsayılan maskeleme kurallarıdır.
+var DefaultRedaction = Redaction{
+	Fields: []string{
+		"password", "passwd", "passphrase", "secret", "token",
+		"accessToken", "refreshToken", "apiKey", "authorization", "cookie",
+	},
+}
+
+// redaction, Redaction'ın hazırlanmış hâlidir.
+type redaction struct {
+	methods []string
+	fields  map[string]bool
+}
+
+// SetLogRedaction() → Trafik loglarının maskeleme kurallarını değiştirir.
+// ------------------------------------------------------------
+// Kurallar DefaultRedaction'ın yerine geçer; varsayılanları korumak için
+// eklenerek verilmelidir.
+func (b *Bridge) SetLogRedaction(r Redaction) {
+	b.redaction.Store(compileRedaction(r))
+}
+
+func compileRedaction(r Redaction) *redaction {
+	c := &redaction{methods: r.Methods, fields: make(map[string]bool, len(r.Fields))}
+	for _, f := range r.Fields {
+		c.fields[strings.ToLower(f)] = true
+	}
+	return c
+}
+
+// defaultRedaction, SetLogRedaction çağrılmadığında kullanılır.
+var defaultRedaction = compileRedaction(DefaultRedaction)
+
+func (b *Bridge) currentRedaction() *redaction {
+	if r := b.redaction.Load(); r != nil {
+		return r
+	}
+	return defaultRedaction
+}
+
+// logTraffic() → Mesajı maskeleyerek LevelTrace seviyesinde loglar.
+// name, cevaplarda ait oldukları çağrının metodudur (cevap mesajı metod
+// taşımaz).
+func (b *Bridge) logTraffic(dir Direction, name string, msg *Message, elapsed time.Duration) {
+	ctx := context.Background()
+	if msg == nil || !b.logger.Enabled(ctx, LevelTrace) || name == LogBinding {
+		return
+	}
+	if name == "" {
+		name = msg.Method
+	}
+	if name == "" {
+		name = msg.Event
+	}
+
+	r := b.currentRedaction()
+	hidden := matchAny(r.methods, name)
+	args := []any{"direction", dir, "type", msg.Type}
+	if msg.ID != "" {
+		args = append(args, "id", msg.ID)
+	}
+	if name != "" {
+		args = append(args, "name", name)
+	}
+	for _, p := range []struct {
+		key  string
+		data json.RawMessage
+	}{{"args", msg.Args}, {"result", msg.Result}, {"data", msg.Data}} {
+		if len(p.data) > 0 {
+			args = append(args, p.key, string(r.redact(p.data, hidden)))
+		}
+	}
+	if msg.Error != nil {
+		args = append(args, "code", msg.Error.Code, "error", msg.Error.Message)
+	}
+	if elapsed > 0 {
+		args = append(args, "duration", elapsed)
+	}
+	b.logger.Log(ctx, LevelTrace, "bridge traffic", args...)
+}
+
+// redact, JSON verisindeki maskelenecek alanları değiştirir. hidden ise
+// verinin tamamı maskelenir; çözülemeyen veri de güvenli tarafta kalmak
+// için maskelenir.
+func (r *redaction) redact(data json.RawMessage, hidden bool) json.RawMessage {
+	if hidden {
+		return json.RawMessage(redactedValue)
+	}
+	if len(r.fields) == 0 {
+		return data
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return json.RawMessage(redactedValue)
+	}
+	out, err := json.Marshal(r.redactValue(v))
+	if err != nil {
+		return json.RawMessage(redactedValue)
+	}
+	return out
+}
+
+func (r *redaction) redactValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			if r.fields[strings.ToLower(k)] {
+				t[k] = "***"
+			} else {
+				t[k] = r.redactValue(child)
+			}
+		}
+	case []interface{}:
+		for i, child := range t {
+			t[i] = r.redactValue(child)
+		}
+	}
+	return v
+}
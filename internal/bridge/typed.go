@@ -0,0 +1,154 @@
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// ============================================================
+// TYPED BINDINGS — Reflection'sız çağrı yolu
+// ------------------------------------------------------------
+// Registry varsayılan olarak fonksiyonları reflect.Value.Call ile çağırır.
+// Sık çağrılan binding'ler için generic sarmalayıcılar argümanları doğrudan
+// tipine çözer ve fonksiyonu normal bir çağrıyla çalıştırır; reflect.New,
+// reflect.Call ve dönüş değerlerinin interface'e çevrilmesi atlanır.
+//
+//	b.Bind("add", bridge.Func2(func(a, b int) (int, error) { return a + b, nil }))
+//
+// Sayaçlar (bkz. Stats) iki yolu ayrı ayrı sayar.
+// ============================================================
+
+// TypedFunc, reflection'sız çağrılan bir binding'dir. Func0..Func3 ile üretilir
+// ve Bind'e normal fonksiyon gibi verilir.
+type TypedFunc struct {
+	fn    interface{} // Orijinal fonksiyon (imza gösterimi için)
+	numIn int
+	call  func(args []json.RawMessage) (interface{}, error)
+}
+
+// Func0, argümansız bir fonksiyonu TypedFunc'a çevirir.
+func Func0[R any](fn func() (R, error)) TypedFunc {
+	return TypedFunc{fn: fn, numIn: 0, call: func([]json.RawMessage) (interface{}, error) {
+		return fn()
+	}}
+}
+
+// Func1, tek argümanlı bir fonksiyonu TypedFunc'a çevirir.
+func Func1[A, R any](fn func(A) (R, error)) TypedFunc {
+	return TypedFunc{fn: fn, numIn: 1, call: func(args []json.RawMessage) (interface{}, error) {
+		var a A
+		if err := decodeArg(args, 0, &a); err != nil {
+			return nil, err
+		}
+		return fn(a)
+	}}
+}
+
+// Func2, iki argümanlı bir fonksiyonu TypedFunc'a çevirir.
+func Func2[A, B, R any](fn func(A, B) (R, error)) TypedFunc {
+	return TypedFunc{fn: fn, numIn: 2, call: func(args []json.RawMessage) (interface{}, error) {
+		var a A
+		var b B
+		if err := decodeArg(args, 0, &a); err != nil {
+			return nil, err
+		}
+		if err := decodeArg(args, 1, &b); err != nil {
+			return nil, err
+		}
+		return fn(a, b)
+	}}
+}
+
+// Func3, üç argümanlı bir fonksiyonu TypedFunc'a çevirir.
+func Func3[A, B, C, R any](fn func(A, B, C) (R, error)) TypedFunc {
+	return TypedFunc{fn: fn, numIn: 3, call: func(args []json.RawMessage) (interface{}, error) {
+		var a A
+		var b B
+		var c C
+		if err := decodeArg(args, 0, &a); err != nil {
+			return nil, err
+		}
+		if err := decodeArg(args, 1, &b); err != nil {
+			return nil, err
+		}
+		if err := decodeArg(args, 2, &c); err != nil {
+			return nil, err
+		}
+		return fn(a, b, c)
+	}}
+}
+
+// RawFunc, argümanlarını çözmeden alan numIn argümanlı bir TypedFunc
+// oluşturur. İmzası derleme zamanında bilinmeyen binding'ler (ör. ayrı bir
+// süreçte çalışan eklentinin metodları) içindir; tip tanımlarında argümanlar
+// ve sonuç "unknown" olarak görünür. JS'nin atladığı argümanlar call'a nil
+// olarak gelir.
+func RawFunc(numIn int, call func(args []json.RawMessage) (interface{}, error)) TypedFunc {
+	in := make([]reflect.Type, numIn)
+	for i := range in {
+		in[i] = rawMessageType
+	}
+	sig := reflect.FuncOf(in, []reflect.Type{rawMessageType, errorType}, false)
+	return TypedFunc{fn: reflect.Zero(sig).Interface(), numIn: numIn, call: call}
+}
+
+// argError, argüman çözme hatasını işaretler; Registry bunu BindingError'a çevirir.
+type argError struct {
+	index int
+	typ   string
+	err   error
+}
+
+func (e *argError) Error() string {
+	return fmt.Sprintf("failed to convert argument %d to %s: %v", e.index, e.typ, e.err)
+}
+
+// decodeArg, i. argümanı v'ye çözer ve validate tag'lerine göre doğrular.
+// Atlanan ya da null opsiyonel argümanlar (bkz. optionalParam) sıfır değerde
+// bırakılır.
+func decodeArg[T any](args []json.RawMessage, i int, v *T) error {
+	if optionalParam(reflect.TypeOf(v).Elem()) && nullArg(args[i]) {
+		return nil
+	}
+	if err := json.Unmarshal(args[i], v); err != nil {
+		return &argError{index: i, typ: reflect.TypeOf(v).Elem().String(), err: err}
+	}
+	if verr := validateArg(i, reflect.ValueOf(v).Elem()); verr != nil {
+		return &argError{index: i, typ: reflect.TypeOf(v).Elem().String(), err: verr}
+	}
+	return nil
+}
+
+// registerTyped, TypedFunc'ı kaydeder.
+func (r *Registry) registerTyped(name string, tf TypedFunc) error {
+	if tf.call == nil {
+		return gomerrors.NewBindingError(name, "function cannot be nil", nil)
+	}
+	fnType := reflect.TypeOf(tf.fn)
+	for i := 0; i < fnType.NumIn(); i++ {
+		if err := checkValidation(fnType.In(i)); err != nil {
+			return gomerrors.NewBindingError(name, "invalid validate tag", err)
+		}
+	}
+	bound := &BoundFunc{
+		Name:     name,
+		Fn:       reflect.ValueOf(tf.fn),
+		Type:     fnType,
+		NumIn:    tf.numIn,
+		MinIn:    minIn(fnType),
+		NumOut:   fnType.NumOut(),
+		HasError: true,
+		typed:    tf.call,
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.funcs[name]; exists {
+		return gomerrors.NewBindingError(name, "already registered", gomerrors.ErrAlreadyExists)
+	}
+	r.funcs[name] = bound
+	return nil
+}
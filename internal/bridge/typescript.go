@@ -0,0 +1,269 @@
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// ============================================================
+// TYPESCRIPT — Binding'lerden .d.ts üretimi
+// ------------------------------------------------------------
+// Kayıtlı fonksiyonların Go tiplerinden @gomad/client için tanım dosyası
+// üretilir. Tanımlar GomadBindings arayüzünü module augmentation ile doldurur;
+// böylece call('greet', ...) adı, argümanları ve sonucu tipli olur.
+//
+// Tip eşlemesi encoding/json ile aynıdır:
+//
+//	bool → boolean       sayılar → number      string, []byte, time.Time → string
+//	[]T → T[]            map[K]V → Record<string, V>
+//	*T → T | null        interface{}, json.RawMessage → unknown
+//	struct → export interface (json etiketleri, omitempty → isteğe bağlı alan)
+//
+// Go parametre adları reflection ile okunamadığı için argümanlar arg0, arg1…
+// olarak adlandırılır. Kayıtlı hata kodları GomadErrorCodes arayüzüne yazılır.
+// ============================================================
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+	marshalerType  = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	errorType      = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// TypeScript() → include'un kabul ettiği binding'ler için .d.ts içeriği üretir.
+// include nil ise tüm binding'ler dahil edilir. Çıktı ada göre sıralıdır ve aynı
+// binding'ler için her zaman aynıdır (değişiklik tespiti içerik karşılaştırmasıyla yapılır).
+func (b *Bridge) TypeScript(include func(name string) bool) string {
+	names := b.registry.List()
+	sort.Strings(names)
+
+	g := &tsGen{names: make(map[reflect.Type]string), used: make(map[string]bool), enc: b.registry.encoding.Load()}
+	var methods []string
+	for _, name := range names {
+		if include != nil && !include(name) {
+			continue
+		}
+		fnType, ok := b.registry.funcType(name)
+		if !ok {
+			continue
+		}
+		methods = append(methods, g.method(name, fnType))
+	}
+
+	var sb strings.Builder
+	sb.WriteString("// Code generated by gomad; DO NOT EDIT.\n")
+	sb.WriteString("import '@gomad/client';\n\n")
+	sb.WriteString("declare module '@gomad/client' {\n")
+	for _, decl := range g.decls {
+		sb.WriteString(decl)
+		sb.WriteString("\n")
+	}
+	sb.WriteString("  interface GomadBindings {\n")
+	for _, m := range methods {
+		sb.WriteString("    " + m + "\n")
+	}
+	sb.WriteString("  }\n")
+	writeErrorCodes(&sb)
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// writeErrorCodes, kayıtlı hata kodlarını (bkz. gomerrors.NewCode)
+// GomadErrorCodes arayüzüne yazar; errorClass('QuotaExceeded') bu adları
+// kabul eder.
+func writeErrorCodes(sb *strings.Builder) {
+	codes := gomerrors.Codes()
+	if len(codes) == 0 {
+		return
+	}
+	sb.WriteString("  interface GomadErrorCodes {\n")
+	for _, c := range codes {
+		if c.Description != "" {
+			fmt.Fprintf(sb, "    /** %s */\n", strings.ReplaceAll(c.Description, "*/", "* /"))
+		}
+		fmt.Fprintf(sb, "    %s: %d;\n", c.Name, c.Value)
+	}
+	sb.WriteString("  }\n")
+}
+
+// funcType, kayıtlı fonksiyonun Go tipini döner.
+func (r *Registry) funcType(name string) (reflect.Type, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	bound, exists := r.funcs[name]
+	if !exists {
+		return nil, false
+	}
+	return bound.Type, true
+}
+
+// tsGen, struct tiplerini adlandırıp arayüz bildirimlerini toplar.
+type tsGen struct {
+	names map[reflect.Type]string // struct → TS arayüz adı
+	used  map[string]bool         // Kullanılmış arayüz adları
+	decls []string                // Üretilen arayüz bildirimleri
+	enc   *JSONEncoding           // Sonuçların JSON politikası; nil → encoding/json
+}
+
+// method, binding için GomadBindings üyesini üretir.
+func (g *tsGen) method(name string, fn reflect.Type) string {
+	skip := ctxParams(fn) // context.Context JS'ten gelmez
+	params := make([]string, fn.NumIn()-skip)
+	required := minIn(fn)
+	for i := range params {
+		optional := ""
+		if i >= required {
+			optional = "?" // Atlanabilir (bkz. optionalParam)
+		}
+		params[i] = fmt.Sprintf("arg%d%s: %s", i, optional, g.typeOf(fn.In(i+skip)))
+	}
+
+	result := "void"
+	outs := fn.NumOut()
+	if outs > 0 && fn.Out(outs-1).Implements(errorType) {
+		outs--
+	}
+	if outs > 0 {
+		result = g.typeOf(fn.Out(0))
+	}
+	return fmt.Sprintf("%s(%s): %s;", strconv.Quote(name), strings.Join(params, ", "), result)
+}
+
+// typeOf, Go tipinin TS karşılığını döner.
+func (g *tsGen) typeOf(t reflect.Type) string {
+	switch {
+	case t == timeType:
+		return "string"
+	case t == rawMessageType, t.Implements(marshalerType):
+		return "unknown"
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if g.enc != nil && g.enc.Int64AsString && wideInt(t.Kind()) {
+			return "string"
+		}
+		return "number"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.String:
+		return "string"
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 && t.Kind() == reflect.Slice {
+			return "string" // base64
+		}
+		return arrayOf(g.typeOf(t.Elem()))
+	case reflect.Map:
+		return "Record<string, " + g.typeOf(t.Elem()) + ">"
+	case reflect.Pointer:
+		return g.typeOf(t.Elem()) + " | null"
+	case reflect.Struct:
+		return g.structName(t)
+	default:
+		return "unknown"
+	}
+}
+
+// arrayOf, eleman tipini dizi tipine çevirir; birleşim tipleri parantezlenir.
+func arrayOf(elem string) string {
+	if strings.Contains(elem, "|") {
+		return "(" + elem + ")[]"
+	}
+	return elem + "[]"
+}
+
+// structName, struct için arayüz adını döner; ilk karşılaşmada bildirimi üretir.
+func (g *tsGen) structName(t reflect.Type) string {
+	if name, ok := g.names[t]; ok {
+		return name
+	}
+	name := g.uniqueName(t)
+	g.names[t] = name // Özyinelemeli tipler için bildirimden önce kaydedilir
+
+	var sb strings.Builder
+	sb.WriteString("  export interface " + name + " {\n")
+	g.fields(&sb, t)
+	sb.WriteString("  }\n")
+	g.decls = append(g.decls, sb.String())
+	return name
+}
+
+// fields, struct alanlarını encoding/json kurallarıyla yazar; gömülü struct'lar düzleştirilir.
+func (g *tsGen) fields(sb *strings.Builder, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				g.fields(sb, ft)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = g.enc.fieldName(jsonField{goName: f.Name})
+		}
+		optional := ""
+		if strings.Contains(opts, "omitempty") || strings.Contains(opts, "omitzero") ||
+			(g.enc != nil && g.enc.OmitNil && nilable(f.Type)) {
+			optional = "?"
+		}
+		typ := g.typeOf(f.Type)
+		if strings.Contains(opts, "string") {
+			typ = "string"
+		}
+		if rules := validationTag(f); rules != "" {
+			fmt.Fprintf(sb, "    /** @validate %s */\n", rules)
+		}
+		fmt.Fprintf(sb, "    %s%s: %s;\n", tsKey(name), optional, typ)
+	}
+}
+
+// uniqueName, struct adından çakışmayan bir TS adı üretir (anonim ve generic
+// tipler dahil).
+func (g *tsGen) uniqueName(t reflect.Type) string {
+	base := t.Name()
+	if i := strings.IndexByte(base, '['); i >= 0 {
+		base = base[:i]
+	}
+	if base == "" {
+		base = "Anonymous"
+	}
+	name := base
+	for n := 2; g.used[name]; n++ {
+		name = base + strconv.Itoa(n)
+	}
+	g.used[name] = true
+	return name
+}
+
+// tsKey, alan adını gerekiyorsa tırnaklar.
+func tsKey(name string) string {
+	for i, r := range name {
+		if r == '_' || r == '$' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (i > 0 && r >= '0' && r <= '9') {
+			continue
+		}
+		return strconv.Quote(name)
+	}
+	return name
+}
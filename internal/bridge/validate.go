@@ -0,0 +1,357 @@
+package bridge
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// ============================================================
+// VALIDATION — Struct argümanlarının tag ile doğrulanması
+// ------------------------------------------------------------
+// Argümanlar JSON'dan çözüldükten sonra, handler çalışmadan önce struct
+// alanlarındaki `validate` tag'leri kontrol edilir:
+//
+//	type SignupRequest struct {
+//	    Email string   `json:"email" validate:"required,email"`
+//	    Name  string   `json:"name" validate:"required,max=64"`
+//	    Age   int      `json:"age" validate:"omitempty,min=13"`
+//	    Plan  string   `json:"plan" validate:"oneof=free pro"`
+//	    Tags  []string `json:"tags" validate:"max=10"`
+//	}
+//
+// Kurallar:
+//
+//	required   → Alan sıfır değer olamaz (nil, "", 0, boş dizi)
+//	omitempty  → Alan sıfır değerse diğer kurallar atlanır
+//	min=N      → String'de en az N karakter, sayıda en az N, dizide en az N eleman
+//	max=N      → min'in üst sınır karşılığı
+//	len=N      → Tam olarak N karakter / eleman
+//	oneof=a b  → Değer boşlukla ayrılmış listeden biri olmalı
+//	email      → Geçerli bir e-posta adresi
+//	url        → Şema ve host içeren mutlak URL
+//
+// İç içe struct'lar, pointer'lar ve struct dizileri de doğrulanır. Başarısız
+// alanların tümü tek bir ValidationError'da toplanır; çağrı ErrCodeInvalidArgs
+// ile, alan listesi ErrorPayload.Fields içinde döner:
+//
+//	try { await gomad.call("signup", req); }
+//	catch (e) { e.fields?.forEach(f => form.showError(f.field, f.message)); }
+//
+// Tag'ler Register sırasında çözülür; bilinmeyen bir kural ya da hatalı bir
+// parametre binding kaydını reddeder.
+// ============================================================
+
+// FieldError, doğrulamadan geçemeyen tek bir alandır.
+type FieldError struct {
+	Arg     int    `json:"arg"`     // Argüman sırası
+	Field   string `json:"field"`   // JSON yolu, ör. "address.zip", "items[2].qty"
+	Rule    string `json:"rule"`    // Başarısız kural, ör. "required", "min=3"
+	Message string `json:"message"` // Okunabilir açıklama
+}
+
+// ValidationError, doğrulamadan geçemeyen argüman alanlarıdır.
+// errors.Is(err, gomerrors.ErrInvalidArgument) true döner.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		parts[i] = fmt.Sprintf("argument %d: %s %s", f.Arg, f.Field, f.Message)
+	}
+	return "validation failed: " + strings.Join(parts, "; ")
+}
+
+func (e *ValidationError) Unwrap() error { return gomerrors.ErrInvalidArgument }
+
+// rule, çözülmüş tek bir doğrulama kuralıdır.
+type rule struct {
+	name  string
+	param string
+	num   float64  // min, max, len
+	set   []string // oneof
+}
+
+// fieldRules, struct alanının doğrulama planıdır.
+type fieldRules struct {
+	index     []int
+	name      string // JSON adı
+	omitempty bool
+	rules     []rule
+}
+
+// validationPlans, tip başına çözülmüş alan planlarının önbelleğidir.
+var validationPlans sync.Map // reflect.Type → []fieldRules
+
+// checkValidation, tipin (ve iç içe tiplerinin) validate tag'lerini çözer;
+// Register bunu hatalı tag'leri kayıt anında yakalamak için kullanır.
+func checkValidation(t reflect.Type) error {
+	return walkValidationTypes(t, map[reflect.Type]bool{})
+}
+
+func walkValidationTypes(t reflect.Type, seen map[reflect.Type]bool) error {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || seen[t] {
+		return nil
+	}
+	seen[t] = true
+	plan, err := validationPlan(t)
+	if err != nil {
+		return err
+	}
+	for _, f := range plan {
+		if err := walkValidationTypes(t.FieldByIndex(f.index).Type, seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validationPlan, struct tipinin alan planını döner; sonuç önbelleğe alınır.
+func validationPlan(t reflect.Type) ([]fieldRules, error) {
+	if cached, ok := validationPlans.Load(t); ok {
+		return cached.([]fieldRules), nil
+	}
+	var plan []fieldRules
+	if err := collectFields(t, nil, &plan); err != nil {
+		return nil, err
+	}
+	validationPlans.Store(t, plan)
+	return plan, nil
+}
+
+// collectFields, JSON'da görünen alanları (gömülü struct'lar düzleştirilerek)
+// plana ekler.
+func collectFields(t reflect.Type, index []int, plan *[]fieldRules) error {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		idx := append(append([]int(nil), index...), i)
+		jsonTag := f.Tag.Get("json")
+		if jsonTag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(jsonTag, ",")
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			if err := collectFields(f.Type, idx, plan); err != nil {
+				return err
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		tag := f.Tag.Get("validate")
+		if tag == "-" {
+			continue
+		}
+		fr := fieldRules{index: idx, name: name}
+		if tag != "" {
+			for _, part := range strings.Split(tag, ",") {
+				if part == "omitempty" {
+					fr.omitempty = true
+					continue
+				}
+				r, err := parseRule(part, f.Type)
+				if err != nil {
+					return fmt.Errorf("%s.%s: %w", t.Name(), f.Name, err)
+				}
+				fr.rules = append(fr.rules, r)
+			}
+		}
+		*plan = append(*plan, fr)
+	}
+	return nil
+}
+
+// parseRule, "min=3" gibi tek bir kuralı çözer.
+func parseRule(s string, t reflect.Type) (rule, error) {
+	name, param, _ := strings.Cut(strings.TrimSpace(s), "=")
+	r := rule{name: name, param: param}
+	switch name {
+	case "required":
+	case "email", "url":
+		if deref(t).Kind() != reflect.String {
+			return r, fmt.Errorf("rule %q requires a string field", name)
+		}
+	case "min", "max", "len":
+		n, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return r, fmt.Errorf("rule %q: invalid parameter %q", name, param)
+		}
+		r.num = n
+	case "oneof":
+		r.set = strings.Fields(param)
+		if len(r.set) == 0 {
+			return r, fmt.Errorf("rule %q needs at least one value", name)
+		}
+	default:
+		return r, fmt.Errorf("unknown validation rule %q", name)
+	}
+	return r, nil
+}
+
+// validateArg, i. argümanın doğrulama hatalarını döner; hata yoksa nil.
+func validateArg(i int, v reflect.Value) *ValidationError {
+	var fields []FieldError
+	validateValue(i, v, "", &fields)
+	if len(fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: fields}
+}
+
+// validateValue, değeri ve içindeki struct'ları doğrular.
+func validateValue(arg int, v reflect.Value, path string, out *[]FieldError) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		plan, err := validationPlan(v.Type())
+		if err != nil {
+			// Register'da yakalanır; buraya yalnızca iç içe interface
+			// değerlerindeki tipler ulaşabilir
+			*out = append(*out, FieldError{Arg: arg, Field: path, Rule: "tag", Message: err.Error()})
+			return
+		}
+		for _, f := range plan {
+			fv := v.FieldByIndex(f.index)
+			fpath := f.name
+			if path != "" {
+				fpath = path + "." + f.name
+			}
+			if f.omitempty && fv.IsZero() {
+				continue
+			}
+			failed := false
+			for _, r := range f.rules {
+				if msg := r.check(fv); msg != "" {
+					rule := r.name
+					if r.param != "" {
+						rule += "=" + r.param
+					}
+					*out = append(*out, FieldError{Arg: arg, Field: fpath, Rule: rule, Message: msg})
+					failed = true
+					break
+				}
+			}
+			if !failed {
+				validateValue(arg, fv, fpath, out)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if deref(v.Type().Elem()).Kind() != reflect.Struct {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			validateValue(arg, v.Index(i), fmt.Sprintf("%s[%d]", path, i), out)
+		}
+	}
+}
+
+// check, kuralı değere uygular; geçerse "" döner.
+func (r rule) check(v reflect.Value) string {
+	if r.name == "required" {
+		if v.IsZero() || (isSized(v) && v.Len() == 0) {
+			return "is required"
+		}
+		return ""
+	}
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "" // Yokluk required'ın işidir
+		}
+		v = v.Elem()
+	}
+	switch r.name {
+	case "min", "max", "len":
+		size, unit, ok := measure(v)
+		if !ok {
+			return ""
+		}
+		switch {
+		case r.name == "min" && size < r.num:
+			return fmt.Sprintf("must be at least %s%s", r.param, unit)
+		case r.name == "max" && size > r.num:
+			return fmt.Sprintf("must be at most %s%s", r.param, unit)
+		case r.name == "len" && size != r.num:
+			return fmt.Sprintf("must be exactly %s%s", r.param, unit)
+		}
+	case "oneof":
+		s := fmt.Sprint(v.Interface())
+		for _, allowed := range r.set {
+			if s == allowed {
+				return ""
+			}
+		}
+		return "must be one of: " + strings.Join(r.set, ", ")
+	case "email":
+		s := v.String()
+		if addr, err := mail.ParseAddress(s); err != nil || addr.Address != s {
+			return "must be a valid email address"
+		}
+	case "url":
+		if u, err := url.Parse(v.String()); err != nil || u.Scheme == "" || u.Host == "" {
+			return "must be a valid URL"
+		}
+	}
+	return ""
+}
+
+// measure, min/max/len kurallarının karşılaştırdığı büyüklüğü döner.
+func measure(v reflect.Value) (size float64, unit string, ok bool) {
+	switch v.Kind() {
+	case reflect.String:
+		return float64(utf8.RuneCountInString(v.String())), " characters", true
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return float64(v.Len()), " items", true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), "", true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), "", true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), "", true
+	}
+	return 0, "", false
+}
+
+func isSized(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.String:
+		return true
+	}
+	return false
+}
+
+func deref(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// validationTag, TS çıktısında alanın üstüne yazılacak kuralları döner.
+func validationTag(f reflect.StructField) string {
+	tag := f.Tag.Get("validate")
+	if tag == "-" {
+		return ""
+	}
+	return strings.ReplaceAll(tag, "*/", "* /")
+}
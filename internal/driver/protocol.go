@@ -0,0 +1,53 @@
+// Package driver, uçtan uca test sürücüsü (pkg/gomadtest) ile test altındaki
+// uygulama arasındaki protokolü tanımlar.
+//
+// Sürücü 127.0.0.1 üzerinde dinler ve uygulamayı GOMAD_DRIVER=<adres> ve
+// GOMAD_DRIVER_TOKEN=<jeton> ortam değişkenleriyle başlatır. Uygulama bu
+// adrese bağlanır; iki taraf satır başına bir JSON Message gönderir:
+//
+//	uygulama → sürücü: hello (jeton), loaded (sayfa yüklendi), result, traffic
+//	sürücü → uygulama: eval (JS fonksiyon gövdesi), quit
+//
+// WebKitGTK ve WKWebView, webview_go üzerinden bir otomasyon protokolü
+// (CDP/WebDriver) açmadığından DOM komutları uygulamanın kendi köprüsü
+// üzerinden taşınır; bu sayede sürücü her platformda aynı çalışır.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package driver
+
+import (
+	"encoding/json"
+
+	"github.com/biyonik/gomad/internal/bridge"
+)
+
+// Ortam değişkenleri
+const (
+	AddrEnv  = "GOMAD_DRIVER"
+	TokenEnv = "GOMAD_DRIVER_TOKEN"
+)
+
+// Mesaj türleri
+const (
+	TypeHello   = "hello"
+	TypeLoaded  = "loaded"
+	TypeResult  = "result"
+	TypeTraffic = "traffic"
+	TypeEval    = "eval"
+	TypeQuit    = "quit"
+)
+
+// Message, protokolün tek satırıdır.
+type Message struct {
+	Type    string          `json:"type"`
+	ID      int64           `json:"id,omitempty"`
+	Token   string          `json:"token,omitempty"`
+	JS      string          `json:"js,omitempty"`
+	URL     string          `json:"url,omitempty"`
+	Value   json.RawMessage `json:"value,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Traffic *bridge.Traffic `json:"traffic,omitempty"`
+}
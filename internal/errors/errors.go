@@ -21,8 +21,15 @@
 package errors
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+	"sync"
+	"unicode"
 )
 
 // ─────────────────────────────────────────────────────────────────────────────
@@ -50,6 +57,14 @@ var (
 	// ErrClosed → Kapalı veya sonlandırılmış bir kaynak üzerinde işlem yapılmaya
 	// çalışıldığında dönen hata.
 	ErrClosed = errors.New("resource closed")
+
+	// ErrNotSupported → İstenen özellik mevcut işletim sisteminde (henüz)
+	// desteklenmediğinde dönen hata. Platform katmanları bununla sarmalar.
+	ErrNotSupported = errors.New("not supported on this platform")
+
+	// ErrPermissionDenied → JS tarafının uygulamanın izin vermediği bir kaynağa
+	// (ör. izin listesi dışındaki bir dizine) erişmeye çalıştığında dönen hata.
+	ErrPermissionDenied = errors.New("permission denied")
 )
 
 // ─────────────────────────────────────────────────────────────────────────────
@@ -166,3 +181,358 @@ func NewWindowError(operation, reason string, cause error) *WindowError {
 		Cause:     cause,
 	}
 }
+
+// ─────────────────────────────────────────────────────────────────────────────
+// TaskError
+// Arka plan görevlerinin (task kuyruğu) işlemlerinde ortaya çıkan hatalar için
+// kullanılır. Örn: çalışmayan bir görevi duraklatmak, bilinmeyen görev türü...
+// ─────────────────────────────────────────────────────────────────────────────
+
+// TaskError → Görev yönetimine özgü hata modeli.
+type TaskError struct {
+	TaskID    string // İlgili görev (varsa)
+	Operation string // Hangi işlemde hata gerçekleşti
+	Reason    string // Hata nedeni
+	Cause     error  // Alt neden (varsa)
+}
+
+// Error → Hatanın okunabilir hâlini üretir.
+func (e *TaskError) Error() string {
+	msg := fmt.Sprintf("task %s failed: %s", e.Operation, e.Reason)
+	if e.TaskID != "" {
+		msg = fmt.Sprintf("task %s: %s failed: %s", e.TaskID, e.Operation, e.Reason)
+	}
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", msg, e.Cause)
+	}
+	return msg
+}
+
+// Unwrap → Alt hata erişimi sağlar.
+func (e *TaskError) Unwrap() error { return e.Cause }
+
+// NewTaskError → Yeni bir TaskError oluşturur.
+func NewTaskError(taskID, operation, reason string, cause error) *TaskError {
+	return &TaskError{
+		TaskID:    taskID,
+		Operation: operation,
+		Reason:    reason,
+		Cause:     cause,
+	}
+}
+
+// ─────────────────────────────────────────────────────────────────────────────
+// OperationError
+// Pencereye, göreve veya cihaza bağlı olmayan uygulama servislerinin (ayarlar,
+// durum deposu, oturum, güncelleme, eklentiler...) işlemlerinde ortaya çıkan
+// hatalar için kullanılır. Operation "<alan>.<işlem>" biçimindedir.
+// ─────────────────────────────────────────────────────────────────────────────
+
+// OperationError → Uygulama servislerine özgü genel hata modeli.
+type OperationError struct {
+	Operation string // Hangi işlemde hata gerçekleşti. Örn: "settings.migrate"
+	Reason    string // Hata nedeni
+	Cause     error  // Alt neden (varsa)
+}
+
+// Error → Hatanın okunabilir hâlini üretir.
+func (e *OperationError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s failed: %s: %v", e.Operation, e.Reason, e.Cause)
+	}
+	return fmt.Sprintf("%s failed: %s", e.Operation, e.Reason)
+}
+
+// Unwrap → Alt hata erişimi sağlar.
+func (e *OperationError) Unwrap() error { return e.Cause }
+
+// NewOperationError → Yeni bir OperationError oluşturur.
+func NewOperationError(operation, reason string, cause error) *OperationError {
+	return &OperationError{
+		Operation: operation,
+		Reason:    reason,
+		Cause:     cause,
+	}
+}
+
+// ─────────────────────────────────────────────────────────────────────────────
+// DeviceError
+// Donanım cihazlarıyla (HID/gamepad, seri port, Bluetooth) yapılan işlemlerde
+// ortaya çıkan hatalar için kullanılır. Örn: cihazın açılamaması, adaptörün
+// bulunamaması, GATT işleminin reddedilmesi...
+// ─────────────────────────────────────────────────────────────────────────────
+
+// DeviceError → Cihaz erişimine özgü hata modeli.
+type DeviceError struct {
+	Kind   string // Cihaz türü: "hid", "serial", "bluetooth"
+	Reason string // Hata nedeni (genellikle işlem ve cihaz kimliği)
+	Cause  error  // Alt neden (varsa)
+}
+
+// Error → Hatanın okunabilir hâlini üretir.
+func (e *DeviceError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s device failed: %s: %v", e.Kind, e.Reason, e.Cause)
+	}
+	return fmt.Sprintf("%s device failed: %s", e.Kind, e.Reason)
+}
+
+// Unwrap → Alt hata erişimi sağlar.
+func (e *DeviceError) Unwrap() error { return e.Cause }
+
+// NewDeviceError → Yeni bir DeviceError oluşturur.
+func NewDeviceError(kind, reason string, cause error) *DeviceError {
+	return &DeviceError{
+		Kind:   kind,
+		Reason: reason,
+		Cause:  cause,
+	}
+}
+
+// ─────────────────────────────────────────────────────────────────────────────
+// PanicError
+// Bir goroutine veya bağlanmış fonksiyon içinde yakalanan panic'i hata olarak
+// taşır. Panic değeri ile birlikte yakalandığı andaki stack trace'i saklar;
+// böylece süreç çökmeden raporlanabilir ve loglanabilir.
+// ─────────────────────────────────────────────────────────────────────────────
+
+// PanicError → recover() ile yakalanan panic bilgisini tutar.
+type PanicError struct {
+	Value interface{} // panic(...) ile fırlatılan değer
+	Stack []byte      // Yakalandığı andaki goroutine stack'i
+}
+
+// Error → Panic değerini okunabilir hâle getirir. Stack dahil edilmez.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap → Panic değeri bir error ise zincire dahil eder.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// NewPanicError → Yeni bir PanicError oluşturur.
+func NewPanicError(value interface{}, stack []byte) *PanicError {
+	return &PanicError{
+		Value: value,
+		Stack: stack,
+	}
+}
+
+// ─────────────────────────────────────────────────────────────────────────────
+// HATA KODU KAYDI (Code, CodedError)
+// Köprünün kendi hata kodları (negatif, bkz. bridge.ErrCode*) dışında
+// uygulamalar adlandırılmış, pozitif hata kodları kaydedebilir. Binding'ler
+// bu kodları döndürdüğünde JS tarafı hatayı koduyla alır; @gomad/client
+// her kod için "<Name>Error" sınıfı üretir ve hata instanceof ile ayırt
+// edilir. Kodlar genellikle paket seviyesinde, init sırasında tanımlanır:
+//
+//	var ErrQuotaExceeded = errors.NewCode(1001, "QuotaExceeded", "storage quota exceeded")
+//
+//	return nil, ErrQuotaExceeded                            // Açıklama mesaj olur
+//	return nil, ErrQuotaExceeded.New("%d MB over", over)    // Özel mesaj
+//	return nil, ErrQuotaExceeded.Wrap(err, "upload failed") // Alt hata ile
+// ─────────────────────────────────────────────────────────────────────────────
+
+// Code → Kayıtlı, adlandırılmış bir hata kodu. Code bir error'dur; doğrudan
+// döndürülebilir ve errors.Is ile CodedError'larla eşleşir.
+type Code struct {
+	Value       int    // Pozitif, uygulama içinde benzersiz kod
+	Name        string // PascalCase ad; JS sınıfı Name + "Error" olur
+	Description string // Varsayılan hata mesajı
+}
+
+// Error → Kodun açıklamasını (yoksa adını) döner.
+func (c *Code) Error() string {
+	if c.Description != "" {
+		return c.Description
+	}
+	return c.Name
+}
+
+// New → Bu kodla, verilen mesajla bir hata üretir (fmt.Sprintf biçimi).
+func (c *Code) New(format string, args ...interface{}) *CodedError {
+	return &CodedError{Code: c, Message: fmt.Sprintf(format, args...)}
+}
+
+// Wrap → Alt hatayı bu kodla sarar. message boşsa kodun açıklaması kullanılır.
+func (c *Code) Wrap(cause error, message string) *CodedError {
+	if message == "" {
+		message = c.Error()
+	}
+	return &CodedError{Code: c, Message: message, Cause: cause}
+}
+
+// CodedError → Kayıtlı bir koda sahip hata.
+type CodedError struct {
+	Code    *Code  // Hatanın kodu
+	Message string // JS tarafına giden mesaj
+	Cause   error  // Alt hata (opsiyonel)
+}
+
+// Error → Mesajı ve varsa alt hatayı döner.
+func (e *CodedError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+// Unwrap → Alt hatayı döner.
+func (e *CodedError) Unwrap() error { return e.Cause }
+
+// Is → errors.Is(err, ErrQuotaExceeded) gibi kod karşılaştırmalarını sağlar.
+func (e *CodedError) Is(target error) bool {
+	c, ok := target.(*Code)
+	return ok && c == e.Code
+}
+
+// codes, kayıtlı hata kodlarıdır.
+var codes = struct {
+	byValue map[int]*Code
+	byName  map[string]*Code
+	mu      sync.RWMutex
+}{byValue: make(map[int]*Code), byName: make(map[string]*Code)}
+
+// NewCode → Yeni bir hata kodu kaydeder ve döner.
+//
+// value pozitif olmalıdır (negatif kodlar köprüye ayrılmıştır); name büyük
+// harfle başlayan bir tanımlayıcı olmalıdır. Geçersiz ya da daha önce
+// kaydedilmiş bir değer veya ad programlama hatasıdır ve panic'e yol açar
+// (expvar.Publish gibi).
+func NewCode(value int, name, description string) *Code {
+	if value <= 0 {
+		panic(fmt.Sprintf("errors: code %d for %q must be positive", value, name))
+	}
+	if !validCodeName(name) {
+		panic(fmt.Sprintf("errors: invalid code name %q", name))
+	}
+
+	codes.mu.Lock()
+	defer codes.mu.Unlock()
+	if existing, ok := codes.byValue[value]; ok {
+		panic(fmt.Sprintf("errors: code %d already registered as %q", value, existing.Name))
+	}
+	if existing, ok := codes.byName[name]; ok {
+		panic(fmt.Sprintf("errors: code name %q already registered as %d", name, existing.Value))
+	}
+	c := &Code{Value: value, Name: name, Description: description}
+	codes.byValue[value] = c
+	codes.byName[name] = c
+	return c
+}
+
+// Codes → Kayıtlı tüm hata kodlarını değere göre sıralı döner.
+func Codes() []*Code {
+	codes.mu.RLock()
+	defer codes.mu.RUnlock()
+	list := make([]*Code, 0, len(codes.byValue))
+	for _, c := range codes.byValue {
+		list = append(list, c)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Value < list[j].Value })
+	return list
+}
+
+// LookupCode → Değeri verilen kayıtlı kodu döner; yoksa nil.
+func LookupCode(value int) *Code {
+	codes.mu.RLock()
+	defer codes.mu.RUnlock()
+	return codes.byValue[value]
+}
+
+// CodeOf → Hata zincirindeki kayıtlı kodu döner; yoksa nil.
+func CodeOf(err error) *Code {
+	var coded *CodedError
+	if errors.As(err, &coded) {
+		return coded.Code
+	}
+	var c *Code
+	if errors.As(err, &c) {
+		return c
+	}
+	return nil
+}
+
+// validCodeName, adın büyük harfle başlayan bir tanımlayıcı olup olmadığını döner.
+func validCodeName(name string) bool {
+	for i, r := range name {
+		switch {
+		case i == 0 && !unicode.IsUpper(r):
+			return false
+		case r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			return false
+		}
+	}
+	return name != ""
+}
+
+// ─────────────────────────────────────────────────────────────────────────────
+// SENTINEL KAYDI
+// Köprü, binding'lerin döndürdüğü hata zincirini JS'e taşırken zincirin
+// errors.Is ile eşleştiği sentinel'leri adlarıyla bildirir (ör.
+// "fs.ErrNotExist"); frontend sarılmış mesajları ayrıştırmadan hatanın
+// türünü anlayabilir. Yaygın standart kütüphane ve GOMAD sentinel'leri
+// kayıtlıdır; uygulamalar kendi sentinel'lerini RegisterSentinel ile ekler.
+// ─────────────────────────────────────────────────────────────────────────────
+
+// sentinel, adlandırılmış bir sentinel hatadır.
+type sentinel struct {
+	name string
+	err  error
+}
+
+// sentinels, kayıt sırasıyla tutulan sentinel'lerdir.
+var sentinels = struct {
+	list []sentinel
+	mu   sync.RWMutex
+}{list: []sentinel{
+	{"io.EOF", io.EOF},
+	{"io.ErrUnexpectedEOF", io.ErrUnexpectedEOF},
+	{"fs.ErrNotExist", fs.ErrNotExist},
+	{"fs.ErrExist", fs.ErrExist},
+	{"fs.ErrPermission", fs.ErrPermission},
+	{"fs.ErrClosed", fs.ErrClosed},
+	{"context.Canceled", context.Canceled},
+	{"context.DeadlineExceeded", context.DeadlineExceeded},
+	{"os.ErrDeadlineExceeded", os.ErrDeadlineExceeded},
+	{"gomad.ErrNotReady", ErrNotReady},
+	{"gomad.ErrAlreadyExists", ErrAlreadyExists},
+	{"gomad.ErrNotFound", ErrNotFound},
+	{"gomad.ErrInvalidArgument", ErrInvalidArgument},
+	{"gomad.ErrClosed", ErrClosed},
+	{"gomad.ErrNotSupported", ErrNotSupported},
+	{"gomad.ErrPermissionDenied", ErrPermissionDenied},
+}}
+
+// RegisterSentinel → err'i verilen adla sentinel olarak kaydeder. Aynı ad
+// tekrar kaydedilirse yeni hata eskisinin yerine geçer.
+func RegisterSentinel(name string, err error) {
+	sentinels.mu.Lock()
+	defer sentinels.mu.Unlock()
+	for i, s := range sentinels.list {
+		if s.name == name {
+			sentinels.list[i].err = err
+			return
+		}
+	}
+	sentinels.list = append(sentinels.list, sentinel{name, err})
+}
+
+// SentinelsOf → err'in errors.Is ile eşleştiği kayıtlı sentinel adlarını
+// kayıt sırasıyla döner.
+func SentinelsOf(err error) []string {
+	sentinels.mu.RLock()
+	defer sentinels.mu.RUnlock()
+	var names []string
+	for _, s := range sentinels.list {
+		if errors.Is(err, s.err) {
+			names = append(names, s.name)
+		}
+	}
+	return names
+}
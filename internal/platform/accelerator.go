@@ -0,0 +1,140 @@
+package platform
+
+import (
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// Accelerator, "CmdOrCtrl+Shift+S" gibi bir kısayolun çözümlenmiş halidir.
+// Menü kısayolları ve global kısayollar aynı sözdizimini kullanır.
+type Accelerator struct {
+	Ctrl, Alt, Shift, Meta bool
+
+	// Key, tuşun normalize adıdır: "a"-"z", "0"-"9", "f1"-"f24" veya
+	// "enter", "escape", "up", "equal" gibi adlar. JS tarafında
+	// KeyboardEvent.code'dan türetilen adla aynıdır.
+	Key string
+
+	label string // Menüde görünen tuş etiketi
+}
+
+// Combo, kısayolun karşılaştırma anahtarıdır: modifier'lar sabit sırada
+// (ctrl, alt, shift, meta), ardından tuş adı. Örn: "ctrl+shift+s".
+func (a Accelerator) Combo() string {
+	parts := a.modifiers([]string{"ctrl", "alt", "shift", "meta"})
+	return strings.Join(append(parts, a.Key), "+")
+}
+
+// String, kısayolun menüde görünen halidir. Örn: "Ctrl+Shift+S".
+func (a Accelerator) String() string {
+	parts := a.modifiers([]string{"Ctrl", "Alt", "Shift", "Cmd"})
+	return strings.Join(append(parts, a.label), "+")
+}
+
+func (a Accelerator) modifiers(names []string) []string {
+	var out []string
+	for i, on := range []bool{a.Ctrl, a.Alt, a.Shift, a.Meta} {
+		if on {
+			out = append(out, names[i])
+		}
+	}
+	return out
+}
+
+// acceleratorKeys, kabul edilen tuş adlarını JS tarafındaki ada ve menüde
+// görünen etikete eşler.
+var acceleratorKeys = map[string][2]string{
+	"enter":     {"enter", "Enter"},
+	"return":    {"enter", "Enter"},
+	"esc":       {"escape", "Esc"},
+	"escape":    {"escape", "Esc"},
+	"tab":       {"tab", "Tab"},
+	"space":     {"space", "Space"},
+	"backspace": {"backspace", "Backspace"},
+	"delete":    {"delete", "Del"},
+	"del":       {"delete", "Del"},
+	"insert":    {"insert", "Ins"},
+	"home":      {"home", "Home"},
+	"end":       {"end", "End"},
+	"pageup":    {"pageup", "PgUp"},
+	"pagedown":  {"pagedown", "PgDn"},
+	"up":        {"up", "Up"},
+	"down":      {"down", "Down"},
+	"left":      {"left", "Left"},
+	"right":     {"right", "Right"},
+	"plus":      {"equal", "+"},
+	"=":         {"equal", "="},
+	"minus":     {"minus", "-"},
+	"-":         {"minus", "-"},
+	",":         {"comma", ","},
+	".":         {"period", "."},
+	"/":         {"slash", "/"},
+}
+
+// ParseAccelerator, kısayol metnini çözümler.
+//
+// Modifier'lar: Ctrl/Control, Alt/Option, Shift, Cmd/Command/Meta/Super ve
+// platforma göre Cmd ya da Ctrl olan CmdOrCtrl/CommandOrControl.
+// Tuşlar: A-Z, 0-9, F1-F24 ve acceleratorKeys'teki adlar (büyük/küçük harf duyarsız).
+func ParseAccelerator(s string) (Accelerator, error) {
+	if strings.TrimSpace(s) == "" {
+		return Accelerator{}, fmt.Errorf("empty accelerator")
+	}
+	parts := strings.Split(s, "+")
+	if strings.HasSuffix(s, "++") {
+		// "Ctrl++" → son tuş "+"
+		parts = append(parts[:len(parts)-2], "plus")
+	}
+
+	var ctrl, alt, shift, meta bool
+	for _, mod := range parts[:len(parts)-1] {
+		switch strings.ToLower(strings.TrimSpace(mod)) {
+		case "ctrl", "control":
+			ctrl = true
+		case "alt", "option":
+			alt = true
+		case "shift":
+			shift = true
+		case "cmd", "command", "meta", "super":
+			meta = true
+		case "cmdorctrl", "commandorcontrol":
+			if runtime.GOOS == "darwin" {
+				meta = true
+			} else {
+				ctrl = true
+			}
+		default:
+			return Accelerator{}, fmt.Errorf("accelerator %q: unknown modifier %q", s, mod)
+		}
+	}
+
+	key, label, err := acceleratorKey(strings.TrimSpace(parts[len(parts)-1]))
+	if err != nil {
+		return Accelerator{}, fmt.Errorf("accelerator %q: %w", s, err)
+	}
+
+	return Accelerator{Ctrl: ctrl, Alt: alt, Shift: shift, Meta: meta, Key: key, label: label}, nil
+}
+
+// acceleratorKey, tuş adını JS adına ve görünen etikete çevirir.
+func acceleratorKey(name string) (key, label string, err error) {
+	lower := strings.ToLower(name)
+
+	if len(lower) == 1 && (lower[0] >= 'a' && lower[0] <= 'z' || lower[0] >= '0' && lower[0] <= '9') {
+		return lower, strings.ToUpper(lower), nil
+	}
+	if len(lower) >= 2 && lower[0] == 'f' {
+		if n, err := strconv.Atoi(lower[1:]); err == nil && n >= 1 && n <= 24 && strconv.Itoa(n) == lower[1:] {
+			return lower, strings.ToUpper(lower), nil
+		}
+	}
+	if k, ok := acceleratorKeys[lower]; ok {
+		return k[0], k[1], nil
+	}
+	if name == "" {
+		return "", "", fmt.Errorf("missing key")
+	}
+	return "", "", fmt.Errorf("unknown key %q", name)
+}
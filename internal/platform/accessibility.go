@@ -0,0 +1,22 @@
+package platform
+
+// ============================================================================
+// ANNOUNCER INTERFACE
+// Mesajı işletim sisteminin erişilebilirlik katmanı üzerinden ekran
+// okuyucuya iletebilen implementasyonların sözleşmesidir ("Dosya kaydedildi",
+// "3 sonuç bulundu"). Window interface'ine eklenmemiştir; çağıran taraf type
+// assertion ile kontrol eder. UI thread'inden çağrılmalıdır.
+//
+// Platform karşılıkları:
+//
+//   - Windows → UI Automation bildirimi (UiaRaiseNotificationEvent)
+//   - macOS   → NSAccessibilityAnnouncementRequestedNotification
+//   - Linux   → AT-SPI "announcement" olayı
+//
+// ============================================================================
+type Announcer interface {
+	// Announce → mesajı ekran okuyucuya iletir. assertive true ise okunmakta
+	// olan içerik kesilir; false ise mesaj sıraya girer. Platform mesajı
+	// iletemezse false döner.
+	Announce(message string, assertive bool) bool
+}
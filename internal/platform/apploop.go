@@ -0,0 +1,41 @@
+package platform
+
+import "errors"
+
+// ============================================================================
+// APPLICATION LOOP
+// UI thread'inin tüm pencereleri ve Dispatch kuyruğunu işleyen tek olay
+// döngüsüdür. Win32'de mesaj kuyruğu pencereye değil thread'e ait olduğundan
+// pencere başına bir döngü çoklu pencereyle çalışmaz. İşletim sistemi paketi
+// döngüsünü init sırasında RegisterAppLoop ile kaydeder.
+//
+// Platform karşılıkları:
+//
+//   - Windows → windows.RunApp (GetMessage döngüsü + gizli dispatcher penceresi)
+//   - macOS   → kayıt yok; NSApplication döngüsü zaten uygulama düzeyindedir
+//   - Linux   → kayıt yok; gtk_main zaten uygulama düzeyindedir
+//
+// ============================================================================
+
+// ErrNoAppLoop, platform kendi uygulama döngüsünü kaydetmediğinde RunApp'tan
+// döner; çağıran taraf WebView'in döngüsünü kullanır.
+var ErrNoAppLoop = errors.New("no platform application loop")
+
+// appLoop, RegisterAppLoop ile kaydedilen döngüdür.
+var appLoop func() error
+
+// RegisterAppLoop, platformun uygulama döngüsünü kaydeder. İşletim sistemi
+// paketlerinin init fonksiyonlarından çağrılır.
+func RegisterAppLoop(run func() error) {
+	appLoop = run
+}
+
+// RunApp, uygulama döngüsünü çağıran (UI) thread'inde çalıştırır ve döngü
+// bitene (son pencere kapanana ya da çıkış istenene) kadar bloklar. Kayıtlı
+// döngü yoksa hemen ErrNoAppLoop döner.
+func RunApp() error {
+	if appLoop == nil {
+		return ErrNoAppLoop
+	}
+	return appLoop()
+}
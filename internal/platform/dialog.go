@@ -0,0 +1,36 @@
+package platform
+
+// ============================================================================
+// FILE DIALOG MODEL
+// Dosya/klasör seçme ve kaydetme diyaloglarının platform sözleşmesidir.
+// Implementasyonlar native diyaloğu (IFileDialog, NSOpenPanel/NSSavePanel,
+// GtkFileChooser) modal olarak açar ve seçilen yolları döner.
+// ============================================================================
+
+// FileDialogKind, açılacak diyaloğun türüdür.
+type FileDialogKind int
+
+const (
+	FileDialogOpen      FileDialogKind = iota // Tek dosya seç
+	FileDialogOpenMulti                       // Birden fazla dosya seç
+	FileDialogFolder                          // Klasör seç
+	FileDialogSave                            // Kaydedilecek dosya yolunu seç
+)
+
+// FileFilter, diyalogdaki dosya türü filtresidir.
+// Patterns glob biçimindedir: "*.png", "*.jpg".
+type FileFilter struct {
+	Name     string
+	Patterns []string
+}
+
+// FileDialogOptions, diyalog ayarlarıdır.
+type FileDialogOptions struct {
+	Kind        FileDialogKind
+	Title       string       // Başlık çubuğu metni
+	ButtonLabel string       // Onay butonu metni (boşsa sistem varsayılanı)
+	Directory   string       // Başlangıç klasörü
+	FileName    string       // Başlangıç dosya adı (özellikle kaydetmede)
+	Filters     []FileFilter // Dosya türü filtreleri
+	Owner       uintptr      // Sahip pencere tutamacı (0 → sahipsiz)
+}
@@ -0,0 +1,14 @@
+package platform
+
+// ============================================================================
+// KIOSK HOST INTERFACE
+// Pencereyi bulunduğu ekranı kaplayan, çerçevesiz ve her zaman üstte bir
+// kiosk görünümüne geçirebilen implementasyonların sözleşmesidir. MenuHost
+// gibi Window interface'ine eklenmemiştir; çağıran taraf type assertion ile
+// kontrol eder. UI thread'inden çağrılmalıdır.
+// ============================================================================
+type KioskHost interface {
+	// SetKiosk → true: pencere stili, konumu ve menü çubuğu saklanır; pencere
+	// ekranı kaplar. false: saklanan görünüme geri dönülür.
+	SetKiosk(enabled bool) error
+}
@@ -0,0 +1,887 @@
+//go:build linux
+
+package linux
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ============================================================================
+// MINIMAL D-BUS İSTEMCİSİ
+// BlueZ gibi yalnızca D-Bus API'si sunan sistem servislerini cgo ve harici
+// bağımlılık olmadan kullanabilmek için gereken en küçük parçalar: sistem
+// bus'ına bağlanma (EXTERNAL kimlik doğrulama), metod çağırma, sinyal
+// dinleme ve wire formatının kodlanıp çözülmesi.
+//
+// Bağlantı kalıcıdır: BlueZ tarama ve bildirim oturumlarını onları başlatan
+// bağlantıya bağlar, bağlantı kapanınca oturumlar da biter. Bu yüzden
+// komut satırı araçları (busctl, gdbus) yerine bu istemci kullanılır.
+//
+// Go → D-Bus tip eşlemesi: byte=y, bool=b, int16=n, uint16=q, int32=i,
+// uint32=u, int64=x, uint64=t, float64=d, string=s, ObjectPath=o,
+// []byte=ay, []string=as, map[string]Variant=a{sv}, Variant=v.
+// Çözülen değerlerde diziler []interface{}, sözlükler map[string]interface{}
+// (anahtarı string/ObjectPath olanlar), struct'lar []interface{} olur.
+// ============================================================================
+
+const (
+	// dbusSystemSocket, DBUS_SYSTEM_BUS_ADDRESS tanımlı değilse kullanılan adrestir.
+	dbusSystemSocket = "/var/run/dbus/system_bus_socket"
+
+	// dbusCallTimeout, bir metod çağrısının cevabının en uzun bekleme süresidir.
+	dbusCallTimeout = 30 * time.Second
+
+	// dbusMaxMessage, kabul edilen en büyük mesajdır (spesifikasyon sınırı 128 MiB).
+	dbusMaxMessage = 128 << 20
+)
+
+// Mesaj tipleri
+const (
+	dbusMethodCall   = 1
+	dbusMethodReturn = 2
+	dbusError        = 3
+	dbusSignal       = 4
+)
+
+// Başlık alanları
+const (
+	dbusFieldPath        = 1
+	dbusFieldInterface   = 2
+	dbusFieldMember      = 3
+	dbusFieldErrorName   = 4
+	dbusFieldReplySerial = 5
+	dbusFieldDestination = 6
+	dbusFieldSender      = 7
+	dbusFieldSignature   = 8
+)
+
+// ErrDBusClosed, bağlantı kapandıktan sonra yapılan çağrılarda döner.
+var ErrDBusClosed = errors.New("dbus connection closed")
+
+// ObjectPath, D-Bus nesne yoludur (o).
+type ObjectPath string
+
+// Variant, tipi çalışma zamanında belli olan bir değerdir (v).
+type Variant struct {
+	Signature string
+	Value     interface{}
+}
+
+// DBusError, servisin döndürdüğü hata cevabıdır.
+type DBusError struct {
+	Name    string // ör. org.bluez.Error.NotReady
+	Message string
+}
+
+func (e *DBusError) Error() string {
+	if e.Message == "" {
+		return e.Name
+	}
+	return e.Name + ": " + e.Message
+}
+
+// Signal, alınan bir D-Bus sinyalidir.
+type Signal struct {
+	Sender    string
+	Path      ObjectPath
+	Interface string
+	Member    string
+	Body      []interface{}
+}
+
+// dbusMessage, çözülmüş bir mesajdır.
+type dbusMessage struct {
+	kind   byte
+	serial uint32
+	fields map[byte]interface{}
+	body   []interface{}
+}
+
+// DBusConn, bir bus bağlantısıdır. Metodları eşzamanlı çağrılabilir.
+type DBusConn struct {
+	conn   net.Conn
+	name   string
+	serial atomic.Uint32
+
+	writeMu sync.Mutex
+
+	pending   map[uint32]chan *dbusMessage
+	signals   map[int]func(*Signal)
+	nextSig   int
+	err       error
+	closed    bool
+	stateMu   sync.Mutex
+	readerEnd chan struct{}
+}
+
+// SystemBus, sistem bus'ına yeni bir bağlantı açar.
+func SystemBus() (*DBusConn, error) {
+	path := dbusSystemSocket
+	if addr := os.Getenv("DBUS_SYSTEM_BUS_ADDRESS"); addr != "" {
+		if p, ok := unixPath(addr); ok {
+			path = p
+		}
+	}
+	conn, err := net.Dial("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	c := &DBusConn{
+		conn:      conn,
+		pending:   make(map[uint32]chan *dbusMessage),
+		signals:   make(map[int]func(*Signal)),
+		readerEnd: make(chan struct{}),
+	}
+	r := bufio.NewReader(conn)
+	if err := c.auth(r); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("dbus auth: %w", err)
+	}
+	go c.read(r)
+
+	reply, err := c.Call("org.freedesktop.DBus", "/org/freedesktop/DBus", "org.freedesktop.DBus", "Hello")
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	if len(reply) > 0 {
+		c.name, _ = reply[0].(string)
+	}
+	return c, nil
+}
+
+// unixPath, "unix:path=/run/dbus/system_bus_socket,guid=..." adresinden
+// soket yolunu çıkarır.
+func unixPath(addr string) (string, bool) {
+	for _, entry := range strings.Split(addr, ";") {
+		rest, ok := strings.CutPrefix(entry, "unix:")
+		if !ok {
+			continue
+		}
+		for _, kv := range strings.Split(rest, ",") {
+			if p, ok := strings.CutPrefix(kv, "path="); ok {
+				return p, true
+			}
+		}
+	}
+	return "", false
+}
+
+// auth, EXTERNAL mekanizmasıyla (süreç kullanıcısının uid'i) kimlik doğrular.
+func (c *DBusConn) auth(r *bufio.Reader) error {
+	uid := hex.EncodeToString([]byte(strconv.Itoa(os.Getuid())))
+	if _, err := io.WriteString(c.conn, "\x00AUTH EXTERNAL "+uid+"\r\n"); err != nil {
+		return err
+	}
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(line, "OK ") {
+		return fmt.Errorf("rejected: %s", strings.TrimSpace(line))
+	}
+	_, err = io.WriteString(c.conn, "BEGIN\r\n")
+	return err
+}
+
+// Name, bağlantının bus üzerindeki benzersiz adıdır (":1.42").
+func (c *DBusConn) Name() string { return c.name }
+
+// Close, bağlantıyı kapatır; bekleyen çağrılar ErrDBusClosed ile döner.
+func (c *DBusConn) Close() error {
+	err := c.conn.Close()
+	<-c.readerEnd
+	return err
+}
+
+// Done, bağlantı kapandığında kapanan kanalı döner.
+func (c *DBusConn) Done() <-chan struct{} { return c.readerEnd }
+
+// Call, bir metodu çağırır ve cevabın gövdesini döner.
+func (c *DBusConn) Call(dest string, path ObjectPath, iface, method string, args ...interface{}) ([]interface{}, error) {
+	sig, body, err := encodeBody(args)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[byte]Variant{
+		dbusFieldPath:        {"o", path},
+		dbusFieldMember:      {"s", method},
+		dbusFieldDestination: {"s", dest},
+	}
+	if iface != "" {
+		fields[dbusFieldInterface] = Variant{"s", iface}
+	}
+	if sig != "" {
+		fields[dbusFieldSignature] = Variant{"g", sig}
+	}
+
+	serial := c.serial.Add(1)
+	reply := make(chan *dbusMessage, 1)
+	c.stateMu.Lock()
+	if c.closed {
+		c.stateMu.Unlock()
+		return nil, ErrDBusClosed
+	}
+	c.pending[serial] = reply
+	c.stateMu.Unlock()
+
+	if err := c.send(dbusMethodCall, serial, fields, body); err != nil {
+		c.stateMu.Lock()
+		delete(c.pending, serial)
+		c.stateMu.Unlock()
+		return nil, err
+	}
+
+	timer := time.NewTimer(dbusCallTimeout)
+	defer timer.Stop()
+	select {
+	case msg := <-reply:
+		if msg == nil {
+			return nil, c.closeErr()
+		}
+		if msg.kind == dbusError {
+			name, _ := msg.fields[dbusFieldErrorName].(string)
+			e := &DBusError{Name: name}
+			if len(msg.body) > 0 {
+				e.Message, _ = msg.body[0].(string)
+			}
+			return nil, e
+		}
+		return msg.body, nil
+	case <-timer.C:
+		c.stateMu.Lock()
+		delete(c.pending, serial)
+		c.stateMu.Unlock()
+		return nil, fmt.Errorf("dbus call %s.%s timed out", iface, method)
+	}
+}
+
+// AddMatch, bus'tan match kuralına uyan sinyalleri ister (ör.
+// "type='signal',sender='org.bluez',member='PropertiesChanged'").
+func (c *DBusConn) AddMatch(rule string) error {
+	_, err := c.Call("org.freedesktop.DBus", "/org/freedesktop/DBus", "org.freedesktop.DBus", "AddMatch", rule)
+	return err
+}
+
+// RemoveMatch, AddMatch ile eklenen kuralı kaldırır.
+func (c *DBusConn) RemoveMatch(rule string) error {
+	_, err := c.Call("org.freedesktop.DBus", "/org/freedesktop/DBus", "org.freedesktop.DBus", "RemoveMatch", rule)
+	return err
+}
+
+// OnSignal, alınan her sinyal için fn'i çağırır ve kaldırma fonksiyonu
+// döner. fn okuma goroutine'inde çalışır; Call gibi cevap bekleyen işler
+// için yeni goroutine başlatılmalıdır, aksi hâlde bağlantı kilitlenir.
+func (c *DBusConn) OnSignal(fn func(*Signal)) (remove func()) {
+	c.stateMu.Lock()
+	id := c.nextSig
+	c.nextSig++
+	c.signals[id] = fn
+	c.stateMu.Unlock()
+	return func() {
+		c.stateMu.Lock()
+		delete(c.signals, id)
+		c.stateMu.Unlock()
+	}
+}
+
+// closeErr, bağlantının kapanma nedenini döner.
+func (c *DBusConn) closeErr() error {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	if c.err != nil && !errors.Is(c.err, net.ErrClosed) {
+		return fmt.Errorf("%w: %v", ErrDBusClosed, c.err)
+	}
+	return ErrDBusClosed
+}
+
+// send, bir mesajı kodlayıp yazar.
+func (c *DBusConn) send(kind byte, serial uint32, fields map[byte]Variant, body []byte) error {
+	e := &encoder{}
+	e.buf = append(e.buf, 'l', kind, 0, 1)
+	e.uint32(uint32(len(body)))
+	e.uint32(serial)
+	codes := make([]int, 0, len(fields))
+	for code := range fields {
+		codes = append(codes, int(code))
+	}
+	sortInts(codes)
+	e.array(8, func() error {
+		for _, code := range codes {
+			e.align(8)
+			e.buf = append(e.buf, byte(code))
+			if err := e.variant(fields[byte(code)]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	e.align(8)
+	msg := append(e.buf, body...)
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_, err := c.conn.Write(msg)
+	return err
+}
+
+// read, gelen mesajları okuyup cevapları bekleyen çağrılara, sinyalleri
+// dinleyicilere iletir. Bağlantı kapanınca bekleyen tüm çağrılar sonlanır.
+func (c *DBusConn) read(r *bufio.Reader) {
+	defer close(c.readerEnd)
+	for {
+		msg, err := readMessage(r)
+		if err != nil {
+			c.stateMu.Lock()
+			c.err, c.closed = err, true
+			pending := c.pending
+			c.pending = map[uint32]chan *dbusMessage{}
+			c.stateMu.Unlock()
+			for _, ch := range pending {
+				ch <- nil
+			}
+			return
+		}
+
+		switch msg.kind {
+		case dbusMethodReturn, dbusError:
+			serial, _ := msg.fields[dbusFieldReplySerial].(uint32)
+			c.stateMu.Lock()
+			ch := c.pending[serial]
+			delete(c.pending, serial)
+			c.stateMu.Unlock()
+			if ch != nil {
+				ch <- msg
+			}
+		case dbusSignal:
+			s := &Signal{Body: msg.body}
+			s.Sender, _ = msg.fields[dbusFieldSender].(string)
+			s.Path, _ = msg.fields[dbusFieldPath].(ObjectPath)
+			s.Interface, _ = msg.fields[dbusFieldInterface].(string)
+			s.Member, _ = msg.fields[dbusFieldMember].(string)
+			c.stateMu.Lock()
+			fns := make([]func(*Signal), 0, len(c.signals))
+			for _, fn := range c.signals {
+				fns = append(fns, fn)
+			}
+			c.stateMu.Unlock()
+			for _, fn := range fns {
+				fn(s)
+			}
+		}
+	}
+}
+
+// readMessage, bir mesajı okur ve çözer.
+func readMessage(r *bufio.Reader) (*dbusMessage, error) {
+	fixed := make([]byte, 16)
+	if _, err := io.ReadFull(r, fixed); err != nil {
+		return nil, err
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	switch fixed[0] {
+	case 'l':
+	case 'B':
+		order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("dbus: invalid endianness %q", fixed[0])
+	}
+	bodyLen := order.Uint32(fixed[4:8])
+	fieldsLen := order.Uint32(fixed[12:16])
+	headerLen := 16 + uint64(fieldsLen)
+	headerLen += (8 - headerLen%8) % 8
+	total := headerLen + uint64(bodyLen)
+	if total > dbusMaxMessage {
+		return nil, fmt.Errorf("dbus: message too large (%d bytes)", total)
+	}
+
+	buf := make([]byte, total)
+	copy(buf, fixed)
+	if _, err := io.ReadFull(r, buf[16:]); err != nil {
+		return nil, err
+	}
+
+	msg := &dbusMessage{kind: fixed[1], serial: order.Uint32(fixed[8:12]), fields: map[byte]interface{}{}}
+	d := &decoder{buf: buf[:headerLen], pos: 12, order: order}
+	raw, err := d.value("a(yv)")
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range raw.([]interface{}) {
+		field := f.([]interface{})
+		msg.fields[field[0].(byte)] = field[1].(Variant).Value
+	}
+
+	if sig, _ := msg.fields[dbusFieldSignature].(string); sig != "" {
+		d := &decoder{buf: buf[headerLen:], order: order}
+		for sig != "" {
+			t, rest, err := nextType(sig)
+			if err != nil {
+				return nil, err
+			}
+			v, err := d.value(t)
+			if err != nil {
+				return nil, err
+			}
+			msg.body = append(msg.body, v)
+			sig = rest
+		}
+	}
+	return msg, nil
+}
+
+// ----------------------------------------------------------------------------
+// Kodlama
+// ----------------------------------------------------------------------------
+
+// encoder, değerleri little-endian wire formatında yazar. Hizalama tampon
+// başına göredir; gövde mesajda 8'e hizalı başladığı için bu yeterlidir.
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) align(n int) {
+	for len(e.buf)%n != 0 {
+		e.buf = append(e.buf, 0)
+	}
+}
+
+func (e *encoder) uint32(v uint32) {
+	e.align(4)
+	e.buf = binary.LittleEndian.AppendUint32(e.buf, v)
+}
+
+func (e *encoder) string(s string) {
+	e.uint32(uint32(len(s)))
+	e.buf = append(e.buf, s...)
+	e.buf = append(e.buf, 0)
+}
+
+// array, uzunluk alanını yazar, elemanları fn ile ekler ve uzunluğu
+// doldurur. Uzunluk ilk elemanın hizalama dolgusunu içermez.
+func (e *encoder) array(elemAlign int, fn func() error) error {
+	e.uint32(0)
+	at := len(e.buf) - 4
+	e.align(elemAlign)
+	start := len(e.buf)
+	if err := fn(); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(e.buf[at:], uint32(len(e.buf)-start))
+	return nil
+}
+
+func (e *encoder) variant(v Variant) error {
+	e.buf = append(e.buf, byte(len(v.Signature)))
+	e.buf = append(e.buf, v.Signature...)
+	e.buf = append(e.buf, 0)
+	if s, ok := v.Value.(string); ok && v.Signature == "g" {
+		// İmzalar metinlerden farklı olarak tek baytlık uzunluk önekiyle yazılır
+		e.buf = append(e.buf, byte(len(s)))
+		e.buf = append(e.buf, s...)
+		e.buf = append(e.buf, 0)
+		return nil
+	}
+	return e.value(v.Value)
+}
+
+// value, Go değerini tipine göre kodlar.
+func (e *encoder) value(v interface{}) error {
+	switch v := v.(type) {
+	case byte:
+		e.buf = append(e.buf, v)
+	case bool:
+		b := uint32(0)
+		if v {
+			b = 1
+		}
+		e.uint32(b)
+	case int16:
+		e.align(2)
+		e.buf = binary.LittleEndian.AppendUint16(e.buf, uint16(v))
+	case uint16:
+		e.align(2)
+		e.buf = binary.LittleEndian.AppendUint16(e.buf, v)
+	case int32:
+		e.uint32(uint32(v))
+	case uint32:
+		e.uint32(v)
+	case int64:
+		e.align(8)
+		e.buf = binary.LittleEndian.AppendUint64(e.buf, uint64(v))
+	case uint64:
+		e.align(8)
+		e.buf = binary.LittleEndian.AppendUint64(e.buf, v)
+	case float64:
+		e.align(8)
+		e.buf = binary.LittleEndian.AppendUint64(e.buf, math.Float64bits(v))
+	case string:
+		e.string(v)
+	case ObjectPath:
+		e.string(string(v))
+	case []byte:
+		e.uint32(uint32(len(v)))
+		e.buf = append(e.buf, v...)
+	case []string:
+		return e.array(4, func() error {
+			for _, s := range v {
+				e.string(s)
+			}
+			return nil
+		})
+	case map[string]Variant:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sortStrings(keys)
+		return e.array(8, func() error {
+			for _, k := range keys {
+				e.align(8)
+				e.string(k)
+				if err := e.variant(v[k]); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	case Variant:
+		return e.variant(v)
+	default:
+		return fmt.Errorf("dbus: unsupported type %T", v)
+	}
+	return nil
+}
+
+// signatureOf, Go değerinin D-Bus imzasını döner.
+func signatureOf(v interface{}) (string, error) {
+	switch v.(type) {
+	case byte:
+		return "y", nil
+	case bool:
+		return "b", nil
+	case int16:
+		return "n", nil
+	case uint16:
+		return "q", nil
+	case int32:
+		return "i", nil
+	case uint32:
+		return "u", nil
+	case int64:
+		return "x", nil
+	case uint64:
+		return "t", nil
+	case float64:
+		return "d", nil
+	case string:
+		return "s", nil
+	case ObjectPath:
+		return "o", nil
+	case []byte:
+		return "ay", nil
+	case []string:
+		return "as", nil
+	case map[string]Variant:
+		return "a{sv}", nil
+	case Variant:
+		return "v", nil
+	}
+	return "", fmt.Errorf("dbus: unsupported type %T", v)
+}
+
+// encodeBody, argümanları kodlar ve imzalarını döner.
+func encodeBody(args []interface{}) (string, []byte, error) {
+	var sig strings.Builder
+	e := &encoder{}
+	for _, arg := range args {
+		s, err := signatureOf(arg)
+		if err != nil {
+			return "", nil, err
+		}
+		sig.WriteString(s)
+		if err := e.value(arg); err != nil {
+			return "", nil, err
+		}
+	}
+	return sig.String(), e.buf, nil
+}
+
+// ----------------------------------------------------------------------------
+// Çözme
+// ----------------------------------------------------------------------------
+
+// errTruncated, mesaj imzanın gerektirdiğinden kısa olduğunda döner.
+var errTruncated = errors.New("dbus: truncated message")
+
+// decoder, wire formatındaki değerleri imzaya göre çözer.
+type decoder struct {
+	buf   []byte
+	pos   int
+	order binary.ByteOrder
+}
+
+func (d *decoder) align(n int) error {
+	for d.pos%n != 0 {
+		d.pos++
+	}
+	if d.pos > len(d.buf) {
+		return errTruncated
+	}
+	return nil
+}
+
+func (d *decoder) take(n int) ([]byte, error) {
+	if n < 0 || d.pos+n > len(d.buf) {
+		return nil, errTruncated
+	}
+	b := d.buf[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+func (d *decoder) fixed(size int) ([]byte, error) {
+	if err := d.align(size); err != nil {
+		return nil, err
+	}
+	return d.take(size)
+}
+
+func (d *decoder) uint32() (uint32, error) {
+	b, err := d.fixed(4)
+	if err != nil {
+		return 0, err
+	}
+	return d.order.Uint32(b), nil
+}
+
+// str, uzunluk önekli ve NUL sonlu bir metni okur; lenSize 4 (s, o) ya da
+// 1'dir (g).
+func (d *decoder) str(lenSize int) (string, error) {
+	var n int
+	if lenSize == 1 {
+		b, err := d.take(1)
+		if err != nil {
+			return "", err
+		}
+		n = int(b[0])
+	} else {
+		v, err := d.uint32()
+		if err != nil {
+			return "", err
+		}
+		n = int(v)
+	}
+	b, err := d.take(n + 1)
+	if err != nil {
+		return "", err
+	}
+	return string(b[:n]), nil
+}
+
+// value, tek bir tam tipi çözer.
+func (d *decoder) value(sig string) (interface{}, error) {
+	if sig == "" {
+		return nil, errors.New("dbus: empty signature")
+	}
+	switch sig[0] {
+	case 'y':
+		b, err := d.take(1)
+		if err != nil {
+			return nil, err
+		}
+		return b[0], nil
+	case 'b':
+		v, err := d.uint32()
+		return v != 0, err
+	case 'n', 'q':
+		b, err := d.fixed(2)
+		if err != nil {
+			return nil, err
+		}
+		if sig[0] == 'n' {
+			return int16(d.order.Uint16(b)), nil
+		}
+		return d.order.Uint16(b), nil
+	case 'i':
+		v, err := d.uint32()
+		return int32(v), err
+	case 'u', 'h':
+		return d.uint32()
+	case 'x', 't', 'd':
+		b, err := d.fixed(8)
+		if err != nil {
+			return nil, err
+		}
+		v := d.order.Uint64(b)
+		switch sig[0] {
+		case 'x':
+			return int64(v), nil
+		case 'd':
+			return math.Float64frombits(v), nil
+		}
+		return v, nil
+	case 's':
+		return d.str(4)
+	case 'o':
+		s, err := d.str(4)
+		return ObjectPath(s), err
+	case 'g':
+		return d.str(1)
+	case 'v':
+		inner, err := d.str(1)
+		if err != nil {
+			return nil, err
+		}
+		v, err := d.value(inner)
+		return Variant{Signature: inner, Value: v}, err
+	case 'a':
+		return d.array(sig[1:])
+	case '(':
+		if err := d.align(8); err != nil {
+			return nil, err
+		}
+		fields := sig[1 : len(sig)-1]
+		var out []interface{}
+		for fields != "" {
+			t, rest, err := nextType(fields)
+			if err != nil {
+				return nil, err
+			}
+			v, err := d.value(t)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, v)
+			fields = rest
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("dbus: unsupported signature %q", sig)
+}
+
+// array, eleman imzası elem olan bir diziyi çözer.
+func (d *decoder) array(elem string) (interface{}, error) {
+	n, err := d.uint32()
+	if err != nil {
+		return nil, err
+	}
+	if err := d.align(alignOf(elem[0])); err != nil {
+		return nil, err
+	}
+	end := d.pos + int(n)
+	if end > len(d.buf) {
+		return nil, errTruncated
+	}
+
+	if elem == "y" {
+		b, _ := d.take(int(n))
+		return append([]byte{}, b...), nil
+	}
+	if elem[0] == '{' {
+		key, value := elem[1:2], elem[2:len(elem)-1]
+		out := map[string]interface{}{}
+		for d.pos < end {
+			if err := d.align(8); err != nil {
+				return nil, err
+			}
+			k, err := d.value(key)
+			if err != nil {
+				return nil, err
+			}
+			v, err := d.value(value)
+			if err != nil {
+				return nil, err
+			}
+			out[fmt.Sprint(k)] = v
+		}
+		return out, nil
+	}
+	out := []interface{}{}
+	for d.pos < end {
+		v, err := d.value(elem)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+// alignOf, tipin hizalamasıdır.
+func alignOf(t byte) int {
+	switch t {
+	case 'n', 'q':
+		return 2
+	case 'b', 'i', 'u', 'h', 's', 'o', 'a':
+		return 4
+	case 'x', 't', 'd', '(', '{':
+		return 8
+	}
+	return 1 // y, g, v
+}
+
+// nextType, imzanın başındaki tam tipi ve kalanını döner.
+func nextType(sig string) (string, string, error) {
+	if sig == "" {
+		return "", "", errors.New("dbus: empty signature")
+	}
+	switch sig[0] {
+	case 'a':
+		t, rest, err := nextType(sig[1:])
+		return "a" + t, rest, err
+	case '(', '{':
+		closing := byte(')')
+		if sig[0] == '{' {
+			closing = '}'
+		}
+		depth := 0
+		for i := 0; i < len(sig); i++ {
+			switch sig[i] {
+			case '(', '{':
+				depth++
+			case ')', '}':
+				depth--
+				if depth == 0 {
+					if sig[i] != closing {
+						return "", "", fmt.Errorf("dbus: invalid signature %q", sig)
+					}
+					return sig[:i+1], sig[i+1:], nil
+				}
+			}
+		}
+		return "", "", fmt.Errorf("dbus: invalid signature %q", sig)
+	}
+	return sig[:1], sig[1:], nil
+}
+
+// sortInts ve sortStrings, kodlamanın deterministik olması için küçük
+// yardımcılardır (alan ve anahtar sayıları küçüktür).
+func sortInts(s []int) {
+	for i := 1; i < len(s); i++ {
+		for j := i; j > 0 && s[j] < s[j-1]; j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+}
+
+func sortStrings(s []string) {
+	for i := 1; i < len(s); i++ {
+		for j := i; j > 0 && s[j] < s[j-1]; j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+}
@@ -0,0 +1,294 @@
+//go:build linux
+
+package linux
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"net"
+	"reflect"
+	"testing"
+)
+
+// decodeBody, encodeBody'nin ürettiği gövdeyi imzasına göre çözer.
+func decodeBody(t *testing.T, sig string, body []byte) []interface{} {
+	t.Helper()
+	d := &decoder{buf: body, order: binary.LittleEndian}
+	var out []interface{}
+	for sig != "" {
+		typ, rest, err := nextType(sig)
+		if err != nil {
+			t.Fatalf("nextType(%q): %v", sig, err)
+		}
+		v, err := d.value(typ)
+		if err != nil {
+			t.Fatalf("decode %q: %v", typ, err)
+		}
+		out = append(out, v)
+		sig = rest
+	}
+	if d.pos != len(body) {
+		t.Fatalf("decoded %d of %d bytes", d.pos, len(body))
+	}
+	return out
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []interface{}
+		sig  string
+		want []interface{}
+	}{
+		{
+			name: "basic",
+			in:   []interface{}{byte(7), true, int16(-2), uint16(3), int32(-4), uint32(5), int64(-6), uint64(7), 1.5, "hi", ObjectPath("/org/bluez")},
+			sig:  "ybnqiuxtdso",
+			want: []interface{}{byte(7), true, int16(-2), uint16(3), int32(-4), uint32(5), int64(-6), uint64(7), 1.5, "hi", ObjectPath("/org/bluez")},
+		},
+		{
+			name: "extremes",
+			in:   []interface{}{int64(math.MinInt64), uint64(math.MaxUint64), math.Inf(-1), ""},
+			sig:  "xtds",
+			want: []interface{}{int64(math.MinInt64), uint64(math.MaxUint64), math.Inf(-1), ""},
+		},
+		{
+			name: "arrays",
+			in:   []interface{}{[]byte{1, 2, 3}, []string{"a", "bcd"}, []byte{}, []string{}},
+			sig:  "ayasayas",
+			want: []interface{}{[]byte{1, 2, 3}, []interface{}{"a", "bcd"}, []byte{}, []interface{}{}},
+		},
+		{
+			name: "dict",
+			in: []interface{}{map[string]Variant{
+				"Name":   {Signature: "s", Value: "sensor"},
+				"RSSI":   {Signature: "n", Value: int16(-60)},
+				"Paired": {Signature: "b", Value: true},
+				"UUIDs":  {Signature: "as", Value: []string{"180d"}},
+			}},
+			sig: "a{sv}",
+			want: []interface{}{map[string]interface{}{
+				"Name":   Variant{Signature: "s", Value: "sensor"},
+				"RSSI":   Variant{Signature: "n", Value: int16(-60)},
+				"Paired": Variant{Signature: "b", Value: true},
+				"UUIDs":  Variant{Signature: "as", Value: []interface{}{"180d"}},
+			}},
+		},
+		{
+			name: "variants",
+			in: []interface{}{
+				Variant{Signature: "g", Value: "a{sv}"},
+				Variant{Signature: "t", Value: uint64(1) << 40},
+				Variant{Signature: "ay", Value: []byte{0xff}},
+			},
+			sig: "vvv",
+			want: []interface{}{
+				Variant{Signature: "g", Value: "a{sv}"},
+				Variant{Signature: "t", Value: uint64(1) << 40},
+				Variant{Signature: "ay", Value: []byte{0xff}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sig, body, err := encodeBody(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sig != tt.sig {
+				t.Fatalf("signature = %q, want %q", sig, tt.sig)
+			}
+			if got := decodeBody(t, sig, body); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("decoded %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeAlignment(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []interface{}
+		want []byte
+	}{
+		{
+			// uint64 8'e hizalanır
+			name: "padding before uint64",
+			in:   []interface{}{byte(1), uint64(2)},
+			want: []byte{1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			// int16 2'ye, string uzunluğu 4'e hizalanır; metin NUL ile biter
+			name: "int16 and string",
+			in:   []interface{}{byte(1), int16(-1), "ab"},
+			want: []byte{1, 0, 0xff, 0xff, 2, 0, 0, 0, 'a', 'b', 0},
+		},
+		{
+			// Dizi uzunluğu ilk elemandan önceki dolguyu içermez: uzunluk
+			// 8..12'de, sözlük girdileri 16'da başlar
+			name: "array length excludes padding",
+			in:   []interface{}{uint32(9), byte(1), map[string]Variant{"k": {Signature: "y", Value: byte(5)}}},
+			want: []byte{
+				9, 0, 0, 0, // u
+				1, 0, 0, 0, // y + dolgu
+				10, 0, 0, 0, // dizi uzunluğu
+				0, 0, 0, 0, // girdiler 8'e hizalı
+				1, 0, 0, 0, 'k', 0, // anahtar
+				1, 'y', 0, 5, // varyant
+			},
+		},
+		{
+			// Varyant imzası tek baytlık uzunlukla yazılır, değer kendi
+			// hizalamasına göre dolgulanır
+			name: "variant alignment",
+			in:   []interface{}{Variant{Signature: "u", Value: uint32(3)}},
+			want: []byte{1, 'u', 0, 0, 3, 0, 0, 0},
+		},
+		{
+			// "g" değerinin uzunluk öneki de tek bayttır
+			name: "signature value",
+			in:   []interface{}{Variant{Signature: "g", Value: "as"}},
+			want: []byte{1, 'g', 0, 2, 'a', 's', 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, body, err := encodeBody(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(body, tt.want) {
+				t.Fatalf("encoded\n%v\nwant\n%v", body, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeBigEndian(t *testing.T) {
+	buf := []byte{
+		0x01, 0x02, 0x03, 0x04, // u
+		0, 0, 0, 0, // x için dolgu
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe, // x
+		0, 0, 0, 3, 'a', 'b', 'c', 0, // s
+	}
+	d := &decoder{buf: buf, order: binary.BigEndian}
+	var got []interface{}
+	for _, sig := range []string{"u", "x", "s"} {
+		v, err := d.value(sig)
+		if err != nil {
+			t.Fatalf("decode %q: %v", sig, err)
+		}
+		got = append(got, v)
+	}
+	want := []interface{}{uint32(0x01020304), int64(-2), "abc"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("decoded %#v, want %#v", got, want)
+	}
+}
+
+func TestDecodeTruncated(t *testing.T) {
+	_, body, err := encodeBody([]interface{}{"hello", []string{"a", "b"}, uint64(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for n := 0; n < len(body); n++ {
+		d := &decoder{buf: body[:n], order: binary.LittleEndian}
+		var err error
+		for _, sig := range []string{"s", "as", "t"} {
+			if _, err = d.value(sig); err != nil {
+				break
+			}
+		}
+		if !errors.Is(err, errTruncated) {
+			t.Fatalf("body[:%d]: err = %v, want errTruncated", n, err)
+		}
+	}
+}
+
+func TestNextType(t *testing.T) {
+	tests := []struct {
+		sig, typ, rest string
+	}{
+		{"s", "s", ""},
+		{"ayas", "ay", "as"},
+		{"a{sv}u", "a{sv}", "u"},
+		{"(ya(sv))b", "(ya(sv))", "b"},
+		{"aa{oa{sa{sv}}}", "aa{oa{sa{sv}}}", ""},
+	}
+	for _, tt := range tests {
+		typ, rest, err := nextType(tt.sig)
+		if err != nil || typ != tt.typ || rest != tt.rest {
+			t.Errorf("nextType(%q) = %q, %q, %v; want %q, %q", tt.sig, typ, rest, err, tt.typ, tt.rest)
+		}
+	}
+	for _, sig := range []string{"", "(s", "{sv)", "a"} {
+		if _, _, err := nextType(sig); err == nil {
+			t.Errorf("nextType(%q): want error", sig)
+		}
+	}
+}
+
+func TestUnsupportedType(t *testing.T) {
+	if _, _, err := encodeBody([]interface{}{struct{}{}}); err == nil {
+		t.Fatal("want error for unsupported type")
+	}
+}
+
+// TestMessageRoundTrip, send'in yazdığı mesajı readMessage ile geri okur.
+func TestMessageRoundTrip(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+	c := &DBusConn{conn: client}
+
+	sig, body, err := encodeBody([]interface{}{"org.bluez.Device1", map[string]Variant{"Connected": {Signature: "b", Value: true}}, []string{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields := map[byte]Variant{
+		dbusFieldPath:      {Signature: "o", Value: ObjectPath("/org/bluez/hci0/dev_AA")},
+		dbusFieldInterface: {Signature: "s", Value: "org.freedesktop.DBus.Properties"},
+		dbusFieldMember:    {Signature: "s", Value: "PropertiesChanged"},
+		dbusFieldSignature: {Signature: "g", Value: sig},
+	}
+	go func() {
+		if err := c.send(dbusSignal, 42, fields, body); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	msg, err := readMessage(bufio.NewReader(server))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.kind != dbusSignal || msg.serial != 42 {
+		t.Fatalf("kind = %d, serial = %d", msg.kind, msg.serial)
+	}
+	wantFields := map[byte]interface{}{
+		dbusFieldPath:      ObjectPath("/org/bluez/hci0/dev_AA"),
+		dbusFieldInterface: "org.freedesktop.DBus.Properties",
+		dbusFieldMember:    "PropertiesChanged",
+		dbusFieldSignature: "sa{sv}as",
+	}
+	if !reflect.DeepEqual(msg.fields, wantFields) {
+		t.Fatalf("fields = %#v, want %#v", msg.fields, wantFields)
+	}
+	wantBody := []interface{}{
+		"org.bluez.Device1",
+		map[string]interface{}{"Connected": Variant{Signature: "b", Value: true}},
+		[]interface{}{},
+	}
+	if !reflect.DeepEqual(msg.body, wantBody) {
+		t.Fatalf("body = %#v, want %#v", msg.body, wantBody)
+	}
+}
+
+func TestReadMessageTooLarge(t *testing.T) {
+	header := []byte{'l', dbusSignal, 0, 1, 0xff, 0xff, 0xff, 0xff, 1, 0, 0, 0, 0, 0, 0, 0}
+	if _, err := readMessage(bufio.NewReader(bytes.NewReader(header))); err == nil {
+		t.Fatal("want error for oversized message")
+	}
+}
@@ -0,0 +1,141 @@
+//go:build linux
+
+// Package linux, GOMAD'ın Linux'a özel platform implementasyonlarını içerir.
+package linux
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// ============================================================================
+// LIBNOTIFY NOTIFICATIONS
+// Bildirimler libnotify'ın notify-send aracı ile gösterilir. Böylece cgo ve
+// D-Bus bağımlılığı olmadan tüm freedesktop uyumlu masaüstlerinde çalışır.
+// Aksiyonlar (-A) ve --wait, libnotify 0.7.9+ gerektirir; daha eski
+// sürümlerde bildirim aksiyonsuz gösterilir ve tıklama bildirilmez.
+// ============================================================================
+
+var _ platform.Notifier = (*Notifier)(nil)
+
+// defaultAction, freedesktop spesifikasyonunda bildirimin gövdesine
+// tıklanınca tetiklenen özel aksiyon anahtarıdır; buton olarak görünmez.
+const defaultAction = "default"
+
+// Notifier, notify-send tabanlı platform.Notifier implementasyonudur.
+type Notifier struct {
+	appName string
+	command string
+
+	onClick   func(id, action string)
+	onDismiss func(id string)
+	mu        sync.RWMutex
+}
+
+// NewNotifier creates a notifier. notify-send bulunamazsa ErrNotSupported döner.
+func NewNotifier(appName string) (*Notifier, error) {
+	command, err := exec.LookPath("notify-send")
+	if err != nil {
+		return nil, gomerrors.NewWindowError("notification", "notify-send not found", gomerrors.ErrNotSupported)
+	}
+	return &Notifier{appName: appName, command: command}, nil
+}
+
+// ShowNotification implements platform.Notifier.
+// notify-send, bildirim kapanana kadar bekler; bu yüzden ayrı bir goroutine'de çalışır.
+func (n *Notifier) ShowNotification(notification *platform.Notification) error {
+	args := []string{"--app-name", n.appName}
+	if len(notification.Icon) > 0 {
+		if path, err := iconFile(notification.Icon); err == nil {
+			args = append(args, "--icon", path)
+		}
+	}
+	plain := append([]string(nil), args...)
+
+	args = append(args, "--wait", "--action", defaultAction+"=Open")
+	for _, action := range notification.Actions {
+		args = append(args, "--action", action.ID+"="+action.Label)
+	}
+
+	args = append(args, "--", notification.Title, notification.Body)
+	plain = append(plain, "--", notification.Title, notification.Body)
+
+	go n.run(notification.ID, args, plain)
+	return nil
+}
+
+// run, notify-send'i çalıştırır ve seçilen aksiyonu callback'lere iletir.
+func (n *Notifier) run(id string, args, plain []string) {
+	out, err := exec.Command(n.command, args...).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			// Eski libnotify: aksiyonları tanımıyor; aksiyonsuz göster
+			_ = exec.Command(n.command, plain...).Run()
+		}
+		n.dismiss(id)
+		return
+	}
+
+	action := strings.TrimSpace(string(out))
+	if action == "" {
+		n.dismiss(id)
+		return
+	}
+	if action == defaultAction {
+		action = ""
+	}
+
+	n.mu.RLock()
+	onClick := n.onClick
+	n.mu.RUnlock()
+	if onClick != nil {
+		onClick(id, action)
+	}
+}
+
+func (n *Notifier) dismiss(id string) {
+	n.mu.RLock()
+	onDismiss := n.onDismiss
+	n.mu.RUnlock()
+	if onDismiss != nil {
+		onDismiss(id)
+	}
+}
+
+// OnNotificationClick implements platform.Notifier.
+func (n *Notifier) OnNotificationClick(callback func(id, action string)) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.onClick = callback
+}
+
+// OnNotificationDismiss implements platform.Notifier.
+func (n *Notifier) OnNotificationDismiss(callback func(id string)) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.onDismiss = callback
+}
+
+// iconFile, ikon verisini geçici dizinde içerik hash'i ile adlandırılmış bir
+// dosyaya yazar. notify-send yalnızca dosya yolu kabul eder; aynı ikon tekrar yazılmaz.
+func iconFile(data []byte) (string, error) {
+	sum := sha256.Sum256(data)
+	path := filepath.Join(os.TempDir(), "gomad-icon-"+hex.EncodeToString(sum[:8]))
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return "", err
+	}
+	return path, nil
+}
@@ -0,0 +1,67 @@
+package platform
+
+// ============================================================================
+// MENU MODEL
+// Tray menüsü, pencere menü çubuğu ve sağ tık (context) menüleri aynı veri
+// modelini kullanır. Platform implementasyonları bu ağacı native menüye
+// (HMENU, NSMenu, GtkMenu) çevirir; tıklamalar ID ile geri bildirilir.
+// ============================================================================
+
+// MenuItem, native menüdeki tek bir öğeyi tanımlar.
+type MenuItem struct {
+	ID          string      // Tıklamada geri bildirilen benzersiz kimlik
+	Label       string      // Görünen metin
+	Accelerator string      // Kısayol metni (ör. "Ctrl+S"); yalnızca görüntülenir
+	Separator   bool        // true ise ayırıcı çizgi (diğer alanlar yok sayılır)
+	Checkable   bool        // Onay kutusu davranışı
+	Checked     bool        // Onay durumu
+	Disabled    bool        // Gri / tıklanamaz
+	Submenu     []*MenuItem // Alt menü (varsa öğe tıklanamaz, açılır)
+}
+
+// ============================================================================
+// MENU HOST INTERFACE
+// Menü çubuğu ve context menü gösterebilen pencerelerin sözleşmesidir.
+// Window interface'ine eklenmemiştir; menü desteği olan implementasyonlar
+// bunu ayrıca karşılar ve çağıran taraf type assertion ile kontrol eder.
+// Tüm metodlar UI thread'inden çağrılmalıdır.
+// ============================================================================
+type MenuHost interface {
+	// SetMenuBar → Pencerenin menü çubuğunu değiştirir. nil → menü çubuğu kaldırılır.
+	// Seçilen öğenin ID'si onSelect ile bildirilir.
+	SetMenuBar(items []*MenuItem, onSelect func(id string)) error
+
+	// PopupMenu → İmleç konumunda bir context menü açar ve kullanıcı seçim
+	// yapana ya da menüyü kapatana kadar bloklar. Seçim yoksa ok false döner.
+	PopupMenu(items []*MenuItem) (id string, ok bool, err error)
+}
+
+// ============================================================================
+// TRAY INTERFACE
+// Sistem tepsisi (Windows notification area, macOS menu bar extras,
+// Linux StatusNotifierItem) ikonunun platform sözleşmesidir.
+// Tüm metodlar UI thread'inden çağrılmalıdır.
+// ============================================================================
+type Tray interface {
+	// SetIcon → İkonu değiştirir. Windows'ta .ico formatında veri beklenir.
+	SetIcon(icon []byte) error
+
+	// SetTooltip → Fare üzerine gelince görünen metin.
+	SetTooltip(text string) error
+
+	// SetMenu → Sağ tıkta açılan menüyü değiştirir. nil → menü yok.
+	SetMenu(items []*MenuItem) error
+
+	// OnClick → İkona tıklandığında çağrılır (sol/sağ buton bilgisiyle).
+	// Sağ tık ile menü açılıyorsa callback menüden önce çağrılır.
+	OnClick(callback func(button MouseButton))
+
+	// OnDoubleClick → İkona çift tıklandığında çağrılır.
+	OnDoubleClick(callback func())
+
+	// OnMenuItem → Menü öğesi seçildiğinde öğe ID'si ile çağrılır.
+	OnMenuItem(callback func(id string))
+
+	// Destroy → İkonu tepsiden kaldırır ve kaynakları serbest bırakır.
+	Destroy()
+}
@@ -0,0 +1,42 @@
+package platform
+
+// ============================================================================
+// NOTIFICATION MODEL
+// Masaüstü bildirimlerinin (Windows toast/balon, macOS Notification Center,
+// Linux libnotify) platform sözleşmesidir. Bildirime veya bir aksiyon
+// butonuna tıklanınca bildirim ID'si ile geri bildirilir.
+// ============================================================================
+
+// Notification, gösterilecek tek bir bildirimdir.
+type Notification struct {
+	ID      string               // Geri bildirimlerde kullanılan benzersiz kimlik
+	Title   string               // Başlık
+	Body    string               // Mesaj metni
+	Icon    []byte               // İkon verisi (Windows: .ico, Linux: .png/.ico); nil → uygulama ikonu
+	Actions []NotificationAction // Aksiyon butonları (desteklenmeyen platformlarda yok sayılır)
+}
+
+// NotificationAction, bildirim üzerindeki bir butondur.
+type NotificationAction struct {
+	ID    string
+	Label string
+}
+
+// ============================================================================
+// NOTIFIER INTERFACE
+// Bildirim gösterebilen implementasyonların sözleşmesidir. Callback'ler
+// herhangi bir thread'den çağrılabilir; çağıran taraf gerekirse UI thread'ine taşır.
+// ============================================================================
+type Notifier interface {
+	// ShowNotification → Bildirimi gösterir. Asenkrondur; kullanıcı etkileşimi
+	// callback'ler ile bildirilir.
+	ShowNotification(n *Notification) error
+
+	// OnNotificationClick → Bildirime (action == "") veya bir aksiyon butonuna
+	// tıklandığında çağrılır.
+	OnNotificationClick(callback func(id, action string))
+
+	// OnNotificationDismiss → Bildirim tıklanmadan kapandığında (zaman aşımı,
+	// kullanıcı kapattı, yeni bildirim tarafından değiştirildi) çağrılır.
+	OnNotificationDismiss(callback func(id string))
+}
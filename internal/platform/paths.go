@@ -0,0 +1,146 @@
+package platform
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// ============================================================================
+// STANDART UYGULAMA DİZİNLERİ
+// Her işletim sistemi kullanıcı verisini, ayarları, cache'i ve logları farklı
+// yerlerde tutar. Her uygulamanın bu kuralları yeniden yazmaması için tek bir
+// çözümleyici burada tanımlanır. Dizinler uygulama kimliğinden (app ID) türetilir:
+//
+//	Windows → %APPDATA%\<id>, %LOCALAPPDATA%\<id>\Cache ...
+//	macOS   → ~/Library/Application Support/<id>, ~/Library/Caches/<id> ...
+//	Linux   → XDG: ~/.local/share/<id>, ~/.config/<id>, ~/.cache/<id> ...
+// ============================================================================
+
+// DirKind, standart uygulama dizini türünü belirtir.
+type DirKind int
+
+const (
+	DirUserData DirKind = iota // Kalıcı kullanıcı verisi (veritabanı, dokümanlar)
+	DirConfig                  // Ayar dosyaları
+	DirCache                   // Silinebilir önbellek
+	DirLogs                    // Log dosyaları
+	DirTemp                    // Geçici dosyalar
+)
+
+// String → Dizin türünün okunabilir adını döner.
+func (k DirKind) String() string {
+	switch k {
+	case DirUserData:
+		return "userData"
+	case DirConfig:
+		return "config"
+	case DirCache:
+		return "cache"
+	case DirLogs:
+		return "logs"
+	case DirTemp:
+		return "temp"
+	default:
+		return "unknown"
+	}
+}
+
+// ValidateAppID
+// -----------------------------------------------------------------------------
+// App ID dizin adı olarak kullanılacağı için boş olamaz ve yol ayırıcı ya da
+// ".." içeremez. Aksi halde uygulama kendi dizininin dışına yazabilir.
+func ValidateAppID(appID string) error {
+	if appID == "" {
+		return fmt.Errorf("app id cannot be empty")
+	}
+	if strings.ContainsAny(appID, `/\:`) || appID == "." || appID == ".." {
+		return fmt.Errorf("invalid app id %q", appID)
+	}
+	return nil
+}
+
+// ResolveDir
+// -----------------------------------------------------------------------------
+// Verilen dizin türü için işletim sistemine uygun yolu hesaplar. Dizini
+// oluşturmaz; oluşturma işi çağırana bırakılır (bkz. EnsureDir).
+func ResolveDir(appID string, kind DirKind) (string, error) {
+	if err := ValidateAppID(appID); err != nil {
+		return "", err
+	}
+
+	if kind == DirTemp {
+		return filepath.Join(os.TempDir(), appID), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	switch runtime.GOOS {
+	case "windows":
+		roaming := envOr("APPDATA", filepath.Join(home, "AppData", "Roaming"))
+		local := envOr("LOCALAPPDATA", filepath.Join(home, "AppData", "Local"))
+		switch kind {
+		case DirUserData:
+			return filepath.Join(roaming, appID), nil
+		case DirConfig:
+			return filepath.Join(roaming, appID, "Config"), nil
+		case DirCache:
+			return filepath.Join(local, appID, "Cache"), nil
+		case DirLogs:
+			return filepath.Join(local, appID, "Logs"), nil
+		}
+
+	case "darwin":
+		lib := filepath.Join(home, "Library")
+		switch kind {
+		case DirUserData:
+			return filepath.Join(lib, "Application Support", appID), nil
+		case DirConfig:
+			return filepath.Join(lib, "Application Support", appID, "Config"), nil
+		case DirCache:
+			return filepath.Join(lib, "Caches", appID), nil
+		case DirLogs:
+			return filepath.Join(lib, "Logs", appID), nil
+		}
+
+	default:
+		// Linux ve diğer Unix'ler — XDG Base Directory
+		switch kind {
+		case DirUserData:
+			return filepath.Join(envOr("XDG_DATA_HOME", filepath.Join(home, ".local", "share")), appID), nil
+		case DirConfig:
+			return filepath.Join(envOr("XDG_CONFIG_HOME", filepath.Join(home, ".config")), appID), nil
+		case DirCache:
+			return filepath.Join(envOr("XDG_CACHE_HOME", filepath.Join(home, ".cache")), appID), nil
+		case DirLogs:
+			return filepath.Join(envOr("XDG_STATE_HOME", filepath.Join(home, ".local", "state")), appID, "logs"), nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown directory kind: %d", kind)
+}
+
+// EnsureDir → ResolveDir + dizini (yoksa) oluşturur.
+func EnsureDir(appID string, kind DirKind) (string, error) {
+	dir, err := ResolveDir(appID, kind)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create %s directory: %w", kind, err)
+	}
+	return dir, nil
+}
+
+// envOr → Ortam değişkeni tanımlıysa onu, değilse varsayılanı döner.
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,31 @@
+package platform
+
+// Placement, pencerenin ekrandaki yerleşimidir. Konum ve boyut pencerenin
+// normal (büyütülmemiş) hâlindeki dış sınırlarıdır, sanal masaüstü
+// koordinatlarındadır; büyütülmüş bir pencere geri yüklendiğinde bu
+// sınırlara döner.
+type Placement struct {
+	X, Y          int
+	Width, Height int
+	Maximized     bool
+	// Monitor, pencerenin bulunduğu monitörün aygıt adıdır (ör. \\.\DISPLAY2)
+	Monitor string
+}
+
+// ============================================================================
+// PLACEMENT HOST INTERFACE
+// Pencerenin yerleşimini okuyup geri yükleyebilen implementasyonların
+// sözleşmesidir; oturum geri yükleme pencereleri kaldıkları monitöre ve
+// boyuta geri koyar. Window interface'ine eklenmemiştir; çağıran taraf type
+// assertion ile kontrol eder. UI thread'inden çağrılmalıdır.
+// ============================================================================
+type PlacementHost interface {
+	// Placement → pencerenin geçerli yerleşimi.
+	Placement() Placement
+
+	// SetPlacement → pencereyi p'ye taşır. Monitör hâlâ bağlıysa sınırlar
+	// monitörün çalışma alanına sığdırılır (çözünürlük değişmiş olabilir).
+	// Monitör çıkarılmışsa ve sınırlar başka bir monitörde görünmüyorsa
+	// pencere taşınmaz ve false döner.
+	SetPlacement(p Placement) bool
+}
@@ -19,6 +19,8 @@
 // Bu soyutlama sayesinde kodun %90’ı işletim sistemi fark etmeksizin çalışır.
 package platform
 
+import "log/slog"
+
 // ============================================================================
 // WINDOW INTERFACE
 // Üst seviye tüm pencere işlemlerinin ortak sözleşmesidir. Bir OS implementasyonu
@@ -49,6 +51,12 @@ type Window interface {
 	// ancak destroy edilip yeniden oluşturulması istenmiyorsa kullanılır.
 	Hide()
 
+	// IsVisible
+	// ----------------------------------------------------
+	// Pencere Hide ile gizlenmemişse true döner. Tray'den göster/gizle
+	// davranışı gibi durum değiştirme işlemlerinde kullanılır.
+	IsVisible() bool
+
 	// Close
 	// ----------------------------------------------------
 	// Pencereyi tamamen yok eder, tüm handle ve resource'ları serbest bırakır.
@@ -185,6 +193,8 @@ type WindowConfig struct {
 	Height    int    // Yükseklik
 	Resizable bool   // Boyutlandırılabilir mi?
 	Centered  bool   // Ortalansın mı?
+
+	Logger *slog.Logger // Lifecycle logları (nil → log üretilmez)
 }
 
 // DefaultWindowConfig
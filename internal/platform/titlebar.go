@@ -0,0 +1,43 @@
+package platform
+
+// ============================================================================
+// TITLEBAR OVERLAY
+// Native başlık düğmeleri (küçült, büyüt, kapat) yerinde kalırken web
+// içeriğinin başlık çubuğu alanına uzandığı hibrit pencere modudur
+// (WebView2 titleBarOverlay, macOS hiddenInset başlığı). Sayfa başlık
+// çubuğunu kendisi çizer; düğmelerin kapladığı alana içerik koymamak için
+// TitlebarGeometry'yi kullanır.
+//
+// Window interface'ine eklenmemiştir; çağıran taraf type assertion ile
+// kontrol eder. Tüm metodlar UI thread'inden çağrılmalıdır.
+// ============================================================================
+
+// TitlebarGeometry, başlık çubuğunda web içeriğine kalan alandır: native
+// düğmelerin dışında kalan dikdörtgen. Değerler client alanına göre fiziksel
+// piksel cinsindendir.
+type TitlebarGeometry struct {
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+type TitlebarHost interface {
+	// SetTitlebarOverlay → true: client alanı başlık çubuğunu kapsar, native
+	// düğmeler içeriğin üzerinde kalır. false: standart başlık çubuğu.
+	SetTitlebarOverlay(enabled bool) error
+
+	// TitlebarGeometry → Overlay açıksa güncel alanı döner; kapalıysa ok false.
+	TitlebarGeometry() (geometry TitlebarGeometry, ok bool)
+
+	// OnTitlebarGeometry → Alan değiştiğinde (boyut, büyütme, DPI) çağrılır.
+	OnTitlebarGeometry(callback func(TitlebarGeometry))
+
+	// DragWindow → Sol fare tuşu basılıyken pencereyi sürüklemeye başlar;
+	// sayfanın sürükleme bölgelerinden çağrılır.
+	DragWindow() error
+
+	// ToggleMaximize → Büyütülmüşse geri yükler, değilse büyütür (başlık
+	// çubuğuna çift tıklama).
+	ToggleMaximize()
+}
@@ -0,0 +1,77 @@
+//go:build windows
+
+package windows
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// ============================================================================
+// ERİŞİLEBİLİRLİK
+// Ekran okuyucu varlığı SPI_GETSCREENREADER ile okunur (Narrator, NVDA ve
+// JAWS bu bayrağı açar). Duyurular pencerenin UI Automation sağlayıcısı
+// üzerinden bildirim olayı olarak gönderilir; UiaRaiseNotificationEvent
+// Windows 10 1709 ile gelmiştir, daha eski sürümlerde Announce false döner.
+// ============================================================================
+
+var _ platform.Announcer = (*Window)(nil)
+
+var (
+	uiautomationcore              = syscall.NewLazyDLL("uiautomationcore.dll")
+	procUiaHostProviderFromHwnd   = uiautomationcore.NewProc("UiaHostProviderFromHwnd")
+	procUiaRaiseNotificationEvent = uiautomationcore.NewProc("UiaRaiseNotificationEvent")
+	oleaut32                      = syscall.NewLazyDLL("oleaut32.dll")
+	procSysAllocString            = oleaut32.NewProc("SysAllocString")
+	procSysFreeString             = oleaut32.NewProc("SysFreeString")
+)
+
+const (
+	SPI_GETSCREENREADER = 0x0046
+
+	// NotificationKind / NotificationProcessing (UIAutomationCore.h)
+	NotificationKind_Other                     = 4
+	NotificationProcessing_ImportantMostRecent = 1
+	NotificationProcessing_All                 = 2
+)
+
+/*
+ScreenReaderActive → Bir ekran okuyucu çalışıyorsa true.
+*/
+func ScreenReaderActive() bool {
+	var active int32
+	procSystemParametersInfoW.Call(SPI_GETSCREENREADER, 0, uintptr(unsafe.Pointer(&active)), 0)
+	return active != 0
+}
+
+// Announce sends message to screen readers as a UI Automation notification.
+func (w *Window) Announce(message string, assertive bool) bool {
+	if message == "" || procUiaRaiseNotificationEvent.Find() != nil {
+		return false
+	}
+	provider := new(*comObject)
+	if ret, _, _ := procUiaHostProviderFromHwnd.Call(uintptr(w.hwnd), uintptr(unsafe.Pointer(provider))); ret != S_OK || *provider == nil {
+		return false
+	}
+	defer (*provider).Release()
+
+	text := bstr(message)
+	defer procSysFreeString.Call(text)
+	activity := bstr("gomad.announce")
+	defer procSysFreeString.Call(activity)
+
+	processing := uintptr(NotificationProcessing_All)
+	if assertive {
+		processing = NotificationProcessing_ImportantMostRecent
+	}
+	ret, _, _ := procUiaRaiseNotificationEvent.Call(uintptr(unsafe.Pointer(*provider)), NotificationKind_Other, processing, text, activity)
+	return ret == S_OK
+}
+
+// bstr, s'yi SysFreeString ile serbest bırakılması gereken bir BSTR'ye çevirir.
+func bstr(s string) uintptr {
+	p, _, _ := procSysAllocString.Call(uintptr(unsafe.Pointer(UTF16PtrFromString(s))))
+	return p
+}
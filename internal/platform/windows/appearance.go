@@ -0,0 +1,78 @@
+//go:build windows
+
+package windows
+
+import (
+	"unsafe"
+)
+
+// ============================================================================
+// GÖRÜNÜM AYARLARI
+// Kullanıcının kişiselleştirme tercihleri: vurgu rengi ve koyu tema registry'de,
+// erişilebilirlik ayarları (animasyon, yüksek kontrast) SystemParametersInfo'da
+// tutulur.
+// ============================================================================
+
+var procSystemParametersInfoW = user32.NewProc("SystemParametersInfoW")
+
+const (
+	SPI_GETHIGHCONTRAST        = 0x0042
+	SPI_GETCLIENTAREAANIMATION = 0x1042
+	HCF_HIGHCONTRASTON         = 0x00000001
+	personalizeKey             = `Software\Microsoft\Windows\CurrentVersion\Themes\Personalize`
+	dwmKey                     = `Software\Microsoft\Windows\DWM`
+)
+
+// HIGHCONTRAST: SPI_GETHIGHCONTRAST parametre yapısı
+type HIGHCONTRAST struct {
+	CbSize            uint32
+	DwFlags           uint32
+	LpszDefaultScheme *uint16
+}
+
+/*
+AccentColor → Kullanıcının vurgu rengini (R, G, B) döner.
+DWM AccentColor değeri ABGR sırasında saklanır.
+*/
+func AccentColor() (r, g, b uint8, err error) {
+	v, err := RegGetDWORD(dwmKey, "AccentColor")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return uint8(v), uint8(v >> 8), uint8(v >> 16), nil
+}
+
+/*
+AppsUseDarkTheme → Uygulamalar için koyu tema seçiliyse true.
+*/
+func AppsUseDarkTheme() bool {
+	v, err := RegGetDWORD(personalizeKey, "AppsUseLightTheme")
+	return err == nil && v == 0
+}
+
+/*
+TransparencyEnabled → Saydamlık efektleri açıksa true (varsayılan açık).
+*/
+func TransparencyEnabled() bool {
+	v, err := RegGetDWORD(personalizeKey, "EnableTransparency")
+	return err != nil || v != 0
+}
+
+/*
+ClientAreaAnimation → Windows'ta "animasyonları göster" açıksa true.
+*/
+func ClientAreaAnimation() bool {
+	var enabled int32 = 1
+	procSystemParametersInfoW.Call(SPI_GETCLIENTAREAANIMATION, 0, uintptr(unsafe.Pointer(&enabled)), 0)
+	return enabled != 0
+}
+
+/*
+HighContrastEnabled → Yüksek kontrast teması etkinse true.
+*/
+func HighContrastEnabled() bool {
+	hc := HIGHCONTRAST{}
+	hc.CbSize = uint32(unsafe.Sizeof(hc))
+	ret, _, _ := procSystemParametersInfoW.Call(SPI_GETHIGHCONTRAST, uintptr(hc.CbSize), uintptr(unsafe.Pointer(&hc)), 0)
+	return ret != 0 && hc.DwFlags&HCF_HIGHCONTRASTON != 0
+}
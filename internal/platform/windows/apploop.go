@@ -0,0 +1,157 @@
+//go:build windows
+
+package windows
+
+import (
+	"errors"
+	"sync"
+	"syscall"
+
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// ============================================================================
+// UYGULAMA MESAJ DÖNGÜSÜ (Application Loop)
+// Win32'de mesaj kuyruğu pencereye değil thread'e aittir; UI thread'inde
+// oluşturulan tüm pencereler tek bir GetMessage döngüsüyle beslenir. Pencere
+// başına döngü birden fazla pencerede çalışmaz: ilk pencere kapandığında
+// WM_QUIT tüm uygulamayı durdurur.
+//
+// RunApp, UI thread'inin tek döngüsüdür (bkz. platform.RunApp). Bütün
+// pencereleri (WebView pencereleri dahil) ve Dispatch kuyruğunu işler; son
+// sahip olunan pencere yok edildiğinde ya da WM_QUIT geldiğinde (Quit,
+// WebView Terminate) geri döner.
+//
+// Dispatch kuyruğu gizli bir mesaj penceresi üzerinden çalışır. Thread
+// mesajları (PostThreadMessage) modal döngüler sırasında (MessageBox,
+// pencere sürükleme) kaybolur; pencere mesajları ise her döngüde iletilir.
+// ============================================================================
+
+// wmDispatch, Dispatch kuyruğunu işletmek için UI thread'ine gönderilen mesaj.
+const wmDispatch = WM_APP + 3
+
+// RunApp, platform.RunApp'ın Windows karşılığıdır.
+func init() {
+	platform.RegisterAppLoop(RunApp)
+}
+
+// ErrLoopRunning, RunApp zaten çalışırken tekrar çağrıldığında döner.
+var ErrLoopRunning = errors.New("application loop already running")
+
+var appLoop struct {
+	mu      sync.Mutex
+	window  *MessageWindow
+	queue   []func()
+	running bool
+}
+
+// RunApp runs the application message loop on the calling (UI) thread.
+// -----------------------------------------------------------------------------
+// Pencereler RunApp'ı çağıran thread'de oluşturulmuş olmalıdır. RunApp
+// başlamadan önce Dispatch ile kuyruğa alınan fonksiyonlar döngü başlar
+// başlamaz çalıştırılır.
+func RunApp() error {
+	appLoop.mu.Lock()
+	if appLoop.running {
+		appLoop.mu.Unlock()
+		return ErrLoopRunning
+	}
+	mw, err := NewMessageWindow("GomadDispatcher", func(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) (uintptr, bool) {
+		if msg == wmDispatch {
+			drainDispatch()
+			return 0, true
+		}
+		return 0, false
+	})
+	if err != nil {
+		appLoop.mu.Unlock()
+		return err
+	}
+	appLoop.window = mw
+	appLoop.running = true
+	pending := len(appLoop.queue) > 0
+	appLoop.mu.Unlock()
+
+	if pending {
+		_ = PostMessage(mw.Handle(), wmDispatch, 0, 0)
+	}
+
+	var msg MSG
+	for {
+		ret := GetMessage(&msg, 0, 0, 0)
+		if ret == 0 || ret == -1 {
+			break // WM_QUIT veya hata
+		}
+		TranslateMessage(&msg)
+		DispatchMessage(&msg)
+	}
+
+	// Kuyrukta kalanlar burada çalışır; bundan sonra gelenler bir sonraki
+	// RunApp'ı bekler
+	appLoop.mu.Lock()
+	appLoop.window = nil
+	appLoop.running = false
+	appLoop.mu.Unlock()
+	drainDispatch()
+	mw.Destroy()
+	return nil
+}
+
+// Dispatch queues fn to run on the UI thread.
+// -----------------------------------------------------------------------------
+// Herhangi bir goroutine'den çağrılabilir ve beklemez. Fonksiyonlar
+// kuyruğa alındıkları sırayla çalışır.
+func Dispatch(fn func()) {
+	if fn == nil {
+		return
+	}
+	appLoop.mu.Lock()
+	appLoop.queue = append(appLoop.queue, fn)
+	var hwnd syscall.Handle
+	if appLoop.window != nil {
+		hwnd = appLoop.window.Handle()
+	}
+	appLoop.mu.Unlock()
+
+	if hwnd != 0 {
+		_ = PostMessage(hwnd, wmDispatch, 0, 0)
+	}
+}
+
+// Quit stops RunApp. Any goroutine may call it.
+func Quit() {
+	Dispatch(func() { PostQuitMessage(0) })
+}
+
+// drainDispatch, kuyruktaki fonksiyonları çalıştırır. Çalışırken eklenenler
+// de aynı turda işlenir.
+func drainDispatch() {
+	for {
+		appLoop.mu.Lock()
+		queue := appLoop.queue
+		appLoop.queue = nil
+		appLoop.mu.Unlock()
+		if len(queue) == 0 {
+			return
+		}
+		for _, fn := range queue {
+			fn()
+		}
+	}
+}
+
+// ownedWindowCount, kayıtlı ve bize ait (Attach ile sahiplenilmemiş)
+// pencerelerin sayısını döner.
+func ownedWindowCount() int {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	n := 0
+	for _, w := range windowRegistry {
+		w.mu.RLock()
+		if w.prevProc == 0 {
+			n++
+		}
+		w.mu.RUnlock()
+	}
+	return n
+}
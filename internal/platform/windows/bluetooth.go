@@ -0,0 +1,585 @@
+//go:build windows
+
+package windows
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// ============================================================================
+// BLUETOOTH
+// Klasik aygıtlar ve eşleşme durumları BluetoothFindFirstDevice ile okunur;
+// inquiry (tarama) isteğe bağlıdır ve süresince çağrı bloklar. LE aygıtlar
+// yalnızca eşleştirildikten sonra GUID_BLUETOOTHLE_DEVICE_INTERFACE
+// sınıfında görünür; GATT işlemleri bu arayüzün yolu açılarak yapılır.
+// Değer değişikliği olayları ise servisin kendi arayüzü (servis UUID'si
+// arayüz sınıfı olarak) açılarak kaydedilir.
+// ============================================================================
+
+var (
+	bthprops                         = syscall.NewLazyDLL("bthprops.cpl")
+	procBluetoothFindFirstRadio      = bthprops.NewProc("BluetoothFindFirstRadio")
+	procBluetoothFindRadioClose      = bthprops.NewProc("BluetoothFindRadioClose")
+	procBluetoothFindFirstDevice     = bthprops.NewProc("BluetoothFindFirstDevice")
+	procBluetoothFindNextDevice      = bthprops.NewProc("BluetoothFindNextDevice")
+	procBluetoothFindDeviceClose     = bthprops.NewProc("BluetoothFindDeviceClose")
+	procSetupDiGetDeviceRegistryProp = setupapi.NewProc("SetupDiGetDeviceRegistryPropertyW")
+
+	bluetoothAPIs                                 = syscall.NewLazyDLL("BluetoothApis.dll")
+	procBluetoothGATTGetServices                  = bluetoothAPIs.NewProc("BluetoothGATTGetServices")
+	procBluetoothGATTGetCharacteristics           = bluetoothAPIs.NewProc("BluetoothGATTGetCharacteristics")
+	procBluetoothGATTGetDescriptors               = bluetoothAPIs.NewProc("BluetoothGATTGetDescriptors")
+	procBluetoothGATTGetCharacteristicVal         = bluetoothAPIs.NewProc("BluetoothGATTGetCharacteristicValue")
+	procBluetoothGATTSetCharacteristicVal         = bluetoothAPIs.NewProc("BluetoothGATTSetCharacteristicValue")
+	procBluetoothGATTSetDescriptorValue           = bluetoothAPIs.NewProc("BluetoothGATTSetDescriptorValue")
+	procBluetoothGATTRegisterEvent                = bluetoothAPIs.NewProc("BluetoothGATTRegisterEvent")
+	procBluetoothGATTUnregisterEvent              = bluetoothAPIs.NewProc("BluetoothGATTUnregisterEvent")
+	bluetoothGATTCallback                         = syscall.NewCallback(gattEvent)
+	bluetoothGATTHandlers                         = map[uintptr]func([]byte){}
+	bluetoothGATTNextHandler              uintptr = 1
+	bluetoothGATTMu                       sync.Mutex
+)
+
+const (
+	// BLUETOOTH_MAX_NAME_SIZE, aygıt adının karakter sayısıdır.
+	BLUETOOTH_MAX_NAME_SIZE = 248
+
+	SPDRP_FRIENDLYNAME = 0x0000000C
+
+	BLUETOOTH_GATT_FLAG_NONE                   = 0x00000000
+	BLUETOOTH_GATT_FLAG_FORCE_READ_FROM_DEVICE = 0x00000004
+	BLUETOOTH_GATT_FLAG_WRITE_WITHOUT_RESPONSE = 0x00000020
+
+	// BTH_LE_GATT_DESCRIPTOR_TYPE
+	ClientCharacteristicConfiguration = 2
+
+	// BTH_LE_GATT_EVENT_TYPE
+	CharacteristicValueChangedEvent = 0
+
+	// HRESULT_FROM_WIN32 değerleri
+	HRESULT_MORE_DATA = 0x800700EA
+	HRESULT_NOT_FOUND = 0x80070490
+)
+
+// GUID_BLUETOOTHLE_DEVICE_INTERFACE: eşleştirilmiş LE aygıtların arayüz sınıfı
+var GUID_BLUETOOTHLE_DEVICE_INTERFACE = GUID{0x781aee18, 0x7733, 0x4ce4, [8]byte{0xad, 0xd0, 0x91, 0xf4, 0x1c, 0x67, 0xb5, 0x92}}
+
+// bluetoothBaseUUID, 16 bit UUID'lerin yerleştirildiği Bluetooth taban
+// UUID'sidir (0000xxxx-0000-1000-8000-00805f9b34fb).
+var bluetoothBaseUUID = GUID{0, 0, 0x1000, [8]byte{0x80, 0x00, 0x00, 0x80, 0x5f, 0x9b, 0x34, 0xfb}}
+
+// ErrBluetoothNotFound, GATT çağrısı istenen öğeyi bulamadığında döner.
+var ErrBluetoothNotFound = errors.New("bluetooth attribute not found")
+
+// SP_DEVINFO_DATA: SetupAPI aygıt bilgisi
+type SP_DEVINFO_DATA struct {
+	CbSize    uint32
+	ClassGuid GUID
+	DevInst   uint32
+	Reserved  uintptr
+}
+
+// BLUETOOTH_FIND_RADIO_PARAMS: BluetoothFindFirstRadio girdisi
+type BLUETOOTH_FIND_RADIO_PARAMS struct {
+	DwSize uint32
+}
+
+// BLUETOOTH_DEVICE_SEARCH_PARAMS: BluetoothFindFirstDevice girdisi
+type BLUETOOTH_DEVICE_SEARCH_PARAMS struct {
+	DwSize               uint32
+	FReturnAuthenticated int32
+	FReturnRemembered    int32
+	FReturnUnknown       int32
+	FReturnConnected     int32
+	FIssueInquiry        int32
+	CTimeoutMultiplier   uint8
+	HRadio               syscall.Handle
+}
+
+// BLUETOOTH_DEVICE_INFO: BluetoothFindFirstDevice çıktısı. Address, C'de 8
+// bayta hizalı bir union'dır; 32-bit'te de aynı düzen için dolgu açıktır.
+type BLUETOOTH_DEVICE_INFO struct {
+	DwSize          uint32
+	_               uint32
+	Address         uint64
+	UlClassofDevice uint32
+	FConnected      int32
+	FRemembered     int32
+	FAuthenticated  int32
+	StLastSeen      [8]uint16
+	StLastUsed      [8]uint16
+	SzName          [BLUETOOTH_MAX_NAME_SIZE]uint16
+}
+
+// BTH_LE_UUID: kısa (16 bit) ya da uzun UUID. Kısa UUID, union'ın ilk iki
+// baytıdır (Value.Data1'in alt yarısı).
+type BTH_LE_UUID struct {
+	IsShortUuid uint8
+	Value       GUID
+}
+
+// BTH_LE_GATT_SERVICE: GATT servisi
+type BTH_LE_GATT_SERVICE struct {
+	ServiceUuid     BTH_LE_UUID
+	AttributeHandle uint16
+}
+
+// BTH_LE_GATT_CHARACTERISTIC: GATT karakteristiği
+type BTH_LE_GATT_CHARACTERISTIC struct {
+	ServiceHandle             uint16
+	CharacteristicUuid        BTH_LE_UUID
+	AttributeHandle           uint16
+	CharacteristicValueHandle uint16
+	IsBroadcastable           uint8
+	IsReadable                uint8
+	IsWritable                uint8
+	IsWritableWithoutResponse uint8
+	IsSignedWritable          uint8
+	IsNotifiable              uint8
+	IsIndicatable             uint8
+	HasExtendedProperties     uint8
+}
+
+// BTH_LE_GATT_DESCRIPTOR: GATT tanımlayıcısı
+type BTH_LE_GATT_DESCRIPTOR struct {
+	ServiceHandle        uint16
+	CharacteristicHandle uint16
+	DescriptorType       uint32
+	DescriptorUuid       BTH_LE_UUID
+	AttributeHandle      uint16
+}
+
+// BTH_LE_GATT_DESCRIPTOR_VALUE: tanımlayıcı değeri. Union'ın en büyük
+// üyesi CharacteristicFormat'tır (48 bayt); CCCD için ilk iki bayt
+// IsSubscribeToNotification ve IsSubscribeToIndication'dır.
+type BTH_LE_GATT_DESCRIPTOR_VALUE struct {
+	DescriptorType uint32
+	DescriptorUuid BTH_LE_UUID
+	Union          [48]byte
+	DataSize       uint32
+	Data           [4]byte
+}
+
+// BLUETOOTH_GATT_VALUE_CHANGED_EVENT_REGISTRATION: BluetoothGATTRegisterEvent girdisi
+type BLUETOOTH_GATT_VALUE_CHANGED_EVENT_REGISTRATION struct {
+	NumCharacteristics uint16
+	Characteristics    [1]BTH_LE_GATT_CHARACTERISTIC
+}
+
+// BLUETOOTH_GATT_VALUE_CHANGED_EVENT: değer değişikliği olayının çıktısı
+type BLUETOOTH_GATT_VALUE_CHANGED_EVENT struct {
+	ChangedAttributeHandle      uint16
+	CharacteristicValueDataSize uintptr
+	CharacteristicValue         *BTH_LE_GATT_CHARACTERISTIC_VALUE
+}
+
+// BTH_LE_GATT_CHARACTERISTIC_VALUE: karakteristik değeri (Data, DataSize
+// uzunluğundadır)
+type BTH_LE_GATT_CHARACTERISTIC_VALUE struct {
+	DataSize uint32
+	Data     [1]byte
+}
+
+// GUID, UUID'nin 128 bit karşılığıdır.
+func (u BTH_LE_UUID) GUID() GUID {
+	if u.IsShortUuid == 0 {
+		return u.Value
+	}
+	g := bluetoothBaseUUID
+	g.Data1 = u.Value.Data1 & 0xffff
+	return g
+}
+
+// String, UUID'yi küçük harfli kanonik biçimde döner.
+func (u BTH_LE_UUID) String() string {
+	g := u.GUID()
+	return fmt.Sprintf("%08x-%04x-%04x-%02x%02x-%x", g.Data1, g.Data2, g.Data3, g.Data4[0], g.Data4[1], g.Data4[2:])
+}
+
+// BluetoothDevice, sistemin bildiği bir Bluetooth aygıtıdır.
+type BluetoothDevice struct {
+	Address       string // "AA:BB:CC:DD:EE:FF"
+	Name          string
+	Connected     bool
+	Remembered    bool
+	Authenticated bool
+}
+
+// BLEDevice, eşleştirilmiş bir LE aygıtın arayüzüdür.
+type BLEDevice struct {
+	Path    string
+	Address string
+	Name    string
+}
+
+/*
+BluetoothRadioAvailable → Sistemde en az bir Bluetooth adaptörü varsa true döner.
+*/
+func BluetoothRadioAvailable() bool {
+	if procBluetoothFindFirstRadio.Find() != nil {
+		return false
+	}
+	params := BLUETOOTH_FIND_RADIO_PARAMS{DwSize: uint32(unsafe.Sizeof(BLUETOOTH_FIND_RADIO_PARAMS{}))}
+	var radio syscall.Handle
+	find, _, _ := procBluetoothFindFirstRadio.Call(uintptr(unsafe.Pointer(&params)), uintptr(unsafe.Pointer(&radio)))
+	if find == 0 {
+		return false
+	}
+	syscall.CloseHandle(radio)
+	procBluetoothFindRadioClose.Call(find)
+	return true
+}
+
+/*
+BluetoothDevices → Bilinen klasik aygıtları döner. inquiry > 0 ise önce
+inquiry×1.28 saniyelik bir tarama yapılır ve çağrı bu süre boyunca bloklar
+(en fazla 48).
+*/
+func BluetoothDevices(inquiry uint8) ([]BluetoothDevice, error) {
+	if err := procBluetoothFindFirstDevice.Find(); err != nil {
+		return nil, err
+	}
+	params := BLUETOOTH_DEVICE_SEARCH_PARAMS{
+		FReturnAuthenticated: 1,
+		FReturnRemembered:    1,
+		FReturnUnknown:       1,
+		FReturnConnected:     1,
+		CTimeoutMultiplier:   min(inquiry, 48),
+	}
+	params.DwSize = uint32(unsafe.Sizeof(params))
+	if inquiry > 0 {
+		params.FIssueInquiry = 1
+	}
+	info := new(BLUETOOTH_DEVICE_INFO)
+	info.DwSize = uint32(unsafe.Sizeof(*info))
+
+	find, _, err := procBluetoothFindFirstDevice.Call(uintptr(unsafe.Pointer(&params)), uintptr(unsafe.Pointer(info)))
+	if find == 0 {
+		if err == ERROR_NO_MORE_ITEMS {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer procBluetoothFindDeviceClose.Call(find)
+
+	var devices []BluetoothDevice
+	for {
+		devices = append(devices, BluetoothDevice{
+			Address:       bluetoothAddress(info.Address),
+			Name:          syscall.UTF16ToString(info.SzName[:]),
+			Connected:     info.FConnected != 0,
+			Remembered:    info.FRemembered != 0,
+			Authenticated: info.FAuthenticated != 0,
+		})
+		if ret, _, _ := procBluetoothFindNextDevice.Call(find, uintptr(unsafe.Pointer(info))); ret == 0 {
+			break
+		}
+	}
+	return devices, nil
+}
+
+// bluetoothAddress, BLUETOOTH_ADDRESS'i "AA:BB:CC:DD:EE:FF" biçimine
+// çevirir (en anlamlı bayt önce).
+func bluetoothAddress(addr uint64) string {
+	return fmt.Sprintf("%02X:%02X:%02X:%02X:%02X:%02X",
+		byte(addr>>40), byte(addr>>32), byte(addr>>24), byte(addr>>16), byte(addr>>8), byte(addr))
+}
+
+/*
+BLEDevices → Eşleştirilmiş LE aygıtları döner.
+*/
+func BLEDevices() []BLEDevice {
+	var devices []BLEDevice
+	enumInterfaces(&GUID_BLUETOOTHLE_DEVICE_INTERFACE, func(set uintptr, path string, devinfo *SP_DEVINFO_DATA) {
+		addr := bleAddress(path)
+		if addr == "" {
+			return
+		}
+		devices = append(devices, BLEDevice{Path: path, Address: addr, Name: registryString(set, devinfo, SPDRP_FRIENDLYNAME)})
+	})
+	return devices
+}
+
+/*
+BLEServicePath → LE aygıtın verilen servisinin arayüz yolunu döner; servis
+yoksa boş döner. BluetoothGATTRegisterEvent bu yolla açılan tanıtıcıyı ister.
+*/
+func BLEServicePath(address string, service BTH_LE_UUID) string {
+	want := strings.ToLower(strings.ReplaceAll(address, ":", ""))
+	guid := service.GUID()
+	found := ""
+	enumInterfaces(&guid, func(set uintptr, path string, devinfo *SP_DEVINFO_DATA) {
+		if found == "" && strings.Contains(strings.ToLower(path), want) {
+			found = path
+		}
+	})
+	return found
+}
+
+// enumInterfaces, sınıftaki mevcut aygıt arayüzleri için fn'i çağırır.
+func enumInterfaces(class *GUID, fn func(set uintptr, path string, devinfo *SP_DEVINFO_DATA)) {
+	set, _, _ := procSetupDiGetClassDevsW.Call(uintptr(unsafe.Pointer(class)), 0, 0, DIGCF_PRESENT|DIGCF_DEVICEINTERFACE)
+	if set == uintptr(syscall.InvalidHandle) {
+		return
+	}
+	defer procSetupDiDestroyDeviceInfoList.Call(set)
+
+	for i := 0; ; i++ {
+		iface := new(SP_DEVICE_INTERFACE_DATA)
+		iface.CbSize = uint32(unsafe.Sizeof(*iface))
+		ret, _, _ := procSetupDiEnumDeviceInterfaces.Call(set, 0, uintptr(unsafe.Pointer(class)), uintptr(i), uintptr(unsafe.Pointer(iface)))
+		if ret == 0 {
+			return
+		}
+		devinfo := new(SP_DEVINFO_DATA)
+		devinfo.CbSize = uint32(unsafe.Sizeof(*devinfo))
+		if path := interfacePath(set, iface, devinfo); path != "" {
+			fn(set, path, devinfo)
+		}
+	}
+}
+
+// registryString, aygıtın metin türündeki bir registry özelliğini okur.
+func registryString(set uintptr, devinfo *SP_DEVINFO_DATA, prop uint32) string {
+	buf := make([]uint16, 256)
+	ret, _, _ := procSetupDiGetDeviceRegistryProp.Call(set, uintptr(unsafe.Pointer(devinfo)), uintptr(prop), 0,
+		uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)*2), 0)
+	if ret == 0 {
+		return ""
+	}
+	return syscall.UTF16ToString(buf)
+}
+
+// bleAddress, LE aygıt yolundan adresi çıkarır
+// (\\?\BTHLE#Dev_aabbccddeeff#... → "AA:BB:CC:DD:EE:FF").
+func bleAddress(path string) string {
+	lower := strings.ToLower(path)
+	i := strings.Index(lower, "dev_")
+	if i < 0 || len(lower) < i+16 {
+		return ""
+	}
+	hex := lower[i+4 : i+16]
+	var b strings.Builder
+	for j := 0; j < 12; j += 2 {
+		if !strings.ContainsRune("0123456789abcdef", rune(hex[j])) || !strings.ContainsRune("0123456789abcdef", rune(hex[j+1])) {
+			return ""
+		}
+		if j > 0 {
+			b.WriteByte(':')
+		}
+		b.WriteString(strings.ToUpper(hex[j : j+2]))
+	}
+	return b.String()
+}
+
+/*
+OpenBLE → LE aygıt ya da servis arayüzünü GATT işlemleri için açar.
+Tanıtıcı syscall.CloseHandle ile kapatılmalıdır.
+*/
+func OpenBLE(path string) (syscall.Handle, error) {
+	p, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return syscall.InvalidHandle, err
+	}
+	return syscall.CreateFile(p, syscall.GENERIC_READ|syscall.GENERIC_WRITE, syscall.FILE_SHARE_READ|syscall.FILE_SHARE_WRITE, nil, syscall.OPEN_EXISTING, 0, 0)
+}
+
+// gattError, GATT çağrısının HRESULT'unu hataya çevirir.
+func gattError(op string, hr uintptr) error {
+	if uint32(hr) == HRESULT_NOT_FOUND {
+		return ErrBluetoothNotFound
+	}
+	return hresult(op, hr)
+}
+
+/*
+GATTServices → Aygıtın birincil servislerini döner.
+*/
+func GATTServices(h syscall.Handle) ([]BTH_LE_GATT_SERVICE, error) {
+	if err := procBluetoothGATTGetServices.Find(); err != nil {
+		return nil, err
+	}
+	var count uint16
+	hr, _, _ := procBluetoothGATTGetServices.Call(uintptr(h), 0, 0, uintptr(unsafe.Pointer(&count)), BLUETOOTH_GATT_FLAG_NONE)
+	if uint32(hr) != HRESULT_MORE_DATA {
+		if err := gattError("BluetoothGATTGetServices", hr); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+	services := make([]BTH_LE_GATT_SERVICE, count)
+	hr, _, _ = procBluetoothGATTGetServices.Call(uintptr(h), uintptr(count), uintptr(unsafe.Pointer(&services[0])), uintptr(unsafe.Pointer(&count)), BLUETOOTH_GATT_FLAG_NONE)
+	if err := gattError("BluetoothGATTGetServices", hr); err != nil {
+		return nil, err
+	}
+	return services[:count], nil
+}
+
+/*
+GATTCharacteristics → Servisin karakteristiklerini döner.
+*/
+func GATTCharacteristics(h syscall.Handle, service *BTH_LE_GATT_SERVICE) ([]BTH_LE_GATT_CHARACTERISTIC, error) {
+	var count uint16
+	hr, _, _ := procBluetoothGATTGetCharacteristics.Call(uintptr(h), uintptr(unsafe.Pointer(service)), 0, 0, uintptr(unsafe.Pointer(&count)), BLUETOOTH_GATT_FLAG_NONE)
+	if uint32(hr) != HRESULT_MORE_DATA {
+		if err := gattError("BluetoothGATTGetCharacteristics", hr); err != nil && err != ErrBluetoothNotFound {
+			return nil, err
+		}
+		return nil, nil
+	}
+	chars := make([]BTH_LE_GATT_CHARACTERISTIC, count)
+	hr, _, _ = procBluetoothGATTGetCharacteristics.Call(uintptr(h), uintptr(unsafe.Pointer(service)), uintptr(count),
+		uintptr(unsafe.Pointer(&chars[0])), uintptr(unsafe.Pointer(&count)), BLUETOOTH_GATT_FLAG_NONE)
+	if err := gattError("BluetoothGATTGetCharacteristics", hr); err != nil {
+		return nil, err
+	}
+	return chars[:count], nil
+}
+
+/*
+GATTRead → Karakteristiğin değerini önbellek yerine aygıttan okur.
+*/
+func GATTRead(h syscall.Handle, char *BTH_LE_GATT_CHARACTERISTIC) ([]byte, error) {
+	var size uint16
+	hr, _, _ := procBluetoothGATTGetCharacteristicVal.Call(uintptr(h), uintptr(unsafe.Pointer(char)), 0, 0,
+		uintptr(unsafe.Pointer(&size)), BLUETOOTH_GATT_FLAG_FORCE_READ_FROM_DEVICE)
+	if uint32(hr) != HRESULT_MORE_DATA {
+		if err := gattError("BluetoothGATTGetCharacteristicValue", hr); err != nil {
+			return nil, err
+		}
+		return []byte{}, nil
+	}
+	// BTH_LE_GATT_CHARACTERISTIC_VALUE: ULONG DataSize + UCHAR Data[]
+	buf := make([]byte, max(int(size), 4))
+	hr, _, _ = procBluetoothGATTGetCharacteristicVal.Call(uintptr(h), uintptr(unsafe.Pointer(char)), uintptr(len(buf)),
+		uintptr(unsafe.Pointer(&buf[0])), 0, BLUETOOTH_GATT_FLAG_FORCE_READ_FROM_DEVICE)
+	if err := gattError("BluetoothGATTGetCharacteristicValue", hr); err != nil {
+		return nil, err
+	}
+	n := min(int(*(*uint32)(unsafe.Pointer(&buf[0]))), len(buf)-4)
+	return append([]byte(nil), buf[4:4+n]...), nil
+}
+
+/*
+GATTWrite → Karakteristiğe değer yazar; withoutResponse true ise aygıtın
+onayı beklenmez.
+*/
+func GATTWrite(h syscall.Handle, char *BTH_LE_GATT_CHARACTERISTIC, value []byte, withoutResponse bool) error {
+	buf := make([]byte, 4+len(value))
+	*(*uint32)(unsafe.Pointer(&buf[0])) = uint32(len(value))
+	copy(buf[4:], value)
+	flags := uintptr(BLUETOOTH_GATT_FLAG_NONE)
+	if withoutResponse {
+		flags = BLUETOOTH_GATT_FLAG_WRITE_WITHOUT_RESPONSE
+	}
+	// ReliableWriteContext ULONG64'tür; 32-bit'te iki yığın slotu kaplar
+	args := []uintptr{uintptr(h), uintptr(unsafe.Pointer(char)), uintptr(unsafe.Pointer(&buf[0])), 0}
+	if unsafe.Sizeof(uintptr(0)) == 4 {
+		args = append(args, 0)
+	}
+	hr, _, _ := procBluetoothGATTSetCharacteristicVal.Call(append(args, flags)...)
+	return gattError("BluetoothGATTSetCharacteristicValue", hr)
+}
+
+/*
+GATTSubscribe → Karakteristiğin CCCD tanımlayıcısına bildirim (yoksa
+indication) isteği yazar ve değer değişikliği olayını kaydeder. device aygıt
+arayüzünün, service servis arayüzünün tanıtıcısıdır. fn, sistemin olay
+thread'inde çağrılır. Dönen fonksiyon kaydı siler ve CCCD'yi sıfırlar.
+*/
+func GATTSubscribe(device, service syscall.Handle, char *BTH_LE_GATT_CHARACTERISTIC, fn func([]byte)) (func(), error) {
+	desc, err := cccd(device, char)
+	if err != nil {
+		return nil, err
+	}
+	if err := setCCCD(device, desc, char.IsNotifiable != 0, char.IsNotifiable == 0); err != nil {
+		return nil, err
+	}
+
+	bluetoothGATTMu.Lock()
+	id := bluetoothGATTNextHandler
+	bluetoothGATTNextHandler++
+	bluetoothGATTHandlers[id] = fn
+	bluetoothGATTMu.Unlock()
+	forget := func() {
+		bluetoothGATTMu.Lock()
+		delete(bluetoothGATTHandlers, id)
+		bluetoothGATTMu.Unlock()
+	}
+
+	reg := BLUETOOTH_GATT_VALUE_CHANGED_EVENT_REGISTRATION{NumCharacteristics: 1}
+	reg.Characteristics[0] = *char
+	var event uintptr
+	hr, _, _ := procBluetoothGATTRegisterEvent.Call(uintptr(service), CharacteristicValueChangedEvent, uintptr(unsafe.Pointer(&reg)),
+		bluetoothGATTCallback, id, uintptr(unsafe.Pointer(&event)), BLUETOOTH_GATT_FLAG_NONE)
+	if err := gattError("BluetoothGATTRegisterEvent", hr); err != nil {
+		forget()
+		setCCCD(device, desc, false, false)
+		return nil, err
+	}
+	return func() {
+		procBluetoothGATTUnregisterEvent.Call(event, BLUETOOTH_GATT_FLAG_NONE)
+		forget()
+		setCCCD(device, desc, false, false)
+	}, nil
+}
+
+// cccd, karakteristiğin Client Characteristic Configuration tanımlayıcısını bulur.
+func cccd(h syscall.Handle, char *BTH_LE_GATT_CHARACTERISTIC) (*BTH_LE_GATT_DESCRIPTOR, error) {
+	var count uint16
+	hr, _, _ := procBluetoothGATTGetDescriptors.Call(uintptr(h), uintptr(unsafe.Pointer(char)), 0, 0, uintptr(unsafe.Pointer(&count)), BLUETOOTH_GATT_FLAG_NONE)
+	if uint32(hr) != HRESULT_MORE_DATA {
+		if err := gattError("BluetoothGATTGetDescriptors", hr); err != nil {
+			return nil, err
+		}
+		return nil, ErrBluetoothNotFound
+	}
+	descs := make([]BTH_LE_GATT_DESCRIPTOR, count)
+	hr, _, _ = procBluetoothGATTGetDescriptors.Call(uintptr(h), uintptr(unsafe.Pointer(char)), uintptr(count),
+		uintptr(unsafe.Pointer(&descs[0])), uintptr(unsafe.Pointer(&count)), BLUETOOTH_GATT_FLAG_NONE)
+	if err := gattError("BluetoothGATTGetDescriptors", hr); err != nil {
+		return nil, err
+	}
+	for i := range descs[:count] {
+		if descs[i].DescriptorType == ClientCharacteristicConfiguration {
+			return &descs[i], nil
+		}
+	}
+	return nil, ErrBluetoothNotFound
+}
+
+// setCCCD, bildirim ve indication isteklerini tanımlayıcıya yazar.
+func setCCCD(h syscall.Handle, desc *BTH_LE_GATT_DESCRIPTOR, notify, indicate bool) error {
+	value := BTH_LE_GATT_DESCRIPTOR_VALUE{DescriptorType: ClientCharacteristicConfiguration, DescriptorUuid: desc.DescriptorUuid}
+	if notify {
+		value.Union[0] = 1
+	}
+	if indicate {
+		value.Union[1] = 1
+	}
+	hr, _, _ := procBluetoothGATTSetDescriptorValue.Call(uintptr(h), uintptr(unsafe.Pointer(desc)), uintptr(unsafe.Pointer(&value)), BLUETOOTH_GATT_FLAG_NONE)
+	return gattError("BluetoothGATTSetDescriptorValue", hr)
+}
+
+// gattEvent, tüm GATT olay kayıtlarının ortak callback'idir; context
+// GATTSubscribe'ın verdiği kimliktir.
+func gattEvent(eventType uintptr, event *BLUETOOTH_GATT_VALUE_CHANGED_EVENT, context uintptr) uintptr {
+	if eventType != CharacteristicValueChangedEvent || event == nil || event.CharacteristicValue == nil {
+		return 0
+	}
+	bluetoothGATTMu.Lock()
+	fn := bluetoothGATTHandlers[context]
+	bluetoothGATTMu.Unlock()
+	if fn == nil {
+		return 0
+	}
+	value := event.CharacteristicValue
+	data := unsafe.Slice(&value.Data[0], value.DataSize)
+	fn(append([]byte(nil), data...))
+	return 0
+}
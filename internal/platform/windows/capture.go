@@ -0,0 +1,237 @@
+//go:build windows
+
+package windows
+
+import (
+	"errors"
+	"image"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// ============================================================================
+// EKRAN YAKALAMA (GDI)
+// Monitörler EnumDisplayMonitors ile, üst seviye pencereler EnumWindows ile
+// listelenir. Ekran görüntüsü masaüstü DC'sinden BitBlt, pencere görüntüsü
+// PrintWindow (PW_RENDERFULLCONTENT; arkada kalan/GPU ile çizilen pencereler
+// dahil) ile alınır. Windows'ta bu işlemler için izin gerekmez.
+// ============================================================================
+
+var (
+	gdi32                      = syscall.NewLazyDLL("gdi32.dll")
+	procGetDC                  = user32.NewProc("GetDC")
+	procReleaseDC              = user32.NewProc("ReleaseDC")
+	procEnumDisplayMonitors    = user32.NewProc("EnumDisplayMonitors")
+	procGetMonitorInfoW        = user32.NewProc("GetMonitorInfoW")
+	procEnumWindows            = user32.NewProc("EnumWindows")
+	procPrintWindow            = user32.NewProc("PrintWindow")
+	procCreateCompatibleDC     = gdi32.NewProc("CreateCompatibleDC")
+	procCreateCompatibleBitmap = gdi32.NewProc("CreateCompatibleBitmap")
+	procSelectObject           = gdi32.NewProc("SelectObject")
+	procBitBlt                 = gdi32.NewProc("BitBlt")
+	procGetDIBits              = gdi32.NewProc("GetDIBits")
+	procDeleteObject           = gdi32.NewProc("DeleteObject")
+	procDeleteDC               = gdi32.NewProc("DeleteDC")
+)
+
+const (
+	SRCCOPY    = 0x00CC0020
+	CAPTUREBLT = 0x40000000
+
+	PW_RENDERFULLCONTENT = 0x00000002
+
+	MONITORINFOF_PRIMARY = 0x00000001
+
+	BI_RGB         = 0
+	DIB_RGB_COLORS = 0
+)
+
+// MONITORINFOEX: GetMonitorInfoW yapısı
+type MONITORINFOEX struct {
+	CbSize    uint32
+	RcMonitor RECT
+	RcWork    RECT
+	DwFlags   uint32
+	SzDevice  [32]uint16
+}
+
+// BITMAPINFOHEADER: DIB başlığı
+type BITMAPINFOHEADER struct {
+	BiSize          uint32
+	BiWidth         int32
+	BiHeight        int32
+	BiPlanes        uint16
+	BiBitCount      uint16
+	BiCompression   uint32
+	BiSizeImage     uint32
+	BiXPelsPerMeter int32
+	BiYPelsPerMeter int32
+	BiClrUsed       uint32
+	BiClrImportant  uint32
+}
+
+// Monitor, bir ekranın bilgisidir.
+type Monitor struct {
+	Device  string
+	Bounds  RECT
+	Work    RECT // Görev çubuğu hariç çalışma alanı
+	Primary bool
+}
+
+// TopWindow, görünür bir üst seviye pencerenin bilgisidir.
+type TopWindow struct {
+	Handle syscall.Handle
+	Title  string
+	Bounds RECT
+}
+
+// Enum callback'leri bir kez oluşturulur (syscall.NewCallback sınırlıdır);
+// sonuçlar enumMu altında paylaşılan dilimlere toplanır.
+var (
+	enumMu       sync.Mutex
+	enumMonitors []Monitor
+	enumWindows  []TopWindow
+
+	monitorEnumProc = syscall.NewCallback(func(hmon, hdc, rect, lparam uintptr) uintptr {
+		info := MONITORINFOEX{}
+		info.CbSize = uint32(unsafe.Sizeof(info))
+		if ret, _, _ := procGetMonitorInfoW.Call(hmon, uintptr(unsafe.Pointer(&info))); ret != 0 {
+			enumMonitors = append(enumMonitors, Monitor{
+				Device:  syscall.UTF16ToString(info.SzDevice[:]),
+				Bounds:  info.RcMonitor,
+				Work:    info.RcWork,
+				Primary: info.DwFlags&MONITORINFOF_PRIMARY != 0,
+			})
+		}
+		return 1
+	})
+
+	windowEnumProc = syscall.NewCallback(func(hwnd, lparam uintptr) uintptr {
+		h := syscall.Handle(hwnd)
+		if !IsWindowVisible(h) || IsIconic(h) {
+			return 1
+		}
+		title := GetWindowText(h)
+		if title == "" {
+			return 1
+		}
+		var rect RECT
+		if GetWindowRect(h, &rect) != nil || rect.Width() <= 0 || rect.Height() <= 0 {
+			return 1
+		}
+		enumWindows = append(enumWindows, TopWindow{Handle: h, Title: title, Bounds: rect})
+		return 1
+	})
+)
+
+/*
+EnumMonitors → Bağlı ekranları döner.
+*/
+func EnumMonitors() []Monitor {
+	enumMu.Lock()
+	defer enumMu.Unlock()
+
+	enumMonitors = nil
+	procEnumDisplayMonitors.Call(0, 0, monitorEnumProc, 0)
+	return enumMonitors
+}
+
+/*
+EnumTopWindows → Başlığı olan, görünür ve simge durumunda olmayan üst seviye pencereleri döner.
+*/
+func EnumTopWindows() []TopWindow {
+	enumMu.Lock()
+	defer enumMu.Unlock()
+
+	enumWindows = nil
+	procEnumWindows.Call(windowEnumProc, 0)
+	return enumWindows
+}
+
+/*
+CaptureScreen → Sanal masaüstünün verilen bölgesini yakalar.
+*/
+func CaptureScreen(r RECT) (*image.RGBA, error) {
+	screen, _, _ := procGetDC.Call(0)
+	if screen == 0 {
+		return nil, errors.New("GetDC failed")
+	}
+	defer procReleaseDC.Call(0, screen)
+
+	return captureDC(screen, r.Width(), r.Height(), func(mem uintptr) bool {
+		ret, _, _ := procBitBlt.Call(mem, 0, 0, uintptr(r.Width()), uintptr(r.Height()),
+			screen, uintptr(r.Left), uintptr(r.Top), SRCCOPY|CAPTUREBLT)
+		return ret != 0
+	})
+}
+
+/*
+CaptureWindow → Pencerenin görüntüsünü (üstü kapalı olsa bile) yakalar.
+*/
+func CaptureWindow(hwnd syscall.Handle) (*image.RGBA, error) {
+	var r RECT
+	if err := GetWindowRect(hwnd, &r); err != nil {
+		return nil, err
+	}
+
+	screen, _, _ := procGetDC.Call(0)
+	if screen == 0 {
+		return nil, errors.New("GetDC failed")
+	}
+	defer procReleaseDC.Call(0, screen)
+
+	return captureDC(screen, r.Width(), r.Height(), func(mem uintptr) bool {
+		ret, _, _ := procPrintWindow.Call(uintptr(hwnd), mem, PW_RENDERFULLCONTENT)
+		return ret != 0
+	})
+}
+
+// captureDC, uyumlu bir bitmap'e draw ile çizer ve pikselleri RGBA olarak okur.
+func captureDC(ref uintptr, width, height int32, draw func(mem uintptr) bool) (*image.RGBA, error) {
+	if width <= 0 || height <= 0 {
+		return nil, errors.New("empty capture area")
+	}
+
+	mem, _, _ := procCreateCompatibleDC.Call(ref)
+	if mem == 0 {
+		return nil, errors.New("CreateCompatibleDC failed")
+	}
+	defer procDeleteDC.Call(mem)
+
+	bmp, _, _ := procCreateCompatibleBitmap.Call(ref, uintptr(width), uintptr(height))
+	if bmp == 0 {
+		return nil, errors.New("CreateCompatibleBitmap failed")
+	}
+	defer procDeleteObject.Call(bmp)
+
+	old, _, _ := procSelectObject.Call(mem, bmp)
+	ok := draw(mem)
+	procSelectObject.Call(mem, old)
+	if !ok {
+		return nil, errors.New("capture failed")
+	}
+
+	header := BITMAPINFOHEADER{
+		BiWidth:       width,
+		BiHeight:      -height, // negatif → üstten alta satır sırası
+		BiPlanes:      1,
+		BiBitCount:    32,
+		BiCompression: BI_RGB,
+	}
+	header.BiSize = uint32(unsafe.Sizeof(header))
+
+	img := image.NewRGBA(image.Rect(0, 0, int(width), int(height)))
+	ret, _, _ := procGetDIBits.Call(mem, bmp, 0, uintptr(height),
+		uintptr(unsafe.Pointer(&img.Pix[0])), uintptr(unsafe.Pointer(&header)), DIB_RGB_COLORS)
+	if ret == 0 {
+		return nil, errors.New("GetDIBits failed")
+	}
+
+	// GDI BGRA → RGBA; alfa kanalı GDI'da anlamsızdır
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+2] = img.Pix[i+2], img.Pix[i]
+		img.Pix[i+3] = 0xFF
+	}
+	return img, nil
+}
@@ -0,0 +1,128 @@
+//go:build windows
+
+package windows
+
+import (
+	"fmt"
+	"runtime"
+	"syscall"
+	"unsafe"
+)
+
+// ============================================================================
+// MINIMAL COM DESTEĞİ
+// Shell diyalogları (IFileDialog) gibi COM arayüzlerini harici bağımlılık
+// olmadan kullanabilmek için gereken en küçük parçalar: nesne oluşturma,
+// vtable üzerinden metod çağırma ve bellek serbest bırakma.
+// ============================================================================
+
+var (
+	ole32                = syscall.NewLazyDLL("ole32.dll")
+	procCoInitializeEx   = ole32.NewProc("CoInitializeEx")
+	procCoUninitialize   = ole32.NewProc("CoUninitialize")
+	procCoCreateInstance = ole32.NewProc("CoCreateInstance")
+	procCoTaskMemFree    = ole32.NewProc("CoTaskMemFree")
+)
+
+const (
+	COINIT_APARTMENTTHREADED = 0x2
+	CLSCTX_INPROC_SERVER     = 0x1
+
+	S_OK    = 0x00000000
+	S_FALSE = 0x00000001
+
+	// HRESULT_FROM_WIN32(ERROR_CANCELLED): kullanıcı diyaloğu iptal etti
+	HRESULT_CANCELLED = 0x800704C7
+)
+
+// comObject, bir COM arayüz pointer'ıdır. İlk alanı vtable adresidir.
+type comObject struct {
+	vtbl *[64]uintptr
+}
+
+// call, vtable'daki index numaralı metodu çağırır ve HRESULT döner.
+// IUnknown: 0=QueryInterface, 1=AddRef, 2=Release.
+//
+// Argümanlar çağrıdan önce uintptr'a çevrildiği için pointer argümanlar heap'te
+// olmalı (new ile ayrılmalı) ve çağrı sonrasına kadar runtime.KeepAlive ile
+// canlı tutulmalıdır; stack değişkenlerinin adresi verilmemelidir.
+func (o *comObject) call(index int, args ...uintptr) uintptr {
+	all := append([]uintptr{uintptr(unsafe.Pointer(o))}, args...)
+	ret, _, _ := syscall.SyscallN(o.vtbl[index], all...)
+	return ret
+}
+
+// callString, tek string argüman alan bir metodu çağırır.
+func (o *comObject) callString(index int, s string) uintptr {
+	p := UTF16PtrFromString(s)
+	ret := o.call(index, uintptr(unsafe.Pointer(p)))
+	runtime.KeepAlive(p)
+	return ret
+}
+
+// Release, referans sayacını azaltır.
+func (o *comObject) Release() {
+	if o != nil {
+		o.call(2)
+	}
+}
+
+// HRESULTError, başarısız bir COM çağrısını temsil eder.
+type HRESULTError struct {
+	Op     string
+	Result uint32
+}
+
+func (e *HRESULTError) Error() string {
+	return fmt.Sprintf("%s failed: HRESULT 0x%08X", e.Op, e.Result)
+}
+
+// hresult, başarısız HRESULT'u hataya çevirir (S_OK/S_FALSE → nil).
+func hresult(op string, hr uintptr) error {
+	if int32(hr) >= 0 {
+		return nil
+	}
+	return &HRESULTError{Op: op, Result: uint32(hr)}
+}
+
+// CoInitialize, çağıran thread için STA apartment'ı başlatır.
+// Thread zaten başlatılmışsa (ör. WebView2 tarafından) hata sayılmaz.
+// Dönen fonksiyon yalnızca bu çağrı başlatma yaptıysa CoUninitialize çağırır.
+func CoInitialize() func() {
+	hr, _, _ := procCoInitializeEx.Call(0, COINIT_APARTMENTTHREADED)
+	if hr == S_OK || hr == S_FALSE {
+		return func() { procCoUninitialize.Call() }
+	}
+	return func() {}
+}
+
+// coCreate, CLSID'den istenen arayüzü oluşturur.
+func coCreate(clsid, iid *GUID) (*comObject, error) {
+	var obj *comObject
+	hr, _, _ := procCoCreateInstance.Call(
+		uintptr(unsafe.Pointer(clsid)),
+		0,
+		CLSCTX_INPROC_SERVER,
+		uintptr(unsafe.Pointer(iid)),
+		uintptr(unsafe.Pointer(&obj)),
+	)
+	if err := hresult("CoCreateInstance", hr); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// outObject, bir metodun **IUnknown çıkış parametresi için heap'te yer ayırır.
+func outObject() **comObject {
+	return new(*comObject)
+}
+
+// coTaskString, CoTaskMemAlloc ile ayrılmış UTF16 string'i okur ve serbest bırakır.
+func coTaskString(p *uint16) string {
+	if p == nil {
+		return ""
+	}
+	s := UTF16ToString(p)
+	procCoTaskMemFree.Call(uintptr(unsafe.Pointer(p)))
+	return s
+}
@@ -0,0 +1,98 @@
+//go:build windows
+
+package windows
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// ============================================================================
+// CREDENTIAL MANAGER
+// Windows Kimlik Bilgisi Yöneticisi'nde genel (generic) kimlik bilgileri.
+// Sırlar kullanıcının oturum anahtarıyla şifrelenerek saklanır.
+// ============================================================================
+
+var (
+	procCredWriteW  = advapi32.NewProc("CredWriteW")
+	procCredReadW   = advapi32.NewProc("CredReadW")
+	procCredDeleteW = advapi32.NewProc("CredDeleteW")
+	procCredFree    = advapi32.NewProc("CredFree")
+)
+
+const (
+	CRED_TYPE_GENERIC          = 1
+	CRED_PERSIST_LOCAL_MACHINE = 2
+
+	ERROR_NOT_FOUND syscall.Errno = 1168
+)
+
+// CREDENTIALW: CredWriteW/CredReadW yapısı
+type CREDENTIALW struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        [2]uint32 // FILETIME
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+/*
+CredWrite → target adıyla genel bir kimlik bilgisi yazar (varsa üzerine yazar).
+*/
+func CredWrite(target, user string, secret []byte) error {
+	cred := CREDENTIALW{
+		Type:               CRED_TYPE_GENERIC,
+		TargetName:         UTF16PtrFromString(target),
+		UserName:           UTF16PtrFromString(user),
+		CredentialBlobSize: uint32(len(secret)),
+		Persist:            CRED_PERSIST_LOCAL_MACHINE,
+	}
+	if len(secret) > 0 {
+		cred.CredentialBlob = &secret[0]
+	}
+	ret, _, err := procCredWriteW.Call(uintptr(unsafe.Pointer(&cred)), 0)
+	if ret == 0 {
+		return err
+	}
+	return nil
+}
+
+/*
+CredRead → target kimlik bilgisinin sırrını döner. Yoksa ERROR_NOT_FOUND.
+*/
+func CredRead(target string) ([]byte, error) {
+	var cred *CREDENTIALW
+	ret, _, err := procCredReadW.Call(
+		uintptr(unsafe.Pointer(UTF16PtrFromString(target))),
+		CRED_TYPE_GENERIC,
+		0,
+		uintptr(unsafe.Pointer(&cred)),
+	)
+	if ret == 0 {
+		return nil, err
+	}
+	defer procCredFree.Call(uintptr(unsafe.Pointer(cred)))
+	return append([]byte(nil), unsafe.Slice(cred.CredentialBlob, cred.CredentialBlobSize)...), nil
+}
+
+/*
+CredDelete → target kimlik bilgisini siler. Yoksa ERROR_NOT_FOUND.
+*/
+func CredDelete(target string) error {
+	ret, _, err := procCredDeleteW.Call(
+		uintptr(unsafe.Pointer(UTF16PtrFromString(target))),
+		CRED_TYPE_GENERIC,
+		0,
+	)
+	if ret == 0 {
+		return err
+	}
+	return nil
+}
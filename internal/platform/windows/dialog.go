@@ -0,0 +1,62 @@
+//go:build windows
+
+package windows
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// ============================================================================
+// NATIVE MESSAGE BOX
+// Win32 MessageBoxW sarmalayıcısı. UI (WebView) henüz oluşmamışken veya
+// çökmüşken bile kullanıcıya native bir mesaj gösterebilmek için kullanılır.
+// MessageBox içeriği Ctrl+C ile panoya kopyalanabilir; çökme raporları için
+// ayrıca bir "kopyala" butonuna gerek kalmaz.
+// ============================================================================
+
+var procMessageBoxW = user32.NewProc("MessageBoxW")
+
+// MessageBox flag'leri
+const (
+	MB_OK               = 0x00000000
+	MB_OKCANCEL         = 0x00000001
+	MB_ABORTRETRYIGNORE = 0x00000002
+	MB_YESNOCANCEL      = 0x00000003
+	MB_YESNO            = 0x00000004
+	MB_RETRYCANCEL      = 0x00000005
+	MB_ICONERROR        = 0x00000010
+	MB_ICONQUESTION     = 0x00000020
+	MB_ICONWARNING      = 0x00000030
+	MB_ICONINFORMATION  = 0x00000040
+	MB_SETFOREGROUND    = 0x00010000
+	MB_TOPMOST          = 0x00040000
+	MB_TASKMODAL        = 0x00002000
+	MB_DEFBUTTON2       = 0x00000100
+	MB_SYSTEMMODAL      = 0x00001000
+)
+
+// MessageBox dönüş değerleri (basılan buton)
+const (
+	IDOK     = 1
+	IDCANCEL = 2
+	IDABORT  = 3
+	IDRETRY  = 4
+	IDIGNORE = 5
+	IDYES    = 6
+	IDNO     = 7
+)
+
+/*
+MessageBox → Modal native mesaj kutusu gösterir ve basılan butonun ID'sini döner.
+owner 0 olabilir; bu durumda kutu sahipsiz (task-modal) açılır.
+*/
+func MessageBox(owner syscall.Handle, text, caption string, flags uint32) int32 {
+	ret, _, _ := procMessageBoxW.Call(
+		uintptr(owner),
+		uintptr(unsafe.Pointer(UTF16PtrFromString(text))),
+		uintptr(unsafe.Pointer(UTF16PtrFromString(caption))),
+		uintptr(flags),
+	)
+	return int32(ret)
+}
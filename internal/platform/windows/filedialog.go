@@ -0,0 +1,240 @@
+//go:build windows
+
+package windows
+
+import (
+	"runtime"
+	"strings"
+	"unsafe"
+
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// ============================================================================
+// NATIVE FILE DIALOG
+// Vista ve sonrasının IFileOpenDialog / IFileSaveDialog COM arayüzleri ile
+// dosya/klasör seçimi. Eski GetOpenFileName'e göre uzun yolları, kütüphaneleri
+// ve modern klasör seçiciyi (FOS_PICKFOLDERS) destekler.
+// ============================================================================
+
+var procSHCreateItemFromParsingName = shell32.NewProc("SHCreateItemFromParsingName")
+
+var (
+	clsidFileOpenDialog = GUID{0xDC1C5A9C, 0xE88A, 0x4DDE, [8]byte{0xA5, 0xA1, 0x60, 0xF8, 0x2A, 0x20, 0xAE, 0xF7}}
+	clsidFileSaveDialog = GUID{0xC0B4E2F3, 0xBA21, 0x4773, [8]byte{0x8D, 0xBA, 0x33, 0x5E, 0xC9, 0x46, 0xEB, 0x8B}}
+	iidIFileOpenDialog  = GUID{0xD57C7288, 0xD4AD, 0x4768, [8]byte{0xBE, 0x02, 0x9D, 0x96, 0x95, 0x32, 0xD9, 0x60}}
+	iidIFileSaveDialog  = GUID{0x84BCCD23, 0x5FDE, 0x4CDB, [8]byte{0xAE, 0xA4, 0xAF, 0x64, 0xB8, 0x3D, 0x78, 0xAB}}
+	iidIShellItem       = GUID{0x43826D1E, 0xE718, 0x42EE, [8]byte{0xBC, 0x55, 0xA1, 0xE2, 0x61, 0xC3, 0x7B, 0xFE}}
+)
+
+// IFileDialog vtable index'leri (IUnknown + IModalWindow + IFileDialog + IFileOpenDialog)
+const (
+	fdShow             = 3
+	fdSetFileTypes     = 4
+	fdSetOptions       = 9
+	fdGetOptions       = 10
+	fdSetFolder        = 12
+	fdSetFileName      = 15
+	fdSetTitle         = 17
+	fdSetOkButtonLabel = 18
+	fdGetResult        = 20
+	fdSetDefaultExt    = 22
+	fdGetResults       = 27 // yalnızca IFileOpenDialog
+)
+
+// IShellItem / IShellItemArray vtable index'leri
+const (
+	siGetDisplayName = 5
+	siaGetCount      = 7
+	siaGetItemAt     = 8
+)
+
+// FILEOPENDIALOGOPTIONS
+const (
+	FOS_OVERWRITEPROMPT  = 0x00000002
+	FOS_NOCHANGEDIR      = 0x00000008
+	FOS_PICKFOLDERS      = 0x00000020
+	FOS_FORCEFILESYSTEM  = 0x00000040
+	FOS_ALLOWMULTISELECT = 0x00000200
+	FOS_PATHMUSTEXIST    = 0x00000800
+	FOS_FILEMUSTEXIST    = 0x00001000
+)
+
+// SIGDN_FILESYSPATH: IShellItem'ın dosya sistemi yolu
+const SIGDN_FILESYSPATH = 0x80058000
+
+// comdlgFilterSpec, COMDLG_FILTERSPEC yapısıdır.
+type comdlgFilterSpec struct {
+	name *uint16
+	spec *uint16
+}
+
+// ShowFileDialog opens a modal IFileDialog and returns the selected paths.
+// İptal edilirse boş slice ve nil hata döner. Çağıran thread'de COM
+// başlatılmamışsa başlatılır; diyalog modal olduğu için çağrı bloklar.
+func ShowFileDialog(opts platform.FileDialogOptions) ([]string, error) {
+	uninit := CoInitialize()
+	defer uninit()
+
+	save := opts.Kind == platform.FileDialogSave
+	clsid, iid := &clsidFileOpenDialog, &iidIFileOpenDialog
+	if save {
+		clsid, iid = &clsidFileSaveDialog, &iidIFileSaveDialog
+	}
+
+	dlg, err := coCreate(clsid, iid)
+	if err != nil {
+		return nil, err
+	}
+	defer dlg.Release()
+
+	// Seçenekler
+	flagsOut := new(uint32)
+	hr := dlg.call(fdGetOptions, uintptr(unsafe.Pointer(flagsOut)))
+	if err := hresult("GetOptions", hr); err != nil {
+		return nil, err
+	}
+	flags := *flagsOut | FOS_FORCEFILESYSTEM | FOS_NOCHANGEDIR
+	switch opts.Kind {
+	case platform.FileDialogOpen:
+		flags |= FOS_FILEMUSTEXIST | FOS_PATHMUSTEXIST
+	case platform.FileDialogOpenMulti:
+		flags |= FOS_FILEMUSTEXIST | FOS_PATHMUSTEXIST | FOS_ALLOWMULTISELECT
+	case platform.FileDialogFolder:
+		flags |= FOS_PICKFOLDERS | FOS_PATHMUSTEXIST
+	case platform.FileDialogSave:
+		flags |= FOS_OVERWRITEPROMPT | FOS_PATHMUSTEXIST
+	}
+	if err := hresult("SetOptions", dlg.call(fdSetOptions, uintptr(flags))); err != nil {
+		return nil, err
+	}
+
+	if opts.Title != "" {
+		dlg.callString(fdSetTitle, opts.Title)
+	}
+	if opts.ButtonLabel != "" {
+		dlg.callString(fdSetOkButtonLabel, opts.ButtonLabel)
+	}
+	if opts.FileName != "" {
+		dlg.callString(fdSetFileName, opts.FileName)
+	}
+	if opts.Directory != "" {
+		if folder, err := shellItemFromPath(opts.Directory); err == nil {
+			dlg.call(fdSetFolder, uintptr(unsafe.Pointer(folder)))
+			folder.Release()
+		}
+	}
+
+	// Filtreler (klasör seçiminde anlamsız)
+	if len(opts.Filters) > 0 && opts.Kind != platform.FileDialogFolder {
+		specs := make([]comdlgFilterSpec, 0, len(opts.Filters))
+		for _, f := range opts.Filters {
+			specs = append(specs, comdlgFilterSpec{
+				name: UTF16PtrFromString(f.Name),
+				spec: UTF16PtrFromString(strings.Join(f.Patterns, ";")),
+			})
+		}
+		hr := dlg.call(fdSetFileTypes, uintptr(len(specs)), uintptr(unsafe.Pointer(&specs[0])))
+		runtime.KeepAlive(specs)
+		if err := hresult("SetFileTypes", hr); err != nil {
+			return nil, err
+		}
+		if save {
+			// Kullanıcı uzantı yazmazsa ilk filtrenin uzantısı eklenir
+			if ext := defaultExtension(opts.Filters[0]); ext != "" {
+				dlg.callString(fdSetDefaultExt, ext)
+			}
+		}
+	}
+
+	hr = dlg.call(fdShow, opts.Owner)
+	if uint32(hr) == HRESULT_CANCELLED {
+		return nil, nil
+	}
+	if err := hresult("Show", hr); err != nil {
+		return nil, err
+	}
+
+	if opts.Kind == platform.FileDialogOpenMulti {
+		return multiResult(dlg)
+	}
+
+	out := outObject()
+	hr = dlg.call(fdGetResult, uintptr(unsafe.Pointer(out)))
+	if err := hresult("GetResult", hr); err != nil {
+		return nil, err
+	}
+	item := *out
+	defer item.Release()
+
+	path, err := shellItemPath(item)
+	if err != nil {
+		return nil, err
+	}
+	return []string{path}, nil
+}
+
+// multiResult, IFileOpenDialog::GetResults ile tüm seçimleri okur.
+func multiResult(dlg *comObject) ([]string, error) {
+	out := outObject()
+	if err := hresult("GetResults", dlg.call(fdGetResults, uintptr(unsafe.Pointer(out)))); err != nil {
+		return nil, err
+	}
+	items := *out
+	defer items.Release()
+
+	count := new(uint32)
+	if err := hresult("GetCount", items.call(siaGetCount, uintptr(unsafe.Pointer(count)))); err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, *count)
+	for i := uint32(0); i < *count; i++ {
+		itemOut := outObject()
+		if err := hresult("GetItemAt", items.call(siaGetItemAt, uintptr(i), uintptr(unsafe.Pointer(itemOut)))); err != nil {
+			return nil, err
+		}
+		item := *itemOut
+		path, err := shellItemPath(item)
+		item.Release()
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// shellItemPath, IShellItem'ın dosya sistemi yolunu döner.
+func shellItemPath(item *comObject) (string, error) {
+	name := new(*uint16)
+	if err := hresult("GetDisplayName", item.call(siGetDisplayName, SIGDN_FILESYSPATH, uintptr(unsafe.Pointer(name)))); err != nil {
+		return "", err
+	}
+	return coTaskString(*name), nil
+}
+
+// shellItemFromPath, bir yoldan IShellItem oluşturur.
+func shellItemFromPath(path string) (*comObject, error) {
+	var item *comObject
+	hr, _, _ := procSHCreateItemFromParsingName.Call(
+		uintptr(unsafe.Pointer(UTF16PtrFromString(path))),
+		0,
+		uintptr(unsafe.Pointer(&iidIShellItem)),
+		uintptr(unsafe.Pointer(&item)),
+	)
+	if err := hresult("SHCreateItemFromParsingName", hr); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// defaultExtension, "*.png" gibi bir desenden "png" uzantısını çıkarır.
+func defaultExtension(f platform.FileFilter) string {
+	for _, p := range f.Patterns {
+		if ext := strings.TrimPrefix(p, "*."); ext != p && !strings.ContainsAny(ext, "*?") {
+			return ext
+		}
+	}
+	return ""
+}
@@ -0,0 +1,198 @@
+//go:build windows
+
+package windows
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// ============================================================================
+// HID AYGITLARI
+// HID aygıtları SetupAPI ile HID arayüz sınıfından listelenir; her aygıtın
+// yolu CreateFile ile açılır. Bilgi okumak için erişim hakkı istenmeden
+// açılır (klavye ve fare gibi sistemin ayırdığı aygıtlar da listelenebilsin);
+// rapor okumak için GENERIC_READ gerekir ve bu aygıtlarda reddedilir.
+// ============================================================================
+
+var (
+	setupapi                             = syscall.NewLazyDLL("setupapi.dll")
+	procSetupDiGetClassDevsW             = setupapi.NewProc("SetupDiGetClassDevsW")
+	procSetupDiEnumDeviceInterfaces      = setupapi.NewProc("SetupDiEnumDeviceInterfaces")
+	procSetupDiGetDeviceInterfaceDetailW = setupapi.NewProc("SetupDiGetDeviceInterfaceDetailW")
+	procSetupDiDestroyDeviceInfoList     = setupapi.NewProc("SetupDiDestroyDeviceInfoList")
+
+	hidDLL                         = syscall.NewLazyDLL("hid.dll")
+	procHidD_GetHidGuid            = hidDLL.NewProc("HidD_GetHidGuid")
+	procHidD_GetAttributes         = hidDLL.NewProc("HidD_GetAttributes")
+	procHidD_GetProductString      = hidDLL.NewProc("HidD_GetProductString")
+	procHidD_GetManufacturerString = hidDLL.NewProc("HidD_GetManufacturerString")
+	procHidD_GetPreparsedData      = hidDLL.NewProc("HidD_GetPreparsedData")
+	procHidD_FreePreparsedData     = hidDLL.NewProc("HidD_FreePreparsedData")
+	procHidP_GetCaps               = hidDLL.NewProc("HidP_GetCaps")
+)
+
+const (
+	DIGCF_PRESENT         = 0x00000002
+	DIGCF_DEVICEINTERFACE = 0x00000010
+
+	HIDP_STATUS_SUCCESS = 0x00110000
+
+	// hidStringLen, HidD_Get*String tamponunun karakter sayısıdır (API sınırı 126)
+	hidStringLen = 127
+)
+
+// SP_DEVICE_INTERFACE_DATA: SetupDiEnumDeviceInterfaces çıktısı
+type SP_DEVICE_INTERFACE_DATA struct {
+	CbSize             uint32
+	InterfaceClassGuid GUID
+	Flags              uint32
+	Reserved           uintptr
+}
+
+// HIDD_ATTRIBUTES: HidD_GetAttributes çıktısı
+type HIDD_ATTRIBUTES struct {
+	Size          uint32
+	VendorID      uint16
+	ProductID     uint16
+	VersionNumber uint16
+}
+
+// HIDP_CAPS: HidP_GetCaps çıktısı
+type HIDP_CAPS struct {
+	Usage                     uint16
+	UsagePage                 uint16
+	InputReportByteLength     uint16
+	OutputReportByteLength    uint16
+	FeatureReportByteLength   uint16
+	Reserved                  [17]uint16
+	NumberLinkCollectionNodes uint16
+	NumberInputButtonCaps     uint16
+	NumberInputValueCaps      uint16
+	NumberInputDataIndices    uint16
+	NumberOutputButtonCaps    uint16
+	NumberOutputValueCaps     uint16
+	NumberOutputDataIndices   uint16
+	NumberFeatureButtonCaps   uint16
+	NumberFeatureValueCaps    uint16
+	NumberFeatureDataIndices  uint16
+}
+
+// HIDDevice, listelenen bir HID aygıtıdır.
+type HIDDevice struct {
+	Path              string
+	Product           string
+	Manufacturer      string
+	VendorID          uint16
+	ProductID         uint16
+	UsagePage         uint16
+	Usage             uint16
+	InputReportLength int
+}
+
+/*
+EnumHIDDevices → Bağlı HID aygıtlarını döner. Açılamayan aygıtlar atlanır.
+*/
+func EnumHIDDevices() []HIDDevice {
+	guid := new(GUID)
+	procHidD_GetHidGuid.Call(uintptr(unsafe.Pointer(guid)))
+
+	set, _, _ := procSetupDiGetClassDevsW.Call(uintptr(unsafe.Pointer(guid)), 0, 0, DIGCF_PRESENT|DIGCF_DEVICEINTERFACE)
+	if set == uintptr(syscall.InvalidHandle) {
+		return nil
+	}
+	defer procSetupDiDestroyDeviceInfoList.Call(set)
+
+	var devices []HIDDevice
+	for i := 0; ; i++ {
+		iface := new(SP_DEVICE_INTERFACE_DATA)
+		iface.CbSize = uint32(unsafe.Sizeof(*iface))
+		ret, _, _ := procSetupDiEnumDeviceInterfaces.Call(set, 0, uintptr(unsafe.Pointer(guid)), uintptr(i), uintptr(unsafe.Pointer(iface)))
+		if ret == 0 {
+			break
+		}
+		path := interfacePath(set, iface, nil)
+		if path == "" {
+			continue
+		}
+		if dev, ok := hidDeviceInfo(path); ok {
+			devices = append(devices, dev)
+		}
+	}
+	return devices
+}
+
+// interfacePath, arayüzün aygıt yolunu okur. devinfo nil değilse aygıtın
+// bilgisiyle doldurulur (registry özelliklerini okumak için).
+func interfacePath(set uintptr, iface *SP_DEVICE_INTERFACE_DATA, devinfo *SP_DEVINFO_DATA) string {
+	var size uint32
+	procSetupDiGetDeviceInterfaceDetailW.Call(set, uintptr(unsafe.Pointer(iface)), 0, 0, uintptr(unsafe.Pointer(&size)), 0)
+	if size == 0 {
+		return ""
+	}
+	// SP_DEVICE_INTERFACE_DETAIL_DATA_W: cbSize + WCHAR DevicePath[]; cbSize
+	// yapının sabit kısmının boyutudur (64-bit'te 8, 32-bit'te 6)
+	buf := make([]uint16, size/2+1)
+	cbSize := uint32(4 + 2)
+	if unsafe.Sizeof(uintptr(0)) == 8 {
+		cbSize = 8
+	}
+	*(*uint32)(unsafe.Pointer(&buf[0])) = cbSize
+	ret, _, _ := procSetupDiGetDeviceInterfaceDetailW.Call(set, uintptr(unsafe.Pointer(iface)), uintptr(unsafe.Pointer(&buf[0])), uintptr(size), 0, uintptr(unsafe.Pointer(devinfo)))
+	if ret == 0 {
+		return ""
+	}
+	return syscall.UTF16ToString(buf[2:])
+}
+
+// hidDeviceInfo, aygıtı erişim hakkı istemeden açıp bilgilerini okur.
+func hidDeviceInfo(path string) (HIDDevice, bool) {
+	h, err := OpenHID(path, 0)
+	if err != nil {
+		return HIDDevice{}, false
+	}
+	defer syscall.CloseHandle(h)
+
+	dev := HIDDevice{Path: path}
+	attrs := new(HIDD_ATTRIBUTES)
+	attrs.Size = uint32(unsafe.Sizeof(*attrs))
+	if ret, _, _ := procHidD_GetAttributes.Call(uintptr(h), uintptr(unsafe.Pointer(attrs))); ret == 0 {
+		return HIDDevice{}, false
+	}
+	dev.VendorID, dev.ProductID = attrs.VendorID, attrs.ProductID
+	dev.Product = hidString(procHidD_GetProductString, h)
+	dev.Manufacturer = hidString(procHidD_GetManufacturerString, h)
+
+	var preparsed uintptr
+	if ret, _, _ := procHidD_GetPreparsedData.Call(uintptr(h), uintptr(unsafe.Pointer(&preparsed))); ret != 0 {
+		caps := new(HIDP_CAPS)
+		if status, _, _ := procHidP_GetCaps.Call(preparsed, uintptr(unsafe.Pointer(caps))); status == HIDP_STATUS_SUCCESS {
+			dev.UsagePage, dev.Usage = caps.UsagePage, caps.Usage
+			dev.InputReportLength = int(caps.InputReportByteLength)
+		}
+		procHidD_FreePreparsedData.Call(preparsed)
+	}
+	return dev, true
+}
+
+// hidString, HidD_Get*String ile bir aygıt metnini okur.
+func hidString(proc *syscall.LazyProc, h syscall.Handle) string {
+	buf := make([]uint16, hidStringLen)
+	ret, _, _ := proc.Call(uintptr(h), uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)*2))
+	if ret == 0 {
+		return ""
+	}
+	return syscall.UTF16ToString(buf)
+}
+
+/*
+OpenHID → Aygıt yolunu verilen erişim hakkıyla açar (0: yalnızca bilgi,
+GENERIC_READ: rapor okuma). Tanıtıcı syscall.CloseHandle ile kapatılmalıdır.
+*/
+func OpenHID(path string, access uint32) (syscall.Handle, error) {
+	p, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return syscall.InvalidHandle, err
+	}
+	return syscall.CreateFile(p, access, syscall.FILE_SHARE_READ|syscall.FILE_SHARE_WRITE, nil, syscall.OPEN_EXISTING, 0, 0)
+}
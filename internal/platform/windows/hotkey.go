@@ -0,0 +1,116 @@
+//go:build windows
+
+package windows
+
+import (
+	"syscall"
+
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// ============================================================================
+// GLOBAL HOTKEYS
+// RegisterHotKey ile sistem genelinde (uygulama odakta değilken de) çalışan
+// kısayollar. Kısayol tetiklendiğinde kaydeden thread'in penceresine
+// WM_HOTKEY gönderilir (bkz. MessageThread).
+// ============================================================================
+
+var (
+	procRegisterHotKey   = user32.NewProc("RegisterHotKey")
+	procUnregisterHotKey = user32.NewProc("UnregisterHotKey")
+)
+
+const WM_HOTKEY = 0x0312
+
+// RegisterHotKey modifier'ları
+const (
+	MOD_ALT      = 0x0001
+	MOD_CONTROL  = 0x0002
+	MOD_SHIFT    = 0x0004
+	MOD_WIN      = 0x0008
+	MOD_NOREPEAT = 0x4000
+)
+
+// ERROR_HOTKEY_ALREADY_REGISTERED: kısayol başka bir uygulamaya ait
+const ERROR_HOTKEY_ALREADY_REGISTERED syscall.Errno = 1409
+
+// virtualKeys, platform.Accelerator tuş adlarını sanal tuş kodlarına eşler.
+// Harf, rakam ve F tuşları hesaplanır (bkz. VirtualKey).
+var virtualKeys = map[string]uint32{
+	"enter":     0x0D,
+	"escape":    0x1B,
+	"tab":       0x09,
+	"space":     0x20,
+	"backspace": 0x08,
+	"delete":    0x2E,
+	"insert":    0x2D,
+	"home":      0x24,
+	"end":       0x23,
+	"pageup":    0x21,
+	"pagedown":  0x22,
+	"left":      0x25,
+	"up":        0x26,
+	"right":     0x27,
+	"down":      0x28,
+	"equal":     0xBB, // VK_OEM_PLUS
+	"minus":     0xBD, // VK_OEM_MINUS
+	"comma":     0xBC, // VK_OEM_COMMA
+	"period":    0xBE, // VK_OEM_PERIOD
+	"slash":     0xBF, // VK_OEM_2
+}
+
+// HotkeyCode converts an accelerator to RegisterHotKey modifiers and virtual key.
+func HotkeyCode(accel platform.Accelerator) (mods, vk uint32, ok bool) {
+	mods = MOD_NOREPEAT
+	if accel.Ctrl {
+		mods |= MOD_CONTROL
+	}
+	if accel.Alt {
+		mods |= MOD_ALT
+	}
+	if accel.Shift {
+		mods |= MOD_SHIFT
+	}
+	if accel.Meta {
+		mods |= MOD_WIN
+	}
+
+	key := accel.Key
+	switch {
+	case len(key) == 1 && key[0] >= 'a' && key[0] <= 'z':
+		return mods, uint32(key[0]-'a') + 'A', true
+	case len(key) == 1 && key[0] >= '0' && key[0] <= '9':
+		return mods, uint32(key[0]), true
+	case len(key) >= 2 && key[0] == 'f':
+		n := 0
+		for _, c := range key[1:] {
+			n = n*10 + int(c-'0')
+		}
+		return mods, 0x70 + uint32(n-1), true // VK_F1 = 0x70
+	}
+	vk, ok = virtualKeys[key]
+	return mods, vk, ok
+}
+
+/*
+RegisterHotKey → Sistem genelinde kısayol kaydeder. hwnd, çağıran thread'e ait
+olmalıdır. Kısayol başka bir uygulamaya aitse ERROR_HOTKEY_ALREADY_REGISTERED döner.
+*/
+func RegisterHotKey(hwnd syscall.Handle, id int32, mods, vk uint32) error {
+	ret, _, err := procRegisterHotKey.Call(uintptr(hwnd), uintptr(id), uintptr(mods), uintptr(vk))
+	if ret == 0 {
+		return err
+	}
+	return nil
+}
+
+/*
+UnregisterHotKey → RegisterHotKey ile kaydedilen kısayolu kaldırır.
+*/
+func UnregisterHotKey(hwnd syscall.Handle, id int32) error {
+	ret, _, err := procUnregisterHotKey.Call(uintptr(hwnd), uintptr(id))
+	if ret == 0 {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+//go:build windows
+
+package windows
+
+import (
+	"unsafe"
+)
+
+var (
+	procGetLastInputInfo = user32.NewProc("GetLastInputInfo")
+	procGetTickCount     = kernel32.NewProc("GetTickCount")
+)
+
+// LASTINPUTINFO: GetLastInputInfo çıktısı
+type LASTINPUTINFO struct {
+	CbSize uint32
+	DwTime uint32 // Son girişin GetTickCount değeri (ms)
+}
+
+/*
+IdleMilliseconds → Oturumdaki son klavye/fare girişinden bu yana geçen süre (ms).
+Tick sayacı 49.7 günde bir taşar; uint32 çıkarma bunu doğru hesaplar.
+*/
+func IdleMilliseconds() (uint32, error) {
+	info := LASTINPUTINFO{}
+	info.CbSize = uint32(unsafe.Sizeof(info))
+	ret, _, err := procGetLastInputInfo.Call(uintptr(unsafe.Pointer(&info)))
+	if ret == 0 {
+		return 0, err
+	}
+	now, _, _ := procGetTickCount.Call()
+	return uint32(now) - info.DwTime, nil
+}
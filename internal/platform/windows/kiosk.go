@@ -0,0 +1,105 @@
+//go:build windows
+
+package windows
+
+import (
+	"unsafe"
+
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// ============================================================================
+// KIOSK GÖRÜNÜMÜ
+// Kiosk modunda pencere WS_POPUP stiline geçer (başlık, kenarlık ve sistem
+// menüsü yok), bulunduğu monitörün tamamını kaplar ve HWND_TOPMOST ile görev
+// çubuğunun da üstünde kalır. Önceki stil, konum ve menü çubuğu saklanır;
+// kiosktan çıkınca aynen geri yüklenir.
+// ============================================================================
+
+var _ platform.KioskHost = (*Window)(nil)
+
+var procMonitorFromWindow = user32.NewProc("MonitorFromWindow")
+
+const (
+	MONITOR_DEFAULTTONEAREST = 0x00000002
+
+	SWP_SHOWWINDOW = 0x0040
+)
+
+var (
+	HWND_TOPMOST   = ^uintptr(0)     // (HWND)-1
+	HWND_NOTOPMOST = ^uintptr(0) - 1 // (HWND)-2
+)
+
+// kioskState, kiosktan çıkınca geri yüklenecek görünümdür.
+type kioskState struct {
+	style   uintptr
+	exStyle uintptr
+	rect    RECT
+}
+
+// SetKiosk switches the window into or out of kiosk presentation.
+func (w *Window) SetKiosk(enabled bool) error {
+	w.mu.Lock()
+	saved := w.kiosk
+	w.mu.Unlock()
+
+	if enabled == (saved != nil) {
+		return nil
+	}
+
+	if !enabled {
+		SetWindowLongPtr(w.hwnd, GWL_STYLE, saved.style)
+		SetWindowLongPtr(w.hwnd, GWL_EXSTYLE, saved.exStyle)
+		w.mu.RLock()
+		bar := w.menuBar
+		w.mu.RUnlock()
+		if bar != nil {
+			procSetMenu.Call(uintptr(w.hwnd), uintptr(bar.handle))
+		}
+		r := saved.rect
+		procSetWindowPos.Call(uintptr(w.hwnd), HWND_NOTOPMOST,
+			uintptr(r.Left), uintptr(r.Top), uintptr(r.Width()), uintptr(r.Height()),
+			SWP_FRAMECHANGED|SWP_SHOWWINDOW)
+
+		w.mu.Lock()
+		w.kiosk = nil
+		w.mu.Unlock()
+		return nil
+	}
+
+	state := &kioskState{
+		style:   GetWindowLongPtr(w.hwnd, GWL_STYLE),
+		exStyle: GetWindowLongPtr(w.hwnd, GWL_EXSTYLE),
+	}
+	if IsZoomed(w.hwnd) {
+		// Büyütülmüş pencerenin normal konumu kaybolmasın
+		ShowWindow(w.hwnd, SW_RESTORE)
+	}
+	if err := GetWindowRect(w.hwnd, &state.rect); err != nil {
+		return err
+	}
+
+	hmon, _, _ := procMonitorFromWindow.Call(uintptr(w.hwnd), MONITOR_DEFAULTTONEAREST)
+	info := MONITORINFOEX{}
+	info.CbSize = uint32(unsafe.Sizeof(info))
+	if ret, _, err := procGetMonitorInfoW.Call(hmon, uintptr(unsafe.Pointer(&info))); ret == 0 {
+		return err
+	}
+
+	style := state.style&^(WS_CAPTION|WS_THICKFRAME|WS_SYSMENU|WS_MINIMIZEBOX|WS_MAXIMIZEBOX) | WS_POPUP
+	exStyle := state.exStyle &^ (WS_EX_DLGMODALFRAME | WS_EX_OVERLAPPEDWINDOW)
+	SetWindowLongPtr(w.hwnd, GWL_STYLE, style)
+	SetWindowLongPtr(w.hwnd, GWL_EXSTYLE, exStyle)
+	procSetMenu.Call(uintptr(w.hwnd), 0)
+
+	m := info.RcMonitor
+	procSetWindowPos.Call(uintptr(w.hwnd), HWND_TOPMOST,
+		uintptr(m.Left), uintptr(m.Top), uintptr(m.Width()), uintptr(m.Height()),
+		SWP_FRAMECHANGED|SWP_SHOWWINDOW)
+
+	w.mu.Lock()
+	w.kiosk = state
+	w.mu.Unlock()
+	return nil
+}
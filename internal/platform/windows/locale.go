@@ -0,0 +1,25 @@
+//go:build windows
+
+package windows
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+var procGetUserDefaultLocaleName = kernel32.NewProc("GetUserDefaultLocaleName")
+
+// LOCALE_NAME_MAX_LENGTH: yerel ayar adının en uzun hali (sonlandırıcı dahil)
+const LOCALE_NAME_MAX_LENGTH = 85
+
+/*
+UserLocaleName → Kullanıcının varsayılan yerel ayarı (ör. "tr-TR").
+*/
+func UserLocaleName() (string, error) {
+	buf := make([]uint16, LOCALE_NAME_MAX_LENGTH)
+	ret, _, err := procGetUserDefaultLocaleName.Call(uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)))
+	if ret == 0 {
+		return "", err
+	}
+	return syscall.UTF16ToString(buf), nil
+}
@@ -0,0 +1,288 @@
+//go:build windows
+
+package windows
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// ============================================================================
+// NATIVE MENÜ (HMENU)
+// platform.MenuItem ağacını Win32 popup menüsüne çevirir. Win32 menü
+// öğelerini string değil uint16 komut ID'si ile tanır; bu yüzden her
+// oluşturmada bir komut tablosu (cmd → MenuItem.ID) üretilir.
+// ============================================================================
+
+var (
+	procCreatePopupMenu = user32.NewProc("CreatePopupMenu")
+	procCreateMenu      = user32.NewProc("CreateMenu")
+	procAppendMenuW     = user32.NewProc("AppendMenuW")
+	procDestroyMenu     = user32.NewProc("DestroyMenu")
+	procTrackPopupMenu  = user32.NewProc("TrackPopupMenu")
+	procSetMenu         = user32.NewProc("SetMenu")
+	procDrawMenuBar     = user32.NewProc("DrawMenuBar")
+)
+
+// Menü flag'leri
+const (
+	MF_STRING    = 0x00000000
+	MF_GRAYED    = 0x00000001
+	MF_CHECKED   = 0x00000008
+	MF_POPUP     = 0x00000010
+	MF_SEPARATOR = 0x00000800
+
+	TPM_LEFTALIGN   = 0x0000
+	TPM_RIGHTBUTTON = 0x0002
+	TPM_BOTTOMALIGN = 0x0020
+	TPM_RETURNCMD   = 0x0100
+)
+
+// firstMenuCommand, komut ID'lerinin başlangıcıdır (0 = "seçim yok").
+const firstMenuCommand = 1
+
+// Menu, oluşturulmuş bir native menüyü ve komut tablosunu tutar.
+type Menu struct {
+	handle   syscall.Handle
+	commands map[uint16]string // Win32 komut ID → MenuItem.ID
+}
+
+// BuildPopupMenu creates a native popup menu from the item tree.
+// Dönen menü kullanıldıktan sonra Destroy ile serbest bırakılmalıdır.
+func BuildPopupMenu(items []*platform.MenuItem) (*Menu, error) {
+	h, _, err := procCreatePopupMenu.Call()
+	if h == 0 {
+		return nil, err
+	}
+
+	m := &Menu{
+		handle:   syscall.Handle(h),
+		commands: make(map[uint16]string),
+	}
+	next := uint16(firstMenuCommand)
+	if err := m.append(m.handle, items, &next); err != nil {
+		m.Destroy()
+		return nil, err
+	}
+	return m, nil
+}
+
+// BuildMenuBar creates a native menu bar (top-level items become dropdowns).
+func BuildMenuBar(items []*platform.MenuItem) (*Menu, error) {
+	h, _, err := procCreateMenu.Call()
+	if h == 0 {
+		return nil, err
+	}
+
+	m := &Menu{
+		handle:   syscall.Handle(h),
+		commands: make(map[uint16]string),
+	}
+	next := uint16(firstMenuCommand)
+	if err := m.append(m.handle, items, &next); err != nil {
+		m.Destroy()
+		return nil, err
+	}
+	return m, nil
+}
+
+// append, öğeleri (ve alt menüleri) verilen HMENU'ya ekler.
+func (m *Menu) append(parent syscall.Handle, items []*platform.MenuItem, next *uint16) error {
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+
+		if item.Separator {
+			if err := appendMenu(parent, MF_SEPARATOR, 0, ""); err != nil {
+				return err
+			}
+			continue
+		}
+
+		flags := uint32(MF_STRING)
+		if item.Disabled {
+			flags |= MF_GRAYED
+		}
+		if item.Checkable && item.Checked {
+			flags |= MF_CHECKED
+		}
+
+		if len(item.Submenu) > 0 {
+			sub, _, err := procCreatePopupMenu.Call()
+			if sub == 0 {
+				return err
+			}
+			if err := m.append(syscall.Handle(sub), item.Submenu, next); err != nil {
+				return err
+			}
+			if err := appendMenu(parent, flags|MF_POPUP, sub, item.Label); err != nil {
+				return err
+			}
+			continue
+		}
+
+		label := item.Label
+		if item.Accelerator != "" {
+			// Win32 kuralı: TAB'dan sonrası sağa hizalı kısayol metnidir
+			label += "\t" + item.Accelerator
+		}
+
+		cmd := *next
+		*next++
+		m.commands[cmd] = item.ID
+		if err := appendMenu(parent, flags, uintptr(cmd), label); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Handle returns the native HMENU.
+func (m *Menu) Handle() syscall.Handle { return m.handle }
+
+// CommandID, Win32 komut ID'sini MenuItem.ID'ye çevirir.
+func (m *Menu) CommandID(cmd uint16) (string, bool) {
+	id, ok := m.commands[cmd]
+	return id, ok
+}
+
+// Track shows the popup menu at the given screen position and blocks until
+// the user picks an item or dismisses it. Seçilen öğenin ID'sini döner.
+func (m *Menu) Track(owner syscall.Handle, x, y int32) (string, bool) {
+	// Menü dışına tıklanınca kapanabilmesi için owner ön planda olmalı (MSDN)
+	SetForegroundWindow(owner)
+
+	cmd, _, _ := procTrackPopupMenu.Call(
+		uintptr(m.handle),
+		TPM_LEFTALIGN|TPM_BOTTOMALIGN|TPM_RIGHTBUTTON|TPM_RETURNCMD,
+		uintptr(x),
+		uintptr(y),
+		0,
+		uintptr(owner),
+		0,
+	)
+
+	procPostMessageW.Call(uintptr(owner), WM_NULL, 0, 0)
+
+	if cmd == 0 {
+		return "", false
+	}
+	return m.CommandID(uint16(cmd))
+}
+
+// Destroy frees the native menu (and its submenus).
+func (m *Menu) Destroy() {
+	if m.handle != 0 {
+		procDestroyMenu.Call(uintptr(m.handle))
+		m.handle = 0
+	}
+}
+
+// appendMenu → AppendMenuW sarmalayıcısı.
+func appendMenu(menu syscall.Handle, flags uint32, id uintptr, text string) error {
+	var textPtr uintptr
+	if text != "" {
+		textPtr = uintptr(unsafe.Pointer(UTF16PtrFromString(text)))
+	}
+	ret, _, err := procAppendMenuW.Call(uintptr(menu), uintptr(flags), id, textPtr)
+	if ret == 0 {
+		return err
+	}
+	return nil
+}
+
+// GetCursorPos → İmlecin ekran koordinatlarını döner.
+func GetCursorPos() (x, y int32) {
+	var pt POINT
+	procGetCursorPos.Call(uintptr(unsafe.Pointer(&pt)))
+	return pt.X, pt.Y
+}
+
+// ==================== Window Menu Host ====================
+
+// SetMenuBar implements platform.MenuHost.
+// Eski menü çubuğu yok edilir; pencere çerçevesi yeniden hesaplanır ki
+// client alanı (ve içindeki WebView) yeni yüksekliğe uyum sağlasın.
+func (w *Window) SetMenuBar(items []*platform.MenuItem, onSelect func(id string)) error {
+	var bar *Menu
+	if len(items) > 0 {
+		var err error
+		if bar, err = BuildMenuBar(items); err != nil {
+			return err
+		}
+	}
+
+	w.mu.RLock()
+	kiosk := w.kiosk != nil
+	w.mu.RUnlock()
+
+	// Kiosk modunda menü çubuğu gizlidir; kiosktan çıkınca takılır
+	if !kiosk {
+		var handle uintptr
+		if bar != nil {
+			handle = uintptr(bar.handle)
+		}
+		if ret, _, err := procSetMenu.Call(uintptr(w.hwnd), handle); ret == 0 {
+			if bar != nil {
+				bar.Destroy()
+			}
+			return err
+		}
+		procDrawMenuBar.Call(uintptr(w.hwnd))
+		procSetWindowPos.Call(uintptr(w.hwnd), 0, 0, 0, 0, 0,
+			SWP_NOMOVE|SWP_NOSIZE|SWP_NOZORDER|SWP_FRAMECHANGED)
+	}
+
+	w.mu.Lock()
+	old := w.menuBar
+	w.menuBar = bar
+	w.onMenu = onSelect
+	w.mu.Unlock()
+
+	if old != nil {
+		old.Destroy()
+	}
+	return nil
+}
+
+// PopupMenu implements platform.MenuHost.
+func (w *Window) PopupMenu(items []*platform.MenuItem) (string, bool, error) {
+	m, err := BuildPopupMenu(items)
+	if err != nil {
+		return "", false, err
+	}
+	defer m.Destroy()
+
+	x, y := GetCursorPos()
+	id, ok := m.Track(w.hwnd, x, y)
+	return id, ok, nil
+}
+
+// handleCommand, menü çubuğundan gelen WM_COMMAND mesajını işler.
+// Mesaj bir menü seçimine aitse true döner.
+func (w *Window) handleCommand(wParam, lParam uintptr) bool {
+	// lParam != 0 → kontrol bildirimi; HIWORD(wParam) == 1 → accelerator
+	if lParam != 0 || HIWORD(wParam) != 0 {
+		return false
+	}
+
+	w.mu.RLock()
+	bar := w.menuBar
+	onMenu := w.onMenu
+	w.mu.RUnlock()
+
+	if bar == nil {
+		return false
+	}
+	id, ok := bar.CommandID(uint16(LOWORD(wParam)))
+	if !ok {
+		return false
+	}
+	if onMenu != nil {
+		onMenu(id)
+	}
+	return true
+}
@@ -0,0 +1,112 @@
+//go:build windows
+
+package windows
+
+import (
+	"runtime"
+	"syscall"
+)
+
+// ============================================================================
+// MESAJ THREAD'İ (Message Thread)
+// UI thread'inden bağımsız çalışması gereken servisler (global kısayollar,
+// sistem olay izleyicileri) için kendi mesaj döngüsüne sahip bir OS thread'i.
+// Thread, bir MessageWindow oluşturur ve kapanana kadar mesaj pompalar.
+//
+// RegisterHotKey gibi API'ler pencerenin sahibi olan thread'den çağrılmak
+// zorundadır; Do bu çağrıları thread'e taşır.
+// ============================================================================
+
+// wmRunQueue, Do kuyruğunu işletmek için thread'e gönderilen özel mesaj.
+const wmRunQueue = WM_APP + 2
+
+// MessageThread, mesaj döngüsü çalıştıran özel bir OS thread'idir.
+type MessageThread struct {
+	window *MessageWindow
+	queue  chan func()
+	done   chan struct{}
+}
+
+// NewMessageThread starts a locked OS thread with a hidden message window.
+// handler, pencereye gelen mesajları bu thread üzerinde işler.
+func NewMessageThread(name string, handler MessageHandler) (*MessageThread, error) {
+	t := &MessageThread{
+		queue: make(chan func(), 16),
+		done:  make(chan struct{}),
+	}
+	ready := make(chan error, 1)
+
+	go func() {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+		defer close(t.done)
+
+		mw, err := NewMessageWindow(name, func(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) (uintptr, bool) {
+			if msg == wmRunQueue {
+				t.drain()
+				return 0, true
+			}
+			if handler != nil {
+				return handler(hwnd, msg, wParam, lParam)
+			}
+			return 0, false
+		})
+		if err != nil {
+			ready <- err
+			return
+		}
+		t.window = mw
+		ready <- nil
+
+		var msg MSG
+		for GetMessage(&msg, 0, 0, 0) > 0 {
+			TranslateMessage(&msg)
+			DispatchMessage(&msg)
+		}
+		mw.Destroy()
+	}()
+
+	if err := <-ready; err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Handle returns the message window HWND.
+func (t *MessageThread) Handle() syscall.Handle { return t.window.Handle() }
+
+// Do runs fn on the message thread and waits for it to finish.
+func (t *MessageThread) Do(fn func()) {
+	finished := make(chan struct{})
+	t.queue <- func() {
+		defer close(finished)
+		fn()
+	}
+	if err := PostMessage(t.window.Handle(), wmRunQueue, 0, 0); err != nil {
+		// Thread kapanmış; fn çalışmayacak
+		<-t.done
+		return
+	}
+	select {
+	case <-finished:
+	case <-t.done:
+	}
+}
+
+// Close stops the message loop and destroys the window.
+func (t *MessageThread) Close() {
+	t.Do(func() { PostQuitMessage(0) })
+	<-t.done
+}
+
+// drain, kuyruktaki tüm fonksiyonları çalıştırır.
+func (t *MessageThread) drain() {
+	for {
+		select {
+		case fn := <-t.queue:
+			fn()
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,120 @@
+//go:build windows
+
+package windows
+
+import (
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// ============================================================================
+// GİZLİ MESAJ PENCERESİ (Message Window)
+// Tray ikonu, global kısayollar, güç/oturum bildirimleri gibi birçok Win32
+// özelliği olaylarını bir pencereye mesaj olarak gönderir. Bu olaylar için
+// kullanıcıya hiç gösterilmeyen, yalnızca mesaj almak amacıyla oluşturulmuş
+// bir pencere kullanılır.
+//
+// HWND_MESSAGE (message-only) yerine gizli bir üst seviye pencere tercih
+// edilir; çünkü WM_POWERBROADCAST, WM_SETTINGCHANGE ve "TaskbarCreated" gibi
+// broadcast mesajlar message-only pencerelere ulaşmaz.
+//
+// Pencere oluşturulduğu thread'in mesaj döngüsüne bağlıdır; bu yüzden UI
+// thread'inde oluşturulmalıdır.
+// ============================================================================
+
+// MessageHandler, bir mesaj penceresine gelen mesajları işler.
+// handled false dönerse mesaj DefWindowProc'a iletilir.
+type MessageHandler func(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) (result uintptr, handled bool)
+
+// MessageWindow, olay almak için kullanılan gizli penceredir.
+type MessageWindow struct {
+	hwnd    syscall.Handle
+	handler MessageHandler
+}
+
+const messageWindowClass = "GomadMessageWindowClass"
+
+var (
+	msgWindows   = make(map[syscall.Handle]*MessageWindow)
+	msgWindowsMu sync.RWMutex
+
+	msgClassOnce sync.Once
+	msgClassErr  error
+
+	msgWndProcCallback = syscall.NewCallback(msgWndProc)
+)
+
+// NewMessageWindow creates a hidden window that dispatches messages to handler.
+// -----------------------------------------------------------------------------
+// name yalnızca hata ayıklama araçlarında (Spy++ vb.) görünür.
+func NewMessageWindow(name string, handler MessageHandler) (*MessageWindow, error) {
+	hInstance := GetModuleHandle(nil)
+
+	msgClassOnce.Do(func() {
+		wc := WNDCLASSEX{
+			LpfnWndProc:   msgWndProcCallback,
+			HInstance:     hInstance,
+			LpszClassName: UTF16PtrFromString(messageWindowClass),
+		}
+		wc.CbSize = uint32(unsafe.Sizeof(wc))
+		if _, err := RegisterClassEx(&wc); err != nil && err.Error() != "Class already exists." {
+			msgClassErr = err
+		}
+	})
+	if msgClassErr != nil {
+		return nil, msgClassErr
+	}
+
+	mw := &MessageWindow{handler: handler}
+
+	hwnd, err := CreateWindowEx(
+		0,
+		UTF16PtrFromString(messageWindowClass),
+		UTF16PtrFromString(name),
+		WS_OVERLAPPED,
+		0, 0, 0, 0,
+		0, 0, hInstance,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	mw.hwnd = hwnd
+	msgWindowsMu.Lock()
+	msgWindows[hwnd] = mw
+	msgWindowsMu.Unlock()
+
+	return mw, nil
+}
+
+// Handle returns the native HWND.
+func (mw *MessageWindow) Handle() syscall.Handle { return mw.hwnd }
+
+// Destroy destroys the hidden window.
+func (mw *MessageWindow) Destroy() {
+	if mw.hwnd == 0 {
+		return
+	}
+	msgWindowsMu.Lock()
+	delete(msgWindows, mw.hwnd)
+	msgWindowsMu.Unlock()
+
+	DestroyWindow(mw.hwnd)
+	mw.hwnd = 0
+}
+
+// msgWndProc, tüm mesaj pencereleri için ortak window procedure'dır.
+func msgWndProc(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) uintptr {
+	msgWindowsMu.RLock()
+	mw, ok := msgWindows[hwnd]
+	msgWindowsMu.RUnlock()
+
+	if ok && mw.handler != nil {
+		if ret, handled := mw.handler(hwnd, msg, wParam, lParam); handled {
+			return ret
+		}
+	}
+	return DefWindowProc(hwnd, msg, wParam, lParam)
+}
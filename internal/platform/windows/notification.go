@@ -0,0 +1,124 @@
+//go:build windows
+
+package windows
+
+import (
+	"errors"
+	"syscall"
+	"unsafe"
+
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// ============================================================================
+// BALLOON NOTIFICATIONS
+// Shell_NotifyIconW(NIF_INFO) ile tray ikonuna bağlı bildirimler. Windows 10
+// ve sonrasında bunlar toast olarak görünür ve Action Center'da listelenir.
+// WinRT toast API'sinin aksine uygulamanın AUMID/kısayol ile kayıtlı olmasını
+// gerektirmez; karşılığında aksiyon butonları desteklenmez.
+// ============================================================================
+
+var _ platform.Notifier = (*Tray)(nil)
+
+// Balon olayları (NOTIFYICON_VERSION 0 → lParam'da gelir)
+const (
+	NIN_BALLOONSHOW      = WM_USER + 2
+	NIN_BALLOONHIDE      = WM_USER + 3
+	NIN_BALLOONTIMEOUT   = WM_USER + 4
+	NIN_BALLOONUSERCLICK = WM_USER + 5
+)
+
+// DwInfoFlags
+const (
+	NIIF_NONE       = 0x00000000
+	NIIF_INFO       = 0x00000001
+	NIIF_USER       = 0x00000004
+	NIIF_LARGE_ICON = 0x00000020
+)
+
+// ShowNotification implements platform.Notifier.
+// Aynı anda tek balon görünür; yenisi öncekinin yerini alır (önceki için
+// dismiss bildirilir). Actions yok sayılır.
+func (t *Tray) ShowNotification(n *platform.Notification) error {
+	if t.window == nil {
+		return errors.New("tray icon has been removed")
+	}
+
+	var icon uintptr
+	if len(n.Icon) > 0 {
+		h, err := IconFromICO(n.Icon)
+		if err != nil {
+			return err
+		}
+		icon = uintptr(h)
+	}
+
+	var nid NOTIFYICONDATA
+	nid.CbSize = uint32(unsafe.Sizeof(nid))
+	nid.HWnd = t.window.Handle()
+	nid.UID = t.id
+	nid.UFlags = NIF_INFO
+	// NIIF_USER: HBalloonIcon boşsa tray ikonu kullanılır
+	nid.DwInfoFlags = NIIF_USER | NIIF_LARGE_ICON
+	nid.HBalloonIcon = syscall.Handle(icon)
+	copyUTF16(nid.SzInfoTitle[:], n.Title)
+	copyUTF16(nid.SzInfo[:], n.Body)
+
+	t.mu.Lock()
+	previous := t.balloonID
+	onDismiss := t.onBalloonDismiss
+	t.balloonID = n.ID
+	oldIcon := t.balloonIcon
+	t.balloonIcon = nid.HBalloonIcon
+	t.mu.Unlock()
+
+	ret, _, err := procShellNotifyIconW.Call(NIM_MODIFY, uintptr(unsafe.Pointer(&nid)))
+	if oldIcon != 0 {
+		procDestroyIcon.Call(uintptr(oldIcon))
+	}
+	if ret == 0 {
+		return err
+	}
+
+	if previous != "" && onDismiss != nil {
+		onDismiss(previous)
+	}
+	return nil
+}
+
+// OnNotificationClick implements platform.Notifier.
+func (t *Tray) OnNotificationClick(callback func(id, action string)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.onBalloonClick = callback
+}
+
+// OnNotificationDismiss implements platform.Notifier.
+func (t *Tray) OnNotificationDismiss(callback func(id string)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.onBalloonDismiss = callback
+}
+
+// handleBalloon, balon olaylarını callback'lere çevirir.
+func (t *Tray) handleBalloon(event uint32) {
+	t.mu.Lock()
+	id := t.balloonID
+	t.balloonID = ""
+	onClick := t.onBalloonClick
+	onDismiss := t.onBalloonDismiss
+	t.mu.Unlock()
+
+	if id == "" {
+		return
+	}
+	if event == NIN_BALLOONUSERCLICK {
+		if onClick != nil {
+			onClick(id, "")
+		}
+		return
+	}
+	if onDismiss != nil {
+		onDismiss(id)
+	}
+}
@@ -0,0 +1,124 @@
+//go:build windows
+
+package windows
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// ============================================================================
+// PENCERE YERLEŞİMİ
+// Yerleşim GetWindowPlacement/SetWindowPlacement ile okunur ve yazılır; bu
+// çift büyütülmüş pencerenin normal sınırlarını da taşır. API'nin sınırları
+// "çalışma alanı" koordinatlarındadır (görev çubuğu sol/üstteyse ekran
+// koordinatlarından kayıktır); dışarıya ekran koordinatları verilir ve
+// dönüşüm pencerenin monitörüne göre yapılır.
+// ============================================================================
+
+var _ platform.PlacementHost = (*Window)(nil)
+
+var (
+	procGetWindowPlacement = user32.NewProc("GetWindowPlacement")
+	procSetWindowPlacement = user32.NewProc("SetWindowPlacement")
+)
+
+// placementMinVisible, sınırların bir monitörde "görünür" sayılması için
+// kesişimin en az genişlik ve yüksekliğidir; başlık çubuğu tutulabilmelidir.
+const placementMinVisible = 48
+
+// WINDOWPLACEMENT: Get/SetWindowPlacement yapısı
+type WINDOWPLACEMENT struct {
+	Length           uint32
+	Flags            uint32
+	ShowCmd          uint32
+	PtMinPosition    POINT
+	PtMaxPosition    POINT
+	RcNormalPosition RECT
+}
+
+// Placement returns the window's normal bounds, maximized state and monitor.
+func (w *Window) Placement() platform.Placement {
+	wp := WINDOWPLACEMENT{}
+	wp.Length = uint32(unsafe.Sizeof(wp))
+	procGetWindowPlacement.Call(uintptr(w.hwnd), uintptr(unsafe.Pointer(&wp)))
+
+	hmon, _, _ := procMonitorFromWindow.Call(uintptr(w.hwnd), MONITOR_DEFAULTTONEAREST)
+	info := MONITORINFOEX{}
+	info.CbSize = uint32(unsafe.Sizeof(info))
+	procGetMonitorInfoW.Call(hmon, uintptr(unsafe.Pointer(&info)))
+
+	r := wp.RcNormalPosition
+	dx, dy := info.RcWork.Left-info.RcMonitor.Left, info.RcWork.Top-info.RcMonitor.Top
+	return platform.Placement{
+		X:         int(r.Left + dx),
+		Y:         int(r.Top + dy),
+		Width:     int(r.Width()),
+		Height:    int(r.Height()),
+		Maximized: IsZoomed(w.hwnd) || wp.ShowCmd == SW_SHOWMAXIMIZED,
+		Monitor:   syscall.UTF16ToString(info.SzDevice[:]),
+	}
+}
+
+// SetPlacement moves the window to p, fitting it into p's monitor.
+func (w *Window) SetPlacement(p platform.Placement) bool {
+	if p.Width <= 0 || p.Height <= 0 {
+		return false
+	}
+	r := RECT{Left: int32(p.X), Top: int32(p.Y), Right: int32(p.X + p.Width), Bottom: int32(p.Y + p.Height)}
+
+	var target *Monitor
+	monitors := EnumMonitors()
+	for i := range monitors {
+		if monitors[i].Device == p.Monitor {
+			target = &monitors[i]
+			break
+		}
+	}
+	if target == nil {
+		for i := range monitors {
+			if visibleOn(r, monitors[i].Work) {
+				target = &monitors[i]
+				break
+			}
+		}
+	}
+	if target == nil {
+		return false
+	}
+	r = fitRect(r, target.Work)
+
+	// Ekran → çalışma alanı koordinatları
+	dx, dy := target.Work.Left-target.Bounds.Left, target.Work.Top-target.Bounds.Top
+	r = RECT{Left: r.Left - dx, Top: r.Top - dy, Right: r.Right - dx, Bottom: r.Bottom - dy}
+
+	wp := WINDOWPLACEMENT{ShowCmd: SW_SHOWNORMAL, RcNormalPosition: r}
+	wp.Length = uint32(unsafe.Sizeof(wp))
+	switch {
+	case !IsWindowVisible(w.hwnd):
+		// Gizli başlatılan pencere görünür hâle gelmesin; büyütme gösterilince kaybolur
+		wp.ShowCmd = SW_HIDE
+	case p.Maximized:
+		wp.ShowCmd = SW_SHOWMAXIMIZED
+	}
+	ret, _, _ := procSetWindowPlacement.Call(uintptr(w.hwnd), uintptr(unsafe.Pointer(&wp)))
+	return ret != 0
+}
+
+// visibleOn, r'nin area ile yeterince kesişip kesişmediğini söyler.
+func visibleOn(r, area RECT) bool {
+	width := min(r.Right, area.Right) - max(r.Left, area.Left)
+	height := min(r.Bottom, area.Bottom) - max(r.Top, area.Top)
+	return width >= min(placementMinVisible, r.Width()) && height >= min(placementMinVisible, r.Height())
+}
+
+// fitRect, r'yi area'ya sığdırır: önce boyut küçültülür, sonra dikdörtgen
+// alanın içine kaydırılır.
+func fitRect(r, area RECT) RECT {
+	width, height := min(r.Width(), area.Width()), min(r.Height(), area.Height())
+	left := max(area.Left, min(r.Left, area.Right-width))
+	top := max(area.Top, min(r.Top, area.Bottom-height))
+	return RECT{Left: left, Top: top, Right: left + width, Bottom: top + height}
+}
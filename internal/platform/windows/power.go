@@ -0,0 +1,53 @@
+//go:build windows
+
+package windows
+
+import (
+	"unsafe"
+)
+
+// ============================================================================
+// POWER STATUS
+// Güç kaynağı/pil durumu ve WM_POWERBROADCAST olayları. Olaylar yalnızca
+// üst seviye pencerelere gönderilir (bkz. MessageWindow).
+// ============================================================================
+
+var procGetSystemPowerStatus = kernel32.NewProc("GetSystemPowerStatus")
+
+const WM_POWERBROADCAST = 0x0218
+
+// WM_POWERBROADCAST wParam değerleri
+const (
+	PBT_APMSUSPEND           = 0x0004
+	PBT_APMRESUMESUSPEND     = 0x0007
+	PBT_APMPOWERSTATUSCHANGE = 0x000A
+	PBT_APMRESUMEAUTOMATIC   = 0x0012
+)
+
+// SYSTEM_POWER_STATUS.BatteryFlag değerleri
+const (
+	BATTERY_FLAG_NO_BATTERY = 128
+	BATTERY_FLAG_UNKNOWN    = 255
+)
+
+// SYSTEM_POWER_STATUS: GetSystemPowerStatus çıktısı
+type SYSTEM_POWER_STATUS struct {
+	ACLineStatus        byte // 0 = pil, 1 = AC, 255 = bilinmiyor
+	BatteryFlag         byte
+	BatteryLifePercent  byte // 0-100, 255 = bilinmiyor
+	SystemStatusFlag    byte
+	BatteryLifeTime     uint32
+	BatteryFullLifeTime uint32
+}
+
+/*
+GetSystemPowerStatus → Anlık güç kaynağı ve pil durumunu okur.
+*/
+func GetSystemPowerStatus() (SYSTEM_POWER_STATUS, error) {
+	var status SYSTEM_POWER_STATUS
+	ret, _, err := procGetSystemPowerStatus.Call(uintptr(unsafe.Pointer(&status)))
+	if ret == 0 {
+		return status, err
+	}
+	return status, nil
+}
@@ -0,0 +1,181 @@
+//go:build windows
+
+package windows
+
+import (
+	"runtime"
+	"syscall"
+	"unsafe"
+)
+
+// ============================================================================
+// YAZDIRMA
+// Yazıcı listesi ve kuyruk durumu winspool üzerinden okunur. Dosyalar,
+// dosya türünün kayıtlı uygulamasının "printto" shell fiili ile gönderilir
+// (PDF için varsayılan PDF görüntüleyici); bu yüzden iş kimliği doğrudan
+// alınamaz ve kuyruğa doküman adıyla bakılır.
+// ============================================================================
+
+var (
+	winspool               = syscall.NewLazyDLL("winspool.drv")
+	procEnumPrintersW      = winspool.NewProc("EnumPrintersW")
+	procGetDefaultPrinterW = winspool.NewProc("GetDefaultPrinterW")
+	procOpenPrinterW       = winspool.NewProc("OpenPrinterW")
+	procClosePrinter       = winspool.NewProc("ClosePrinter")
+	procEnumJobsW          = winspool.NewProc("EnumJobsW")
+	procShellExecuteW      = shell32.NewProc("ShellExecuteW")
+)
+
+const (
+	PRINTER_ENUM_LOCAL       = 0x00000002
+	PRINTER_ENUM_CONNECTIONS = 0x00000004
+
+	JOB_STATUS_PAUSED   = 0x00000001
+	JOB_STATUS_ERROR    = 0x00000002
+	JOB_STATUS_PRINTING = 0x00000010
+	JOB_STATUS_OFFLINE  = 0x00000020
+	JOB_STATUS_PAPEROUT = 0x00000040
+	JOB_STATUS_PRINTED  = 0x00000080
+	JOB_STATUS_BLOCKED  = 0x00000200
+
+	ERROR_INSUFFICIENT_BUFFER syscall.Errno = 122
+)
+
+// PRINTER_INFO_4: EnumPrintersW level 4 yapısı
+type PRINTER_INFO_4 struct {
+	PPrinterName *uint16
+	PServerName  *uint16
+	Attributes   uint32
+}
+
+// SYSTEMTIME: Win32 tarih/saat yapısı
+type SYSTEMTIME struct {
+	Year, Month, DayOfWeek, Day, Hour, Minute, Second, Milliseconds uint16
+}
+
+// JOB_INFO_1: EnumJobsW level 1 yapısı
+type JOB_INFO_1 struct {
+	JobId        uint32
+	PPrinterName *uint16
+	PMachineName *uint16
+	PUserName    *uint16
+	PDocument    *uint16
+	PDatatype    *uint16
+	PStatus      *uint16
+	Status       uint32
+	Priority     uint32
+	Position     uint32
+	TotalPages   uint32
+	PagesPrinted uint32
+	Submitted    SYSTEMTIME
+}
+
+// PrintJob, kuyruktaki bir işin özetidir.
+type PrintJob struct {
+	ID       uint32
+	Document string
+	Status   uint32
+}
+
+/*
+EnumPrinters → Yerel ve ağ bağlantılı yazıcıların adlarını döner.
+*/
+func EnumPrinters() ([]string, error) {
+	flags := uintptr(PRINTER_ENUM_LOCAL | PRINTER_ENUM_CONNECTIONS)
+	var needed, count uint32
+	procEnumPrintersW.Call(flags, 0, 4, 0, 0, uintptr(unsafe.Pointer(&needed)), uintptr(unsafe.Pointer(&count)))
+	if needed == 0 {
+		return nil, nil
+	}
+
+	buf := make([]byte, needed)
+	ret, _, err := procEnumPrintersW.Call(flags, 0, 4,
+		uintptr(unsafe.Pointer(&buf[0])), uintptr(needed),
+		uintptr(unsafe.Pointer(&needed)), uintptr(unsafe.Pointer(&count)))
+	if ret == 0 {
+		return nil, err
+	}
+
+	infos := unsafe.Slice((*PRINTER_INFO_4)(unsafe.Pointer(&buf[0])), count)
+	names := make([]string, 0, count)
+	for _, info := range infos {
+		names = append(names, UTF16ToString(info.PPrinterName))
+	}
+	runtime.KeepAlive(buf)
+	return names, nil
+}
+
+/*
+DefaultPrinter → Varsayılan yazıcının adını döner; yoksa "".
+*/
+func DefaultPrinter() string {
+	var size uint32
+	procGetDefaultPrinterW.Call(0, uintptr(unsafe.Pointer(&size)))
+	if size == 0 {
+		return ""
+	}
+	buf := make([]uint16, size)
+	ret, _, _ := procGetDefaultPrinterW.Call(uintptr(unsafe.Pointer(&buf[0])), uintptr(unsafe.Pointer(&size)))
+	if ret == 0 {
+		return ""
+	}
+	return syscall.UTF16ToString(buf)
+}
+
+/*
+EnumJobs → Yazıcı kuyruğundaki işleri döner.
+*/
+func EnumJobs(printer string) ([]PrintJob, error) {
+	var h syscall.Handle
+	ret, _, err := procOpenPrinterW.Call(uintptr(unsafe.Pointer(UTF16PtrFromString(printer))), uintptr(unsafe.Pointer(&h)), 0)
+	if ret == 0 {
+		return nil, err
+	}
+	defer procClosePrinter.Call(uintptr(h))
+
+	const maxJobs = 256
+	var needed, count uint32
+	procEnumJobsW.Call(uintptr(h), 0, maxJobs, 1, 0, 0, uintptr(unsafe.Pointer(&needed)), uintptr(unsafe.Pointer(&count)))
+	if needed == 0 {
+		return nil, nil
+	}
+
+	buf := make([]byte, needed)
+	ret, _, err = procEnumJobsW.Call(uintptr(h), 0, maxJobs, 1,
+		uintptr(unsafe.Pointer(&buf[0])), uintptr(needed),
+		uintptr(unsafe.Pointer(&needed)), uintptr(unsafe.Pointer(&count)))
+	if ret == 0 {
+		return nil, err
+	}
+
+	infos := unsafe.Slice((*JOB_INFO_1)(unsafe.Pointer(&buf[0])), count)
+	jobs := make([]PrintJob, 0, count)
+	for _, info := range infos {
+		jobs = append(jobs, PrintJob{
+			ID:       info.JobId,
+			Document: UTF16ToString(info.PDocument),
+			Status:   info.Status,
+		})
+	}
+	runtime.KeepAlive(buf)
+	return jobs, nil
+}
+
+/*
+ShellPrintTo → Dosyayı kayıtlı uygulamanın "printto" fiiliyle yazıcıya gönderir.
+*/
+func ShellPrintTo(path, printer string) error {
+	ret, _, _ := procShellExecuteW.Call(
+		0,
+		uintptr(unsafe.Pointer(UTF16PtrFromString("printto"))),
+		uintptr(unsafe.Pointer(UTF16PtrFromString(path))),
+		uintptr(unsafe.Pointer(UTF16PtrFromString(`"`+printer+`"`))),
+		0,
+		SW_HIDE,
+	)
+	// ShellExecute 32'den büyük değerde başarılıdır; küçük değerler hata kodudur
+	if ret <= 32 {
+		return syscall.Errno(ret)
+	}
+	return nil
+}
@@ -75,11 +75,13 @@ var (
 	procIsWindowVisible      = user32.NewProc("IsWindowVisible")
 	procIsIconic             = user32.NewProc("IsIconic")
 	procIsZoomed             = user32.NewProc("IsZoomed")
+	procSetForegroundWindow  = user32.NewProc("SetForegroundWindow")
 	procGetMessageW          = user32.NewProc("GetMessageW")
 	procTranslateMessage     = user32.NewProc("TranslateMessage")
 	procDispatchMessageW     = user32.NewProc("DispatchMessageW")
 	procPostQuitMessage      = user32.NewProc("PostQuitMessage")
 	procDefWindowProcW       = user32.NewProc("DefWindowProcW")
+	procCallWindowProcW      = user32.NewProc("CallWindowProcW")
 	procSendMessageW         = user32.NewProc("SendMessageW")
 	procPostMessageW         = user32.NewProc("PostMessageW")
 	procLoadCursorW          = user32.NewProc("LoadCursorW")
@@ -105,7 +107,7 @@ Neden gerekli? Çünkü Windows’ta pencere açmadan önce sınıf bilgisi kay
 Başarılıysa atom-id döndürür, aksi durumda error taşır.
 */
 func RegisterClassEx(wc *WNDCLASSEX) (uint16, error) {
-	ret, _, err := procRegisterClassExW.Call(uintptr(syscall.Pointer(wc)))
+	ret, _, err := procRegisterClassExW.Call(uintptr(unsafe.Pointer(wc)))
 	if ret == 0 {
 		return 0, err
 	}
@@ -166,6 +168,40 @@ func ShowWindow(hwnd syscall.Handle, cmdShow int32) bool {
 	return ret != 0
 }
 
+/*
+IsWindowVisible → Pencerenin WS_VISIBLE stiline sahip olup olmadığını döner.
+*/
+func IsWindowVisible(hwnd syscall.Handle) bool {
+	ret, _, _ := procIsWindowVisible.Call(uintptr(hwnd))
+	return ret != 0
+}
+
+/*
+IsIconic → Pencere minimize edilmiş mi?
+*/
+func IsIconic(hwnd syscall.Handle) bool {
+	ret, _, _ := procIsIconic.Call(uintptr(hwnd))
+	return ret != 0
+}
+
+/*
+IsZoomed → Pencere büyütülmüş (maximize) mi?
+*/
+func IsZoomed(hwnd syscall.Handle) bool {
+	ret, _, _ := procIsZoomed.Call(uintptr(hwnd))
+	return ret != 0
+}
+
+/*
+SetForegroundWindow → Pencereyi öne getirir ve klavye odağını verir.
+Windows, yalnızca ön plandaki süreçlerin bunu yapmasına izin verir
+(ör. tray tıklamasından hemen sonra).
+*/
+func SetForegroundWindow(hwnd syscall.Handle) bool {
+	ret, _, _ := procSetForegroundWindow.Call(uintptr(hwnd))
+	return ret != 0
+}
+
 /*
 UpdateWindow → Client rect yeniden çizilir. Redraw, refresh mekanizmasıdır.
 */
@@ -294,6 +330,45 @@ func DefWindowProc(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) uint
 	return ret
 }
 
+/*
+CallWindowProc → Subclass edilmiş pencerede mesajı orijinal procedure'a iletir.
+Başka bir bileşenin oluşturduğu pencereyi sahiplenirken zincirin kopmaması için gereklidir.
+*/
+func CallWindowProc(prevProc uintptr, hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) uintptr {
+	ret, _, _ := procCallWindowProcW.Call(
+		prevProc,
+		uintptr(hwnd),
+		uintptr(msg),
+		wParam,
+		lParam,
+	)
+	return ret
+}
+
+/*
+SetWindowLongPtr → Pencere özniteliğini (stil, wndproc vb.) değiştirir, önceki değeri döner.
+Önceki değer 0 olabileceğinden hata, GetLastError ile ayrıca kontrol edilir.
+*/
+func SetWindowLongPtr(hwnd syscall.Handle, index int32, value uintptr) (uintptr, error) {
+	ret, _, err := procSetWindowLongPtrW.Call(
+		uintptr(hwnd),
+		uintptr(index),
+		value,
+	)
+	if ret == 0 && err != nil && err.(syscall.Errno) != 0 {
+		return 0, err
+	}
+	return ret, nil
+}
+
+/*
+GetWindowLongPtr → Pencere özniteliğini okur (GWL_STYLE, GWL_EXSTYLE ...).
+*/
+func GetWindowLongPtr(hwnd syscall.Handle, index int32) uintptr {
+	ret, _, _ := procGetWindowLongPtrW.Call(uintptr(hwnd), uintptr(index))
+	return ret
+}
+
 /*
 PostQuitMessage → Mesaj kuyruğuna çıkış mesajı gönderir.
 MessageLoop'u sonlandırmak için kullanılır.
@@ -332,6 +407,18 @@ func DispatchMessage(msg *MSG) uintptr {
 	return ret
 }
 
+/*
+PostMessage → Mesajı pencerenin thread kuyruğuna bırakır ve beklemeden döner.
+Başka thread'lerdeki pencerelere güvenle mesaj göndermenin yoludur.
+*/
+func PostMessage(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) error {
+	ret, _, err := procPostMessageW.Call(uintptr(hwnd), uintptr(msg), wParam, lParam)
+	if ret == 0 {
+		return err
+	}
+	return nil
+}
+
 /*
 LoadCursor → Sistem imleçlerini yüklemek için kullanılır (Arrow, Hand vb.)
 */
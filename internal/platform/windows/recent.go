@@ -0,0 +1,54 @@
+//go:build windows
+
+package windows
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// ============================================================================
+// SON KULLANILAN DOKÜMANLAR
+// SHAddToRecentDocs, dosyayı kullanıcının "Recent" listesine ve dosya türü
+// uygulamaya kayıtlıysa görev çubuğu jump list'inin "Recent" kategorisine
+// ekler. Temizleme, yalnızca bu uygulamanın listesini silen
+// IApplicationDestinations ile yapılır.
+// ============================================================================
+
+var procSHAddToRecentDocs = shell32.NewProc("SHAddToRecentDocs")
+
+const SHARD_PATHW = 0x00000003
+
+var (
+	clsidApplicationDestinations = GUID{0x86C14003, 0x4D6B, 0x4EF3, [8]byte{0xA7, 0xB4, 0x05, 0x06, 0x66, 0x3B, 0x2E, 0x68}}
+	iidIApplicationDestinations  = GUID{0x12337D35, 0x94C6, 0x48A0, [8]byte{0xBC, 0xE7, 0x6A, 0x9C, 0x69, 0xD4, 0xD6, 0x00}}
+)
+
+// IApplicationDestinations vtable indeksleri (IUnknown'dan sonra)
+const appDestRemoveAllDestinations = 5
+
+/*
+AddRecentDocument → Dosyayı sistemin son kullanılanlar listesine ekler.
+*/
+func AddRecentDocument(path string) {
+	p := UTF16PtrFromString(path)
+	procSHAddToRecentDocs.Call(SHARD_PATHW, uintptr(unsafe.Pointer(p)))
+	runtime.KeepAlive(p)
+}
+
+/*
+ClearRecentDocuments → Uygulamanın jump list "Recent" kategorisini temizler.
+COM kullandığı için çağıran goroutine OS thread'ine kilitli olmalıdır.
+*/
+func ClearRecentDocuments() error {
+	uninit := CoInitialize()
+	defer uninit()
+
+	dest, err := coCreate(&clsidApplicationDestinations, &iidIApplicationDestinations)
+	if err != nil {
+		return err
+	}
+	defer dest.Release()
+
+	return hresult("RemoveAllDestinations", dest.call(appDestRemoveAllDestinations))
+}
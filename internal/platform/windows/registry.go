@@ -0,0 +1,159 @@
+//go:build windows
+
+package windows
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// ============================================================================
+// REGISTRY
+// HKEY_CURRENT_USER altındaki string değerler için minimal sarmalayıcılar
+// (başlangıçta çalıştırma, dosya ilişkilendirme gibi kullanıcı ayarları).
+// ============================================================================
+
+var (
+	advapi32             = syscall.NewLazyDLL("advapi32.dll")
+	procRegCreateKeyExW  = advapi32.NewProc("RegCreateKeyExW")
+	procRegSetValueExW   = advapi32.NewProc("RegSetValueExW")
+	procRegQueryValueExW = advapi32.NewProc("RegQueryValueExW")
+	procRegDeleteValueW  = advapi32.NewProc("RegDeleteValueW")
+	procRegCloseKey      = advapi32.NewProc("RegCloseKey")
+)
+
+const (
+	HKEY_CURRENT_USER syscall.Handle = 0x80000001
+
+	KEY_READ  = 0x20019
+	KEY_WRITE = 0x20006
+
+	REG_SZ    = 1
+	REG_DWORD = 4
+
+	ERROR_FILE_NOT_FOUND   syscall.Errno = 2
+	ERROR_INVALID_DATATYPE syscall.Errno = 1804
+)
+
+// openKey, HKCU altındaki anahtarı açar (yoksa oluşturur).
+func openKey(path string, access uint32) (syscall.Handle, error) {
+	var key syscall.Handle
+	ret, _, _ := procRegCreateKeyExW.Call(
+		uintptr(HKEY_CURRENT_USER),
+		uintptr(unsafe.Pointer(UTF16PtrFromString(path))),
+		0, 0, 0,
+		uintptr(access),
+		0,
+		uintptr(unsafe.Pointer(&key)),
+		0,
+	)
+	if ret != 0 {
+		return 0, syscall.Errno(ret)
+	}
+	return key, nil
+}
+
+/*
+RegSetString → HKCU\path altına string (REG_SZ) değer yazar.
+*/
+func RegSetString(path, name, value string) error {
+	key, err := openKey(path, KEY_WRITE)
+	if err != nil {
+		return err
+	}
+	defer procRegCloseKey.Call(uintptr(key))
+
+	data, err := syscall.UTF16FromString(value)
+	if err != nil {
+		return err
+	}
+	ret, _, _ := procRegSetValueExW.Call(
+		uintptr(key),
+		uintptr(unsafe.Pointer(UTF16PtrFromString(name))),
+		0,
+		REG_SZ,
+		uintptr(unsafe.Pointer(&data[0])),
+		uintptr(len(data)*2),
+	)
+	if ret != 0 {
+		return syscall.Errno(ret)
+	}
+	return nil
+}
+
+/*
+RegGetString → HKCU\path altındaki string değeri okur.
+Değer yoksa ERROR_FILE_NOT_FOUND döner.
+*/
+func RegGetString(path, name string) (string, error) {
+	key, err := openKey(path, KEY_READ)
+	if err != nil {
+		return "", err
+	}
+	defer procRegCloseKey.Call(uintptr(key))
+
+	namePtr := UTF16PtrFromString(name)
+	var size uint32
+	ret, _, _ := procRegQueryValueExW.Call(uintptr(key), uintptr(unsafe.Pointer(namePtr)), 0, 0, 0, uintptr(unsafe.Pointer(&size)))
+	if ret != 0 {
+		return "", syscall.Errno(ret)
+	}
+	if size == 0 {
+		return "", nil
+	}
+
+	buf := make([]uint16, (size+1)/2)
+	ret, _, _ = procRegQueryValueExW.Call(uintptr(key), uintptr(unsafe.Pointer(namePtr)), 0, 0,
+		uintptr(unsafe.Pointer(&buf[0])), uintptr(unsafe.Pointer(&size)))
+	if ret != 0 {
+		return "", syscall.Errno(ret)
+	}
+	return syscall.UTF16ToString(buf), nil
+}
+
+/*
+RegDeleteValue → HKCU\path altındaki değeri siler. Değer yoksa hata sayılmaz.
+*/
+func RegDeleteValue(path, name string) error {
+	key, err := openKey(path, KEY_WRITE)
+	if err != nil {
+		return err
+	}
+	defer procRegCloseKey.Call(uintptr(key))
+
+	ret, _, _ := procRegDeleteValueW.Call(uintptr(key), uintptr(unsafe.Pointer(UTF16PtrFromString(name))))
+	if ret != 0 && syscall.Errno(ret) != ERROR_FILE_NOT_FOUND {
+		return syscall.Errno(ret)
+	}
+	return nil
+}
+
+/*
+RegGetDWORD → HKCU\path altındaki DWORD değeri okur.
+Değer yoksa ERROR_FILE_NOT_FOUND döner.
+*/
+func RegGetDWORD(path, name string) (uint32, error) {
+	key, err := openKey(path, KEY_READ)
+	if err != nil {
+		return 0, err
+	}
+	defer procRegCloseKey.Call(uintptr(key))
+
+	var value, valueType uint32
+	size := uint32(4)
+	ret, _, _ := procRegQueryValueExW.Call(
+		uintptr(key),
+		uintptr(unsafe.Pointer(UTF16PtrFromString(name))),
+		0,
+		uintptr(unsafe.Pointer(&valueType)),
+		uintptr(unsafe.Pointer(&value)),
+		uintptr(unsafe.Pointer(&size)),
+	)
+	if ret != 0 {
+		return 0, syscall.Errno(ret)
+	}
+	if valueType != REG_DWORD {
+		return 0, ERROR_INVALID_DATATYPE
+	}
+	return value, nil
+}
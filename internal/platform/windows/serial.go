@@ -0,0 +1,180 @@
+//go:build windows
+
+package windows
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// ============================================================================
+// SERİ PORTLAR
+// Portlar HKLM\HARDWARE\DEVICEMAP\SERIALCOMM anahtarından listelenir (değer
+// adı sürücü aygıtı, verisi "COM3"). Port \\.\COMn yoluyla açılır ve DCB ile
+// yapılandırılır. Okuma zaman aşımları, veri geldiğinde hemen dönecek ve
+// veri yoksa en çok SerialReadTimeout bekleyecek şekilde ayarlanır:
+// senkron tanıtıcıda okuma ve yazma sıraya girdiği için bekleyen bir okuma
+// yazmayı uzun süre tutmamalıdır.
+// ============================================================================
+
+var (
+	procRegOpenKeyExW   = advapi32.NewProc("RegOpenKeyExW")
+	procRegEnumValueW   = advapi32.NewProc("RegEnumValueW")
+	procGetCommState    = kernel32.NewProc("GetCommState")
+	procSetCommState    = kernel32.NewProc("SetCommState")
+	procSetCommTimeouts = kernel32.NewProc("SetCommTimeouts")
+	procPurgeComm       = kernel32.NewProc("PurgeComm")
+)
+
+const (
+	HKEY_LOCAL_MACHINE syscall.Handle = 0x80000002
+
+	ERROR_NO_MORE_ITEMS syscall.Errno = 259
+
+	NOPARITY    = 0
+	ODDPARITY   = 1
+	EVENPARITY  = 2
+	ONESTOPBIT  = 0
+	TWOSTOPBITS = 2
+
+	PURGE_TXCLEAR = 0x0004
+	PURGE_RXCLEAR = 0x0008
+
+	// DCB bit alanları
+	dcbBinary          = 1 << 0
+	dcbParity          = 1 << 1
+	dcbDtrControlMask  = 3 << 4
+	dcbDtrControlOn    = 1 << 4
+	dcbRtsControlMask  = 3 << 12
+	dcbRtsControlOn    = 1 << 12
+	dcbFlowControlMask = 1<<2 | 1<<3 | 1<<6 | 1<<8 | 1<<9 // fOutxCtsFlow, fOutxDsrFlow, fDsrSensitivity, fOutX, fInX
+
+	// SerialReadTimeout, veri beklenirken bir okumanın en uzun süresidir (ms).
+	SerialReadTimeout = 50
+
+	serialCommKey = `HARDWARE\DEVICEMAP\SERIALCOMM`
+)
+
+// DCB: seri port ayarları
+type DCB struct {
+	DCBlength  uint32
+	BaudRate   uint32
+	Flags      uint32
+	WReserved  uint16
+	XonLim     uint16
+	XoffLim    uint16
+	ByteSize   byte
+	Parity     byte
+	StopBits   byte
+	XonChar    byte
+	XoffChar   byte
+	ErrorChar  byte
+	EofChar    byte
+	EvtChar    byte
+	WReserved1 uint16
+}
+
+// COMMTIMEOUTS: seri port okuma/yazma zaman aşımları (ms)
+type COMMTIMEOUTS struct {
+	ReadIntervalTimeout         uint32
+	ReadTotalTimeoutMultiplier  uint32
+	ReadTotalTimeoutConstant    uint32
+	WriteTotalTimeoutMultiplier uint32
+	WriteTotalTimeoutConstant   uint32
+}
+
+// SerialPort, kayıtlı bir seri porttur.
+type SerialPort struct {
+	Name   string // "COM3"
+	Device string // Sürücü aygıtı, ör. \Device\USBSER000
+}
+
+/*
+SerialPorts → Sistemdeki seri portları döner.
+*/
+func SerialPorts() []SerialPort {
+	var key syscall.Handle
+	ret, _, _ := procRegOpenKeyExW.Call(
+		uintptr(HKEY_LOCAL_MACHINE),
+		uintptr(unsafe.Pointer(UTF16PtrFromString(serialCommKey))),
+		0, KEY_READ,
+		uintptr(unsafe.Pointer(&key)),
+	)
+	if ret != 0 {
+		return nil // Anahtar yalnızca port varken bulunur
+	}
+	defer procRegCloseKey.Call(uintptr(key))
+
+	var ports []SerialPort
+	for i := 0; ; i++ {
+		name := make([]uint16, 256)
+		data := make([]uint16, 256)
+		nameLen, dataLen := uint32(len(name)), uint32(len(data)*2)
+		var valueType uint32
+		ret, _, _ := procRegEnumValueW.Call(
+			uintptr(key), uintptr(i),
+			uintptr(unsafe.Pointer(&name[0])), uintptr(unsafe.Pointer(&nameLen)),
+			0, uintptr(unsafe.Pointer(&valueType)),
+			uintptr(unsafe.Pointer(&data[0])), uintptr(unsafe.Pointer(&dataLen)),
+		)
+		if syscall.Errno(ret) == ERROR_NO_MORE_ITEMS {
+			break
+		}
+		if ret != 0 || valueType != REG_SZ {
+			continue
+		}
+		ports = append(ports, SerialPort{
+			Name:   syscall.UTF16ToString(data),
+			Device: syscall.UTF16ToString(name[:nameLen]),
+		})
+	}
+	return ports
+}
+
+/*
+OpenSerial → COM portunu özel erişimle açar. Tanıtıcı CloseHandle ile
+kapatılmalıdır.
+*/
+func OpenSerial(name string) (syscall.Handle, error) {
+	p, err := syscall.UTF16PtrFromString(`\\.\` + name)
+	if err != nil {
+		return syscall.InvalidHandle, err
+	}
+	return syscall.CreateFile(p, syscall.GENERIC_READ|syscall.GENERIC_WRITE, 0, nil, syscall.OPEN_EXISTING, 0, 0)
+}
+
+/*
+ConfigureSerial → Portu verilen hız, veri biti, parite (NOPARITY,
+ODDPARITY, EVENPARITY) ve durma bitiyle (ONESTOPBIT, TWOSTOPBITS) ikili
+modda, akış kontrolü olmadan yapılandırır; DTR ve RTS açılır.
+*/
+func ConfigureSerial(h syscall.Handle, baud uint32, dataBits, parity, stopBits byte) error {
+	dcb := new(DCB)
+	dcb.DCBlength = uint32(unsafe.Sizeof(*dcb))
+	if ret, _, err := procGetCommState.Call(uintptr(h), uintptr(unsafe.Pointer(dcb))); ret == 0 {
+		return err
+	}
+	dcb.BaudRate = baud
+	dcb.ByteSize, dcb.Parity, dcb.StopBits = dataBits, parity, stopBits
+	dcb.Flags &^= dcbParity | dcbDtrControlMask | dcbRtsControlMask | dcbFlowControlMask
+	dcb.Flags |= dcbBinary | dcbDtrControlOn | dcbRtsControlOn
+	if parity != NOPARITY {
+		dcb.Flags |= dcbParity
+	}
+	if ret, _, err := procSetCommState.Call(uintptr(h), uintptr(unsafe.Pointer(dcb))); ret == 0 {
+		return err
+	}
+
+	// MAXDWORD/MAXDWORD/sabit: bekleyen bayt varsa hemen dön, yoksa ilk
+	// baytı en çok SerialReadTimeout ms bekle
+	timeouts := &COMMTIMEOUTS{
+		ReadIntervalTimeout:        0xFFFFFFFF,
+		ReadTotalTimeoutMultiplier: 0xFFFFFFFF,
+		ReadTotalTimeoutConstant:   SerialReadTimeout,
+	}
+	if ret, _, err := procSetCommTimeouts.Call(uintptr(h), uintptr(unsafe.Pointer(timeouts))); ret == 0 {
+		return err
+	}
+	procPurgeComm.Call(uintptr(h), PURGE_RXCLEAR|PURGE_TXCLEAR)
+	return nil
+}
@@ -0,0 +1,295 @@
+//go:build windows
+
+package windows
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// ============================================================================
+// BAŞLIK ÇUBUĞU OVERLAY'İ (DWM özel çerçeve)
+// "Custom Window Frame Using DWM" yaklaşımı:
+//
+//  1. WM_NCCALCSIZE'da üst non-client alanı kaldırılır; client alanı başlık
+//     çubuğunu da kapsar (yan ve alt kenarlıklar yerinde kalır).
+//  2. DwmExtendFrameIntoClientArea ile DWM çerçevesi başlık yüksekliği kadar
+//     client alanına uzatılır; başlık düğmelerini DWM çizmeye devam eder.
+//  3. Mesajlar önce DwmDefWindowProc'a verilir; düğmelerin hover/tıklama
+//     davranışı ve Windows 11 snap menüsü böylece native kalır.
+//
+// WebView child penceresi tüm client alanını kapladığı için düğmelerin
+// üstünü örter. Child'ın bölgesinden (SetWindowRgn) düğme dikdörtgeni ve üst
+// yeniden boyutlandırma şeridi çıkarılır; bu deliklerden DWM düğmeleri
+// görünür ve fare mesajları ana pencereye ulaşır.
+// ============================================================================
+
+var _ platform.TitlebarHost = (*Window)(nil)
+
+var (
+	dwmapi = syscall.NewLazyDLL("dwmapi.dll")
+
+	procDwmExtendFrameIntoClientArea = dwmapi.NewProc("DwmExtendFrameIntoClientArea")
+	procDwmDefWindowProc             = dwmapi.NewProc("DwmDefWindowProc")
+	procDwmGetWindowAttribute        = dwmapi.NewProc("DwmGetWindowAttribute")
+
+	procClientToScreen = user32.NewProc("ClientToScreen")
+	procGetWindow      = user32.NewProc("GetWindow")
+	procSetWindowRgn   = user32.NewProc("SetWindowRgn")
+	procReleaseCapture = user32.NewProc("ReleaseCapture")
+	procCreateRectRgn  = gdi32.NewProc("CreateRectRgn")
+	procCombineRgn     = gdi32.NewProc("CombineRgn")
+)
+
+const (
+	WM_NCCALCSIZE    = 0x0083
+	WM_NCLBUTTONDOWN = 0x00A1
+	WM_SYSCOMMAND    = 0x0112
+
+	HTCLIENT  = 1
+	HTCAPTION = 2
+	HTTOP     = 12
+
+	SC_MAXIMIZE = 0xF030
+	SC_RESTORE  = 0xF120
+
+	SM_CYFRAME        = 33
+	SM_CXPADDEDBORDER = 92
+
+	GW_HWNDNEXT = 2
+	GW_CHILD    = 5
+
+	RGN_DIFF = 4
+
+	DWMWA_CAPTION_BUTTON_BOUNDS = 5
+)
+
+// MARGINS: DwmExtendFrameIntoClientArea kenar boşlukları
+type MARGINS struct {
+	Left, Right, Top, Bottom int32
+}
+
+// NCCALCSIZE_PARAMS: WM_NCCALCSIZE (wParam = TRUE) verisi
+type NCCALCSIZE_PARAMS struct {
+	Rgrc  [3]RECT
+	Lppos uintptr
+}
+
+// titlebarState, overlay açıkken hesaplanan geometridir.
+type titlebarState struct {
+	geometry platform.TitlebarGeometry
+	onChange func(platform.TitlebarGeometry)
+}
+
+// SetTitlebarOverlay implements platform.TitlebarHost.
+func (w *Window) SetTitlebarOverlay(enabled bool) error {
+	w.mu.Lock()
+	if enabled == (w.titlebar != nil) {
+		w.mu.Unlock()
+		return nil
+	}
+	if enabled {
+		w.titlebar = &titlebarState{onChange: w.onTitlebar}
+	} else {
+		w.titlebar = nil
+	}
+	w.mu.Unlock()
+
+	// Çerçeveyi yeniden hesaplat (WM_NCCALCSIZE); ardından gelen WM_SIZE
+	// geometriyi ve child bölgesini günceller
+	procSetWindowPos.Call(uintptr(w.hwnd), 0, 0, 0, 0, 0,
+		SWP_NOMOVE|SWP_NOSIZE|SWP_NOZORDER|SWP_FRAMECHANGED)
+	if !enabled {
+		margins := MARGINS{}
+		procDwmExtendFrameIntoClientArea.Call(uintptr(w.hwnd), uintptr(unsafe.Pointer(&margins)))
+		w.forEachChild(func(child syscall.Handle) {
+			procSetWindowRgn.Call(uintptr(child), 0, 1)
+		})
+		return nil
+	}
+	w.updateTitlebar()
+	return nil
+}
+
+// TitlebarGeometry implements platform.TitlebarHost.
+func (w *Window) TitlebarGeometry() (platform.TitlebarGeometry, bool) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.titlebar == nil {
+		return platform.TitlebarGeometry{}, false
+	}
+	return w.titlebar.geometry, true
+}
+
+// OnTitlebarGeometry implements platform.TitlebarHost.
+func (w *Window) OnTitlebarGeometry(callback func(platform.TitlebarGeometry)) {
+	w.mu.Lock()
+	w.onTitlebar = callback
+	if w.titlebar != nil {
+		w.titlebar.onChange = callback
+	}
+	w.mu.Unlock()
+}
+
+// DragWindow implements platform.TitlebarHost.
+// -----------------------------------------------------------------------------
+// Fare yakalaması WebView'den alınır ve pencereye başlık çubuğuna basılmış
+// gibi WM_NCLBUTTONDOWN gönderilir; sürüklemeyi Windows yürütür (Aero Snap
+// dahil).
+func (w *Window) DragWindow() error {
+	procReleaseCapture.Call()
+	return PostMessage(w.hwnd, WM_NCLBUTTONDOWN, HTCAPTION, 0)
+}
+
+// ToggleMaximize implements platform.TitlebarHost.
+func (w *Window) ToggleMaximize() {
+	cmd := uintptr(SC_MAXIMIZE)
+	if IsZoomed(w.hwnd) {
+		cmd = SC_RESTORE
+	}
+	procSendMessageW.Call(uintptr(w.hwnd), WM_SYSCOMMAND, cmd, 0)
+}
+
+// titlebarProc, overlay açıkken wndProc'tan önce çalışır. handled false ise
+// mesaj normal akışına devam eder.
+func (w *Window) titlebarProc(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) (uintptr, bool) {
+	var result uintptr
+	if ret, _, _ := procDwmDefWindowProc.Call(uintptr(hwnd), uintptr(msg), wParam, lParam,
+		uintptr(unsafe.Pointer(&result))); ret != 0 {
+		return result, true
+	}
+
+	switch msg {
+	case WM_NCCALCSIZE:
+		if wParam == 0 {
+			return 0, false
+		}
+		params := *(**NCCALCSIZE_PARAMS)(unsafe.Pointer(&lParam))
+		top := params.Rgrc[0].Top
+		w.defaultProc(hwnd, msg, wParam, lParam)
+		params.Rgrc[0].Top = top
+		if IsZoomed(hwnd) {
+			// Büyütülmüş pencere ekran dışına taşan kenarlık kadar kayar
+			params.Rgrc[0].Top += GetSystemMetrics(SM_CYFRAME) + GetSystemMetrics(SM_CXPADDEDBORDER)
+		}
+		return 0, true
+
+	case WM_NCHITTEST:
+		ret := w.defaultProc(hwnd, msg, wParam, lParam)
+		if ret != HTCLIENT {
+			return ret, true
+		}
+		// Fare WebView'in bölgesindeki deliklerden birinde
+		pt := POINT{X: int32(int16(LOWORD(lParam))), Y: int32(int16(HIWORD(lParam)))}
+		var origin POINT
+		procClientToScreen.Call(uintptr(hwnd), uintptr(unsafe.Pointer(&origin)))
+		y := pt.Y - origin.Y
+		if !IsZoomed(hwnd) && y < w.resizeBorder() {
+			return HTTOP, true
+		}
+		w.mu.RLock()
+		height := 0
+		if w.titlebar != nil {
+			height = w.titlebar.geometry.Height
+		}
+		w.mu.RUnlock()
+		if int(y) < height {
+			return HTCAPTION, true
+		}
+		return ret, true
+	}
+	return 0, false
+}
+
+// resizeBorder, üst kenardaki yeniden boyutlandırma şeridinin yüksekliğidir.
+func (w *Window) resizeBorder() int32 {
+	return GetSystemMetrics(SM_CYFRAME) + GetSystemMetrics(SM_CXPADDEDBORDER)
+}
+
+// updateTitlebar, düğme konumlarını yeniden okur, DWM çerçevesini uzatır,
+// WebView child'larının bölgesini ayarlar ve değişiklik varsa bildirir.
+// WM_SIZE sonrasında çağrılır.
+func (w *Window) updateTitlebar() {
+	w.mu.RLock()
+	state := w.titlebar
+	w.mu.RUnlock()
+	if state == nil {
+		return
+	}
+
+	var bounds, window RECT
+	if ret, _, _ := procDwmGetWindowAttribute.Call(uintptr(w.hwnd), DWMWA_CAPTION_BUTTON_BOUNDS,
+		uintptr(unsafe.Pointer(&bounds)), unsafe.Sizeof(bounds)); ret != 0 {
+		return // S_OK değil
+	}
+	if err := GetWindowRect(w.hwnd, &window); err != nil {
+		return
+	}
+	var origin POINT
+	procClientToScreen.Call(uintptr(w.hwnd), uintptr(unsafe.Pointer(&origin)))
+	dx, dy := window.Left-origin.X, window.Top-origin.Y
+	buttons := RECT{
+		Left:   bounds.Left + dx,
+		Top:    bounds.Top + dy,
+		Right:  bounds.Right + dx,
+		Bottom: bounds.Bottom + dy,
+	}
+	if buttons.Top < 0 {
+		buttons.Top = 0
+	}
+
+	margins := MARGINS{Top: buttons.Bottom}
+	procDwmExtendFrameIntoClientArea.Call(uintptr(w.hwnd), uintptr(unsafe.Pointer(&margins)))
+
+	var client RECT
+	procGetClientRect.Call(uintptr(w.hwnd), uintptr(unsafe.Pointer(&client)))
+	strip := int32(0)
+	if !IsZoomed(w.hwnd) {
+		strip = w.resizeBorder()
+	}
+	w.forEachChild(func(child syscall.Handle) {
+		var r RECT
+		if GetWindowRect(child, &r) != nil {
+			return
+		}
+		// Child koordinatlarına çevir
+		ox, oy := r.Left-origin.X, r.Top-origin.Y
+		rgn, _, _ := procCreateRectRgn.Call(0, 0, uintptr(r.Width()), uintptr(r.Height()))
+		cut := func(left, top, right, bottom int32) {
+			hole, _, _ := procCreateRectRgn.Call(uintptr(left-ox), uintptr(top-oy), uintptr(right-ox), uintptr(bottom-oy))
+			procCombineRgn.Call(rgn, rgn, hole, RGN_DIFF)
+			procDeleteObject.Call(hole)
+		}
+		cut(buttons.Left, buttons.Top, buttons.Right, buttons.Bottom)
+		if strip > 0 {
+			cut(client.Left, client.Top, client.Right, client.Top+strip)
+		}
+		// Bölgenin sahibi artık sistemdir; silinmez
+		procSetWindowRgn.Call(uintptr(child), rgn, 1)
+	})
+
+	geometry := platform.TitlebarGeometry{
+		Width:  int(buttons.Left),
+		Height: int(buttons.Bottom),
+	}
+	w.mu.Lock()
+	changed := state.geometry != geometry
+	state.geometry = geometry
+	onChange := state.onChange
+	w.mu.Unlock()
+
+	if changed && onChange != nil {
+		onChange(geometry)
+	}
+}
+
+// forEachChild, pencerenin doğrudan child pencerelerini (WebView) gezer.
+func (w *Window) forEachChild(fn func(child syscall.Handle)) {
+	child, _, _ := procGetWindow.Call(uintptr(w.hwnd), GW_CHILD)
+	for child != 0 {
+		fn(syscall.Handle(child))
+		child, _, _ = procGetWindow.Call(child, GW_HWNDNEXT)
+	}
+}
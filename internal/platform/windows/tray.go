@@ -0,0 +1,319 @@
+//go:build windows
+
+package windows
+
+import (
+	"errors"
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// ============================================================================
+// SİSTEM TEPSİSİ (Notification Area)
+// Shell_NotifyIconW ile görev çubuğunun bildirim alanına ikon ekler.
+// İkon olayları (tıklama, çift tıklama) gizli mesaj penceresine özel bir
+// callback mesajı olarak gelir. Explorer yeniden başlarsa ("TaskbarCreated")
+// ikon otomatik olarak tekrar eklenir.
+// ============================================================================
+
+var _ platform.Tray = (*Tray)(nil)
+
+var (
+	shell32                         = syscall.NewLazyDLL("shell32.dll")
+	procShellNotifyIconW            = shell32.NewProc("Shell_NotifyIconW")
+	procRegisterWindowMessageW      = user32.NewProc("RegisterWindowMessageW")
+	procLookupIconIdFromDirectoryEx = user32.NewProc("LookupIconIdFromDirectoryEx")
+	procCreateIconFromResourceEx    = user32.NewProc("CreateIconFromResourceEx")
+	procDestroyIcon                 = user32.NewProc("DestroyIcon")
+	procLoadIconW                   = user32.NewProc("LoadIconW")
+)
+
+// Shell_NotifyIcon sabitleri
+const (
+	NIM_ADD    = 0x00000000
+	NIM_MODIFY = 0x00000001
+	NIM_DELETE = 0x00000002
+
+	NIF_MESSAGE = 0x00000001
+	NIF_ICON    = 0x00000002
+	NIF_TIP     = 0x00000004
+	NIF_INFO    = 0x00000010
+
+	WM_USER        = 0x0400
+	WM_APP         = 0x8000
+	WM_CONTEXTMENU = 0x007B
+
+	IDI_APPLICATION = 32512
+)
+
+// trayCallbackMessage, tray olaylarının mesaj penceresine geldiği özel mesaj.
+const trayCallbackMessage = WM_APP + 1
+
+// GUID, Win32 GUID yapısı.
+type GUID struct {
+	Data1 uint32
+	Data2 uint16
+	Data3 uint16
+	Data4 [8]byte
+}
+
+// NOTIFYICONDATA: Shell_NotifyIconW parametre yapısı
+type NOTIFYICONDATA struct {
+	CbSize           uint32
+	HWnd             syscall.Handle
+	UID              uint32
+	UFlags           uint32
+	UCallbackMessage uint32
+	HIcon            syscall.Handle
+	SzTip            [128]uint16
+	DwState          uint32
+	DwStateMask      uint32
+	SzInfo           [256]uint16
+	UVersion         uint32
+	SzInfoTitle      [64]uint16
+	DwInfoFlags      uint32
+	GuidItem         GUID
+	HBalloonIcon     syscall.Handle
+}
+
+// Tray, Windows bildirim alanındaki bir ikonu temsil eder.
+type Tray struct {
+	window *MessageWindow
+	id     uint32
+	icon   syscall.Handle
+	tip    string
+	menu   []*platform.MenuItem
+
+	taskbarCreated uint32
+
+	onClick       func(button platform.MouseButton)
+	onDoubleClick func()
+	onMenuItem    func(id string)
+	mu            sync.RWMutex
+
+	// Balon bildirimi durumu (bkz. notification.go)
+	balloonID        string
+	balloonIcon      syscall.Handle
+	onBalloonClick   func(id, action string)
+	onBalloonDismiss func(id string)
+}
+
+var trayIDCounter uint32
+
+// NewTray creates a tray icon with the default application icon.
+// UI thread'inde çağrılmalıdır.
+func NewTray() (*Tray, error) {
+	t := &Tray{}
+
+	trayIDCounter++
+	t.id = trayIDCounter
+
+	msg, _, _ := procRegisterWindowMessageW.Call(uintptr(unsafe.Pointer(UTF16PtrFromString("TaskbarCreated"))))
+	t.taskbarCreated = uint32(msg)
+
+	mw, err := NewMessageWindow("GomadTray", t.handleMessage)
+	if err != nil {
+		return nil, err
+	}
+	t.window = mw
+
+	// Varsayılan uygulama ikonu; SetIcon ile değiştirilir
+	icon, _, _ := procLoadIconW.Call(0, IDI_APPLICATION)
+	t.icon = syscall.Handle(icon)
+
+	if err := t.notify(NIM_ADD); err != nil {
+		mw.Destroy()
+		return nil, err
+	}
+	return t, nil
+}
+
+// notify, mevcut durumu Shell_NotifyIconW ile gönderir.
+func (t *Tray) notify(action uint32) error {
+	var nid NOTIFYICONDATA
+	nid.CbSize = uint32(unsafe.Sizeof(nid))
+	nid.HWnd = t.window.Handle()
+	nid.UID = t.id
+	nid.UFlags = NIF_MESSAGE | NIF_ICON | NIF_TIP
+	nid.UCallbackMessage = trayCallbackMessage
+	nid.HIcon = t.icon
+	copyUTF16(nid.SzTip[:], t.tip)
+
+	ret, _, err := procShellNotifyIconW.Call(uintptr(action), uintptr(unsafe.Pointer(&nid)))
+	if ret == 0 {
+		if err == nil || err.(syscall.Errno) == 0 {
+			return errors.New("Shell_NotifyIcon failed")
+		}
+		return err
+	}
+	return nil
+}
+
+// SetIcon sets the icon from .ico file data.
+func (t *Tray) SetIcon(data []byte) error {
+	icon, err := IconFromICO(data)
+	if err != nil {
+		return err
+	}
+
+	old := t.icon
+	t.icon = icon
+	if err := t.notify(NIM_MODIFY); err != nil {
+		return err
+	}
+	if old != 0 {
+		procDestroyIcon.Call(uintptr(old))
+	}
+	return nil
+}
+
+// SetTooltip sets the hover text (max 127 karakter).
+func (t *Tray) SetTooltip(text string) error {
+	t.tip = text
+	return t.notify(NIM_MODIFY)
+}
+
+// SetMenu sets the context menu. Menü her açılışta yeniden oluşturulur;
+// böylece checkbox durumları her zaman günceldir.
+func (t *Tray) SetMenu(items []*platform.MenuItem) error {
+	t.mu.Lock()
+	t.menu = items
+	t.mu.Unlock()
+	return nil
+}
+
+// OnClick sets the click callback.
+func (t *Tray) OnClick(callback func(button platform.MouseButton)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.onClick = callback
+}
+
+// OnDoubleClick sets the double-click callback.
+func (t *Tray) OnDoubleClick(callback func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.onDoubleClick = callback
+}
+
+// OnMenuItem sets the menu selection callback.
+func (t *Tray) OnMenuItem(callback func(id string)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.onMenuItem = callback
+}
+
+// Destroy removes the icon from the notification area.
+func (t *Tray) Destroy() {
+	if t.window == nil {
+		return
+	}
+	_ = t.notify(NIM_DELETE)
+	t.window.Destroy()
+	t.window = nil
+	if t.balloonIcon != 0 {
+		procDestroyIcon.Call(uintptr(t.balloonIcon))
+		t.balloonIcon = 0
+	}
+}
+
+// handleMessage, tray mesaj penceresinin olay işleyicisidir.
+func (t *Tray) handleMessage(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) (uintptr, bool) {
+	if msg == t.taskbarCreated && msg != 0 {
+		// Explorer yeniden başladı; ikon kayboldu, tekrar ekle
+		_ = t.notify(NIM_ADD)
+		return 0, true
+	}
+
+	if msg != trayCallbackMessage {
+		return 0, false
+	}
+
+	t.mu.RLock()
+	onClick := t.onClick
+	onDouble := t.onDoubleClick
+	onMenu := t.onMenuItem
+	menu := t.menu
+	t.mu.RUnlock()
+
+	switch uint32(lParam) {
+	case NIN_BALLOONUSERCLICK, NIN_BALLOONTIMEOUT, NIN_BALLOONHIDE:
+		t.handleBalloon(uint32(lParam))
+
+	case WM_LBUTTONUP:
+		if onClick != nil {
+			onClick(platform.MouseButtonLeft)
+		}
+
+	case WM_LBUTTONDBLCLK:
+		if onDouble != nil {
+			onDouble()
+		}
+
+	case WM_RBUTTONUP:
+		if onClick != nil {
+			onClick(platform.MouseButtonRight)
+		}
+		if len(menu) > 0 {
+			m, err := BuildPopupMenu(menu)
+			if err != nil {
+				return 0, true
+			}
+			x, y := GetCursorPos()
+			id, ok := m.Track(hwnd, x, y)
+			m.Destroy()
+			if ok && onMenu != nil {
+				onMenu(id)
+			}
+		}
+	}
+	return 0, true
+}
+
+// IconFromICO, .ico dosya verisinden HICON üretir.
+// Dizindeki en uygun (varsayılan boyuttaki) görüntü seçilir.
+func IconFromICO(data []byte) (syscall.Handle, error) {
+	if len(data) < 6 {
+		return 0, errors.New("invalid icon data")
+	}
+
+	const lrDefaultColor = 0
+	offset, _, err := procLookupIconIdFromDirectoryEx.Call(
+		uintptr(unsafe.Pointer(&data[0])),
+		1, // fIcon
+		0, 0,
+		lrDefaultColor,
+	)
+	if offset == 0 || int(offset) >= len(data) {
+		return 0, err
+	}
+
+	icon, _, err := procCreateIconFromResourceEx.Call(
+		uintptr(unsafe.Pointer(&data[offset])),
+		uintptr(len(data)-int(offset)),
+		1,          // fIcon
+		0x00030000, // dwVer
+		0, 0,
+		lrDefaultColor,
+	)
+	if icon == 0 {
+		return 0, err
+	}
+	return syscall.Handle(icon), nil
+}
+
+// copyUTF16, metni sabit boyutlu UTF16 buffer'a (null-terminated) kopyalar.
+func copyUTF16(dst []uint16, s string) {
+	src, err := syscall.UTF16FromString(s)
+	if err != nil {
+		return
+	}
+	if len(src) > len(dst) {
+		src = src[:len(dst)]
+		src[len(src)-1] = 0
+	}
+	copy(dst, src)
+}
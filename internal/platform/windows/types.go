@@ -56,6 +56,7 @@ const (
 	WS_EX_TOPMOST          = 0x00000008 // Her zaman üstte
 	WS_EX_ACCEPTFILES      = 0x00000010 // Dosya bırakılabilir
 	WS_EX_TRANSPARENT      = 0x00000020 // Transparent pencere
+	WS_EX_TOOLWINDOW       = 0x00000080 // Araç penceresi (görev çubuğu ve Alt+Tab'da yok)
 	WS_EX_APPWINDOW        = 0x00040000 // Görev çubuğunda görünür
 	WS_EX_OVERLAPPEDWINDOW = 0x00000300 // Kombine overlapped window
 )
@@ -92,6 +93,7 @@ const (
 	WM_NCHITTEST         = 0x0084
 	WM_NCPAINT           = 0x0085
 	WM_NCACTIVATE        = 0x0086
+	WM_COMMAND           = 0x0111
 
 	// Klavye mesajları
 	WM_KEYDOWN    = 0x0100
@@ -145,6 +147,23 @@ const (
 	SM_CYSCREEN = 1 // Ekran yüksekliği
 )
 
+// ==================== Window Long Indexes ====================
+
+const (
+	GWLP_WNDPROC = -4  // Window procedure adresi
+	GWL_STYLE    = -16 // Pencere stili
+	GWL_EXSTYLE  = -20 // Genişletilmiş pencere stili
+)
+
+// ==================== SetWindowPos Flags ====================
+
+const (
+	SWP_NOSIZE       = 0x0001
+	SWP_NOMOVE       = 0x0002
+	SWP_NOZORDER     = 0x0004
+	SWP_FRAMECHANGED = 0x0020
+)
+
 // ==================== Special Values ====================
 
 const (
@@ -51,6 +51,7 @@ Yapının sınırları:
 */
 
 import (
+	"log/slog"
 	"runtime"
 	"sync"
 	"syscall"
@@ -87,6 +88,7 @@ type Window struct {
 	hInstance syscall.Handle
 	className string
 	title     string
+	logger    *slog.Logger
 
 	// Callbacks
 	onClose  func() bool
@@ -95,10 +97,26 @@ type Window struct {
 	onFocus  func()
 	onBlur   func()
 
+	// Menü çubuğu (bkz. SetMenuBar)
+	menuBar *Menu
+	onMenu  func(id string)
+
+	// Kiosk görünümüne geçmeden önceki durum; nil ise kiosk kapalı
+	// (bkz. SetKiosk)
+	kiosk *kioskState
+
+	// Başlık çubuğu overlay'i; nil ise standart başlık (bkz. SetTitlebarOverlay)
+	titlebar   *titlebarState
+	onTitlebar func(platform.TitlebarGeometry)
+
 	// State
 	resizable bool
 	closed    bool
 	mu        sync.RWMutex
+
+	// Attach ile sahiplenilen (başka bir kütüphanenin oluşturduğu) pencereler
+	// için orijinal window procedure. 0 ise pencere bize aittir.
+	prevProc uintptr
 }
 
 // Global window registry - wndProc'tan window'a ulaşmak için
@@ -130,11 +148,17 @@ func NewWindow(cfg platform.WindowConfig) (*Window, error) {
 
 	hInstance := GetModuleHandle(nil)
 
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+
 	w := &Window{
 		hInstance: hInstance,
 		className: "GomadWindowClass",
 		title:     cfg.Title,
 		resizable: cfg.Resizable,
+		logger:    logger.With("component", "platform", "os", "windows"),
 	}
 
 	// Window class'ı register et
@@ -160,10 +184,12 @@ func NewWindow(cfg platform.WindowConfig) (*Window, error) {
 		unsafe.Pointer(w),
 	)
 	if err != nil {
+		w.logger.Error("failed to create window", "error", err)
 		return nil, err
 	}
 
 	w.hwnd = hwnd
+	w.logger.Debug("window created", "hwnd", uintptr(hwnd), "title", cfg.Title)
 
 	// Global registry'e ekle
 	registryMu.Lock()
@@ -178,6 +204,88 @@ func NewWindow(cfg platform.WindowConfig) (*Window, error) {
 	return w, nil
 }
 
+// wndProcCallback, tüm pencereler için tek bir native callback üretir.
+// syscall.NewCallback sınırlı sayıda callback slotu sunduğu için her
+// çağrıda yenisini üretmek yerine bir kez oluşturulur.
+var wndProcCallback = syscall.NewCallback(wndProc)
+
+// AttachWindow wraps a native window created by another component.
+// -----------------------------------------------------------------------------
+// WebView kütüphanesi kendi penceresini kendisi oluşturur; bu pencereyi
+// platform.Window arayüzü üzerinden yönetebilmek için HWND'yi sahipleniriz.
+//
+// Nasıl?
+// - Pencere "subclass" edilir: GWLP_WNDPROC bizim wndProc'umuz ile değiştirilir.
+// - Orijinal procedure saklanır; işlemediğimiz her mesaj ona iletilir.
+// - WM_CLOSE'da onClose false dönerse mesaj yutulur → pencere kapanmaz.
+//
+// Böylece WebView'in kendi yaşam döngüsü bozulmadan OnClose, OnResize gibi
+// callback'ler aynı şekilde çalışır. UI thread'inden çağrılmalıdır.
+func AttachWindow(handle uintptr, logger *slog.Logger) (*Window, error) {
+	hwnd := syscall.Handle(handle)
+	if hwnd == 0 {
+		return nil, syscall.EINVAL
+	}
+
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+
+	w := &Window{
+		hwnd:      hwnd,
+		title:     GetWindowText(hwnd),
+		resizable: true,
+		logger:    logger.With("component", "platform", "os", "windows"),
+	}
+
+	// Registry'e subclass'tan önce ekle; ilk mesaj geldiğinde pencere bulunabilmeli
+	registryMu.Lock()
+	windowRegistry[hwnd] = w
+	registryMu.Unlock()
+
+	prev, err := SetWindowLongPtr(hwnd, GWLP_WNDPROC, wndProcCallback)
+	if err != nil {
+		registryMu.Lock()
+		delete(windowRegistry, hwnd)
+		registryMu.Unlock()
+		w.logger.Error("failed to attach window", "error", err)
+		return nil, err
+	}
+
+	w.mu.Lock()
+	w.prevProc = prev
+	w.mu.Unlock()
+
+	w.logger.Debug("window attached", "hwnd", handle)
+	return w, nil
+}
+
+// passThrough, işlenen mesajın sonucunu belirler.
+// Kendi pencerelerimizde 0 döner (mevcut davranış); sahiplenilen pencerelerde
+// mesaj orijinal procedure'a iletilir, aksi halde WebView resize vb. işleyemez.
+func (w *Window) passThrough(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) uintptr {
+	w.mu.RLock()
+	prev := w.prevProc
+	w.mu.RUnlock()
+
+	if prev == 0 {
+		return 0
+	}
+	return CallWindowProc(prev, hwnd, msg, wParam, lParam)
+}
+
+// defaultProc, işlenmeyen mesajları varsayılan işleyiciye iletir.
+func (w *Window) defaultProc(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) uintptr {
+	w.mu.RLock()
+	prev := w.prevProc
+	w.mu.RUnlock()
+
+	if prev == 0 {
+		return DefWindowProc(hwnd, msg, wParam, lParam)
+	}
+	return CallWindowProc(prev, hwnd, msg, wParam, lParam)
+}
+
 // registerClass registers the window class with Windows.
 // -----------------------------------------------------------------------------
 // WNDCLASSEX doldurularak RegisterClassEx çağrılır. Bu işlem, CreateWindowEx
@@ -187,7 +295,7 @@ func (w *Window) registerClass() error {
 	wc := WNDCLASSEX{
 		CbSize:        uint32(unsafe.Sizeof(WNDCLASSEX{})),
 		Style:         0,
-		LpfnWndProc:   syscall.NewCallback(wndProc),
+		LpfnWndProc:   wndProcCallback,
 		HInstance:     w.hInstance,
 		HCursor:       LoadCursor(0, MakeIntResource(IDC_ARROW)),
 		HbrBackground: syscall.Handle(6), // COLOR_WINDOW + 1
@@ -221,14 +329,30 @@ func wndProc(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) uintptr {
 		return DefWindowProc(hwnd, msg, wParam, lParam)
 	}
 
+	w.mu.RLock()
+	onClose := w.onClose
+	attached := w.prevProc != 0
+	overlay := w.titlebar != nil
+	w.mu.RUnlock()
+
+	if overlay {
+		if ret, handled := w.titlebarProc(hwnd, msg, wParam, lParam); handled {
+			return ret
+		}
+	}
+
 	switch msg {
 	case WM_CLOSE:
 		// onClose callback varsa çağır
-		if w.onClose != nil {
-			if !w.onClose() {
+		if onClose != nil {
+			if !onClose() {
 				return 0 // Kapanmayı engelle
 			}
 		}
+		if attached {
+			// Kapanışı sahibi (WebView) yönetir
+			return w.passThrough(hwnd, msg, wParam, lParam)
+		}
 		DestroyWindow(hwnd)
 		return 0
 
@@ -242,7 +366,14 @@ func wndProc(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) uintptr {
 		w.closed = true
 		w.mu.Unlock()
 
-		PostQuitMessage(0)
+		w.logger.Debug("window destroyed", "hwnd", uintptr(hwnd))
+		if attached {
+			return w.passThrough(hwnd, msg, wParam, lParam)
+		}
+		// Son pencere kapanınca uygulama döngüsü (RunApp) biter
+		if ownedWindowCount() == 0 {
+			PostQuitMessage(0)
+		}
 		return 0
 
 	case WM_SIZE:
@@ -251,7 +382,12 @@ func wndProc(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) uintptr {
 			height := int(HIWORD(lParam))
 			w.onResize(width, height)
 		}
-		return 0
+		// WebView child'ı önce yeni boyuta uyar, bölgesi sonra hesaplanır
+		ret := w.passThrough(hwnd, msg, wParam, lParam)
+		if overlay {
+			w.updateTitlebar()
+		}
+		return ret
 
 	case WM_MOVE:
 		if w.onMove != nil {
@@ -259,22 +395,28 @@ func wndProc(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) uintptr {
 			y := int(HIWORD(lParam))
 			w.onMove(x, y)
 		}
-		return 0
+		return w.passThrough(hwnd, msg, wParam, lParam)
 
 	case WM_SETFOCUS:
 		if w.onFocus != nil {
 			w.onFocus()
 		}
-		return 0
+		return w.passThrough(hwnd, msg, wParam, lParam)
 
 	case WM_KILLFOCUS:
 		if w.onBlur != nil {
 			w.onBlur()
 		}
-		return 0
+		return w.passThrough(hwnd, msg, wParam, lParam)
+
+	case WM_COMMAND:
+		if w.handleCommand(wParam, lParam) {
+			return 0
+		}
+		return w.passThrough(hwnd, msg, wParam, lParam)
 	}
 
-	return DefWindowProc(hwnd, msg, wParam, lParam)
+	return w.defaultProc(hwnd, msg, wParam, lParam)
 }
 
 // ==================== Lifecycle ====================
@@ -283,9 +425,15 @@ func wndProc(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) uintptr {
 // -----------------------------------------------------------------------------
 // Window görünür hale getirilir. WinAPI ShowWindow + UpdateWindow çağrıları
 // ile pencere ekranda görüntülenir ve arayüz güncellemesi tetiklenir.
+// Minimize edilmiş pencere geri yüklenir ve öne getirilir.
 func (w *Window) Show() {
-	ShowWindow(w.hwnd, SW_SHOW)
+	if IsIconic(w.hwnd) {
+		ShowWindow(w.hwnd, SW_RESTORE)
+	} else {
+		ShowWindow(w.hwnd, SW_SHOW)
+	}
 	UpdateWindow(w.hwnd)
+	SetForegroundWindow(w.hwnd)
 }
 
 // Hide makes the window invisible.
@@ -296,6 +444,14 @@ func (w *Window) Hide() {
 	ShowWindow(w.hwnd, SW_HIDE)
 }
 
+// IsVisible reports whether the window is shown.
+// -----------------------------------------------------------------------------
+// Minimize edilmiş pencere de görünür kabul edilir; yalnızca Hide ile
+// gizlenmiş pencere için false döner.
+func (w *Window) IsVisible() bool {
+	return IsWindowVisible(w.hwnd)
+}
+
 // Close destroys the window.
 // -----------------------------------------------------------------------------
 // Pencereyi yok eder. Eğer pencere zaten kapatıldıysa fonksiyon erken döner.
@@ -494,23 +650,13 @@ func (w *Window) Handle() uintptr {
 
 // ==================== Message Loop ====================
 
-// Run starts the Windows message loop.
-// Bu fonksiyon pencere kapanana kadar bloklar.
+// Run starts the application message loop.
+// Bu fonksiyon son pencere kapanana kadar bloklar.
 // -----------------------------------------------------------------------------
-// Mesaj döngüsünü başlatır: GetMessage blocking olarak mesaj bekler; WM_QUIT
-// geldiğinde döngü sonlanır. Döngü sırasında TranslateMessage ve DispatchMessage
-// ile uygun window procedure'lar tetiklenir.
+// Mesaj kuyruğu thread'e ait olduğundan döngü pencereye özgü değildir; Run,
+// UI thread'indeki tüm pencereleri işleyen RunApp'ı çağırır.
+//
+// Deprecated: RunApp kullanılmalıdır.
 func (w *Window) Run() {
-	var msg MSG
-	for {
-		ret := GetMessage(&msg, 0, 0, 0)
-		if ret == 0 {
-			break // WM_QUIT
-		}
-		if ret == -1 {
-			break // Error
-		}
-		TranslateMessage(&msg)
-		DispatchMessage(&msg)
-	}
+	_ = RunApp()
 }
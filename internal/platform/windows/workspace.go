@@ -0,0 +1,48 @@
+//go:build windows
+
+package windows
+
+import "github.com/biyonik/gomad/internal/platform"
+
+// ============================================================================
+// TÜM SANAL MASAÜSTLERİ
+// Windows'ta pencereyi tüm sanal masaüstlerine sabitlemenin belgelenmiş bir
+// API'si yoktur (IVirtualDesktopPinnedApps belgelenmemiştir ve Windows
+// sürümleri arasında değişir). Araç pencereleri (WS_EX_TOOLWINDOW) ise sanal
+// masaüstü yöneticisi tarafından izlenmez ve her masaüstünde görünür.
+//
+// Bedeli: araç penceresinin görev çubuğu düğmesi yoktur ve Alt+Tab listesinde
+// görünmez. Widget ve yardımcı pencereler için beklenen davranış budur.
+// Görev çubuğu yalnızca pencere gizlenip gösterilince güncellendiği için
+// görünür pencere kısa süreliğine gizlenir.
+// ============================================================================
+
+var _ platform.WorkspaceHost = (*Window)(nil)
+
+// SetAllWorkspaces shows the window on every virtual desktop.
+func (w *Window) SetAllWorkspaces(enabled bool) error {
+	exStyle := GetWindowLongPtr(w.hwnd, GWL_EXSTYLE)
+	next := exStyle &^ (WS_EX_TOOLWINDOW | WS_EX_APPWINDOW)
+	if enabled {
+		next |= WS_EX_TOOLWINDOW
+	} else {
+		next |= WS_EX_APPWINDOW
+	}
+	if next == exStyle {
+		return nil
+	}
+
+	visible := IsWindowVisible(w.hwnd)
+	if visible {
+		ShowWindow(w.hwnd, SW_HIDE)
+	}
+	_, err := SetWindowLongPtr(w.hwnd, GWL_EXSTYLE, next)
+	if err == nil {
+		procSetWindowPos.Call(uintptr(w.hwnd), 0, 0, 0, 0, 0,
+			SWP_NOMOVE|SWP_NOSIZE|SWP_NOZORDER|SWP_FRAMECHANGED)
+	}
+	if visible {
+		ShowWindow(w.hwnd, SW_SHOWNA)
+	}
+	return err
+}
@@ -0,0 +1,86 @@
+//go:build windows
+
+package windows
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// ============================================================================
+// XINPUT
+// Xbox uyumlu kumandalar XInput ile okunur; en fazla dört kumanda (slot)
+// desteklenir ve durum yoklanarak (polling) alınır. xinput1_4.dll Windows
+// 8+ ile gelir; Windows 7'de xinput9_1_0.dll kullanılır.
+// ============================================================================
+
+var (
+	xinput14           = syscall.NewLazyDLL("xinput1_4.dll")
+	xinput910          = syscall.NewLazyDLL("xinput9_1_0.dll")
+	procXInputGetState *syscall.LazyProc
+)
+
+const (
+	XUSER_MAX_COUNT            = 4
+	ERROR_DEVICE_NOT_CONNECTED = 1167
+
+	XINPUT_GAMEPAD_DPAD_UP        = 0x0001
+	XINPUT_GAMEPAD_DPAD_DOWN      = 0x0002
+	XINPUT_GAMEPAD_DPAD_LEFT      = 0x0004
+	XINPUT_GAMEPAD_DPAD_RIGHT     = 0x0008
+	XINPUT_GAMEPAD_START          = 0x0010
+	XINPUT_GAMEPAD_BACK           = 0x0020
+	XINPUT_GAMEPAD_LEFT_THUMB     = 0x0040
+	XINPUT_GAMEPAD_RIGHT_THUMB    = 0x0080
+	XINPUT_GAMEPAD_LEFT_SHOULDER  = 0x0100
+	XINPUT_GAMEPAD_RIGHT_SHOULDER = 0x0200
+	XINPUT_GAMEPAD_A              = 0x1000
+	XINPUT_GAMEPAD_B              = 0x2000
+	XINPUT_GAMEPAD_X              = 0x4000
+	XINPUT_GAMEPAD_Y              = 0x8000
+)
+
+// XINPUT_GAMEPAD: kumandanın tuş ve eksen durumu
+type XINPUT_GAMEPAD struct {
+	WButtons      uint16
+	BLeftTrigger  uint8
+	BRightTrigger uint8
+	SThumbLX      int16
+	SThumbLY      int16
+	SThumbRX      int16
+	SThumbRY      int16
+}
+
+// XINPUT_STATE: XInputGetState çıktısı
+type XINPUT_STATE struct {
+	DwPacketNumber uint32
+	Gamepad        XINPUT_GAMEPAD
+}
+
+/*
+XInputAvailable → XInput DLL'lerinden biri yüklenebiliyorsa true.
+*/
+func XInputAvailable() bool {
+	if procXInputGetState != nil {
+		return true
+	}
+	for _, dll := range []*syscall.LazyDLL{xinput14, xinput910} {
+		if dll.Load() == nil {
+			procXInputGetState = dll.NewProc("XInputGetState")
+			return true
+		}
+	}
+	return false
+}
+
+/*
+XInputGetState → slot'taki (0-3) kumandanın durumunu döner. Kumanda bağlı
+değilse ok false'tur.
+*/
+func XInputGetState(slot int) (state XINPUT_STATE, ok bool) {
+	if !XInputAvailable() {
+		return state, false
+	}
+	ret, _, _ := procXInputGetState.Call(uintptr(slot), uintptr(unsafe.Pointer(&state)))
+	return state, ret == 0
+}
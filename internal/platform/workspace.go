@@ -0,0 +1,23 @@
+package platform
+
+// ============================================================================
+// WORKSPACE HOST INTERFACE
+// Pencereyi tüm sanal masaüstlerinde (Windows sanal masaüstleri, macOS
+// Spaces, X11 çalışma alanları) gösterebilen implementasyonların
+// sözleşmesidir. Yardımcı (companion) ve widget pencereleri kullanıcı hangi
+// masaüstüne geçerse geçsin görünür kalır. Window interface'ine eklenmemiştir;
+// çağıran taraf type assertion ile kontrol eder. UI thread'inden
+// çağrılmalıdır.
+//
+// Platform karşılıkları:
+//
+//   - Windows → WS_EX_TOOLWINDOW (araç pencereleri her masaüstünde görünür)
+//   - macOS   → NSWindowCollectionBehaviorCanJoinAllSpaces
+//   - X11     → _NET_WM_STATE_STICKY
+//
+// ============================================================================
+type WorkspaceHost interface {
+	// SetAllWorkspaces → true: pencere tüm masaüstlerinde görünür.
+	// false: yalnızca açıldığı masaüstünde kalır.
+	SetAllWorkspaces(enabled bool) error
+}
@@ -0,0 +1,137 @@
+// Package sse, Server-Sent Events (text/event-stream) akışlarının minimal
+// bir istemcisidir (bkz. gomad.OpenSocket).
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package sse
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxLine, tek bir satırın en büyük boyutudur.
+const maxLine = 4 << 20
+
+// Event, sunucunun gönderdiği tek bir olaydır.
+type Event struct {
+	// ID, "id:" alanıdır; yeniden bağlanırken Last-Event-ID olarak gönderilir.
+	ID string
+
+	// Event, "event:" alanıdır; boşsa "message" kabul edilir.
+	Event string
+
+	// Data, "data:" satırlarının satır sonlarıyla birleştirilmiş halidir.
+	Data string
+}
+
+// StatusError, sunucunun akışı 200 dışında bir durumla reddettiği durumdur.
+type StatusError struct {
+	Status int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("event stream request failed: %d %s", e.Status, http.StatusText(e.Status))
+}
+
+// Stream, açık bir olay akışıdır.
+type Stream struct {
+	resp    *http.Response
+	scanner *bufio.Scanner
+
+	// LastID, alınan son olay kimliğidir.
+	LastID string
+
+	// Retry, sunucunun "retry:" ile önerdiği yeniden bağlanma süresidir.
+	Retry time.Duration
+}
+
+// Connect, url'e text/event-stream isteği gönderir. lastID boş değilse
+// Last-Event-ID başlığı olarak eklenir; sunucu kaldığı yerden devam edebilir.
+func Connect(ctx context.Context, client *http.Client, url string, header http.Header, lastID string) (*Stream, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Cache-Control", "no-cache")
+	if lastID != "" {
+		req.Header.Set("Last-Event-ID", lastID)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, &StatusError{Status: resp.StatusCode}
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxLine)
+	return &Stream{resp: resp, scanner: scanner, LastID: lastID}, nil
+}
+
+// Next, sıradaki olayı döner. Akış kapanırsa io.EOF veya okuma hatası döner.
+func (s *Stream) Next() (Event, error) {
+	var ev Event
+	var data []string
+	hasData := false
+	for s.scanner.Scan() {
+		line := s.scanner.Text()
+		if line == "" {
+			if !hasData {
+				ev = Event{}
+				continue
+			}
+			ev.Data = strings.Join(data, "\n")
+			if ev.Event == "" {
+				ev.Event = "message"
+			}
+			return ev, nil
+		}
+		if strings.HasPrefix(line, ":") {
+			continue // yorum / keep-alive
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			ev.Event = value
+		case "data":
+			data = append(data, value)
+			hasData = true
+		case "id":
+			if !strings.Contains(value, "\x00") {
+				ev.ID = value
+				s.LastID = value
+			}
+		case "retry":
+			if ms, err := strconv.Atoi(value); err == nil && ms >= 0 {
+				s.Retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+	if err := s.scanner.Err(); err != nil {
+		return Event{}, err
+	}
+	return Event{}, io.EOF
+}
+
+// Close, akışı kapatır.
+func (s *Stream) Close() error {
+	return s.resp.Body.Close()
+}
@@ -0,0 +1,261 @@
+package webview
+
+import (
+	"log/slog"
+	"sync"
+
+	"github.com/biyonik/gomad/internal/bridge"
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// ============================================================================
+// HEADLESS
+// ----------------------------------------------------------------------------
+// Native pencere ve WebView oluşturmadan çalışan View. Köprü, binding'ler,
+// Emit ve mesaj protokolü gerçek WebView'deki ile aynıdır; yalnızca JS motoru
+// yerine bellek içi bir evaluator vardır:
+//
+//   - JS → Go çağrıları Invoke ile, __gomad_invoke'un aldığı JSON mesajın
+//     aynısı verilerek yapılır.
+//   - Go → JS olayları (Emit) çözümlenip OnEvent aboneliklerine iletilir.
+//   - Diğer Eval'ler yürütülmez; OnEval ile gözlemlenebilir.
+//
+// Böylece Go ↔ JS sözleşmelerinin entegrasyon testleri CI'da ekran sunucusu
+// (X11/Wayland, masaüstü oturumu) olmadan çalışır.
+// ============================================================================
+
+// Headless, pencere açmayan View implementasyonudur.
+type Headless struct {
+	bridge *bridge.Bridge
+	logger *slog.Logger
+
+	// UI thread'i taklit eden FIFO kuyruk; Run tarafından işlenir. Sınırsızdır,
+	// böylece kuyruktaki bir iş içinden Dispatch kilitlenmez.
+	queue    []func()
+	queueMu  sync.Mutex
+	wake     chan struct{}
+	done     chan struct{}
+	started  chan struct{}
+	stopOnce sync.Once
+
+	onEvent []func(*bridge.Message)
+	onEval  []func(js string)
+	mu      sync.RWMutex
+}
+
+// NewHeadless, pencere açmadan çalışan bir View oluşturur. Options'tan
+// yalnızca Logger kullanılır; URL, HTML ve Scripts yüklenmez.
+func NewHeadless(opts Options) *Headless {
+	logger := opts.Logger
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+
+	h := &Headless{
+		logger:  logger.With("component", "webview", "mode", "headless"),
+		wake:    make(chan struct{}, 1),
+		done:    make(chan struct{}),
+		started: make(chan struct{}),
+	}
+	h.bridge = bridge.NewBridge(h)
+	h.bridge.SetLogger(logger)
+
+	h.logger.Debug("headless webview created")
+	return h
+}
+
+// ==================== Test İstemcisi ====================
+
+// Invoke, JS'in __gomad_invoke ile gönderdiği mesajı işler ve yanıt JSON'unu
+// döner. Herhangi bir goroutine'den çağrılabilir.
+func (h *Headless) Invoke(msgJSON string) string {
+	return h.bridge.HandleMessage(msgJSON)
+}
+
+// OnEvent, Go'dan JS'e gönderilen her olayda çağrılacak fonksiyonu ekler.
+// Fonksiyon, olayları Emit sırasıyla alır (Run goroutine'inde çalışır).
+// Dönen fonksiyon aboneliği kaldırır.
+func (h *Headless) OnEvent(fn func(msg *bridge.Message)) (cancel func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onEvent = append(h.onEvent, fn)
+	idx := len(h.onEvent) - 1
+	return func() {
+		h.mu.Lock()
+		h.onEvent[idx] = nil
+		h.mu.Unlock()
+	}
+}
+
+// OnEval, olay olmayan her Eval'de çağrılacak fonksiyonu ekler.
+func (h *Headless) OnEval(fn func(js string)) {
+	h.mu.Lock()
+	h.onEval = append(h.onEval, fn)
+	h.mu.Unlock()
+}
+
+// Started, Run olay döngüsünü başlattığında kapanan kanalı döner.
+func (h *Headless) Started() <-chan struct{} {
+	return h.started
+}
+
+// evaluate, bellek içi evaluator'dır; UI kuyruğunda çalışır.
+func (h *Headless) evaluate(js string) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if msg, ok := bridge.ParseEventScript(js); ok {
+		for _, fn := range h.onEvent {
+			if fn != nil {
+				fn(msg)
+			}
+		}
+		return
+	}
+	for _, fn := range h.onEval {
+		fn(js)
+	}
+}
+
+// ==================== WebView Interface Implementation ====================
+
+// Navigate, headless modda etkisizdir.
+func (h *Headless) Navigate(url string) {}
+
+// SetHTML, headless modda etkisizdir.
+func (h *Headless) SetHTML(html string) {}
+
+// Reload, headless modda etkisizdir.
+func (h *Headless) Reload() {}
+
+// ReloadIgnoringCache, headless modda etkisizdir.
+func (h *Headless) ReloadIgnoringCache() {}
+
+// GoBack, headless modda etkisizdir.
+func (h *Headless) GoBack() {}
+
+// GoForward, headless modda etkisizdir.
+func (h *Headless) GoForward() {}
+
+// CanGoBack, HeadlessClient'ın gomad.navigation ile bildirdiği duruma göre
+// döner.
+func (h *Headless) CanGoBack() bool { return h.bridge.Navigation().CanGoBack }
+
+// CanGoForward, HeadlessClient'ın bildirdiği duruma göre döner.
+func (h *Headless) CanGoForward() bool { return h.bridge.Navigation().CanGoForward }
+
+// OnHistoryChanged, gezinme durumu bildirildiğinde çağrılacak callback'i
+// ekler.
+func (h *Headless) OnHistoryChanged(fn func(bridge.NavigationState)) {
+	h.bridge.OnNavigation(fn)
+}
+
+// SetTitle, headless modda etkisizdir.
+func (h *Headless) SetTitle(title string) {}
+
+// SetSize, headless modda etkisizdir.
+func (h *Headless) SetSize(width, height int, hint int) {}
+
+// Eval, kodu UI kuyruğunda bellek içi evaluator'a verir. Gerçek WebView'de
+// olduğu gibi sıra korunur.
+func (h *Headless) Eval(js string) error {
+	h.Dispatch(func() { h.evaluate(js) })
+	return nil
+}
+
+// Bind, headless modda desteklenmez; Bridge.Bind kullanılmalıdır.
+func (h *Headless) Bind(name string, fn interface{}) error {
+	return gomerrors.NewBindingError(name, "raw bind in headless mode", gomerrors.ErrNotSupported)
+}
+
+// Init, headless modda etkisizdir; JS motoru yoktur.
+func (h *Headless) Init(js string) error { return nil }
+
+// Run, Terminate çağrılana kadar Dispatch kuyruğunu işler.
+func (h *Headless) Run() {
+	h.logger.Debug("event loop started")
+	close(h.started)
+	for {
+		// Terminate öncesi kuyruğa alınan işler (ör. son Emit'ler) de çalışır
+		for fn := h.next(); fn != nil; fn = h.next() {
+			fn()
+		}
+		select {
+		case <-h.wake:
+		case <-h.done:
+			for fn := h.next(); fn != nil; fn = h.next() {
+				fn()
+			}
+			h.logger.Debug("event loop stopped")
+			return
+		}
+	}
+}
+
+// next, kuyruktaki ilk işi çıkarır; kuyruk boşsa nil döner.
+func (h *Headless) next() func() {
+	h.queueMu.Lock()
+	defer h.queueMu.Unlock()
+	if len(h.queue) == 0 {
+		return nil
+	}
+	fn := h.queue[0]
+	h.queue = h.queue[1:]
+	return fn
+}
+
+// Dispatch, fonksiyonu UI kuyruğuna ekler. Döngü durduysa fonksiyon atılır.
+func (h *Headless) Dispatch(fn func()) {
+	if fn == nil {
+		return
+	}
+	select {
+	case <-h.done:
+		return
+	default:
+	}
+	h.queueMu.Lock()
+	h.queue = append(h.queue, fn)
+	h.queueMu.Unlock()
+	select {
+	case h.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Terminate, olay döngüsünü durdurur; Run geri döner.
+func (h *Headless) Terminate() {
+	h.stopOnce.Do(func() { close(h.done) })
+}
+
+// Destroy, headless modda serbest bırakılacak native kaynak yoktur.
+func (h *Headless) Destroy() {
+	h.logger.Debug("webview destroyed")
+}
+
+// Window, headless modda 0 döner.
+func (h *Headless) Window() uintptr { return 0 }
+
+// NativeWindow, headless modda nil döner.
+func (h *Headless) NativeWindow() platform.Window { return nil }
+
+// OnCloseRequested, headless modda pencere olmadığından ErrNotSupported döner.
+func (h *Headless) OnCloseRequested(fn func() bool) error {
+	return gomerrors.NewWindowError("close", "close confirmation in headless mode", gomerrors.ErrNotSupported)
+}
+
+// ==================== Bridge Access ====================
+
+// Bridge, köprüyü döner.
+func (h *Headless) Bridge() *bridge.Bridge { return h.bridge }
+
+// BindFunc, fonksiyonu köprü üzerinden bağlar.
+func (h *Headless) BindFunc(name string, fn interface{}) error {
+	return h.bridge.Bind(name, fn)
+}
+
+// Emit, JS tarafına (OnEvent abonelerine) bir olay gönderir.
+func (h *Headless) Emit(event string, data interface{}) error {
+	return h.bridge.Emit(event, data)
+}
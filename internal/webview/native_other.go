@@ -0,0 +1,22 @@
+//go:build !windows
+
+package webview
+
+import (
+	"log/slog"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// attachNative, bu platformda henüz desteklenmiyor.
+// macOS (Cocoa) ve Linux (GTK) implementasyonları eklendiğinde burası dolacak.
+func attachNative(handle uintptr, logger *slog.Logger) (platform.Window, error) {
+	return nil, gomerrors.NewWindowError("attach", "native window access", gomerrors.ErrNotSupported)
+}
+
+// useDataDir, bu platformda desteklenmiyor: webview_go WebKit veri dizinini
+// dışarıya açmaz.
+func useDataDir(dir string) (func(), error) {
+	return nil, gomerrors.NewWindowError("create", "separate storage partition", gomerrors.ErrNotSupported)
+}
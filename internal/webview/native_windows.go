@@ -0,0 +1,38 @@
+//go:build windows
+
+package webview
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/biyonik/gomad/internal/platform"
+	"github.com/biyonik/gomad/internal/platform/windows"
+)
+
+// attachNative, WebView'in oluşturduğu HWND'yi platform.Window olarak sahiplenir.
+func attachNative(handle uintptr, logger *slog.Logger) (platform.Window, error) {
+	return windows.AttachWindow(handle, logger)
+}
+
+// webview2DataEnv, WebView2'nin kullanıcı veri dizinini (çerezler, önbellek,
+// localStorage) belirleyen ortam değişkenidir; motora verilen dizini ezer.
+const webview2DataEnv = "WEBVIEW2_USER_DATA_FOLDER"
+
+// useDataDir, bir sonraki WebView'in verisini dir'de tutmasını sağlar; dönen
+// fonksiyon önceki değeri geri yükler. WebView2 ortamı oluşturucu içinde
+// eşzamanlı kurulduğundan değişkenin yalnızca webview.New süresince ayarlı
+// kalması yeterlidir.
+func useDataDir(dir string) (func(), error) {
+	prev, had := os.LookupEnv(webview2DataEnv)
+	if err := os.Setenv(webview2DataEnv, dir); err != nil {
+		return nil, err
+	}
+	return func() {
+		if had {
+			_ = os.Setenv(webview2DataEnv, prev)
+		} else {
+			_ = os.Unsetenv(webview2DataEnv)
+		}
+	}, nil
+}
@@ -13,11 +13,15 @@
 package webview
 
 import (
-	"fmt"
+	"encoding/json"
+	"log/slog"
 	"sync"
+	"time"
 	_ "unsafe"
 
 	"github.com/biyonik/gomad/internal/bridge"
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/platform"
 	webview "github.com/webview/webview_go"
 )
 
@@ -30,6 +34,24 @@ type WebView interface {
 	// SetHTML, HTML içeriğini doğrudan ayarlar.
 	SetHTML(html string)
 
+	// Reload, yüklü içeriği yeniler.
+	Reload()
+
+	// ReloadIgnoringCache, içeriği HTTP önbelleğini atlayarak yeniler.
+	ReloadIgnoringCache()
+
+	// GoBack ve GoForward, gezinme geçmişinde bir adım geri/ileri gider.
+	GoBack()
+	GoForward()
+
+	// CanGoBack ve CanGoForward, geçmişte geri/ileri gidilebilir mi döner.
+	CanGoBack() bool
+	CanGoForward() bool
+
+	// OnHistoryChanged, sayfanın geçmişteki konumu değiştiğinde çağrılacak
+	// callback'i ekler.
+	OnHistoryChanged(fn func(bridge.NavigationState))
+
 	// SetTitle, pencere başlığını ayarlar.
 	SetTitle(title string)
 
@@ -50,6 +72,14 @@ type WebView interface {
 	// Run, WebView olay döngüsünü başlatır.
 	Run()
 
+	// Dispatch, fonksiyonu UI thread'inde çalıştırılmak üzere kuyruğa alır.
+	// Herhangi bir goroutine'den güvenle çağrılabilir.
+	Dispatch(fn func())
+
+	// Terminate, olay döngüsünü durdurur; Run geri döner.
+	// Herhangi bir goroutine'den güvenle çağrılabilir.
+	Terminate()
+
 	// Destroy, WebView'i kapatır ve kaynakları serbest bırakır.
 	Destroy()
 
@@ -57,10 +87,34 @@ type WebView interface {
 	Window() uintptr
 }
 
+// View, uygulama katmanının (pkg/gomad) kullandığı WebView'dir: WebView'e ek
+// olarak köprü ve native pencere erişimi sunar. WebViewImpl gerçek pencereyi,
+// Headless ise pencere açmadan aynı köprüyü sağlar.
+type View interface {
+	WebView
+
+	// NativeWindow, WebView'i barındıran pencereyi döner; yoksa nil.
+	NativeWindow() platform.Window
+
+	// OnCloseRequested, pencere kapatılmak istendiğinde çağrılacak callback'i ayarlar.
+	OnCloseRequested(fn func() bool) error
+
+	// Bridge, Go ↔ JS köprüsünü döner.
+	Bridge() *bridge.Bridge
+
+	// BindFunc, fonksiyonu köprü üzerinden JS'e bağlar.
+	BindFunc(name string, fn interface{}) error
+
+	// Emit, JS tarafına bir olay gönderir.
+	Emit(event string, data interface{}) error
+}
+
 // WebViewImpl, webview/webview_go kullanılarak oluşturulmuş WebView implementasyonudur.
 type WebViewImpl struct {
 	w      webview.WebView
 	bridge *bridge.Bridge
+	logger *slog.Logger
+	native platform.Window // Sahiplenilen native pencere (desteklenmiyorsa nil)
 
 	// Durum bilgisi
 	ready   bool
@@ -69,6 +123,16 @@ type WebViewImpl struct {
 	// Geri çağırma fonksiyonları
 	onReady func()
 	mu      sync.Mutex
+
+	// SetHTML ile yüklenen son içerik; Reload URL'si olmayan sayfayı bununla
+	// yeniler (Navigate sıfırlar)
+	html   string
+	htmlMu sync.Mutex
+
+	// UI thread'ine henüz aktarılmamış scriptler (bkz. Eval)
+	evalQueue []string
+	evalSince time.Time // İlk scriptin kuyruğa girdiği an (Eval gecikmesi)
+	evalMu    sync.Mutex
 }
 
 // Options, WebView oluşturulurken yapılandırma seçeneklerini temsil eder.
@@ -92,6 +156,65 @@ type Options struct {
 	// HTML, başlangıç HTML içeriğidir.
 	// URL belirtilmişse göz ardı edilir.
 	HTML string
+
+	// Logger, WebView ve Bridge katmanlarının log çıktısını alır.
+	// nil ise log üretilmez.
+	Logger *slog.Logger
+
+	// InlineCalls, UI thread'inde senkron çalışacak binding'leri seçer (ör.
+	// UI thread'i gerektiren yerleşik binding'ler ya da senkron mod). Diğer
+	// çağrılar worker goroutine'lerde çalışır ve sonuçları UI thread'i
+	// üzerinden JS'e iletilir; böylece uzun süren bir binding pencereyi
+	// dondurmaz. nil ise tüm çağrılar worker'larda çalışır.
+	InlineCalls func(method string) bool
+
+	// LargePayloadThreshold, bu boyuttan (bayt) büyük sonuç ve olay
+	// verilerinin Eval yerine loopback adresinden okunmasını sağlar
+	// (bkz. Bridge.SetLargePayloadThreshold). 0 kapatır.
+	LargePayloadThreshold int
+
+	// AllowedOrigins, başlangıç URL'inin origin'ine ek olarak binding
+	// çağırabilecek origin'lerdir (ör. "https://auth.example.com"). "*" her
+	// origin'e izin verir; iframe'ler ve token'sız mesajlar yine reddedilir.
+	AllowedOrigins []string
+
+	// Capabilities, URL desenine göre sayfaların çağırabileceği binding'leri
+	// ve alabileceği olayları sınırlar (bkz. Bridge.SetCapabilities).
+	Capabilities []bridge.Capability
+
+	// SignMessages, sayfa ile Go arasındaki mesajların ve cevapların oturum
+	// anahtarıyla imzalanmasını ve imzası tutmayanların reddedilmesini sağlar
+	// (bkz. Bridge.EnableMessageSigning).
+	SignMessages bool
+
+	// Limits, sayfadan gelen çağrıların hız, eşzamanlılık ve kuyruk
+	// sınırlarıdır (bkz. Bridge.SetLimits). Sıfır değer sınır koymaz.
+	Limits bridge.Limits
+
+	// MaxMessageSize, argüman, sonuç ve olay verisi için bayt sınırıdır
+	// (bkz. Bridge.SetMaxMessageSize). 0 sınır koymaz.
+	MaxMessageSize int
+
+	// JournalSize, yeniden yüklemede tekrar gönderilmek üzere saklanacak
+	// olay ve çağrı cevabı sayısıdır (bkz. Bridge.EnableJournal). 0 kapalı.
+	JournalSize int
+
+	// DataDir, çerezlerin, önbelleğin ve localStorage'ın tutulacağı dizindir.
+	// Farklı dizinlerdeki WebView'ler oturum paylaşmaz. Boşsa motorun
+	// varsayılan dizini kullanılır. Dizin ayrılamayan platformlarda New
+	// ErrNotSupported döner; WebView ortak oturuma düşürülmez.
+	DataDir string
+
+	// Preload, WebView'i gizli ve içeriksiz oluşturur; motor hazır bekler ve
+	// sayfa daha sonra Navigate/SetHTML ile yüklenir (ön ısıtılmış pencere
+	// havuzu). URL bu durumda yalnızca origin doğrulaması için kullanılır.
+	// Native pencere gizlenemiyorsa New ErrNotSupported döner.
+	Preload bool
+
+	// Scripts, bridge kodundan sonra her sayfa yüklemesinde çalıştırılacak
+	// ek JavaScript kodlarıdır (ör. window.gomad.tray gibi modül API'leri).
+	// Sayfa scriptlerinden önce çalışmaları garanti edilir.
+	Scripts []string
 }
 
 // DefaultOptions, mantıklı varsayılan seçenekleri döndürür.
@@ -106,39 +229,116 @@ func DefaultOptions() Options {
 
 // New, verilen seçeneklerle yeni bir WebView oluşturur.
 func New(opts Options) (*WebViewImpl, error) {
+	// Ayrı veri dizini yalnızca motor oluşturulurken okunur
+	if opts.DataDir != "" {
+		restore, err := useDataDir(opts.DataDir)
+		if err != nil {
+			return nil, err
+		}
+		defer restore()
+	}
+
 	// webview/webview_go oluştur
 	w := webview.New(opts.Debug)
 	if w == nil {
-		return nil, fmt.Errorf("failed to create webview")
+		// Genellikle sistem web motoru (WebView2, WebKitGTK, WKWebView) eksiktir
+		return nil, gomerrors.NewWindowError("create", "native webview could not be created; is the system web engine (WebView2 / WebKitGTK) installed?", gomerrors.ErrNotReady)
+	}
+
+	logger := opts.Logger
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
 	}
 
 	impl := &WebViewImpl{
-		w: w,
+		w:      w,
+		logger: logger.With("component", "webview"),
 	}
 
 	// Bridge oluştur
 	impl.bridge = bridge.NewBridge(impl)
+	impl.bridge.SetLogger(logger)
+	impl.bridge.SetLargePayloadThreshold(opts.LargePayloadThreshold)
 
 	// Pencere ayarları
 	w.SetTitle(opts.Title)
 	w.SetSize(opts.Width, opts.Height, webview.HintNone)
 
 	// Go fonksiyonlarını JS'ten çağırma mekanizması
-	// webview/webview_go'nun Bind fonksiyonu string alır ve string döner
+	// webview/webview_go'nun Bind fonksiyonu string alır ve string döner.
+	// Worker'da çalışan çağrılar boş string döner; cevap daha sonra
+	// window.__gomad_reply ile gelir (bkz. Bridge.HandleMessageAsync).
 	err := w.Bind("__gomad_invoke", func(msgJSON string) string {
-		return impl.bridge.HandleMessage(msgJSON)
+		return impl.bridge.HandleMessageAsync(msgJSON, opts.InlineCalls, impl.reply)
 	})
 	if err != nil {
-		return nil, err
+		w.Destroy()
+		return nil, gomerrors.NewWindowError("create", "bridge binding", err)
 	}
 
+	// Sayfadan gelen mesajlar yalnızca beklenen origin'den ve en üst çerçeveden
+	// kabul edilir (bkz. Bridge.EnableOriginCheck). Beklenen origin başlangıç
+	// URL'inin origin'i, HTML içerikte "null"dır.
+	origins := append([]string(nil), opts.AllowedOrigins...)
+	if opts.URL != "" {
+		origins = append(origins, opts.URL)
+	} else {
+		origins = append(origins, "null")
+	}
+	impl.bridge.EnableOriginCheck(origins...)
+	impl.bridge.SetCapabilities(opts.Capabilities)
+	if opts.SignMessages {
+		impl.bridge.EnableMessageSigning()
+	}
+	impl.bridge.SetLimits(opts.Limits)
+	impl.bridge.SetMaxMessageSize(opts.MaxMessageSize)
+	impl.bridge.EnableJournal(opts.JournalSize)
+
 	// Bridge'i başlat ve invoke wrapper'ı ekle
 	initJS := bridge.JSBridgeCode + `
 	
 	// Override the call mechanism to use __gomad_invoke
 	(function() {
-		const originalCall = window.gomad.call;
-		window.gomad.call = async function(method, ...args) {
+		// Captured before page scripts run so they cannot intercept the token
+		const invoke = window.__gomad_invoke;
+		const stringify = JSON.stringify;
+		const parse = JSON.parse;
+		const token = ` + impl.bridge.GuardScript() + `;
+		const origin = ` + bridge.OriginScript + `;
+		const sign = ` + impl.bridge.SignScript() + `;
+		// Responses are handled only here; page scripts cannot resolve
+		// pending calls with forged results
+		const handleResponse = window.gomad._handleResponse;
+		delete window.gomad._handleResponse;
+		
+		// Go signs responses when signing is on (see Bridge.sealResponse):
+		// 64 hex characters of HMAC followed by the JSON
+		const receive = function(sealed) {
+			let body = sealed;
+			if (sign) {
+				body = sealed.slice(64);
+				if (sealed.slice(0, 64) !== sign.text(body)) {
+					console.error('GOMAD: Dropped response with invalid signature');
+					return null;
+				}
+			}
+			try {
+				return parse(body);
+			} catch (e) {
+				console.error('GOMAD: Invalid response JSON:', e);
+				return null;
+			}
+		};
+		
+		// Worker responses arrive here (see WebViewImpl.reply)
+		Object.defineProperty(window, '__gomad_reply', {
+			value: function(sealed) {
+				const response = typeof sealed === 'string' ? receive(sealed) : null;
+				if (response) handleResponse(response);
+			}
+		});
+		
+		const call = async function(method, ...args) {
 			const id = 'js_' + Date.now() + '_' + Math.random().toString(36).substr(2, 9);
 			
 			const message = {
@@ -146,47 +346,76 @@ func New(opts Options) (*WebViewImpl, error) {
 				type: 'call',
 				method: method,
 				args: args,
+				origin: origin,
+				page: origin + location.pathname,
+				meta: window.gomad._meta(),
+				token: token || undefined,
 				timestamp: Date.now()
 			};
+			if (sign) message.sig = sign.message(message);
 			
+			// Cevap ya __gomad_invoke'un dönüşünde (UI thread'inde çalışan
+			// çağrılar) ya da sonradan __gomad_reply ile (worker) gelir
+			const pending = window.gomad._expect(id, method);
+			let responseJSON;
 			try {
 				// __gomad_invoke returns a Promise, so we need await
-				const responseJSON = await __gomad_invoke(JSON.stringify(message));
-				
-				if (responseJSON) {
-					const response = JSON.parse(responseJSON);
-					if (response.type === 'error') {
-						const error = new Error(response.error.message);
-						error.code = response.error.code;
-						throw error;
-					} else {
-						return response.result;
-					}
-				}
-				return undefined;
+				responseJSON = await invoke(stringify(message));
 			} catch (e) {
-				// JSON parse hatası değilse, orijinal hatayı fırlat
-				if (e instanceof SyntaxError) {
-					console.error('GOMAD: Invalid response JSON:', e);
-					throw new Error('Invalid response from Go');
-				}
-				throw e;
+				handleResponse({ id: id, type: 'error', error: { code: -1, message: String((e && e.message) || e) } });
+				return pending;
+			}
+			
+			if (responseJSON) {
+				const response = receive(responseJSON);
+				handleResponse(response && response.id === id ? response :
+					{ id: id, type: 'error', error: { code: -1, message: 'Invalid response from Go' } });
 			}
+			return pending;
 		};
+		// Page scripts cannot swap the bridge or wrap call to read or alter
+		// other callers' arguments and results
+		Object.defineProperty(window.gomad, 'call', { value: call, writable: false, configurable: false });
+		Object.defineProperty(window, 'gomad', { value: window.gomad, writable: false, configurable: false });
+		
+		// Report the page right away so Go applies its capabilities to events
+		window.gomad.call('` + bridge.PageBinding + `').catch(function() {});
 		
 		console.log('GOMAD: Call mechanism initialized');
 	})();
 	`
 
 	w.Init(initJS)
+	w.Init(bridge.NavigationScript)
+	for _, js := range opts.Scripts {
+		w.Init(js)
+	}
 
-	// İçerik yükle
-	if opts.URL != "" {
+	// İçerik yükle (ön yüklenen WebView'de içerik sonra verilir)
+	switch {
+	case opts.Preload:
+	case opts.URL != "":
 		w.Navigate(opts.URL)
-	} else if opts.HTML != "" {
+	case opts.HTML != "":
 		w.SetHtml(opts.HTML)
 	}
 
+	// Native pencereyi platform katmanına bağla (kapanış onayı, stil vb. için)
+	if native, err := attachNative(impl.Window(), logger); err != nil {
+		impl.logger.Debug("native window not attached", "error", err)
+	} else {
+		impl.native = native
+	}
+
+	if opts.Preload {
+		if impl.native == nil {
+			impl.Destroy()
+			return nil, gomerrors.NewWindowError("create", "hidden preload window", gomerrors.ErrNotSupported)
+		}
+		impl.native.Hide()
+	}
+
+	impl.logger.Debug("webview created", "title", opts.Title, "url", opts.URL)
 	return impl, nil
 }
 
@@ -194,14 +423,92 @@ func New(opts Options) (*WebViewImpl, error) {
 
 // Navigate, WebView'i verilen URL'ye yönlendirir.
 func (wv *WebViewImpl) Navigate(url string) {
+	wv.htmlMu.Lock()
+	wv.html = ""
+	wv.htmlMu.Unlock()
 	wv.w.Navigate(url)
 }
 
 // SetHTML, WebView içerisine HTML içeriği yükler.
 func (wv *WebViewImpl) SetHTML(html string) {
+	wv.htmlMu.Lock()
+	wv.html = html
+	wv.htmlMu.Unlock()
 	wv.w.SetHtml(html)
 }
 
+// Reload, yüklü sayfayı yeniler. SetHTML ile yüklenen içerik bir adrese
+// sahip olmadığından aynı HTML yeniden yüklenir. UI thread'inde
+// çağrılmalıdır.
+func (wv *WebViewImpl) Reload() {
+	wv.htmlMu.Lock()
+	html := wv.html
+	wv.htmlMu.Unlock()
+	if html != "" {
+		wv.w.SetHtml(html)
+		return
+	}
+	wv.w.Eval("location.reload()")
+}
+
+// ReloadIgnoringCache, sayfayı HTTP önbelleğini atlayarak yeniler.
+// -----------------------------------------------------------------------------
+// webview_go motorun önbelleksiz yenileme komutunu açmaz. Bunun yerine sayfa
+// ve o ana kadar yüklenen aynı origin'li kaynaklar fetch(cache: "reload")
+// ile yeniden indirilir; önbellek taze yanıtlarla güncellendikten sonra sayfa
+// yenilenir. UI thread'inde çağrılmalıdır.
+func (wv *WebViewImpl) ReloadIgnoringCache() {
+	wv.htmlMu.Lock()
+	html := wv.html
+	wv.htmlMu.Unlock()
+	if html != "" {
+		wv.w.SetHtml(html)
+		return
+	}
+	wv.w.Eval(reloadIgnoringCacheJS)
+}
+
+// GoBack, geçmişte bir sayfa geri gider. UI thread'inde çağrılmalıdır.
+func (wv *WebViewImpl) GoBack() {
+	wv.w.Eval("history.back()")
+}
+
+// GoForward, geçmişte bir sayfa ileri gider. UI thread'inde çağrılmalıdır.
+func (wv *WebViewImpl) GoForward() {
+	wv.w.Eval("history.forward()")
+}
+
+// CanGoBack, sayfanın bildirdiği geçmişe göre geri gidilebilir mi döner
+// (bkz. bridge.NavigationScript).
+func (wv *WebViewImpl) CanGoBack() bool {
+	return wv.bridge.Navigation().CanGoBack
+}
+
+// CanGoForward, sayfanın bildirdiği geçmişe göre ileri gidilebilir mi döner.
+func (wv *WebViewImpl) CanGoForward() bool {
+	return wv.bridge.Navigation().CanGoForward
+}
+
+// OnHistoryChanged, sayfanın geçmişteki konumu değiştiğinde çağrılacak
+// callback'i ekler; callback UI thread'inde çalışır.
+func (wv *WebViewImpl) OnHistoryChanged(fn func(bridge.NavigationState)) {
+	wv.bridge.OnNavigation(fn)
+}
+
+// reloadIgnoringCacheJS, önbelleği tazeleyip sayfayı yeniler. Kaynaklardan
+// biri indirilemese de yenileme yapılır.
+const reloadIgnoringCacheJS = `(function() {
+    const urls = [location.href];
+    if (window.performance && performance.getEntriesByType) {
+        for (const entry of performance.getEntriesByType('resource')) urls.push(entry.name);
+    }
+    const same = urls.filter((u) => {
+        try { return new URL(u, location.href).origin === location.origin; } catch (e) { return false; }
+    });
+    Promise.all(same.map((u) => fetch(u, { cache: 'reload', credentials: 'same-origin' }).catch(() => {})))
+        .finally(() => location.reload());
+})();`
+
 // SetTitle, WebView pencere başlığını ayarlar.
 func (wv *WebViewImpl) SetTitle(title string) {
 	wv.w.SetTitle(title)
@@ -213,11 +520,50 @@ func (wv *WebViewImpl) SetSize(width, height int, hint int) {
 }
 
 // Eval, WebView içinde JavaScript kodunu yürütür.
+//
+// WebView implementasyonları Eval'in UI thread'inden çağrılmasını şart koşar.
+// Kullanıcılar ise Emit'i doğal olarak worker goroutine'lerden çağırır; bu yüzden
+// çağrı her zaman Dispatch üzerinden UI thread'ine taşınır. UI thread'i
+// kuyruğu boşaltana kadar gelen Eval'ler aynı partiye eklenir ve tek bir
+// Dispatch ile, geldikleri sırayla çalışır; art arda gelen olaylar tek bir JS
+// çağrısında birleşir (bkz. bridge.CoalesceScripts).
 func (wv *WebViewImpl) Eval(js string) error {
-	wv.w.Eval(js)
+	wv.evalMu.Lock()
+	wv.evalQueue = append(wv.evalQueue, js)
+	first := len(wv.evalQueue) == 1
+	if first {
+		wv.evalSince = time.Now()
+	}
+	wv.evalMu.Unlock()
+
+	if first {
+		wv.w.Dispatch(wv.flushEval)
+	}
 	return nil // webview/webview_go hata dönmüyor
 }
 
+// flushEval, kuyruktaki scriptleri UI thread'inde çalıştırır.
+func (wv *WebViewImpl) flushEval() {
+	wv.evalMu.Lock()
+	scripts, since := wv.evalQueue, wv.evalSince
+	wv.evalQueue = nil
+	wv.evalMu.Unlock()
+
+	for _, js := range bridge.CoalesceScripts(scripts) {
+		wv.w.Eval(js)
+	}
+	wv.bridge.RecordEvalLatency(time.Since(since))
+}
+
+// reply, worker'da tamamlanan bir çağrının cevabını JS'e iletir.
+func (wv *WebViewImpl) reply(response string) {
+	quoted, err := json.Marshal(response)
+	if err != nil {
+		return
+	}
+	_ = wv.Eval("window.__gomad_reply(" + string(quoted) + ")")
+}
+
 // Bind, düşük seviyede Go fonksiyonunu JS tarafına bağlar.
 // Yeni kod için Bridge.Bind kullanılması önerilir.
 func (wv *WebViewImpl) Bind(name string, fn interface{}) error {
@@ -236,11 +582,29 @@ func (wv *WebViewImpl) Init(js string) error {
 
 // Run, WebView olay döngüsünü başlatır. Pencere kapanana kadar bloklar.
 func (wv *WebViewImpl) Run() {
+	wv.logger.Debug("event loop started")
 	wv.w.Run()
+	wv.logger.Debug("event loop stopped")
+}
+
+// Dispatch, fonksiyonu UI thread'inde çalıştırılmak üzere kuyruğa alır.
+// Native pencere veya WebView API'lerine worker goroutine'lerden erişmek için kullanılır.
+func (wv *WebViewImpl) Dispatch(fn func()) {
+	if fn == nil {
+		return
+	}
+	wv.w.Dispatch(fn)
+}
+
+// Terminate, olay döngüsünü durdurur. Run geri döndükten sonra Destroy çağrılmalıdır.
+func (wv *WebViewImpl) Terminate() {
+	wv.w.Terminate()
 }
 
 // Destroy, WebView'i kapatır ve kaynakları serbest bırakır.
 func (wv *WebViewImpl) Destroy() {
+	wv.logger.Debug("webview destroyed")
+	wv.bridge.Close()
 	wv.w.Destroy()
 }
 
@@ -249,6 +613,23 @@ func (wv *WebViewImpl) Window() uintptr {
 	return uintptr(wv.w.Window())
 }
 
+// NativeWindow, WebView'i barındıran pencereyi platform.Window olarak döner.
+// Platform henüz desteklenmiyorsa nil döner.
+func (wv *WebViewImpl) NativeWindow() platform.Window {
+	return wv.native
+}
+
+// OnCloseRequested, kullanıcı pencereyi kapatmak istediğinde çağrılacak
+// callback'i ayarlar. Callback false dönerse pencere kapanmaz.
+// Native pencere erişimi olmayan platformlarda ErrNotSupported döner.
+func (wv *WebViewImpl) OnCloseRequested(fn func() bool) error {
+	if wv.native == nil {
+		return gomerrors.NewWindowError("close", "close confirmation", gomerrors.ErrNotSupported)
+	}
+	wv.native.OnClose(fn)
+	return nil
+}
+
 // ==================== Bridge Access ====================
 
 // Bridge, WebView ile JS arasındaki iletişim köprüsünü döndürür.
@@ -0,0 +1,346 @@
+// Package ws, RFC 6455 WebSocket protokolünün minimal bir istemcisidir.
+//
+// GOMAD'ın Go tarafında tuttuğu uzak bağlantılar (bkz. gomad.OpenSocket) için
+// yazılmıştır; harici bağımlılık eklememek amacıyla yalnızca istemcinin
+// ihtiyaç duyduğu kısım uygulanır: el sıkışma, maskeli çerçeve yazma,
+// parçalı mesajları birleştirme ve ping/pong/close kontrol çerçeveleri.
+// Sıkıştırma (permessage-deflate) gibi eklentiler desteklenmez.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package ws
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// acceptGUID, Sec-WebSocket-Accept hesabında kullanılan sabit değerdir.
+const acceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// MaxMessageSize, kabul edilen en büyük mesaj boyutudur.
+const MaxMessageSize = 32 << 20
+
+// Opcode'lar
+const (
+	OpContinuation = 0x0
+	OpText         = 0x1
+	OpBinary       = 0x2
+	OpClose        = 0x8
+	OpPing         = 0x9
+	OpPong         = 0xA
+)
+
+// CloseError, sunucunun gönderdiği close çerçevesidir.
+type CloseError struct {
+	Code   int
+	Reason string
+}
+
+func (e *CloseError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("websocket closed: %d %s", e.Code, e.Reason)
+	}
+	return fmt.Sprintf("websocket closed: %d", e.Code)
+}
+
+// ErrMessageTooLarge, MaxMessageSize'ı aşan mesajlarda döner.
+var ErrMessageTooLarge = errors.New("websocket message too large")
+
+// Conn, açık bir WebSocket bağlantısıdır. ReadMessage tek bir goroutine'den,
+// WriteMessage ve Close herhangi bir goroutine'den çağrılabilir.
+type Conn struct {
+	conn net.Conn
+	br   *bufio.Reader
+
+	// Protocol, sunucunun seçtiği alt protokoldür.
+	Protocol string
+
+	wmu       sync.Mutex
+	closeOnce sync.Once
+}
+
+// Dial, rawURL'e (ws:// veya wss://) bağlanır ve el sıkışmayı tamamlar.
+// header el sıkışma isteğine eklenir (ör. Authorization).
+func Dial(ctx context.Context, rawURL string, header http.Header, protocols []string) (*Conn, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	var secure bool
+	switch u.Scheme {
+	case "ws":
+	case "wss":
+		secure = true
+	default:
+		return nil, fmt.Errorf("unsupported websocket scheme %q", u.Scheme)
+	}
+
+	host := u.Host
+	if u.Port() == "" {
+		if secure {
+			host = net.JoinHostPort(u.Hostname(), "443")
+		} else {
+			host = net.JoinHostPort(u.Hostname(), "80")
+		}
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return nil, err
+	}
+	if secure {
+		tc := tls.Client(conn, &tls.Config{ServerName: u.Hostname()})
+		if err := tc.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tc
+	}
+
+	// El sıkışma ctx iptal edilirse yarıda kalmasın
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	c, err := handshake(conn, u, header, protocols)
+	if err != nil {
+		conn.Close()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+// handshake, HTTP Upgrade isteğini gönderir ve cevabı doğrular.
+func handshake(conn net.Conn, u *url.URL, header http.Header, protocols []string) (*Conn, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	key := base64.StdEncoding.EncodeToString(nonce)
+
+	req := &http.Request{
+		Method:     http.MethodGet,
+		URL:        &url.URL{Path: u.EscapedPath(), RawQuery: u.RawQuery},
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{},
+		Host:       u.Host,
+	}
+	if req.URL.Path == "" {
+		req.URL.Path = "/"
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Sec-WebSocket-Key", key)
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	if len(protocols) > 0 {
+		req.Header.Set("Sec-WebSocket-Protocol", strings.Join(protocols, ", "))
+	}
+	if err := req.Write(conn); err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		resp.Body.Close()
+		return nil, &HandshakeError{Status: resp.StatusCode}
+	}
+	if !strings.EqualFold(resp.Header.Get("Upgrade"), "websocket") ||
+		resp.Header.Get("Sec-WebSocket-Accept") != acceptKey(key) {
+		return nil, errors.New("websocket handshake: invalid upgrade response")
+	}
+	return &Conn{conn: conn, br: br, Protocol: resp.Header.Get("Sec-WebSocket-Protocol")}, nil
+}
+
+// HandshakeError, sunucunun upgrade isteğini reddettiği durumdur.
+// 401/403 gibi durumlar yeniden denemeden önce kimlik bilgisinin
+// yenilenmesi gerektiğini gösterir.
+type HandshakeError struct {
+	Status int
+}
+
+func (e *HandshakeError) Error() string {
+	return fmt.Sprintf("websocket handshake failed: %d %s", e.Status, http.StatusText(e.Status))
+}
+
+// acceptKey, key için beklenen Sec-WebSocket-Accept değeridir.
+func acceptKey(key string) string {
+	h := sha1.Sum([]byte(key + acceptGUID))
+	return base64.StdEncoding.EncodeToString(h[:])
+}
+
+// ReadMessage, sıradaki veri mesajını (OpText veya OpBinary) döner. Ping'lere
+// otomatik cevap verilir; sunucu kapatırsa *CloseError döner.
+func (c *Conn) ReadMessage() (op int, data []byte, err error) {
+	var msgOp = -1
+	var buf []byte
+	for {
+		fin, opcode, payload, err := c.readFrame()
+		if err != nil {
+			return 0, nil, err
+		}
+
+		switch opcode {
+		case OpPing:
+			if err := c.writeFrame(OpPong, payload); err != nil {
+				return 0, nil, err
+			}
+			continue
+		case OpPong:
+			continue
+		case OpClose:
+			cerr := &CloseError{Code: 1005}
+			if len(payload) >= 2 {
+				cerr.Code = int(binary.BigEndian.Uint16(payload))
+				cerr.Reason = string(payload[2:])
+			}
+			_ = c.writeFrame(OpClose, payload[:min(2, len(payload))])
+			c.conn.Close()
+			return 0, nil, cerr
+		case OpText, OpBinary:
+			if msgOp != -1 {
+				return 0, nil, errors.New("websocket: unexpected data frame inside fragmented message")
+			}
+			msgOp = opcode
+		case OpContinuation:
+			if msgOp == -1 {
+				return 0, nil, errors.New("websocket: unexpected continuation frame")
+			}
+		default:
+			return 0, nil, fmt.Errorf("websocket: unknown opcode %#x", opcode)
+		}
+
+		if len(buf)+len(payload) > MaxMessageSize {
+			return 0, nil, ErrMessageTooLarge
+		}
+		buf = append(buf, payload...)
+		if fin {
+			return msgOp, buf, nil
+		}
+	}
+}
+
+// readFrame, tek bir çerçeveyi okur.
+func (c *Conn) readFrame() (fin bool, opcode int, payload []byte, err error) {
+	var head [2]byte
+	if _, err := io.ReadFull(c.br, head[:]); err != nil {
+		return false, 0, nil, err
+	}
+	fin = head[0]&0x80 != 0
+	opcode = int(head[0] & 0x0F)
+	masked := head[1]&0x80 != 0
+
+	length := uint64(head[1] & 0x7F)
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.br, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.br, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+	if length > MaxMessageSize {
+		return false, 0, nil, ErrMessageTooLarge
+	}
+
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(c.br, mask[:]); err != nil {
+			return false, 0, nil, err
+		}
+	}
+	payload = make([]byte, length)
+	if _, err := io.ReadFull(c.br, payload); err != nil {
+		return false, 0, nil, err
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+	return fin, opcode, payload, nil
+}
+
+// WriteMessage, data'yı tek çerçevelik bir mesaj olarak gönderir.
+// op OpText veya OpBinary olmalıdır.
+func (c *Conn) WriteMessage(op int, data []byte) error {
+	if op != OpText && op != OpBinary {
+		return fmt.Errorf("websocket: invalid message opcode %#x", op)
+	}
+	return c.writeFrame(op, data)
+}
+
+// writeFrame, istemciden sunucuya giden maskeli bir çerçeve yazar.
+func (c *Conn) writeFrame(opcode int, payload []byte) error {
+	frame := make([]byte, 0, 14+len(payload))
+	frame = append(frame, 0x80|byte(opcode))
+	switch n := len(payload); {
+	case n < 126:
+		frame = append(frame, 0x80|byte(n))
+	case n <= 0xFFFF:
+		frame = append(frame, 0x80|126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(n))
+	default:
+		frame = append(frame, 0x80|127)
+		frame = binary.BigEndian.AppendUint64(frame, uint64(n))
+	}
+
+	var mask [4]byte
+	if _, err := rand.Read(mask[:]); err != nil {
+		return err
+	}
+	frame = append(frame, mask[:]...)
+	start := len(frame)
+	frame = append(frame, payload...)
+	for i := range payload {
+		frame[start+i] ^= mask[i%4]
+	}
+
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	_, err := c.conn.Write(frame)
+	return err
+}
+
+// Close, normal kapanış (1000) çerçevesi gönderir ve bağlantıyı kapatır.
+func (c *Conn) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		_ = c.writeFrame(OpClose, binary.BigEndian.AppendUint16(nil, 1000))
+		err = c.conn.Close()
+	})
+	return err
+}
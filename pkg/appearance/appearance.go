@@ -0,0 +1,127 @@
+// Package appearance, işletim sisteminin kişiselleştirme ve erişilebilirlik
+// tercihlerini okur: vurgu rengi, koyu tema, azaltılmış hareket, azaltılmış
+// saydamlık, yüksek kontrast ve ekran okuyucu.
+//
+// WebView'in prefers-* media query'leri platforma göre eksik veya gecikmeli
+// güncellenir; vurgu rengi ise CSS'e hiç yansımaz. Bu paket değerleri
+// doğrudan işletim sisteminden okur ve değişiklikleri bildirir.
+//
+// Örnek:
+//
+//	s, _ := appearance.Get()
+//	theme.SetAccent(s.AccentColor)
+//
+//	cancel, err := appearance.Subscribe(func(s appearance.Settings) {
+//	    theme.SetDark(s.DarkMode)
+//	})
+//	defer cancel()
+//
+// Platform desteği:
+//   - Windows: registry (DWM, Personalize) ve SystemParametersInfo.
+//   - macOS: kullanıcı varsayılanları (defaults).
+//   - Linux: GNOME gsettings (diğer masaüstlerinde değerler varsayılan kalır).
+//   - Diğer: ErrNotSupported.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package appearance
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// ErrNotSupported, platform görünüm ayarlarını veremiyorsa döner.
+var ErrNotSupported = gomerrors.ErrNotSupported
+
+// pollInterval, ayarların okunma sıklığıdır.
+const pollInterval = 2 * time.Second
+
+// Settings, sistemin görünüm tercihleridir.
+type Settings struct {
+	// AccentColor, "#rrggbb" biçiminde vurgu rengidir; bilinmiyorsa boştur.
+	AccentColor         string `json:"accentColor"`
+	DarkMode            bool   `json:"darkMode"`
+	ReducedMotion       bool   `json:"reducedMotion"`
+	ReducedTransparency bool   `json:"reducedTransparency"`
+	HighContrast        bool   `json:"highContrast"`
+	ScreenReader        bool   `json:"screenReader"`
+}
+
+var (
+	subscribers = make(map[int]func(Settings))
+	nextSub     int
+	subMu       sync.Mutex
+
+	lastSettings Settings
+	started      bool
+)
+
+// Get, görünüm ayarlarının anlık değerini döner.
+func Get() (Settings, error) {
+	return readSettings()
+}
+
+// Subscribe, görünüm değişiklikleri için bir dinleyici ekler ve kaldırma
+// fonksiyonu döner. fn, izleyici goroutine'inde yeni ayarlarla çağrılır.
+// İlk abonelikte izleme başlatılır.
+func Subscribe(fn func(Settings)) (cancel func(), err error) {
+	subMu.Lock()
+	defer subMu.Unlock()
+
+	if !started {
+		if lastSettings, err = readSettings(); err != nil {
+			return nil, err
+		}
+		go poll()
+		started = true
+	}
+
+	id := nextSub
+	nextSub++
+	subscribers[id] = fn
+
+	return func() {
+		subMu.Lock()
+		defer subMu.Unlock()
+		delete(subscribers, id)
+	}, nil
+}
+
+// poll, ayarları periyodik okuyup değişiklikleri yayınlar.
+func poll() {
+	for {
+		time.Sleep(pollInterval)
+
+		s, err := readSettings()
+		if err != nil {
+			continue
+		}
+
+		subMu.Lock()
+		changed := s != lastSettings
+		lastSettings = s
+		fns := make([]func(Settings), 0, len(subscribers))
+		for _, fn := range subscribers {
+			fns = append(fns, fn)
+		}
+		subMu.Unlock()
+
+		if !changed {
+			continue
+		}
+		for _, fn := range fns {
+			fn(s)
+		}
+	}
+}
+
+// hexColor, RGB bileşenlerini "#rrggbb" biçimine çevirir.
+func hexColor(r, g, b uint8) string {
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
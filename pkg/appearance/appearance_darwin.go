@@ -0,0 +1,45 @@
+//go:build darwin
+
+package appearance
+
+import (
+	"os/exec"
+	"strings"
+)
+
+// accentColors, AppleAccentColor değerlerinin sistem renkleridir.
+// Değer yoksa kullanıcı varsayılan (mavi) vurgu rengini kullanıyordur.
+var accentColors = map[string]string{
+	"-1": "#8c8c8c", // Graphite
+	"0":  "#ff5257", // Red
+	"1":  "#f7821b", // Orange
+	"2":  "#ffc600", // Yellow
+	"3":  "#62ba46", // Green
+	"4":  "#007aff", // Blue
+	"5":  "#a550a7", // Purple
+	"6":  "#f74f9e", // Pink
+}
+
+func readSettings() (Settings, error) {
+	accent, ok := accentColors[readDefault("-g", "AppleAccentColor")]
+	if !ok {
+		accent = accentColors["4"]
+	}
+	return Settings{
+		AccentColor:         accent,
+		DarkMode:            readDefault("-g", "AppleInterfaceStyle") == "Dark",
+		ReducedMotion:       readDefault("com.apple.universalaccess", "reduceMotion") == "1",
+		ReducedTransparency: readDefault("com.apple.universalaccess", "reduceTransparency") == "1",
+		HighContrast:        readDefault("com.apple.universalaccess", "increaseContrast") == "1",
+		ScreenReader:        readDefault("com.apple.universalaccess", "voiceOverOnOffKey") == "1",
+	}, nil
+}
+
+// readDefault, "defaults read" ile bir kullanıcı tercihini okur; yoksa "" döner.
+func readDefault(domain, key string) string {
+	out, err := exec.Command("defaults", "read", domain, key).Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
@@ -0,0 +1,52 @@
+//go:build linux
+
+package appearance
+
+import (
+	"os/exec"
+	"strings"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// accentColors, GNOME 47+ accent-color değerlerinin renkleridir.
+var accentColors = map[string]string{
+	"blue":   "#3584e4",
+	"teal":   "#2190a4",
+	"green":  "#3a944a",
+	"yellow": "#c88800",
+	"orange": "#ed5b00",
+	"red":    "#e62d42",
+	"pink":   "#d56199",
+	"purple": "#9141ac",
+	"slate":  "#6f8396",
+}
+
+// readSettings, GNOME masaüstü ayarlarını gsettings ile okur.
+// Şema yoksa (başka masaüstü) ilgili alan varsayılan değerinde kalır.
+func readSettings() (Settings, error) {
+	path, err := exec.LookPath("gsettings")
+	if err != nil {
+		return Settings{}, gomerrors.NewOperationError("appearance.read", "gsettings not found", ErrNotSupported)
+	}
+
+	get := func(schema, key string) string {
+		out, err := exec.Command(path, "get", schema, key).Output()
+		if err != nil {
+			return ""
+		}
+		return strings.Trim(strings.TrimSpace(string(out)), "'")
+	}
+
+	const iface = "org.gnome.desktop.interface"
+	dark := get(iface, "color-scheme") == "prefer-dark" ||
+		strings.HasSuffix(strings.ToLower(get(iface, "gtk-theme")), "-dark")
+
+	return Settings{
+		AccentColor:   accentColors[get(iface, "accent-color")],
+		DarkMode:      dark,
+		ReducedMotion: get(iface, "enable-animations") == "false",
+		HighContrast:  get("org.gnome.desktop.a11y.interface", "high-contrast") == "true",
+		ScreenReader:  get("org.gnome.desktop.a11y.applications", "screen-reader-enabled") == "true",
+	}, nil
+}
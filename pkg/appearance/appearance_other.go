@@ -0,0 +1,9 @@
+//go:build !windows && !linux && !darwin
+
+package appearance
+
+import gomerrors "github.com/biyonik/gomad/internal/errors"
+
+func readSettings() (Settings, error) {
+	return Settings{}, gomerrors.NewOperationError("appearance.read", "appearance settings", ErrNotSupported)
+}
@@ -0,0 +1,19 @@
+//go:build windows
+
+package appearance
+
+import "github.com/biyonik/gomad/internal/platform/windows"
+
+func readSettings() (Settings, error) {
+	s := Settings{
+		DarkMode:            windows.AppsUseDarkTheme(),
+		ReducedMotion:       !windows.ClientAreaAnimation(),
+		ReducedTransparency: !windows.TransparencyEnabled(),
+		HighContrast:        windows.HighContrastEnabled(),
+		ScreenReader:        windows.ScreenReaderActive(),
+	}
+	if r, g, b, err := windows.AccentColor(); err == nil {
+		s.AccentColor = hexColor(r, g, b)
+	}
+	return s, nil
+}
@@ -0,0 +1,268 @@
+// Package bluetooth, Bluetooth aygıtlarını tarar, eşleşme durumlarını okur
+// ve Bluetooth Low Energy (GATT) karakteristiklerini okuyup yazar.
+//
+// WebView'lerde Web Bluetooth ya hiç yoktur (WebKitGTK, WKWebView) ya da
+// izin ve seçici diyaloğu nedeniyle güvenilmezdir. IoT yardımcı
+// uygulamaları aygıtlarla bu paket üzerinden doğrudan konuşur:
+//
+//	stop, err := bluetooth.Scan(func(e bluetooth.ScanEvent) {
+//	    fmt.Println(e.Device.Name, e.Device.RSSI)
+//	})
+//	...
+//	stop()
+//
+//	if err := bluetooth.Connect(id); err != nil {
+//	    return err
+//	}
+//	chars, _ := bluetooth.Characteristics(id)
+//	value, err := bluetooth.Read(chars[0].ID)
+//
+//	cancel, err := bluetooth.Notify(chars[1].ID, func(value []byte) {
+//	    heartRate.Feed(value)
+//	})
+//	defer cancel()
+//
+// Aygıt kimlikleri "AA:BB:CC:DD:EE:FF" biçiminde adreslerdir.
+// Karakteristik kimlikleri platforma özel ve opaktır; yalnızca
+// Characteristics'in döndürdüğü değerler kullanılmalıdır.
+//
+// Platform desteği:
+//   - Linux: BlueZ (D-Bus üzerinden). Tarama klasik ve LE aygıtları bulur;
+//     eşleştirme masaüstünün Bluetooth ayarlarından yapılır.
+//   - Windows: BluetoothApis. Tarama klasik aygıtları bulur (LE reklamları
+//     WinRT gerektirdiğinden bulunmaz); GATT yalnızca sistem ayarlarından
+//     eşleştirilmiş LE aygıtlarda kullanılabilir ve Windows bağlantıyı
+//     kendisi yönettiği için Connect ve Disconnect bir şey yapmaz.
+//   - Diğer: ErrNotSupported.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package bluetooth
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// ErrNotSupported, platform Bluetooth erişimini desteklemiyorsa döner.
+var ErrNotSupported = gomerrors.ErrNotSupported
+
+// ErrNoAdapter, sistemde kullanılabilir bir Bluetooth adaptörü yoksa döner.
+var ErrNoAdapter = errors.New("no bluetooth adapter")
+
+// ErrDeviceNotFound, aygıt kimliği geçersizse ya da aygıt bilinmiyorsa döner.
+var ErrDeviceNotFound = errors.New("bluetooth device not found")
+
+// ErrCharacteristicNotFound, karakteristik kimliği geçersizse ya da aygıt
+// bağlantısı koptuysa döner.
+var ErrCharacteristicNotFound = errors.New("bluetooth characteristic not found")
+
+// ErrNotConnected, GATT işlemi bağlı olmayan bir aygıtta istendiğinde döner.
+var ErrNotConnected = errors.New("bluetooth device not connected")
+
+// Device, bilinen ya da taramada bulunan bir aygıttır.
+type Device struct {
+	ID   string `json:"id"` // "AA:BB:CC:DD:EE:FF"
+	Name string `json:"name"`
+	// RSSI, son alınan sinyal gücüdür (dBm); bilinmiyorsa 0.
+	RSSI      int  `json:"rssi,omitempty"`
+	Paired    bool `json:"paired"`
+	Connected bool `json:"connected"`
+	// Services, aygıtın bildirdiği servis UUID'leridir.
+	Services []string `json:"services,omitempty"`
+}
+
+// ScanEventType, tarama olayının türüdür.
+type ScanEventType string
+
+const (
+	// EventDevice, yeni bulunan ya da bilgileri (RSSI, ad, bağlantı) değişen aygıttır.
+	EventDevice ScanEventType = "device"
+	// EventRemoved, sistemin artık tanımadığı aygıttır.
+	EventRemoved ScanEventType = "removed"
+)
+
+// ScanEvent, bir tarama olayıdır.
+type ScanEvent struct {
+	Type   ScanEventType `json:"type"`
+	Device Device        `json:"device"`
+}
+
+// Karakteristik özellikleri
+const (
+	PropRead                 = "read"
+	PropWrite                = "write"
+	PropWriteWithoutResponse = "write-without-response"
+	PropNotify               = "notify"
+	PropIndicate             = "indicate"
+)
+
+// Characteristic, bağlı bir LE aygıtın GATT karakteristiğidir.
+type Characteristic struct {
+	// ID, Read, Write ve Notify'a verilen opak kimliktir.
+	ID string `json:"id"`
+	// UUID ve Service, küçük harfli 128 bit UUID'lerdir
+	// ("00002a37-0000-1000-8000-00805f9b34fb").
+	UUID    string `json:"uuid"`
+	Service string `json:"service"`
+	// Properties, desteklenen işlemlerdir (PropRead, PropNotify, ...).
+	Properties []string `json:"properties"`
+}
+
+var (
+	subscribers = make(map[int]func(ScanEvent))
+	nextSub     int
+	subMu       sync.Mutex
+
+	// scanMu, taramanın başlatılıp durdurulmasını sıralar. subMu'dan ayrıdır:
+	// platform çağrıları sürerken gelen olaylar publish'i bloklamamalıdır.
+	scanMu   sync.Mutex
+	stopScan func()
+)
+
+// Devices, sistemin bildiği aygıtları döner: eşleştirilmiş, bağlı ve
+// (platform destekliyorsa) yakın zamanda taramada görülen aygıtlar.
+func Devices() ([]Device, error) {
+	devices, err := listDevices()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(devices, func(i, j int) bool { return devices[i].ID < devices[j].ID })
+	return devices, nil
+}
+
+// Scan, aygıt taramasına bir dinleyici ekler ve kaldırma fonksiyonu döner.
+// İlk dinleyicide tarama başlar, son dinleyici kaldırılınca durur. fn,
+// tarama goroutine'inde çağrılır; uzun işler ve bu paketin diğer
+// fonksiyonları için yeni goroutine başlatılmalıdır.
+func Scan(fn func(ScanEvent)) (stop func(), err error) {
+	scanMu.Lock()
+	defer scanMu.Unlock()
+
+	if stopScan == nil {
+		if stopScan, err = startScan(publish); err != nil {
+			return nil, err
+		}
+	}
+
+	subMu.Lock()
+	id := nextSub
+	nextSub++
+	subscribers[id] = fn
+	subMu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			scanMu.Lock()
+			defer scanMu.Unlock()
+
+			subMu.Lock()
+			delete(subscribers, id)
+			last := len(subscribers) == 0
+			subMu.Unlock()
+
+			if last && stopScan != nil {
+				stopScan()
+				stopScan = nil
+			}
+		})
+	}, nil
+}
+
+// publish, tarama olayını tüm dinleyicilere iletir.
+func publish(e ScanEvent) {
+	subMu.Lock()
+	fns := make([]func(ScanEvent), 0, len(subscribers))
+	for _, fn := range subscribers {
+		fns = append(fns, fn)
+	}
+	subMu.Unlock()
+
+	for _, fn := range fns {
+		fn(e)
+	}
+}
+
+// Connect, aygıta bağlanır ve servisleri çözülene kadar bekler.
+func Connect(id string) error {
+	id, err := normalizeAddress(id)
+	if err != nil {
+		return err
+	}
+	return connect(id)
+}
+
+// Disconnect, aygıtın bağlantısını keser.
+func Disconnect(id string) error {
+	id, err := normalizeAddress(id)
+	if err != nil {
+		return err
+	}
+	return disconnect(id)
+}
+
+// Characteristics, bağlı aygıtın GATT karakteristiklerini döner.
+func Characteristics(id string) ([]Characteristic, error) {
+	id, err := normalizeAddress(id)
+	if err != nil {
+		return nil, err
+	}
+	chars, err := characteristics(id)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(chars, func(i, j int) bool { return chars[i].ID < chars[j].ID })
+	return chars, nil
+}
+
+// Read, karakteristiğin değerini aygıttan okur.
+func Read(charID string) ([]byte, error) {
+	return readValue(charID)
+}
+
+// Write, karakteristiğe değer yazar. withoutResponse true ise aygıtın
+// onayı beklenmez (karakteristik PropWriteWithoutResponse desteklemelidir).
+func Write(charID string, value []byte, withoutResponse bool) error {
+	return writeValue(charID, value, withoutResponse)
+}
+
+// Notify, karakteristiğin bildirimlerine (ya da indication'larına) abone
+// olur ve aboneliği bitiren fonksiyonu döner. fn, platformun olay
+// goroutine'inde yeni değerle çağrılır.
+func Notify(charID string, fn func(value []byte)) (stop func(), err error) {
+	cancel, err := notify(charID, fn)
+	if err != nil {
+		return nil, err
+	}
+	var once sync.Once
+	return func() { once.Do(cancel) }, nil
+}
+
+// normalizeAddress, "aa:bb:cc:dd:ee:ff" biçimindeki adresi doğrular ve
+// büyük harfe çevirir.
+func normalizeAddress(id string) (string, error) {
+	if len(id) != 17 {
+		return "", fmt.Errorf("%w: %q", ErrDeviceNotFound, id)
+	}
+	for i := 0; i < len(id); i++ {
+		c := id[i]
+		if i%3 == 2 {
+			if c != ':' {
+				return "", fmt.Errorf("%w: %q", ErrDeviceNotFound, id)
+			}
+			continue
+		}
+		if !strings.ContainsRune("0123456789abcdefABCDEF", rune(c)) {
+			return "", fmt.Errorf("%w: %q", ErrDeviceNotFound, id)
+		}
+	}
+	return strings.ToUpper(id), nil
+}
@@ -0,0 +1,489 @@
+//go:build linux
+
+package bluetooth
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/platform/linux"
+)
+
+// BlueZ D-Bus adları
+const (
+	bluezService      = "org.bluez"
+	adapterIface      = "org.bluez.Adapter1"
+	deviceIface       = "org.bluez.Device1"
+	serviceIface      = "org.bluez.GattService1"
+	charIface         = "org.bluez.GattCharacteristic1"
+	propertiesIface   = "org.freedesktop.DBus.Properties"
+	objectManagerPath = "/"
+	objectManager     = "org.freedesktop.DBus.ObjectManager"
+)
+
+// resolveTimeout, Connect'ten sonra servislerin çözülmesinin en uzun
+// bekleme süresidir.
+const resolveTimeout = 10 * time.Second
+
+var (
+	bus   *linux.DBusConn
+	busMu sync.Mutex
+)
+
+// systemBus, paylaşılan sistem bus bağlantısını döner; bağlantı koptuysa
+// (ör. bluetoothd ya da dbus yeniden başladıysa) yenisini açar.
+func systemBus() (*linux.DBusConn, error) {
+	busMu.Lock()
+	defer busMu.Unlock()
+	if bus != nil {
+		select {
+		case <-bus.Done():
+			bus = nil
+		default:
+			return bus, nil
+		}
+	}
+	c, err := linux.SystemBus()
+	if err != nil {
+		return nil, gomerrors.NewDeviceError("bluetooth", "system bus is not available", ErrNotSupported)
+	}
+	bus = c
+	return bus, nil
+}
+
+// objects, BlueZ'nin nesne ağacıdır: yol → arayüz → özellik → değer.
+type objects map[string]map[string]map[string]interface{}
+
+// managedObjects, BlueZ'nin tüm nesnelerini okur.
+func managedObjects(c *linux.DBusConn) (objects, error) {
+	reply, err := c.Call(bluezService, objectManagerPath, objectManager, "GetManagedObjects")
+	if err != nil {
+		var derr *linux.DBusError
+		if errors.As(err, &derr) && derr.Name == "org.freedesktop.DBus.Error.ServiceUnknown" {
+			return nil, gomerrors.NewDeviceError("bluetooth", "bluetoothd is not running", ErrNoAdapter)
+		}
+		return nil, gomerrors.NewDeviceError("bluetooth", "list objects", err)
+	}
+	objs := objects{}
+	if len(reply) == 0 {
+		return objs, nil
+	}
+	tree, _ := reply[0].(map[string]interface{})
+	for path, v := range tree {
+		ifaces, _ := v.(map[string]interface{})
+		objs[path] = map[string]map[string]interface{}{}
+		for name, p := range ifaces {
+			props, _ := p.(map[string]interface{})
+			objs[path][name] = props
+		}
+	}
+	return objs, nil
+}
+
+// adapter, ilk Bluetooth adaptörünün yolunu döner.
+func (objs objects) adapter() (string, error) {
+	var paths []string
+	for path, ifaces := range objs {
+		if _, ok := ifaces[adapterIface]; ok {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) == 0 {
+		return "", ErrNoAdapter
+	}
+	sort.Strings(paths)
+	return paths[0], nil
+}
+
+// device, adresi verilen aygıtın yolunu döner.
+func (objs objects) device(id string) (string, error) {
+	for path, ifaces := range objs {
+		if props, ok := ifaces[deviceIface]; ok && strings.EqualFold(propString(props, "Address"), id) {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrDeviceNotFound, id)
+}
+
+func listDevices() ([]Device, error) {
+	c, err := systemBus()
+	if err != nil {
+		return nil, err
+	}
+	objs, err := managedObjects(c)
+	if err != nil {
+		return nil, err
+	}
+	devices := []Device{}
+	for _, ifaces := range objs {
+		if props, ok := ifaces[deviceIface]; ok {
+			var d Device
+			applyProps(&d, props)
+			devices = append(devices, d)
+		}
+	}
+	return devices, nil
+}
+
+// applyProps, Device1 özelliklerini aygıta uygular; taramada yalnızca
+// değişen özellikler gelir.
+func applyProps(d *Device, props map[string]interface{}) {
+	for key, v := range props {
+		value := v
+		if variant, ok := v.(linux.Variant); ok {
+			value = variant.Value
+		}
+		switch key {
+		case "Address":
+			d.ID, _ = value.(string)
+		case "Alias":
+			d.Name, _ = value.(string)
+		case "RSSI":
+			if rssi, ok := value.(int16); ok {
+				d.RSSI = int(rssi)
+			}
+		case "Paired":
+			d.Paired, _ = value.(bool)
+		case "Connected":
+			d.Connected, _ = value.(bool)
+		case "UUIDs":
+			list, _ := value.([]interface{})
+			d.Services = nil
+			for _, u := range list {
+				if s, ok := u.(string); ok {
+					d.Services = append(d.Services, strings.ToLower(s))
+				}
+			}
+		}
+	}
+}
+
+// propString, bir özelliğin metin değerini döner.
+func propString(props map[string]interface{}, key string) string {
+	if v, ok := props[key].(linux.Variant); ok {
+		s, _ := v.Value.(string)
+		return s
+	}
+	return ""
+}
+
+// propBool, bir özelliğin bool değerini döner.
+func propBool(props map[string]interface{}, key string) bool {
+	if v, ok := props[key].(linux.Variant); ok {
+		b, _ := v.Value.(bool)
+		return b
+	}
+	return false
+}
+
+func startScan(emit func(ScanEvent)) (func(), error) {
+	c, err := systemBus()
+	if err != nil {
+		return nil, err
+	}
+	objs, err := managedObjects(c)
+	if err != nil {
+		return nil, err
+	}
+	adapter, err := objs.adapter()
+	if err != nil {
+		return nil, gomerrors.NewDeviceError("bluetooth", "scan", err)
+	}
+
+	var (
+		known = map[string]*Device{}
+		mu    sync.Mutex
+	)
+	for path, ifaces := range objs {
+		if props, ok := ifaces[deviceIface]; ok {
+			d := &Device{}
+			applyProps(d, props)
+			known[path] = d
+		}
+	}
+
+	remove := c.OnSignal(func(s *linux.Signal) {
+		path := string(s.Path)
+		mu.Lock()
+		var events []ScanEvent
+		switch {
+		case s.Interface == objectManager && s.Member == "InterfacesAdded" && len(s.Body) == 2:
+			added, _ := s.Body[0].(linux.ObjectPath)
+			ifaces, _ := s.Body[1].(map[string]interface{})
+			if props, ok := ifaces[deviceIface].(map[string]interface{}); ok {
+				d := &Device{}
+				applyProps(d, props)
+				known[string(added)] = d
+				events = append(events, ScanEvent{Type: EventDevice, Device: *d})
+			}
+		case s.Interface == objectManager && s.Member == "InterfacesRemoved" && len(s.Body) == 2:
+			removed, _ := s.Body[0].(linux.ObjectPath)
+			ifaces, _ := s.Body[1].([]interface{})
+			for _, name := range ifaces {
+				if d, ok := known[string(removed)]; ok && name == deviceIface {
+					delete(known, string(removed))
+					events = append(events, ScanEvent{Type: EventRemoved, Device: *d})
+				}
+			}
+		case s.Interface == propertiesIface && s.Member == "PropertiesChanged" && len(s.Body) == 3:
+			d, ok := known[path]
+			if iface, _ := s.Body[0].(string); !ok || iface != deviceIface {
+				break
+			}
+			changed, _ := s.Body[1].(map[string]interface{})
+			applyProps(d, changed)
+			invalidated, _ := s.Body[2].([]interface{})
+			for _, name := range invalidated {
+				if name == "RSSI" {
+					d.RSSI = 0
+				}
+			}
+			events = append(events, ScanEvent{Type: EventDevice, Device: *d})
+		}
+		mu.Unlock()
+
+		for _, e := range events {
+			emit(e)
+		}
+	})
+
+	rules := []string{
+		"type='signal',sender='" + bluezService + "',interface='" + objectManager + "'",
+		"type='signal',sender='" + bluezService + "',interface='" + propertiesIface + "',member='PropertiesChanged',arg0='" + deviceIface + "'",
+	}
+	var added []string
+	cleanup := func() {
+		remove()
+		for _, rule := range added {
+			c.RemoveMatch(rule)
+		}
+	}
+	for _, rule := range rules {
+		if err := c.AddMatch(rule); err != nil {
+			cleanup()
+			return nil, gomerrors.NewDeviceError("bluetooth", "scan", err)
+		}
+		added = append(added, rule)
+	}
+
+	if _, err := c.Call(bluezService, linux.ObjectPath(adapter), adapterIface, "StartDiscovery"); err != nil {
+		cleanup()
+		return nil, gomerrors.NewDeviceError("bluetooth", "start discovery", err)
+	}
+
+	return func() {
+		c.Call(bluezService, linux.ObjectPath(adapter), adapterIface, "StopDiscovery")
+		cleanup()
+	}, nil
+}
+
+// devicePath, adresi verilen aygıtın yolunu ve bağlantıyı döner.
+func devicePath(id string) (*linux.DBusConn, string, error) {
+	c, err := systemBus()
+	if err != nil {
+		return nil, "", err
+	}
+	objs, err := managedObjects(c)
+	if err != nil {
+		return nil, "", err
+	}
+	path, err := objs.device(id)
+	if err != nil {
+		return nil, "", err
+	}
+	return c, path, nil
+}
+
+func connect(id string) error {
+	c, path, err := devicePath(id)
+	if err != nil {
+		return err
+	}
+	if _, err := c.Call(bluezService, linux.ObjectPath(path), deviceIface, "Connect"); err != nil {
+		return gomerrors.NewDeviceError("bluetooth", "connect "+id, err)
+	}
+	return waitResolved(c, path)
+}
+
+// waitResolved, BlueZ GATT servislerini keşfedene kadar bekler. Klasik
+// aygıtlarda ServicesResolved da Connect'ten kısa süre sonra true olur.
+func waitResolved(c *linux.DBusConn, path string) error {
+	deadline := time.Now().Add(resolveTimeout)
+	for {
+		reply, err := c.Call(bluezService, linux.ObjectPath(path), propertiesIface, "GetAll", deviceIface)
+		if err != nil {
+			return gomerrors.NewDeviceError("bluetooth", "read device state", err)
+		}
+		var props map[string]interface{}
+		if len(reply) > 0 {
+			props, _ = reply[0].(map[string]interface{})
+		}
+		if !propBool(props, "Connected") {
+			return ErrNotConnected
+		}
+		if propBool(props, "ServicesResolved") {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return gomerrors.NewDeviceError("bluetooth", "services were not resolved in time", ErrNotConnected)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func disconnect(id string) error {
+	c, path, err := devicePath(id)
+	if err != nil {
+		return err
+	}
+	if _, err := c.Call(bluezService, linux.ObjectPath(path), deviceIface, "Disconnect"); err != nil {
+		return gomerrors.NewDeviceError("bluetooth", "disconnect "+id, err)
+	}
+	return nil
+}
+
+func characteristics(id string) ([]Characteristic, error) {
+	c, path, err := devicePath(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := waitResolved(c, path); err != nil {
+		return nil, err
+	}
+	objs, err := managedObjects(c)
+	if err != nil {
+		return nil, err
+	}
+
+	chars := []Characteristic{}
+	for charPath, ifaces := range objs {
+		props, ok := ifaces[charIface]
+		if !ok || !strings.HasPrefix(charPath, path+"/") {
+			continue
+		}
+		ch := Characteristic{ID: charPath, UUID: strings.ToLower(propString(props, "UUID")), Properties: []string{}}
+		if v, ok := props["Service"].(linux.Variant); ok {
+			service, _ := v.Value.(linux.ObjectPath)
+			ch.Service = strings.ToLower(propString(objs[string(service)][serviceIface], "UUID"))
+		}
+		if v, ok := props["Flags"].(linux.Variant); ok {
+			flags, _ := v.Value.([]interface{})
+			for _, f := range flags {
+				switch f {
+				case PropRead, PropWrite, PropWriteWithoutResponse, PropNotify, PropIndicate:
+					ch.Properties = append(ch.Properties, f.(string))
+				}
+			}
+		}
+		chars = append(chars, ch)
+	}
+	return chars, nil
+}
+
+// charPath, karakteristik kimliğini doğrular. Kimlik BlueZ nesne yoludur
+// (/org/bluez/hci0/dev_AA_BB_CC_DD_EE_FF/service000c/char000d).
+func charPath(charID string) (linux.ObjectPath, error) {
+	if !strings.HasPrefix(charID, "/org/bluez/") || !strings.Contains(charID, "/char") || strings.Contains(charID, "..") {
+		return "", fmt.Errorf("%w: %q", ErrCharacteristicNotFound, charID)
+	}
+	return linux.ObjectPath(charID), nil
+}
+
+// gattError, BlueZ hatasını paket hatasına çevirir.
+func gattError(op string, err error) error {
+	var derr *linux.DBusError
+	if errors.As(err, &derr) {
+		switch derr.Name {
+		case "org.freedesktop.DBus.Error.UnknownObject", "org.freedesktop.DBus.Error.UnknownMethod":
+			return gomerrors.NewDeviceError("bluetooth", op, ErrCharacteristicNotFound)
+		case "org.bluez.Error.NotConnected":
+			return gomerrors.NewDeviceError("bluetooth", op, ErrNotConnected)
+		case "org.bluez.Error.NotPermitted", "org.bluez.Error.NotAuthorized":
+			return gomerrors.NewDeviceError("bluetooth", op+": "+derr.Message, gomerrors.ErrPermissionDenied)
+		case "org.bluez.Error.NotSupported":
+			return gomerrors.NewDeviceError("bluetooth", op, ErrNotSupported)
+		}
+	}
+	return gomerrors.NewDeviceError("bluetooth", op, err)
+}
+
+func readValue(charID string) ([]byte, error) {
+	path, err := charPath(charID)
+	if err != nil {
+		return nil, err
+	}
+	c, err := systemBus()
+	if err != nil {
+		return nil, err
+	}
+	reply, err := c.Call(bluezService, path, charIface, "ReadValue", map[string]linux.Variant{})
+	if err != nil {
+		return nil, gattError("read characteristic", err)
+	}
+	value, _ := reply[0].([]byte)
+	return value, nil
+}
+
+func writeValue(charID string, value []byte, withoutResponse bool) error {
+	path, err := charPath(charID)
+	if err != nil {
+		return err
+	}
+	c, err := systemBus()
+	if err != nil {
+		return err
+	}
+	kind := "request"
+	if withoutResponse {
+		kind = "command"
+	}
+	opts := map[string]linux.Variant{"type": {Signature: "s", Value: kind}}
+	if _, err := c.Call(bluezService, path, charIface, "WriteValue", value, opts); err != nil {
+		return gattError("write characteristic", err)
+	}
+	return nil
+}
+
+func notify(charID string, fn func([]byte)) (func(), error) {
+	path, err := charPath(charID)
+	if err != nil {
+		return nil, err
+	}
+	c, err := systemBus()
+	if err != nil {
+		return nil, err
+	}
+
+	rule := "type='signal',sender='" + bluezService + "',interface='" + propertiesIface +
+		"',member='PropertiesChanged',path='" + string(path) + "'"
+	if err := c.AddMatch(rule); err != nil {
+		return nil, gattError("subscribe", err)
+	}
+	remove := c.OnSignal(func(s *linux.Signal) {
+		if s.Path != path || s.Interface != propertiesIface || s.Member != "PropertiesChanged" || len(s.Body) < 2 {
+			return
+		}
+		changed, _ := s.Body[1].(map[string]interface{})
+		if v, ok := changed["Value"].(linux.Variant); ok {
+			if value, ok := v.Value.([]byte); ok {
+				fn(value)
+			}
+		}
+	})
+
+	if _, err := c.Call(bluezService, path, charIface, "StartNotify"); err != nil {
+		remove()
+		c.RemoveMatch(rule)
+		return nil, gattError("subscribe", err)
+	}
+	return func() {
+		c.Call(bluezService, path, charIface, "StopNotify")
+		remove()
+		c.RemoveMatch(rule)
+	}, nil
+}
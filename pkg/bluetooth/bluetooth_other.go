@@ -0,0 +1,39 @@
+//go:build !windows && !linux
+
+package bluetooth
+
+import (
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+func listDevices() ([]Device, error) {
+	return nil, gomerrors.NewDeviceError("bluetooth", "list devices", ErrNotSupported)
+}
+
+func startScan(emit func(ScanEvent)) (func(), error) {
+	return nil, gomerrors.NewDeviceError("bluetooth", "scan", ErrNotSupported)
+}
+
+func connect(id string) error {
+	return gomerrors.NewDeviceError("bluetooth", "connect "+id, ErrNotSupported)
+}
+
+func disconnect(id string) error {
+	return gomerrors.NewDeviceError("bluetooth", "disconnect "+id, ErrNotSupported)
+}
+
+func characteristics(id string) ([]Characteristic, error) {
+	return nil, gomerrors.NewDeviceError("bluetooth", "list characteristics", ErrNotSupported)
+}
+
+func readValue(charID string) ([]byte, error) {
+	return nil, gomerrors.NewDeviceError("bluetooth", "read characteristic", ErrNotSupported)
+}
+
+func writeValue(charID string, value []byte, withoutResponse bool) error {
+	return gomerrors.NewDeviceError("bluetooth", "write characteristic", ErrNotSupported)
+}
+
+func notify(charID string, fn func([]byte)) (func(), error) {
+	return nil, gomerrors.NewDeviceError("bluetooth", "subscribe", ErrNotSupported)
+}
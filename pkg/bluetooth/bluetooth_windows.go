@@ -0,0 +1,289 @@
+//go:build windows
+
+package bluetooth
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/platform/windows"
+)
+
+// inquiryMultiplier, tarama turlarının süresidir (4 × 1.28 sn ≈ 5 sn).
+const inquiryMultiplier = 4
+
+// retryInterval, başarısız bir tarama turundan sonra bekleme süresidir.
+const retryInterval = 2 * time.Second
+
+// inquiryMu, inquiry'leri sıralar: durdurulan bir tarama turunu bitirmeden
+// yenisi başlatılırsa iki inquiry aynı anda çalışmasın.
+var inquiryMu sync.Mutex
+
+func listDevices() ([]Device, error) {
+	return knownDevices(0)
+}
+
+// knownDevices, klasik aygıtları (inquiry > 0 ise taradıktan sonra) ve
+// eşleştirilmiş LE aygıtları birleştirir.
+func knownDevices(inquiry uint8) ([]Device, error) {
+	if !windows.BluetoothRadioAvailable() {
+		return nil, gomerrors.NewDeviceError("bluetooth", "list devices", ErrNoAdapter)
+	}
+	classic, err := windows.BluetoothDevices(inquiry)
+	if err != nil {
+		return nil, gomerrors.NewDeviceError("bluetooth", "list devices", err)
+	}
+
+	devices := []Device{}
+	index := map[string]int{}
+	for _, d := range classic {
+		index[d.Address] = len(devices)
+		devices = append(devices, Device{ID: d.Address, Name: d.Name, Paired: d.Authenticated, Connected: d.Connected})
+	}
+	for _, d := range windows.BLEDevices() {
+		if i, ok := index[d.Address]; ok {
+			devices[i].Paired = true
+			continue
+		}
+		index[d.Address] = len(devices)
+		devices = append(devices, Device{ID: d.Address, Name: d.Name, Paired: true})
+	}
+	return devices, nil
+}
+
+func startScan(emit func(ScanEvent)) (func(), error) {
+	if !windows.BluetoothRadioAvailable() {
+		return nil, gomerrors.NewDeviceError("bluetooth", "scan", ErrNoAdapter)
+	}
+	done := make(chan struct{})
+	go func() {
+		last := map[string]Device{}
+		for {
+			inquiryMu.Lock()
+			devices, err := knownDevices(inquiryMultiplier)
+			inquiryMu.Unlock()
+
+			if err != nil {
+				// Adaptör kapatıldıysa tekrar denemeden önce beklenir
+				select {
+				case <-done:
+					return
+				case <-time.After(retryInterval):
+				}
+				continue
+			}
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			current := make(map[string]Device, len(devices))
+			for _, d := range devices {
+				current[d.ID] = d
+				if prev, ok := last[d.ID]; !ok || prev.Name != d.Name || prev.Paired != d.Paired || prev.Connected != d.Connected {
+					emit(ScanEvent{Type: EventDevice, Device: d})
+				}
+			}
+			for id, d := range last {
+				if _, ok := current[id]; !ok {
+					emit(ScanEvent{Type: EventRemoved, Device: d})
+				}
+			}
+			last = current
+		}
+	}()
+	var once sync.Once
+	return func() { once.Do(func() { close(done) }) }, nil
+}
+
+// blePath, eşleştirilmiş LE aygıtın arayüz yolunu döner.
+func blePath(id string) (string, error) {
+	for _, d := range windows.BLEDevices() {
+		if d.Address == id {
+			return d.Path, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s is not a paired Bluetooth LE device", ErrDeviceNotFound, id)
+}
+
+// Windows LE bağlantılarını GATT işlemlerinde kendisi açar ve kapatır;
+// Connect yalnızca aygıtın kullanılabilir olduğunu doğrular.
+func connect(id string) error {
+	_, err := blePath(id)
+	return err
+}
+
+func disconnect(id string) error {
+	_, err := blePath(id)
+	return err
+}
+
+func characteristics(id string) ([]Characteristic, error) {
+	path, err := blePath(id)
+	if err != nil {
+		return nil, err
+	}
+	h, err := windows.OpenBLE(path)
+	if err != nil {
+		return nil, gomerrors.NewDeviceError("bluetooth", "open "+id, err)
+	}
+	defer syscall.CloseHandle(h)
+
+	services, err := windows.GATTServices(h)
+	if err != nil {
+		return nil, gattError("list services", err)
+	}
+	chars := []Characteristic{}
+	for i := range services {
+		list, err := windows.GATTCharacteristics(h, &services[i])
+		if err != nil {
+			return nil, gattError("list characteristics", err)
+		}
+		for _, c := range list {
+			chars = append(chars, Characteristic{
+				ID:         fmt.Sprintf("%s/%04x", id, c.AttributeHandle),
+				UUID:       c.CharacteristicUuid.String(),
+				Service:    services[i].ServiceUuid.String(),
+				Properties: properties(c),
+			})
+		}
+	}
+	return chars, nil
+}
+
+// properties, karakteristiğin desteklediği işlemleri döner.
+func properties(c windows.BTH_LE_GATT_CHARACTERISTIC) []string {
+	props := []string{}
+	for _, p := range []struct {
+		set  uint8
+		name string
+	}{
+		{c.IsReadable, PropRead},
+		{c.IsWritable, PropWrite},
+		{c.IsWritableWithoutResponse, PropWriteWithoutResponse},
+		{c.IsNotifiable, PropNotify},
+		{c.IsIndicatable, PropIndicate},
+	} {
+		if p.set != 0 {
+			props = append(props, p.name)
+		}
+	}
+	return props
+}
+
+// gattError, platform hatasını paket hatasına çevirir.
+func gattError(op string, err error) error {
+	if errors.Is(err, windows.ErrBluetoothNotFound) {
+		return gomerrors.NewDeviceError("bluetooth", op, ErrCharacteristicNotFound)
+	}
+	return gomerrors.NewDeviceError("bluetooth", op, err)
+}
+
+// gattChar, açık bir aygıt tanıtıcısıyla bulunan karakteristiktir.
+type gattChar struct {
+	handle  syscall.Handle
+	address string
+	service windows.BTH_LE_GATT_SERVICE
+	char    windows.BTH_LE_GATT_CHARACTERISTIC
+}
+
+// openChar, "AA:BB:CC:DD:EE:FF/000d" biçimindeki kimliğin aygıtını açar ve
+// karakteristiği attribute handle'ından bulur. Tanıtıcı kapatılmalıdır.
+func openChar(charID string) (*gattChar, error) {
+	address, attr, ok := strings.Cut(charID, "/")
+	handle, err := strconv.ParseUint(attr, 16, 16)
+	if !ok || err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrCharacteristicNotFound, charID)
+	}
+	address, err = normalizeAddress(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrCharacteristicNotFound, charID)
+	}
+	path, err := blePath(address)
+	if err != nil {
+		return nil, err
+	}
+	h, err := windows.OpenBLE(path)
+	if err != nil {
+		return nil, gomerrors.NewDeviceError("bluetooth", "open "+address, err)
+	}
+
+	services, err := windows.GATTServices(h)
+	if err != nil {
+		syscall.CloseHandle(h)
+		return nil, gattError("list services", err)
+	}
+	for i := range services {
+		chars, err := windows.GATTCharacteristics(h, &services[i])
+		if err != nil {
+			continue
+		}
+		for _, c := range chars {
+			if uint64(c.AttributeHandle) == handle {
+				return &gattChar{handle: h, address: address, service: services[i], char: c}, nil
+			}
+		}
+	}
+	syscall.CloseHandle(h)
+	return nil, fmt.Errorf("%w: %q", ErrCharacteristicNotFound, charID)
+}
+
+func readValue(charID string) ([]byte, error) {
+	c, err := openChar(charID)
+	if err != nil {
+		return nil, err
+	}
+	defer syscall.CloseHandle(c.handle)
+	value, err := windows.GATTRead(c.handle, &c.char)
+	if err != nil {
+		return nil, gattError("read characteristic", err)
+	}
+	return value, nil
+}
+
+func writeValue(charID string, value []byte, withoutResponse bool) error {
+	c, err := openChar(charID)
+	if err != nil {
+		return err
+	}
+	defer syscall.CloseHandle(c.handle)
+	if err := windows.GATTWrite(c.handle, &c.char, value, withoutResponse); err != nil {
+		return gattError("write characteristic", err)
+	}
+	return nil
+}
+
+func notify(charID string, fn func([]byte)) (func(), error) {
+	c, err := openChar(charID)
+	if err != nil {
+		return nil, err
+	}
+	servicePath := windows.BLEServicePath(c.address, c.service.ServiceUuid)
+	if servicePath == "" {
+		syscall.CloseHandle(c.handle)
+		return nil, gomerrors.NewDeviceError("bluetooth", "subscribe", ErrCharacteristicNotFound)
+	}
+	service, err := windows.OpenBLE(servicePath)
+	if err != nil {
+		syscall.CloseHandle(c.handle)
+		return nil, gomerrors.NewDeviceError("bluetooth", "subscribe", err)
+	}
+	cancel, err := windows.GATTSubscribe(c.handle, service, &c.char, fn)
+	if err != nil {
+		syscall.CloseHandle(service)
+		syscall.CloseHandle(c.handle)
+		return nil, gattError("subscribe", err)
+	}
+	return func() {
+		cancel()
+		syscall.CloseHandle(service)
+		syscall.CloseHandle(c.handle)
+	}, nil
+}
@@ -0,0 +1,167 @@
+// Package capture, ekranları ve pencereleri listeler; tek kare görüntü ya da
+// belirli FPS'te kare akışı yakalar.
+//
+// Ekran görüntüsü, ekran kaydı ve "pencere paylaş" özellikleri için
+// kullanılır. Kaynaklar kimlikleriyle seçilir:
+//
+//	sources, _ := capture.Sources()
+//	img, err := capture.Capture(sources[0].ID)
+//
+//	stop, err := capture.Stream("screen:0", 10, func(img image.Image) {
+//	    encoder.AddFrame(img)
+//	})
+//	defer stop()
+//
+// Platform desteği:
+//   - Windows: GDI (BitBlt, PrintWindow). İzin gerekmez.
+//   - Diğer: ErrNotSupported. macOS ScreenCaptureKit ve "Ekran Kaydı" izni,
+//     Linux ise Wayland'de xdg-desktop-portal gerektirir; ikisi de native
+//     (cgo/D-Bus) entegrasyon ister.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package capture
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"sync"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// ErrNotSupported, platform ekran yakalamayı desteklemiyorsa döner.
+var ErrNotSupported = gomerrors.ErrNotSupported
+
+// ErrSourceNotFound, kaynak kimliği geçersizse ya da kaynak kapandıysa döner.
+var ErrSourceNotFound = errors.New("capture source not found")
+
+// maxFPS, akışlar için izin verilen en yüksek kare hızıdır.
+const maxFPS = 30
+
+// SourceKind, yakalama kaynağının türüdür.
+type SourceKind string
+
+const (
+	KindScreen SourceKind = "screen"
+	KindWindow SourceKind = "window"
+)
+
+// Bounds, kaynağın sanal masaüstündeki konumu ve boyutudur.
+type Bounds struct {
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// Source, yakalanabilir bir ekran ya da penceredir.
+type Source struct {
+	ID      string     `json:"id"` // "screen:0", "window:1234"
+	Kind    SourceKind `json:"kind"`
+	Name    string     `json:"name"` // Ekran cihaz adı ya da pencere başlığı
+	Bounds  Bounds     `json:"bounds"`
+	Primary bool       `json:"primary"` // Yalnızca birincil ekran için true
+}
+
+// Sources, ekranları ve ardından pencereleri döner.
+func Sources() ([]Source, error) {
+	screens, err := Screens()
+	if err != nil {
+		return nil, err
+	}
+	windows, err := Windows()
+	if err != nil {
+		return nil, err
+	}
+	return append(screens, windows...), nil
+}
+
+// Screens, bağlı ekranları döner.
+func Screens() ([]Source, error) {
+	return listScreens()
+}
+
+// Windows, görünür üst seviye pencereleri döner.
+func Windows() ([]Source, error) {
+	return listWindows()
+}
+
+// Capture, kaynağın anlık görüntüsünü yakalar.
+func Capture(id string) (image.Image, error) {
+	return captureSource(id)
+}
+
+// CapturePNG, kaynağı yakalar ve PNG olarak kodlar.
+func CapturePNG(id string) ([]byte, error) {
+	img, err := captureSource(id)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// CaptureJPEG, kaynağı yakalar ve verilen kalitede (1-100) JPEG olarak kodlar.
+func CaptureJPEG(id string, quality int) ([]byte, error) {
+	img, err := captureSource(id)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Stream, kaynağı saniyede fps kare yakalar ve her kareyi fn'e verir.
+// fn, akış goroutine'inde çağrılır; yavaş kalırsa kareler atlanır.
+// Kaynak kapanırsa (ör. pencere kapandı) akış kendiliğinden durur.
+func Stream(id string, fps int, fn func(image.Image)) (stop func(), err error) {
+	if fps <= 0 || fps > maxFPS {
+		fps = maxFPS
+	}
+
+	// Kaynağı doğrula; ilk kare hemen gönderilir
+	first, err := captureSource(id)
+	if err != nil {
+		return nil, err
+	}
+
+	done := make(chan struct{})
+	var once sync.Once
+	stop = func() { once.Do(func() { close(done) }) }
+
+	go func() {
+		fn(first)
+		ticker := time.NewTicker(time.Second / time.Duration(fps))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				img, err := captureSource(id)
+				if errors.Is(err, ErrSourceNotFound) {
+					stop()
+					return
+				}
+				if err != nil {
+					continue
+				}
+				fn(img)
+			}
+		}
+	}()
+	return stop, nil
+}
@@ -0,0 +1,21 @@
+//go:build !windows
+
+package capture
+
+import (
+	"image"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+func listScreens() ([]Source, error) {
+	return nil, gomerrors.NewOperationError("capture.screens", "list screens", ErrNotSupported)
+}
+
+func listWindows() ([]Source, error) {
+	return nil, gomerrors.NewOperationError("capture.windows", "list windows", ErrNotSupported)
+}
+
+func captureSource(id string) (image.Image, error) {
+	return nil, gomerrors.NewOperationError("capture.source", "capture", ErrNotSupported)
+}
@@ -0,0 +1,78 @@
+//go:build windows
+
+package capture
+
+import (
+	"fmt"
+	"image"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/biyonik/gomad/internal/platform/windows"
+)
+
+func listScreens() ([]Source, error) {
+	monitors := windows.EnumMonitors()
+	sources := make([]Source, 0, len(monitors))
+	for i, m := range monitors {
+		sources = append(sources, Source{
+			ID:      fmt.Sprintf("screen:%d", i),
+			Kind:    KindScreen,
+			Name:    m.Device,
+			Bounds:  toBounds(m.Bounds),
+			Primary: m.Primary,
+		})
+	}
+	return sources, nil
+}
+
+func listWindows() ([]Source, error) {
+	windowList := windows.EnumTopWindows()
+	sources := make([]Source, 0, len(windowList))
+	for _, w := range windowList {
+		sources = append(sources, Source{
+			ID:     fmt.Sprintf("window:%d", w.Handle),
+			Kind:   KindWindow,
+			Name:   w.Title,
+			Bounds: toBounds(w.Bounds),
+		})
+	}
+	return sources, nil
+}
+
+func captureSource(id string) (image.Image, error) {
+	kind, value, ok := strings.Cut(id, ":")
+	if !ok {
+		return nil, ErrSourceNotFound
+	}
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return nil, ErrSourceNotFound
+	}
+
+	// ReleaseDC, GetDC ile aynı thread'den çağrılmalıdır (MSDN)
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	switch SourceKind(kind) {
+	case KindScreen:
+		monitors := windows.EnumMonitors()
+		if n >= uint64(len(monitors)) {
+			return nil, ErrSourceNotFound
+		}
+		return windows.CaptureScreen(monitors[n].Bounds)
+	case KindWindow:
+		hwnd := syscall.Handle(n)
+		if !windows.IsWindowVisible(hwnd) {
+			return nil, ErrSourceNotFound
+		}
+		return windows.CaptureWindow(hwnd)
+	}
+	return nil, ErrSourceNotFound
+}
+
+func toBounds(r windows.RECT) Bounds {
+	return Bounds{X: int(r.Left), Y: int(r.Top), Width: int(r.Width()), Height: int(r.Height())}
+}
@@ -0,0 +1,58 @@
+// Package credentials, sırları (erişim jetonları, parolalar) işletim
+// sisteminin güvenli kimlik deposunda saklar:
+//
+//   - Windows: Kimlik Bilgisi Yöneticisi (Credential Manager)
+//   - macOS: Anahtar Zinciri (Keychain, "security" aracıyla)
+//   - Linux: Secret Service / GNOME Keyring ("secret-tool" aracıyla)
+//
+// Sırlar diske düz metin olarak yazılmaz ve WebView'in localStorage'ı gibi
+// sayfa kodunun okuyabileceği yerlerde tutulmaz.
+//
+// Örnek:
+//
+//	err := credentials.Set("com.example.notes", "api", token)
+//	token, err := credentials.Get("com.example.notes", "api")
+//	if errors.Is(err, credentials.ErrNotFound) { ... }
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package credentials
+
+import (
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// ErrNotFound, istenen kimlik bilgisi depoda yoksa döner.
+var ErrNotFound = gomerrors.ErrNotFound
+
+// ErrNotSupported, platformda kullanılabilir bir güvenli depo yoksa döner
+// (ör. Secret Service çalışmayan bir Linux oturumu).
+var ErrNotSupported = gomerrors.ErrNotSupported
+
+// Set, service/account için secret'ı saklar; varsa üzerine yazar.
+// service genellikle uygulama kimliğidir (WithAppID).
+func Set(service, account, secret string) error {
+	if err := set(service, account, secret); err != nil {
+		return gomerrors.NewOperationError("credentials.set", service+"/"+account, err)
+	}
+	return nil
+}
+
+// Get, service/account sırrını döner. Yoksa ErrNotFound döner.
+func Get(service, account string) (string, error) {
+	secret, err := get(service, account)
+	if err != nil {
+		return "", gomerrors.NewOperationError("credentials.get", service+"/"+account, err)
+	}
+	return secret, nil
+}
+
+// Delete, service/account sırrını siler. Yoksa ErrNotFound döner.
+func Delete(service, account string) error {
+	if err := del(service, account); err != nil {
+		return gomerrors.NewOperationError("credentials.delete", service+"/"+account, err)
+	}
+	return nil
+}
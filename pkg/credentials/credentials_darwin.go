@@ -0,0 +1,46 @@
+//go:build darwin
+
+package credentials
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+)
+
+// errSecItemNotFound, "security" aracının kayıt bulunamadığında döndüğü çıkış kodudur.
+const errSecItemNotFound = 44
+
+func set(service, account, secret string) error {
+	// -U: varsa güncelle. Sır argüman olarak geçer; "security" stdin'den
+	// okumayı yalnızca etkileşimli modda desteklediğinden başka yol yoktur.
+	return run("add-generic-password", "-U", "-s", service, "-a", account, "-w", secret)
+}
+
+func get(service, account string) (string, error) {
+	out, err := exec.Command("security", "find-generic-password", "-s", service, "-a", account, "-w").Output()
+	if err != nil {
+		return "", keychainError(err)
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+func del(service, account string) error {
+	return run("delete-generic-password", "-s", service, "-a", account)
+}
+
+func run(args ...string) error {
+	if err := exec.Command("security", args...).Run(); err != nil {
+		return keychainError(err)
+	}
+	return nil
+}
+
+// keychainError, "security" çıkış kodunu paket hatalarına çevirir.
+func keychainError(err error) error {
+	var exit *exec.ExitError
+	if errors.As(err, &exit) && exit.ExitCode() == errSecItemNotFound {
+		return ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,56 @@
+//go:build linux
+
+package credentials
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+)
+
+// secretTool, libsecret'in komut satırı aracını bulur. Yoksa (ör. minimal
+// kurulumlar) güvenli depo kullanılamaz; sırlar düz metne düşürülmez.
+func secretTool() (string, error) {
+	path, err := exec.LookPath("secret-tool")
+	if err != nil {
+		return "", ErrNotSupported
+	}
+	return path, nil
+}
+
+func set(service, account, secret string) error {
+	tool, err := secretTool()
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command(tool, "store", "--label", service+" ("+account+")", "service", service, "account", account)
+	cmd.Stdin = strings.NewReader(secret) // sır süreç argümanlarında görünmesin
+	return cmd.Run()
+}
+
+func get(service, account string) (string, error) {
+	tool, err := secretTool()
+	if err != nil {
+		return "", err
+	}
+	out, err := exec.Command(tool, "lookup", "service", service, "account", account).Output()
+	var exit *exec.ExitError
+	if errors.As(err, &exit) && len(out) == 0 {
+		return "", ErrNotFound // secret-tool kayıt yokken 1 ile çıkar
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func del(service, account string) error {
+	tool, err := secretTool()
+	if err != nil {
+		return err
+	}
+	if _, err := get(service, account); err != nil {
+		return err
+	}
+	return exec.Command(tool, "clear", "service", service, "account", account).Run()
+}
@@ -0,0 +1,9 @@
+//go:build !windows && !darwin && !linux
+
+package credentials
+
+func set(service, account, secret string) error { return ErrNotSupported }
+
+func get(service, account string) (string, error) { return "", ErrNotSupported }
+
+func del(service, account string) error { return ErrNotSupported }
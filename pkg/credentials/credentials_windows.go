@@ -0,0 +1,37 @@
+//go:build windows
+
+package credentials
+
+import (
+	"errors"
+
+	"github.com/biyonik/gomad/internal/platform/windows"
+)
+
+// target, Credential Manager'daki kaydın adıdır.
+func target(service, account string) string {
+	return service + ":" + account
+}
+
+func set(service, account, secret string) error {
+	return windows.CredWrite(target(service, account), account, []byte(secret))
+}
+
+func get(service, account string) (string, error) {
+	blob, err := windows.CredRead(target(service, account))
+	if errors.Is(err, windows.ERROR_NOT_FOUND) {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(blob), nil
+}
+
+func del(service, account string) error {
+	err := windows.CredDelete(target(service, account))
+	if errors.Is(err, windows.ERROR_NOT_FOUND) {
+		return ErrNotFound
+	}
+	return err
+}
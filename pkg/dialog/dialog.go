@@ -0,0 +1,120 @@
+// Package dialog, native işletim sistemi diyaloglarını (dosya seçme, kaydetme,
+// klasör seçme) sunar.
+//
+// Webview içindeki <input type=file> yalnızca dosya içeriğine erişim verir;
+// gerçek dosya yolunu, klasör seçimini ve "Farklı Kaydet" diyaloğunu sağlamaz.
+// Bu paket işletim sisteminin kendi diyaloglarını açar ve seçilen yolları döner.
+//
+// Örnek:
+//
+//	path, err := dialog.OpenFile(dialog.Options{
+//	    Title:   "Resim seç",
+//	    Filters: []dialog.Filter{{Name: "Resimler", Patterns: []string{"*.png", "*.jpg"}}},
+//	})
+//	if err != nil { ... }
+//	if path == "" { /* kullanıcı iptal etti */ }
+//
+// Diyaloglar modaldır ve kullanıcı kapatana kadar bloklar. Herhangi bir
+// goroutine'den çağrılabilir. JS tarafından gomad.dialog.* ile kullanılır.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package dialog
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// Filter, diyalogdaki dosya türü filtresidir.
+type Filter struct {
+	// Name, filtre listesinde görünen addır. Örn: "Resimler".
+	Name string `json:"name"`
+
+	// Patterns, glob desenleridir. Örn: ["*.png", "*.jpg"].
+	Patterns []string `json:"patterns"`
+}
+
+// Options, dosya diyaloğu ayarlarıdır. Tüm alanlar isteğe bağlıdır.
+type Options struct {
+	// Title, diyalog başlığıdır.
+	Title string `json:"title,omitempty"`
+
+	// ButtonLabel, onay butonunun metnidir. Boşsa sistem varsayılanı kullanılır.
+	ButtonLabel string `json:"buttonLabel,omitempty"`
+
+	// DefaultPath, diyaloğun açılacağı konumdur. Var olan bir klasörse o klasör
+	// açılır; aksi halde son bileşen dosya adı olarak önerilir.
+	DefaultPath string `json:"defaultPath,omitempty"`
+
+	// Filters, seçilebilecek dosya türleridir. İlk filtre varsayılandır.
+	// Kaydetme diyaloğunda kullanıcı uzantı yazmazsa ilk filtrenin uzantısı eklenir.
+	Filters []Filter `json:"filters,omitempty"`
+
+	// Owner, diyaloğun sahibi olan native pencere tutamacıdır. Verilirse diyalog
+	// pencerenin üzerinde açılır ve kapanana kadar pencereyi kilitler.
+	Owner uintptr `json:"-"`
+}
+
+// OpenFile, tek dosya seçme diyaloğu açar.
+// Kullanıcı iptal ederse boş string ve nil hata döner.
+func OpenFile(opts Options) (string, error) {
+	return single(platform.FileDialogOpen, opts)
+}
+
+// OpenFiles, birden fazla dosya seçilebilen bir diyalog açar.
+// Kullanıcı iptal ederse boş slice ve nil hata döner.
+func OpenFiles(opts Options) ([]string, error) {
+	return showFileDialog(opts.toPlatform(platform.FileDialogOpenMulti))
+}
+
+// OpenFolder, klasör seçme diyaloğu açar. Filters yok sayılır.
+// Kullanıcı iptal ederse boş string ve nil hata döner.
+func OpenFolder(opts Options) (string, error) {
+	return single(platform.FileDialogFolder, opts)
+}
+
+// SaveFile, "Farklı Kaydet" diyaloğu açar. Var olan dosya seçilirse üzerine
+// yazma onayı istenir; dosya oluşturulmaz, yalnızca yol döner.
+// Kullanıcı iptal ederse boş string ve nil hata döner.
+func SaveFile(opts Options) (string, error) {
+	return single(platform.FileDialogSave, opts)
+}
+
+func single(kind platform.FileDialogKind, opts Options) (string, error) {
+	paths, err := showFileDialog(opts.toPlatform(kind))
+	if err != nil || len(paths) == 0 {
+		return "", err
+	}
+	return paths[0], nil
+}
+
+// toPlatform, public seçenekleri platform katmanının modeline çevirir.
+func (o Options) toPlatform(kind platform.FileDialogKind) platform.FileDialogOptions {
+	out := platform.FileDialogOptions{
+		Kind:        kind,
+		Title:       o.Title,
+		ButtonLabel: o.ButtonLabel,
+		Owner:       o.Owner,
+	}
+
+	if o.DefaultPath != "" {
+		if info, err := os.Stat(o.DefaultPath); err == nil && info.IsDir() {
+			out.Directory = o.DefaultPath
+		} else {
+			out.Directory, out.FileName = filepath.Split(o.DefaultPath)
+		}
+		if out.Directory != "" {
+			out.Directory, _ = filepath.Abs(out.Directory)
+		}
+	}
+
+	for _, f := range o.Filters {
+		out.Filters = append(out.Filters, platform.FileFilter{Name: f.Name, Patterns: f.Patterns})
+	}
+	return out
+}
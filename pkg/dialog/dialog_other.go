@@ -0,0 +1,18 @@
+//go:build !windows
+
+package dialog
+
+import (
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// showFileDialog, native diyalog desteği henüz olmayan platformlarda ErrNotSupported döner.
+func showFileDialog(opts platform.FileDialogOptions) ([]string, error) {
+	return nil, gomerrors.NewWindowError("dialog", "file dialog", gomerrors.ErrNotSupported)
+}
+
+// showMessage, native mesaj kutusu desteği henüz olmayan platformlarda ErrNotSupported döner.
+func showMessage(opts MessageOptions) (Button, error) {
+	return "", gomerrors.NewWindowError("dialog", "message box", gomerrors.ErrNotSupported)
+}
@@ -0,0 +1,68 @@
+//go:build windows
+
+package dialog
+
+import (
+	"fmt"
+	"runtime"
+	"syscall"
+
+	"github.com/biyonik/gomad/internal/platform"
+	"github.com/biyonik/gomad/internal/platform/windows"
+)
+
+// showFileDialog, IFileDialog ile native diyaloğu açar.
+// COM apartment'ı thread'e bağlı olduğu için çağrı süresince thread kilitlenir.
+func showFileDialog(opts platform.FileDialogOptions) ([]string, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	return windows.ShowFileDialog(opts)
+}
+
+// showMessage, MessageBoxW ile mesaj kutusu gösterir.
+func showMessage(opts MessageOptions) (Button, error) {
+	flags := uint32(windows.MB_SETFOREGROUND)
+	if opts.Owner == 0 {
+		flags |= windows.MB_TASKMODAL
+	}
+
+	switch opts.Kind {
+	case KindInfo:
+		flags |= windows.MB_ICONINFORMATION
+	case KindWarning:
+		flags |= windows.MB_ICONWARNING
+	case KindError:
+		flags |= windows.MB_ICONERROR
+	case KindQuestion:
+		flags |= windows.MB_ICONQUESTION
+	}
+
+	switch opts.Buttons {
+	case ButtonsOK:
+		flags |= windows.MB_OK
+	case ButtonsOKCancel:
+		flags |= windows.MB_OKCANCEL
+	case ButtonsYesNo:
+		flags |= windows.MB_YESNO
+	case ButtonsYesNoCancel:
+		flags |= windows.MB_YESNOCANCEL
+	case ButtonsRetryCancel:
+		flags |= windows.MB_RETRYCANCEL
+	}
+
+	ret := windows.MessageBox(syscall.Handle(opts.Owner), opts.Text, opts.Title, flags)
+	switch ret {
+	case windows.IDOK:
+		return ButtonOK, nil
+	case windows.IDCANCEL:
+		return ButtonCancel, nil
+	case windows.IDYES:
+		return ButtonYes, nil
+	case windows.IDNO:
+		return ButtonNo, nil
+	case windows.IDRETRY:
+		return ButtonRetry, nil
+	}
+	return "", fmt.Errorf("MessageBox failed: %w", syscall.GetLastError())
+}
@@ -0,0 +1,104 @@
+package dialog
+
+import (
+	"fmt"
+)
+
+// MessageKind, mesaj kutusunun türüdür; ikonu ve sistem sesini belirler.
+type MessageKind string
+
+const (
+	KindInfo     MessageKind = "info"
+	KindWarning  MessageKind = "warning"
+	KindError    MessageKind = "error"
+	KindQuestion MessageKind = "question"
+)
+
+// Buttons, mesaj kutusunda gösterilecek buton grubudur.
+type Buttons string
+
+const (
+	ButtonsOK          Buttons = "ok"
+	ButtonsOKCancel    Buttons = "okCancel"
+	ButtonsYesNo       Buttons = "yesNo"
+	ButtonsYesNoCancel Buttons = "yesNoCancel"
+	ButtonsRetryCancel Buttons = "retryCancel"
+)
+
+// Button, kullanıcının bastığı butondur.
+type Button string
+
+const (
+	ButtonOK     Button = "ok"
+	ButtonCancel Button = "cancel"
+	ButtonYes    Button = "yes"
+	ButtonNo     Button = "no"
+	ButtonRetry  Button = "retry"
+)
+
+// MessageOptions, mesaj kutusu ayarlarıdır.
+type MessageOptions struct {
+	Kind    MessageKind `json:"kind,omitempty"`    // Boşsa KindInfo
+	Title   string      `json:"title,omitempty"`   // Başlık çubuğu metni
+	Text    string      `json:"text"`              // Mesaj metni
+	Buttons Buttons     `json:"buttons,omitempty"` // Boşsa ButtonsOK
+
+	// Owner, sahip pencere tutamacıdır. 0 ise kutu sahipsiz açılır ve
+	// WebView çalışmasa (ör. çökmüş olsa) bile gösterilebilir.
+	Owner uintptr `json:"-"`
+}
+
+// Message, native bir mesaj kutusu gösterir ve basılan butonu döner.
+// Kullanıcı kutuyu Esc veya kapatma butonuyla kapatırsa, grupta varsa
+// ButtonCancel, yoksa ButtonNo/ButtonOK döner.
+//
+// Örnek:
+//
+//	btn, err := dialog.Message(dialog.KindQuestion, "Kaydet", "Değişiklikler kaydedilsin mi?", dialog.ButtonsYesNoCancel)
+//	if btn == dialog.ButtonYes { ... }
+//
+// WebView'e bağımlı değildir; UI bozulmuşken hata bildirmek için de kullanılabilir.
+func Message(kind MessageKind, title, text string, buttons Buttons) (Button, error) {
+	return ShowMessage(MessageOptions{Kind: kind, Title: title, Text: text, Buttons: buttons})
+}
+
+// ShowMessage, Message'ın seçenek yapısı alan halidir.
+func ShowMessage(opts MessageOptions) (Button, error) {
+	if opts.Kind == "" {
+		opts.Kind = KindInfo
+	}
+	if opts.Buttons == "" {
+		opts.Buttons = ButtonsOK
+	}
+
+	switch opts.Kind {
+	case KindInfo, KindWarning, KindError, KindQuestion:
+	default:
+		return "", fmt.Errorf("unknown message kind %q", opts.Kind)
+	}
+	switch opts.Buttons {
+	case ButtonsOK, ButtonsOKCancel, ButtonsYesNo, ButtonsYesNoCancel, ButtonsRetryCancel:
+	default:
+		return "", fmt.Errorf("unknown button set %q", opts.Buttons)
+	}
+
+	return showMessage(opts)
+}
+
+// Info, bilgi mesajı gösterir.
+func Info(title, text string) error {
+	_, err := Message(KindInfo, title, text, ButtonsOK)
+	return err
+}
+
+// Error, hata mesajı gösterir.
+func Error(title, text string) error {
+	_, err := Message(KindError, title, text, ButtonsOK)
+	return err
+}
+
+// Confirm, Evet/Hayır sorusu sorar; Evet'e basıldıysa true döner.
+func Confirm(title, text string) (bool, error) {
+	btn, err := Message(KindQuestion, title, text, ButtonsYesNo)
+	return btn == ButtonYes, err
+}
@@ -0,0 +1,209 @@
+// Package fswatch, dizinlerdeki dosya değişikliklerini izler ve kısa süre
+// içindeki değişiklikleri tek bir toplu bildirimde birleştirir (debounce).
+//
+// "Klasörü izle" ve otomatik yeniden yükleme özellikleri için kullanılır.
+// Bir editörün kaydetme işlemi (geçici dosya, yeniden adlandırma, yazma)
+// tek bir değişiklik olarak görünür.
+//
+// Örnek:
+//
+//	cancel, err := fswatch.Watch("/data/inbox", fswatch.Options{
+//	    Recursive: true,
+//	    Ignore:    []string{"*.tmp", ".git"},
+//	}, func(changes []fswatch.Change) {
+//	    for _, c := range changes {
+//	        log.Println(c.Op, c.Path)
+//	    }
+//	})
+//	defer cancel()
+//
+// Platform desteği:
+//   - Linux: inotify.
+//   - Diğer: dosya sistemini periyodik tarama (1 sn).
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package fswatch
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// DefaultDebounce, Options.Debounce verilmezse kullanılan sessizlik süresidir.
+const DefaultDebounce = 200 * time.Millisecond
+
+// ErrNotDirectory, izlenen yol bir dizin değilse döner.
+var ErrNotDirectory = errors.New("fswatch: path is not a directory")
+
+// Op, değişikliğin türüdür.
+type Op string
+
+const (
+	OpCreate Op = "create" // Dosya/dizin oluşturuldu (ya da içeri taşındı)
+	OpWrite  Op = "write"  // İçerik değişti
+	OpRemove Op = "remove" // Silindi (ya da dışarı taşındı)
+)
+
+// Change, tek bir yoldaki değişikliktir.
+type Change struct {
+	Path string `json:"path"`
+	Op   Op     `json:"op"`
+}
+
+// Options, izleme ayarlarıdır.
+type Options struct {
+	// Recursive, alt dizinleri de izler.
+	Recursive bool `json:"recursive"`
+
+	// Debounce, son değişiklikten sonra bildirimden önce beklenecek süredir.
+	// Sıfırsa DefaultDebounce kullanılır.
+	Debounce time.Duration `json:"-"`
+
+	// Ignore, yok sayılacak dosya/dizin adı kalıplarıdır (filepath.Match,
+	// yalnızca yolun son elemanına uygulanır), ör. "*.tmp", ".git".
+	Ignore []string `json:"ignore"`
+}
+
+// Watch, dizini izlemeye başlar ve durdurma fonksiyonu döner.
+// fn, birleştirilmiş değişikliklerle izleyici goroutine'inde çağrılır.
+func Watch(path string, opts Options, fn func([]Change)) (cancel func(), err error) {
+	root, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, ErrNotDirectory
+	}
+	if opts.Debounce <= 0 {
+		opts.Debounce = DefaultDebounce
+	}
+
+	w := &watcher{
+		root:    root,
+		opts:    opts,
+		changes: make(chan Change, 256),
+		done:    make(chan struct{}),
+	}
+	if err := w.start(); err != nil {
+		return nil, err
+	}
+	go w.debounce(fn)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(w.done)
+			w.stop()
+		})
+	}, nil
+}
+
+// watcher, tek bir Watch çağrısının durumudur. start/stop platform dosyalarındadır.
+type watcher struct {
+	root    string
+	opts    Options
+	changes chan Change
+	done    chan struct{}
+
+	backend
+}
+
+// emit, backend'den gelen değişikliği (yok sayılmıyorsa) kuyruğa ekler.
+func (w *watcher) emit(path string, op Op) {
+	if w.ignored(path) {
+		return
+	}
+	select {
+	case w.changes <- Change{Path: path, Op: op}:
+	case <-w.done:
+	}
+}
+
+// ignored, yolun ya da (kök altındaki) herhangi bir üst dizinin Ignore
+// kalıplarından birine uyup uymadığını döner.
+func (w *watcher) ignored(path string) bool {
+	if len(w.opts.Ignore) == 0 {
+		return false
+	}
+	rel, err := filepath.Rel(w.root, path)
+	if err != nil {
+		return false
+	}
+	for rel != "." && rel != string(filepath.Separator) && rel != "" {
+		name := filepath.Base(rel)
+		for _, pattern := range w.opts.Ignore {
+			if ok, _ := filepath.Match(pattern, name); ok {
+				return true
+			}
+		}
+		rel = filepath.Dir(rel)
+	}
+	return false
+}
+
+// debounce, değişiklikleri Debounce süresi sessizlik olana kadar toplar,
+// aynı yoldaki ardışık değişiklikleri birleştirir ve fn'e verir.
+func (w *watcher) debounce(fn func([]Change)) {
+	pending := make(map[string]Op)
+	var order []string
+	timer := time.NewTimer(time.Hour)
+	timer.Stop()
+
+	for {
+		select {
+		case <-w.done:
+			timer.Stop()
+			return
+
+		case c := <-w.changes:
+			prev, seen := pending[c.Path]
+			if !seen {
+				order = append(order, c.Path)
+				pending[c.Path] = c.Op
+			} else if op, keep := mergeOp(prev, c.Op); keep {
+				pending[c.Path] = op
+			} else {
+				delete(pending, c.Path)
+			}
+			timer.Reset(w.opts.Debounce)
+
+		case <-timer.C:
+			batch := make([]Change, 0, len(pending))
+			for _, path := range order {
+				if op, ok := pending[path]; ok {
+					batch = append(batch, Change{Path: path, Op: op})
+				}
+			}
+			pending = make(map[string]Op)
+			order = nil
+			if len(batch) > 0 {
+				fn(batch)
+			}
+		}
+	}
+}
+
+// mergeOp, aynı yoldaki iki ardışık değişikliği birleştirir.
+// keep false ise değişiklikler birbirini götürür (oluşturulup silinen geçici dosya).
+func mergeOp(prev, next Op) (op Op, keep bool) {
+	switch {
+	case prev == OpCreate && next == OpRemove:
+		return "", false
+	case prev == OpCreate:
+		return OpCreate, true
+	case prev == OpRemove && next == OpCreate:
+		return OpWrite, true // Atomik kaydetme: sil + yeniden oluştur
+	default:
+		return next, true
+	}
+}
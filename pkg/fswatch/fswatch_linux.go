@@ -0,0 +1,142 @@
+//go:build linux
+
+package fswatch
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+const inotifyMask = syscall.IN_CREATE | syscall.IN_MODIFY | syscall.IN_CLOSE_WRITE |
+	syscall.IN_DELETE | syscall.IN_MOVED_FROM | syscall.IN_MOVED_TO |
+	syscall.IN_DELETE_SELF | syscall.IN_ONLYDIR
+
+// backend, inotify tanımlayıcısını ve izleme tablosunu tutar.
+type backend struct {
+	file *os.File
+	fd   int
+
+	dirs map[int32]string // watch descriptor → dizin
+	mu   sync.Mutex
+}
+
+func (w *watcher) start() error {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		return os.NewSyscallError("inotify_init1", err)
+	}
+	w.fd = fd
+	// Non-blocking fd, Go'nun poller'ına bağlanır; Close bekleyen Read'i uyandırır
+	w.file = os.NewFile(uintptr(fd), "inotify")
+	w.dirs = make(map[int32]string)
+
+	if err := w.addDir(w.root, false); err != nil {
+		w.file.Close()
+		return err
+	}
+	go w.read()
+	return nil
+}
+
+func (w *watcher) stop() {
+	w.file.Close()
+}
+
+// addDir, dizini (ve Recursive ise alt dizinlerini) izlemeye ekler.
+// report true ise bulunan girdiler OpCreate olarak bildirilir; yeni oluşturulan
+// bir dizine izleme eklenene kadar içine yazılan dosyalar böylece kaçırılmaz.
+func (w *watcher) addDir(dir string, report bool) error {
+	if err := w.addWatch(dir); err != nil {
+		return err
+	}
+	if !w.opts.Recursive && !report {
+		return nil
+	}
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || path == dir {
+			return nil
+		}
+		if w.ignored(path) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if report {
+			w.emit(path, OpCreate)
+		}
+		if d.IsDir() {
+			if !w.opts.Recursive {
+				return filepath.SkipDir
+			}
+			_ = w.addWatch(path)
+		}
+		return nil
+	})
+}
+
+func (w *watcher) addWatch(dir string) error {
+	wd, err := syscall.InotifyAddWatch(w.fd, dir, inotifyMask)
+	if err != nil {
+		return os.NewSyscallError("inotify_add_watch", err)
+	}
+	w.mu.Lock()
+	w.dirs[int32(wd)] = dir
+	w.mu.Unlock()
+	return nil
+}
+
+// read, inotify olaylarını okur ve değişikliklere çevirir.
+func (w *watcher) read() {
+	buf := make([]byte, 64*1024)
+	for {
+		n, err := w.file.Read(buf)
+		if err != nil {
+			return // stop ile kapatıldı
+		}
+
+		for offset := 0; offset+syscall.SizeofInotifyEvent <= n; {
+			event := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			nameBytes := buf[offset+syscall.SizeofInotifyEvent : offset+syscall.SizeofInotifyEvent+int(event.Len)]
+			offset += syscall.SizeofInotifyEvent + int(event.Len)
+
+			w.mu.Lock()
+			dir, ok := w.dirs[event.Wd]
+			if event.Mask&(syscall.IN_DELETE_SELF|syscall.IN_IGNORED) != 0 {
+				delete(w.dirs, event.Wd)
+			}
+			w.mu.Unlock()
+			if !ok || event.Mask&syscall.IN_DELETE_SELF != 0 {
+				continue
+			}
+
+			name := string(nameBytes)
+			for i := 0; i < len(name); i++ {
+				if name[i] == 0 {
+					name = name[:i]
+					break
+				}
+			}
+			if name == "" {
+				continue
+			}
+			path := filepath.Join(dir, name)
+
+			switch {
+			case event.Mask&(syscall.IN_CREATE|syscall.IN_MOVED_TO) != 0:
+				w.emit(path, OpCreate)
+				if event.Mask&syscall.IN_ISDIR != 0 && w.opts.Recursive && !w.ignored(path) {
+					_ = w.addDir(path, true)
+				}
+			case event.Mask&(syscall.IN_DELETE|syscall.IN_MOVED_FROM) != 0:
+				w.emit(path, OpRemove)
+			case event.Mask&(syscall.IN_MODIFY|syscall.IN_CLOSE_WRITE) != 0:
+				w.emit(path, OpWrite)
+			}
+		}
+	}
+}
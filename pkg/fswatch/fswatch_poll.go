@@ -0,0 +1,88 @@
+//go:build !linux
+
+package fswatch
+
+import (
+	"io/fs"
+	"path/filepath"
+	"time"
+)
+
+// pollInterval, dosya sisteminin taranma sıklığıdır.
+const pollInterval = time.Second
+
+// fileState, bir yolun son taramadaki durumudur.
+type fileState struct {
+	modTime time.Time
+	size    int64
+	dir     bool
+}
+
+// backend, periyodik taramanın son görüntüsünü tutar.
+type backend struct {
+	snapshot map[string]fileState
+}
+
+func (w *watcher) start() error {
+	w.snapshot = w.scan()
+	go w.poll()
+	return nil
+}
+
+func (w *watcher) stop() {}
+
+// scan, kökün (Recursive ise tüm ağacın) anlık görüntüsünü alır.
+func (w *watcher) scan() map[string]fileState {
+	snapshot := make(map[string]fileState)
+	filepath.WalkDir(w.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || path == w.root {
+			return nil
+		}
+		if w.ignored(path) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		snapshot[path] = fileState{modTime: info.ModTime(), size: info.Size(), dir: d.IsDir()}
+		if d.IsDir() && !w.opts.Recursive {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	return snapshot
+}
+
+// poll, taramaları karşılaştırıp farkları değişiklik olarak bildirir.
+func (w *watcher) poll() {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-ticker.C:
+		}
+
+		current := w.scan()
+		for path, state := range current {
+			prev, ok := w.snapshot[path]
+			switch {
+			case !ok:
+				w.emit(path, OpCreate)
+			case !state.dir && (state.size != prev.size || !state.modTime.Equal(prev.modTime)):
+				w.emit(path, OpWrite)
+			}
+		}
+		for path := range w.snapshot {
+			if _, ok := current[path]; !ok {
+				w.emit(path, OpRemove)
+			}
+		}
+		w.snapshot = current
+	}
+}
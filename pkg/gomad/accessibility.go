@@ -0,0 +1,161 @@
+package gomad
+
+import (
+	"github.com/biyonik/gomad/internal/platform"
+	"github.com/biyonik/gomad/internal/webview"
+	"github.com/biyonik/gomad/pkg/appearance"
+)
+
+// ============================================================================
+// Erişilebilirlik
+// Ekran okuyucu, yüksek kontrast ve azaltılmış hareket tercihleri işletim
+// sisteminden okunur (bkz. appearance paketi); biri değiştiğinde
+// "a11y:changed" olayı gönderilir. Announce, mesajı işletim sisteminin
+// erişilebilirlik katmanıyla ekran okuyucuya iletir; platform bunu
+// desteklemiyorsa mesaj sayfadaki gizli bir aria-live bölgesine yazılır ve
+// WebView'in erişilebilirlik ağacı üzerinden okunur.
+//
+//	const { screenReader } = await gomad.a11y.get();
+//	gomad.on("a11y:changed", ({ reducedMotion }) => animations.enabled = !reducedMotion);
+//	await gomad.a11y.announce("3 sonuç bulundu");
+//	await gomad.a11y.announce("Bağlantı koptu", { assertive: true });
+// ============================================================================
+
+// AccessibilityChangedEvent, erişilebilirlik tercihlerinden biri değiştiğinde
+// gönderilen olaydır; verisi AccessibilityState'tir ve sonradan abone
+// olanlara ve sonradan açılan pencerelere de iletilir (bkz. BroadcastSticky).
+const AccessibilityChangedEvent = "a11y:changed"
+
+// announceEvent, native duyuru yapılamadığında sayfaya gönderilen olaydır.
+const announceEvent = "a11y:announce"
+
+// AccessibilityState, sistemin erişilebilirlik tercihleridir.
+type AccessibilityState struct {
+	ScreenReader  bool `json:"screenReader"`
+	HighContrast  bool `json:"highContrast"`
+	ReducedMotion bool `json:"reducedMotion"`
+}
+
+// announceOptions, JS'ten gelen duyuru ayarlarıdır.
+type announceOptions struct {
+	Assertive bool `json:"assertive"`
+}
+
+// accessibilityState, görünüm ayarlarının erişilebilirlik kısmını döner.
+func accessibilityState(s appearance.Settings) AccessibilityState {
+	return AccessibilityState{
+		ScreenReader:  s.ScreenReader,
+		HighContrast:  s.HighContrast,
+		ReducedMotion: s.ReducedMotion,
+	}
+}
+
+// Accessibility, sistemin erişilebilirlik tercihlerini döner.
+//
+//	if s, _ := app.Accessibility(); s.ScreenReader {
+//	    app.Announce("Yükleniyor", false)
+//	}
+func (a *Application) Accessibility() (AccessibilityState, error) {
+	s, err := appearance.Get()
+	if err != nil {
+		return AccessibilityState{}, err
+	}
+	return accessibilityState(s), nil
+}
+
+// Announce, mesajı ana pencereden ekran okuyucuya iletir. assertive true
+// ise okunmakta olan içerik kesilir (hata, bağlantı kopması); false ise mesaj
+// sıraya girer. Herhangi bir goroutine'den çağrılabilir.
+func (a *Application) Announce(message string, assertive bool) {
+	if message == "" {
+		return
+	}
+	a.RunOnUIThread(func() {
+		if wv := a.view(); wv != nil {
+			a.announce(wv, message, assertive)
+		}
+	})
+}
+
+// announce, mesajı wv'nin penceresinden duyurur; native duyuru yapılamazsa
+// sayfadaki aria-live bölgesine yazdırır. UI thread'inde çalışır.
+func (a *Application) announce(wv webview.View, message string, assertive bool) {
+	if host, ok := wv.NativeWindow().(platform.Announcer); ok && host.Announce(message, assertive) {
+		return
+	}
+	_ = wv.Emit(announceEvent, map[string]interface{}{"message": message, "assertive": assertive})
+}
+
+// watchAccessibility, erişilebilirlik tercihlerinin başlangıç değerini
+// saklar. Görünüm izleyicisi başlamadan önce çağrılır.
+func (a *Application) watchAccessibility() {
+	if s, err := appearance.Get(); err == nil {
+		a.a11yMu.Lock()
+		a.a11yState = accessibilityState(s)
+		a.a11yMu.Unlock()
+	}
+}
+
+// onAccessibilityChanged, görünüm değişikliği erişilebilirlik tercihlerini
+// etkilediyse "a11y:changed" gönderir.
+func (a *Application) onAccessibilityChanged(s appearance.Settings) {
+	state := accessibilityState(s)
+	a.a11yMu.Lock()
+	changed := state != a.a11yState
+	a.a11yState = state
+	a.a11yMu.Unlock()
+	if changed {
+		_ = a.BroadcastSticky(AccessibilityChangedEvent, state)
+	}
+}
+
+// accessibilityModule, erişilebilirliğin JS API'sidir (window.gomad.a11y).
+// Duyurular çağıran pencereden yapılır.
+func (a *Application) accessibilityModule() builtinModule {
+	return builtinModule{
+		namespace: "a11y",
+		methods: map[string]interface{}{
+			"get": a.Accessibility,
+		},
+		viewMethods: func(wv webview.View) map[string]interface{} {
+			return map[string]interface{}{
+				"announce": func(message string, opts *announceOptions) error {
+					if message == "" {
+						return nil
+					}
+					assertive := opts != nil && opts.Assertive
+					wv.Dispatch(func() { a.announce(wv, message, assertive) })
+					return nil
+				},
+			}
+		},
+		init: accessibilityJS,
+	}
+}
+
+// accessibilityJS, native duyurunun yapılamadığı platformlarda mesajları
+// görünmez aria-live bölgelerine yazar. Aynı mesajın yeniden okunması için
+// bölge önce boşaltılır.
+const accessibilityJS = `
+(function() {
+    const regions = {};
+    const region = (assertive) => {
+        const key = assertive ? 'assertive' : 'polite';
+        if (regions[key] && regions[key].isConnected) return regions[key];
+        const el = document.createElement('div');
+        el.setAttribute('aria-live', key);
+        el.setAttribute('aria-atomic', 'true');
+        el.setAttribute('role', assertive ? 'alert' : 'status');
+        el.style.cssText = 'position:absolute;width:1px;height:1px;margin:-1px;padding:0;' +
+            'overflow:hidden;clip:rect(0 0 0 0);white-space:nowrap;border:0;';
+        document.body.appendChild(el);
+        return regions[key] = el;
+    };
+    window.gomad.on('` + announceEvent + `', ({ message, assertive }) => {
+        if (!document.body) return;
+        const el = region(assertive);
+        el.textContent = '';
+        setTimeout(() => { el.textContent = message; }, 50);
+    });
+})();
+`
@@ -12,10 +12,18 @@
 package gomad
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"runtime"
+	"sync"
 
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/platform"
 	"github.com/biyonik/gomad/internal/webview"
+	"github.com/biyonik/gomad/pkg/serial"
+	"github.com/biyonik/gomad/pkg/update"
 )
 
 // Application, GOMAD masaüstü uygulamasını temsil eder.
@@ -29,10 +37,187 @@ import (
 //	)
 //
 // Application, aynı anda birden fazla goroutine'den güvenli değildir.
-// Tüm metodlar ana goroutine'den çağrılmalıdır.
+// Tüm metodlar ana goroutine'den çağrılmalıdır. İstisnalar: RunOnUIThread,
+// Emit ve Eval herhangi bir goroutine'den çağrılabilir; işlemi UI thread'ine
+// kendileri taşırlar.
 type Application struct {
 	config  *config
-	webview *webview.WebViewImpl
+	webview webview.View
+	mu      sync.RWMutex // webview, uiQueue ve bindings erişimi
+
+	// Run öncesi RunOnUIThread ile kuyruğa alınan fonksiyonlar
+	uiQueue []func()
+
+	// Uygulama genelindeki bind'ler; Run öncesi kaydedilenler WebView oluşunca,
+	// sonrakiler anında tüm pencerelere uygulanır
+	bindings  map[string]interface{}
+	bindOrder []string
+
+	// Frontend hazır olduğunda çağrılacak callback'ler
+	frontendReady []func()
+
+	// Run bittikten sonra başlatılacak yeni instance (bkz. Relaunch)
+	relaunch *relaunchRequest
+
+	// Çökme işleyicisi yalnızca bir kez çalışır
+	crashOnce sync.Once
+
+	// Menü çubuğu (bkz. SetMenuBar)
+	menuBar []*MenuItem
+	menuMu  sync.Mutex
+
+	// Sistem tepsisi ikonu (bkz. Tray)
+	tray     *Tray
+	trayOnce sync.Once
+
+	// Gösterilen ve henüz sonuçlanmamış bildirimler (bkz. Notify)
+	notifications map[string]*Notification
+	nativeNotify  platform.Notifier
+	notifyMu      sync.Mutex
+
+	// JS'in kurduğu hareketsizlik izleyicisi (bkz. gomad.idle.watch)
+	idleCancel func()
+	idleMu     sync.Mutex
+
+	// Son kullanılan dokümanlar (bkz. AddRecentDocument)
+	onRecentDocument func(path string)
+	recentMu         sync.Mutex
+
+	// JS'in başlattığı ekran yakalama akışları (bkz. gomad.capture.startStream)
+	captureStreams map[string]func()
+	nextStream     int
+	captureMu      sync.Mutex
+
+	// Kumanda yoklaması ve JS'in açtığı HID aygıtları (bkz. gomad.hid)
+	gamepadCancel func()
+	hidDevices    map[string]func()
+	hidMu         sync.Mutex
+
+	// JS'in açtığı seri portlar ve verilen izinler (bkz. gomad.serial)
+	serialPorts    map[string]*serial.Port
+	serialOpening  map[string]bool // İzin sorulan ya da açılmakta olan portlar
+	serialGrants   map[string]bool
+	serialMu       sync.Mutex
+	serialPromptMu sync.Mutex
+
+	// Bluetooth taraması ve JS'in abone olduğu karakteristikler (bkz. gomad.bluetooth)
+	bluetoothScan   func()
+	bluetoothNotify map[string]func()
+	bluetoothMu     sync.Mutex
+
+	// JS'in kurduğu dizin izleyicileri (bkz. gomad.fs.watch)
+	fsWatches   map[string]func()
+	nextFSWatch int
+	fsWatchMu   sync.Mutex
+
+	// Uçtan uca test sürücüsü bağlantısı (bkz. pkg/gomadtest)
+	driver   *driverConn
+	driverMu sync.Mutex
+
+	// Debug modundaki bridge inspector paneli (bkz. inspector.go)
+	inspector inspectorState
+
+	// GOMAD_REPLAY ile tekrar oynatılan kaydın sonucu; Run bunu döner
+	replayErr error
+
+	// Headless View (bkz. WithHeadless); Run'dan önce de oluşturulabilir
+	headless     *webview.Headless
+	headlessOnce sync.Once
+
+	// Kiosk modu açık mı (bkz. WithKiosk, ExitKiosk)
+	kioskActive bool
+	kioskMu     sync.Mutex
+	// Geri al/yinele geçmişi (bkz. History)
+	history     *History
+	historyOnce sync.Once
+	// Yedeğe veri ekleyen kaynaklar (bkz. RegisterBackupSource)
+	backupSources map[string]BackupSource
+	backupMu      sync.Mutex
+	// Yüklü UI eklentileri (bkz. LoadExtension)
+	extensions  map[string]*extension
+	extensionMu sync.Mutex
+	// Yüklü eklentiler; süreç eklentileri için süreç, diğerleri için nil
+	// (bkz. Use, StartPluginProcess)
+	plugins  map[string]*pluginProcess
+	pluginMu sync.Mutex
+	// İkincil pencereler ve ön ısıtılmış WebView havuzu (bkz. OpenWindow)
+	windows      map[string]*Window
+	nextWindow   int
+	viewPool     []webview.View
+	poolDisabled bool
+	windowMu     sync.Mutex
+	// BroadcastSticky ile gönderilen son değerler; sonradan açılan pencerelere
+	// gönderilir. windowMu ile korunur
+	broadcastSticky map[string]interface{}
+	stickyOrder     []string
+	// Özellik bayrakları (bkz. Flags)
+	flags     *Flags
+	flagsOnce sync.Once
+	// İsteğe bağlı kullanım ölçümü (bkz. Telemetry)
+	telemetry     *Telemetry
+	telemetryOnce sync.Once
+	// Sürümlü kullanıcı ayarları (bkz. Settings)
+	settings     *Settings
+	settingsOnce sync.Once
+	// Veri dosyalarının şifreleme anahtarı (bkz. WithEncryptedData)
+	dataKeyBytes []byte
+	dataKeyMu    sync.Mutex
+	// Go ve JS'in ortak önbelleği (bkz. Cache)
+	cache     *Cache
+	cacheOnce sync.Once
+	// Kimlik doğrulama oturumu (bkz. Session)
+	session     *Session
+	sessionOnce sync.Once
+	// Seçili tema (ad veya ThemeAuto) ve en son uygulanan tema (bkz. SetTheme)
+	themeMode    string
+	themeApplied string
+	themeMu      sync.Mutex
+	// Etkin yerel ayar; ilk kullanımda çözülür (bkz. Locale)
+	locale   string
+	localeMu sync.Mutex
+	// Uzun süren görevler (bkz. StartTask)
+	taskQueue *taskQueue
+	taskOnce  sync.Once
+	// Go'nun tuttuğu WebSocket/SSE bağlantıları (bkz. OpenSocket)
+	sockets  map[string]*Socket
+	socketMu sync.Mutex
+	// Go'nun sahip olduğu, JS'e senkronize edilen durumlar (bkz. State)
+	state     *StateStore
+	stateOnce sync.Once
+	// Güncelleme kanalı ve kurulum kimliği (bkz. SetUpdateChannel); bulunan,
+	// indirilen ve kuruluma hazır sürüm (bkz. DownloadUpdate)
+	updateMu          sync.Mutex
+	updateRelease     *update.Release
+	updateStaged      *update.Release
+	updateStagedPath  string
+	updateDownloading bool
+	// Çözülmüş komut satırı argümanları (bkz. Args)
+	launchArgs *LaunchArgs
+	argsOnce   sync.Once
+	// Etkin kullanıcı profili (bkz. Profile)
+	profile    string
+	profileMu  sync.Mutex
+	profilesMu sync.Mutex
+	// İlk çalıştırma ve sürüm yükseltme bilgisi (bkz. Launch)
+	launchInfo LaunchInfo
+	launchOnce sync.Once
+	// Pencere oturumu bu çalışmada kaydedildi (bkz. WithRestoreSession); windowMu ile korunur
+	sessionSaved bool
+
+	// Son bilinen erişilebilirlik tercihleri (bkz. Accessibility)
+	a11yState AccessibilityState
+	a11yMu    sync.Mutex
+
+	// Kök context; Quit'te ve Run dönerken iptal edilir (bkz. Context)
+	ctx    context.Context
+	cancel context.CancelFunc
+	// Düzgün kapanış başladı (bkz. WithShutdownTimeout); quitPending
+	// onWillQuit dinleyicileri henüz bitmemiş sayfalardır, hepsi bitince
+	// quitDone kapanır. İkisi de yalnızca kapanış sürerken doludur
+	shuttingDown bool
+	shutdownMu   sync.Mutex
+	quitPending  map[webview.View]bool
+	quitDone     chan struct{}
 
 	// Durum
 	running bool
@@ -60,8 +245,22 @@ func New(opts ...Option) *Application {
 		opt(cfg)
 	}
 
+	// "gomad dev" altında çalışırken frontend dev sunucusu kullanılır
+	applyDevOverrides(cfg)
+	applyHeadlessEnv(cfg)
+	applyHotEnv(cfg)
+
+	parent := cfg.context
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithCancel(parent)
 	return &Application{
-		config: cfg,
+		config:      cfg,
+		bindings:    make(map[string]interface{}),
+		kioskActive: cfg.kiosk,
+		ctx:         ctx,
+		cancel:      cancel,
 	}
 }
 
@@ -70,38 +269,250 @@ func New(opts ...Option) *Application {
 //
 // Başarısız olursa hata döner.
 func (a *Application) Run() error {
+	// Yakalanmayan panic'ler çökme raporuna dönüşür
+	defer a.Recover()
+
 	// GUI işlemleri ana thread'de olmalı (özellikle macOS için)
 	runtime.LockOSThread()
 
-	// WebView oluştur
-	wv, err := webview.New(webview.Options{
-		Title:  a.config.title,
-		Width:  a.config.width,
-		Height: a.config.height,
-		Debug:  a.config.debug,
-		URL:    a.config.url,
-		HTML:   a.config.html,
-	})
+	// Gömülü frontend dosyaları (bkz. WithAssets)
+	if a.config.assets != nil && a.config.url == "" {
+		url, stopAssets, err := serveAssets(a.config.assets)
+		if err != nil {
+			return a.startupFailed("serve assets", err)
+		}
+		defer stopAssets()
+		a.config.url = url
+	}
+
+	// Profili seç (bkz. WithProfilePicker); dizinler ve WebView oturumu profile göre ayrılır
+	a.Profile()
+
+	// Ayarları yükle; eski şema sürümleri burada taşınır
+	if err := a.Settings().loadErr; err != nil {
+		return a.startupFailed("migrate settings", err)
+	}
+
+	// WebView oluştur (headless modda pencere açılmaz, bkz. WithHeadless);
+	// önceki oturumun rotası ve boyutu uygulanır (bkz. WithRestoreSession)
+	session := a.loadWindowSession()
+	wv, err := a.newView(a.restoreMainOptions(a.viewOptions(), session))
 	if err != nil {
-		return fmt.Errorf("failed to create webview: %w", err)
+		return a.startupFailed("create webview", err)
+	}
+
+	a.startAudit(wv)
+	a.startTracing(wv)
+	if a.config.logRedaction != nil {
+		wv.Bridge().SetLogRedaction(*a.config.logRedaction)
+	}
+	if !hotShellMode() {
+		// Hot-swap'te çağrılar backend sürecinde çalışır ve orada izlenir
+		wv.Bridge().SetSlowCallThreshold(a.config.slowCallThreshold)
+	}
+	wv.Bridge().SetErrorPolicy(a.errorPolicy())
+	wv.Bridge().SetJSONEncoding(a.config.jsonEncoding)
+	wv.Bridge().SetContext(a.ctx)
+	stopMetrics := a.startMetrics(wv)
+	defer stopMetrics()
+	defer a.closeSockets()
+	defer a.stopPlugins()
+	defer a.stopExtensions()
+	stopTelemetry := a.startTelemetry()
+	defer stopTelemetry()
+	stopFlags := a.startFlagRefresh()
+	defer stopFlags()
+	stopUpdates := a.startUpdateChecks()
+	defer stopUpdates()
+
+	// Yerleşik binding'ler
+	if err := a.registerBuiltins(wv); err != nil {
+		wv.Destroy()
+		return a.startupFailed("register builtins", err)
+	}
+
+	// Bekleyen bind'leri uygula (hot-swap pencere sürecinde backend'e bırakılır)
+	for _, name := range a.bindOrder {
+		if hotShellMode() {
+			break
+		}
+		if err := wv.BindFunc(name, a.bindings[name]); err != nil {
+			wv.Destroy()
+			return fmt.Errorf("failed to bind %q: %w", name, err)
+		}
+	}
+
+	// "gomad types": tanımları yaz ve pencereyi açmadan dön
+	if written, err := writeTypes(wv); written {
+		wv.Destroy()
+		return err
 	}
 
+	a.mu.Lock()
 	a.webview = wv
+	queued := a.uiQueue
+	a.uiQueue = nil
+	a.mu.Unlock()
 	a.running = true
 
+	// Bağlı fonksiyonlardaki panic'ler süreci düşürmez; yine de raporlanır
+	wv.Bridge().OnPanic(func(method string, perr *gomerrors.PanicError) {
+		a.writeCrashReport(perr)
+	})
+	a.watchNavigation(wv, MainWindow)
+
+	// Kapanış onayı ve kiosk modunda kapanış engeli
+	if err := wv.OnCloseRequested(a.mainCloseRequested); err != nil && (a.config.onCloseRequested != nil || a.IsKiosk()) {
+		a.Logger().Warn("close confirmation unavailable", "error", err)
+	}
+
+	// Frontend hazır callback'lerini köprüye bağla (hot-swap'te backend çalıştırır)
+	for _, fn := range a.frontendReady {
+		if hotShellMode() {
+			break
+		}
+		wv.Bridge().OnFrontendReady(fn)
+	}
+
 	// OnReady callback
 	if a.config.onReady != nil {
 		a.config.onReady()
 	}
 
+	// Run öncesi kuyruğa alınan UI işleri
+	for _, fn := range queued {
+		wv.Dispatch(fn)
+	}
+
+	// WithKiosk: pencere ilk gösterildiği anda kilitli olmalı
+	if a.IsKiosk() {
+		wv.Dispatch(a.applyKiosk)
+	}
+	if a.config.allWorkspaces {
+		a.SetAllWorkspaces(true)
+	}
+	if a.config.titlebarOverlay {
+		a.SetTitlebarOverlay(true)
+	}
+
+	// Oturum açılışında gizli başlatıldıysa pencereyi gösterme (bkz. SetAutoLaunch)
+	if LaunchedHidden() {
+		a.Hide()
+	}
+
+	// "gomad dev" komutlarını (reload, quit) dinle ve binding tiplerini sun
+	a.startDevControl()
+	stopTypes := a.startTypesServer(wv)
+
+	// "gomad dev -hot": pencere süreci backend'i bekler, backend shell'e bağlanır
+	stopHotShell := a.startHotShell(wv)
+	stopHotBackend := a.startHotBackend(wv)
+
+	// Komut satırı argümanlarını frontend'e ilet (bkz. WithArgs)
+	a.publishArgs()
+
+	// İlk çalıştırma / sürüm yükseltmesi ise bildir (onboarding, yenilikler)
+	a.publishLaunch()
+
+	// Jump list'ten bir doküman seçilerek başlatıldıysa bildir
+	a.checkRecentLaunch()
+
+	// Önceki oturumun pencerelerini yerlerine koy
+	a.restoreWindows(wv, session)
+
+	// Sistem olaylarını (güç vb.) JS'e ilet
+	stopWatchers := a.startSystemWatchers()
+
+	// pkg/gomadtest sürücüsü altında başlatıldıysa bağlan
+	stopDriver := a.startDriver(wv)
+
+	// Debug modunda bridge inspector paneli (Ctrl+Shift+B)
+	stopInspector := a.startInspector(wv)
+
+	// Köprü trafiği kaydı ve "gomad replay"
+	stopRecording := a.startRecording(wv)
+	a.startReplay(wv)
+
+	// İkincil pencereler için gizli WebView'leri hazırla
+	a.startViewPool()
+
 	// Olay döngüsünü başlat (blocking)
-	wv.Run()
+	a.Logger().Info("application started", "appID", a.config.appID)
+	stopContext := a.watchContext()
+	a.runLoop(wv)
+	a.Logger().Info("application stopped", "appID", a.config.appID)
+
+	// Arka plan işleri durdurulmadan önce kök context iptal edilir
+	stopContext()
+	a.drainCalls(wv)
+	a.cancel()
 
 	// Temizlik
+	stopHotBackend()
+	stopHotShell()
+	stopTypes()
+	stopInspector()
+	stopRecording()
+	stopDriver()
+	stopWatchers()
+	a.unwatchIdle()
+	a.stopCaptureStreams()
+	a.stopHID()
+	a.closeSerialPorts()
+	a.stopBluetooth()
+	a.unwatchAllDirs()
+	a.destroySecondaryViews()
+	a.mu.Lock()
+	a.webview = nil
+	a.mu.Unlock()
 	wv.Destroy()
 	a.running = false
 
-	return nil
+	if a.relaunch != nil {
+		return a.spawnRelaunch()
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.replayErr
+}
+
+// viewOptions, ana pencerenin WebView seçenekleridir. İkincil pencereler ve
+// havuzdaki WebView'ler de aynı köprü ayarlarıyla oluşturulur.
+func (a *Application) viewOptions() webview.Options {
+	return webview.Options{
+		Title:                 a.config.title,
+		Width:                 a.config.width,
+		Height:                a.config.height,
+		Debug:                 a.config.debug,
+		URL:                   a.config.url,
+		HTML:                  a.config.html,
+		Logger:                a.config.logger,
+		Scripts:               a.builtinScripts(),
+		InlineCalls:           a.inlineCall,
+		LargePayloadThreshold: a.config.largePayload,
+		AllowedOrigins:        a.config.allowedOrigins,
+		Capabilities:          a.config.capabilities,
+		SignMessages:          a.config.signMessages,
+		Limits:                a.config.callLimits,
+		MaxMessageSize:        a.config.maxMessageSize,
+		JournalSize:           a.config.journalSize,
+		DataDir:               a.profileWebViewDir(),
+	}
+}
+
+// Quit, uygulamayı düzgün kapatır ve Run'ın geri dönmesini sağlar: sayfalara
+// "app:will-quit" gönderilir, çalışan çağrılar beklenir ve kök context (bkz.
+// Context) iptal edilir (bkz. WithShutdownTimeout). Herhangi bir goroutine'den
+// çağrılabilir ve hemen döner. Uygulama çalışmıyorsa yalnızca context iptal
+// edilir.
+func (a *Application) Quit() {
+	wv := a.view()
+	if wv == nil {
+		a.cancel()
+		return
+	}
+	wv.Dispatch(a.beginShutdown)
 }
 
 // Bind, JavaScript tarafında çağrılabilecek bir Go fonksiyonu kaydeder.
@@ -113,8 +524,238 @@ func (a *Application) Run() error {
 //   - func() (T, error)
 //   - func(args...) (T, error)
 //
-// T, JSON-serializable bir tip olmalıdır.
+// T, JSON-serializable bir tip olmalıdır. İlk parametre context.Context ise
+// JS'ten gelmez; uygulama kapanırken iptal edilen kök context verilir (bkz.
+// Context).
 //
 // Örnek:
+//
 //	app.Bind("getVersion", func() string { return "1.0.0" })
-//	app.Bind("add", func(a
+//	app.Bind("add", func(a, b int) int { return a + b })
+//	app.Bind("fetch", func(ctx context.Context, url string) (string, error) { ... })
+//
+// Run çağrılmadan önce yapılan kayıtlar bekletilir ve WebView oluşturulduğunda uygulanır.
+// Bind ile kaydedilen fonksiyonlar tüm pencerelerden çağrılabilir; yalnızca
+// bir pencereye ait fonksiyonlar için Window.Bind kullanılır.
+func (a *Application) Bind(name string, fn interface{}) error {
+	if wv := a.view(); wv != nil {
+		if hotShellMode() {
+			return nil // Hot-swap: binding'ler backend sürecinde çalışır
+		}
+		if err := wv.BindFunc(name, fn); err != nil {
+			return err
+		}
+		a.mu.Lock()
+		a.bindings[name] = fn
+		a.bindOrder = append(a.bindOrder, name)
+		a.mu.Unlock()
+		a.bindSecondaryViews(name, fn)
+		return nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, exists := a.bindings[name]; exists {
+		return fmt.Errorf("binding %q already registered", name)
+	}
+	a.bindings[name] = fn
+	a.bindOrder = append(a.bindOrder, name)
+	return nil
+}
+
+// unbind, Bind ile kaydedilen fonksiyonu kaldırır; ana pencereden, ikincil
+// pencerelerden ve havuzdaki WebView'lerden de çıkarılır. Yarıda kalan
+// eklenti kayıtlarını geri almak için kullanılır.
+func (a *Application) unbind(name string) {
+	a.mu.Lock()
+	if _, exists := a.bindings[name]; !exists {
+		a.mu.Unlock()
+		return
+	}
+	delete(a.bindings, name)
+	for i, n := range a.bindOrder {
+		if n == name {
+			a.bindOrder = append(a.bindOrder[:i:i], a.bindOrder[i+1:]...)
+			break
+		}
+	}
+	a.mu.Unlock()
+
+	views := a.liveViews()
+	a.windowMu.Lock()
+	views = append(views, a.viewPool...)
+	a.windowMu.Unlock()
+	for _, wv := range views {
+		wv.Bridge().Unbind(name)
+	}
+}
+
+// Emit, JavaScript tarafına bir olay gönderir.
+// JS tarafında window.gomad.on(event, cb) ile dinlenir.
+//
+// Olay yalnızca ana pencereye gider; ikincil pencereler için EmitTo, tüm
+// pencereler için Broadcast kullanılır.
+//
+// Uygulama henüz çalışmıyorsa hata döner. Başlangıç durumunu göndermek için
+// OnFrontendReady callback'i kullanılmalıdır.
+func (a *Application) Emit(event string, data interface{}) error {
+	wv := a.view()
+	if wv == nil {
+		return fmt.Errorf("application is not running")
+	}
+	return wv.Emit(event, data)
+}
+
+// EmitSticky, olayı ana pencereye gönderir ve son değer olarak saklar:
+// olaydan sonra gomad.on ile abone olan bileşenler de son değeri hemen
+// alır, sayfa yeniden yüklendiğinde değer tekrar gönderilir. Bileşenler
+// mount edilmeden önce gönderilen durum olayları (oturum, tema) için
+// kullanılır.
+//
+//	app.EmitSticky("auth:changed", user)
+func (a *Application) EmitSticky(event string, data interface{}) error {
+	wv := a.view()
+	if wv == nil {
+		return fmt.Errorf("application is not running")
+	}
+	return wv.Bridge().EmitSticky(event, data)
+}
+
+// ClearSticky, EmitSticky veya BroadcastSticky ile saklanan son değeri
+// siler; BroadcastSticky ile saklanan değer tüm pencerelerden silinir.
+func (a *Application) ClearSticky(event string) {
+	a.windowMu.Lock()
+	_, broadcast := a.broadcastSticky[event]
+	if broadcast {
+		delete(a.broadcastSticky, event)
+		for i, name := range a.stickyOrder {
+			if name == event {
+				a.stickyOrder = append(a.stickyOrder[:i:i], a.stickyOrder[i+1:]...)
+				break
+			}
+		}
+	}
+	windows := make([]*Window, 0, len(a.windows))
+	for _, w := range a.windows {
+		windows = append(windows, w)
+	}
+	a.windowMu.Unlock()
+
+	if broadcast {
+		for _, w := range windows {
+			_ = w.do(func(wv webview.View) { wv.Bridge().ClearSticky(event) })
+		}
+	}
+	if wv := a.view(); wv != nil {
+		wv.Bridge().ClearSticky(event)
+	}
+}
+
+// Eval, WebView içinde JavaScript kodu çalıştırır.
+// Herhangi bir goroutine'den çağrılabilir; kod UI thread'inde yürütülür.
+func (a *Application) Eval(js string) error {
+	wv := a.view()
+	if wv == nil {
+		return fmt.Errorf("application is not running")
+	}
+	return wv.Eval(js)
+}
+
+// runLoop, UI thread'inin olay döngüsünü çalıştırır (blocking). Platform tüm
+// pencereleri ve Dispatch kuyruğunu işleyen ortak bir döngü sunuyorsa
+// (platform.RunApp) ana ve ikincil pencereler onunla beslenir; sunmuyorsa
+// WebView'in kendi döngüsü çalışır.
+func (a *Application) runLoop(wv webview.View) {
+	if !a.config.headless {
+		err := platform.RunApp()
+		if err == nil {
+			return
+		}
+		if !errors.Is(err, platform.ErrNoAppLoop) {
+			a.Logger().Warn("application loop unavailable, using webview loop", "error", err)
+		}
+	}
+	wv.Run()
+}
+
+// RunOnUIThread, fn'i UI thread'inde çalıştırılmak üzere kuyruğa alır.
+//
+// WebView ve native pencere API'leri yalnızca UI thread'inden kullanılabilir.
+// Worker goroutine'lerden bu API'lere erişmek gerektiğinde kullanılır:
+//
+//	go func() {
+//	    data := fetchData()
+//	    app.RunOnUIThread(func() {
+//	        // native pencere işlemleri
+//	    })
+//	}()
+//
+// Run çağrılmadan önce kuyruğa alınan fonksiyonlar, WebView oluşturulduktan
+// sonra sırasıyla çalıştırılır. Fonksiyon asenkron çalışır; çağıran beklemez.
+func (a *Application) RunOnUIThread(fn func()) {
+	if fn == nil {
+		return
+	}
+
+	a.mu.Lock()
+	wv := a.webview
+	if wv == nil {
+		a.uiQueue = append(a.uiQueue, fn)
+	}
+	a.mu.Unlock()
+
+	if wv != nil {
+		wv.Dispatch(fn)
+	}
+}
+
+// Logger, uygulamanın logger'ını döner. WithLogger verilmediyse
+// her şeyi yutan bir logger döner; nil kontrolü gerekmez.
+func (a *Application) Logger() *slog.Logger {
+	if a.config.logger == nil {
+		return slog.New(slog.DiscardHandler)
+	}
+	return a.config.logger
+}
+
+// view, mevcut WebView'i thread-safe şekilde döner.
+func (a *Application) view() webview.View {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.webview
+}
+
+// nativeWindow, WebView'i barındıran native pencereyi döner.
+// Uygulama çalışmıyorsa veya platform desteklemiyorsa nil döner.
+func (a *Application) nativeWindow() platform.Window {
+	wv := a.view()
+	if wv == nil {
+		return nil
+	}
+	return wv.NativeWindow()
+}
+
+// OnFrontendReady, frontend uygulaması window.gomad.ready() çağırdığında
+// tetiklenecek bir callback ekler.
+//
+// Angular uygulaması bootstrap işlemini tamamlayıp olay dinleyicilerini
+// kaydettikten sonra ready() çağırır; bu noktadan sonra Emit ile gönderilen
+// başlangıç durumu kaybolmaz. time.Sleep ile tahmin yürütmeye gerek kalmaz.
+//
+// Örnek:
+//
+//	app.OnFrontendReady(func() {
+//	    app.Emit("state:init", loadState())
+//	})
+//
+// Sayfa yeniden yüklenirse frontend ready() çağrısını tekrarlar ve callback'ler
+// yeniden çalışır.
+func (a *Application) OnFrontendReady(fn func()) {
+	if fn == nil {
+		return
+	}
+	a.frontendReady = append(a.frontendReady, fn)
+	if wv := a.view(); wv != nil && !hotShellMode() {
+		wv.Bridge().OnFrontendReady(fn)
+	}
+}
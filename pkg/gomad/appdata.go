@@ -0,0 +1,164 @@
+package gomad
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/pkg/credentials"
+)
+
+// dataKeyAccount, veri şifreleme anahtarının kimlik deposundaki hesap adıdır.
+const dataKeyAccount = "gomad.datakey"
+
+// encryptedMagic, şifreli veri dosyalarının başlığıdır. Başlığı olmayan
+// dosyalar düz metin kabul edilir; şifreleme sonradan açılan uygulamalarda
+// eski dosyalar okunur ve bir sonraki yazmada şifrelenir.
+var encryptedMagic = []byte("GOMADENC1\n")
+
+// ReadDataFile, UserData dizinindeki name dosyasını okur. WithEncryptedData
+// açıkken şifreli dosyalar şeffaf olarak çözülür.
+func (a *Application) ReadDataFile(name string) ([]byte, error) {
+	dir, err := a.Paths().UserData()
+	if err != nil {
+		return nil, err
+	}
+	return a.readAppFile(filepath.Join(dir, name))
+}
+
+// WriteDataFile, data'yı UserData dizinindeki name dosyasına atomik olarak
+// yazar. WithEncryptedData açıkken dosya şifrelenir.
+//
+//	app.WriteDataFile("notes.json", data)
+func (a *Application) WriteDataFile(name string, data []byte) error {
+	dir, err := a.Paths().UserData()
+	if err != nil {
+		return err
+	}
+	return a.writeAppFile(filepath.Join(dir, name), data)
+}
+
+// readAppFile, framework'ün uygulama dizinlerine yazdığı bir dosyayı okur
+// ve gerekiyorsa çözer. Dosya yoksa os.ErrNotExist döner.
+func (a *Application) readAppFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(data, encryptedMagic) {
+		return data, nil
+	}
+	key, err := a.dataKey()
+	if err != nil {
+		return nil, err
+	}
+	return openData(key, filepath.Base(path), data[len(encryptedMagic):])
+}
+
+// writeAppFile, dosyayı (açıksa şifreleyerek) geçici dosya + rename ile
+// yazar; yarıda kalan bir yazma eski içeriği bozmaz.
+func (a *Application) writeAppFile(path string, data []byte) error {
+	perm := os.FileMode(0o644)
+	if a.config.encryptData {
+		key, err := a.dataKey()
+		if err != nil {
+			return err
+		}
+		sealed, err := sealData(key, filepath.Base(path), data)
+		if err != nil {
+			return err
+		}
+		data, perm = append(append([]byte{}, encryptedMagic...), sealed...), 0o600
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, perm); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+// dataKey, veri şifreleme anahtarını döner; ilk kullanımda kimlik
+// deposundan okur, yoksa üretip saklar. Anahtar diske hiç yazılmaz: Windows'ta
+// Credential Manager (DPAPI), macOS'ta Keychain, Linux'ta Secret Service
+// tarafından korunur.
+func (a *Application) dataKey() ([]byte, error) {
+	a.dataKeyMu.Lock()
+	defer a.dataKeyMu.Unlock()
+	if a.dataKeyBytes != nil {
+		return a.dataKeyBytes, nil
+	}
+
+	stored, err := credentials.Get(a.config.appID, dataKeyAccount)
+	switch {
+	case err == nil:
+		key, err := base64.StdEncoding.DecodeString(stored)
+		if err != nil || len(key) != 32 {
+			return nil, gomerrors.NewOperationError("data.key", "stored data key is corrupt", gomerrors.ErrInvalidArgument)
+		}
+		a.dataKeyBytes = key
+	case errors.Is(err, credentials.ErrNotFound):
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		if err := credentials.Set(a.config.appID, dataKeyAccount, base64.StdEncoding.EncodeToString(key)); err != nil {
+			return nil, fmt.Errorf("cannot store data encryption key: %w", err)
+		}
+		a.dataKeyBytes = key
+	default:
+		// Güvenli depo yoksa veriler düz metne düşürülmez
+		return nil, fmt.Errorf("data encryption key unavailable: %w", err)
+	}
+	return a.dataKeyBytes, nil
+}
+
+// sealData, data'yı AES-256-GCM ile şifreler: nonce || ciphertext. Dosya adı
+// ek doğrulama verisidir; şifreli dosyalar birbirinin yerine konamaz.
+func sealData(key []byte, name string, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, data, []byte(name)), nil
+}
+
+// openData, sealData'nın çıktısını çözer.
+func openData(key []byte, name string, sealed []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, gomerrors.NewOperationError("data.decrypt", name, gomerrors.ErrInvalidArgument)
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	data, err := gcm.Open(nil, nonce, ciphertext, []byte(name))
+	if err != nil {
+		return nil, gomerrors.NewOperationError("data.decrypt", name+": wrong key or corrupted file", err)
+	}
+	return data, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
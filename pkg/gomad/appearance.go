@@ -0,0 +1,56 @@
+package gomad
+
+import "github.com/biyonik/gomad/pkg/appearance"
+
+// appearanceJS, sistem görünüm ayarlarını <html> elemanına CSS değişkenleri
+// ve data attribute'ları olarak uygular; değişikliklerde günceller:
+//
+//	--gomad-accent-color: #3584e4
+//	data-gomad-theme="dark" | "light"
+//	data-gomad-reduced-motion, data-gomad-reduced-transparency, data-gomad-high-contrast,
+//	data-gomad-screen-reader
+//
+// Angular stilleri doğrudan kullanabilir:
+//
+//	.btn-primary { background: var(--gomad-accent-color, #0d6efd); }
+//	[data-gomad-reduced-motion] * { transition: none !important; }
+const appearanceJS = `
+(function() {
+    const apply = (s) => {
+        if (!s) return;
+        const root = document.documentElement;
+        if (s.accentColor) {
+            root.style.setProperty('--gomad-accent-color', s.accentColor);
+        } else {
+            root.style.removeProperty('--gomad-accent-color');
+        }
+        root.dataset.gomadTheme = s.darkMode ? 'dark' : 'light';
+        root.toggleAttribute('data-gomad-reduced-motion', !!s.reducedMotion);
+        root.toggleAttribute('data-gomad-reduced-transparency', !!s.reducedTransparency);
+        root.toggleAttribute('data-gomad-high-contrast', !!s.highContrast);
+        root.toggleAttribute('data-gomad-screen-reader', !!s.screenReader);
+    };
+    window.gomad.on('system:appearance', apply);
+    window.gomad.appearance.get().then(apply).catch(() => {});
+})();
+`
+
+// Appearance, sistemin görünüm tercihlerini (vurgu rengi, koyu tema,
+// erişilebilirlik ayarları) döner.
+func (a *Application) Appearance() (appearance.Settings, error) {
+	return appearance.Get()
+}
+
+// appearanceModule, görünüm ayarlarının JS API'sidir (window.gomad.appearance).
+//
+//	const { accentColor, darkMode } = await gomad.appearance.get();
+//	gomad.on("system:appearance", (settings) => { ... });
+func (a *Application) appearanceModule() builtinModule {
+	return builtinModule{
+		namespace: "appearance",
+		methods: map[string]interface{}{
+			"get": appearance.Get,
+		},
+		init: appearanceJS,
+	}
+}
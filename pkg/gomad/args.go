@@ -0,0 +1,276 @@
+package gomad
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// argsEvent, başlatma argümanlarının gönderildiği sticky olaydır.
+const argsEvent = "app:args"
+
+// argKind, bayrağın değer tipidir.
+type argKind int
+
+const (
+	argString argKind = iota
+	argBool
+	argInt
+	argFloat
+	argStrings
+)
+
+// Arg, WithArgs ile bildirilen bir başlatma bayrağıdır. StringArg, BoolArg,
+// IntArg, FloatArg ve StringsArg ile oluşturulur.
+type Arg struct {
+	name  string
+	usage string
+	kind  argKind
+	def   interface{}
+}
+
+// StringArg, "--name=value" ya da "--name value" biçiminde bir string bayrak bildirir.
+func StringArg(name, def, usage string) Arg {
+	return Arg{name: name, usage: usage, kind: argString, def: def}
+}
+
+// BoolArg, "--name", "--name=false" ya da "--no-name" biçiminde bir bool bayrak bildirir.
+func BoolArg(name string, def bool, usage string) Arg {
+	return Arg{name: name, usage: usage, kind: argBool, def: def}
+}
+
+// IntArg, tamsayı bir bayrak bildirir.
+func IntArg(name string, def int, usage string) Arg {
+	return Arg{name: name, usage: usage, kind: argInt, def: def}
+}
+
+// FloatArg, ondalıklı bir bayrak bildirir.
+func FloatArg(name string, def float64, usage string) Arg {
+	return Arg{name: name, usage: usage, kind: argFloat, def: def}
+}
+
+// StringsArg, tekrarlanabilen bir bayrak bildirir: "--tag a --tag b" → ["a", "b"].
+func StringsArg(name, usage string) Arg {
+	return Arg{name: name, usage: usage, kind: argStrings, def: []string{}}
+}
+
+// LaunchArgs, uygulamanın komut satırı argümanlarının WithArgs şemasına göre
+// çözülmüş halidir. JS'e gomad.args() ve "app:args" olayıyla aynı biçimde
+// gider:
+//
+//	{ "flags": { "profile": "work", "safe-mode": false }, "positional": ["notes.md"], "cwd": "/home/me" }
+//
+// Positional, bayrak olmayan argümanlardır (ör. dosya ilişkilendirmesiyle
+// açılan dosyalar); göreli yollar Cwd'ye göredir. Şemada olmayan bayraklar
+// (ör. macOS'un -psn_ argümanı) Unknown'a düşer ve hata sayılmaz.
+type LaunchArgs struct {
+	Flags      map[string]interface{} `json:"flags"`
+	Positional []string               `json:"positional"`
+	Unknown    []string               `json:"unknown,omitempty"`
+	Cwd        string                 `json:"cwd"`
+
+	specs []Arg
+}
+
+// String, string bayrağın değerini döner; bayrak bildirilmemişse "".
+func (l *LaunchArgs) String(name string) string {
+	v, _ := l.Flags[name].(string)
+	return v
+}
+
+// Bool, bool bayrağın değerini döner.
+func (l *LaunchArgs) Bool(name string) bool {
+	v, _ := l.Flags[name].(bool)
+	return v
+}
+
+// Int, tamsayı bayrağın değerini döner.
+func (l *LaunchArgs) Int(name string) int {
+	v, _ := l.Flags[name].(int)
+	return v
+}
+
+// Float, ondalıklı bayrağın değerini döner.
+func (l *LaunchArgs) Float(name string) float64 {
+	v, _ := l.Flags[name].(float64)
+	return v
+}
+
+// Strings, tekrarlanabilen bayrağın değerlerini döner.
+func (l *LaunchArgs) Strings(name string) []string {
+	v, _ := l.Flags[name].([]string)
+	return slices.Clone(v)
+}
+
+// Usage, bildirilen bayrakların "--help" çıktısına uygun açıklamasıdır.
+func (l *LaunchArgs) Usage() string {
+	var b strings.Builder
+	for _, spec := range l.specs {
+		name := "--" + spec.name
+		switch spec.kind {
+		case argString, argStrings:
+			name += " <string>"
+		case argInt:
+			name += " <int>"
+		case argFloat:
+			name += " <number>"
+		}
+		fmt.Fprintf(&b, "  %-24s %s", name, spec.usage)
+		if spec.kind != argStrings && spec.def != nil && spec.def != "" && spec.def != false && spec.def != 0 && spec.def != 0.0 {
+			fmt.Fprintf(&b, " (default %v)", spec.def)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// Args, komut satırı argümanlarını WithArgs şemasına göre çözer. İlk
+// çağrıda os.Args çözülür ve sonuç saklanır; Run'dan önce de çağrılabilir
+// (ör. profile göre yapılandırma seçmek için).
+//
+//	app := gomad.New(gomad.WithArgs(
+//	    gomad.StringArg("profile", "default", "profile to open"),
+//	    gomad.BoolArg("safe-mode", false, "start without extensions"),
+//	))
+//	if app.Args().Bool("safe-mode") { ... }
+//
+// "--profile" her zaman tanımlıdır (bkz. Profile).
+//
+// Geçersiz değerler (ör. "--port=abc") uyarı olarak loglanır ve bayrak
+// varsayılan değerinde kalır; başlatma engellenmez.
+func (a *Application) Args() *LaunchArgs {
+	a.argsOnce.Do(func() {
+		specs := a.config.args
+		if !slices.ContainsFunc(specs, func(s Arg) bool { return s.name == profileFlag }) {
+			specs = append([]Arg{StringArg(profileFlag, "", "user profile to open")}, specs...)
+		}
+		args, errs := parseLaunchArgs(specs, os.Args[1:])
+		for _, err := range errs {
+			a.Logger().Warn("invalid command-line argument", "error", err)
+		}
+		a.launchArgs = args
+	})
+	return a.launchArgs
+}
+
+// publishArgs, argümanları frontend'e sticky olay olarak gönderir; olaydan
+// sonra abone olan bileşenler de son değeri alır.
+func (a *Application) publishArgs() {
+	if err := a.EmitSticky(argsEvent, a.Args()); err != nil {
+		a.Logger().Warn("failed to publish launch arguments", "error", err)
+	}
+}
+
+// parseLaunchArgs, argv'yi şemaya göre çözer. "--" sonrasındaki her şey
+// positional'dır; "-name" ve "--name" eşdeğerdir.
+func parseLaunchArgs(specs []Arg, argv []string) (*LaunchArgs, []error) {
+	args := &LaunchArgs{
+		Flags:      make(map[string]interface{}, len(specs)),
+		Positional: []string{},
+		specs:      specs,
+	}
+	args.Cwd, _ = os.Getwd()
+	byName := make(map[string]Arg, len(specs))
+	for _, spec := range specs {
+		byName[spec.name] = spec
+		args.Flags[spec.name] = spec.def
+	}
+
+	var errs []error
+	for i := 0; i < len(argv); i++ {
+		tok := argv[i]
+		if tok == "--" {
+			args.Positional = append(args.Positional, argv[i+1:]...)
+			break
+		}
+		if !isFlagToken(tok) {
+			args.Positional = append(args.Positional, tok)
+			continue
+		}
+		if tok == autoLaunchHiddenFlag {
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(strings.TrimLeft(tok, "-"), "=")
+		spec, ok := byName[name]
+		if !ok {
+			negated, found := strings.CutPrefix(name, "no-")
+			if b, isBool := byName[negated]; found && isBool && b.kind == argBool && !hasValue {
+				args.Flags[negated] = false
+				continue
+			}
+			args.Unknown = append(args.Unknown, tok)
+			continue
+		}
+
+		if spec.kind == argBool {
+			if !hasValue {
+				args.Flags[name] = true
+				continue
+			}
+			v, err := strconv.ParseBool(value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("--%s: invalid boolean %q", name, value))
+				continue
+			}
+			args.Flags[name] = v
+			continue
+		}
+
+		if !hasValue {
+			if i+1 >= len(argv) {
+				errs = append(errs, fmt.Errorf("--%s: missing value", name))
+				continue
+			}
+			i++
+			value = argv[i]
+		}
+		switch spec.kind {
+		case argString:
+			args.Flags[name] = value
+		case argStrings:
+			args.Flags[name] = append(slices.Clone(args.Flags[name].([]string)), value)
+		case argInt:
+			v, err := strconv.Atoi(value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("--%s: invalid integer %q", name, value))
+				continue
+			}
+			args.Flags[name] = v
+		case argFloat:
+			v, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("--%s: invalid number %q", name, value))
+				continue
+			}
+			args.Flags[name] = v
+		}
+	}
+	return args, errs
+}
+
+// isFlagToken, argümanın bayrak olup olmadığını söyler; "-" (stdin) ve
+// negatif sayılar positional sayılır.
+func isFlagToken(tok string) bool {
+	if len(tok) < 2 || tok[0] != '-' {
+		return false
+	}
+	_, err := strconv.ParseFloat(tok, 64)
+	return err != nil
+}
+
+// argsModule, başlatma argümanlarının JS API'sidir.
+//
+//	const { flags, positional } = await gomad.args();
+//	gomad.on("app:args", ({ flags }) => store.setProfile(flags.profile));
+func (a *Application) argsModule() builtinModule {
+	return builtinModule{
+		methods: map[string]interface{}{
+			"args": func() (*LaunchArgs, error) {
+				return a.Args(), nil
+			},
+		},
+	}
+}
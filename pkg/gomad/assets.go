@@ -0,0 +1,154 @@
+package gomad
+
+import (
+	"cmp"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/fs"
+	"mime"
+	"net"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// WithAssets, frontend derleme çıktısını (ör. Angular dist dizini) uygulamaya
+// gömülü olarak sunar. Dosyalar yalnızca 127.0.0.1 üzerinde dinleyen bir
+// HTTP sunucusundan verilir ve WebView bu adresi açar.
+//
+// Bilinmeyen yollar index.html'e yönlendirilir; böylece Angular router'ın
+// derin bağlantıları (ör. /settings/profile) sayfa yenilemede de çalışır.
+//
+// Örnek:
+//
+//	//go:embed all:frontend/dist/browser
+//	var assets embed.FS
+//
+//	dist, _ := fs.Sub(assets, "frontend/dist/browser")
+//	app := gomad.New(gomad.WithAssets(dist))
+//
+// "gomad bundle" (ya da "gomad build -bundle") çıktısı da doğrudan
+// verilebilir: <ad>.gz dosyaları <ad> olarak sunulur. WebView gzip kabul
+// ettiğinden içerik çözülmeden Content-Encoding: gzip ile aktarılır; kabul
+// etmeyen istemciler için akış halinde çözülür. Büyük Angular dist
+// dizinlerinde çalıştırılabilir dosya belirgin şekilde küçülür. webview_go
+// özel şema (custom scheme) işleyicisi sunmadığından sıkıştırılmış içerik de
+// aynı loopback sunucusundan verilir; zstd/brotli yerine gzip kullanılır
+// çünkü standart kütüphanede yalnızca gzip codec'i vardır:
+//
+//	//go:embed all:frontend/bundle
+//	var assets embed.FS
+//
+//	bundle, _ := fs.Sub(assets, "frontend/bundle")
+//	app := gomad.New(gomad.WithAssets(bundle))
+//
+// "gomad dev" altında frontend dev sunucusu kullanıldığından assets yok sayılır.
+func WithAssets(fsys fs.FS) Option {
+	return func(c *config) {
+		c.assets = fsys
+	}
+}
+
+// serveAssets, gömülü dosyalar için loopback sunucusunu başlatır ve adresini döner.
+func serveAssets(fsys fs.FS) (url string, stop func(), err error) {
+	if !assetExists(fsys, "index.html") {
+		return "", nil, errors.New("assets: index.html not found")
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", nil, err
+	}
+
+	server := &http.Server{Handler: spaHandler(fsys)}
+	go server.Serve(ln)
+	return "http://" + ln.Addr().String() + "/", func() { server.Close() }, nil
+}
+
+// spaHandler, dosyaları sunar; bulunamayan ve uzantısız yollarda index.html döner.
+func spaHandler(fsys fs.FS) http.Handler {
+	files := http.FileServer(http.FS(fsys))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(path.Clean(r.URL.Path), "/")
+		if name != "" && !assetExists(fsys, name) && path.Ext(name) == "" {
+			r.URL.Path = "/"
+			name = ""
+		}
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		if serveCompressed(w, r, fsys, name) {
+			return
+		}
+		files.ServeHTTP(w, r)
+	})
+}
+
+// assetExists, dosyanın kendisi ya da sıkıştırılmış (.gz) hali varsa true döner.
+func assetExists(fsys fs.FS, name string) bool {
+	for _, candidate := range []string{name, name + ".gz"} {
+		if _, err := fs.Stat(fsys, candidate); !errors.Is(err, fs.ErrNotExist) {
+			return true
+		}
+	}
+	return false
+}
+
+// serveCompressed, istenen dosyanın yalnızca .gz hali varsa onu sunar ve true
+// döner. Dizinler için index.html aranır.
+func serveCompressed(w http.ResponseWriter, r *http.Request, fsys fs.FS, name string) bool {
+	if info, err := fs.Stat(fsys, cmp.Or(name, ".")); err == nil {
+		if !info.IsDir() {
+			return false
+		}
+		name = path.Join(name, "index.html")
+		if _, err := fs.Stat(fsys, name); err == nil {
+			return false
+		}
+	}
+
+	f, err := fsys.Open(name + ".gz")
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	ctype := mime.TypeByExtension(path.Ext(name))
+	if ctype == "" {
+		ctype = "application/octet-stream"
+	}
+	w.Header().Set("Content-Type", ctype)
+	w.Header().Add("Vary", "Accept-Encoding")
+
+	var body io.Reader = f
+	if acceptsGzip(r) {
+		w.Header().Set("Content-Encoding", "gzip")
+		if info, err := f.Stat(); err == nil {
+			w.Header().Set("Content-Length", strconv.FormatInt(info.Size(), 10))
+		}
+	} else {
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			http.Error(w, "corrupt asset", http.StatusInternalServerError)
+			return true
+		}
+		defer zr.Close()
+		body = zr
+	}
+	if r.Method == http.MethodHead {
+		return true
+	}
+	io.Copy(w, body)
+	return true
+}
+
+// acceptsGzip, istemcinin gzip kodlamasını kabul edip etmediğini söyler.
+func acceptsGzip(r *http.Request) bool {
+	for _, part := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if strings.EqualFold(strings.TrimSpace(coding), "gzip") || coding == "*" {
+			return strings.ReplaceAll(params, " ", "") != "q=0"
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package gomad
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/biyonik/gomad/internal/bridge"
+	"github.com/biyonik/gomad/internal/webview"
+)
+
+// AuditRecord, tek bir köprü çağrısının denetim kaydıdır: metod, çağıran
+// sayfa, süre, sonuç (ok, error, rejected) ve istenirse argümanlar.
+type AuditRecord = bridge.AuditRecord
+
+// AuditSink, denetim kayıtlarını alan hedeftir (bkz. WithAuditLog).
+type AuditSink = bridge.AuditSink
+
+// AuditFunc, bir fonksiyonu AuditSink olarak kullanmayı sağlar.
+type AuditFunc = bridge.AuditFunc
+
+// Denetim kaydındaki çağrı sonuçları (AuditRecord.Outcome).
+const (
+	AuditOK       = bridge.AuditOK
+	AuditError    = bridge.AuditError
+	AuditRejected = bridge.AuditRejected
+)
+
+// NewJSONAuditSink, kayıtları w'ya satır başına bir JSON nesnesi (JSON
+// Lines) olarak yazan bir AuditSink oluşturur.
+//
+// Örnek:
+//
+//	f, _ := os.OpenFile("audit.jsonl", os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+//	app := gomad.New(gomad.WithAuditLog(gomad.NewJSONAuditSink(f)))
+func NewJSONAuditSink(w io.Writer) AuditSink {
+	return bridge.NewJSONAuditSink(w)
+}
+
+// RedactFields, WithAuditArgs için argümanları kaydeden ancak verilen
+// adlardaki nesne alanlarını (her derinlikte) "***" ile maskeleyen bir
+// fonksiyon döner.
+//
+// Örnek:
+//
+//	gomad.WithAuditArgs(gomad.RedactFields("password", "token", "ssn"))
+func RedactFields(fields ...string) func(method string, args json.RawMessage) json.RawMessage {
+	redact := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		redact[f] = true
+	}
+	return func(method string, args json.RawMessage) json.RawMessage {
+		var v interface{}
+		if err := json.Unmarshal(args, &v); err != nil {
+			return nil
+		}
+		out, err := json.Marshal(redactValue(v, redact))
+		if err != nil {
+			return nil
+		}
+		return out
+	}
+}
+
+// redactValue, v içindeki maskelenecek alanları değiştirir.
+func redactValue(v interface{}, redact map[string]bool) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			if redact[k] {
+				t[k] = "***"
+			} else {
+				t[k] = redactValue(child, redact)
+			}
+		}
+	case []interface{}:
+		for i, child := range t {
+			t[i] = redactValue(child, redact)
+		}
+	}
+	return v
+}
+
+// startAudit, WithAuditLog ile verilen sink'i köprüye bağlar. Hot-swap
+// backend sürecinde çağrılar pencere sürecinde zaten kaydedildiği için
+// bağlanmaz.
+func (a *Application) startAudit(wv webview.View) {
+	if a.config.auditSink == nil || os.Getenv(hotBackendEnv) != "" {
+		return
+	}
+	wv.Bridge().SetAudit(bridge.Audit{Sink: a.config.auditSink, Args: a.config.auditArgs})
+}
@@ -0,0 +1,66 @@
+package gomad
+
+import (
+	"os"
+	"slices"
+)
+
+// autoLaunchHiddenFlag, uygulamanın oturum açılışında gizli başlatıldığını
+// belirten komut satırı argümanıdır. SetAutoLaunch(true, true) tarafından eklenir.
+const autoLaunchHiddenFlag = "--gomad-hidden"
+
+// SetAutoLaunch, uygulamanın kullanıcı oturum açtığında otomatik başlamasını
+// ayarlar. hidden true ise uygulama penceresi gösterilmeden (ör. yalnızca
+// tray ikonuyla) başlar.
+//
+// "Sistemle birlikte başlat" ayarı için kullanılır:
+//
+//	app.SetAutoLaunch(settings.StartWithSystem, true)
+//
+// Kayıt, uygulama kimliği (WithAppID) ve çalışan programın yolu ile yapılır:
+//   - Windows: HKCU\Software\Microsoft\Windows\CurrentVersion\Run
+//   - macOS: ~/Library/LaunchAgents/<appID>.plist
+//   - Linux: ~/.config/autostart/<appID>.desktop
+//
+// Program taşınırsa kayıt eski yolu gösterir; uygulama her başlangıçta
+// ayarı tekrar uygulayarak yolu güncel tutabilir.
+func (a *Application) SetAutoLaunch(enabled, hidden bool) error {
+	if !enabled {
+		return disableAutoLaunch(a.config.appID)
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	var args []string
+	if hidden {
+		args = append(args, autoLaunchHiddenFlag)
+	}
+	return enableAutoLaunch(a.config.appID, a.config.title, exe, args)
+}
+
+// AutoLaunchEnabled, otomatik başlatma kaydının var olup olmadığını döner.
+func (a *Application) AutoLaunchEnabled() (bool, error) {
+	return autoLaunchEnabled(a.config.appID)
+}
+
+// LaunchedHidden, uygulama SetAutoLaunch(true, true) kaydıyla (gizli) başlatıldıysa
+// true döner. Bu durumda Run pencereyi gizli başlatır.
+func LaunchedHidden() bool {
+	return slices.Contains(os.Args[1:], autoLaunchHiddenFlag)
+}
+
+// autoLaunchModule, otomatik başlatmanın JS API'sidir (window.gomad.autoLaunch).
+//
+//	const enabled = await gomad.autoLaunch.isEnabled();
+//	await gomad.autoLaunch.set(true, true); // etkin, gizli başlat
+func (a *Application) autoLaunchModule() builtinModule {
+	return builtinModule{
+		namespace: "autoLaunch",
+		methods: map[string]interface{}{
+			"set":       a.SetAutoLaunch,
+			"isEnabled": a.AutoLaunchEnabled,
+		},
+	}
+}
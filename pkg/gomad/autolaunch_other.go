@@ -0,0 +1,119 @@
+//go:build !windows
+
+package gomad
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// autoLaunchFile, platformun otomatik başlatma dosyasının yolunu döner.
+func autoLaunchFile(appID string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	if runtime.GOOS == "darwin" {
+		return filepath.Join(home, "Library", "LaunchAgents", appID+".plist"), nil
+	}
+	config := os.Getenv("XDG_CONFIG_HOME")
+	if config == "" {
+		config = filepath.Join(home, ".config")
+	}
+	return filepath.Join(config, "autostart", appID+".desktop"), nil
+}
+
+func enableAutoLaunch(appID, name, exe string, args []string) error {
+	path, err := autoLaunchFile(appID)
+	if err != nil {
+		return err
+	}
+
+	var content string
+	if runtime.GOOS == "darwin" {
+		content = launchAgentPlist(appID, append([]string{exe}, args...))
+	} else {
+		content = autostartDesktopEntry(name, append([]string{exe}, args...))
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(content), 0o644)
+}
+
+func disableAutoLaunch(appID string) error {
+	path, err := autoLaunchFile(appID)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func autoLaunchEnabled(appID string) (bool, error) {
+	path, err := autoLaunchFile(appID)
+	if err != nil {
+		return false, err
+	}
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// launchAgentPlist, macOS LaunchAgent tanımını üretir.
+func launchAgentPlist(label string, command []string) string {
+	var sb strings.Builder
+	sb.WriteString(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+    <key>Label</key>
+    <string>` + html.EscapeString(label) + `</string>
+    <key>ProgramArguments</key>
+    <array>
+`)
+	for _, arg := range command {
+		fmt.Fprintf(&sb, "        <string>%s</string>\n", html.EscapeString(arg))
+	}
+	sb.WriteString(`    </array>
+    <key>RunAtLoad</key>
+    <true/>
+</dict>
+</plist>
+`)
+	return sb.String()
+}
+
+// autostartDesktopEntry, XDG autostart .desktop dosyasını üretir.
+func autostartDesktopEntry(name string, command []string) string {
+	quoted := make([]string, len(command))
+	for i, arg := range command {
+		quoted[i] = desktopQuote(arg)
+	}
+	return "[Desktop Entry]\n" +
+		"Type=Application\n" +
+		"Name=" + name + "\n" +
+		"Exec=" + strings.Join(quoted, " ") + "\n" +
+		"X-GNOME-Autostart-enabled=true\n"
+}
+
+// desktopQuote, Desktop Entry spesifikasyonuna göre Exec argümanını tırnaklar.
+func desktopQuote(arg string) string {
+	if !strings.ContainsAny(arg, " \t\"'\\$`") {
+		return arg
+	}
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "`", "\\`", `$`, `\$`)
+	return `"` + r.Replace(arg) + `"`
+}
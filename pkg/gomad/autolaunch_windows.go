@@ -0,0 +1,32 @@
+//go:build windows
+
+package gomad
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/biyonik/gomad/internal/platform/windows"
+)
+
+const runKey = `Software\Microsoft\Windows\CurrentVersion\Run`
+
+func enableAutoLaunch(appID, name, exe string, args []string) error {
+	command := `"` + exe + `"`
+	if len(args) > 0 {
+		command += " " + strings.Join(args, " ")
+	}
+	return windows.RegSetString(runKey, appID, command)
+}
+
+func disableAutoLaunch(appID string) error {
+	return windows.RegDeleteValue(runKey, appID)
+}
+
+func autoLaunchEnabled(appID string) (bool, error) {
+	_, err := windows.RegGetString(runKey, appID)
+	if errors.Is(err, windows.ERROR_FILE_NOT_FOUND) {
+		return false, nil
+	}
+	return err == nil, err
+}
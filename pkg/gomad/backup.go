@@ -0,0 +1,425 @@
+package gomad
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+const (
+	// backupFormat, yedek arşivinin biçim sürümüdür. Daha yeni biçimdeki
+	// arşivler içe aktarılmaz.
+	backupFormat = 1
+	// backupManifestName, arşivdeki üst veri dosyasıdır.
+	backupManifestName = "manifest.json"
+	// backupSettingsName, ayar deposunun arşivdeki adıdır.
+	backupSettingsName = "settings.json"
+	// backupFilesDir ve backupSourcesDir, bildirilen dosyaların ve
+	// kaynakların arşivdeki dizinleridir.
+	backupFilesDir   = "files/"
+	backupSourcesDir = "sources/"
+	// backupManifestMaxSize, manifest'in okunabilecek en büyük boyutudur;
+	// diğer girdiler manifest'te bildirilen boyutla sınırlanır.
+	backupManifestMaxSize = 16 << 20
+)
+
+// BackupSource, yedeğe kendi verisini ekleyen bir kaynaktır; örneğin
+// pkg/sqlite her veritabanı için tutarlı bir kopya ekler.
+type BackupSource struct {
+	// Export, kaynağın verisini w'ya yazar.
+	Export func(w io.Writer) error
+	// Import, Export'un yazdığı veriyi geri yükler. Açık dosyaları
+	// değiştiremeyen kaynaklar veriyi hazırlar ve sonraki başlangıçta uygular.
+	Import func(r io.Reader) error
+}
+
+// backupManifest, arşivin manifest.json dosyasıdır.
+type backupManifest struct {
+	Format          int           `json:"format"`
+	AppID           string        `json:"appId"`
+	AppVersion      string        `json:"appVersion"`
+	SettingsVersion int           `json:"settingsVersion"`
+	Created         time.Time     `json:"created"`
+	Entries         []backupEntry `json:"entries"`
+}
+
+// backupEntry, arşivdeki bir dosyanın doğrulama bilgisidir.
+type backupEntry struct {
+	Name   string `json:"name"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// dataImported, "data:imported" olayının verisidir.
+type dataImported struct {
+	AppVersion      string    `json:"appVersion"`
+	Created         time.Time `json:"created"`
+	RestartRequired bool      `json:"restartRequired"`
+}
+
+// RegisterBackupSource, name adıyla bir yedek kaynağı ekler. Dönen fonksiyon
+// kaynağı kaldırır.
+func (a *Application) RegisterBackupSource(name string, src BackupSource) (unregister func(), err error) {
+	if name == "" || !filepath.IsLocal(name) || src.Export == nil || src.Import == nil {
+		return nil, gomerrors.NewOperationError("backup.register", fmt.Sprintf("invalid backup source %q", name), gomerrors.ErrInvalidArgument)
+	}
+	a.backupMu.Lock()
+	defer a.backupMu.Unlock()
+	if _, exists := a.backupSources[name]; exists {
+		return nil, gomerrors.NewOperationError("backup.register", name, gomerrors.ErrAlreadyExists)
+	}
+	if a.backupSources == nil {
+		a.backupSources = map[string]BackupSource{}
+	}
+	a.backupSources[name] = src
+	return func() {
+		a.backupMu.Lock()
+		defer a.backupMu.Unlock()
+		delete(a.backupSources, name)
+	}, nil
+}
+
+// ExportData, ayar deposunu, kayıtlı yedek kaynaklarını (ör. pkg/sqlite
+// veritabanları) ve WithBackupFiles ile bildirilen dosyaları sürüm bilgisiyle
+// birlikte tek bir zip arşivine yazar. Şifreli veri dosyaları (bkz.
+// WithEncryptedData) çözülmüş olarak yazılır; arşiv başka bir bilgisayara
+// taşınabilir ve kullanıcı tarafından korunmalıdır.
+//
+//	app.Bind("backup", func(path string) error { return app.ExportData(path) })
+func (a *Application) ExportData(path string) error {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	err = a.writeBackup(f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("export data: %w", err)
+	}
+	a.Logger().Info("application data exported", "path", path)
+	return nil
+}
+
+// writeBackup, arşivi w'ya yazar.
+func (a *Application) writeBackup(w io.Writer) error {
+	zw := zip.NewWriter(w)
+	manifest := backupManifest{
+		Format:          backupFormat,
+		AppID:           a.config.appID,
+		AppVersion:      Build().Version,
+		SettingsVersion: a.Settings().Version(),
+		Created:         time.Now().UTC(),
+	}
+
+	add := func(name string, write func(io.Writer) error) error {
+		fw, err := zw.Create(name)
+		if err != nil {
+			return err
+		}
+		h := sha256.New()
+		cw := &countingWriter{w: io.MultiWriter(fw, h)}
+		if err := write(cw); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		manifest.Entries = append(manifest.Entries, backupEntry{Name: name, Size: cw.n, SHA256: hex.EncodeToString(h.Sum(nil))})
+		return nil
+	}
+	addFile := func(name, file string) error {
+		data, err := a.readAppFile(file)
+		if err != nil {
+			return err
+		}
+		return add(name, func(w io.Writer) error {
+			_, err := w.Write(data)
+			return err
+		})
+	}
+
+	// Ayarlar
+	configDir, err := a.Paths().Config()
+	if err != nil {
+		return err
+	}
+	settingsPath := filepath.Join(configDir, settingsFile)
+	if _, err := os.Stat(settingsPath); err == nil {
+		if err := addFile(backupSettingsName, settingsPath); err != nil {
+			return err
+		}
+	}
+
+	// Bildirilen dosyalar
+	files, err := a.backupFileList()
+	if err != nil {
+		return err
+	}
+	dataDir, err := a.Paths().UserData()
+	if err != nil {
+		return err
+	}
+	for _, rel := range files {
+		if err := addFile(backupFilesDir+filepath.ToSlash(rel), filepath.Join(dataDir, rel)); err != nil {
+			return err
+		}
+	}
+
+	// Kaynaklar
+	a.backupMu.Lock()
+	sources := maps.Clone(a.backupSources)
+	a.backupMu.Unlock()
+	for _, name := range slices.Sorted(maps.Keys(sources)) {
+		if err := add(backupSourcesDir+filepath.ToSlash(name), sources[name].Export); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	fw, err := zw.Create(backupManifestName)
+	if err != nil {
+		return err
+	}
+	if _, err := fw.Write(data); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// backupFileList, WithBackupFiles desenlerine uyan dosyaları UserData
+// dizinine göre göreli yollarla döner.
+func (a *Application) backupFileList() ([]string, error) {
+	if len(a.config.backupFiles) == 0 {
+		return nil, nil
+	}
+	dir, err := a.Paths().UserData()
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, pattern := range a.config.backupFiles {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, fmt.Errorf("backup pattern %q: %w", pattern, err)
+		}
+		for _, m := range matches {
+			if info, err := os.Stat(m); err != nil || !info.Mode().IsRegular() {
+				continue
+			}
+			rel, err := filepath.Rel(dir, m)
+			if err != nil || !filepath.IsLocal(rel) || slices.Contains(files, rel) {
+				continue
+			}
+			files = append(files, rel)
+		}
+	}
+	slices.Sort(files)
+	return files, nil
+}
+
+// ImportData, ExportData ile oluşturulan arşivi geri yükler. Arşiv önce
+// tamamen doğrulanır (uygulama kimliği, biçim ve ayar sürümü, dosya
+// özetleri); doğrulanamayan arşivden hiçbir şey yazılmaz. Yazmadan önce
+// mevcut veriler UserData/backups altına yedeklenir; WithEncryptedData
+// açıkken bu güvenlik yedeği de şifrelenir ve yine ImportData ile geri
+// yüklenebilir.
+//
+// Daha eski ayar sürümleri yüklenirken geçişler (bkz. WithSettingsMigration)
+// çalışır. Kaynaklar (ör. veritabanları) sonraki başlangıçta uygulanabilir;
+// JS'e gönderilen "data:imported" olayının restartRequired alanı bunu
+// bildirir ve uygulama Relaunch ile yeniden başlatılabilir.
+func (a *Application) ImportData(path string) error {
+	// Şifreli güvenlik yedekleri çözülür; ExportData arşivleri olduğu gibi okunur
+	archive, err := a.readAppFile(path)
+	if err != nil {
+		return fmt.Errorf("import data: %w", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return fmt.Errorf("import data: %w", err)
+	}
+
+	manifest, files, err := a.verifyBackup(zr)
+	if err != nil {
+		return err
+	}
+
+	// Geri alınabilmesi için mevcut verinin kopyası
+	dataDir, err := a.Paths().UserData()
+	if err != nil {
+		return err
+	}
+	safety := filepath.Join(dataDir, "backups", fmt.Sprintf("pre-import-%d.zip", time.Now().Unix()))
+	if err := os.MkdirAll(filepath.Dir(safety), 0o700); err != nil {
+		return err
+	}
+	// Arşiv çözülmüş veriyi içerir; diske writeAppFile ile (açıksa şifreli)
+	// yazılır ki içe aktarma şifreli veriyi düz metin olarak bırakmasın
+	var buf bytes.Buffer
+	if err := a.writeBackup(&buf); err != nil {
+		return fmt.Errorf("import data: safety backup: %w", err)
+	}
+	if err := a.writeAppFile(safety, buf.Bytes()); err != nil {
+		return fmt.Errorf("import data: safety backup: %w", err)
+	}
+
+	a.backupMu.Lock()
+	sources := maps.Clone(a.backupSources)
+	a.backupMu.Unlock()
+
+	restart := false
+	for _, e := range manifest.Entries {
+		data, err := readZipFile(files[e.Name], e.Size)
+		if err != nil {
+			return err
+		}
+		switch {
+		case e.Name == backupSettingsName:
+			configDir, err := a.Paths().Config()
+			if err != nil {
+				return err
+			}
+			if err := a.writeAppFile(filepath.Join(configDir, settingsFile), data); err != nil {
+				return err
+			}
+		case strings.HasPrefix(e.Name, backupFilesDir):
+			target := filepath.Join(dataDir, filepath.FromSlash(strings.TrimPrefix(e.Name, backupFilesDir)))
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return err
+			}
+			if err := a.writeAppFile(target, data); err != nil {
+				return err
+			}
+		case strings.HasPrefix(e.Name, backupSourcesDir):
+			name := filepath.FromSlash(strings.TrimPrefix(e.Name, backupSourcesDir))
+			src, ok := sources[name]
+			if !ok {
+				a.Logger().Warn("backup source not registered, skipping", "source", name)
+				continue
+			}
+			if err := src.Import(bytes.NewReader(data)); err != nil {
+				return fmt.Errorf("import data: %s: %w", name, err)
+			}
+			restart = true
+		}
+	}
+
+	if err := a.Settings().reload(); err != nil {
+		return fmt.Errorf("import data: settings: %w", err)
+	}
+
+	a.Logger().Info("application data imported", "path", path, "from_version", manifest.AppVersion, "safety_backup", safety)
+	_ = a.Broadcast("data:imported", dataImported{AppVersion: manifest.AppVersion, Created: manifest.Created, RestartRequired: restart})
+	return nil
+}
+
+// verifyBackup, arşivin manifest'ini ve dosya özetlerini doğrular.
+func (a *Application) verifyBackup(zr *zip.Reader) (backupManifest, map[string]*zip.File, error) {
+	var manifest backupManifest
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+	invalid := func(reason string) error {
+		return gomerrors.NewOperationError("data.import", reason, gomerrors.ErrInvalidArgument)
+	}
+
+	mf, ok := files[backupManifestName]
+	if !ok {
+		return manifest, nil, invalid("not a backup archive: missing " + backupManifestName)
+	}
+	data, err := readZipFile(mf, backupManifestMaxSize)
+	if err != nil {
+		return manifest, nil, err
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return manifest, nil, invalid("invalid " + backupManifestName + ": " + err.Error())
+	}
+	switch {
+	case manifest.Format < 1 || manifest.Format > backupFormat:
+		return manifest, nil, invalid(fmt.Sprintf("unsupported backup format %d", manifest.Format))
+	case manifest.AppID != a.config.appID:
+		return manifest, nil, invalid(fmt.Sprintf("backup belongs to %q, not %q", manifest.AppID, a.config.appID))
+	case manifest.SettingsVersion > a.config.settingsVersion:
+		return manifest, nil, invalid(fmt.Sprintf("backup was made by a newer version (settings v%d, this version supports v%d)",
+			manifest.SettingsVersion, a.config.settingsVersion))
+	}
+
+	for _, e := range manifest.Entries {
+		name := strings.TrimPrefix(strings.TrimPrefix(e.Name, backupFilesDir), backupSourcesDir)
+		if !filepath.IsLocal(filepath.FromSlash(name)) || path.Clean(e.Name) != e.Name {
+			return manifest, nil, invalid("unsafe entry name " + e.Name)
+		}
+		f, ok := files[e.Name]
+		if !ok {
+			return manifest, nil, invalid("missing entry " + e.Name)
+		}
+		if e.Size < 0 {
+			return manifest, nil, invalid("corrupted entry " + e.Name)
+		}
+		data, err := readZipFile(f, e.Size)
+		if err != nil {
+			return manifest, nil, err
+		}
+		sum := sha256.Sum256(data)
+		if int64(len(data)) != e.Size || hex.EncodeToString(sum[:]) != e.SHA256 {
+			return manifest, nil, invalid("corrupted entry " + e.Name)
+		}
+	}
+	return manifest, files, nil
+}
+
+// readZipFile, arşivdeki bir dosyanın en fazla limit baytlık içeriğini okur.
+// Sıkıştırılmış veri limit'ten uzun açılırsa (ör. zip bombası) hata döner;
+// zip başlığındaki boyuta güvenilmez.
+func readZipFile(f *zip.File, limit int64) ([]byte, error) {
+	if f == nil {
+		return nil, errors.New("missing archive entry")
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(io.LimitReader(rc, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, gomerrors.NewOperationError("data.import", fmt.Sprintf("entry %s exceeds %d bytes", f.Name, limit), gomerrors.ErrInvalidArgument)
+	}
+	return data, nil
+}
+
+// countingWriter, yazılan bayt sayısını tutar.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
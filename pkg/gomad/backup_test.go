@@ -0,0 +1,101 @@
+package gomad
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+func TestImportDataSealsSafetyBackup(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_DATA_HOME", filepath.Join(home, "data"))
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "config"))
+
+	a := &Application{
+		config:       &config{appID: "com.example.backup", encryptData: true, backupFiles: []string{"notes.json"}},
+		dataKeyBytes: bytes.Repeat([]byte{7}, 32),
+	}
+	secret := []byte(`{"note":"correct horse battery staple"}`)
+	if err := a.WriteDataFile("notes.json", secret); err != nil {
+		t.Fatal(err)
+	}
+
+	archive := filepath.Join(home, "export.zip")
+	if err := a.ExportData(archive); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.ImportData(archive); err != nil {
+		t.Fatal(err)
+	}
+
+	dataDir, err := a.Paths().UserData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	safety, _ := filepath.Glob(filepath.Join(dataDir, "backups", "pre-import-*.zip"))
+	if len(safety) != 1 {
+		t.Fatalf("safety backups = %v, want 1", safety)
+	}
+	raw, err := os.ReadFile(safety[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(raw, encryptedMagic) || bytes.Contains(raw, []byte("correct horse")) {
+		t.Fatal("safety backup is not encrypted")
+	}
+
+	// Güvenlik yedeği çözülerek okunabilir ve içe aktarılabilir
+	plain, err := a.readAppFile(safety[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(plain), int64(len(plain)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var notes *zip.File
+	for _, f := range zr.File {
+		if f.Name == backupFilesDir+"notes.json" {
+			notes = f
+		}
+	}
+	if got, err := readZipFile(notes, int64(len(secret))); err != nil || !bytes.Equal(got, secret) {
+		t.Fatalf("safety backup notes = %q, %v, want %q", got, err, secret)
+	}
+	if err := a.ImportData(safety[0]); err != nil {
+		t.Fatalf("ImportData(safety backup) = %v", err)
+	}
+}
+
+func TestReadZipFileLimit(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("big.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(make([]byte, 1<<20)); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Bildirilen boyuttan uzun açılan girdi reddedilir
+	if _, err := readZipFile(zr.File[0], 1024); !errors.Is(err, gomerrors.ErrInvalidArgument) {
+		t.Fatalf("readZipFile(limit 1024) = %v, want ErrInvalidArgument", err)
+	}
+	if data, err := readZipFile(zr.File[0], 1<<20); err != nil || len(data) != 1<<20 {
+		t.Fatalf("readZipFile(exact limit) = %d bytes, %v", len(data), err)
+	}
+}
@@ -0,0 +1,184 @@
+package gomad
+
+import "github.com/biyonik/gomad/pkg/bluetooth"
+
+// ============================================================================
+// Bluetooth
+// Tarama sırasında bulunan ya da bilgileri değişen aygıtlar JS'e
+// "bluetooth:device", sistemin unuttuğu aygıtlar "bluetooth:removed" olarak
+// iletilir (verisi bluetooth.Device). Bir karakteristiğe abone olunduğunda
+// her yeni değer "bluetooth:notify" ({characteristic, data}) olarak gönderilir.
+// Değerler JS'te Uint8Array'dir.
+//
+//	const stop = await gomad.bluetooth.scan((device) => list.upsert(device));
+//	await gomad.bluetooth.connect(id);
+//	const chars = await gomad.bluetooth.characteristics(id);
+//	const hr = chars.find(c => c.uuid.startsWith("00002a37"));
+//	const off = await gomad.bluetooth.notify(hr.id, (value) => show(value[1]));
+//	await gomad.bluetooth.write(ctrl.id, [0x01], { withoutResponse: true });
+// ============================================================================
+
+// bluetoothValue, JS'e gönderilen karakteristik değeridir; Data base64
+// olarak kodlanır.
+type bluetoothValue struct {
+	Characteristic string `json:"characteristic"`
+	Data           []byte `json:"data"`
+}
+
+// bluetoothWriteOptions, gomad.bluetooth.write seçenekleridir.
+type bluetoothWriteOptions struct {
+	WithoutResponse bool `json:"withoutResponse"`
+}
+
+// startBluetoothScan, tarama olaylarını JS'e iletmeye başlar. Birden fazla
+// çağrılması zararsızdır.
+func (a *Application) startBluetoothScan() error {
+	a.bluetoothMu.Lock()
+	defer a.bluetoothMu.Unlock()
+	if a.bluetoothScan != nil {
+		return nil
+	}
+	stop, err := bluetooth.Scan(func(e bluetooth.ScanEvent) {
+		_ = a.Emit("bluetooth:"+string(e.Type), e.Device)
+	})
+	if err != nil {
+		return err
+	}
+	a.bluetoothScan = stop
+	return nil
+}
+
+// stopBluetoothScan, taramayı durdurur.
+func (a *Application) stopBluetoothScan() {
+	a.bluetoothMu.Lock()
+	stop := a.bluetoothScan
+	a.bluetoothScan = nil
+	a.bluetoothMu.Unlock()
+
+	if stop != nil {
+		stop()
+	}
+}
+
+// writeBluetooth, karakteristiğe değer yazar.
+func (a *Application) writeBluetooth(id string, data []byte, opts *bluetoothWriteOptions) error {
+	withoutResponse := opts != nil && opts.WithoutResponse
+	return bluetooth.Write(id, data, withoutResponse)
+}
+
+// subscribeBluetooth, karakteristiğin bildirimlerini JS'e iletmeye başlar.
+// Zaten abone olunmuşsa bir şey yapmaz.
+func (a *Application) subscribeBluetooth(id string) error {
+	a.bluetoothMu.Lock()
+	defer a.bluetoothMu.Unlock()
+	if _, ok := a.bluetoothNotify[id]; ok {
+		return nil
+	}
+	stop, err := bluetooth.Notify(id, func(value []byte) {
+		_ = a.Emit("bluetooth:notify", bluetoothValue{Characteristic: id, Data: value})
+	})
+	if err != nil {
+		return err
+	}
+	if a.bluetoothNotify == nil {
+		a.bluetoothNotify = make(map[string]func())
+	}
+	a.bluetoothNotify[id] = stop
+	return nil
+}
+
+// unsubscribeBluetooth, karakteristiğin bildirim aboneliğini bitirir.
+func (a *Application) unsubscribeBluetooth(id string) {
+	a.bluetoothMu.Lock()
+	stop := a.bluetoothNotify[id]
+	delete(a.bluetoothNotify, id)
+	a.bluetoothMu.Unlock()
+
+	if stop != nil {
+		stop()
+	}
+}
+
+// stopBluetooth, taramayı ve bildirim aboneliklerini durdurur. Run
+// temizliğinde çağrılır.
+func (a *Application) stopBluetooth() {
+	a.stopBluetoothScan()
+
+	a.bluetoothMu.Lock()
+	subs := a.bluetoothNotify
+	a.bluetoothNotify = nil
+	a.bluetoothMu.Unlock()
+
+	for _, stop := range subs {
+		stop()
+	}
+}
+
+// bluetoothJS, değerleri Uint8Array'e çeviren ve olay dinleyicilerini
+// bağlayan scan, read, write ve notify yardımcılarını ekler.
+const bluetoothJS = `
+(function() {
+    const api = window.gomad.bluetooth;
+    const encode = (data) => {
+        const bytes = typeof data === 'string' ? new TextEncoder().encode(data) : Uint8Array.from(data);
+        let bin = '';
+        for (let i = 0; i < bytes.length; i++) bin += String.fromCharCode(bytes[i]);
+        return btoa(bin);
+    };
+    const decode = (b64) => Uint8Array.from(atob(b64 || ''), c => c.charCodeAt(0));
+    const read = api.read, write = api.write;
+    api.read = async (id) => decode(await read(id));
+    api.write = (id, data, opts) => write(id, encode(data), opts || null);
+    api.scan = async function(fn) {
+        const off = window.gomad.on('bluetooth:device', fn);
+        try {
+            await api.startScan();
+        } catch (e) {
+            off();
+            throw e;
+        }
+        return () => {
+            off();
+            api.stopScan().catch(() => {});
+        };
+    };
+    api.notify = async function(id, fn) {
+        const off = window.gomad.on('bluetooth:notify', (e) => {
+            if (e.characteristic === id) fn(decode(e.data));
+        });
+        try {
+            await api.subscribe(id);
+        } catch (e) {
+            off();
+            throw e;
+        }
+        return () => {
+            off();
+            api.unsubscribe(id).catch(() => {});
+        };
+    };
+})();
+`
+
+// bluetoothModule, Bluetooth'un JS API'sidir (window.gomad.bluetooth).
+//
+//	const devices = await gomad.bluetooth.devices();
+//	const value = await gomad.bluetooth.read(chars[0].id); // Uint8Array
+func (a *Application) bluetoothModule() builtinModule {
+	return builtinModule{
+		namespace: "bluetooth",
+		methods: map[string]interface{}{
+			"devices":         bluetooth.Devices,
+			"startScan":       a.startBluetoothScan,
+			"stopScan":        a.stopBluetoothScan,
+			"connect":         bluetooth.Connect,
+			"disconnect":      bluetooth.Disconnect,
+			"characteristics": bluetooth.Characteristics,
+			"read":            bluetooth.Read,
+			"write":           a.writeBluetooth,
+			"subscribe":       a.subscribeBluetooth,
+			"unsubscribe":     a.unsubscribeBluetooth,
+		},
+		init: bluetoothJS,
+	}
+}
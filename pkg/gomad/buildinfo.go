@@ -0,0 +1,45 @@
+package gomad
+
+import "runtime/debug"
+
+// Derleme bilgileri "gomad build" tarafından -ldflags "-X" ile doldurulur:
+//
+//	-X github.com/biyonik/gomad/pkg/gomad.buildVersion=1.4.0
+//	-X github.com/biyonik/gomad/pkg/gomad.buildCommit=3f2a1c9
+//	-X github.com/biyonik/gomad/pkg/gomad.buildDate=2025-01-31T10:00:00Z
+var (
+	buildVersion = ""
+	buildCommit  = ""
+	buildDate    = ""
+)
+
+// BuildInfo, uygulamanın derleme bilgileridir.
+type BuildInfo struct {
+	Version string `json:"version"` // "gomad build" ile verilen sürüm; yoksa "dev"
+	Commit  string `json:"commit"`  // VCS revizyonu (biliniyorsa)
+	Date    string `json:"date"`    // Derleme zamanı, RFC 3339 (biliniyorsa)
+}
+
+// Build, uygulamanın derleme bilgilerini döner.
+//
+// "gomad build" dışında derlenen uygulamalarda (go run, go build) sürüm "dev"
+// olur; commit ve tarih Go'nun gömdüğü VCS bilgisinden okunur.
+func Build() BuildInfo {
+	info := BuildInfo{Version: buildVersion, Commit: buildCommit, Date: buildDate}
+	if info.Version == "" {
+		info.Version = "dev"
+	}
+	if info.Commit == "" || info.Date == "" {
+		if bi, ok := debug.ReadBuildInfo(); ok {
+			for _, s := range bi.Settings {
+				switch {
+				case s.Key == "vcs.revision" && info.Commit == "":
+					info.Commit = s.Value
+				case s.Key == "vcs.time" && info.Date == "":
+					info.Date = s.Value
+				}
+			}
+		}
+	}
+	return info
+}
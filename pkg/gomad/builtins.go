@@ -0,0 +1,205 @@
+package gomad
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/biyonik/gomad/internal/webview"
+)
+
+// builtinPrefix, framework'e ayrılmış binding önekidir.
+// Kullanıcı binding'leri bu öneki kullanmamalıdır.
+const builtinPrefix = "gomad."
+
+// uiThreadBuiltins, native pencereye doğrudan eriştikleri için UI thread'inde
+// senkron çalışması gereken yerleşik binding'lerdir. Diğer yerleşikler
+// kullanıcı binding'leri gibi worker'larda çalışır; ağ ya da aygıt erişimi
+// yapan çağrılar (ör. gomad.bluetooth.read) pencereyi dondurmaz. Native
+// işlemlerini RunOnUIThread ile kendisi taşıyan metodlar listede yer almaz.
+var uiThreadBuiltins = map[string]bool{
+	// Sürükleme fare basışı sürerken pencerenin thread'inde başlamalıdır
+	"gomad.titlebar.drag":           true,
+	"gomad.titlebar.geometry":       true,
+	"gomad.titlebar.toggleMaximize": true,
+}
+
+// workerBuiltins, WithSyncCalls(true) modunda da worker'larda çalışan
+// yerleşik binding'lerdir. İndirme dakikalar sürebilir ve ilerleme olayları
+// ancak UI thread'i boştayken sayfaya ulaşır.
+var workerBuiltins = map[string]bool{
+	"gomad.update.check":    true,
+	"gomad.update.download": true,
+}
+
+// inlineCall, çağrının UI thread'inde senkron çalışıp çalışmayacağını seçer
+// (bkz. webview.Options.InlineCalls).
+func (a *Application) inlineCall(method string) bool {
+	if workerBuiltins[method] {
+		return false
+	}
+	return a.config.syncCalls || uiThreadBuiltins[method]
+}
+
+// builtinModule, JS tarafında window.gomad altında bir isim alanı olarak
+// görünen yerleşik fonksiyon grubudur.
+//
+//	namespace "tray", method "setTooltip"
+//	  → binding adı: "gomad.tray.setTooltip"
+//	  → JS: window.gomad.tray.setTooltip(...)
+//
+// namespace boşsa metodlar doğrudan window.gomad altına eklenir.
+// init, sarmalayıcılardan sonra çalışan ek JS kodudur (ör. olay dinleyicileri).
+// viewMethods, çağıran pencereye bağlı metodları her WebView için ayrı üretir
+// (ör. window.reload çağrıldığı pencereyi yeniler); wv nil ise yalnızca
+// metod adları için çağrılır.
+type builtinModule struct {
+	namespace   string
+	methods     map[string]interface{}
+	viewMethods func(wv webview.View) map[string]interface{}
+	init        string
+}
+
+// builtinModules, framework'ün JS tarafına açtığı tüm yerleşik modülleri döner.
+func (a *Application) builtinModules() []builtinModule {
+	modules := []builtinModule{
+		{
+			// JS: const paths = await gomad.paths()
+			methods: map[string]interface{}{
+				"paths": func() (map[string]string, error) {
+					return a.Paths().All()
+				},
+				// JS: const { version, commit, date } = await gomad.buildInfo()
+				"buildInfo": Build,
+				// JS: const { callsPerSec, p99, bytesIn } = await gomad.bridgeStats()
+				"bridgeStats": func() (BridgeStats, error) {
+					return a.BridgeStats(), nil
+				},
+				// JS: const { methods, errorsByCode, evalLatency } = await gomad.metrics()
+				"metrics": func() (Metrics, error) {
+					return a.Metrics(), nil
+				},
+			},
+		},
+		a.envModule(),
+		a.argsModule(),
+		a.launchModule(),
+		a.profileModule(),
+		a.trayModule(),
+		a.menuModule(),
+		a.dialogModule(),
+		a.notificationModule(),
+		a.shortcutsModule(),
+		a.powerModule(),
+		a.networkModule(),
+		a.idleModule(),
+		a.autoLaunchModule(),
+		a.appearanceModule(),
+		a.accessibilityModule(),
+		a.recentModule(),
+		a.printModule(),
+		a.captureModule(),
+		a.hidModule(),
+		a.serialModule(),
+		a.bluetoothModule(),
+		a.fsModule(),
+		a.updateModule(),
+		a.sysModule(),
+		a.stateModule(),
+		a.socketModule(),
+		a.tasksModule(),
+		a.i18nModule(),
+		a.themeModule(),
+		a.sessionModule(),
+		a.cacheModule(),
+		a.settingsModule(),
+		a.telemetryModule(),
+		a.flagsModule(),
+		a.extensionsModule(),
+		a.historyModule(),
+		a.kioskModule(),
+		a.titlebarModule(),
+		a.windowsModule(),
+		a.windowModule(),
+		a.shutdownModule(),
+	}
+	if a.config.debug {
+		modules = append(modules, a.inspectorModule(), a.overlayModule())
+	}
+	if driverEnabled() {
+		modules = append(modules, a.driverModule())
+	}
+	return modules
+}
+
+// bindingName, modül metodunun binding adını üretir.
+func (m builtinModule) bindingName(method string) string {
+	if m.namespace == "" {
+		return builtinPrefix + method
+	}
+	return builtinPrefix + m.namespace + "." + method
+}
+
+// script, modülün JS tarafındaki sarmalayıcılarını üretir.
+func (m builtinModule) script() string {
+	methods := make([]string, 0, len(m.methods))
+	for name := range m.methods {
+		methods = append(methods, name)
+	}
+	if m.viewMethods != nil {
+		for name := range m.viewMethods(nil) {
+			methods = append(methods, name)
+		}
+	}
+	sort.Strings(methods)
+
+	var sb strings.Builder
+	sb.WriteString("(function() {\n")
+	target := "window.gomad"
+	if m.namespace != "" {
+		fmt.Fprintf(&sb, "    window.gomad.%[1]s = window.gomad.%[1]s || {};\n", m.namespace)
+		target = "window.gomad." + m.namespace
+	}
+	for _, name := range methods {
+		fmt.Fprintf(&sb, "    %s.%s = (...args) => window.gomad.call(%q, ...args);\n",
+			target, name, m.bindingName(name))
+	}
+	sb.WriteString("})();\n")
+	sb.WriteString(m.init)
+	return sb.String()
+}
+
+// builtinScripts, tüm yerleşik modüllerin JS kodunu döner.
+// WebView oluşturulurken sayfa scriptlerinden önce enjekte edilir.
+func (a *Application) builtinScripts() []string {
+	modules := a.builtinModules()
+	scripts := make([]string, 0, len(modules))
+	for _, m := range modules {
+		scripts = append(scripts, m.script())
+	}
+	return scripts
+}
+
+// registerBuiltins, framework'ün JS tarafına açtığı yerleşik fonksiyonları kaydeder.
+// Run sırasında, kullanıcı binding'lerinden önce çağrılır.
+func (a *Application) registerBuiltins(wv webview.View) error {
+	for _, m := range a.builtinModules() {
+		methods := m.methods
+		if m.viewMethods != nil {
+			methods = make(map[string]interface{}, len(m.methods))
+			for method, fn := range m.methods {
+				methods[method] = fn
+			}
+			for method, fn := range m.viewMethods(wv) {
+				methods[method] = fn
+			}
+		}
+		for method, fn := range methods {
+			name := m.bindingName(method)
+			if err := wv.BindFunc(name, fn); err != nil {
+				return fmt.Errorf("failed to register built-in %q: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
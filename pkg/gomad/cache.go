@@ -0,0 +1,356 @@
+package gomad
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheInvalidatedEvent, önbellekten kayıt silindiğinde gönderilen olaydır.
+// Verisi {keys} veya {prefix} nesnesidir; JS tarafında kendi kopyasını tutan
+// kod bu olayla senkron kalır.
+const CacheInvalidatedEvent = "cache:invalidated"
+
+// cacheMaxEntries, bellekteki en fazla kayıt sayısıdır. Aşılınca önce süresi
+// dolanlar, sonra süresi en yakın olanlar atılır.
+const cacheMaxEntries = 4096
+
+// cacheDirName, disk önbelleğinin Cache dizini altındaki klasörüdür.
+const cacheDirName = "gomad-cache"
+
+// Cache, Go handler'ları ile JS'in ortak kullandığı TTL'li önbellektir.
+// Pahalı bir sonuç (ör. dizin taraması) bir kez hesaplanır; iki tarafta ayrı
+// ayrı önbelleklenmez. WithDiskCache ile kayıtlar yeniden başlatmalarda da
+// korunur.
+//
+//	files, err := app.Cache().GetOrLoad("scan:"+dir, time.Minute, func() (any, error) {
+//	    return scanDir(dir)
+//	})
+//
+//	// JS:
+//	// const files = await gomad.cache.memo("scan:" + dir, 60_000, () => gomad.call("scanDir", dir));
+//	// gomad.on("cache:invalidated", ({ keys, prefix }) => ...);
+type Cache struct {
+	app *Application
+	dir string // boşsa disk önbelleği kapalı
+
+	mu       sync.Mutex
+	entries  map[string]cacheEntry
+	inflight map[string]*cacheLoad
+}
+
+// cacheEntry, tek bir önbellek kaydıdır. Değer JSON olarak tutulur; böylece
+// Go ve JS aynı kaydı okur ve disk biçimi bellektekiyle aynıdır.
+type cacheEntry struct {
+	Key     string          `json:"key"`
+	Value   json.RawMessage `json:"value"`
+	Expires time.Time       `json:"expires,omitzero"`
+}
+
+func (e cacheEntry) expired(now time.Time) bool {
+	return !e.Expires.IsZero() && !now.Before(e.Expires)
+}
+
+// cacheLoad, GetOrLoad'un aynı anahtar için devam eden yüklemesidir.
+type cacheLoad struct {
+	done  chan struct{}
+	value json.RawMessage
+	err   error
+}
+
+// Cache, uygulamanın önbelleğini döner.
+func (a *Application) Cache() *Cache {
+	a.cacheOnce.Do(func() {
+		c := &Cache{app: a, entries: map[string]cacheEntry{}, inflight: map[string]*cacheLoad{}}
+		if a.config.diskCache {
+			if dir, err := a.Paths().Cache(); err == nil {
+				c.dir = filepath.Join(dir, cacheDirName)
+				if err := os.MkdirAll(c.dir, 0o700); err != nil {
+					a.Logger().Warn("disk cache disabled", "error", err)
+					c.dir = ""
+				}
+			}
+		}
+		a.cache = c
+	})
+	return a.cache
+}
+
+// Get, key'in değerini out'a çözer. Kayıt yoksa veya süresi dolduysa false döner.
+func (c *Cache) Get(key string, out any) (bool, error) {
+	raw, ok := c.raw(key)
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(raw, out)
+}
+
+// Set, key'e v'yi ttl süresiyle yazar. ttl 0 ise kayıt yalnızca
+// Invalidate ile silinir. v JSON-serializable olmalıdır.
+func (c *Cache) Set(key string, v any, ttl time.Duration) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	c.setRaw(key, raw, ttl)
+	return nil
+}
+
+// GetOrLoad, key önbellekte varsa onu, yoksa load'un sonucunu döner ve
+// ttl süresiyle saklar. Aynı anahtar için eşzamanlı çağrılar tek bir load'u
+// bekler. Sonuç JSON olarak döner; out'a çözmek için GetOrLoadInto kullanılır.
+func (c *Cache) GetOrLoad(key string, ttl time.Duration, load func() (any, error)) (json.RawMessage, error) {
+	if raw, ok := c.raw(key); ok {
+		return raw, nil
+	}
+
+	c.mu.Lock()
+	if l, ok := c.inflight[key]; ok {
+		c.mu.Unlock()
+		<-l.done
+		return l.value, l.err
+	}
+	l := &cacheLoad{done: make(chan struct{})}
+	c.inflight[key] = l
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		delete(c.inflight, key)
+		c.mu.Unlock()
+		close(l.done)
+	}()
+
+	v, err := load()
+	if err != nil {
+		l.err = err
+		return nil, err
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		l.err = err
+		return nil, err
+	}
+	c.setRaw(key, raw, ttl)
+	l.value = raw
+	return raw, nil
+}
+
+// GetOrLoadInto, GetOrLoad'un sonucu out'a çözen halidir.
+func (c *Cache) GetOrLoadInto(key string, ttl time.Duration, out any, load func() (any, error)) error {
+	raw, err := c.GetOrLoad(key, ttl, load)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, out)
+}
+
+// Invalidate, verilen anahtarları siler ve "cache:invalidated" gönderir.
+func (c *Cache) Invalidate(keys ...string) {
+	c.mu.Lock()
+	for _, key := range keys {
+		c.removeLocked(key)
+	}
+	c.mu.Unlock()
+	c.emit(map[string]any{"keys": keys})
+}
+
+// InvalidatePrefix, prefix ile başlayan tüm anahtarları siler (ör. bir
+// dizin değişince "scan:/home/ahmet/"). Boş prefix tüm önbelleği temizler.
+func (c *Cache) InvalidatePrefix(prefix string) {
+	c.mu.Lock()
+	for key := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			c.removeLocked(key)
+		}
+	}
+	c.mu.Unlock()
+	if c.dir != "" {
+		// Yalnızca diskte kalmış (henüz okunmamış) kayıtlar
+		for _, e := range c.diskEntries() {
+			if strings.HasPrefix(e.Key, prefix) {
+				_ = os.Remove(c.path(e.Key))
+			}
+		}
+	}
+	c.emit(map[string]any{"prefix": prefix})
+}
+
+// raw, kaydın JSON değerini döner. Bellekte yoksa diskten okunur.
+func (c *Cache) raw(key string) (json.RawMessage, bool) {
+	now := time.Now()
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	if ok && e.expired(now) {
+		c.removeLocked(key)
+		ok = false
+	}
+	c.mu.Unlock()
+	if ok {
+		return e.Value, true
+	}
+
+	if e, ok := c.readDisk(key); ok && !e.expired(now) {
+		c.mu.Lock()
+		c.entries[key] = e
+		c.mu.Unlock()
+		return e.Value, true
+	}
+	return nil, false
+}
+
+// setRaw, kaydı belleğe (ve açıksa diske) yazar.
+func (c *Cache) setRaw(key string, raw json.RawMessage, ttl time.Duration) {
+	e := cacheEntry{Key: key, Value: raw}
+	if ttl > 0 {
+		e.Expires = time.Now().Add(ttl)
+	}
+	c.mu.Lock()
+	c.entries[key] = e
+	if len(c.entries) > cacheMaxEntries {
+		c.evictLocked()
+	}
+	c.mu.Unlock()
+	c.writeDisk(e)
+}
+
+// evictLocked, süresi dolanları, yetmezse süresi en yakın kayıtları
+// bellekten atar. Diskteki kopyalar kalır. c.mu tutulmalıdır.
+func (c *Cache) evictLocked() {
+	now := time.Now()
+	for key, e := range c.entries {
+		if e.expired(now) {
+			c.removeLocked(key)
+		}
+	}
+	for len(c.entries) > cacheMaxEntries {
+		var victim string
+		var soonest time.Time
+		for key, e := range c.entries {
+			if victim == "" || (!e.Expires.IsZero() && (soonest.IsZero() || e.Expires.Before(soonest))) {
+				victim, soonest = key, e.Expires
+			}
+		}
+		delete(c.entries, victim)
+	}
+}
+
+// removeLocked, kaydı bellekten ve diskten siler. c.mu tutulmalıdır.
+func (c *Cache) removeLocked(key string) {
+	delete(c.entries, key)
+	if c.dir != "" {
+		_ = os.Remove(c.path(key))
+	}
+}
+
+// path, anahtarın disk dosyasıdır. Anahtar dosya adına uygun olmayabileceği
+// için özeti kullanılır; asıl anahtar dosyanın içinde saklanır.
+func (c *Cache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:16])+".json")
+}
+
+func (c *Cache) readDisk(key string) (cacheEntry, bool) {
+	if c.dir == "" {
+		return cacheEntry{}, false
+	}
+	data, err := c.app.readAppFile(c.path(key))
+	if err != nil {
+		return cacheEntry{}, false
+	}
+	var e cacheEntry
+	if json.Unmarshal(data, &e) != nil || e.Key != key {
+		return cacheEntry{}, false
+	}
+	return e, true
+}
+
+func (c *Cache) writeDisk(e cacheEntry) {
+	if c.dir == "" {
+		return
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	if err := c.app.writeAppFile(c.path(e.Key), data); err != nil {
+		c.app.Logger().Debug("cache write failed", "key", e.Key, "error", err)
+	}
+}
+
+// diskEntries, disk önbelleğindeki tüm kayıtları okur.
+func (c *Cache) diskEntries() []cacheEntry {
+	files, _ := filepath.Glob(filepath.Join(c.dir, "*.json"))
+	out := make([]cacheEntry, 0, len(files))
+	for _, f := range files {
+		data, err := c.app.readAppFile(f)
+		if err != nil {
+			continue
+		}
+		var e cacheEntry
+		if json.Unmarshal(data, &e) == nil {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// emit, silme olayını tüm pencerelere gönderir.
+func (c *Cache) emit(data map[string]any) {
+	if c.app.view() != nil {
+		_ = c.app.Broadcast(CacheInvalidatedEvent, data)
+	}
+}
+
+// cacheLookup, gomad.cache.get'in sonucudur.
+type cacheLookup struct {
+	Hit   bool            `json:"hit"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// cacheModule, önbelleğin JS API'sidir (window.gomad.cache).
+//
+//	const { hit, value } = await gomad.cache.get("scan:/tmp");
+//	await gomad.cache.set("user:42", user, 30_000); // ttl ms
+//	await gomad.cache.invalidate(["user:42"]);
+//	await gomad.cache.invalidatePrefix("scan:");
+//	const files = await gomad.cache.memo("scan:/tmp", 60_000, () => gomad.call("scanDir", "/tmp"));
+func (a *Application) cacheModule() builtinModule {
+	return builtinModule{
+		namespace: "cache",
+		methods: map[string]interface{}{
+			"get": func(key string) (cacheLookup, error) {
+				raw, ok := a.Cache().raw(key)
+				return cacheLookup{Hit: ok, Value: raw}, nil
+			},
+			"set": func(key string, value json.RawMessage, ttlMs int64) error {
+				a.Cache().setRaw(key, value, time.Duration(ttlMs)*time.Millisecond)
+				return nil
+			},
+			"invalidate": func(keys []string) error {
+				a.Cache().Invalidate(keys...)
+				return nil
+			},
+			"invalidatePrefix": func(prefix string) error {
+				a.Cache().InvalidatePrefix(prefix)
+				return nil
+			},
+		},
+		init: `
+(function() {
+    window.gomad.cache.memo = async (key, ttlMs, loader) => {
+        const { hit, value } = await window.gomad.cache.get(key);
+        if (hit) return value;
+        const fresh = await loader();
+        await window.gomad.cache.set(key, fresh === undefined ? null : fresh, ttlMs);
+        return fresh;
+    };
+})();
+`,
+	}
+}
@@ -0,0 +1,147 @@
+package gomad
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/jpeg"
+
+	"github.com/biyonik/gomad/pkg/capture"
+)
+
+// streamJPEGQuality, akış karelerinin JPEG kalitesidir.
+const streamJPEGQuality = 70
+
+// captureOptions, JS'ten gelen tek kare yakalama ayarlarıdır.
+type captureOptions struct {
+	Format  string `json:"format"`  // "png" (varsayılan) | "jpeg"
+	Quality int    `json:"quality"` // JPEG kalitesi (1-100), varsayılan 85
+}
+
+// captureImage, kaynağı yakalar ve data URL olarak döner.
+func (a *Application) captureImage(id string, opts captureOptions) (string, error) {
+	if opts.Format == "jpeg" {
+		if opts.Quality <= 0 {
+			opts.Quality = 85
+		}
+		data, err := capture.CaptureJPEG(id, opts.Quality)
+		if err != nil {
+			return "", err
+		}
+		return "data:image/jpeg;base64," + base64.StdEncoding.EncodeToString(data), nil
+	}
+	data, err := capture.CapturePNG(id)
+	if err != nil {
+		return "", err
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(data), nil
+}
+
+// startCaptureStream, kaynağı fps hızında yakalar ve her kareyi JS'e
+// "capture:frame" ({stream, data}) olayı olarak JPEG data URL ile gönderir.
+// Akış kimliğini döner; kaynak kapanırsa kare gönderimi durur.
+func (a *Application) startCaptureStream(id string, fps int) (string, error) {
+	a.captureMu.Lock()
+	a.nextStream++
+	streamID := fmt.Sprintf("stream-%d", a.nextStream)
+	a.captureMu.Unlock()
+
+	stop, err := capture.Stream(id, fps, func(img image.Image) {
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: streamJPEGQuality}); err != nil {
+			return
+		}
+		_ = a.Emit("capture:frame", map[string]string{
+			"stream": streamID,
+			"data":   "data:image/jpeg;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()),
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+
+	a.captureMu.Lock()
+	if a.captureStreams == nil {
+		a.captureStreams = make(map[string]func())
+	}
+	a.captureStreams[streamID] = stop
+	a.captureMu.Unlock()
+	return streamID, nil
+}
+
+// stopCaptureStream, JS akışını durdurur.
+func (a *Application) stopCaptureStream(streamID string) {
+	a.captureMu.Lock()
+	stop := a.captureStreams[streamID]
+	delete(a.captureStreams, streamID)
+	a.captureMu.Unlock()
+
+	if stop != nil {
+		stop()
+	}
+}
+
+// stopCaptureStreams, tüm akışları durdurur. Run temizliğinde çağrılır.
+func (a *Application) stopCaptureStreams() {
+	a.captureMu.Lock()
+	streams := a.captureStreams
+	a.captureStreams = nil
+	a.captureMu.Unlock()
+
+	for _, stop := range streams {
+		stop()
+	}
+}
+
+// captureStreamJS, akış karelerini bir canvas'a çizer ve canvas.captureStream
+// ile MediaStream üretir; <video> ya da MediaRecorder doğrudan kullanabilir.
+const captureStreamJS = `
+(function() {
+    window.gomad.capture.stream = async function(sourceId, fps) {
+        fps = fps || 15;
+        const streamId = await window.gomad.capture.startStream(sourceId, fps);
+        const canvas = document.createElement('canvas');
+        const ctx = canvas.getContext('2d');
+        const img = new Image();
+        img.onload = () => {
+            if (canvas.width !== img.width || canvas.height !== img.height) {
+                canvas.width = img.width;
+                canvas.height = img.height;
+            }
+            ctx.drawImage(img, 0, 0);
+        };
+        const offFrame = window.gomad.on('capture:frame', (f) => {
+            if (f.stream === streamId) img.src = f.data;
+        });
+        const media = canvas.captureStream(fps);
+        const stop = () => {
+            offFrame();
+            media.getTracks().forEach(t => t.stop());
+            window.gomad.capture.stopStream(streamId).catch(() => {});
+        };
+        media.getVideoTracks().forEach(t => t.addEventListener('ended', stop));
+        media.gomadStop = stop;
+        return media;
+    };
+})();
+`
+
+// captureModule, ekran yakalamanın JS API'sidir (window.gomad.capture).
+//
+//	const sources = await gomad.capture.sources();
+//	const dataUrl = await gomad.capture.image("screen:0", { format: "png" });
+//	const media = await gomad.capture.stream("window:1234", 15);
+//	video.srcObject = media; // bitince: media.gomadStop()
+func (a *Application) captureModule() builtinModule {
+	return builtinModule{
+		namespace: "capture",
+		methods: map[string]interface{}{
+			"sources":     capture.Sources,
+			"image":       a.captureImage,
+			"startStream": a.startCaptureStream,
+			"stopStream":  a.stopCaptureStream,
+		},
+		init: captureStreamJS,
+	}
+}
@@ -17,12 +17,27 @@
 // @email ahmet.altun60@gmail.com
 package gomad
 
+import (
+	"context"
+	"encoding/json"
+	"io/fs"
+	"log/slog"
+	"time"
+
+	"github.com/biyonik/gomad/internal/bridge"
+	"github.com/biyonik/gomad/pkg/i18n"
+	"github.com/biyonik/gomad/pkg/update"
+)
+
 // Option, Application yapılandırmasını değiştiren fonksiyonel bir seçenektir.
 // Fonksiyonel seçenekler deseni, API'nin genişletilebilir ve okunabilir olmasını sağlar.
 type Option func(*config)
 
 // config, uygulama konfigürasyonunu tutar.
 type config struct {
+	// Uygulama kimliği (dizin adları vb. için)
+	appID string
+
 	// Pencere ayarları
 	title     string
 	width     int
@@ -30,22 +45,179 @@ type config struct {
 	resizable bool
 
 	// WebView ayarları
-	debug bool
-	url   string
-	html  string
+	debug  bool
+	url    string
+	html   string
+	assets fs.FS
+
+	// Loglama
+	logger *slog.Logger
+
+	// Çökme yönetimi
+	crashDialog    bool
+	restartOnCrash bool
+
+	// JS'in izleyebileceği dizinler (bkz. WithWatchRoots)
+	watchRoots []string
+
+	// Pencere açmadan çalışma (bkz. WithHeadless)
+	headless bool
+
+	// Binding'leri UI thread'inde senkron çalıştırma (bkz. WithSyncCalls)
+	syncCalls bool
+
+	// Loopback'ten okunacak payload eşiği (bkz. WithLargePayloadThreshold)
+	largePayload int
+
+	// Binding çağırabilecek ek origin'ler (bkz. WithAllowedOrigins)
+	allowedOrigins []string
+
+	// Sayfa başına binding ve olay izinleri (bkz. WithCapabilities)
+	capabilities []Capability
+
+	// Sayfa mesajlarının imzalanması (bkz. WithMessageSigning)
+	signMessages bool
+
+	// Sayfadan gelen çağrıların sınırları (bkz. WithCallLimits)
+	callLimits CallLimits
+
+	// Yeniden yüklemeye dayanıklı mesaj günlüğünün boyutu (bkz. WithMessageJournal)
+	journalSize int
+
+	// Argüman, sonuç ve olay verisi boyut sınırı (bkz. WithMaxMessageSize)
+	maxMessageSize int
+
+	// Köprü çağrılarının izlenmesi (bkz. WithTracer)
+	tracer Tracer
+
+	// Metrik sunucusunun adresi (bkz. WithMetricsEndpoint)
+	metricsAddr string
+
+	// Komut satırı bayrak şeması (bkz. WithArgs)
+	args []Arg
+
+	// Açılışta profil seçen kanca (bkz. WithProfilePicker)
+	profilePicker ProfilePicker
+
+	// Açık pencereler kapanışta kaydedilip açılışta geri yüklenir (bkz. WithRestoreSession)
+	restoreSession bool
+
+	// Kök context'in üst context'i (bkz. WithContext)
+	context context.Context
+	// Kapanışta sayfaların ve çalışan çağrıların beklendiği süre (bkz. WithShutdownTimeout)
+	shutdownTimeout time.Duration
+
+	// Sayfaya window.gomad.env olarak açılan değerler (bkz. WithFrontendEnv)
+	frontendEnv map[string]string
+
+	// Sayfanın seri port erişim izni kararı (bkz. WithSerialPermission)
+	serialPermission func(port string) bool
+
+	// Trafik logu maskeleme kuralları (bkz. WithLogRedaction)
+	logRedaction *LogRedaction
+
+	// Takılan binding eşiği (bkz. WithSlowCallThreshold)
+	slowCallThreshold time.Duration
+
+	// Özellik bayraklarının varsayılanları ve uzak kaynağı (bkz. Flags)
+	flagDefaults map[string]any
+	flagSource   FlagSource
+	flagInterval time.Duration
+
+	// Kullanım olaylarının gönderileceği hedef (bkz. WithTelemetry)
+	telemetryExporter TelemetryExporter
+
+	// Yedeğe eklenecek UserData dosya desenleri (bkz. WithBackupFiles)
+	backupFiles []string
+
+	// Geri alma geçmişinde tutulacak en fazla komut (bkz. History)
+	undoLimit int
+
+	// Ayar şeması sürümü ve sürümden sürüme geçişler (bkz. Settings)
+	settingsVersion    int
+	settingsMigrations map[int]SettingsMigration
+
+	// Uygulama veri dosyalarının şifrelenmesi (bkz. WithEncryptedData)
+	encryptData bool
+
+	// Önbellek kayıtlarının diske de yazılması (bkz. WithDiskCache)
+	diskCache bool
+
+	// Oturum jetonu yönetimi (bkz. WithSession)
+	session *SessionOptions
+
+	// Tanımlı temalar ve başlangıç seçimi (bkz. WithThemes, WithTheme)
+	themes []Theme
+	theme  string
+
+	// Çeviri paketleri ve zorlanan yerel ayar (bkz. WithTranslations, WithLocale)
+	translations *i18n.Bundle
+	locale       string
+
+	// Aynı anda çalışan en fazla görev sayısı (bkz. WithTaskConcurrency)
+	taskConcurrency int
+
+	// JS'e taşınan hata ayrıntısı; nil ise moda göre seçilir (bkz. WithErrorPolicy)
+	errorPolicy *ErrorPolicy
+
+	// Sonuç ve olayların JSON politikası (bkz. WithJSONEncoding)
+	jsonEncoding JSONEncoding
+
+	// "system:stats" olayının aralığı ve profil yazma (bkz. WithRuntimeStats, WithProfiling)
+	runtimeStatsInterval time.Duration
+	profiling            bool
+
+	// Köprü çağrılarının denetim kaydı (bkz. WithAuditLog, WithAuditArgs)
+	auditSink AuditSink
+	auditArgs func(method string, args json.RawMessage) json.RawMessage
+
+	// Köprü trafiği kaydı (bkz. WithBridgeRecording)
+	recordPath string
+
+	// Güncelleme feed'i, varsayılan kanal ve otomatik kontrol aralığı (bkz. CheckForUpdate)
+	updateFeed     string
+	updateChannel  update.Channel
+	updateInterval time.Duration
+
+	// Pencere tüm sanal masaüstlerinde görünür (bkz. WithAllWorkspaces)
+	allWorkspaces bool
+
+	// Sayfa başlık çubuğu alanına uzanır (bkz. WithTitlebarOverlay)
+	titlebarOverlay bool
+
+	// Önceden hazırlanan gizli WebView sayısı (bkz. WithWebViewPool)
+	viewPool int
+
+	// Kiosk modu ve çıkış kısayolu (bkz. WithKiosk, WithKioskExit)
+	kiosk          bool
+	kioskExit      string
+	kioskAllowExit func() bool
 
 	// Callbacks
-	onReady func()
+	onReady          func()
+	onCloseRequested func() bool
 }
 
 // defaultConfig, mantıklı varsayılan değerler döner.
 func defaultConfig() *config {
 	return &config{
+		appID:     "gomad-app",
 		title:     "GOMAD Application",
 		width:     800,
 		height:    600,
 		resizable: true,
 		debug:     false,
+
+		crashDialog:       true,
+		updateChannel:     update.Stable,
+		largePayload:      1 << 20,
+		callLimits:        bridge.DefaultLimits,
+		slowCallThreshold: 10 * time.Second,
+		taskConcurrency:   4,
+		settingsVersion:   1,
+		undoLimit:         100,
+		maxMessageSize:    64 << 20,
+		shutdownTimeout:   defaultShutdownTimeout,
 	}
 }
 
@@ -83,3 +255,931 @@ func WithResizable(resizable bool) Option {
 		c.resizable = resizable
 	}
 }
+
+// WithURL, WebView'de açılacak adresi ayarlar (ör. yerel bir sunucu).
+// WithHTML ile birlikte verilirse URL önceliklidir.
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithURL("http://localhost:4200"))
+func WithURL(url string) Option {
+	return func(c *config) {
+		c.url = url
+	}
+}
+
+// WithHTML, WebView'de gösterilecek HTML içeriğini doğrudan ayarlar.
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithHTML("<h1>Merhaba</h1>"))
+func WithHTML(html string) Option {
+	return func(c *config) {
+		c.html = html
+	}
+}
+
+// WithDebug, WebView geliştirici araçlarını (F12 / sağ tık → Inspect) açar.
+// Köprü inspector'ı (Ctrl+Shift+B) ve köprü sağlığı overlay'i (Ctrl+Shift+O)
+// da yalnızca bu modda sayfaya eklenir.
+// Varsayılan: false
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithDebug(true))
+func WithDebug(debug bool) Option {
+	return func(c *config) {
+		c.debug = debug
+	}
+}
+
+// WithAppID, uygulamanın benzersiz kimliğini ayarlar.
+// Veri, ayar, cache ve log dizinleri bu kimlikten türetilir (bkz. Application.Paths).
+// Yol ayırıcı içermemelidir. Varsayılan: "gomad-app"
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithAppID("com.example.notes"))
+func WithAppID(id string) Option {
+	return func(c *config) {
+		c.appID = id
+	}
+}
+
+// WithLogger, uygulamanın yapılandırılmış (structured) logger'ını ayarlar.
+// Bridge, WebView ve platform katmanları lifecycle, çağrı ve hata loglarını
+// bu logger'a yazar. JS tarafındaki gomad.log.* çağrıları da aynı akışa düşer.
+// Varsayılan: log üretilmez.
+//
+// Örnek:
+//
+//	logger := slog.New(slog.NewJSONHandler(os.Stderr, nil))
+//	app := gomad.New(gomad.WithLogger(logger))
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *config) {
+		c.logger = logger
+	}
+}
+
+// WithSlowCallThreshold, bir binding'in takılmış sayılacağı süreyi ayarlar.
+// Süreyi aşan çağrı için binding'i çalıştıran goroutine'in yığın dökümü
+// loglanır ve JS'e "bridge:slow-call" olayı ({id, method, elapsedMs,
+// stack}) gönderilir; kilitlenen bir handler sonsuza dek bekleyen bir
+// promise yerine teşhis edilebilir bir hataya dönüşür. Çağrı iptal edilmez.
+// 0 kapatır. Varsayılan: 10 saniye.
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithSlowCallThreshold(3 * time.Second))
+//	// JS: gomad.on("bridge:slow-call", ({ method, elapsedMs }) => showSpinnerHint(method))
+func WithSlowCallThreshold(d time.Duration) Option {
+	return func(c *config) {
+		c.slowCallThreshold = d
+	}
+}
+
+// WithFeatureFlags, özellik bayraklarının yerel varsayılanlarını ayarlar.
+// Değerler JSON-serializable olmalıdır; uzak kaynak (WithFlagSource) aynı
+// adlı bayrakları ezer.
+func WithFeatureFlags(defaults map[string]any) Option {
+	return func(c *config) {
+		c.flagDefaults = defaults
+	}
+}
+
+// WithFlagSource, bayrak değerlerinin alınacağı uzak kaynağı ayarlar.
+// Değerler Run başlarken ve interval aralıklarıyla (0 ise yalnızca
+// başlangıçta) yenilenir; son alınan değerler diske yazılır ve çevrimdışı
+// başlangıçlarda kullanılır.
+func WithFlagSource(src FlagSource, interval time.Duration) Option {
+	return func(c *config) {
+		c.flagSource = src
+		c.flagInterval = interval
+	}
+}
+
+// WithTelemetry, app.Telemetry() olaylarının gönderileceği exporter'ı
+// ayarlar. Telemetri yine de kullanıcı SetEnabled(true) ile onay verene kadar
+// kapalıdır; olaylar anonim kimlikle toplanır ve toplu gönderilir.
+//
+//	gomad.WithTelemetry(gomad.NewHTTPTelemetryExporter("https://t.example.com/v1"))
+//
+// Varsayılan: nil (telemetri devre dışı)
+func WithTelemetry(exporter TelemetryExporter) Option {
+	return func(c *config) {
+		c.telemetryExporter = exporter
+	}
+}
+
+// WithBackupFiles, ExportData'nın arşive ekleyeceği kullanıcı dosyalarını
+// UserData dizinine göre glob desenleriyle bildirir:
+//
+//	gomad.WithBackupFiles("notes/*.md", "templates/*.json")
+//
+// Ayar deposu ve kayıtlı yedek kaynakları (ör. pkg/sqlite) her zaman eklenir.
+func WithBackupFiles(patterns ...string) Option {
+	return func(c *config) {
+		c.backupFiles = append(c.backupFiles, patterns...)
+	}
+}
+
+// WithUndoLimit, app.History()'nin geri alınabilir adım sayısını sınırlar;
+// sınır aşıldığında en eski komut düşer.
+// Varsayılan: 100
+func WithUndoLimit(n int) Option {
+	return func(c *config) {
+		if n > 0 {
+			c.undoLimit = n
+		}
+	}
+}
+
+// WithSettingsVersion, app.Settings() için geçerli şema sürümünü ayarlar.
+// Daha eski sürümle yazılmış ayar dosyaları başlangıçta
+// WithSettingsMigration ile kaydedilen geçişlerle taşınır.
+// Varsayılan: 1
+func WithSettingsVersion(version int) Option {
+	return func(c *config) {
+		c.settingsVersion = version
+	}
+}
+
+// WithSettingsMigration, from sürümündeki ayarları from+1'e taşıyan geçişi
+// kaydeder. Her ara sürüm için bir geçiş gerekir:
+//
+//	gomad.New(
+//	    gomad.WithSettingsVersion(3),
+//	    gomad.WithSettingsMigration(1, renameThemeKey),
+//	    gomad.WithSettingsMigration(2, splitWindowBounds),
+//	)
+func WithSettingsMigration(from int, fn SettingsMigration) Option {
+	return func(c *config) {
+		if c.settingsMigrations == nil {
+			c.settingsMigrations = map[int]SettingsMigration{}
+		}
+		c.settingsMigrations[from] = fn
+	}
+}
+
+// WithEncryptedData, framework'ün ve uygulamanın veri dosyalarını
+// (WriteDataFile, son kullanılan dokümanlar, güncelleme ayarları, disk
+// önbelleği) AES-256-GCM ile şifreler. Anahtar işletim sisteminin güvenli
+// kimlik deposunda tutulur (Windows: DPAPI korumalı Credential Manager,
+// macOS: Keychain, Linux: Secret Service); paylaşılan bilgisayarlarda başka
+// kullanıcılar dosyaları okuyamaz.
+//
+// Şifreleme sonradan açılırsa mevcut düz metin dosyalar okunmaya devam eder
+// ve ilk yazmada şifrelenir. Güvenli depo yoksa yazma hata döner; veriler
+// düz metne düşürülmez. Loglar ve çökme raporları şifrelenmez.
+// Varsayılan: false
+func WithEncryptedData(enabled bool) Option {
+	return func(c *config) {
+		c.encryptData = enabled
+	}
+}
+
+// WithDiskCache, app.Cache() kayıtlarının uygulamanın Cache dizinine de
+// yazılmasını sağlar; TTL'i dolmamış kayıtlar yeniden başlatmadan sonra da
+// kullanılır. Varsayılan: false (yalnızca bellek)
+func WithDiskCache(enabled bool) Option {
+	return func(c *config) {
+		c.diskCache = enabled
+	}
+}
+
+// WithSession, kimlik doğrulama oturumunun yenileme fonksiyonunu ve
+// ayarlarını verir (bkz. Session). Verilmezse app.Session() yine kullanılabilir;
+// ancak süresi dolan jetonlar yenilenmez, oturum sona erer.
+func WithSession(opts SessionOptions) Option {
+	return func(c *config) {
+		c.session = &opts
+	}
+}
+
+// WithThemes, uygulamanın adlandırılmış temalarını tanımlar. Temalar
+// sayfaya CSS custom property olarak enjekte edilir (bkz. Theme) ve SetTheme
+// ile yeniden yükleme olmadan değiştirilebilir.
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithThemes(
+//	    gomad.Theme{Name: "light", Colors: map[string]string{"bg": "#fff", "fg": "#222"}},
+//	    gomad.Theme{Name: "dark", Dark: true, Colors: map[string]string{"bg": "#1e1e24", "fg": "#ddd"}},
+//	))
+func WithThemes(themes ...Theme) Option {
+	return func(c *config) {
+		c.themes = append(c.themes, themes...)
+	}
+}
+
+// WithTheme, başlangıç temasını seçer: bir tema adı veya ThemeAuto.
+// Varsayılan: ThemeAuto (sistemin koyu/açık tercihine uyan tema)
+func WithTheme(name string) Option {
+	return func(c *config) {
+		c.theme = name
+	}
+}
+
+// WithTranslations, Go (app.T) ve JS (gomad.i18n.t) tarafından kullanılan
+// çeviri paketlerini ayarlar (bkz. i18n.Load).
+//
+// Örnek:
+//
+//	//go:embed locales/*.json
+//	var locales embed.FS
+//
+//	bundle, err := i18n.Load(locales, "locales")
+//	if err != nil { ... }
+//	app := gomad.New(gomad.WithTranslations(bundle))
+func WithTranslations(bundle *i18n.Bundle) Option {
+	return func(c *config) {
+		c.translations = bundle
+	}
+}
+
+// WithLocale, başlangıç yerel ayarını işletim sisteminden algılamak yerine
+// sabitler (ör. kullanıcının kaydedilmiş tercihi). Çalışırken SetLocale ile
+// değiştirilebilir.
+func WithLocale(locale string) Option {
+	return func(c *config) {
+		c.locale = locale
+	}
+}
+
+// WithTaskConcurrency, StartTask ile başlatılan görevlerden aynı anda en
+// fazla kaçının çalışacağını ayarlar; fazlası "queued" durumunda sırasını
+// bekler. Varsayılan: 4
+func WithTaskConcurrency(n int) Option {
+	return func(c *config) {
+		c.taskConcurrency = max(n, 1)
+	}
+}
+
+// WithErrorPolicy, binding hatalarının ne kadar ayrıntıyla JS'e taşınacağını
+// ayarlar. Verilmezse debug modunda ErrorPolicyDebug (yığınlar dahil),
+// "gomad build" ile derlenen sürümlerde ErrorPolicyGeneric (iç hatalar
+// yalnızca "internal error (ref 3f9a1c2e4b7d)" olarak gider, asıl hata aynı
+// ref ile loglanır), diğer durumlarda ErrorPolicyDetailed kullanılır.
+//
+// Kullanıcıya gösterilmesi amaçlanan hatalar için hata kodu kaydedilmelidir
+// (bkz. NewErrorCode); bunların mesajları her politikada korunur.
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithErrorPolicy(gomad.ErrorPolicyGeneric))
+func WithErrorPolicy(p ErrorPolicy) Option {
+	return func(c *config) {
+		c.errorPolicy = &p
+	}
+}
+
+// WithJSONEncoding, binding sonuçlarının ve olayların JSON'a çevrilme
+// politikasını ayarlar; "gomad types" çıktısı da aynı politikayla üretilir.
+// Argümanlar ters yönde çevrilir, yani JS üretilen tiplerle çağrı yapabilir.
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithJSONEncoding(gomad.JSONEncoding{
+//	    Int64AsString: true,           // Kayıt ID'leri 2^53'ü aşabilir
+//	    TimeLayout:    time.DateOnly,
+//	    OmitNil:       true,
+//	    FieldNames:    gomad.CamelCase,
+//	}))
+func WithJSONEncoding(enc JSONEncoding) Option {
+	return func(c *config) {
+		c.jsonEncoding = enc
+	}
+}
+
+// WithRuntimeStats, goroutine sayısı ve bellek istatistiklerini (bkz.
+// RuntimeStats) verilen aralıkta "system:stats" olayı olarak JS'e gönderir.
+// Uzun süre açık kalan oturumlarda sızıntıları izlemek içindir; her ölçüm
+// kısa bir dünya durdurması yaptığından aralık saniyeler mertebesinde
+// olmalıdır. Varsayılan: gönderilmez.
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithRuntimeStats(10 * time.Second))
+func WithRuntimeStats(interval time.Duration) Option {
+	return func(c *config) {
+		c.runtimeStatsInterval = interval
+	}
+}
+
+// WithProfiling, debug modu dışında da gomad.sys.profile(kind) ile pprof
+// profillerinin Logs dizinine yazılmasına izin verir (bkz. WriteProfile).
+// Sahada sızıntı teşhisi için açılabilir. Varsayılan: yalnızca debug modunda.
+func WithProfiling(enabled bool) Option {
+	return func(c *config) {
+		c.profiling = enabled
+	}
+}
+
+// LevelTrace, köprü trafiği loglarının seviyesidir. Debug'dan daha
+// ayrıntılıdır: her çağrının argümanları ve sonucu ile her olayın verisi
+// (maskelenerek, bkz. WithLogRedaction) bu seviyede loglanır.
+//
+//	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: gomad.LevelTrace}))
+//	app := gomad.New(gomad.WithLogger(logger))
+const LevelTrace = bridge.LevelTrace
+
+// LogRedaction, trafik loglarında maskelenecek metodlar (tüm yük) ve nesne
+// alanlarıdır (her derinlikte, büyük/küçük harf duyarsız).
+type LogRedaction = bridge.Redaction
+
+// DefaultLogRedaction, varsayılan maskeleme kurallarıdır: yaygın parola,
+// token ve anahtar alan adları.
+var DefaultLogRedaction = bridge.DefaultRedaction
+
+// WithLogRedaction, LevelTrace trafik loglarının maskeleme kurallarını
+// ayarlar. Kurallar DefaultLogRedaction'ın yerine geçer; varsayılan alanları
+// korumak için onlara eklenmelidir.
+//
+// Örnek:
+//
+//	gomad.WithLogRedaction(gomad.LogRedaction{
+//		Methods: []string{"auth.*"},
+//		Fields:  append(gomad.DefaultLogRedaction.Fields, "iban", "ssn"),
+//	})
+func WithLogRedaction(r LogRedaction) Option {
+	return func(c *config) {
+		c.logRedaction = &r
+	}
+}
+
+// WithOnCloseRequested, kullanıcı pencereyi kapatmak istediğinde (X butonu,
+// Alt+F4 vb.) çağrılacak callback'i ayarlar. Callback false dönerse pencere
+// kapanmaz — "kaydedilmemiş değişiklikler var" uyarıları için kullanılır.
+//
+// Callback UI thread'inde çalışır; uzun işlemler yapılmamalıdır.
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithOnCloseRequested(func() bool {
+//	    return !doc.IsDirty()
+//	}))
+func WithOnCloseRequested(fn func() bool) Option {
+	return func(c *config) {
+		c.onCloseRequested = fn
+	}
+}
+
+// WithAllWorkspaces, ana pencereyi tüm sanal masaüstlerinde gösterir
+// (bkz. Application.SetAllWorkspaces). Desteklenmeyen platformlarda
+// etkisizdir. Varsayılan: false
+func WithAllWorkspaces(enabled bool) Option {
+	return func(c *config) {
+		c.allWorkspaces = enabled
+	}
+}
+
+// WithTitlebarOverlay, sayfanın başlık çubuğu alanına uzandığı hibrit modu
+// açar: native küçült/büyüt/kapat düğmeleri kalır, başlığı sayfa çizer.
+// Düğmelerin dışında kalan alan --gomad-titlebar-area-* CSS değişkenleriyle,
+// sürükleme bölgeleri "-webkit-app-region: drag" ile belirlenir (bkz.
+// Application.SetTitlebarOverlay). Varsayılan: false
+func WithTitlebarOverlay(enabled bool) Option {
+	return func(c *config) {
+		c.titlebarOverlay = enabled
+	}
+}
+
+// WithWebViewPool, başlangıçtan kısa süre sonra n adet gizli WebView
+// hazırlar; OpenWindow ile açılan ikincil pencereler (ayarlar, önizleme)
+// motorun açılışını beklemeden anında görünür. Havuz kullanıldıkça arka
+// planda yeniden doldurulur. Her WebView bellek tuttuğu için küçük bir sayı
+// (1-2) önerilir; gizli pencere oluşturulamayan platformlarda havuz
+// kullanılmaz. Varsayılan: 0 (havuz yok)
+func WithWebViewPool(n int) Option {
+	return func(c *config) {
+		if n >= 0 {
+			c.viewPool = n
+		}
+	}
+}
+
+// WithKiosk, uygulamayı satış noktası ve tabela kurulumları için kiosk
+// modunda başlatır: pencere tam ekran, çerçevesiz ve her zaman üstte açılır,
+// kapatma düğmesi ve Alt+F4 çalışmaz, sayfa uygulamanın origin'i ve
+// WithAllowedOrigins dışına gidemez. Kiosktan app.ExitKiosk() ya da
+// WithKioskExit kısayoluyla çıkılır.
+//
+//	app := gomad.New(
+//	    gomad.WithKiosk(),
+//	    gomad.WithKioskExit("Ctrl+Alt+Shift+K", askTechnicianPIN),
+//	)
+func WithKiosk() Option {
+	return func(c *config) {
+		c.kiosk = true
+	}
+}
+
+// WithKioskExit, kiosktan çıkış kısayolunu ayarlar (bkz. WithKiosk). allow
+// kısayola basıldığında çağrılır; false dönerse kioskta kalınır (ör. yanlış
+// teknisyen PIN'i). nil ise kısayol doğrudan çıkar.
+func WithKioskExit(accelerator string, allow func() bool) Option {
+	return func(c *config) {
+		c.kioskExit = accelerator
+		c.kioskAllowExit = allow
+	}
+}
+
+// WithCrashDialog, yakalanmayan bir panic sonrası native "uygulama çöktü"
+// dialogunun gösterilip gösterilmeyeceğini ayarlar. Rapor her durumda Logs
+// dizinine yazılır. Aynı ayar, Run pencere açılamadan (webview oluşturulamadı,
+// WindowError) başarısız olduğunda gösterilen native hata kutusunu da
+// kapsar. Varsayılan: true
+func WithCrashDialog(enabled bool) Option {
+	return func(c *config) {
+		c.crashDialog = enabled
+	}
+}
+
+// WithRestartOnCrash, yakalanmayan bir panic sonrası uygulamanın otomatik
+// olarak yeniden başlatılmasını sağlar. Yeniden başlatılan süreç tekrar
+// çökerse döngüye girmemek için bir daha yeniden başlatılmaz. Varsayılan: false
+func WithRestartOnCrash(enabled bool) Option {
+	return func(c *config) {
+		c.restartOnCrash = enabled
+	}
+}
+
+// WithWatchRoots, JS tarafının gomad.fs.watch ile izleyebileceği dizinleri
+// belirler. JS yalnızca bu dizinleri ve altlarını izleyebilir; liste boşsa
+// (varsayılan) JS'ten izleme kapalıdır. Go tarafındaki app.WatchDir bu
+// listeden etkilenmez.
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithWatchRoots(inboxDir, templatesDir))
+func WithWatchRoots(roots ...string) Option {
+	return func(c *config) {
+		c.watchRoots = append(c.watchRoots, roots...)
+	}
+}
+
+// WithHeadless, uygulamayı native pencere ve WebView oluşturmadan çalıştırır.
+// Köprü, binding'ler, Emit ve mesaj protokolü normal çalışır; JS tarafı
+// HeadlessClient ile taklit edilir. Ekran sunucusu olmayan CI ortamlarında
+// Go ↔ JS sözleşmelerini test etmek içindir. GOMAD_HEADLESS=1 ortam
+// değişkeni de aynı etkiyi yapar. Varsayılan: false
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithHeadless(true))
+func WithHeadless(headless bool) Option {
+	return func(c *config) {
+		c.headless = headless
+	}
+}
+
+// WithBridgeRecording, köprüden geçen tüm mesajları (çağrılar, sonuçlar,
+// olaylar) zaman damgalarıyla path'e JSON Lines olarak kaydeder. Kayıt
+// "gomad replay" ile uygulamaya geri verilerek hata deterministik olarak
+// yeniden üretilebilir. GOMAD_RECORD ortam değişkeni de aynı etkiyi yapar.
+//
+// Kayıt binding argümanlarını ve sonuçlarını açık metin olarak içerir;
+// hassas veri işleyen uygulamalarda yalnızca geçici olarak açılmalıdır.
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithBridgeRecording("bridge.jsonl"))
+func WithBridgeRecording(path string) Option {
+	return func(c *config) {
+		c.recordPath = path
+	}
+}
+
+// WithUpdateFeed, CheckForUpdate'in okuyacağı feed adresini ayarlar
+// (bkz. update.Feed).
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithUpdateFeed("https://example.com/notes/feed.json"))
+func WithUpdateFeed(url string) Option {
+	return func(c *config) {
+		c.updateFeed = url
+	}
+}
+
+// WithUpdateChannel, kullanıcı henüz kanal seçmemişse kullanılacak yayın
+// kanalını ayarlar. Kullanıcının seçimi (SetUpdateChannel) önceliklidir.
+// Varsayılan: update.Stable
+func WithUpdateChannel(channel update.Channel) Option {
+	return func(c *config) {
+		c.updateChannel = channel
+	}
+}
+
+// WithAutoUpdate, güncellemeleri açılışta ve her interval'de kontrol eder;
+// bulunan sürüm arka planda indirilir (bkz. DownloadUpdate). Frontend
+// hiçbir şeyi yoklamadan "update:available", "update:downloading" ve
+// "update:ready" olaylarıyla "Güncellemek için yeniden başlat" bandını
+// gösterir; kurulum kullanıcı onayıyla gomad.update.install() ile yapılır.
+// WithUpdateFeed gerektirir.
+//
+//	app := gomad.New(
+//	    gomad.WithUpdateFeed("https://example.com/notes/feed.json"),
+//	    gomad.WithAutoUpdate(6*time.Hour),
+//	)
+func WithAutoUpdate(interval time.Duration) Option {
+	return func(c *config) {
+		c.updateInterval = interval
+	}
+}
+
+// WithSyncCalls, binding'lerin UI thread'inde senkron çalışmasını sağlar.
+//
+// Varsayılan olarak kullanıcı binding'leri worker goroutine'lerde çalışır ve
+// sonuçları UI thread'i üzerinden JS'e iletilir; uzun süren bir çağrı
+// pencereyi dondurmaz. Bu durumda binding'ler aynı anda birden fazla
+// goroutine'den çağrılabilir ve paylaşılan durumu korumalıdır. Senkron modda
+// çağrılar sırayla, UI thread'inde çalışır (eski davranış). Native pencereye
+// doğrudan erişen birkaç yerleşik binding (ör. gomad.titlebar.drag) her iki
+// modda da UI thread'inde çalışır; gomad.update.check ve
+// gomad.update.download ise her zaman worker'larda çalışır. Varsayılan: false
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithSyncCalls(true))
+func WithSyncCalls(sync bool) Option {
+	return func(c *config) {
+		c.syncCalls = sync
+	}
+}
+
+// WithLargePayloadThreshold, JS'e loopback üzerinden gönderilecek payload
+// eşiğini bayt cinsinden ayarlar.
+//
+// Binding sonuçları ve olay verileri normalde JS kaynağına gömülerek (Eval)
+// gönderilir; birkaç MB'lık veride bu kopyalama ve ayrıştırma pahalıdır.
+// Eşiği aşan veriler 127.0.0.1 üzerindeki tek kullanımlık bir adresten sunulur
+// ve JS tarafı bunları fetch ile okur; JS API'si değişmez. Adres yalnızca
+// uygulama sayfasının origin'inden okunabilir. 0 kapatır. Varsayılan: 1 MB
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithLargePayloadThreshold(256 << 10))
+func WithLargePayloadThreshold(bytes int) Option {
+	return func(c *config) {
+		c.largePayload = bytes
+	}
+}
+
+// WithAllowedOrigins, başlangıç sayfasının origin'ine ek olarak binding
+// çağırabilecek origin'leri ayarlar.
+//
+// Varsayılan olarak yalnızca uygulamanın kendi sayfası (başlangıç URL'inin
+// origin'i ya da HTML içerik) binding'leri çağırabilir; pencere başka bir
+// siteye yönlenirse (bir link, bir yönlendirme) ya da sayfa bir iframe
+// içinde çalışıyorsa çağrılar ErrCodeForbidden ile reddedilir. Uygulama
+// güvendiği başka bir origin'e geçiyorsa (ör. OAuth dönüşü) buraya eklenir.
+// "*" her origin'e izin verir; iframe'ler yine reddedilir.
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithAllowedOrigins("https://auth.example.com"))
+func WithAllowedOrigins(origins ...string) Option {
+	return func(c *config) {
+		c.allowedOrigins = append(c.allowedOrigins, origins...)
+	}
+}
+
+// Capability, bir URL desenine uyan sayfaların çağırabileceği binding'leri ve
+// alabileceği olayları tanımlar (bkz. WithCapabilities).
+type Capability = bridge.Capability
+
+// WithCapabilities, sayfa başına binding ve olay izinlerini ayarlar.
+//
+// Pencere güvenilmeyen ya da uzak içerik gösterdiğinde (ör. WithAllowedOrigins
+// ile izin verilen bir yardım sitesi) o sayfa yalnızca kendisi için bildirilen
+// binding'leri çağırabilir; diğerleri kayıtlı değilmiş gibi "function not
+// found" hatası döner. Emit ve SendStream ile gönderilenlerden yalnızca
+// Events'e uyanlar sayfaya iletilir. Sayfa adresi origin + yoldur (sorgu ve #
+// hariç) ve desenlerde "*" her şeye uyar. İlk uyan kural geçerlidir.
+//
+// Kural verildiğinde hiçbir kurala uymayan sayfalar (uygulamanın kendi
+// sayfaları dahil) yalnızca köprünün temel yerleşiklerini çağırabilir ve olay
+// almaz. Uygulama sayfalarına tam erişim vermek için en sona açık bir kural
+// eklenir.
+//
+// Örnek:
+//
+//	app := gomad.New(
+//	    gomad.WithAllowedOrigins("https://docs.example.com"),
+//	    gomad.WithCapabilities(
+//	        gomad.Capability{
+//	            Pages:    "https://docs.example.com/*",
+//	            Bindings: []string{"search"},
+//	            Events:   []string{"theme:*"},
+//	        },
+//	        // Diğer tüm sayfalar (uygulamanın kendi arayüzü)
+//	        gomad.Capability{Pages: "*", Bindings: []string{"*"}, Events: []string{"*"}},
+//	    ),
+//	)
+func WithCapabilities(caps ...Capability) Option {
+	return func(c *config) {
+		c.capabilities = append(c.capabilities, caps...)
+	}
+}
+
+// WithMessageSigning, sayfa ile Go arasındaki mesajların imzalanmasını sağlar.
+//
+// Açıkken init scripti her mesajı oturum başında üretilen bir anahtarla
+// HMAC-SHA256 imzalar; anahtar sayfa scriptlerinin erişemeyeceği bir
+// closure'da kalır ve mesaj kanalından hiç geçmez. Go'nun cevapları da aynı
+// anahtarla imzalanır ve init scriptinde doğrulanır. XSS ile sayfaya sızan
+// içerik mesaj kanalını sarsa bile Go'ya sahte çağrı ya da bekleyen Go → JS
+// çağrılarına sahte result/error mesajı gönderemez; imzası tutmayan mesajlar
+// ErrCodeForbidden ile reddedilir. Sızan içerik window.gomad.call'ı sayfa
+// gibi çağırabilir; sayfanın erişimi WithCapabilities ile daraltılır. Mesajlar
+// şifrelenmez. Mesaj başına küçük bir hesaplama maliyeti vardır.
+// Varsayılan: false
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithMessageSigning(true))
+func WithMessageSigning(enabled bool) Option {
+	return func(c *config) {
+		c.signMessages = enabled
+	}
+}
+
+// WithAuditLog, her köprü çağrısının denetim kaydını sink'e gönderir.
+//
+// Kayıt; çağrılan metodu, çağıran sayfayı (origin + yol), süreyi ve sonucu
+// (ok, error ya da origin/imza/capability doğrulamasında reddedildiyse
+// rejected) içerir. Argümanlar varsayılan olarak kaydedilmez; bkz.
+// WithAuditArgs. Sink çağrıyı işleyen goroutine'de senkron çağrılır.
+//
+// Örnek:
+//
+//	f, _ := os.OpenFile("audit.jsonl", os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+//	app := gomad.New(gomad.WithAuditLog(gomad.NewJSONAuditSink(f)))
+func WithAuditLog(sink AuditSink) Option {
+	return func(c *config) {
+		c.auditSink = sink
+	}
+}
+
+// WithAuditArgs, çağrı argümanlarının denetim kaydına nasıl gireceğini
+// belirler. fn'in döndüğü JSON kayda yazılır; nil dönerse o çağrının
+// argümanları yazılmaz. Hassas alanları maskelemek için RedactFields
+// kullanılabilir.
+//
+// Örnek:
+//
+//	app := gomad.New(
+//	    gomad.WithAuditLog(sink),
+//	    gomad.WithAuditArgs(gomad.RedactFields("password", "cardNumber")),
+//	)
+func WithAuditArgs(fn func(method string, args json.RawMessage) json.RawMessage) Option {
+	return func(c *config) {
+		c.auditArgs = fn
+	}
+}
+
+// CallLimits, sayfadan gelen köprü çağrılarının sınırlarıdır: saniyedeki
+// çağrı sayısı, aynı anda çalışan çağrılar ve çalışmak için bekleyen
+// çağrılar. 0 olan sınır uygulanmaz.
+type CallLimits = bridge.Limits
+
+// WithCallLimits, sayfadan gelen çağrıların sınırlarını ayarlar.
+//
+// Döngüye girmiş bir frontend (ör. her render'da çağrı yapan bir effect)
+// binding'leri sınırsız goroutine'de çalıştırıp belleği tüketebilir. Sınırı
+// aşan çağrılar çalıştırılmadan ErrCodeOverloaded (-6) ile reddedilir;
+// @gomad/client bunu OverloadedError olarak verir. Yerleşik gomad.ready,
+// gomad.log ve akış çağrıları sınırlanmaz. Boş CallLimits tüm sınırları
+// kaldırır. Varsayılan: 2000 çağrı/sn, 128 eşzamanlı, 1024 bekleyen
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithCallLimits(gomad.CallLimits{
+//	    MaxCallsPerSecond: 500,
+//	    MaxConcurrent:     32,
+//	    MaxQueued:         256,
+//	}))
+func WithCallLimits(limits CallLimits) Option {
+	return func(c *config) {
+		c.callLimits = limits
+	}
+}
+
+// WithMaxMessageSize, köprüden geçen tek bir argüman listesi, sonuç ya da olay
+// verisi için bayt sınırını ayarlar.
+//
+// Yüzlerce MB'lık JSON WebView'i çökertebilir ya da sessizce kesilebilir.
+// Sınırı aşan çağrılar çalıştırılmadan (sonuç aşıyorsa JS'e gönderilmeden)
+// ErrCodePayloadTooLarge (-7) ile reddedilir; hata metodu ve boyutu söyler
+// (ör. `payload too large: result of "export" is 312.4 MB (limit 64.0 MB)`).
+// Emit, sınırı aşan olayda bridge.ErrPayloadTooLarge sarmalayan bir hata
+// döner. Büyük veriler için SendStream kullanılmalıdır. 0 sınırı kaldırır.
+// Varsayılan: 64 MB
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithMaxMessageSize(16 << 20))
+func WithMaxMessageSize(bytes int) Option {
+	return func(c *config) {
+		c.maxMessageSize = bytes
+	}
+}
+
+// WithMessageJournal, köprünün son size olayı ve worker'da biten çağrı
+// cevaplarını saklamasını sağlar. WebView yeniden yüklendiğinde (çökme
+// sonrası kurtarma, geliştirmede hot reload) yeni sayfa gomad.ready()
+// çağırınca kaçırdığı olaylar sırasıyla yeniden gönderilir; yanıtı eski
+// sayfayla kaybolan çağrıların sonuçları "gomad:recovered" olayıyla gelir.
+// Günlük bellektedir; büyük olaylar için boyut küçük tutulmalıdır.
+// Varsayılan: 0 (kapalı)
+//
+// Örnek:
+//
+//	app := gomad.New(gomad.WithMessageJournal(200))
+func WithMessageJournal(size int) Option {
+	return func(c *config) {
+		if size >= 0 {
+			c.journalSize = size
+		}
+	}
+}
+
+// WithTracer, her köprü çağrısı için span başlatan izleyiciyi ayarlar.
+//
+// Span; metodu, çağıran sayfayı ve sonucu (hata kodu) taşır. Frontend
+// @gomad/client'ın setMetaProvider'ı ile W3C trace context gönderirse
+// CallInfo.Meta'dan üst span okunabilir; böylece masaüstü uygulamanın
+// performansı servislerle aynı araçlarla (Jaeger, Tempo, Honeycomb)
+// incelenir. GOMAD OpenTelemetry'ye bağımlı değildir; OTel SDK'sı ile
+// uyarlaması birkaç satırdır:
+//
+//	type otelTracer struct{ t trace.Tracer }
+//
+//	func (o otelTracer) StartCall(c gomad.CallInfo) gomad.Span {
+//	    ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier(c.Meta))
+//	    _, span := o.t.Start(ctx, "gomad.call "+c.Method, trace.WithTimestamp(c.Start),
+//	        trace.WithAttributes(attribute.String("gomad.method", c.Method), attribute.String("gomad.page", c.Page)))
+//	    return otelSpan{span}
+//	}
+//
+//	type otelSpan struct{ s trace.Span }
+//
+//	func (o otelSpan) End(code int, message string) {
+//	    if code != 0 {
+//	        o.s.SetAttributes(attribute.Int("gomad.error_code", code))
+//	        o.s.SetStatus(codes.Error, message)
+//	    }
+//	    o.s.End()
+//	}
+//
+//	app := gomad.New(gomad.WithTracer(otelTracer{otel.Tracer("my-app")}))
+func WithTracer(t Tracer) Option {
+	return func(c *config) {
+		c.tracer = t
+	}
+}
+
+// WithMetricsEndpoint, köprü metriklerini verilen adreste HTTP ile sunar:
+//
+//	/metrics     → Prometheus metin formatı (gomad_bridge_* metrikleri)
+//	/debug/vars  → expvar; metrikler "gomad" anahtarında
+//
+// Metrikler uygulamanın iç yapısını (binding adları, kullanım sıklığı)
+// gösterdiği için adres bir loopback adresi olmalıdır; ":0" yerine
+// "127.0.0.1:0" tercih edin. Uygulama içi bir gösterge paneli için sunucu
+// gerekmez: gomad.metrics() aynı verileri döner.
+//
+//	app := gomad.New(gomad.WithMetricsEndpoint("127.0.0.1:9464"))
+func WithMetricsEndpoint(addr string) Option {
+	return func(c *config) {
+		c.metricsAddr = addr
+	}
+}
+
+// WithArgs, uygulamanın komut satırı bayraklarını bildirir. Argümanlar bu
+// şemaya göre çözülür; Go'da app.Args() ile, JS'te gomad.args() ve sticky
+// "app:args" olayıyla okunur. Böylece "dosyayı aç" ya da "--profile=work"
+// gibi başlatma seçenekleri Angular katmanına ulaşır:
+//
+//	app := gomad.New(gomad.WithArgs(
+//	    gomad.StringArg("profile", "default", "profile to open"),
+//	    gomad.BoolArg("safe-mode", false, "start without extensions"),
+//	    gomad.StringsArg("tag", "filter by tag (repeatable)"),
+//	))
+//
+//	// JS:
+//	// gomad.on("app:args", ({ flags, positional }) => {
+//	//     profile.select(flags.profile);
+//	//     positional.forEach((path) => editor.open(path));
+//	// });
+//
+// Bayrak olmayan argümanlar (dosya yolları) şema olmadan da positional
+// olarak iletilir.
+func WithArgs(args ...Arg) Option {
+	return func(c *config) {
+		c.args = append(c.args, args...)
+	}
+}
+
+// WithProfilePicker, --profile verilmeden başlatıldığında profili seçen
+// kancayı ayarlar. Paylaşılan makinelerde her açılışta kimin kullandığını
+// sormak için kullanılır; kanca verilmezse son kullanılan profil açılır.
+//
+//	app := gomad.New(gomad.WithProfilePicker(func(profiles []string, last string) (string, error) {
+//	    return pickProfileDialog(profiles, last) // ör. native bir liste penceresi
+//	}))
+func WithProfilePicker(picker ProfilePicker) Option {
+	return func(c *config) {
+		c.profilePicker = picker
+	}
+}
+
+// WithRestoreSession, kapanışta açık pencereleri (rota, sınırlar ve monitör)
+// kaydeder ve sonraki açılışta aynı pencereleri aynı yerde yeniden açar.
+// İkincil pencereler kaydedildikleri adlarla geri gelir; açıldıklarında
+// "window:opened" olayı gönderilir ve app.Window(ad) ile bulunabilirler.
+//
+//	app := gomad.New(
+//	    gomad.WithAssets(dist),
+//	    gomad.WithRestoreSession(),
+//	)
+//
+// Oturum profil dizininde tutulur; her profil kendi pencerelerini hatırlar.
+func WithRestoreSession() Option {
+	return func(c *config) {
+		c.restoreSession = true
+	}
+}
+
+// WithContext, uygulamanın kök context'ini (bkz. Application.Context) ctx'ten
+// türetir. ctx iptal edildiğinde uygulama kapanır; sinyallerle kapanmak ya da
+// uygulamayı daha büyük bir sürecin ömrüne bağlamak için kullanılır.
+//
+//	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+//	defer stop()
+//	app := gomad.New(gomad.WithContext(ctx))
+func WithContext(ctx context.Context) Option {
+	return func(c *config) {
+		c.context = ctx
+	}
+}
+
+// WithShutdownTimeout, kapanışta beklenecek en uzun süreyi ayarlar
+// (varsayılan 5 saniye). Pencere kapatıldığında ya da Quit çağrıldığında
+// sayfalara "app:will-quit" gönderilir, köprü yeni çağrıları reddeder
+// (ErrCodeShuttingDown) ve çalışan handler'lar ile kuyruktaki olaylar
+// beklenir; süre dolunca kök context iptal edilir ve uygulama kapanır.
+// 0, beklemeden kapanır.
+//
+//	app := gomad.New(gomad.WithShutdownTimeout(10 * time.Second))
+//
+//	// JS
+//	gomad.onWillQuit(async () => await editor.saveDraft());
+func WithShutdownTimeout(d time.Duration) Option {
+	return func(c *config) {
+		c.shutdownTimeout = d
+	}
+}
+
+// WithFrontendEnv, verilen değerleri sayfaya dondurulmuş window.gomad.env
+// nesnesi olarak açar. Nesne sayfa scriptlerinden önce tanımlanır; sürüm,
+// derleme kanalı ya da API adresi gibi statik yapılandırma için getVersion
+// benzeri binding'lere gerek kalmaz. Birden fazla kez verilirse değerler
+// birleştirilir; aynı anahtarda sonraki değer geçerlidir.
+//
+//	app := gomad.New(gomad.WithFrontendEnv(map[string]string{
+//	    "VERSION": gomad.Build().Version,
+//	    "CHANNEL": "beta",
+//	    "API_URL": "https://api.example.com",
+//	}))
+//
+//	// JS
+//	fetch(`${gomad.env.API_URL}/me`);
+//
+// Değerler sayfanın kaynağında görünür; gizli anahtarlar için kullanılmamalıdır.
+func WithFrontendEnv(env map[string]string) Option {
+	return func(c *config) {
+		if c.frontendEnv == nil {
+			c.frontendEnv = make(map[string]string, len(env))
+		}
+		for k, v := range env {
+			c.frontendEnv[k] = v
+		}
+	}
+}
+
+// WithSerialPermission, sayfa bir seri portu açmak istediğinde (bkz.
+// gomad.serial.open) varsayılan soru kutusu yerine fn'in kararını kullanır.
+// fn, bridge goroutine'inde port adıyla çağrılır; true dönerse izin çalışma
+// boyunca hatırlanır. Varsayılan olarak kullanıcıya native bir Evet/Hayır
+// kutusu gösterilir; headless modda izin verilmez.
+//
+//	app := gomad.New(gomad.WithSerialPermission(func(port string) bool {
+//	    return strings.HasPrefix(port, "/dev/ttyUSB") // Yalnızca USB dönüştürücüler
+//	}))
+func WithSerialPermission(fn func(port string) bool) Option {
+	return func(c *config) {
+		c.serialPermission = fn
+	}
+}
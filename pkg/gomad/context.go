@@ -0,0 +1,50 @@
+package gomad
+
+import "context"
+
+// ============================================================================
+// Uygulama context'i
+// Uygulamanın tek bir kök context'i vardır; ilk parametresi context.Context
+// olan binding'lere, görevlere (bkz. StartTask), soketlere ve arka plan
+// döngülerine (bayrak yenileme, güncelleme kontrolü, telemetri) bu context'ten
+// türetilen context'ler verilir. Quit çağrıldığında ya da pencere kapanıp Run
+// döndüğünde iptal edilir; böylece uygulamadaki her arka plan işi tek bir
+// mekanizmayla durur.
+//
+//	app.Bind("search", func(ctx context.Context, q string) ([]Hit, error) {
+//	    return index.Search(ctx, q) // Uygulama kapanırken iptal edilir
+//	})
+//
+//	go func() {
+//	    <-app.Context().Done()
+//	    db.Close()
+//	}()
+//
+// WithContext ile verilen üst context iptal edilirse (ör. signal.NotifyContext
+// ile Ctrl+C) uygulama da kapanır.
+// ============================================================================
+
+// Context, uygulamanın kök context'ini döner. New ile oluşturulur; Run'dan
+// önce de kullanılabilir. Quit'te ve Run dönerken iptal edilir.
+func (a *Application) Context() context.Context {
+	return a.ctx
+}
+
+// watchContext, kök context dışarıdan (WithContext'in üst context'i)
+// iptal edildiğinde uygulamayı kapatır. Dönen fonksiyon izlemeyi durdurur.
+func (a *Application) watchContext() (stop func()) {
+	done, exited := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(exited)
+		select {
+		case <-a.ctx.Done():
+			a.Logger().Info("application context canceled", "cause", context.Cause(a.ctx))
+			a.Quit()
+		case <-done:
+		}
+	}()
+	return func() {
+		close(done)
+		<-exited
+	}
+}
@@ -0,0 +1,130 @@
+package gomad
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/pkg/dialog"
+)
+
+// crashRestartEnv, çökme sonrası yeniden başlatılan sürece verilen ortam
+// değişkenidir. Yeni süreç de çökerse sonsuz yeniden başlatma döngüsü oluşmaz.
+const crashRestartEnv = "GOMAD_CRASH_RESTARTED"
+
+// CrashReport, yakalanan bir panic'in raporudur.
+type CrashReport struct {
+	// AppID, çöken uygulamanın kimliğidir.
+	AppID string
+
+	// Time, çökmenin gerçekleştiği andır.
+	Time time.Time
+
+	// Panic, panic(...) ile fırlatılan değerin metin hâlidir.
+	Panic string
+
+	// Stack, panic anındaki goroutine stack trace'idir.
+	Stack string
+
+	// File, raporun yazıldığı dosyadır. Yazılamadıysa boştur.
+	File string
+}
+
+// String, raporu kullanıcıya gösterilebilecek ve kopyalanabilecek formatta döner.
+func (r CrashReport) String() string {
+	return fmt.Sprintf("App: %s\nTime: %s\nOS: %s/%s\nGo: %s\n\n%s\n\n%s",
+		r.AppID, r.Time.Format(time.RFC3339), runtime.GOOS, runtime.GOARCH,
+		runtime.Version(), r.Panic, r.Stack)
+}
+
+// Recover, panic'i yakalayıp uygulama çapında çökme işleyicisine iletir.
+// Kullanıcının başlattığı goroutine'lerde defer ile kullanılır:
+//
+//	go func() {
+//	    defer app.Recover()
+//	    syncWorker()
+//	}()
+//
+// Panic yakalandığında rapor Logs dizinine yazılır, native "uygulama çöktü"
+// dialogu gösterilir ve (WithRestartOnCrash ile) uygulama yeniden başlatılır.
+// Süreç ardından sonlanır; Recover geri dönmez.
+//
+// Run zaten kendi içinde Recover kullanır.
+func (a *Application) Recover() {
+	if rec := recover(); rec != nil {
+		a.crash(gomerrors.NewPanicError(rec, debug.Stack()))
+	}
+}
+
+// Go, fn'i Recover ile korunan yeni bir goroutine'de çalıştırır.
+func (a *Application) Go(fn func()) {
+	go func() {
+		defer a.Recover()
+		fn()
+	}()
+}
+
+// crash, ölümcül bir panic'i işler ve süreci sonlandırır.
+func (a *Application) crash(perr *gomerrors.PanicError) {
+	// Çökme işleyicisinin kendisi panic ederse tekrar girilmesin
+	a.crashOnce.Do(func() {
+		report := a.writeCrashReport(perr)
+
+		a.Logger().Error("application crashed",
+			"panic", report.Panic,
+			"report", report.File,
+			"stack", report.Stack)
+
+		if a.config.crashDialog {
+			text := "The application has crashed and needs to close.\n\n"
+			if report.File != "" {
+				text += "A crash report was saved to:\n" + report.File + "\n\n"
+			}
+			text += "Press Ctrl+C to copy the report below.\n\n" + report.String()
+			title := a.config.title + " crashed"
+			if _, err := dialog.Message(dialog.KindError, title, text, dialog.ButtonsOK); err != nil {
+				// Native kutu yoksa en azından terminalde görünsün
+				fmt.Fprintf(os.Stderr, "%s\n\n%s\n", title, text)
+			}
+		}
+
+		if a.config.restartOnCrash && os.Getenv(crashRestartEnv) == "" {
+			_ = os.Setenv(crashRestartEnv, "1")
+			if exe, err := os.Executable(); err == nil {
+				a.relaunch = &relaunchRequest{exe: exe, args: os.Args[1:]}
+				_ = a.spawnRelaunch()
+			}
+		}
+
+		os.Exit(2)
+	})
+}
+
+// writeCrashReport, raporu Logs dizinine yazar.
+// Dizin oluşturulamazsa rapor yine döner; yalnızca File alanı boş kalır.
+func (a *Application) writeCrashReport(perr *gomerrors.PanicError) CrashReport {
+	report := CrashReport{
+		AppID: a.config.appID,
+		Time:  time.Now(),
+		Panic: perr.Error(),
+		Stack: string(perr.Stack),
+	}
+
+	dir, err := a.Paths().Logs()
+	if err != nil {
+		return report
+	}
+
+	name := fmt.Sprintf("crash-%s.log", report.Time.Format("20060102-150405"))
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(report.String()), 0o600); err != nil {
+		return report
+	}
+
+	report.File = path
+	return report
+}
@@ -0,0 +1,63 @@
+package gomad
+
+import (
+	"bufio"
+	"os"
+	"strings"
+)
+
+// ============================================================================
+// GELİŞTİRME MODU
+// "gomad dev" uygulamayı aşağıdaki ortam değişkenleriyle başlatır:
+//
+//	GOMAD_DEV_URL     → WebView bu adresi (frontend dev sunucusu) açar,
+//	                    geliştirici araçları etkinleşir
+//	GOMAD_DEV_CONTROL → "1" ise komutlar stdin'den satır satır okunur:
+//	                    "reload" sayfayı yeniler, "quit" uygulamayı kapatır
+//	GOMAD_DEV_TYPES   → binding tanımlarının (.d.ts) sunulduğu adres (bkz. types.go)
+//	GOMAD_HOT_SHELL,  → "gomad dev -hot" ile pencere ve backend ayrı süreçlerde
+//	GOMAD_HOT_BACKEND   çalışır (bkz. hotswap.go)
+//
+// Stdin kanalı, CLI'nın Go kaynakları değişince uygulamayı düzgünce
+// kapatabilmesi ve sayfayı port açmadan yenileyebilmesi içindir.
+// ============================================================================
+
+const (
+	devURLEnv     = "GOMAD_DEV_URL"
+	devControlEnv = "GOMAD_DEV_CONTROL"
+)
+
+// IsDev, uygulama "gomad dev" altında çalışıyorsa true döner.
+func IsDev() bool {
+	return os.Getenv(devURLEnv) != ""
+}
+
+// applyDevOverrides, dev modunda URL ve debug ayarlarını geçersiz kılar.
+func applyDevOverrides(cfg *config) {
+	if url := os.Getenv(devURLEnv); url != "" {
+		cfg.url = url
+		cfg.html = ""
+		cfg.debug = true
+	}
+}
+
+// startDevControl, dev modunda stdin'den CLI komutlarını okur.
+func (a *Application) startDevControl() {
+	if os.Getenv(devControlEnv) != "1" {
+		return
+	}
+	go func() {
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			switch strings.TrimSpace(scanner.Text()) {
+			case "reload":
+				a.Logger().Debug("dev: reloading page")
+				a.ReloadIgnoringCache()
+			case "quit":
+				a.Logger().Debug("dev: quit requested")
+				a.Quit()
+				return
+			}
+		}
+	}()
+}
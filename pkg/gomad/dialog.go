@@ -0,0 +1,46 @@
+package gomad
+
+import (
+	"github.com/biyonik/gomad/pkg/dialog"
+)
+
+// ownerHandle, diyalogların sahibi olacak ana pencere tutamacını döner.
+// Uygulama çalışmıyorsa 0 döner (sahipsiz diyalog).
+func (a *Application) ownerHandle() uintptr {
+	if wv := a.view(); wv != nil {
+		return wv.Window()
+	}
+	return 0
+}
+
+// dialogModule, native diyalogların JS API'sidir (window.gomad.dialog).
+//
+//	const path = await gomad.dialog.openFile({ title: "Aç", filters: [{ name: "Metin", patterns: ["*.txt"] }] });
+//	const paths = await gomad.dialog.openFiles({ defaultPath: "C:\\Users" });
+//	const dir = await gomad.dialog.openFolder({});
+//	const target = await gomad.dialog.saveFile({ defaultPath: "rapor.pdf" });
+//
+//	const btn = await gomad.dialog.message({ kind: "question", title: "Sil", text: "Emin misiniz?", buttons: "yesNo" });
+//	if (btn === "yes") { ... }
+//
+// İptal edilirse boş string (openFiles için null) döner.
+// Diyaloglar ana pencerenin üzerinde modal açılır.
+func (a *Application) dialogModule() builtinModule {
+	withOwner := func(opts dialog.Options) dialog.Options {
+		opts.Owner = a.ownerHandle()
+		return opts
+	}
+	return builtinModule{
+		namespace: "dialog",
+		methods: map[string]interface{}{
+			"openFile":   func(opts dialog.Options) (string, error) { return dialog.OpenFile(withOwner(opts)) },
+			"openFiles":  func(opts dialog.Options) ([]string, error) { return dialog.OpenFiles(withOwner(opts)) },
+			"openFolder": func(opts dialog.Options) (string, error) { return dialog.OpenFolder(withOwner(opts)) },
+			"saveFile":   func(opts dialog.Options) (string, error) { return dialog.SaveFile(withOwner(opts)) },
+			"message": func(opts dialog.MessageOptions) (dialog.Button, error) {
+				opts.Owner = a.ownerHandle()
+				return dialog.ShowMessage(opts)
+			},
+		},
+	}
+}
@@ -0,0 +1,157 @@
+package gomad
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/biyonik/gomad/internal/bridge"
+	"github.com/biyonik/gomad/internal/driver"
+	"github.com/biyonik/gomad/internal/webview"
+)
+
+// driverNamespace, test sürücüsünün yerleşik modül adıdır. Binding'leri
+// yalnızca uygulama sürücü altında başlatıldığında kaydedilir.
+const driverNamespace = "driver"
+
+// driverEnabled, uygulamanın uçtan uca test sürücüsü (pkg/gomadtest)
+// tarafından başlatılıp başlatılmadığını döner.
+func driverEnabled() bool {
+	return os.Getenv(driver.AddrEnv) != ""
+}
+
+// driverConn, sürücü bağlantısıdır.
+type driverConn struct {
+	conn net.Conn
+	enc  *json.Encoder
+	mu   sync.Mutex
+}
+
+// send, sürücüye bir mesaj yazar.
+func (d *driverConn) send(msg driver.Message) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_ = d.enc.Encode(msg)
+}
+
+// startDriver, sürücüye bağlanır; eval komutlarını sayfada çalıştırır ve
+// köprü trafiğini sürücüye aktarır. Sürücü yoksa etkisizdir.
+func (a *Application) startDriver(wv webview.View) (stop func()) {
+	addr := os.Getenv(driver.AddrEnv)
+	if addr == "" {
+		return func() {}
+	}
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		a.Logger().Warn("failed to connect to test driver", "addr", addr, "error", err)
+		return func() {}
+	}
+
+	d := &driverConn{conn: conn, enc: json.NewEncoder(conn)}
+	a.driverMu.Lock()
+	a.driver = d
+	a.driverMu.Unlock()
+	d.send(driver.Message{Type: driver.TypeHello, Token: os.Getenv(driver.TokenEnv)})
+
+	// Sürücünün kendi çağrıları trafiğe karışmasın
+	var internal sync.Map
+	cancelObserve := wv.Bridge().Observe(func(t bridge.Traffic) {
+		msg := t.Message
+		if strings.HasPrefix(msg.Method, builtinPrefix+driverNamespace+".") {
+			internal.Store(msg.ID, true)
+			return
+		}
+		if msg.Method == "" && msg.ID != "" {
+			if _, ok := internal.LoadAndDelete(msg.ID); ok {
+				return
+			}
+		}
+		d.send(driver.Message{Type: driver.TypeTraffic, Traffic: &t})
+	})
+
+	go func() {
+		scanner := bufio.NewScanner(conn)
+		scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+		for scanner.Scan() {
+			var msg driver.Message
+			if err := json.Unmarshal(scanner.Bytes(), &msg); err != nil {
+				a.Logger().Warn("invalid test driver message", "error", err)
+				continue
+			}
+			switch msg.Type {
+			case driver.TypeEval:
+				_ = a.Eval(driverScript(msg.ID, msg.JS))
+			case driver.TypeQuit:
+				a.Quit()
+			}
+		}
+	}()
+
+	a.Logger().Info("connected to test driver", "addr", addr)
+	return func() {
+		cancelObserve()
+		a.driverMu.Lock()
+		a.driver = nil
+		a.driverMu.Unlock()
+		conn.Close()
+	}
+}
+
+// driverScript, sürücünün gönderdiği fonksiyon gövdesini çalıştırıp sonucu
+// gomad.driver.result ile geri bildiren JS'i üretir.
+func driverScript(id int64, body string) string {
+	idJSON, _ := json.Marshal(id)
+	return `(async () => {
+    let value = null, error = "";
+    try {
+        value = await (async () => {
+` + body + `
+        })();
+    } catch (e) {
+        error = String((e && e.message) || e);
+    }
+    if (value === undefined) value = null;
+    try {
+        await window.gomad.driver.result(` + string(idJSON) + `, value, error);
+    } catch (e) {
+        await window.gomad.driver.result(` + string(idJSON) + `, null, "result is not JSON-serializable: " + e.message);
+    }
+})();`
+}
+
+// driverModule, sürücünün sayfa tarafı API'sidir; yalnızca sürücü altında
+// kaydedilir. Sayfa her yüklendiğinde sürücüye "loaded" bildirilir.
+func (a *Application) driverModule() builtinModule {
+	send := func(msg driver.Message) {
+		a.driverMu.Lock()
+		d := a.driver
+		a.driverMu.Unlock()
+		if d != nil {
+			d.send(msg)
+		}
+	}
+	return builtinModule{
+		namespace: driverNamespace,
+		methods: map[string]interface{}{
+			"result": func(id int64, value json.RawMessage, errMsg string) {
+				send(driver.Message{Type: driver.TypeResult, ID: id, Value: value, Error: errMsg})
+			},
+			"loaded": func(url string) {
+				send(driver.Message{Type: driver.TypeLoaded, URL: url})
+			},
+		},
+		init: `
+(function() {
+    const notify = () => window.gomad.driver.loaded(location.href);
+    if (document.readyState === 'loading') {
+        document.addEventListener('DOMContentLoaded', notify);
+    } else {
+        notify();
+    }
+})();
+`,
+	}
+}
@@ -0,0 +1,20 @@
+package gomad
+
+import "github.com/biyonik/gomad/internal/bridge"
+
+// JSONEncoding, binding sonuçlarının ve olayların JSON'a çevrilme
+// politikasıdır (bkz. WithJSONEncoding). Sıfır değeri encoding/json'un
+// davranışıdır.
+type JSONEncoding = bridge.JSONEncoding
+
+// CamelCase, Go alan adlarını camelCase'e çevirir (UserID → userID,
+// URLPath → urlPath); JSONEncoding.FieldNames için.
+func CamelCase(name string) string {
+	return bridge.CamelCase(name)
+}
+
+// SnakeCase, Go alan adlarını snake_case'e çevirir (UserID → user_id);
+// JSONEncoding.FieldNames için.
+func SnakeCase(name string) string {
+	return bridge.SnakeCase(name)
+}
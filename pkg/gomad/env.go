@@ -0,0 +1,29 @@
+package gomad
+
+import "encoding/json"
+
+// ============================================================================
+// Frontend ortam değerleri
+// WithFrontendEnv ile verilen değerler sayfaya window.gomad.env olarak,
+// dondurulmuş (Object.freeze) bir nesne hâlinde açılır. Nesne builtin
+// scriptlerle birlikte, sayfa scriptlerinden önce tanımlanır; sürüm, kanal ya
+// da API adresi gibi statik yapılandırma için köprü çağrısı ve await gerekmez:
+//
+//	fetch(`${gomad.env.API_URL}/me`);
+//	if (gomad.env.CHANNEL === "beta") showBetaBadge();
+//
+// Değerler sayfanın kaynağında görünür; gizli anahtarlar buraya konmamalıdır.
+// ============================================================================
+
+// envModule, ortam değerlerinin JS tarafıdır (window.gomad.env). Değer
+// verilmemişse nesne boştur.
+func (a *Application) envModule() builtinModule {
+	env := a.config.frontendEnv
+	if env == nil {
+		env = map[string]string{}
+	}
+	initial, _ := json.Marshal(env)
+	return builtinModule{
+		init: "(function() { window.gomad.env = Object.freeze(" + string(initial) + "); })();\n",
+	}
+}
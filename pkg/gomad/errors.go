@@ -0,0 +1,98 @@
+package gomad
+
+import (
+	"github.com/biyonik/gomad/internal/bridge"
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// ErrorCode, uygulamanın kaydettiği adlandırılmış bir hata kodudur. Binding'ler
+// döndürdüğünde JS tarafına bu kodla ulaşır; @gomad/client her kod için
+// "<Name>Error" sınıfı sağlar (bkz. errorClass) ve "gomad types" kodları
+// tanım dosyasına yazar.
+//
+// ErrorCode bir error'dur: doğrudan döndürülebilir, New ile özel mesajla ya
+// da Wrap ile bir alt hatayı sararak kullanılabilir.
+type ErrorCode = gomerrors.Code
+
+// CodedError, ErrorCode taşıyan hatadır; errors.Is(err, code) ile eşleşir.
+type CodedError = gomerrors.CodedError
+
+// NewErrorCode, yeni bir hata kodu kaydeder. value pozitif ve benzersiz,
+// name büyük harfle başlayan benzersiz bir tanımlayıcı olmalıdır; aksi
+// halde panic eder. Kodlar paket seviyesinde tanımlanmalıdır.
+//
+// Örnek:
+//
+//	var ErrQuotaExceeded = gomad.NewErrorCode(1001, "QuotaExceeded", "storage quota exceeded")
+//
+//	app.Bind("upload", func(f File) (string, error) {
+//		if over := quota.Over(f); over > 0 {
+//			return "", ErrQuotaExceeded.New("%d MB over quota", over)
+//		}
+//		...
+//	})
+//
+//	// JS:
+//	// import { errorClass } from '@gomad/client';
+//	// catch (e) { if (e instanceof errorClass('QuotaExceeded')) showUpgrade(); }
+func NewErrorCode(value int, name, description string) *ErrorCode {
+	return gomerrors.NewCode(value, name, description)
+}
+
+// ErrorCodes, kayıtlı tüm hata kodlarını değere göre sıralı döner.
+func ErrorCodes() []*ErrorCode {
+	return gomerrors.Codes()
+}
+
+// RegisterSentinel, err'i verilen adla sentinel olarak kaydeder. Binding'in
+// döndürdüğü hata zinciri errors.Is ile bu sentinel'le eşleşirse JS
+// tarafındaki hatada adıyla görünür (GomadError.is). Yaygın standart
+// kütüphane sentinel'leri ("fs.ErrNotExist", "io.EOF", "context.Canceled"…)
+// zaten kayıtlıdır.
+//
+// Örnek:
+//
+//	var ErrLocked = errors.New("document is locked")
+//
+//	func init() { gomad.RegisterSentinel("docs.ErrLocked", ErrLocked) }
+//
+//	// JS: catch (e) { if (e.is('docs.ErrLocked')) showReadOnly(); }
+func RegisterSentinel(name string, err error) {
+	gomerrors.RegisterSentinel(name, err)
+}
+
+// ErrInvalidArgument, binding'in argümanları geçersiz olduğunda sarılacak
+// hatadır; JS'e ErrCodeInvalidArgs (InvalidArgumentsError) olarak ulaşır.
+//
+//	return nil, fmt.Errorf("%w: page must be positive", gomad.ErrInvalidArgument)
+var ErrInvalidArgument = gomerrors.ErrInvalidArgument
+
+// ErrorPolicy, binding hatalarının ne kadar ayrıntıyla JS'e taşınacağıdır
+// (bkz. WithErrorPolicy).
+type ErrorPolicy = bridge.ErrorPolicy
+
+const (
+	// ErrorPolicyDetailed → Hata mesajı ve yapısal hata zinciri.
+	ErrorPolicyDetailed = bridge.ErrorPolicyDetailed
+	// ErrorPolicyDebug → Detailed + panic yığınları.
+	ErrorPolicyDebug = bridge.ErrorPolicyDebug
+	// ErrorPolicyGeneric → İç hatalar "internal error (ref …)" olarak gider;
+	// asıl hata aynı ref ile loglanır. Kayıtlı hata kodlarının mesajları korunur.
+	ErrorPolicyGeneric = bridge.ErrorPolicyGeneric
+)
+
+// errorPolicy, uygulanacak politikayı döner: WithErrorPolicy verilmediyse
+// debug modunda Debug, "gomad build" ile derlenmiş sürümlerde Generic,
+// diğer durumlarda (go run, go build) Detailed.
+func (a *Application) errorPolicy() ErrorPolicy {
+	switch {
+	case a.config.errorPolicy != nil:
+		return *a.config.errorPolicy
+	case a.config.debug:
+		return ErrorPolicyDebug
+	case buildVersion != "":
+		return ErrorPolicyGeneric
+	default:
+		return ErrorPolicyDetailed
+	}
+}
@@ -0,0 +1,365 @@
+package gomad
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// ============================================================================
+// UI EKLENTİLERİ
+// Üçüncü taraf arayüz eklentileri uygulama sayfasına gömülmez; her eklenti
+// kendi loopback origin'inden sunulur ve sandbox'lı bir iframe'de
+// (sandbox="allow-scripts", opak origin) çalışır. Eklenti sayfası uygulamanın
+// DOM'una, depolamasına ve köprüsüne erişemez: köprü oturum token'ını yalnızca
+// en üst çerçeveye verir (bkz. Bridge.EnableOriginCheck).
+//
+// Eklenti gomad API'sinin kısıtlı bir kopyasını /__gomad/extension.js ile
+// alır. Çağrılar ve olay abonelikleri postMessage ile uygulama sayfasına
+// gider; sayfadaki proxy yalnızca eklentinin manifest'inde izin verilen
+// binding'leri çağırır ve olayları iletir.
+//
+// extension.json:
+//
+//	{
+//	    "id": "word-count",
+//	    "name": "Word Count",
+//	    "version": "1.2.0",
+//	    "entry": "index.html",
+//	    "permissions": {
+//	        "bindings": ["notes.current", "notes.stats"],
+//	        "events": ["notes:changed"]
+//	    }
+//	}
+// ============================================================================
+
+const (
+	// extensionManifestFile, eklenti kökündeki manifest dosyasıdır.
+	extensionManifestFile = "extension.json"
+	// extensionScriptPath, eklenti sayfalarının yüklediği istemci betiğidir.
+	extensionScriptPath = "/__gomad/extension.js"
+)
+
+// ExtensionPermissions, eklentinin erişebileceği binding ve olay
+// desenleridir. Desenlerde "*" herhangi bir karakter dizisine uyar;
+// boş listeler hiçbir şeye izin vermez.
+type ExtensionPermissions struct {
+	Bindings []string `json:"bindings,omitempty"`
+	Events   []string `json:"events,omitempty"`
+}
+
+// ExtensionManifest, eklentinin extension.json dosyasıdır.
+type ExtensionManifest struct {
+	ID          string               `json:"id"`
+	Name        string               `json:"name"`
+	Version     string               `json:"version,omitempty"`
+	Entry       string               `json:"entry,omitempty"`
+	Permissions ExtensionPermissions `json:"permissions"`
+}
+
+// ExtensionInfo, yüklü bir eklentinin JS'e açılan bilgisidir.
+type ExtensionInfo struct {
+	ExtensionManifest
+	// URL, eklenti giriş sayfasının adresidir.
+	URL string `json:"url"`
+}
+
+// extension, yüklü bir eklentidir.
+type extension struct {
+	info   ExtensionInfo
+	server *http.Server
+}
+
+// LoadExtension, fsys kökünde extension.json bulunan bir UI eklentisini
+// yükler ve kendi origin'inden sunmaya başlar. Eklenti sayfada
+// gomad.extensions.mount(id, element) ile gösterilir:
+//
+//	ext, err := app.LoadExtension(os.DirFS("extensions/word-count"))
+//
+//	// JS:
+//	// const unmount = await gomad.extensions.mount("word-count", document.querySelector("#sidebar"));
+//
+// Manifest izinleri eklentinin tek yetkisidir; izin listesinde olmayan
+// binding'ler eklentiye "bulunamadı" olarak görünür. Yerleşik gomad.*
+// binding'leri de ancak açıkça izin verilirse çağrılabilir.
+func (a *Application) LoadExtension(fsys fs.FS) (ExtensionInfo, error) {
+	data, err := fs.ReadFile(fsys, extensionManifestFile)
+	if err != nil {
+		return ExtensionInfo{}, gomerrors.NewOperationError("extension.load", "missing "+extensionManifestFile, gomerrors.ErrNotFound)
+	}
+	var manifest ExtensionManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return ExtensionInfo{}, gomerrors.NewOperationError("extension.load", "invalid "+extensionManifestFile+": "+err.Error(), gomerrors.ErrInvalidArgument)
+	}
+	if manifest.ID == "" || !isPluginName(manifest.ID) {
+		return ExtensionInfo{}, gomerrors.NewOperationError("extension.load", fmt.Sprintf("invalid extension id %q", manifest.ID), gomerrors.ErrInvalidArgument)
+	}
+	if manifest.Entry == "" {
+		manifest.Entry = "index.html"
+	}
+	if _, err := fs.Stat(fsys, manifest.Entry); err != nil {
+		return ExtensionInfo{}, gomerrors.NewOperationError("extension.load", manifest.ID+": entry "+manifest.Entry+" not found", gomerrors.ErrNotFound)
+	}
+
+	a.extensionMu.Lock()
+	if _, exists := a.extensions[manifest.ID]; exists {
+		a.extensionMu.Unlock()
+		return ExtensionInfo{}, gomerrors.NewOperationError("extension.load", manifest.ID, gomerrors.ErrAlreadyExists)
+	}
+
+	// Her eklenti ayrı porttan sunulur; origin'ler ve depolamaları ayrışır
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		a.extensionMu.Unlock()
+		return ExtensionInfo{}, err
+	}
+	server := &http.Server{Handler: extensionHandler(fsys)}
+	go server.Serve(ln)
+
+	ext := &extension{
+		info:   ExtensionInfo{ExtensionManifest: manifest, URL: "http://" + ln.Addr().String() + "/" + strings.TrimPrefix(manifest.Entry, "/")},
+		server: server,
+	}
+	if a.extensions == nil {
+		a.extensions = map[string]*extension{}
+	}
+	a.extensions[manifest.ID] = ext
+	list := a.extensionList()
+	a.extensionMu.Unlock()
+
+	a.Logger().Info("extension loaded", "extension", manifest.ID, "version", manifest.Version,
+		"bindings", manifest.Permissions.Bindings, "events", manifest.Permissions.Events)
+	_ = a.Broadcast("extensions:changed", list)
+	return ext.info, nil
+}
+
+// UnloadExtension, eklentiyi kaldırır. Sayfadaki iframe'leri kaldırılır ve
+// sunucusu kapanır.
+func (a *Application) UnloadExtension(id string) error {
+	a.extensionMu.Lock()
+	ext, ok := a.extensions[id]
+	delete(a.extensions, id)
+	list := a.extensionList()
+	a.extensionMu.Unlock()
+	if !ok {
+		return gomerrors.NewOperationError("extension.unload", id, gomerrors.ErrNotFound)
+	}
+	ext.server.Close()
+	_ = a.Broadcast("extensions:changed", list)
+	return nil
+}
+
+// Extensions, yüklü eklentileri id'ye göre sıralı döner.
+func (a *Application) Extensions() []ExtensionInfo {
+	a.extensionMu.Lock()
+	defer a.extensionMu.Unlock()
+	return a.extensionList()
+}
+
+// extensionList, extensionMu tutulurken eklenti listesini döner.
+func (a *Application) extensionList() []ExtensionInfo {
+	list := make([]ExtensionInfo, 0, len(a.extensions))
+	for _, ext := range a.extensions {
+		list = append(list, ext.info)
+	}
+	slices.SortFunc(list, func(x, y ExtensionInfo) int { return strings.Compare(x.ID, y.ID) })
+	return list
+}
+
+// stopExtensions, eklenti sunucularını kapatır.
+func (a *Application) stopExtensions() {
+	a.extensionMu.Lock()
+	defer a.extensionMu.Unlock()
+	for id, ext := range a.extensions {
+		ext.server.Close()
+		delete(a.extensions, id)
+	}
+}
+
+// extensionHandler, eklenti dosyalarını ve istemci betiğini sunar. Yanıtlar
+// CSP sandbox'ı taşır; sayfa iframe dışında doğrudan açılsa bile opak
+// origin'de çalışır.
+func extensionHandler(fsys fs.FS) http.Handler {
+	files := http.FileServer(http.FS(fsys))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Security-Policy", "sandbox allow-scripts")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		switch {
+		case r.URL.Path == extensionScriptPath:
+			w.Header().Set("Content-Type", "text/javascript; charset=utf-8")
+			_, _ = w.Write([]byte(extensionClientJS))
+		case r.URL.Path == "/"+extensionManifestFile:
+			http.NotFound(w, r)
+		default:
+			files.ServeHTTP(w, r)
+		}
+	})
+}
+
+// extensionsModule, eklentilerin uygulama sayfasındaki JS API'sidir
+// (window.gomad.extensions).
+//
+//	const list = await gomad.extensions.list();
+//	const unmount = await gomad.extensions.mount("word-count", sidebar);
+func (a *Application) extensionsModule() builtinModule {
+	return builtinModule{
+		namespace: "extensions",
+		methods: map[string]interface{}{
+			"list": func() ([]ExtensionInfo, error) {
+				return a.Extensions(), nil
+			},
+			"get": func(id string) (ExtensionInfo, error) {
+				a.extensionMu.Lock()
+				defer a.extensionMu.Unlock()
+				ext, ok := a.extensions[id]
+				if !ok {
+					return ExtensionInfo{}, gomerrors.NewOperationError("extension.get", id, gomerrors.ErrNotFound)
+				}
+				return ext.info, nil
+			},
+		},
+		init: extensionsHostJS,
+	}
+}
+
+// extensionsHostJS, uygulama sayfasında eklenti iframe'lerini kurar ve
+// postMessage çağrılarını manifest izinlerine göre köprüye aktarır.
+// İzin verilmeyen çağrılar kayıtlı olmayan bir binding ile aynı hatayı alır;
+// eklenti binding'in varlığını öğrenemez.
+const extensionsHostJS = `
+(function() {
+    const api = window.gomad.extensions;
+    const frames = new Map(); // contentWindow → { info, frame, subs }
+    const escape = (s) => s.replace(/[.+?^${}()|[\]\\]/g, '\\$&');
+    const allowed = (patterns, s) =>
+        (patterns || []).some((p) => new RegExp('^' + p.split('*').map(escape).join('.*') + '$').test(s));
+
+    const unmount = (entry) => {
+        for (const off of entry.subs.values()) off();
+        entry.subs.clear();
+        frames.delete(entry.frame.contentWindow);
+        entry.frame.remove();
+    };
+
+    api.mount = async (id, container) => {
+        const info = await api.get(id);
+        const frame = document.createElement('iframe');
+        frame.setAttribute('sandbox', 'allow-scripts');
+        frame.setAttribute('title', info.name || info.id);
+        frame.dataset.gomadExtension = info.id;
+        frame.style.border = '0';
+        frame.src = info.url;
+        container.appendChild(frame);
+        const entry = { info, frame, subs: new Map() };
+        frames.set(frame.contentWindow, entry);
+        return () => unmount(entry);
+    };
+
+    // Kaldırılan eklentilerin iframe'leri de kaldırılır
+    window.gomad.on('extensions:changed', (list) => {
+        const ids = new Set((list || []).map((e) => e.id));
+        for (const entry of Array.from(frames.values())) {
+            if (!ids.has(entry.info.id)) unmount(entry);
+        }
+    });
+
+    window.addEventListener('message', (e) => {
+        const entry = frames.get(e.source);
+        const m = e.data;
+        if (!entry || !m || m.__gomad !== 1) return;
+        const reply = (r) => e.source.postMessage(Object.assign({ __gomad: 1 }, r), '*');
+        const perms = entry.info.permissions || {};
+
+        switch (m.type) {
+        case 'call':
+            if (typeof m.method !== 'string' || !allowed(perms.bindings, m.method)) {
+                console.warn('[gomad] extension ' + entry.info.id + ' denied binding ' + m.method);
+                reply({ type: 'error', id: m.id, error: { code: -2, message: "binding '" + m.method + "' not found" } });
+                return;
+            }
+            window.gomad.call(m.method, ...(Array.isArray(m.args) ? m.args : [])).then(
+                (result) => reply({ type: 'result', id: m.id, result }),
+                (err) => reply({ type: 'error', id: m.id, error: { code: err && err.code, message: String(err && err.message || err) } }));
+            return;
+        case 'subscribe':
+            if (typeof m.event !== 'string' || entry.subs.has(m.event) || !allowed(perms.events, m.event)) return;
+            entry.subs.set(m.event, window.gomad.on(m.event, (data) => reply({ type: 'event', event: m.event, data })));
+            return;
+        case 'unsubscribe': {
+            const off = entry.subs.get(m.event);
+            if (off) { off(); entry.subs.delete(m.event); }
+            return;
+        }
+        }
+    });
+})();
+`
+
+// extensionClientJS, eklenti sayfasına gomad API'sinin kısıtlı kopyasını
+// kurar: call, on ve off. Çağrılar uygulama sayfasındaki proxy'ye gider.
+const extensionClientJS = `(function() {
+    'use strict';
+    let seq = 0;
+    const pending = new Map();
+    const handlers = new Map();
+    const post = (m) => window.parent.postMessage(Object.assign({ __gomad: 1 }, m), '*');
+
+    window.addEventListener('message', (e) => {
+        const m = e.data;
+        if (e.source !== window.parent || !m || m.__gomad !== 1) return;
+        if (m.type === 'result' || m.type === 'error') {
+            const p = pending.get(m.id);
+            if (!p) return;
+            pending.delete(m.id);
+            if (m.type === 'result') {
+                p.resolve(m.result);
+            } else {
+                const err = new Error((m.error && m.error.message) || 'call failed');
+                err.code = m.error && m.error.code;
+                p.reject(err);
+            }
+        } else if (m.type === 'event') {
+            const set = handlers.get(m.event);
+            if (!set) return;
+            for (const cb of set) {
+                try { cb(m.data); } catch (err) { console.error('[gomad] extension listener failed:', err); }
+            }
+        }
+    });
+
+    const off = (event, cb) => {
+        const set = handlers.get(event);
+        if (!set) return;
+        if (cb) set.delete(cb); else set.clear();
+        if (set.size === 0) {
+            handlers.delete(event);
+            post({ type: 'unsubscribe', event });
+        }
+    };
+
+    window.gomad = Object.freeze({
+        call: (method, ...args) => new Promise((resolve, reject) => {
+            const id = ++seq;
+            pending.set(id, { resolve, reject });
+            post({ type: 'call', id, method, args });
+        }),
+        on: (event, cb) => {
+            let set = handlers.get(event);
+            if (!set) {
+                set = new Set();
+                handlers.set(event, set);
+                post({ type: 'subscribe', event });
+            }
+            set.add(cb);
+            return () => off(event, cb);
+        },
+        off,
+    });
+})();
+`
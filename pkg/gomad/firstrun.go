@@ -0,0 +1,139 @@
+package gomad
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/biyonik/gomad/pkg/update"
+)
+
+// launchFile, başlatma geçmişinin UserData dizinindeki dosya adıdır.
+const launchFile = "launch.json"
+
+// launchState, diskte saklanan başlatma geçmişidir.
+type launchState struct {
+	Version     string    `json:"version"`
+	FirstLaunch time.Time `json:"firstLaunch"`
+	Launches    int       `json:"launches"`
+}
+
+// LaunchInfo, bu başlatmanın önceki başlatmalara göre durumudur. JS'e
+// gomad.launch() ile aynı biçimde gider.
+type LaunchInfo struct {
+	// Uygulama bu kurulumda ilk kez çalışıyor
+	FirstRun bool `json:"firstRun"`
+	// Önceki başlatmadan daha yeni bir sürüm çalışıyor
+	Upgraded bool `json:"upgraded"`
+	// Çalışan sürüm (bkz. Build)
+	Version string `json:"version"`
+	// Son başlatmadaki sürüm; ilk çalıştırmada ve sürüm değişmediyse boş
+	PreviousVersion string `json:"previousVersion,omitempty"`
+	// İlk başlatma zamanı ve bu başlatma dahil toplam başlatma sayısı
+	FirstLaunch time.Time `json:"firstLaunch"`
+	Launches    int       `json:"launches"`
+}
+
+// Launch, bu başlatmanın ilk çalıştırma ya da sürüm yükseltmesi olup
+// olmadığını döner. Geçmiş UserData dizinindeki launch.json'da tutulur;
+// ilk çağrıda okunur ve bu başlatma kaydedilir, sonraki çağrılar aynı
+// sonucu döner. Run'dan önce de çağrılabilir.
+//
+// Sürüm "gomad build" ile gömülen sürümdür (bkz. Build); sürümler semver
+// olarak karşılaştırılır, eski bir sürüme dönüş yükseltme sayılmaz.
+func (a *Application) Launch() LaunchInfo {
+	a.launchOnce.Do(func() {
+		a.launchInfo = a.detectLaunch()
+	})
+	return a.launchInfo
+}
+
+// IsFirstRun, uygulamanın bu kurulumda ilk kez çalışıp çalışmadığını söyler.
+//
+//	if app.IsFirstRun() {
+//	    seedSampleData()
+//	}
+func (a *Application) IsFirstRun() bool {
+	return a.Launch().FirstRun
+}
+
+// IsUpgraded, önceki başlatmadan bu yana uygulamanın daha yeni bir sürüme
+// güncellenip güncellenmediğini söyler. Önceki sürüm Launch().PreviousVersion'dır.
+func (a *Application) IsUpgraded() bool {
+	return a.Launch().Upgraded
+}
+
+// detectLaunch, geçmişi okur, bu başlatmayı karşılaştırır ve kaydeder.
+func (a *Application) detectLaunch() LaunchInfo {
+	info := LaunchInfo{Version: Build().Version, FirstLaunch: time.Now().UTC()}
+
+	dir, err := a.Paths().UserData()
+	if err != nil {
+		a.Logger().Warn("failed to resolve launch history directory", "error", err)
+		return info
+	}
+	path := filepath.Join(dir, launchFile)
+
+	var state launchState
+	data, err := a.readAppFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		info.FirstRun = true
+	case err != nil:
+		// Okunamayan geçmiş ilk çalıştırma sayılmaz; onboarding tekrar gösterilmesin
+		a.Logger().Warn("failed to read launch history", "error", err)
+	default:
+		if err := json.Unmarshal(data, &state); err != nil {
+			a.Logger().Warn("invalid launch history file", "error", err)
+		}
+	}
+
+	if !state.FirstLaunch.IsZero() {
+		info.FirstLaunch = state.FirstLaunch
+	}
+	info.Launches = state.Launches + 1
+	if state.Version != "" && state.Version != info.Version {
+		info.PreviousVersion = state.Version
+		info.Upgraded = update.CompareVersions(info.Version, state.Version) > 0
+	}
+
+	state = launchState{Version: info.Version, FirstLaunch: info.FirstLaunch, Launches: info.Launches}
+	if data, err := json.Marshal(state); err == nil {
+		if err := a.writeAppFile(path, data); err != nil {
+			a.Logger().Warn("failed to save launch history", "error", err)
+		}
+	}
+	return info
+}
+
+// publishLaunch, ilk çalıştırma ve yükseltmeyi frontend'e sticky olay olarak
+// bildirir; onboarding ya da "yenilikler" ekranı olay gönderildikten sonra
+// mount edilse de bilgiyi alır.
+func (a *Application) publishLaunch() {
+	info := a.Launch()
+	switch {
+	case info.FirstRun:
+		a.Logger().Info("first run", "version", info.Version)
+		_ = a.EmitSticky("app:first-run", info)
+	case info.Upgraded:
+		a.Logger().Info("upgraded", "from", info.PreviousVersion, "to", info.Version)
+		_ = a.EmitSticky("app:upgraded", info)
+	}
+}
+
+// launchModule, başlatma geçmişinin JS API'sidir.
+//
+//	const { firstRun, upgraded, previousVersion } = await gomad.launch();
+//	gomad.on("app:first-run", () => router.navigate(["/welcome"]));
+//	gomad.on("app:upgraded", ({ previousVersion, version }) => showChangelog(previousVersion, version));
+func (a *Application) launchModule() builtinModule {
+	return builtinModule{
+		methods: map[string]interface{}{
+			"launch": func() (LaunchInfo, error) {
+				return a.Launch(), nil
+			},
+		},
+	}
+}
@@ -0,0 +1,337 @@
+package gomad
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+)
+
+// flagsFile, uzaktan alınan son bayrak değerlerinin Cache dizinindeki
+// dosyasıdır; çevrimdışı başlangıçta son bilinen değerler kullanılır.
+const flagsFile = "feature-flags.json"
+
+// FlagSource, bayrak değerlerini uzak bir kaynaktan getirir (bkz.
+// WithFlagSource). Dönen değerler yerel varsayılanları ezer.
+type FlagSource interface {
+	Fetch(ctx context.Context) (map[string]json.RawMessage, error)
+}
+
+// FlagSourceFunc, bir fonksiyonu FlagSource olarak kullanmayı sağlar.
+type FlagSourceFunc func(ctx context.Context) (map[string]json.RawMessage, error)
+
+// Fetch, f'i çağırır.
+func (f FlagSourceFunc) Fetch(ctx context.Context) (map[string]json.RawMessage, error) {
+	return f(ctx)
+}
+
+// NewHTTPFlagSource, url'den {"flag": value, ...} biçiminde bir JSON nesnesi
+// okuyan FlagSource oluşturur.
+func NewHTTPFlagSource(url string) FlagSource {
+	return FlagSourceFunc(func(ctx context.Context) (map[string]json.RawMessage, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("feature flags: %s", resp.Status)
+		}
+		var values map[string]json.RawMessage
+		if err := json.NewDecoder(resp.Body).Decode(&values); err != nil {
+			return nil, fmt.Errorf("feature flags: %w", err)
+		}
+		return values, nil
+	})
+}
+
+// Flags, uygulamanın özellik bayraklarıdır. Değerler WithFeatureFlags ile
+// verilen yerel varsayılanlardan ve isteğe bağlı uzak kaynaktan (WithFlagSource)
+// gelir; uzak değer varsa varsayılanı ezer.
+//
+//	app := gomad.New(
+//	    gomad.WithFeatureFlags(map[string]any{"newEditor": false, "maxTabs": 8}),
+//	    gomad.WithFlagSource(gomad.NewHTTPFlagSource("https://flags.example.com/app.json"), 15*time.Minute),
+//	)
+//	if app.Flags().Bool("newEditor") { ... }
+//
+//	// JS:
+//	// if (gomad.flags.isEnabled("newEditor")) mountNewEditor();
+//	// gomad.flags.onChange((flags, changed) => ...);
+type Flags struct {
+	app      *Application
+	defaults map[string]json.RawMessage
+
+	mu        sync.Mutex
+	remote    map[string]json.RawMessage
+	listeners map[int]func(changed []string)
+	nextID    int
+}
+
+// flagsChange, "flags:changed" olayının verisidir.
+type flagsChange struct {
+	Flags   map[string]json.RawMessage `json:"flags"`
+	Changed []string                   `json:"changed"`
+}
+
+// Flags, uygulamanın özellik bayraklarını döner.
+func (a *Application) Flags() *Flags {
+	a.flagsOnce.Do(func() {
+		f := &Flags{app: a, defaults: map[string]json.RawMessage{}, listeners: map[int]func([]string){}}
+		for name, v := range a.config.flagDefaults {
+			raw, err := json.Marshal(v)
+			if err != nil {
+				a.Logger().Warn("invalid feature flag default", "flag", name, "error", err)
+				continue
+			}
+			f.defaults[name] = raw
+		}
+		f.remote = f.loadRemote()
+		a.flags = f
+	})
+	return a.flags
+}
+
+// Bool, bayrağın değerini döner; bayrak yoksa ya da bool değilse false.
+func (f *Flags) Bool(name string) bool {
+	var v bool
+	f.Get(name, &v)
+	return v
+}
+
+// String, bayrağın değerini döner; bayrak yoksa ya da string değilse "".
+func (f *Flags) String(name string) string {
+	var v string
+	f.Get(name, &v)
+	return v
+}
+
+// Int, bayrağın değerini döner; bayrak yoksa ya da tam sayı değilse 0.
+func (f *Flags) Int(name string) int {
+	var v int
+	f.Get(name, &v)
+	return v
+}
+
+// Float, bayrağın değerini döner; bayrak yoksa ya da sayı değilse 0.
+func (f *Flags) Float(name string) float64 {
+	var v float64
+	f.Get(name, &v)
+	return v
+}
+
+// Get, bayrağın değerini out'a çözer. Bayrak yoksa false döner.
+func (f *Flags) Get(name string, out any) (bool, error) {
+	f.mu.Lock()
+	raw, ok := f.value(name)
+	f.mu.Unlock()
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(raw, out)
+}
+
+// All, tüm bayrakların geçerli değerlerini döner.
+func (f *Flags) All() map[string]json.RawMessage {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.effective()
+}
+
+// OnChange, uzak yenilemeyle değişen bayrak adlarıyla çağrılacak fn'i
+// kaydeder. Dönen fonksiyon kaydı kaldırır.
+func (f *Flags) OnChange(fn func(changed []string)) (cancel func()) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	id := f.nextID
+	f.nextID++
+	f.listeners[id] = fn
+	return func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		delete(f.listeners, id)
+	}
+}
+
+// Refresh, uzak kaynaktan değerleri hemen yeniden alır. Değişen bayraklar
+// OnChange dinleyicilerine ve JS'e "flags:changed" ({flags, changed}) olayıyla
+// bildirilir. WithFlagSource verilmediyse etkisizdir.
+func (f *Flags) Refresh(ctx context.Context) error {
+	src := f.app.config.flagSource
+	if src == nil {
+		return nil
+	}
+	remote, err := src.Fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	before := f.effective()
+	f.remote = remote
+	after := f.effective()
+	var changed []string
+	for name := range keysOf(before, after) {
+		if string(before[name]) != string(after[name]) {
+			changed = append(changed, name)
+		}
+	}
+	slices.Sort(changed)
+	listeners := slices.Collect(maps.Values(f.listeners))
+	f.mu.Unlock()
+
+	f.saveRemote(remote)
+	if len(changed) == 0 {
+		return nil
+	}
+	f.app.Logger().Debug("feature flags changed", "flags", changed)
+	for _, fn := range listeners {
+		fn(changed)
+	}
+	_ = f.app.Broadcast("flags:changed", flagsChange{Flags: after, Changed: changed})
+	return nil
+}
+
+// value, f.mu tutulurken bayrağın geçerli değerini döner.
+func (f *Flags) value(name string) (json.RawMessage, bool) {
+	if raw, ok := f.remote[name]; ok {
+		return raw, true
+	}
+	raw, ok := f.defaults[name]
+	return raw, ok
+}
+
+// effective, f.mu tutulurken varsayılanlarla uzak değerlerin birleşimini döner.
+func (f *Flags) effective() map[string]json.RawMessage {
+	out := maps.Clone(f.defaults)
+	maps.Copy(out, f.remote)
+	return out
+}
+
+// keysOf, iki haritanın anahtar birleşimini döner.
+func keysOf(a, b map[string]json.RawMessage) map[string]struct{} {
+	keys := make(map[string]struct{}, len(a)+len(b))
+	for k := range a {
+		keys[k] = struct{}{}
+	}
+	for k := range b {
+		keys[k] = struct{}{}
+	}
+	return keys
+}
+
+func (f *Flags) loadRemote() map[string]json.RawMessage {
+	if f.app.config.flagSource == nil {
+		return nil
+	}
+	dir, err := f.app.Paths().Cache()
+	if err != nil {
+		return nil
+	}
+	data, err := f.app.readAppFile(filepath.Join(dir, flagsFile))
+	if err != nil {
+		return nil
+	}
+	var remote map[string]json.RawMessage
+	_ = json.Unmarshal(data, &remote)
+	return remote
+}
+
+func (f *Flags) saveRemote(remote map[string]json.RawMessage) {
+	dir, err := f.app.Paths().Cache()
+	if err != nil {
+		return
+	}
+	data, err := json.Marshal(remote)
+	if err == nil {
+		err = f.app.writeAppFile(filepath.Join(dir, flagsFile), data)
+	}
+	if err != nil {
+		f.app.Logger().Debug("feature flags cache write failed", "error", err)
+	}
+}
+
+// startFlagRefresh, uzak bayrakları başlangıçta ve WithFlagSource aralığıyla
+// arka planda yeniler. Dönen fonksiyon döngüyü durdurur.
+func (a *Application) startFlagRefresh() (stop func()) {
+	if a.config.flagSource == nil {
+		return func() {}
+	}
+	f := a.Flags()
+	ctx, cancel := context.WithCancel(a.Context())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		var tick <-chan time.Time
+		if a.config.flagInterval > 0 {
+			ticker := time.NewTicker(a.config.flagInterval)
+			defer ticker.Stop()
+			tick = ticker.C
+		}
+		for {
+			if err := f.Refresh(ctx); err != nil && ctx.Err() == nil {
+				a.Logger().Warn("feature flag refresh failed", "error", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-tick:
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// flagsModule, özellik bayraklarının JS API'sidir (window.gomad.flags).
+// Değerler init script'e gömülür; isEnabled ve value senkron çalışır.
+func (a *Application) flagsModule() builtinModule {
+	initial, _ := json.Marshal(a.Flags().All())
+
+	return builtinModule{
+		namespace: "flags",
+		methods: map[string]interface{}{
+			"all": func() (map[string]json.RawMessage, error) {
+				return a.Flags().All(), nil
+			},
+			"refresh": func() error {
+				return a.Flags().Refresh(a.Context())
+			},
+		},
+		init: "(function() { const initial = " + string(initial) + ";\n" + flagsJS + "})();\n",
+	}
+}
+
+// flagsJS, bayrakların yerel kopyasını tutar ve "flags:changed" ile günceller.
+const flagsJS = `
+    const flags = window.gomad.flags;
+    const listeners = new Set();
+    let values = initial || {};
+    const replace = (next, changed) => {
+        values = next || {};
+        for (const cb of listeners) {
+            try { cb(values, changed); } catch (e) { console.error('[gomad] flags listener failed:', e); }
+        }
+    };
+    flags.value = (name, fallback) => (name in values ? values[name] : fallback);
+    flags.isEnabled = (name) => values[name] === true;
+    flags.current = () => Object.assign({}, values);
+    flags.onChange = (cb) => { listeners.add(cb); return () => listeners.delete(cb); };
+    window.gomad.on('flags:changed', (e) => replace(e.flags, e.changed));
+    // Sayfa bir yenilemeden sonra yeniden yüklendiyse gömülü değerler eskidir
+    flags.all().then((v) => {
+        const changed = Object.keys(Object.assign({}, v, values)).filter((k) => JSON.stringify(v[k]) !== JSON.stringify(values[k]));
+        if (changed.length) replace(v, changed);
+    }).catch(() => {});
+`
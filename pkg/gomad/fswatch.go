@@ -0,0 +1,153 @@
+package gomad
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/webview"
+	"github.com/biyonik/gomad/pkg/fswatch"
+)
+
+// fsChangedEvent, "fs:changed" olayının içeriğidir.
+type fsChangedEvent struct {
+	Watch   string           `json:"watch"` // İzleyici kimliği
+	Root    string           `json:"root"`  // İzlenen dizin
+	Changes []fswatch.Change `json:"changes"`
+}
+
+// WatchDir, dizini izler; birleştirilmiş değişiklikler fn'e verilir ve tüm
+// pencerelere "fs:changed" ({watch, root, changes}) olayı olarak gönderilir.
+// fn nil olabilir.
+// fn ayrı bir goroutine'de çalışır. Dönen fonksiyon izlemeyi durdurur.
+//
+//	stop, err := app.WatchDir(inbox, fswatch.Options{Recursive: true}, func(c []fswatch.Change) {
+//	    importer.Scan()
+//	})
+func (a *Application) WatchDir(path string, opts fswatch.Options, fn func([]fswatch.Change)) (stop func(), err error) {
+	_, stop, err = a.watchDir(path, opts, fn, a.Broadcast)
+	return stop, err
+}
+
+// watchDir, WatchDir'in izleyici kimliğini de dönen halidir; "fs:changed"
+// olayı emit ile gönderilir.
+func (a *Application) watchDir(path string, opts fswatch.Options, fn func([]fswatch.Change), emit func(event string, data interface{}) error) (string, func(), error) {
+	root, err := filepath.Abs(path)
+	if err != nil {
+		return "", nil, err
+	}
+
+	a.fsWatchMu.Lock()
+	a.nextFSWatch++
+	id := fmt.Sprintf("watch-%d", a.nextFSWatch)
+	a.fsWatchMu.Unlock()
+
+	stop, err := fswatch.Watch(root, opts, func(changes []fswatch.Change) {
+		if fn != nil {
+			a.Go(func() { fn(changes) })
+		}
+		_ = emit("fs:changed", fsChangedEvent{Watch: id, Root: root, Changes: changes})
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return id, stop, nil
+}
+
+// jsWatchDir, JS için izin listesine (WithWatchRoots) bağlı izleyici kurar;
+// değişiklikler yalnızca izleyiciyi kuran sayfaya (wv) gönderilir.
+func (a *Application) jsWatchDir(wv webview.View, path string, opts fswatch.Options) (string, error) {
+	if !a.watchAllowed(path) {
+		return "", gomerrors.NewOperationError("fs.watch", path+" is outside the allowed watch roots", gomerrors.ErrPermissionDenied)
+	}
+
+	id, stop, err := a.watchDir(path, opts, nil, wv.Emit)
+	if err != nil {
+		return "", err
+	}
+
+	a.fsWatchMu.Lock()
+	if a.fsWatches == nil {
+		a.fsWatches = make(map[string]func())
+	}
+	a.fsWatches[id] = stop
+	a.fsWatchMu.Unlock()
+	return id, nil
+}
+
+// watchAllowed, yolun WithWatchRoots dizinlerinden birinin içinde olup
+// olmadığını döner. Sembolik bağlantılar çözülür; "../" ile kaçış engellenir.
+func (a *Application) watchAllowed(path string) bool {
+	target, err := resolvePath(path)
+	if err != nil {
+		return false
+	}
+	for _, root := range a.config.watchRoots {
+		base, err := resolvePath(root)
+		if err != nil {
+			continue
+		}
+		rel, err := filepath.Rel(base, target)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvePath, yolu mutlak ve sembolik bağlantısız hale getirir.
+func resolvePath(path string) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.EvalSymlinks(abs)
+}
+
+// jsUnwatchDir, JS izleyicisini durdurur.
+func (a *Application) jsUnwatchDir(id string) {
+	a.fsWatchMu.Lock()
+	stop := a.fsWatches[id]
+	delete(a.fsWatches, id)
+	a.fsWatchMu.Unlock()
+
+	if stop != nil {
+		stop()
+	}
+}
+
+// unwatchAllDirs, tüm JS izleyicilerini durdurur. Run temizliğinde çağrılır.
+func (a *Application) unwatchAllDirs() {
+	a.fsWatchMu.Lock()
+	watches := a.fsWatches
+	a.fsWatches = nil
+	a.fsWatchMu.Unlock()
+
+	for _, stop := range watches {
+		stop()
+	}
+}
+
+// fsModule, dizin izlemenin JS API'sidir (window.gomad.fs).
+// "fs:changed" olayları yalnızca izleyiciyi kuran pencereye gelir.
+// Yalnızca WithWatchRoots ile izin verilen dizinler izlenebilir.
+//
+//	const id = await gomad.fs.watch("/data/inbox", { recursive: true, ignore: ["*.tmp"] });
+//	gomad.on("fs:changed", ({ watch, changes }) => { ... });
+//	await gomad.fs.unwatch(id);
+func (a *Application) fsModule() builtinModule {
+	return builtinModule{
+		namespace: "fs",
+		methods: map[string]interface{}{
+			"unwatch": a.jsUnwatchDir,
+		},
+		viewMethods: func(wv webview.View) map[string]interface{} {
+			return map[string]interface{}{
+				"watch": func(path string, opts fswatch.Options) (string, error) {
+					return a.jsWatchDir(wv, path, opts)
+				},
+			}
+		},
+	}
+}
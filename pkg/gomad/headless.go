@@ -0,0 +1,234 @@
+package gomad
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/biyonik/gomad/internal/bridge"
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/webview"
+)
+
+// headlessEnv, ayarlanırsa ("1") uygulamayı headless modda çalıştırır.
+const headlessEnv = "GOMAD_HEADLESS"
+
+// applyHeadlessEnv, GOMAD_HEADLESS=1 ise headless modu açar.
+func applyHeadlessEnv(cfg *config) {
+	if os.Getenv(headlessEnv) == "1" {
+		cfg.headless = true
+	}
+}
+
+// newView, yapılandırmaya göre gerçek ya da headless WebView oluşturur.
+func (a *Application) newView(opts webview.Options) (webview.View, error) {
+	if a.config.headless {
+		return a.headlessView(), nil
+	}
+	wv, err := webview.New(opts)
+	if err != nil {
+		return nil, err
+	}
+	return wv, nil
+}
+
+// headlessView, headless View'i ilk kullanımda oluşturur. Run'dan önce de
+// çağrılabilir; böylece test istemcisi olaylara önceden abone olabilir.
+func (a *Application) headlessView() *webview.Headless {
+	a.headlessOnce.Do(func() {
+		a.headless = webview.NewHeadless(webview.Options{Logger: a.config.logger})
+	})
+	return a.headless
+}
+
+// ============================================================================
+// HeadlessClient
+// Headless modda JS tarafının yerini alır: window.gomad.call, gomad.ready ve
+// gomad.on ile aynı mesaj protokolünü kullanır.
+//
+//	app := gomad.New(gomad.WithHeadless(true))
+//	app.Bind("add", func(a, b int) int { return a + b })
+//	go app.Run()
+//	defer app.Quit()
+//
+//	client := app.HeadlessClient()
+//	var sum int
+//	err := client.Call(ctx, "add", &sum, 2, 3) // sum == 5
+//
+//	state, err := client.WaitEvent(ctx, "state:init", func() error {
+//	    return client.Ready(ctx) // OnFrontendReady callback'lerini tetikler
+//	})
+// ============================================================================
+
+// HeadlessClient, headless modda çalışan uygulamanın JS taklididir.
+type HeadlessClient struct {
+	view   *webview.Headless
+	nextID atomic.Uint64
+}
+
+// HeadlessEvent, Go'dan JS'e gönderilmiş bir olaydır.
+type HeadlessEvent struct {
+	Name string
+	Data json.RawMessage
+}
+
+// HeadlessClient, headless modda JS tarafını taklit eden istemciyi döner.
+// Uygulama WithHeadless ile oluşturulmadıysa nil döner.
+func (a *Application) HeadlessClient() *HeadlessClient {
+	if !a.config.headless {
+		return nil
+	}
+	return &HeadlessClient{view: a.headlessView()}
+}
+
+// Call, JS'teki window.gomad.call'un karşılığıdır. Uygulama çalışmaya
+// başlayana kadar bekler. Sonuç result'a (nil değilse) çözülür; Go
+// fonksiyonunun hatası *errors.MessageError olarak döner.
+func (c *HeadlessClient) Call(ctx context.Context, method string, result interface{}, args ...interface{}) error {
+	if err := c.wait(ctx); err != nil {
+		return err
+	}
+	if args == nil {
+		args = []interface{}{}
+	}
+
+	id := "headless_" + strconv.FormatUint(c.nextID.Add(1), 10)
+	msg, err := bridge.NewCallMessage(id, method, args)
+	if err != nil {
+		return fmt.Errorf("failed to encode call: %w", err)
+	}
+	data, err := msg.ToJSON()
+	if err != nil {
+		return err
+	}
+
+	resp, err := bridge.FromJSON([]byte(c.view.Invoke(string(data))))
+	if err != nil {
+		return fmt.Errorf("invalid response: %w", err)
+	}
+	if resp.Type == bridge.MessageTypeError && resp.Error != nil {
+		// Kayıtlı kodlar zincirde kalır: errors.Is(err, ErrQuotaExceeded)
+		var cause error
+		if code := gomerrors.LookupCode(resp.Error.Code); code != nil {
+			cause = code
+		}
+		return gomerrors.NewMessageError(id, method, resp.Error.Message, cause)
+	}
+	if result == nil || len(resp.Result) == 0 {
+		return nil
+	}
+	return resp.ParseResult(result)
+}
+
+// Ready, JS'teki gomad.ready() karşılığıdır; OnFrontendReady callback'lerini
+// çalıştırır.
+func (c *HeadlessClient) Ready(ctx context.Context) error {
+	return c.Call(ctx, bridge.ReadyBinding, nil)
+}
+
+// On, JS'teki gomad.on karşılığıdır. fn, olayları Emit sırasıyla ve aynı
+// goroutine'de alır; uzun işlem yapmamalıdır. Dönen fonksiyon aboneliği kaldırır.
+func (c *HeadlessClient) On(event string, fn func(data json.RawMessage)) (cancel func()) {
+	return c.view.OnEvent(func(msg *bridge.Message) {
+		if msg.Event == event {
+			fn(msg.Data)
+		}
+	})
+}
+
+// Events, abone olunduğu andan itibaren gelen tüm olayları kanal olarak
+// döner. Kanal cancel çağrılana kadar olayları biriktirir.
+func (c *HeadlessClient) Events() (events <-chan HeadlessEvent, cancel func()) {
+	ch := make(chan HeadlessEvent, 64)
+	var mu sync.Mutex
+	var buffered []HeadlessEvent
+	notify := make(chan struct{}, 1)
+	stop := make(chan struct{})
+
+	unsubscribe := c.view.OnEvent(func(msg *bridge.Message) {
+		mu.Lock()
+		buffered = append(buffered, HeadlessEvent{Name: msg.Event, Data: msg.Data})
+		mu.Unlock()
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	})
+
+	// Olay döngüsünü yavaş okuyucu yüzünden bekletmemek için ara tampon
+	go func() {
+		defer close(ch)
+		for {
+			mu.Lock()
+			pending := buffered
+			buffered = nil
+			mu.Unlock()
+			for _, ev := range pending {
+				select {
+				case ch <- ev:
+				case <-stop:
+					return
+				}
+			}
+			select {
+			case <-notify:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			unsubscribe()
+			close(stop)
+		})
+	}
+}
+
+// WaitEvent, trigger'ı çalıştırır ve ardından ilk gelen event olayının
+// verisini döner. Abonelik trigger'dan önce kurulduğu için olay kaçmaz.
+// trigger nil olabilir.
+func (c *HeadlessClient) WaitEvent(ctx context.Context, event string, trigger func() error) (json.RawMessage, error) {
+	got := make(chan json.RawMessage, 1)
+	cancel := c.On(event, func(data json.RawMessage) {
+		select {
+		case got <- data:
+		default:
+		}
+	})
+	defer cancel()
+
+	if trigger != nil {
+		if err := trigger(); err != nil {
+			return nil, err
+		}
+	}
+	select {
+	case data := <-got:
+		return data, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// OnEval, olay olmayan her Eval'de (ör. app.Eval) çağrılacak fonksiyonu ekler.
+// Headless modda JS yürütülmez; kod yalnızca gözlemlenebilir.
+func (c *HeadlessClient) OnEval(fn func(js string)) {
+	c.view.OnEval(fn)
+}
+
+// wait, uygulamanın olay döngüsü başlayana kadar bekler.
+func (c *HeadlessClient) wait(ctx context.Context) error {
+	select {
+	case <-c.view.Started():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,138 @@
+package gomad
+
+import "github.com/biyonik/gomad/pkg/hid"
+
+// ============================================================================
+// Kumanda ve HID girdileri
+// Kumanda olayları JS'e "gamepad:connected", "gamepad:disconnected",
+// "gamepad:button" ve "gamepad:axis" olarak iletilir (verisi
+// hid.GamepadEvent); yoklama yalnızca sayfa gomad.hid.watchGamepads
+// çağırdıktan sonra başlar. HID aygıtları açıldığında her giriş raporu
+// "hid:report" ({device, data}) olarak gönderilir; aygıt çıkarılırsa
+// "hid:closed" ({device, error}) gelir.
+//
+//	await gomad.hid.watchGamepads();
+//	gomad.on("gamepad:button", ({ index, value }) => index === 0 && value && jump());
+//
+//	const [scanner] = (await gomad.hid.devices()).filter(d => d.vendorId === 0x05e0);
+//	const off = await gomad.hid.listen(scanner.id, (report) => parse(report)); // Uint8Array
+// ============================================================================
+
+// hidReport, JS'e gönderilen HID raporudur; Data base64 olarak kodlanır.
+type hidReport struct {
+	Device string `json:"device"`
+	Data   []byte `json:"data"`
+}
+
+// watchGamepads, kumanda olaylarını JS'e iletmeye başlar. Birden fazla
+// çağrılması zararsızdır.
+func (a *Application) watchGamepads() error {
+	a.hidMu.Lock()
+	defer a.hidMu.Unlock()
+	if a.gamepadCancel != nil {
+		return nil
+	}
+	cancel, err := hid.SubscribeGamepads(func(e hid.GamepadEvent) {
+		_ = a.Emit("gamepad:"+string(e.Type), e)
+	})
+	if err != nil {
+		return err
+	}
+	a.gamepadCancel = cancel
+	return nil
+}
+
+// openHID, aygıtın raporlarını JS'e iletmeye başlar. Aygıt zaten açıksa bir
+// şey yapmaz.
+func (a *Application) openHID(id string) error {
+	a.hidMu.Lock()
+	defer a.hidMu.Unlock()
+	if _, ok := a.hidDevices[id]; ok {
+		return nil
+	}
+	stop, err := hid.Listen(id, func(report []byte) {
+		_ = a.Emit("hid:report", hidReport{Device: id, Data: report})
+	}, func(err error) {
+		a.hidMu.Lock()
+		delete(a.hidDevices, id)
+		a.hidMu.Unlock()
+		_ = a.Emit("hid:closed", map[string]string{"device": id, "error": err.Error()})
+	})
+	if err != nil {
+		return err
+	}
+	if a.hidDevices == nil {
+		a.hidDevices = make(map[string]func())
+	}
+	a.hidDevices[id] = stop
+	return nil
+}
+
+// closeHID, aygıtın okunmasını durdurur.
+func (a *Application) closeHID(id string) {
+	a.hidMu.Lock()
+	stop := a.hidDevices[id]
+	delete(a.hidDevices, id)
+	a.hidMu.Unlock()
+
+	if stop != nil {
+		stop()
+	}
+}
+
+// stopHID, kumanda yoklamasını ve açık aygıtları durdurur. Run temizliğinde
+// çağrılır.
+func (a *Application) stopHID() {
+	a.hidMu.Lock()
+	cancel, devices := a.gamepadCancel, a.hidDevices
+	a.gamepadCancel, a.hidDevices = nil, nil
+	a.hidMu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	for _, stop := range devices {
+		stop()
+	}
+}
+
+// hidJS, raporları Uint8Array'e çeviren listen yardımcısını ekler.
+const hidJS = `
+(function() {
+    const decode = (b64) => Uint8Array.from(atob(b64), c => c.charCodeAt(0));
+    window.gomad.hid.listen = async function(id, fn) {
+        const off = window.gomad.on('hid:report', (r) => {
+            if (r.device === id) fn(decode(r.data || ''));
+        });
+        try {
+            await window.gomad.hid.open(id);
+        } catch (e) {
+            off();
+            throw e;
+        }
+        return () => {
+            off();
+            window.gomad.hid.close(id).catch(() => {});
+        };
+    };
+})();
+`
+
+// hidModule, kumanda ve HID aygıtlarının JS API'sidir (window.gomad.hid).
+//
+//	const pads = await gomad.hid.gamepads();
+//	const devices = await gomad.hid.devices();
+//	const off = await gomad.hid.listen(devices[0].id, (report) => { ... });
+func (a *Application) hidModule() builtinModule {
+	return builtinModule{
+		namespace: "hid",
+		methods: map[string]interface{}{
+			"gamepads":      hid.Gamepads,
+			"watchGamepads": a.watchGamepads,
+			"devices":       hid.Devices,
+			"open":          a.openHID,
+			"close":         a.closeHID,
+		},
+		init: hidJS,
+	}
+}
@@ -0,0 +1,310 @@
+package gomad
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// Command, geri alınabilir bir işlemdir. Do işlemi uygular, Undo etkisini
+// geri alır; Redo için Do yeniden çağrılır.
+type Command struct {
+	// Label, menülerde gösterilen addır ("Undo Rename").
+	Label string
+	Do    func() error
+	Undo  func() error
+}
+
+// CommandFactory, JS'ten adıyla çalıştırılan bir komutu argümanlarından
+// üretir (bkz. History.Register).
+type CommandFactory func(args json.RawMessage) (Command, error)
+
+// HistoryState, geri al/yinele yığınlarının durumudur; "history:changed"
+// olayının verisidir.
+type HistoryState struct {
+	CanUndo   bool   `json:"canUndo"`
+	CanRedo   bool   `json:"canRedo"`
+	UndoLabel string `json:"undoLabel,omitempty"`
+	RedoLabel string `json:"redoLabel,omitempty"`
+	UndoDepth int    `json:"undoDepth"`
+	RedoDepth int    `json:"redoDepth"`
+	// Dirty, son MarkSaved'den beri kaydedilmemiş değişiklik olduğunu söyler.
+	Dirty bool `json:"dirty"`
+}
+
+// History, uygulamanın merkezi geri al/yinele geçmişidir. Backend'de veri
+// değiştiren işlemler komut olarak çalıştırılır; JS hangi işlemin hangi
+// katmanda yapıldığını bilmeden gomad.undo() ve gomad.redo() çağırır.
+//
+//	app.History().Register("notes.rename", func(args json.RawMessage) (gomad.Command, error) {
+//	    var p struct{ ID int; Title string }
+//	    if err := json.Unmarshal(args, &p); err != nil {
+//	        return gomad.Command{}, err
+//	    }
+//	    old := store.Title(p.ID)
+//	    return gomad.Command{
+//	        Label: "Rename",
+//	        Do:    func() error { return store.Rename(p.ID, p.Title) },
+//	        Undo:  func() error { return store.Rename(p.ID, old) },
+//	    }, nil
+//	})
+//
+//	// JS:
+//	// await gomad.history.execute("notes.rename", { ID: 4, Title: "Plan" });
+//	// await gomad.undo();
+//	// gomad.on("history:changed", (s) => undoItem.disabled = !s.canUndo);
+//
+// Komutlar sırayla çalışır; bir komut çalışırken gelen Execute, Undo ve
+// Redo çağrıları onu bekler.
+type History struct {
+	app *Application
+
+	// run, komutların sırayla çalışmasını sağlar
+	run sync.Mutex
+
+	mu        sync.Mutex
+	undo      []Command
+	redo      []Command
+	factories map[string]CommandFactory
+	// saved, MarkSaved anındaki undo derinliğidir; -1 kayıtlı duruma
+	// dönülemeyeceğini belirtir (kayıt noktası yığından düştü ya da
+	// üzerine yeni komut yazıldı)
+	saved int
+}
+
+// History, uygulamanın komut geçmişini döner.
+func (a *Application) History() *History {
+	a.historyOnce.Do(func() {
+		a.history = &History{app: a, factories: map[string]CommandFactory{}}
+	})
+	return a.history
+}
+
+// Register, JS'in gomad.history.execute(name, args) ile çalıştırabileceği
+// bir komut üreticisi kaydeder.
+func (h *History) Register(name string, factory CommandFactory) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, exists := h.factories[name]; exists {
+		return gomerrors.NewOperationError("history.register", name, gomerrors.ErrAlreadyExists)
+	}
+	h.factories[name] = factory
+	return nil
+}
+
+// Execute, cmd.Do'yu çalıştırır ve başarılıysa komutu geri alma yığınına
+// ekler. Yinele yığını temizlenir.
+func (h *History) Execute(cmd Command) error {
+	if cmd.Do == nil || cmd.Undo == nil {
+		return gomerrors.NewOperationError("history.execute", "command needs Do and Undo", gomerrors.ErrInvalidArgument)
+	}
+	h.run.Lock()
+	defer h.run.Unlock()
+
+	if err := cmd.Do(); err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	if h.saved > len(h.undo) {
+		h.saved = -1
+	}
+	h.undo = append(h.undo, cmd)
+	h.redo = nil
+	if over := len(h.undo) - h.app.config.undoLimit; over > 0 {
+		h.undo = h.undo[over:]
+		h.saved -= over
+		if h.saved < 0 {
+			h.saved = -1
+		}
+	}
+	h.mu.Unlock()
+
+	h.changed()
+	return nil
+}
+
+// ExecuteAll, cmds'i tek bir geri alınabilir adım olarak sırayla çalıştırır.
+// Bir komut başarısız olursa önceki komutlar ters sırayla geri alınır ve
+// geçmiş değişmez.
+func (h *History) ExecuteAll(label string, cmds ...Command) error {
+	for _, c := range cmds {
+		if c.Do == nil || c.Undo == nil {
+			return gomerrors.NewOperationError("history.execute", "command needs Do and Undo", gomerrors.ErrInvalidArgument)
+		}
+	}
+	undoAll := func(done []Command) error {
+		for i := len(done) - 1; i >= 0; i-- {
+			if err := done[i].Undo(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return h.Execute(Command{
+		Label: label,
+		Do: func() error {
+			for i, c := range cmds {
+				if err := c.Do(); err != nil {
+					if rerr := undoAll(cmds[:i]); rerr != nil {
+						return fmt.Errorf("%w (rollback failed: %v)", err, rerr)
+					}
+					return err
+				}
+			}
+			return nil
+		},
+		Undo: func() error { return undoAll(cmds) },
+	})
+}
+
+// Undo, son komutu geri alır. Geri alınacak komut yoksa false döner. Undo
+// başarısız olursa komut yığında kalır.
+func (h *History) Undo() (bool, error) {
+	return h.step(true)
+}
+
+// Redo, en son geri alınan komutu yeniden uygular. Yinelenecek komut yoksa
+// false döner.
+func (h *History) Redo() (bool, error) {
+	return h.step(false)
+}
+
+// step, undo true ise geri alır, değilse yineler.
+func (h *History) step(undo bool) (bool, error) {
+	h.run.Lock()
+	defer h.run.Unlock()
+
+	h.mu.Lock()
+	from := &h.redo
+	if undo {
+		from = &h.undo
+	}
+	if len(*from) == 0 {
+		h.mu.Unlock()
+		return false, nil
+	}
+	cmd := (*from)[len(*from)-1]
+	h.mu.Unlock()
+
+	run := cmd.Do
+	if undo {
+		run = cmd.Undo
+	}
+	if err := run(); err != nil {
+		return false, err
+	}
+
+	h.mu.Lock()
+	if undo {
+		h.undo = h.undo[:len(h.undo)-1]
+		h.redo = append(h.redo, cmd)
+	} else {
+		h.redo = h.redo[:len(h.redo)-1]
+		h.undo = append(h.undo, cmd)
+	}
+	h.mu.Unlock()
+
+	h.changed()
+	return true, nil
+}
+
+// Clear, geçmişi temizler (ör. yeni doküman açıldığında).
+func (h *History) Clear() {
+	h.run.Lock()
+	defer h.run.Unlock()
+	h.mu.Lock()
+	h.undo, h.redo, h.saved = nil, nil, 0
+	h.mu.Unlock()
+	h.changed()
+}
+
+// MarkSaved, mevcut durumu kayıt noktası yapar; HistoryState.Dirty bu
+// noktaya dönüldüğünde false olur.
+func (h *History) MarkSaved() {
+	h.mu.Lock()
+	h.saved = len(h.undo)
+	h.mu.Unlock()
+	h.changed()
+}
+
+// State, yığınların güncel durumunu döner.
+func (h *History) State() HistoryState {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s := HistoryState{
+		CanUndo:   len(h.undo) > 0,
+		CanRedo:   len(h.redo) > 0,
+		UndoDepth: len(h.undo),
+		RedoDepth: len(h.redo),
+		Dirty:     h.saved != len(h.undo),
+	}
+	if s.CanUndo {
+		s.UndoLabel = h.undo[len(h.undo)-1].Label
+	}
+	if s.CanRedo {
+		s.RedoLabel = h.redo[len(h.redo)-1].Label
+	}
+	return s
+}
+
+// changed, yeni durumu tüm pencerelere "history:changed" olayıyla bildirir.
+func (h *History) changed() {
+	if h.app.view() != nil {
+		_ = h.app.Broadcast("history:changed", h.State())
+	}
+}
+
+// historyModule, komut geçmişinin JS API'sidir (window.gomad.history);
+// gomad.undo() ve gomad.redo() kısayollarını da kurar.
+//
+//	await gomad.history.execute("notes.rename", { ID: 4, Title: "Plan" });
+//	await gomad.undo();
+//	const { canUndo, undoLabel } = await gomad.history.state();
+func (a *Application) historyModule() builtinModule {
+	return builtinModule{
+		namespace: "history",
+		methods: map[string]interface{}{
+			"execute": func(name string, args json.RawMessage) (HistoryState, error) {
+				h := a.History()
+				h.mu.Lock()
+				factory, ok := h.factories[name]
+				h.mu.Unlock()
+				if !ok {
+					return HistoryState{}, gomerrors.NewOperationError("history.execute", name, gomerrors.ErrNotFound)
+				}
+				cmd, err := factory(args)
+				if err != nil {
+					return HistoryState{}, err
+				}
+				if err := h.Execute(cmd); err != nil {
+					return HistoryState{}, err
+				}
+				return h.State(), nil
+			},
+			"undo": func() (HistoryState, error) {
+				_, err := a.History().Undo()
+				return a.History().State(), err
+			},
+			"redo": func() (HistoryState, error) {
+				_, err := a.History().Redo()
+				return a.History().State(), err
+			},
+			"state": func() (HistoryState, error) {
+				return a.History().State(), nil
+			},
+			"clear": func() error {
+				a.History().Clear()
+				return nil
+			},
+		},
+		init: `
+(function() {
+    window.gomad.undo = () => window.gomad.history.undo();
+    window.gomad.redo = () => window.gomad.history.redo();
+})();
+`,
+	}
+}
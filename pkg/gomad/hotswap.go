@@ -0,0 +1,326 @@
+package gomad
+
+import (
+	"bufio"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/biyonik/gomad/internal/bridge"
+	"github.com/biyonik/gomad/internal/webview"
+)
+
+// ============================================================================
+// HOT-SWAP (gomad dev -hot)
+// Uygulama iki süreç olarak çalışır:
+//
+//	GOMAD_HOT_SHELL=<addr>   → Pencere süreci. Yalnızca yerleşik binding'leri
+//	                           bağlar ve addr'de backend bağlantısı bekler;
+//	                           diğer tüm çağrılar bağlı backend'e iletilir.
+//	GOMAD_HOT_BACKEND=<addr> → Backend süreci. Pencere açmadan (headless)
+//	                           kullanıcı binding'lerini çalıştırır, shell'e
+//	                           bağlanır ve Emit edilen olayları ona aktarır.
+//
+// Go kaynakları değişince "gomad dev" yalnızca backend'i yeniden derleyip
+// değiştirir; pencere, sayfa ve UI durumu korunur. Değişim sırasında gelen
+// çağrılar yeni backend bağlanana kadar (en fazla hotWait) bekletilir.
+// Frontend ready() çağırdıysa yeni backend'e de bildirilir; böylece
+// OnFrontendReady callback'leri başlangıç durumunu yeniden gönderebilir.
+//
+// Bağlantı, satır başına bir köprü mesajıdır (bridge.Message JSON'u):
+// shell → backend "call", backend → shell "result", "error" ve "event".
+//
+// Sınırlama: backend süreci pencereye sahip olmadığından backend kodundan
+// çağrılan pencere API'leri (SetTitle, dialog…) gerçek pencereyi etkilemez.
+// ============================================================================
+
+const (
+	hotShellEnv   = "GOMAD_HOT_SHELL"
+	hotBackendEnv = "GOMAD_HOT_BACKEND"
+
+	// hotWait, backend yokken bir çağrının yeni backend için beklediği süredir.
+	hotWait = 5 * time.Second
+
+	// hotDialTimeout, backend'in shell'e bağlanmak için denediği süredir.
+	hotDialTimeout = 10 * time.Second
+)
+
+// hotShellMode, uygulama hot-swap pencere süreci olarak çalışıyorsa true döner.
+func hotShellMode() bool {
+	return os.Getenv(hotShellEnv) != ""
+}
+
+// applyHotEnv, backend sürecini headless modda çalıştırır.
+func applyHotEnv(cfg *config) {
+	if os.Getenv(hotBackendEnv) != "" {
+		cfg.headless = true
+	}
+}
+
+// hotShell, pencere sürecinin backend bağlantısını yönetir.
+type hotShell struct {
+	app *Application
+	wv  webview.View
+
+	backend   *hotBackendConn
+	connected chan struct{} // Backend bağlıyken kapalıdır
+	ready     bool          // Frontend ready() çağırdı mı?
+	mu        sync.Mutex
+
+	seq atomic.Uint64
+}
+
+// hotBackendConn, bağlı bir backend sürecidir.
+type hotBackendConn struct {
+	conn    net.Conn
+	pending map[string]chan *bridge.Message
+	closed  bool
+	mu      sync.Mutex
+}
+
+// send, backend'e bir mesaj yazar.
+func (c *hotBackendConn) send(msg *bridge.Message) error {
+	data, err := msg.ToJSON()
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, err = c.conn.Write(append(data, '\n'))
+	return err
+}
+
+// startHotShell, GOMAD_HOT_SHELL ayarlıysa backend bağlantılarını kabul eder
+// ve yerelde olmayan çağrıları bağlı backend'e iletir.
+func (a *Application) startHotShell(wv webview.View) (stop func()) {
+	addr := os.Getenv(hotShellEnv)
+	if addr == "" {
+		return func() {}
+	}
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		a.Logger().Error("hot-swap: failed to listen", "addr", addr, "error", err)
+		return func() {}
+	}
+
+	s := &hotShell{app: a, wv: wv, connected: make(chan struct{})}
+	wv.Bridge().SetForwarder(s.forward)
+	wv.Bridge().OnFrontendReady(s.frontendReady)
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+
+	a.Logger().Info("hot-swap: waiting for backend", "addr", addr)
+	return func() {
+		wv.Bridge().SetForwarder(nil)
+		ln.Close()
+		s.mu.Lock()
+		if s.backend != nil {
+			s.backend.conn.Close()
+		}
+		s.mu.Unlock()
+	}
+}
+
+// serve, yeni bağlanan backend'i etkin yapar ve mesajlarını okur.
+func (s *hotShell) serve(conn net.Conn) {
+	b := &hotBackendConn{conn: conn, pending: make(map[string]chan *bridge.Message)}
+
+	s.mu.Lock()
+	old := s.backend
+	s.backend = b
+	close(s.connected)
+	ready := s.ready
+	s.mu.Unlock()
+	if old != nil {
+		old.conn.Close()
+	}
+	s.app.Logger().Info("hot-swap: backend connected")
+	if ready {
+		s.notifyReady(b)
+	}
+
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		msg, err := bridge.FromJSON(scanner.Bytes())
+		if err != nil {
+			s.app.Logger().Warn("hot-swap: invalid backend message", "error", err)
+			continue
+		}
+		switch msg.Type {
+		case bridge.MessageTypeEvent:
+			_ = s.wv.Emit(msg.Event, msg.Data)
+		case bridge.MessageTypeResult, bridge.MessageTypeError:
+			b.mu.Lock()
+			ch, ok := b.pending[msg.ID]
+			delete(b.pending, msg.ID)
+			b.mu.Unlock()
+			if ok {
+				ch <- msg
+			}
+		}
+	}
+
+	// Backend kapandı: bekleyen çağrıları hata ile sonlandır
+	b.mu.Lock()
+	b.closed = true
+	for id, ch := range b.pending {
+		ch <- bridge.NewErrorMessage(id, bridge.ErrCodeExecution, "backend disconnected", "the hot-swap backend exited during the call")
+		delete(b.pending, id)
+	}
+	b.mu.Unlock()
+
+	s.mu.Lock()
+	if s.backend == b {
+		s.backend = nil
+		s.connected = make(chan struct{})
+		s.app.Logger().Info("hot-swap: backend disconnected")
+	}
+	s.mu.Unlock()
+}
+
+// forward, çağrıyı etkin backend'e iletir; backend yoksa bağlanmasını bekler.
+func (s *hotShell) forward(msg *bridge.Message) *bridge.Message {
+	b := s.waitBackend()
+	if b == nil {
+		return bridge.NewErrorMessage(msg.ID, bridge.ErrCodeExecution, "backend unavailable",
+			"no hot-swap backend connected within "+hotWait.String())
+	}
+
+	id := "hot_" + strconv.FormatUint(s.seq.Add(1), 10)
+	ch := make(chan *bridge.Message, 1)
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return bridge.NewErrorMessage(msg.ID, bridge.ErrCodeExecution, "backend disconnected", "")
+	}
+	b.pending[id] = ch
+	b.mu.Unlock()
+
+	call := *msg
+	call.ID = id
+	if err := b.send(&call); err != nil {
+		b.mu.Lock()
+		delete(b.pending, id)
+		b.mu.Unlock()
+		return bridge.NewErrorMessage(msg.ID, bridge.ErrCodeExecution, "backend unavailable", err.Error())
+	}
+
+	resp := <-ch
+	resp.ID = msg.ID
+	return resp
+}
+
+// waitBackend, etkin backend'i döner; yoksa hotWait kadar bekler.
+func (s *hotShell) waitBackend() *hotBackendConn {
+	s.mu.Lock()
+	b, connected := s.backend, s.connected
+	s.mu.Unlock()
+	if b != nil {
+		return b
+	}
+
+	select {
+	case <-connected:
+	case <-time.After(hotWait):
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.backend
+}
+
+// frontendReady, sayfanın ready() çağrısını etkin backend'e iletir.
+func (s *hotShell) frontendReady() {
+	s.mu.Lock()
+	s.ready = true
+	b := s.backend
+	s.mu.Unlock()
+	if b != nil {
+		s.notifyReady(b)
+	}
+}
+
+// notifyReady, backend'in OnFrontendReady callback'lerini tetikler.
+// Cevabı beklenmez; bekleyen çağrılar arasında olmadığı için yok sayılır.
+func (s *hotShell) notifyReady(b *hotBackendConn) {
+	msg, err := bridge.NewCallMessage("hot_ready", bridge.ReadyBinding, []interface{}{})
+	if err == nil {
+		_ = b.send(msg)
+	}
+}
+
+// startHotBackend, GOMAD_HOT_BACKEND ayarlıysa shell'e bağlanır; shell'den
+// gelen çağrıları headless köprüde çalıştırır ve olayları shell'e aktarır.
+// Shell kapanırsa uygulama da kapanır.
+func (a *Application) startHotBackend(wv webview.View) (stop func()) {
+	addr := os.Getenv(hotBackendEnv)
+	h, ok := wv.(*webview.Headless)
+	if addr == "" || !ok {
+		return func() {}
+	}
+
+	conn, err := dialRetry(addr, hotDialTimeout)
+	if err != nil {
+		a.Logger().Error("hot-swap: failed to connect to shell", "addr", addr, "error", err)
+		a.Quit()
+		return func() {}
+	}
+
+	var writeMu sync.Mutex
+	write := func(data []byte) {
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		_, _ = conn.Write(append(data, '\n'))
+	}
+
+	cancelEvents := h.OnEvent(func(msg *bridge.Message) {
+		if data, err := msg.ToJSON(); err == nil {
+			write(data)
+		}
+	})
+
+	go func() {
+		scanner := bufio.NewScanner(conn)
+		scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+		for scanner.Scan() {
+			line := scanner.Text()
+			go func() {
+				if resp := h.Invoke(line); resp != "" {
+					write([]byte(resp))
+				}
+			}()
+		}
+		a.Logger().Info("hot-swap: shell closed, exiting backend")
+		a.Quit()
+	}()
+
+	a.Logger().Info("hot-swap: backend connected to shell", "addr", addr)
+	return func() {
+		cancelEvents()
+		conn.Close()
+	}
+}
+
+// dialRetry, adres bağlantı kabul edene kadar tekrar dener.
+func dialRetry(addr string, timeout time.Duration) (net.Conn, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		conn, err := net.Dial("tcp", addr)
+		if err == nil || time.Now().After(deadline) {
+			return conn, err
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+}
@@ -0,0 +1,144 @@
+package gomad
+
+import (
+	"github.com/biyonik/gomad/pkg/i18n"
+)
+
+// LocaleChangedEvent, SetLocale ile dil değiştiğinde gönderilen olaydır.
+// Verisi {locale} nesnesidir.
+const LocaleChangedEvent = "locale:changed"
+
+// Locale, uygulamanın etkin yerel ayarını döner. WithLocale verilmediyse
+// işletim sisteminin yerel ayarına en yakın çeviri paketi seçilir.
+func (a *Application) Locale() string {
+	a.localeMu.Lock()
+	defer a.localeMu.Unlock()
+	return a.localeLocked()
+}
+
+func (a *Application) localeLocked() string {
+	if a.locale == "" {
+		requested := a.config.locale
+		if requested == "" {
+			requested = i18n.Locale()
+		}
+		if b := a.config.translations; b != nil {
+			requested = b.Match(requested)
+		}
+		a.locale = requested
+	}
+	return a.locale
+}
+
+// SetLocale, etkin yerel ayarı değiştirir ve JS'e "locale:changed" olayı
+// gönderir; gomad.i18n.t yeni paketi yükler ve <html lang> güncellenir.
+// Çeviri paketinde olmayan bir yerel ayar en yakın pakete eşlenir.
+func (a *Application) SetLocale(locale string) error {
+	if b := a.config.translations; b != nil {
+		locale = b.Match(locale)
+	} else {
+		locale = i18n.Normalize(locale)
+	}
+
+	a.localeMu.Lock()
+	changed := a.localeLocked() != locale
+	a.locale = locale
+	a.localeMu.Unlock()
+	if !changed {
+		return nil
+	}
+
+	a.Logger().Info("locale changed", "locale", locale)
+	if a.view() != nil {
+		return a.Broadcast(LocaleChangedEvent, map[string]string{"locale": locale})
+	}
+	return nil
+}
+
+// T, key'in etkin yerel ayardaki çevirisini döner (bkz. WithTranslations).
+// args, {ad} yer tutucularının değerleridir; "count" çoğul biçimini seçer.
+// JS'teki gomad.i18n.t aynı paketi ve kuralları kullanır.
+//
+//	app.Notify(app.T("sync.done", map[string]any{"count": n}), "")
+func (a *Application) T(key string, args ...map[string]any) string {
+	b := a.config.translations
+	if b == nil {
+		return key
+	}
+	var m map[string]any
+	if len(args) > 0 {
+		m = args[0]
+	}
+	return b.T(a.Locale(), key, m)
+}
+
+// i18nModule, yerelleştirmenin JS API'sidir (window.gomad.i18n).
+//
+//	await gomad.i18n.ready;
+//	title.textContent = gomad.i18n.t("greeting", { name });
+//	await gomad.i18n.setLocale("de");
+//	gomad.on("locale:changed", ({ locale }) => rerender());
+func (a *Application) i18nModule() builtinModule {
+	return builtinModule{
+		namespace: "i18n",
+		methods: map[string]interface{}{
+			"locale": func() (string, error) {
+				return a.Locale(), nil
+			},
+			"setLocale": a.SetLocale,
+			"locales": func() ([]string, error) {
+				if b := a.config.translations; b != nil {
+					return b.Locales(), nil
+				}
+				return []string{}, nil
+			},
+			"messages": func(locale string) (map[string]i18n.Message, error) {
+				if b := a.config.translations; b != nil {
+					return b.Messages(locale), nil
+				}
+				return map[string]i18n.Message{}, nil
+			},
+		},
+		init: i18nJS,
+	}
+}
+
+// i18nJS, etkin paketin mesajlarını yükler ve gomad.i18n.t'yi senkron hale
+// getirir. Biçimlendirme kuralları i18n.Format ile aynıdır.
+const i18nJS = `
+(function() {
+    const i18n = window.gomad.i18n;
+    let messages = {};
+
+    const pluralForm = (table, count) => {
+        if (typeof count !== 'number') return 'other';
+        if (count === 0 && 'zero' in table) return 'zero';
+        if (count === 1 && 'one' in table) return 'one';
+        if (count === 2 && 'two' in table) return 'two';
+        return 'other';
+    };
+
+    i18n.current = null;
+    i18n.t = (key, args) => {
+        const msg = messages[key];
+        if (!msg) return key;
+        let text = msg.plural ? msg.plural[pluralForm(msg.plural, args && args.count)] : (msg.text || '');
+        if (!args) return text;
+        return text.replace(/\{([^{}]*)\}/g, (m, name) => (name in args ? String(args[name]) : m));
+    };
+
+    const load = (locale) =>
+        i18n.messages(locale).then((m) => {
+            messages = m || {};
+            i18n.current = locale;
+            document.documentElement.lang = locale;
+        });
+
+    i18n.ready = i18n.locale().then(load).catch((e) => console.error('[gomad] i18n load failed:', e));
+    window.gomad.on('locale:changed', ({ locale }) => {
+        i18n.ready = load(locale).then(() => {
+            window.dispatchEvent(new CustomEvent('gomad:localechange', { detail: { locale } }));
+        });
+    });
+})();
+`
@@ -0,0 +1,64 @@
+package gomad
+
+import (
+	"time"
+
+	"github.com/biyonik/gomad/pkg/idle"
+)
+
+// watchIdle, JS için tek bir hareketsizlik izleyicisi kurar; öncekini değiştirir.
+// Geçiş anlarında "idle:start" ve "idle:end" olayları ({threshold}) emit edilir.
+func (a *Application) watchIdle(seconds float64) error {
+	threshold := time.Duration(seconds * float64(time.Second))
+	payload := map[string]float64{"threshold": seconds}
+
+	cancel, err := idle.OnIdle(threshold,
+		func() { _ = a.Emit("idle:start", payload) },
+		func() { _ = a.Emit("idle:end", payload) },
+	)
+	if err != nil {
+		return err
+	}
+
+	a.idleMu.Lock()
+	previous := a.idleCancel
+	a.idleCancel = cancel
+	a.idleMu.Unlock()
+
+	if previous != nil {
+		previous()
+	}
+	return nil
+}
+
+// unwatchIdle, JS hareketsizlik izleyicisini durdurur.
+func (a *Application) unwatchIdle() {
+	a.idleMu.Lock()
+	cancel := a.idleCancel
+	a.idleCancel = nil
+	a.idleMu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+}
+
+// idleModule, hareketsizlik tespitinin JS API'sidir (window.gomad.idle).
+//
+//	const seconds = await gomad.idle.time();
+//	await gomad.idle.watch(300);
+//	gomad.on("idle:start", () => lockScreen());
+//	gomad.on("idle:end", () => resumeTimers());
+func (a *Application) idleModule() builtinModule {
+	return builtinModule{
+		namespace: "idle",
+		methods: map[string]interface{}{
+			"time": func() (float64, error) {
+				d, err := idle.Time()
+				return d.Seconds(), err
+			},
+			"watch":   a.watchIdle,
+			"unwatch": a.unwatchIdle,
+		},
+	}
+}
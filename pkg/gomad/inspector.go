@@ -0,0 +1,324 @@
+package gomad
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/biyonik/gomad/internal/bridge"
+	"github.com/biyonik/gomad/internal/webview"
+)
+
+// ============================================================================
+// Bridge inspector
+// Yalnızca debug modunda (WithDebug veya "gomad dev") sayfaya eklenen
+// geliştirici paneli. Ctrl+Shift+B (macOS: Cmd+Shift+B) ile açılır:
+//
+//   - Bindings: kayıtlı tüm binding'ler ve Go imzaları
+//   - Log: köprüden geçen mesajlar (çağrı, sonuç, hata, olay) ve çağrı süreleri
+//   - Invoke: bir binding'i JSON argümanlarla elle çağırma
+//
+// Panelin kendi çağrıları ve olayları loga yazılmaz.
+// ============================================================================
+
+const (
+	// inspectorNamespace, panelin yerleşik modül adıdır.
+	inspectorNamespace = "inspector"
+
+	// inspectorEvent, panel açıkken her mesaj için emit edilen olaydır.
+	inspectorEvent = "inspector:traffic"
+
+	// inspectorHistory, panel açıldığında gösterilen son mesaj sayısıdır.
+	inspectorHistory = 500
+
+	// inspectorMaxPayload, logda tam gösterilen en büyük içerik boyutudur.
+	inspectorMaxPayload = 8 * 1024
+)
+
+// inspectorEntry, paneldeki bir log satırıdır.
+type inspectorEntry struct {
+	Seq       int             `json:"seq"`
+	Time      time.Time       `json:"time"`
+	Direction string          `json:"direction"`
+	Type      string          `json:"type"`
+	ID        string          `json:"id,omitempty"`
+	Name      string          `json:"name,omitempty"` // Çağrılan metod veya olay adı
+	Payload   json.RawMessage `json:"payload,omitempty"`
+	Error     string          `json:"error,omitempty"`
+	Duration  float64         `json:"durationMs,omitempty"`
+}
+
+// inspectorState, panelin Go tarafındaki durumudur.
+type inspectorState struct {
+	history []inspectorEntry
+	seq     int
+	open    bool
+	live    chan inspectorEntry
+	mu      sync.Mutex
+}
+
+// startInspector, debug modunda köprü trafiğini panel için izlemeye başlar.
+func (a *Application) startInspector(wv webview.View) (stop func()) {
+	if !a.config.debug {
+		return func() {}
+	}
+
+	s := &a.inspector
+	s.live = make(chan inspectorEntry, 256)
+	done := make(chan struct{})
+
+	// Panelin kendi çağrılarını (ve cevaplarını) ayıklamak için kimlikler
+	var internal sync.Map
+	cancel := wv.Bridge().Observe(func(t bridge.Traffic) {
+		msg := t.Message
+		switch {
+		case strings.HasPrefix(msg.Method, builtinPrefix+inspectorNamespace+"."):
+			internal.Store(msg.ID, true)
+			return
+		case msg.Type == bridge.MessageTypeEvent && msg.Event == inspectorEvent:
+			return
+		case msg.Method == "" && msg.ID != "":
+			if _, ok := internal.LoadAndDelete(msg.ID); ok {
+				return
+			}
+		}
+		s.add(t)
+	})
+
+	// Olaylar gözlemcinin dışında gönderilir; Emit trafiği tekrar gözlemler
+	go func() {
+		for {
+			select {
+			case entry := <-s.live:
+				_ = a.Emit(inspectorEvent, entry)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		close(done)
+	}
+}
+
+// add, mesajı geçmişe ekler ve panel açıksa canlı olarak iletir.
+func (s *inspectorState) add(t bridge.Traffic) {
+	msg := t.Message
+	entry := inspectorEntry{
+		Time:      t.Time,
+		Direction: string(t.Direction),
+		Type:      string(msg.Type),
+		ID:        msg.ID,
+		Duration:  float64(t.Duration.Microseconds()) / 1000,
+	}
+	switch msg.Type {
+	case bridge.MessageTypeCall:
+		entry.Name, entry.Payload = msg.Method, msg.Args
+	case bridge.MessageTypeResult:
+		entry.Payload = msg.Result
+	case bridge.MessageTypeError:
+		if msg.Error != nil {
+			entry.Error = msg.Error.Message
+		}
+	case bridge.MessageTypeEvent:
+		entry.Name, entry.Payload = msg.Event, msg.Data
+	}
+	if len(entry.Payload) > inspectorMaxPayload {
+		entry.Payload, _ = json.Marshal("… " + strconv.Itoa(len(entry.Payload)) + " bytes")
+	}
+
+	s.mu.Lock()
+	s.seq++
+	entry.Seq = s.seq
+	s.history = append(s.history, entry)
+	if len(s.history) > inspectorHistory {
+		s.history = s.history[len(s.history)-inspectorHistory:]
+	}
+	open := s.open
+	s.mu.Unlock()
+
+	if open {
+		select {
+		case s.live <- entry:
+		default: // Panel yetişemiyorsa canlı akıştan düşer; geçmişte kalır
+		}
+	}
+}
+
+// inspectorModule, panelin JS API'sidir; yalnızca debug modunda kaydedilir.
+func (a *Application) inspectorModule() builtinModule {
+	s := &a.inspector
+	return builtinModule{
+		namespace: inspectorNamespace,
+		methods: map[string]interface{}{
+			"bindings": func() []bridge.BindingInfo {
+				wv := a.view()
+				if wv == nil {
+					return nil
+				}
+				// Framework'ün yerleşikleri en sonda listelenir
+				all := wv.Bridge().Describe()
+				user := make([]bridge.BindingInfo, 0, len(all))
+				var builtins []bridge.BindingInfo
+				for _, b := range all {
+					if strings.HasPrefix(b.Name, builtinPrefix) {
+						builtins = append(builtins, b)
+					} else {
+						user = append(user, b)
+					}
+				}
+				return append(user, builtins...)
+			},
+			"open": func() []inspectorEntry {
+				s.mu.Lock()
+				defer s.mu.Unlock()
+				s.open = true
+				return append([]inspectorEntry(nil), s.history...)
+			},
+			"close": func() {
+				s.mu.Lock()
+				s.open = false
+				s.mu.Unlock()
+			},
+		},
+		init: inspectorJS,
+	}
+}
+
+// inspectorJS, paneli Shadow DOM içinde kurar; sayfanın stilleri etkilenmez.
+const inspectorJS = `
+(function() {
+    let host = null, root = null, unsubscribe = null, entries = [];
+    const maxRows = 500;
+
+    const css = ` + "`" + `
+        :host { all: initial; }
+        .panel { position: fixed; right: 0; bottom: 0; width: min(720px, 100vw); height: 45vh;
+            background: #1e1e24; color: #ddd; font: 12px/1.4 ui-monospace, Menlo, Consolas, monospace;
+            border-top-left-radius: 6px; box-shadow: 0 0 16px rgba(0,0,0,.5); display: flex;
+            flex-direction: column; z-index: 2147483647; }
+        header { display: flex; gap: 4px; padding: 6px; border-bottom: 1px solid #333; align-items: center; }
+        header button { background: #2d2d35; color: #ddd; border: 0; padding: 4px 10px; border-radius: 3px; cursor: pointer; font: inherit; }
+        header button.active { background: #4c6ef5; color: #fff; }
+        header .spacer { flex: 1; }
+        section { flex: 1; overflow: auto; display: none; padding: 6px; }
+        section.active { display: block; }
+        table { width: 100%; border-collapse: collapse; }
+        td { padding: 2px 6px; border-bottom: 1px solid #2a2a30; vertical-align: top; white-space: nowrap; }
+        td.payload { white-space: pre-wrap; word-break: break-all; color: #9ab; }
+        .in { color: #8ce99a; } .out { color: #74c0fc; } .error { color: #ff8787; } .event { color: #ffd43b; }
+        .binding { cursor: pointer; } .binding:hover { background: #2a2a30; }
+        .sig { color: #888; }
+        textarea, input { width: 100%; box-sizing: border-box; background: #111; color: #ddd; border: 1px solid #333; font: inherit; padding: 4px; }
+        textarea { height: 70px; }
+        pre { white-space: pre-wrap; word-break: break-all; }
+    ` + "`" + `;
+
+    function el(tag, attrs, ...children) {
+        const node = document.createElement(tag);
+        Object.assign(node, attrs || {});
+        children.forEach(c => node.append(c));
+        return node;
+    }
+
+    function preview(value) {
+        if (value === undefined) return '';
+        const text = JSON.stringify(value);
+        return text && text.length > 300 ? text.slice(0, 300) + '…' : text;
+    }
+
+    function addRow(entry) {
+        const tbody = root.querySelector('#log tbody');
+        const cls = entry.type === 'error' ? 'error' : entry.type === 'event' ? 'event' : entry.direction;
+        const time = new Date(entry.time).toLocaleTimeString(undefined, { hour12: false }) +
+            '.' + String(new Date(entry.time).getMilliseconds()).padStart(3, '0');
+        const row = el('tr', {},
+            el('td', {}, time),
+            el('td', { className: cls }, (entry.direction === 'in' ? '→ ' : '← ') + entry.type),
+            el('td', {}, entry.name || entry.id || ''),
+            el('td', {}, entry.durationMs ? entry.durationMs.toFixed(2) + ' ms' : ''),
+            el('td', { className: 'payload' }, entry.error || preview(entry.payload)));
+        tbody.prepend(row);
+        while (tbody.children.length > maxRows) tbody.lastChild.remove();
+    }
+
+    async function loadBindings() {
+        const list = root.querySelector('#bindings');
+        list.replaceChildren();
+        for (const b of await window.gomad.inspector.bindings()) {
+            const row = el('div', { className: 'binding', title: 'Click to invoke' },
+                b.name + ' ', el('span', { className: 'sig' }, b.signature));
+            row.onclick = () => { root.querySelector('#method').value = b.name; show('invoke'); };
+            list.append(row);
+        }
+    }
+
+    async function invoke() {
+        const out = root.querySelector('#result');
+        const method = root.querySelector('#method').value.trim();
+        const started = performance.now();
+        try {
+            const raw = root.querySelector('#args').value.trim() || '[]';
+            const args = JSON.parse(raw);
+            const result = await window.gomad.call(method, ...(Array.isArray(args) ? args : [args]));
+            out.className = '';
+            out.textContent = JSON.stringify(result, null, 2) + '\n\n' + (performance.now() - started).toFixed(2) + ' ms';
+        } catch (e) {
+            out.className = 'error';
+            out.textContent = e.message + (e.code !== undefined ? ' (code ' + e.code + ')' : '');
+        }
+    }
+
+    function show(tab) {
+        root.querySelectorAll('header button[data-tab]').forEach(b => b.classList.toggle('active', b.dataset.tab === tab));
+        root.querySelectorAll('section').forEach(s => s.classList.toggle('active', s.id === tab || s.id === tab + '-tab'));
+        if (tab === 'bindings') loadBindings();
+    }
+
+    async function open() {
+        host = el('div', { id: 'gomad-inspector' });
+        root = host.attachShadow({ mode: 'open' });
+        const tab = (name, label) => { const b = el('button', { textContent: label }); b.dataset.tab = name; b.onclick = () => show(name); return b; };
+        const clear = el('button', { textContent: 'Clear', onclick: () => root.querySelector('#log tbody').replaceChildren() });
+        const closeBtn = el('button', { textContent: '✕', onclick: close });
+        root.append(el('style', { textContent: css }), el('div', { className: 'panel' },
+            el('header', {}, tab('log', 'Log'), tab('bindings', 'Bindings'), tab('invoke', 'Invoke'),
+                el('span', { className: 'spacer' }), clear, closeBtn),
+            el('section', { id: 'log' }, el('table', {}, el('tbody'))),
+            el('section', { id: 'bindings' }),
+            el('section', { id: 'invoke-tab' },
+                el('input', { id: 'method', placeholder: 'binding name' }),
+                el('textarea', { id: 'args', placeholder: 'arguments as JSON array, e.g. ["Ahmet", 42]' }),
+                el('button', { textContent: 'Invoke', onclick: invoke }),
+                el('pre', { id: 'result' }))));
+        root.querySelector('#invoke-tab button').style.cssText = 'margin: 6px 0; padding: 4px 12px;';
+        document.documentElement.append(host);
+        show('log');
+
+        unsubscribe = window.gomad.on('` + inspectorEvent + `', addRow);
+        entries = await window.gomad.inspector.open();
+        entries.forEach(addRow);
+    }
+
+    function close() {
+        if (!host) return;
+        window.gomad.inspector.close();
+        if (unsubscribe) unsubscribe();
+        host.remove();
+        host = root = unsubscribe = null;
+    }
+
+    window.gomad.inspector.toggle = () => host ? close() : open();
+
+    window.addEventListener('keydown', (e) => {
+        if ((e.ctrlKey || e.metaKey) && e.shiftKey && e.code === 'KeyB') {
+            e.preventDefault();
+            window.gomad.inspector.toggle();
+        }
+    }, true);
+})();
+`
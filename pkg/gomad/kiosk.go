@@ -0,0 +1,228 @@
+package gomad
+
+import (
+	"encoding/json"
+
+	"github.com/biyonik/gomad/internal/bridge"
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// ============================================================================
+// Kiosk modu
+// Satış noktası (POS) ve dijital tabela kurulumları için pencere kilitlenir
+// (bkz. WithKiosk):
+//
+//   - Pencere bulunduğu ekranı kaplar, çerçevesi ve menü çubuğu gizlenir ve
+//     diğer pencerelerin (görev çubuğu dahil) üstünde kalır.
+//   - Kapatma düğmesi ve Alt+F4 pencereyi kapatmaz.
+//   - Sayfa uygulamanın kendi origin'i ve WithAllowedOrigins dışındaki bir
+//     adrese gidemez; linkler ve window.open engellenir, dışarıya yönlenen
+//     sayfa geri döndürülür.
+//
+// Kiosktan çıkış Go'dan ExitKiosk ile ya da WithKioskExit ile tanımlanan
+// kısayolla yapılır; Quit her durumda uygulamayı kapatır. Alt+Tab, Win tuşu
+// ve Ctrl+Alt+Del gibi sistem kısayolları uygulama tarafından engellenemez;
+// tam kilitleme için işletim sisteminin kiosk özelliği (Windows Assigned
+// Access) kullanılmalıdır.
+// ============================================================================
+
+// kioskState, "kiosk:changed" olayının ve kiosk.state()'in verisidir.
+type kioskState struct {
+	Active bool `json:"active"`
+}
+
+// IsKiosk, uygulamanın kiosk modunda olup olmadığını döner.
+func (a *Application) IsKiosk() bool {
+	a.kioskMu.Lock()
+	defer a.kioskMu.Unlock()
+	return a.kioskActive
+}
+
+// EnterKiosk, uygulamayı kiosk moduna alır. WithKiosk ile başlatılmayan
+// uygulamalarda da kullanılabilir; herhangi bir goroutine'den çağrılabilir.
+func (a *Application) EnterKiosk() {
+	a.setKiosk(true)
+}
+
+// ExitKiosk, kiosk modundan çıkar: pencere önceki görünümüne döner,
+// kapatılabilir ve dış adreslere gidebilir hale gelir.
+func (a *Application) ExitKiosk() {
+	a.setKiosk(false)
+}
+
+// setKiosk, kiosk durumunu değiştirir ve pencereye uygular.
+func (a *Application) setKiosk(active bool) {
+	a.kioskMu.Lock()
+	changed := a.kioskActive != active
+	a.kioskActive = active
+	a.kioskMu.Unlock()
+	if !changed {
+		return
+	}
+
+	a.RunOnUIThread(a.applyKiosk)
+	a.Logger().Info("kiosk mode changed", "active", active)
+	_ = a.Broadcast("kiosk:changed", kioskState{Active: active})
+}
+
+// applyKiosk, güncel kiosk durumunu native pencereye uygular. UI thread'inde
+// çalışır.
+func (a *Application) applyKiosk() {
+	host, ok := a.nativeWindow().(platform.KioskHost)
+	if !ok {
+		a.Logger().Warn("kiosk window unavailable",
+			"error", gomerrors.NewWindowError("kiosk", "kiosk window", gomerrors.ErrNotSupported))
+		return
+	}
+	if err := host.SetKiosk(a.IsKiosk()); err != nil {
+		a.Logger().Warn("failed to apply kiosk mode", "error", err)
+	}
+}
+
+// closeRequested, native pencerenin kapatma isteğini karşılar. Kiosk
+// modunda kapanış engellenir; değilse WithOnCloseRequested'a sorulur.
+func (a *Application) closeRequested() bool {
+	if a.IsKiosk() {
+		return false
+	}
+	if a.config.onCloseRequested != nil {
+		return a.config.onCloseRequested()
+	}
+	return true
+}
+
+// requestKioskExit, kiosktan çıkış kısayoluna basıldığında çalışır.
+func (a *Application) requestKioskExit() error {
+	if a.config.kioskExit == "" {
+		return gomerrors.NewWindowError("kiosk", "exit shortcut", gomerrors.ErrPermissionDenied)
+	}
+	if a.config.kioskAllowExit != nil && !a.config.kioskAllowExit() {
+		return nil
+	}
+	a.ExitKiosk()
+	return nil
+}
+
+// kioskModule, kiosk modunun JS tarafıdır (window.gomad.kiosk). Gezinme
+// engeli ve çıkış kısayolu init scriptinde kurulur.
+//
+//	const { active } = await gomad.kiosk.state();
+//	gomad.on("kiosk:changed", ({ active }) => toolbar.hidden = active);
+func (a *Application) kioskModule() builtinModule {
+	origins := make([]string, 0, len(a.config.allowedOrigins)+1)
+	if a.config.url != "" {
+		origins = append(origins, bridge.NormalizeOrigin(a.config.url))
+	}
+	for _, o := range a.config.allowedOrigins {
+		if o == "*" {
+			origins = append(origins, o)
+		} else if n := bridge.NormalizeOrigin(o); n != "" {
+			origins = append(origins, n)
+		}
+	}
+
+	combo := ""
+	if a.config.kioskExit != "" {
+		accel, err := platform.ParseAccelerator(a.config.kioskExit)
+		if err != nil {
+			a.Logger().Warn("invalid kiosk exit shortcut", "shortcut", a.config.kioskExit, "error", err)
+		} else {
+			combo = accel.Combo()
+		}
+	}
+
+	initial, _ := json.Marshal(map[string]interface{}{
+		"active":  a.IsKiosk(),
+		"origins": origins,
+		"home":    a.config.url,
+		"exit":    combo,
+	})
+
+	return builtinModule{
+		namespace: "kiosk",
+		methods: map[string]interface{}{
+			"state": func() (kioskState, error) {
+				return kioskState{Active: a.IsKiosk()}, nil
+			},
+			"requestExit": a.requestKioskExit,
+		},
+		init: "(function() { const initial = " + string(initial) + ";\n" + kioskJS + "})();\n",
+	}
+}
+
+// kioskJS, kiosk modunda sayfanın uygulama dışına çıkmasını engeller ve
+// çıkış kısayolunu dinler. Script her sayfa yüklemesinde, sayfa
+// scriptlerinden önce çalışır; dış bir sayfaya ulaşılmışsa köprü o sayfada
+// çalışmadığından karar gömülü duruma göre verilir.
+const kioskJS = `
+    let active = initial.active;
+    const origins = initial.origins || [];
+    const allowed = (href) => {
+        let url;
+        try { url = new URL(href, location.href); } catch (e) { return false; }
+        // Sayfa içi adresler serbesttir; mailto:, file: ve özel şemalar
+        // başka uygulamaları açacağı için engellenir
+        if (['javascript:', 'blob:', 'about:'].includes(url.protocol)) return true;
+        if (url.protocol !== 'http:' && url.protocol !== 'https:') return false;
+        return origins.includes('*') || origins.includes(url.origin);
+    };
+    const goHome = () => {
+        if (initial.home) location.replace(initial.home);
+        else history.back();
+    };
+
+    if (active && (location.protocol === 'http:' || location.protocol === 'https:') && !allowed(location.href)) {
+        console.warn('[gomad] kiosk: leaving blocked page', location.href);
+        goHome();
+    }
+
+    window.gomad.kiosk.isActive = () => active;
+    window.gomad.on('kiosk:changed', (e) => { active = !!e.active; });
+
+    document.addEventListener('click', (e) => {
+        if (!active) return;
+        const link = e.target && e.target.closest && e.target.closest('a[href]');
+        if (link && !allowed(link.href)) {
+            e.preventDefault();
+            e.stopPropagation();
+            console.warn('[gomad] kiosk: blocked navigation to', link.href);
+        }
+    }, true);
+    document.addEventListener('submit', (e) => {
+        if (active && e.target && e.target.action && !allowed(e.target.action)) {
+            e.preventDefault();
+            console.warn('[gomad] kiosk: blocked form submission to', e.target.action);
+        }
+    }, true);
+    const open = window.open;
+    window.open = function(href, ...rest) {
+        if (active) {
+            console.warn('[gomad] kiosk: blocked window.open', href);
+            return null;
+        }
+        return open.call(window, href, ...rest);
+    };
+
+    if (initial.exit) {
+        const keyName = (code) => {
+            if (code.startsWith('Key')) return code.slice(3).toLowerCase();
+            if (code.startsWith('Digit')) return code.slice(5);
+            if (code.startsWith('Arrow')) return code.slice(5).toLowerCase();
+            return code.toLowerCase();
+        };
+        window.addEventListener('keydown', (e) => {
+            if (!active || e.repeat) return;
+            const parts = [];
+            if (e.ctrlKey) parts.push('ctrl');
+            if (e.altKey) parts.push('alt');
+            if (e.shiftKey) parts.push('shift');
+            if (e.metaKey) parts.push('meta');
+            parts.push(keyName(e.code));
+            if (parts.join('+') !== initial.exit) return;
+            e.preventDefault();
+            e.stopPropagation();
+            window.gomad.kiosk.requestExit().catch(() => {});
+        }, true);
+    }
+`
@@ -0,0 +1,250 @@
+package gomad
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// MenuItem, native menülerdeki (tray, menü çubuğu, sağ tık) tek bir öğedir.
+//
+// Örnek:
+//
+//	tray.SetMenu(
+//	    &gomad.MenuItem{Label: "Göster", OnClick: func(*gomad.MenuItem) { app.Show() }},
+//	    &gomad.MenuItem{Label: "Bildirimler", Checkable: true, Checked: true},
+//	    gomad.Separator(),
+//	    &gomad.MenuItem{Label: "Çıkış", OnClick: func(*gomad.MenuItem) { app.Quit() }},
+//	)
+//
+// JS tarafından gönderilen menülerde OnClick yoktur; tıklamalar ID ile olay
+// olarak bildirilir. Bu yüzden JS menülerinde ID verilmesi önerilir.
+type MenuItem struct {
+	// ID, tıklama olaylarında öğeyi tanımlar. Boşsa otomatik atanır.
+	ID string `json:"id,omitempty"`
+
+	// Label, görünen metindir. Role verilmişse boş bırakılabilir.
+	Label string `json:"label,omitempty"`
+
+	// Role, öğeye hazır bir davranış (Kes, Kopyala, Çık...) atar.
+	// Role'ün varsayılan etiketi ve kısayolu, alanlar boşsa kullanılır.
+	Role MenuRole `json:"role,omitempty"`
+
+	// Accelerator, klavye kısayoludur. Örn: "CmdOrCtrl+S", "Alt+F4", "F5".
+	// Menü çubuğundaki kısayollar pencere odaktayken tetiklenir.
+	Accelerator string `json:"accelerator,omitempty"`
+
+	// Separator true ise öğe bir ayırıcı çizgidir.
+	Separator bool `json:"separator,omitempty"`
+
+	// Checkable true ise öğe onay kutusu gibi davranır; her tıklamada
+	// Checked değeri otomatik olarak tersine çevrilir.
+	Checkable bool `json:"checkable,omitempty"`
+	Checked   bool `json:"checked,omitempty"`
+
+	// Disabled true ise öğe gri görünür ve tıklanamaz.
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Submenu, alt menü öğeleridir.
+	Submenu []*MenuItem `json:"submenu,omitempty"`
+
+	// OnClick, öğe seçildiğinde ayrı bir goroutine'de çağrılır.
+	OnClick func(item *MenuItem) `json:"-"`
+}
+
+// MenuRole, menü öğesine atanabilen hazır davranışlardır.
+type MenuRole string
+
+const (
+	RoleUndo      MenuRole = "undo"
+	RoleRedo      MenuRole = "redo"
+	RoleCut       MenuRole = "cut"
+	RoleCopy      MenuRole = "copy"
+	RolePaste     MenuRole = "paste"
+	RoleSelectAll MenuRole = "selectAll"
+	RoleReload    MenuRole = "reload"
+	RoleMinimize  MenuRole = "minimize"
+	RoleQuit      MenuRole = "quit"
+)
+
+// roleDefaults, her role'ün varsayılan etiketi ve kısayoludur.
+var roleDefaults = map[MenuRole]struct{ label, accelerator string }{
+	RoleUndo:      {"Undo", "CmdOrCtrl+Z"},
+	RoleRedo:      {"Redo", "CmdOrCtrl+Y"},
+	RoleCut:       {"Cut", "CmdOrCtrl+X"},
+	RoleCopy:      {"Copy", "CmdOrCtrl+C"},
+	RolePaste:     {"Paste", "CmdOrCtrl+V"},
+	RoleSelectAll: {"Select All", "CmdOrCtrl+A"},
+	RoleReload:    {"Reload", "CmdOrCtrl+R"},
+	RoleMinimize:  {"Minimize", "CmdOrCtrl+M"},
+	RoleQuit:      {"Quit", "CmdOrCtrl+Q"},
+}
+
+// isEditRole, düzenleme role'lerini ayırt eder. Bu role'lerin kısayollarını
+// WebView zaten işler; JS tarafında yakalanmamalıdır.
+func (r MenuRole) isEditRole() bool {
+	switch r {
+	case RoleUndo, RoleRedo, RoleCut, RoleCopy, RolePaste, RoleSelectAll:
+		return true
+	}
+	return false
+}
+
+// label, öğenin görünen etiketini döner (Role varsayılanı dahil).
+func (item *MenuItem) label() string {
+	if item.Label == "" {
+		return roleDefaults[item.Role].label
+	}
+	return item.Label
+}
+
+// accelerator, öğenin kısayolunu döner (Role varsayılanı dahil).
+func (item *MenuItem) accelerator() string {
+	if item.Accelerator == "" {
+		return roleDefaults[item.Role].accelerator
+	}
+	return item.Accelerator
+}
+
+// Separator, bir ayırıcı menü öğesi döner.
+func Separator() *MenuItem {
+	return &MenuItem{Separator: true}
+}
+
+// assignMenuIDs, ID'si boş olan öğelere ağaç içinde benzersiz ID atar.
+func assignMenuIDs(items []*MenuItem, prefix string) {
+	for i, item := range items {
+		if item == nil {
+			continue
+		}
+		if item.ID == "" {
+			item.ID = prefix + strconv.Itoa(i)
+		}
+		if len(item.Submenu) > 0 {
+			assignMenuIDs(item.Submenu, item.ID+".")
+		}
+	}
+}
+
+// validateMenu, role ve kısayolların geçerliliğini kontrol eder.
+func validateMenu(items []*MenuItem) error {
+	for _, item := range items {
+		if item == nil || item.Separator {
+			continue
+		}
+		if _, ok := roleDefaults[item.Role]; item.Role != "" && !ok {
+			return fmt.Errorf("menu item %q: unknown role %q", item.label(), item.Role)
+		}
+		if accel := item.accelerator(); accel != "" {
+			if _, err := platform.ParseAccelerator(accel); err != nil {
+				return fmt.Errorf("menu item %q: %w", item.label(), err)
+			}
+		}
+		if err := validateMenu(item.Submenu); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toPlatformMenu, public menü ağacını platform katmanının modeline çevirir.
+func toPlatformMenu(items []*MenuItem) []*platform.MenuItem {
+	if len(items) == 0 {
+		return nil
+	}
+	out := make([]*platform.MenuItem, 0, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		var display string
+		if accel, err := platform.ParseAccelerator(item.accelerator()); err == nil {
+			display = accel.String()
+		}
+		out = append(out, &platform.MenuItem{
+			ID:          item.ID,
+			Label:       item.label(),
+			Accelerator: display,
+			Separator:   item.Separator,
+			Checkable:   item.Checkable,
+			Checked:     item.Checked,
+			Disabled:    item.Disabled,
+			Submenu:     toPlatformMenu(item.Submenu),
+		})
+	}
+	return out
+}
+
+// findMenuItem, ID'ye göre öğeyi ağaç içinde arar.
+func findMenuItem(items []*MenuItem, id string) *MenuItem {
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		if item.ID == id {
+			return item
+		}
+		if found := findMenuItem(item.Submenu, id); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// findMenuAccelerator, kısayolu verilen combo'ya eşleşen etkin öğeyi arar.
+func findMenuAccelerator(items []*MenuItem, combo string) *MenuItem {
+	for _, item := range items {
+		if item == nil || item.Separator || item.Disabled {
+			continue
+		}
+		if accel, err := platform.ParseAccelerator(item.accelerator()); err == nil && accel.Combo() == combo {
+			return item
+		}
+		if found := findMenuAccelerator(item.Submenu, combo); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// menuAccelerators, JS tarafında yakalanacak kısayolları toplar.
+// Düzenleme role'leri hariç tutulur; onları WebView kendisi işler.
+func menuAccelerators(items []*MenuItem, out []string) []string {
+	for _, item := range items {
+		if item == nil || item.Separator {
+			continue
+		}
+		if !item.Role.isEditRole() {
+			if accel, err := platform.ParseAccelerator(item.accelerator()); err == nil {
+				out = append(out, accel.Combo())
+			}
+		}
+		out = menuAccelerators(item.Submenu, out)
+	}
+	return out
+}
+
+// activateMenuItem, seçilen öğenin role davranışını ve OnClick callback'ini çalıştırır.
+// Checkable öğelerin durumu çağırandan önce güncellenmiş olmalıdır.
+func (a *Application) activateMenuItem(item *MenuItem) {
+	switch item.Role {
+	case RoleUndo, RoleRedo, RoleCut, RoleCopy, RolePaste, RoleSelectAll:
+		cmd := string(item.Role)
+		_ = a.Eval(fmt.Sprintf("document.execCommand(%q)", cmd))
+	case RoleReload:
+		a.Reload()
+	case RoleMinimize:
+		a.RunOnUIThread(func() {
+			if win := a.nativeWindow(); win != nil {
+				win.Minimize()
+			}
+		})
+	case RoleQuit:
+		a.Quit()
+	}
+
+	if item.OnClick != nil {
+		a.Go(func() { item.OnClick(item) })
+	}
+}
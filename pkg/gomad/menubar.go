@@ -0,0 +1,219 @@
+package gomad
+
+import (
+	"encoding/json"
+	"fmt"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// SetMenuBar, ana pencerenin menü çubuğunu ayarlar. Öğesiz çağrı menüyü kaldırır.
+// Üst seviye öğeler açılır menü başlıklarıdır; tıklamalar Submenu öğelerinden gelir.
+//
+// Örnek:
+//
+//	app.SetMenuBar(
+//	    &gomad.MenuItem{Label: "File", Submenu: []*gomad.MenuItem{
+//	        {Label: "Save", Accelerator: "CmdOrCtrl+S", OnClick: save},
+//	        gomad.Separator(),
+//	        {Role: gomad.RoleQuit},
+//	    }},
+//	    &gomad.MenuItem{Label: "Edit", Submenu: []*gomad.MenuItem{
+//	        {Role: gomad.RoleCut}, {Role: gomad.RoleCopy}, {Role: gomad.RolePaste},
+//	    }},
+//	)
+//
+// Seçimler OnClick'e ve JS'e "menu:click" olayı ({id, checked, source}) olarak iletilir.
+// Kısayollar WebView üzerinden yakalandığı için native menü çubuğu olmayan
+// platformlarda da çalışır. Herhangi bir goroutine'den çağrılabilir; Run öncesi
+// çağrılırsa uygulama başladığında uygulanır. Geçersiz role/kısayolda hata döner.
+func (a *Application) SetMenuBar(items ...*MenuItem) error {
+	if err := validateMenu(items); err != nil {
+		return err
+	}
+	assignMenuIDs(items, "menu.")
+
+	a.menuMu.Lock()
+	a.menuBar = items
+	a.menuMu.Unlock()
+
+	a.RunOnUIThread(a.applyMenuBar)
+	a.syncAccelerators()
+	return nil
+}
+
+// PopupMenu, imleç konumunda bir context menü açar.
+// Seçim, OnClick'e ve JS'e "menu:click" olayı (source: "context") olarak iletilir.
+// Herhangi bir goroutine'den çağrılabilir; menü UI thread'inde açılır.
+func (a *Application) PopupMenu(items ...*MenuItem) error {
+	if err := validateMenu(items); err != nil {
+		return err
+	}
+	assignMenuIDs(items, "popup.")
+
+	a.RunOnUIThread(func() {
+		host, ok := a.nativeWindow().(platform.MenuHost)
+		if !ok {
+			a.Logger().Warn("context menu unavailable",
+				"error", gomerrors.NewWindowError("menu", "context menu", gomerrors.ErrNotSupported))
+			return
+		}
+
+		id, ok, err := host.PopupMenu(toPlatformMenu(items))
+		if err != nil {
+			a.Logger().Warn("context menu failed", "error", err)
+			return
+		}
+		if !ok {
+			return
+		}
+		if item := findMenuItem(items, id); item != nil {
+			if item.Checkable {
+				item.Checked = !item.Checked
+			}
+			a.selectMenuItem(item, "context")
+		}
+	})
+	return nil
+}
+
+// applyMenuBar, güncel menü çubuğunu native pencereye uygular. UI thread'inde çalışır.
+func (a *Application) applyMenuBar() {
+	a.menuMu.Lock()
+	items := a.menuBar
+	a.menuMu.Unlock()
+
+	win := a.nativeWindow()
+	host, ok := win.(platform.MenuHost)
+	if !ok {
+		// Kısayollar yine de JS üzerinden çalışır
+		if len(items) > 0 {
+			a.Logger().Debug("native menu bar unavailable",
+				"error", gomerrors.NewWindowError("menu", "menu bar", gomerrors.ErrNotSupported))
+		}
+		return
+	}
+	if err := host.SetMenuBar(toPlatformMenu(items), a.handleMenuBar); err != nil {
+		a.Logger().Warn("failed to set menu bar", "error", err)
+	}
+}
+
+// handleMenuBar, native menü çubuğundan gelen seçimi işler. UI thread'inde çağrılır.
+func (a *Application) handleMenuBar(id string) {
+	a.menuMu.Lock()
+	item := findMenuItem(a.menuBar, id)
+	toggled := item != nil && item.Checkable
+	if toggled {
+		item.Checked = !item.Checked
+	}
+	a.menuMu.Unlock()
+
+	if item == nil {
+		return
+	}
+	if toggled {
+		a.RunOnUIThread(a.applyMenuBar)
+	}
+	a.selectMenuItem(item, "menubar")
+}
+
+// handleAccelerator, JS tarafında yakalanan kısayolu menü çubuğu öğesine eşler.
+func (a *Application) handleAccelerator(combo string) bool {
+	a.menuMu.Lock()
+	item := findMenuAccelerator(a.menuBar, combo)
+	toggled := item != nil && item.Checkable
+	if toggled {
+		item.Checked = !item.Checked
+	}
+	a.menuMu.Unlock()
+
+	if item == nil {
+		return false
+	}
+	if toggled {
+		a.RunOnUIThread(a.applyMenuBar)
+	}
+	a.selectMenuItem(item, "accelerator")
+	return true
+}
+
+// selectMenuItem, seçimi role/OnClick'e ve JS'e iletir.
+func (a *Application) selectMenuItem(item *MenuItem, source string) {
+	a.activateMenuItem(item)
+	_ = a.Emit("menu:click", map[string]interface{}{
+		"id":      item.ID,
+		"checked": item.Checked,
+		"source":  source,
+	})
+}
+
+// acceleratorList, JS tarafında yakalanacak kısayolları döner.
+func (a *Application) acceleratorList() []string {
+	a.menuMu.Lock()
+	defer a.menuMu.Unlock()
+	return menuAccelerators(a.menuBar, []string{})
+}
+
+// syncAccelerators, menü çalışırken değiştiğinde JS'teki kısayol listesini günceller.
+// Sayfa yüklenirken liste zaten gomad.menu.accelerators() ile alınır.
+func (a *Application) syncAccelerators() {
+	if a.view() == nil {
+		return
+	}
+	list, err := json.Marshal(a.acceleratorList())
+	if err != nil {
+		return
+	}
+	_ = a.Eval(fmt.Sprintf("window.gomad.menu._setAccelerators(%s)", list))
+}
+
+// menuAcceleratorJS, menü kısayollarını keydown üzerinden yakalar.
+// Combo biçimi parseAccelerator ile aynıdır (bkz. accelerator).
+const menuAcceleratorJS = `
+(function() {
+    let accels = new Set();
+    window.gomad.menu._setAccelerators = (list) => { accels = new Set(list || []); };
+    window.gomad.menu.accelerators().then(window.gomad.menu._setAccelerators).catch(() => {});
+
+    const keyName = (code) => {
+        if (code.startsWith('Key')) return code.slice(3).toLowerCase();
+        if (code.startsWith('Digit')) return code.slice(5);
+        if (code.startsWith('Arrow')) return code.slice(5).toLowerCase();
+        return code.toLowerCase();
+    };
+
+    window.addEventListener('keydown', (e) => {
+        if (accels.size === 0 || e.repeat) return;
+        const parts = [];
+        if (e.ctrlKey) parts.push('ctrl');
+        if (e.altKey) parts.push('alt');
+        if (e.shiftKey) parts.push('shift');
+        if (e.metaKey) parts.push('meta');
+        parts.push(keyName(e.code));
+        const combo = parts.join('+');
+        if (!accels.has(combo)) return;
+        e.preventDefault();
+        e.stopPropagation();
+        window.gomad.menu.activate(combo);
+    }, true);
+})();
+`
+
+// menuModule, menülerin JS API'sidir (window.gomad.menu).
+//
+//	await gomad.menu.setMenuBar([{ label: "File", submenu: [{ id: "save", label: "Save", accelerator: "CmdOrCtrl+S" }] }]);
+//	await gomad.menu.popup([{ id: "copy", role: "copy" }, { id: "inspect", label: "Inspect" }]);
+//	gomad.on("menu:click", ({ id, checked, source }) => { ... });
+func (a *Application) menuModule() builtinModule {
+	return builtinModule{
+		namespace: "menu",
+		methods: map[string]interface{}{
+			"setMenuBar":   func(items []*MenuItem) error { return a.SetMenuBar(items...) },
+			"popup":        func(items []*MenuItem) error { return a.PopupMenu(items...) },
+			"accelerators": a.acceleratorList,
+			"activate":     a.handleAccelerator,
+		},
+		init: menuAcceleratorJS,
+	}
+}
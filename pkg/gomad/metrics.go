@@ -0,0 +1,80 @@
+package gomad
+
+import (
+	"expvar"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/biyonik/gomad/internal/bridge"
+	"github.com/biyonik/gomad/internal/webview"
+)
+
+// ============================================================================
+// METRİKLER
+// Köprü; metod başına çağrı/hata sayılarını ve süre histogramını, hata kodu
+// başına sayaçları, olay başına gönderim sayısını ve Eval gecikmesini tutar.
+// Bunlara üç yoldan ulaşılır:
+//
+//	app.Metrics()                         → Go'dan anlık görüntü
+//	await gomad.metrics()                 → Uygulama içi gösterge paneli
+//	gomad.WithMetricsEndpoint("127.0.0.1:9464")
+//	                                      → /metrics (Prometheus) ve
+//	                                        /debug/vars (expvar, "gomad")
+// ============================================================================
+
+// Metrics, köprü metriklerinin anlık görüntüsüdür (bkz. Application.Metrics).
+type Metrics = bridge.Metrics
+
+// MethodMetrics, tek bir binding'in çağrı, hata ve süre metrikleridir.
+type MethodMetrics = bridge.MethodMetrics
+
+// Histogram, süre dağılımıdır; Counts birikimlidir ve Buckets saniyedir.
+type Histogram = bridge.Histogram
+
+// Metrics, köprü metriklerinin anlık görüntüsünü döner.
+// Uygulama çalışmıyorsa boş değer döner. ResetBridgeStats bunları da sıfırlar.
+//
+// JS: const { methods, errorsByCode, events, evalLatency } = await gomad.metrics()
+func (a *Application) Metrics() Metrics {
+	if wv := a.view(); wv != nil {
+		return wv.Bridge().Metrics()
+	}
+	return Metrics{}
+}
+
+// publishExpvar, "gomad" expvar değişkenini bir kez yayınlar; expvar.Publish
+// aynı adla ikinci kez çağrılırsa panic eder.
+var publishExpvar sync.Once
+
+// startMetrics, WithMetricsEndpoint ile verilen adreste metrik sunucusunu
+// başlatır ve durdurma fonksiyonunu döner. Hot-swap backend sürecinde
+// çağrılar pencere sürecinden geçtiği için orada başlatılmaz.
+func (a *Application) startMetrics(wv webview.View) (stop func()) {
+	if a.config.metricsAddr == "" || os.Getenv(hotBackendEnv) != "" {
+		return func() {}
+	}
+
+	b := wv.Bridge()
+	publishExpvar.Do(func() {
+		expvar.Publish("gomad", expvar.Func(func() any { return a.Metrics() }))
+	})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_ = b.WritePrometheus(w)
+	})
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	ln, err := net.Listen("tcp", a.config.metricsAddr)
+	if err != nil {
+		a.Logger().Warn("metrics endpoint unavailable", "addr", a.config.metricsAddr, "error", err)
+		return func() {}
+	}
+	server := &http.Server{Handler: mux}
+	go server.Serve(ln)
+	a.Logger().Info("metrics endpoint listening", "url", "http://"+ln.Addr().String()+"/metrics")
+	return func() { server.Close() }
+}
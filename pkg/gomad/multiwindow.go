@@ -0,0 +1,821 @@
+package gomad
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/biyonik/gomad/internal/bridge"
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/webview"
+)
+
+// ============================================================================
+// İkincil pencereler ve ön ısıtılmış WebView havuzu
+// Ayarlar, önizleme gibi pencereler OpenWindow ile açılır. Her pencere kendi
+// WebView'ine ve köprüsüne (Bridge/Registry) sahiptir. Application.Bind ile
+// kaydedilen fonksiyonlar ve yerleşik modüller her pencereye dağıtılır;
+// Window.Bind ile kaydedilenler yalnızca o pencereden çağrılabilir. Olaylar
+// EmitTo ile tek bir pencereye, Broadcast ile tüm pencerelere gönderilir.
+//
+// Pencereler varsayılan olarak ana pencerenin oturumunu (çerezler, önbellek,
+// localStorage) paylaşır. WindowOptions.Partition ile adlandırılmış ayrı bir
+// depolama bölümü verilir: aynı bölümdeki pencereler oturum paylaşır,
+// farklı bölümdekiler birbirini göremez. Çoklu hesap desteği ve güvenilmeyen
+// gömülü içeriğin ana oturumdan yalıtılması için kullanılır.
+//
+// Bir WebView motorunun ayağa kalkması yüzlerce milisaniye sürer.
+// WithWebViewPool ile başlangıçtan kısa süre sonra gizli ve içeriksiz
+// WebView'ler hazırlanır; OpenWindow havuzdan bir tane alıp yalnızca sayfayı
+// yükler ve gösterir. Havuz, kullanılan WebView'in yerine arka planda yenisini
+// hazırlar. Havuzdaki WebView'ler ana pencerenin origin'ine bağlıdır; başka
+// bir origin'deki pencereler her zaman sıfırdan oluşturulur.
+// ============================================================================
+
+// MainWindow, EmitTo'da ana pencereyi belirten addır; ikincil pencerelere
+// verilemez.
+const MainWindow = "main"
+
+// poolWarmupDelay, havuzun başlangıçtan ve her kullanımdan sonra doldurulmadan
+// önce beklenen süredir; ana sayfanın yüklenmesi WebView oluşturmayla
+// yarışmaz.
+const poolWarmupDelay = time.Second
+
+// WindowOptions, OpenWindow ile açılacak pencerenin ayarlarıdır.
+type WindowOptions struct {
+	// Name, pencerenin benzersiz adıdır (ör. "settings"). Boşsa
+	// "window-1", "window-2" ... verilir.
+	Name string `json:"name,omitempty"`
+
+	// Title, pencere başlığıdır; boşsa uygulamanın başlığı kullanılır.
+	Title string `json:"title,omitempty"`
+
+	// Width ve Height pencere boyutudur; 0 ise ana pencereninki kullanılır.
+	Width  int `json:"width,omitempty"`
+	Height int `json:"height,omitempty"`
+
+	// URL, yüklenecek adrestir. "/", "#" veya "?" ile başlayan adresler
+	// uygulamanın URL'ine göre çözülür (ör. "#/settings").
+	URL string `json:"url,omitempty"`
+
+	// HTML, URL verilmediğinde yüklenecek içeriktir.
+	HTML string `json:"html,omitempty"`
+
+	// Partition, pencerenin depolama bölümünün adıdır (ör. "account-2");
+	// harf, rakam, "-", "_" ve "." içerebilir. Bölümün verisi UserData
+	// altında kalıcı tutulur. Boşsa ana pencerenin oturumu kullanılır. Ayrı
+	// bölüm desteklenmeyen platformlarda pencere açılmaz; ortak oturuma
+	// düşülmez.
+	Partition string `json:"partition,omitempty"`
+}
+
+// partitionDirName, depolama bölümlerinin UserData altındaki dizinidir.
+const partitionDirName = "partitions"
+
+// windowEvent, "window:opened" ve "window:closed" olaylarının verisidir.
+type windowEvent struct {
+	Name  string `json:"name"`
+	Error string `json:"error,omitempty"`
+}
+
+// Window, OpenWindow ile açılan ikincil penceredir. Metodları herhangi bir
+// goroutine'den çağrılabilir; pencere henüz oluşmadıysa işlemler sıraya
+// alınır.
+type Window struct {
+	app     *Application
+	name    string
+	opts    WindowOptions
+	dataDir string // Depolama bölümünün dizini; boşsa ortak oturum
+
+	mu      sync.Mutex
+	view    webview.View
+	pending []func(webview.View) // WebView oluşmadan gelen işlemler
+	closed  bool
+
+	// Yalnızca bu pencereye ait bind'ler (bkz. Window.Bind)
+	bindings  map[string]interface{}
+	bindOrder []string
+}
+
+// OpenWindow, yeni bir ikincil pencere açar. Pencere UI thread'inde
+// oluşturulur; Run'dan önce çağrılırsa uygulama başladığında açılır.
+// Oluşturma başarısız olursa hata loglanır ve "window:closed" olayı hata ile
+// gönderilir.
+//
+//	settings, err := app.OpenWindow(gomad.WindowOptions{
+//	    Name: "settings", Title: "Ayarlar", URL: "#/settings",
+//	    Width: 480, Height: 600,
+//	})
+func (a *Application) OpenWindow(opts WindowOptions) (*Window, error) {
+	if a.config.headless {
+		return nil, gomerrors.NewWindowError("open", "secondary window", gomerrors.ErrNotSupported)
+	}
+	if opts.URL != "" {
+		resolved, err := a.resolveWindowURL(opts.URL)
+		if err != nil {
+			return nil, err
+		}
+		opts.URL = resolved
+	} else if opts.HTML == "" {
+		return nil, gomerrors.NewWindowError("open", "window content (url or html)", gomerrors.ErrInvalidArgument)
+	}
+	if opts.Title == "" {
+		opts.Title = a.config.title
+	}
+	if opts.Width <= 0 || opts.Height <= 0 {
+		opts.Width, opts.Height = a.config.width, a.config.height
+	}
+	dataDir := ""
+	if opts.Partition != "" {
+		dir, err := a.partitionDir(opts.Partition)
+		if err != nil {
+			return nil, err
+		}
+		dataDir = dir
+	}
+
+	a.windowMu.Lock()
+	if opts.Name == "" {
+		for {
+			a.nextWindow++
+			opts.Name = fmt.Sprintf("window-%d", a.nextWindow)
+			if _, taken := a.windows[opts.Name]; !taken {
+				break
+			}
+		}
+	} else if _, taken := a.windows[opts.Name]; taken || opts.Name == MainWindow {
+		a.windowMu.Unlock()
+		return nil, gomerrors.NewWindowError("open", fmt.Sprintf("window %q", opts.Name), gomerrors.ErrAlreadyExists)
+	}
+	w := &Window{app: a, name: opts.Name, opts: opts, dataDir: dataDir}
+	if a.windows == nil {
+		a.windows = make(map[string]*Window)
+	}
+	a.windows[w.name] = w
+	a.windowMu.Unlock()
+
+	a.RunOnUIThread(func() { a.createWindow(w) })
+	return w, nil
+}
+
+// windowName, WebView'in ait olduğu pencerenin adını döner; ikincil
+// pencerelerden birine ait değilse MainWindow.
+func (a *Application) windowName(wv webview.View) string {
+	a.windowMu.Lock()
+	defer a.windowMu.Unlock()
+	for name, w := range a.windows {
+		w.mu.Lock()
+		match := w.view == wv
+		w.mu.Unlock()
+		if match {
+			return name
+		}
+	}
+	return MainWindow
+}
+
+// Window, adı verilen açık ikincil pencereyi döner.
+func (a *Application) Window(name string) (*Window, bool) {
+	a.windowMu.Lock()
+	defer a.windowMu.Unlock()
+	w, ok := a.windows[name]
+	return w, ok
+}
+
+// Windows, açık ikincil pencerelerin adlarını sıralı döner.
+func (a *Application) Windows() []string {
+	a.windowMu.Lock()
+	defer a.windowMu.Unlock()
+	names := make([]string, 0, len(a.windows))
+	for name := range a.windows {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// EmitTo, olayı yalnızca adı verilen pencereye gönderir; ana pencere için
+// MainWindow ya da boş ad kullanılır. Pencere bulunamazsa ErrNotFound döner.
+//
+//	app.EmitTo("settings", "settings:reload", nil)
+func (a *Application) EmitTo(window, event string, data interface{}) error {
+	if window == "" || window == MainWindow {
+		return a.Emit(event, data)
+	}
+	w, ok := a.Window(window)
+	if !ok {
+		return gomerrors.NewWindowError("emit", fmt.Sprintf("window %q", window), gomerrors.ErrNotFound)
+	}
+	return w.Emit(event, data)
+}
+
+// Broadcast, olayı ana pencereye ve tüm ikincil pencerelere gönderir (ör.
+// tema değişikliği). Ana pencereye gönderilemezse hatası döner; ikincil
+// pencerelerdeki hatalar loglanır.
+func (a *Application) Broadcast(event string, data interface{}) error {
+	a.windowMu.Lock()
+	windows := make([]*Window, 0, len(a.windows))
+	for _, w := range a.windows {
+		windows = append(windows, w)
+	}
+	a.windowMu.Unlock()
+
+	for _, w := range windows {
+		if err := w.Emit(event, data); err != nil {
+			a.Logger().Debug("broadcast skipped window", "name", w.name, "event", event, "error", err)
+		}
+	}
+	return a.Emit(event, data)
+}
+
+// BroadcastSticky, olayı Broadcast gibi tüm pencerelere gönderir ve son
+// değer olarak saklar (bkz. EmitSticky). Sonradan açılan pencereler de
+// saklanan son değeri alır; erişilebilirlik tercihleri ve bekleyen
+// güncelleme gibi uygulama geneli durumlar için kullanılır.
+//
+//	app.BroadcastSticky("auth:changed", user)
+func (a *Application) BroadcastSticky(event string, data interface{}) error {
+	a.windowMu.Lock()
+	if a.broadcastSticky == nil {
+		a.broadcastSticky = make(map[string]interface{})
+	}
+	if _, exists := a.broadcastSticky[event]; !exists {
+		a.stickyOrder = append(a.stickyOrder, event)
+	}
+	a.broadcastSticky[event] = data
+	windows := make([]*Window, 0, len(a.windows))
+	for _, w := range a.windows {
+		windows = append(windows, w)
+	}
+	a.windowMu.Unlock()
+
+	// Henüz oluşmayan pencereler değeri createWindow'da zaten alır; sıraya
+	// alınan gönderim aynı değeri tekrar saklar
+	for _, w := range windows {
+		if err := w.EmitSticky(event, data); err != nil {
+			a.Logger().Debug("broadcast skipped window", "name", w.name, "event", event, "error", err)
+		}
+	}
+	return a.EmitSticky(event, data)
+}
+
+// replayBroadcastSticky, BroadcastSticky ile saklanan son değerleri yeni
+// açılan pencerenin köprüsüne ilk gönderildikleri sırayla aktarır; sayfa
+// yüklendiğinde köprü bunları gönderir. UI thread'inde çalışır.
+func (a *Application) replayBroadcastSticky(wv webview.View, name string) {
+	a.windowMu.Lock()
+	events := append([]string(nil), a.stickyOrder...)
+	values := make([]interface{}, len(events))
+	for i, event := range events {
+		values[i] = a.broadcastSticky[event]
+	}
+	a.windowMu.Unlock()
+
+	for i, event := range events {
+		if err := wv.Bridge().EmitSticky(event, values[i]); err != nil {
+			a.Logger().Debug("failed to replay sticky event", "name", name, "event", event, "error", err)
+		}
+	}
+}
+
+// validStorageName, depolama bölümü ve profil adlarını doğrular: harf,
+// rakam, "-", "_" ve "."; dizin adı olarak güvenli olmalıdır.
+func validStorageName(name string) bool {
+	if name == "" || name == "." || name == ".." {
+		return false
+	}
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.') {
+			return false
+		}
+	}
+	return true
+}
+
+// partitionDir, depolama bölümünün dizinini döner ve yoksa oluşturur.
+func (a *Application) partitionDir(name string) (string, error) {
+	if !validStorageName(name) {
+		return "", gomerrors.NewWindowError("open", fmt.Sprintf("partition name %q", name), gomerrors.ErrInvalidArgument)
+	}
+	base, err := a.Paths().UserData()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(base, partitionDirName, name)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create partition %q: %w", name, err)
+	}
+	return dir, nil
+}
+
+// resolveWindowURL, göreli pencere adreslerini uygulamanın URL'ine göre
+// çözer.
+func (a *Application) resolveWindowURL(raw string) (string, error) {
+	if !strings.HasPrefix(raw, "/") && !strings.HasPrefix(raw, "#") && !strings.HasPrefix(raw, "?") {
+		return raw, nil
+	}
+	base, err := url.Parse(a.config.url)
+	if a.config.url == "" || err != nil {
+		return "", gomerrors.NewWindowError("open", fmt.Sprintf("relative url %q without application url", raw), gomerrors.ErrInvalidArgument)
+	}
+	ref, err := url.Parse(raw)
+	if err != nil {
+		return "", gomerrors.NewWindowError("open", fmt.Sprintf("url %q", raw), gomerrors.ErrInvalidArgument)
+	}
+	return base.ResolveReference(ref).String(), nil
+}
+
+// createWindow, pencerenin WebView'ini havuzdan alır ya da oluşturur. UI
+// thread'inde çalışır.
+func (a *Application) createWindow(w *Window) {
+	w.mu.Lock()
+	closed := w.closed
+	w.mu.Unlock()
+	if closed {
+		return
+	}
+
+	// Havuzdaki WebView'ler ana pencerenin (profilin) oturumundadır
+	var wv webview.View
+	pooled := false
+	if w.dataDir == "" {
+		wv, pooled = a.takePooledView(bridge.NormalizeOrigin(w.opts.URL))
+	}
+	if pooled {
+		wv.SetTitle(w.opts.Title)
+		wv.SetSize(w.opts.Width, w.opts.Height, 0)
+		if w.opts.URL != "" {
+			wv.Navigate(w.opts.URL)
+		} else {
+			wv.SetHTML(w.opts.HTML)
+		}
+		if native := wv.NativeWindow(); native != nil {
+			native.Show()
+		}
+	} else {
+		opts := a.viewOptions()
+		opts.Title = w.opts.Title
+		opts.Width, opts.Height = w.opts.Width, w.opts.Height
+		opts.URL, opts.HTML = w.opts.URL, w.opts.HTML
+		if w.dataDir != "" {
+			opts.DataDir = w.dataDir
+		}
+		created, err := a.newSecondaryView(opts)
+		if err != nil {
+			a.Logger().Warn("failed to open window", "name", w.name, "error", err)
+			a.forgetWindow(w)
+			_ = a.Emit("window:closed", windowEvent{Name: w.name, Error: err.Error()})
+			return
+		}
+		wv = created
+	}
+
+	// Kapatma düğmesi yalnızca bu pencereyi kapatır
+	_ = wv.OnCloseRequested(func() bool {
+		w.Close()
+		return false
+	})
+
+	w.mu.Lock()
+	w.view = wv
+	pending := w.pending
+	w.pending = nil
+	w.mu.Unlock()
+	if err := a.syncBindings(wv, w); err != nil {
+		a.Logger().Warn("failed to bind window functions", "name", w.name, "error", err)
+	}
+	a.watchNavigation(wv, w.name)
+	a.replayBroadcastSticky(wv, w.name)
+	for _, fn := range pending {
+		fn(wv)
+	}
+
+	a.Logger().Debug("window opened", "name", w.name, "pooled", pooled)
+	_ = a.Emit("window:opened", windowEvent{Name: w.name})
+}
+
+// newSecondaryView, ana pencereyle aynı köprü ayarlarına, yerleşik modüllere
+// ve bind'lere sahip bir WebView oluşturur. UI thread'inde çalışır.
+func (a *Application) newSecondaryView(opts webview.Options) (webview.View, error) {
+	wv, err := webview.New(opts)
+	if err != nil {
+		return nil, err
+	}
+	if a.config.logRedaction != nil {
+		wv.Bridge().SetLogRedaction(*a.config.logRedaction)
+	}
+	wv.Bridge().SetSlowCallThreshold(a.config.slowCallThreshold)
+	wv.Bridge().SetErrorPolicy(a.errorPolicy())
+	wv.Bridge().SetJSONEncoding(a.config.jsonEncoding)
+	wv.Bridge().SetContext(a.ctx)
+	wv.Bridge().OnPanic(func(method string, perr *gomerrors.PanicError) {
+		a.writeCrashReport(perr)
+	})
+	if err := a.registerBuiltins(wv); err != nil {
+		wv.Destroy()
+		return nil, err
+	}
+	if err := a.syncBindings(wv, nil); err != nil {
+		wv.Destroy()
+		return nil, err
+	}
+	return wv, nil
+}
+
+// syncBindings, uygulama bind'lerinden ve (w nil değilse) pencereye ait
+// bind'lerden WebView'e henüz bağlanmamış olanları bağlar. Havuzdan alınan
+// WebView'ler oluşturulduktan sonra eklenen bind'leri böylece alır. UI
+// thread'inde çalışır.
+func (a *Application) syncBindings(wv webview.View, w *Window) error {
+	if hotShellMode() {
+		return nil // Hot-swap: binding'ler backend sürecinde çalışır
+	}
+	a.mu.RLock()
+	names := append([]string(nil), a.bindOrder...)
+	fns := make([]interface{}, len(names))
+	for i, name := range names {
+		fns[i] = a.bindings[name]
+	}
+	a.mu.RUnlock()
+	if w != nil {
+		w.mu.Lock()
+		for _, name := range w.bindOrder {
+			names = append(names, name)
+			fns = append(fns, w.bindings[name])
+		}
+		w.mu.Unlock()
+	}
+
+	for i, name := range names {
+		if wv.Bridge().IsBound(name) {
+			continue
+		}
+		if err := wv.BindFunc(name, fns[i]); err != nil {
+			return fmt.Errorf("failed to bind %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// bindSecondaryViews, Run sonrasında Application.Bind ile eklenen fonksiyonu
+// açık pencerelere ve havuzdaki WebView'lere dağıtır.
+func (a *Application) bindSecondaryViews(name string, fn interface{}) {
+	a.windowMu.Lock()
+	windows := make([]*Window, 0, len(a.windows))
+	for _, w := range a.windows {
+		windows = append(windows, w)
+	}
+	pool := append([]webview.View(nil), a.viewPool...)
+	a.windowMu.Unlock()
+
+	bind := func(wv webview.View) {
+		if wv.Bridge().IsBound(name) {
+			return
+		}
+		if err := wv.BindFunc(name, fn); err != nil {
+			a.Logger().Warn("failed to bind function to window", "binding", name, "error", err)
+		}
+	}
+	for _, w := range windows {
+		w.mu.Lock()
+		ready := w.view != nil
+		w.mu.Unlock()
+		if ready {
+			// Henüz oluşmayan pencereler bind'i syncBindings ile alır
+			_ = w.do(bind)
+		}
+	}
+	for _, wv := range pool {
+		wv := wv
+		a.RunOnUIThread(func() { bind(wv) })
+	}
+}
+
+// forgetWindow, pencereyi açık pencereler listesinden çıkarır.
+func (a *Application) forgetWindow(w *Window) {
+	a.windowMu.Lock()
+	if a.windows[w.name] == w {
+		delete(a.windows, w.name)
+	}
+	a.windowMu.Unlock()
+}
+
+// Name, pencerenin adını döner.
+func (w *Window) Name() string {
+	return w.name
+}
+
+// Bind, yalnızca bu pencereden çağrılabilen bir Go fonksiyonu kaydeder;
+// imza kuralları Application.Bind ile aynıdır. Ana pencere ve diğer
+// pencereler bu fonksiyonu göremez. Uygulama genelindeki bir bind ile aynı
+// adı taşıyamaz.
+//
+//	settings.Bind("settings.save", func(s Settings) error { ... })
+func (w *Window) Bind(name string, fn interface{}) error {
+	w.app.mu.RLock()
+	_, global := w.app.bindings[name]
+	w.app.mu.RUnlock()
+
+	w.mu.Lock()
+	if _, exists := w.bindings[name]; exists || global {
+		w.mu.Unlock()
+		return fmt.Errorf("binding %q already registered", name)
+	}
+	if w.closed {
+		w.mu.Unlock()
+		return gomerrors.NewWindowError("bind", fmt.Sprintf("window %q", w.name), gomerrors.ErrClosed)
+	}
+	if w.bindings == nil {
+		w.bindings = make(map[string]interface{})
+	}
+	w.bindings[name] = fn
+	w.bindOrder = append(w.bindOrder, name)
+	ready := w.view != nil
+	w.mu.Unlock()
+
+	if !ready {
+		return nil // WebView oluşunca syncBindings bağlar
+	}
+	return w.do(func(wv webview.View) {
+		if wv.Bridge().IsBound(name) {
+			return
+		}
+		if err := wv.BindFunc(name, fn); err != nil {
+			w.app.Logger().Warn("failed to bind window function", "name", w.name, "binding", name, "error", err)
+		}
+	})
+}
+
+// Show, pencereyi gösterir.
+func (w *Window) Show() {
+	w.do(func(wv webview.View) {
+		if native := wv.NativeWindow(); native != nil {
+			native.Show()
+		}
+	})
+}
+
+// Hide, pencereyi kapatmadan gizler.
+func (w *Window) Hide() {
+	w.do(func(wv webview.View) {
+		if native := wv.NativeWindow(); native != nil {
+			native.Hide()
+		}
+	})
+}
+
+// SetTitle, pencere başlığını değiştirir.
+func (w *Window) SetTitle(title string) {
+	w.do(func(wv webview.View) { wv.SetTitle(title) })
+}
+
+// Navigate, pencerede verilen adrese gider. Göreli adresler uygulamanın
+// URL'ine göre çözülür.
+func (w *Window) Navigate(raw string) error {
+	resolved, err := w.app.resolveWindowURL(raw)
+	if err != nil {
+		return err
+	}
+	return w.do(func(wv webview.View) { wv.Navigate(resolved) })
+}
+
+// Reload, penceredeki sayfayı yeniler.
+func (w *Window) Reload() {
+	w.do(func(wv webview.View) { wv.Reload() })
+}
+
+// ReloadIgnoringCache, penceredeki sayfayı HTTP önbelleğini atlayarak
+// yeniler.
+func (w *Window) ReloadIgnoringCache() {
+	w.do(func(wv webview.View) { wv.ReloadIgnoringCache() })
+}
+
+// Eval, pencerede JavaScript kodu çalıştırır.
+func (w *Window) Eval(js string) error {
+	return w.do(func(wv webview.View) {
+		if err := wv.Eval(js); err != nil {
+			w.app.Logger().Warn("window eval failed", "name", w.name, "error", err)
+		}
+	})
+}
+
+// Emit, yalnızca bu pencerenin JS tarafına olay gönderir.
+func (w *Window) Emit(event string, data interface{}) error {
+	return w.do(func(wv webview.View) {
+		if err := wv.Emit(event, data); err != nil {
+			w.app.Logger().Warn("window emit failed", "name", w.name, "event", event, "error", err)
+		}
+	})
+}
+
+// EmitSticky, olayı bu pencereye gönderir ve son değer olarak saklar (bkz.
+// Application.EmitSticky).
+func (w *Window) EmitSticky(event string, data interface{}) error {
+	return w.do(func(wv webview.View) {
+		if err := wv.Bridge().EmitSticky(event, data); err != nil {
+			w.app.Logger().Warn("window emit failed", "name", w.name, "event", event, "error", err)
+		}
+	})
+}
+
+// Close, pencereyi kapatır ve WebView'ini serbest bırakır. Ana pencereye
+// "window:closed" olayı gönderilir. Birden fazla kez çağrılabilir.
+func (w *Window) Close() {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return
+	}
+	w.closed = true
+	wv := w.view
+	w.pending = nil
+	w.mu.Unlock()
+
+	w.app.forgetWindow(w)
+	if wv != nil {
+		w.app.RunOnUIThread(wv.Destroy)
+	}
+	w.app.Logger().Debug("window closed", "name", w.name)
+	_ = w.app.Emit("window:closed", windowEvent{Name: w.name})
+}
+
+// do, fn'i pencerenin WebView'iyle UI thread'inde çalıştırır. WebView henüz
+// oluşmadıysa fn sıraya alınır; pencere kapandıysa ErrClosed döner.
+func (w *Window) do(fn func(wv webview.View)) error {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return gomerrors.NewWindowError("window", fmt.Sprintf("window %q", w.name), gomerrors.ErrClosed)
+	}
+	wv := w.view
+	if wv == nil {
+		w.pending = append(w.pending, fn)
+		w.mu.Unlock()
+		return nil
+	}
+	w.mu.Unlock()
+
+	w.app.RunOnUIThread(func() {
+		// Close'un kuyruğa aldığı Destroy bu işlemden sonra çalışır
+		w.mu.Lock()
+		closed := w.closed
+		w.mu.Unlock()
+		if !closed {
+			fn(wv)
+		}
+	})
+	return nil
+}
+
+// ==================== WebView havuzu ====================
+
+// startViewPool, havuzu başlangıçtan kısa süre sonra doldurmaya başlar.
+func (a *Application) startViewPool() {
+	if a.config.viewPool <= 0 || a.config.headless {
+		return
+	}
+	time.AfterFunc(poolWarmupDelay, func() { a.RunOnUIThread(a.fillViewPool) })
+}
+
+// fillViewPool, havuza bir WebView ekler ve eksik kaldıysa bir sonrakini
+// kuyruğa alır; oluşturmalar arasında UI thread'i diğer işlere döner.
+// UI thread'inde çalışır.
+func (a *Application) fillViewPool() {
+	if a.view() == nil {
+		return // uygulama kapanıyor
+	}
+	a.windowMu.Lock()
+	need := !a.poolDisabled && len(a.viewPool) < a.config.viewPool
+	a.windowMu.Unlock()
+	if !need {
+		return
+	}
+
+	opts := a.viewOptions()
+	opts.Preload = true
+	wv, err := a.newSecondaryView(opts)
+	if err != nil {
+		if errors.Is(err, gomerrors.ErrNotSupported) {
+			a.windowMu.Lock()
+			a.poolDisabled = true
+			a.windowMu.Unlock()
+			a.Logger().Debug("webview pool disabled", "error", err)
+			return
+		}
+		a.Logger().Warn("failed to preload webview", "error", err)
+		return
+	}
+
+	a.windowMu.Lock()
+	a.viewPool = append(a.viewPool, wv)
+	more := len(a.viewPool) < a.config.viewPool
+	a.windowMu.Unlock()
+	a.Logger().Debug("webview preloaded", "pool", len(a.viewPool))
+	if more {
+		a.RunOnUIThread(a.fillViewPool)
+	}
+}
+
+// takePooledView, origin'e uyan hazır bir WebView'i havuzdan çıkarır ve
+// havuzun yeniden doldurulmasını planlar. UI thread'inde çalışır.
+func (a *Application) takePooledView(origin string) (webview.View, bool) {
+	if origin != bridge.NormalizeOrigin(a.config.url) {
+		return nil, false
+	}
+	a.windowMu.Lock()
+	if len(a.viewPool) == 0 {
+		a.windowMu.Unlock()
+		return nil, false
+	}
+	wv := a.viewPool[0]
+	a.viewPool = a.viewPool[1:]
+	a.windowMu.Unlock()
+
+	time.AfterFunc(poolWarmupDelay, func() { a.RunOnUIThread(a.fillViewPool) })
+	return wv, true
+}
+
+// mainCloseRequested, ana pencerenin kapatma isteğini karşılar. Ana pencere
+// kapanınca uygulama kapanır: pencere hemen yok edilmez, düzgün kapanış
+// başlatılır (bkz. beginShutdown) ve döngü kapanış bitince durdurulur. Açık
+// ikincil pencereler ve gizli havuz pencereleri döngüyü ayakta tutamaz.
+func (a *Application) mainCloseRequested() bool {
+	if !a.closeRequested() {
+		return false
+	}
+	a.beginShutdown()
+	return false
+}
+
+// destroySecondaryViews, ikincil pencereleri ve havuzu kapatır. Olay döngüsü
+// durduktan sonra, ana WebView'den önce çağrılır.
+func (a *Application) destroySecondaryViews() {
+	a.windowMu.Lock()
+	pool := a.viewPool
+	a.viewPool = nil
+	windows := make([]*Window, 0, len(a.windows))
+	for _, w := range a.windows {
+		windows = append(windows, w)
+	}
+	a.windows = nil
+	a.windowMu.Unlock()
+
+	for _, wv := range pool {
+		wv.Destroy()
+	}
+	for _, w := range windows {
+		w.mu.Lock()
+		w.closed = true
+		wv := w.view
+		w.mu.Unlock()
+		if wv != nil {
+			wv.Destroy()
+		}
+	}
+}
+
+// windowsModule, ikincil pencerelerin JS tarafıdır (window.gomad.windows).
+//
+//	const name = await gomad.windows.open({ name: "settings", url: "#/settings" });
+//	await gomad.windows.emitTo("main", "settings:saved", { theme });
+//	await gomad.windows.broadcast("theme:changed", { theme });
+//	await gomad.windows.close(name);
+//	gomad.on("window:closed", ({ name }) => ...);
+func (a *Application) windowsModule() builtinModule {
+	return builtinModule{
+		namespace: "windows",
+		methods: map[string]interface{}{
+			"open": func(opts WindowOptions) (string, error) {
+				w, err := a.OpenWindow(opts)
+				if err != nil {
+					return "", err
+				}
+				return w.Name(), nil
+			},
+			"close": func(name string) error {
+				w, ok := a.Window(name)
+				if !ok {
+					return gomerrors.NewWindowError("close", fmt.Sprintf("window %q", name), gomerrors.ErrNotFound)
+				}
+				w.Close()
+				return nil
+			},
+			"list": func() ([]string, error) {
+				return a.Windows(), nil
+			},
+			"emitTo": func(window, event string, data json.RawMessage) error {
+				return a.EmitTo(window, event, data)
+			},
+			"broadcast": func(event string, data json.RawMessage) error {
+				return a.Broadcast(event, data)
+			},
+		},
+	}
+}
@@ -0,0 +1,13 @@
+//go:build !windows
+
+package gomad
+
+import (
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// newNativeTray, tray desteği henüz olmayan platformlarda ErrNotSupported döner.
+func newNativeTray() (platform.Tray, error) {
+	return nil, gomerrors.NewWindowError("tray", "system tray", gomerrors.ErrNotSupported)
+}
@@ -0,0 +1,17 @@
+//go:build windows
+
+package gomad
+
+import (
+	"github.com/biyonik/gomad/internal/platform"
+	"github.com/biyonik/gomad/internal/platform/windows"
+)
+
+// newNativeTray, bildirim alanında yeni bir ikon oluşturur. UI thread'inde çağrılmalıdır.
+func newNativeTray() (platform.Tray, error) {
+	tray, err := windows.NewTray()
+	if err != nil {
+		return nil, err
+	}
+	return tray, nil
+}
@@ -0,0 +1,105 @@
+package gomad
+
+import (
+	"github.com/biyonik/gomad/internal/bridge"
+	"github.com/biyonik/gomad/internal/webview"
+)
+
+// ============================================================================
+// Gezinme geçmişi
+// Gezilebilir içerik gösteren pencereler (yardım, doküman görüntüleyici)
+// kendi geri/ileri düğmelerini çizebilir. Pencerelerin konumu her değiştiğinde
+// tüm pencerelere "navigation:changed" olayı gönderilir:
+//
+//	gomad.on("navigation:changed", (s) => {
+//	    if (s.window !== "main") return;
+//	    backButton.disabled = !s.canGoBack;
+//	    forwardButton.disabled = !s.canGoForward;
+//	});
+//	backButton.onclick = () => gomad.window.goBack();
+//
+// Konum sayfanın kendisinden öğrenilir; origin doğrulamasından geçmeyen
+// sayfalar durumu güncelleyemez (bkz. bridge.NavigationScript).
+// ============================================================================
+
+// NavigationState, bir pencerenin gezinme geçmişindeki konumudur;
+// "navigation:changed" olayının verisidir.
+type NavigationState struct {
+	// Window, pencerenin adıdır; ana pencere için MainWindow.
+	Window       string `json:"window"`
+	URL          string `json:"url"`
+	Title        string `json:"title"`
+	CanGoBack    bool   `json:"canGoBack"`
+	CanGoForward bool   `json:"canGoForward"`
+}
+
+// GoBack, ana pencerede geçmişte bir sayfa geri gider. Herhangi bir
+// goroutine'den çağrılabilir.
+func (a *Application) GoBack() {
+	a.RunOnUIThread(func() {
+		if wv := a.view(); wv != nil {
+			wv.GoBack()
+		}
+	})
+}
+
+// GoForward, ana pencerede geçmişte bir sayfa ileri gider.
+func (a *Application) GoForward() {
+	a.RunOnUIThread(func() {
+		if wv := a.view(); wv != nil {
+			wv.GoForward()
+		}
+	})
+}
+
+// CanGoBack, ana pencerede geri gidilebilir mi döner.
+func (a *Application) CanGoBack() bool {
+	wv := a.view()
+	return wv != nil && wv.CanGoBack()
+}
+
+// CanGoForward, ana pencerede ileri gidilebilir mi döner.
+func (a *Application) CanGoForward() bool {
+	wv := a.view()
+	return wv != nil && wv.CanGoForward()
+}
+
+// GoBack, pencerede geçmişte bir sayfa geri gider.
+func (w *Window) GoBack() {
+	w.do(func(wv webview.View) { wv.GoBack() })
+}
+
+// GoForward, pencerede geçmişte bir sayfa ileri gider.
+func (w *Window) GoForward() {
+	w.do(func(wv webview.View) { wv.GoForward() })
+}
+
+// Navigation, pencerenin gezinme geçmişindeki son bilinen konumunu döner.
+func (w *Window) Navigation() NavigationState {
+	w.mu.Lock()
+	wv := w.view
+	w.mu.Unlock()
+	if wv == nil {
+		return NavigationState{Window: w.name}
+	}
+	return navigationState(w.name, wv.Bridge().Navigation())
+}
+
+// watchNavigation, WebView'in konum değişikliklerini "navigation:changed"
+// olayıyla tüm pencerelere duyurur.
+func (a *Application) watchNavigation(wv webview.View, window string) {
+	wv.OnHistoryChanged(func(s bridge.NavigationState) {
+		_ = a.Broadcast("navigation:changed", navigationState(window, s))
+	})
+}
+
+// navigationState, köprünün durumunu pencere adıyla birlikte döner.
+func navigationState(window string, s bridge.NavigationState) NavigationState {
+	return NavigationState{
+		Window:       window,
+		URL:          s.URL,
+		Title:        s.Title,
+		CanGoBack:    s.CanGoBack,
+		CanGoForward: s.CanGoForward,
+	}
+}
@@ -0,0 +1,180 @@
+package gomad
+
+import (
+	"fmt"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// Notification, bir masaüstü bildirimidir.
+//
+// Örnek:
+//
+//	app.Notify(&gomad.Notification{
+//	    Title: "İndirme tamamlandı",
+//	    Body:  "rapor.pdf indirildi",
+//	    Actions: []gomad.NotificationAction{{ID: "open", Label: "Aç"}},
+//	    OnClick: func(action string) {
+//	        if action == "open" { openFile() } else { app.Show() }
+//	    },
+//	})
+//
+// Platform desteği:
+//   - Windows: tray ikonuna bağlı bildirim (Windows 10+ toast olarak görünür).
+//     Gerekirse tray ikonu otomatik oluşturulur. Aksiyon butonları desteklenmez.
+//   - Linux: libnotify (notify-send). Aksiyonlar libnotify 0.7.9+ gerektirir.
+//   - Diğer: ErrNotSupported (log'lanır).
+type Notification struct {
+	// ID, JS olaylarında bildirimi tanımlar. Boşsa otomatik atanır.
+	ID string `json:"id,omitempty"`
+
+	Title string `json:"title"`
+	Body  string `json:"body,omitempty"`
+
+	// Icon, bildirim ikonudur (Windows: .ico, Linux: .png). nil → uygulama ikonu.
+	Icon []byte `json:"icon,omitempty"`
+
+	// Actions, bildirim üzerindeki butonlardır.
+	Actions []NotificationAction `json:"actions,omitempty"`
+
+	// OnClick, bildirime tıklanınca ayrı bir goroutine'de çağrılır.
+	// action, tıklanan butonun ID'sidir; gövdeye tıklandıysa boştur.
+	OnClick func(action string) `json:"-"`
+
+	// OnDismiss, bildirim tıklanmadan kapanınca çağrılır.
+	OnDismiss func() `json:"-"`
+}
+
+// NotificationAction, bildirim üzerindeki bir butondur.
+type NotificationAction struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+}
+
+var notificationCounter atomic.Uint64
+
+// Notify, bir masaüstü bildirimi gösterir.
+// Herhangi bir goroutine'den çağrılabilir; bildirim UI thread'inde gösterilir.
+// Etkileşimler OnClick/OnDismiss'e ve JS'e "notification:click" ({id, action})
+// ve "notification:dismiss" ({id}) olayları olarak iletilir.
+func (a *Application) Notify(n *Notification) error {
+	if n == nil || n.Title == "" {
+		return fmt.Errorf("notification title is required")
+	}
+	for _, action := range n.Actions {
+		if action.ID == "" {
+			return fmt.Errorf("notification action %q: id is required", action.Label)
+		}
+	}
+	if n.ID == "" {
+		n.ID = "notification-" + strconv.FormatUint(notificationCounter.Add(1), 10)
+	}
+
+	a.notifyMu.Lock()
+	if a.notifications == nil {
+		a.notifications = make(map[string]*Notification)
+	}
+	a.notifications[n.ID] = n
+	a.notifyMu.Unlock()
+
+	a.prepareNotifier()
+	a.RunOnUIThread(func() {
+		notifier, err := a.notifier()
+		if err == nil {
+			err = notifier.ShowNotification(n.toPlatform())
+		}
+		if err != nil {
+			a.Logger().Warn("failed to show notification", "id", n.ID, "error", err)
+			a.takeNotification(n.ID)
+		}
+	})
+	return nil
+}
+
+// notifier, platform bildirim servisini döner; ilk çağrıda oluşturur ve
+// callback'leri bağlar. UI thread'inde çağrılır.
+func (a *Application) notifier() (platform.Notifier, error) {
+	a.notifyMu.Lock()
+	existing := a.nativeNotify
+	a.notifyMu.Unlock()
+	if existing != nil {
+		return existing, nil
+	}
+
+	notifier, err := a.nativeNotifier()
+	if err != nil {
+		return nil, err
+	}
+	notifier.OnNotificationClick(a.handleNotificationClick)
+	notifier.OnNotificationDismiss(a.handleNotificationDismiss)
+
+	a.notifyMu.Lock()
+	a.nativeNotify = notifier
+	a.notifyMu.Unlock()
+	return notifier, nil
+}
+
+// takeNotification, bekleyen bildirimi kayıttan çıkarır ve döner.
+func (a *Application) takeNotification(id string) *Notification {
+	a.notifyMu.Lock()
+	defer a.notifyMu.Unlock()
+	n := a.notifications[id]
+	delete(a.notifications, id)
+	return n
+}
+
+func (a *Application) handleNotificationClick(id, action string) {
+	n := a.takeNotification(id)
+	if n == nil {
+		return
+	}
+	if n.OnClick != nil {
+		a.Go(func() { n.OnClick(action) })
+	}
+	_ = a.Emit("notification:click", map[string]string{"id": id, "action": action})
+}
+
+func (a *Application) handleNotificationDismiss(id string) {
+	n := a.takeNotification(id)
+	if n == nil {
+		return
+	}
+	if n.OnDismiss != nil {
+		a.Go(n.OnDismiss)
+	}
+	_ = a.Emit("notification:dismiss", map[string]string{"id": id})
+}
+
+// toPlatform, bildirimi platform katmanının modeline çevirir.
+func (n *Notification) toPlatform() *platform.Notification {
+	out := &platform.Notification{
+		ID:    n.ID,
+		Title: n.Title,
+		Body:  n.Body,
+		Icon:  n.Icon,
+	}
+	for _, action := range n.Actions {
+		out.Actions = append(out.Actions, platform.NotificationAction{ID: action.ID, Label: action.Label})
+	}
+	return out
+}
+
+// notificationModule, bildirimlerin JS API'sidir (window.gomad.notification).
+//
+//	const id = await gomad.notification.show({ title: "Merhaba", body: "...", actions: [{ id: "open", label: "Aç" }] });
+//	gomad.on("notification:click", ({ id, action }) => { ... });
+func (a *Application) notificationModule() builtinModule {
+	return builtinModule{
+		namespace: "notification",
+		methods: map[string]interface{}{
+			"show": func(n Notification) (string, error) {
+				if err := a.Notify(&n); err != nil {
+					return "", err
+				}
+				return n.ID, nil
+			},
+		},
+	}
+}
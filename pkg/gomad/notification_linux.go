@@ -0,0 +1,19 @@
+//go:build linux
+
+package gomad
+
+import (
+	"github.com/biyonik/gomad/internal/platform"
+	"github.com/biyonik/gomad/internal/platform/linux"
+)
+
+func (a *Application) prepareNotifier() {}
+
+// nativeNotifier, Linux'ta bildirimleri libnotify ile gösterir.
+func (a *Application) nativeNotifier() (platform.Notifier, error) {
+	notifier, err := linux.NewNotifier(a.config.title)
+	if err != nil {
+		return nil, err
+	}
+	return notifier, nil
+}
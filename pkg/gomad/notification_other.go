@@ -0,0 +1,15 @@
+//go:build !windows && !linux
+
+package gomad
+
+import (
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+func (a *Application) prepareNotifier() {}
+
+// nativeNotifier, bildirim desteği henüz olmayan platformlarda ErrNotSupported döner.
+func (a *Application) nativeNotifier() (platform.Notifier, error) {
+	return nil, gomerrors.NewWindowError("notification", "desktop notifications", gomerrors.ErrNotSupported)
+}
@@ -0,0 +1,30 @@
+//go:build windows
+
+package gomad
+
+import (
+	"fmt"
+
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// prepareNotifier, bildirimlerin bağlı olduğu tray ikonunu oluşturur.
+// Tray'in native ikonu UI kuyruğunda bildirimden önce oluşsun diye
+// Notify tarafından RunOnUIThread'den önce çağrılır.
+func (a *Application) prepareNotifier() {
+	a.Tray()
+}
+
+// nativeNotifier, Windows'ta bildirimleri tray ikonu üzerinden gösterir.
+func (a *Application) nativeNotifier() (platform.Notifier, error) {
+	t := a.Tray()
+	t.mu.Lock()
+	native := t.native
+	t.mu.Unlock()
+
+	notifier, ok := native.(platform.Notifier)
+	if !ok {
+		return nil, fmt.Errorf("notifications require a tray icon, which is unavailable")
+	}
+	return notifier, nil
+}
@@ -0,0 +1,134 @@
+package gomad
+
+// ============================================================================
+// Debug overlay
+// Yalnızca debug modunda (WithDebug veya "gomad dev") sayfaya eklenen küçük
+// köprü sağlığı göstergesi. Ctrl+Shift+O (macOS: Cmd+Shift+O) veya
+// gomad.overlay.toggle() ile açılıp kapanır; durum sayfa yenilemelerinde
+// korunur. Pencerenin sağ üst köşesinde saniyede bir güncellenir:
+//
+//   - FPS: requestAnimationFrame ile ölçülen kare hızı
+//   - Pending: cevabı beklenen çağrı sayısı
+//   - Calls/s, Events/s: köprü trafiğinin hızı
+//   - Son hatalar: hata ile dönen son çağrılar (metod, kod, mesaj)
+//
+// Değerler sayfadaki köprü sayaçlarından (window.gomad._health) okunur;
+// overlay köprüye çağrı yapmaz, ölçtüğü trafiği etkilemez.
+// ============================================================================
+
+// overlayNamespace, overlay'in yerleşik modül adıdır.
+const overlayNamespace = "overlay"
+
+// overlayModule, overlay'i kuran modüldür; yalnızca debug modunda kaydedilir.
+func (a *Application) overlayModule() builtinModule {
+	return builtinModule{
+		namespace: overlayNamespace,
+		init:      overlayJS,
+	}
+}
+
+// overlayJS, overlay'i Shadow DOM içinde kurar; sayfanın stilleri etkilenmez.
+const overlayJS = `
+(function() {
+    const storageKey = 'gomad.overlay';
+    const maxErrors = 3;
+    let host = null, root = null, timer = null, frame = 0;
+    let frames = 0, last = null;
+
+    const css = ` + "`" + `
+        :host { all: initial; }
+        .overlay { position: fixed; top: 8px; right: 8px; min-width: 170px; max-width: 320px;
+            background: rgba(20, 20, 26, .85); color: #ddd; font: 11px/1.5 ui-monospace, Menlo, Consolas, monospace;
+            padding: 6px 8px; border-radius: 5px; z-index: 2147483647; pointer-events: none;
+            box-shadow: 0 0 8px rgba(0,0,0,.4); }
+        .row { display: flex; justify-content: space-between; gap: 12px; }
+        .value { color: #fff; }
+        .good { color: #8ce99a; } .warn { color: #ffd43b; } .bad { color: #ff8787; }
+        .errors { margin-top: 4px; border-top: 1px solid #333; padding-top: 4px; }
+        .error { color: #ff8787; white-space: nowrap; overflow: hidden; text-overflow: ellipsis; }
+    ` + "`" + `;
+
+    function el(tag, attrs, ...children) {
+        const node = document.createElement(tag);
+        Object.assign(node, attrs || {});
+        children.forEach(c => node.append(c));
+        return node;
+    }
+
+    function row(label, value, cls) {
+        return el('div', { className: 'row' }, label, el('span', { className: 'value ' + (cls || '') }, value));
+    }
+
+    function tick() {
+        frames++;
+        frame = requestAnimationFrame(tick);
+    }
+
+    function update() {
+        const now = performance.now();
+        const health = window.gomad._health();
+        const seconds = last ? (now - last.time) / 1000 : 1;
+        const fps = Math.round(frames / seconds);
+        const calls = last ? (health.calls - last.calls) / seconds : 0;
+        const events = last ? (health.events - last.events) / seconds : 0;
+        frames = 0;
+        last = { time: now, calls: health.calls, events: health.events };
+
+        const panel = root.querySelector('.overlay');
+        panel.replaceChildren(
+            row('FPS', String(fps), fps >= 50 ? 'good' : fps >= 30 ? 'warn' : 'bad'),
+            row('Pending', String(health.pending), health.pending > 32 ? 'warn' : ''),
+            row('Calls/s', calls.toFixed(1)),
+            row('Events/s', events.toFixed(1)));
+        const errors = health.errors.slice(-maxErrors).reverse();
+        if (errors.length) {
+            panel.append(el('div', { className: 'errors' }, ...errors.map(e => el('div', {
+                className: 'error',
+                title: e.message,
+                textContent: new Date(e.time).toLocaleTimeString(undefined, { hour12: false }) + ' ' +
+                    (e.method || '?') + ' (' + e.code + ') ' + e.message
+            }))));
+        }
+    }
+
+    function show() {
+        if (host) return;
+        host = el('div', { id: 'gomad-overlay' });
+        root = host.attachShadow({ mode: 'open' });
+        root.append(el('style', { textContent: css }), el('div', { className: 'overlay' }));
+        document.documentElement.append(host);
+        frames = 0;
+        last = null;
+        frame = requestAnimationFrame(tick);
+        update();
+        timer = setInterval(update, 1000);
+    }
+
+    function hide() {
+        if (!host) return;
+        clearInterval(timer);
+        cancelAnimationFrame(frame);
+        host.remove();
+        host = root = null;
+    }
+
+    window.gomad.overlay.toggle = () => {
+        host ? hide() : show();
+        try { sessionStorage.setItem(storageKey, host ? '1' : ''); } catch (e) {}
+    };
+
+    window.addEventListener('keydown', (e) => {
+        if ((e.ctrlKey || e.metaKey) && e.shiftKey && e.code === 'KeyO') {
+            e.preventDefault();
+            window.gomad.overlay.toggle();
+        }
+    }, true);
+
+    let restore = false;
+    try { restore = sessionStorage.getItem(storageKey) === '1'; } catch (e) {}
+    if (restore) {
+        if (document.documentElement) show();
+        else document.addEventListener('DOMContentLoaded', show);
+    }
+})();
+`
@@ -0,0 +1,90 @@
+package gomad
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// AppPaths, uygulamanın işletim sistemine uygun standart dizinlerini sunar.
+// Tüm dizinler app ID'den türetilir ve ilk erişimde (yoksa) oluşturulur.
+//
+// Örnek:
+//
+//	dir, err := app.Paths().UserData()
+//	db, err := sql.Open("sqlite", filepath.Join(dir, "app.db"))
+//
+// Her uygulamanın os.UserConfigDir mantığını yeniden yazmasına gerek kalmaz.
+type AppPaths struct {
+	appID   string
+	profile string // Varsayılan dışındaki profillerde dizinler profiles/<ad> altındadır
+}
+
+// Paths, verilen app ID için standart dizinleri döner.
+// Application dışında (ör. CLI araçlarında) kullanmak içindir; uygulama içinde
+// app.Paths() tercih edilmelidir.
+func Paths(appID string) *AppPaths {
+	return &AppPaths{appID: appID}
+}
+
+// Paths, uygulamanın etkin profile ait standart dizinlerini döner (bkz.
+// Profile). App ID, WithAppID ile ayarlanır.
+func (a *Application) Paths() *AppPaths {
+	p := Paths(a.config.appID)
+	if profile := a.Profile(); profile != DefaultProfile {
+		p.profile = profile
+	}
+	return p
+}
+
+// UserData, kalıcı kullanıcı verisi dizinini döner (veritabanları, dokümanlar).
+func (p *AppPaths) UserData() (string, error) { return p.dir(platform.DirUserData) }
+
+// Config, ayar dosyalarının tutulduğu dizini döner.
+func (p *AppPaths) Config() (string, error) { return p.dir(platform.DirConfig) }
+
+// Cache, silinebilir önbellek dizinini döner.
+func (p *AppPaths) Cache() (string, error) { return p.dir(platform.DirCache) }
+
+// Logs, log dosyalarının tutulduğu dizini döner.
+func (p *AppPaths) Logs() (string, error) { return p.dir(platform.DirLogs) }
+
+// Temp, uygulamaya özel geçici dizini döner.
+func (p *AppPaths) Temp() (string, error) { return p.dir(platform.DirTemp) }
+
+// dir, dizini (profil alt dizini dahil) çözer ve yoksa oluşturur.
+func (p *AppPaths) dir(kind platform.DirKind) (string, error) {
+	dir, err := platform.EnsureDir(p.appID, kind)
+	if err != nil || p.profile == "" {
+		return dir, err
+	}
+	dir = filepath.Join(dir, profilesDirName, p.profile)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create %s directory: %w", kind, err)
+	}
+	return dir, nil
+}
+
+// All, tüm dizinleri isimleriyle birlikte döner.
+// JS tarafındaki gomad.paths binding'i bu haritayı döndürür.
+func (p *AppPaths) All() (map[string]string, error) {
+	kinds := []platform.DirKind{
+		platform.DirUserData,
+		platform.DirConfig,
+		platform.DirCache,
+		platform.DirLogs,
+		platform.DirTemp,
+	}
+
+	result := make(map[string]string, len(kinds))
+	for _, kind := range kinds {
+		dir, err := p.dir(kind)
+		if err != nil {
+			return nil, err
+		}
+		result[kind.String()] = dir
+	}
+	return result, nil
+}
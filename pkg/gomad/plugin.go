@@ -0,0 +1,399 @@
+package gomad
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os/exec"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/biyonik/gomad/internal/bridge"
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// ============================================================================
+// EKLENTİLER
+// Eklentiler uygulamaya kendi isim alanlarında binding ve olay ekler:
+// "pdf" eklentisinin "render" metodu JS'ten gomad.call("pdf.render", ...)
+// ile çağrılır, "progress" olayı "pdf:progress" olarak yayınlanır.
+//
+// Üç yükleme biçimi vardır:
+//
+//	app.Use(pdf.New())                            // Aynı binary'de derlenmiş eklenti
+//	goplugin.Load(app, "plugins/pdf.so")          // Go plugin (-buildmode=plugin)
+//	app.StartPluginProcess("pdf", "./pdf-plugin") // Ayrı süreç, stdio üzerinden
+//
+// Go plugin desteği pkg/gomadplugin/goplugin paketindedir: "plugin" paketi
+// cgo ile derlenen binary'leri dinamik bağlamaya zorlar ve boyutlarını
+// büyütür; yalnızca bu paketi içe aktaran uygulamalar bu bedeli öder.
+//
+// Süreç eklentileri satır başına bir köprü mesajı (bridge.Message JSON'u)
+// konuşur. Eklenti önce "plugin:register" olayıyla metodlarını ve argüman
+// sayılarını bildirir ({"methods": {"render": 2}}); ardından host "call"
+// gönderir, eklenti "result"/"error" ile cevaplar ve istediği zaman "event"
+// yayınlar. Go ile yazılan eklentiler bunun için pkg/gomadplugin'i kullanır.
+// ============================================================================
+
+const (
+	// pluginRegisterEvent, süreç eklentisinin ilk mesajıdır.
+	pluginRegisterEvent = "plugin:register"
+	// pluginRegisterTimeout, süreç eklentisinin kendini tanıtması için
+	// beklenen süredir.
+	pluginRegisterTimeout = 10 * time.Second
+	// pluginStopTimeout, kapanışta eklenti sürecinin çıkması için beklenen
+	// süredir; sonra süreç sonlandırılır.
+	pluginStopTimeout = 2 * time.Second
+)
+
+// Plugin, uygulamaya isim alanlı binding ve olaylar ekleyen bir modüldür.
+// Go plugin olarak derlenen eklentiler "Plugin" adlı bir değişken dışa
+// aktarır:
+//
+//	var Plugin gomad.Plugin = pdfPlugin{}
+type Plugin interface {
+	// Name, eklentinin isim alanıdır; binding ve olay adlarının önekidir.
+	Name() string
+	// Register, eklentinin binding'lerini host üzerinden kaydeder.
+	Register(host *PluginHost) error
+}
+
+// PluginHost, eklentinin uygulamaya erişimidir. Tüm adlar eklentinin isim
+// alanına göre öneklenir; bir eklenti başka bir eklentinin binding'lerini
+// ezemez.
+type PluginHost struct {
+	app   *Application
+	name  string
+	bound []string // Kayıt başarısız olursa geri alınır
+}
+
+// Name, eklentinin isim alanıdır.
+func (h *PluginHost) Name() string { return h.name }
+
+// Bind, fn'i "<isim alanı>.<method>" adıyla bağlar.
+func (h *PluginHost) Bind(method string, fn interface{}) error {
+	name := h.name + "." + method
+	if err := h.app.Bind(name, fn); err != nil {
+		return err
+	}
+	h.bound = append(h.bound, name)
+	return nil
+}
+
+// unbindAll, host üzerinden bağlanan binding'leri kaldırır.
+func (h *PluginHost) unbindAll() {
+	for _, name := range h.bound {
+		h.app.unbind(name)
+	}
+	h.bound = nil
+}
+
+// Emit, JS'e "<isim alanı>:<event>" olayını gönderir.
+func (h *PluginHost) Emit(event string, data interface{}) error {
+	return h.app.Emit(h.name+":"+event, data)
+}
+
+// Logger, eklentinin adıyla etiketlenmiş logger'ı döner.
+func (h *PluginHost) Logger() *slog.Logger {
+	return h.app.Logger().With("plugin", h.name)
+}
+
+// Use, derlenmiş bir eklentiyi kaydeder. Run'dan önce ya da sonra
+// çağrılabilir.
+func (a *Application) Use(p Plugin) error {
+	name := p.Name()
+	if err := a.claimPlugin(name, nil); err != nil {
+		return err
+	}
+	host := &PluginHost{app: a, name: name}
+	if err := p.Register(host); err != nil {
+		host.unbindAll()
+		a.releasePlugin(name)
+		return fmt.Errorf("plugin %q: %w", name, err)
+	}
+	a.Logger().Info("plugin loaded", "plugin", name)
+	return nil
+}
+
+// Plugins, yüklü eklentilerin adlarını sıralı döner.
+func (a *Application) Plugins() []string {
+	a.pluginMu.Lock()
+	defer a.pluginMu.Unlock()
+	names := make([]string, 0, len(a.plugins))
+	for name := range a.plugins {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// claimPlugin, isim alanını ayırır. proc süreç eklentileri için doludur.
+func (a *Application) claimPlugin(name string, proc *pluginProcess) error {
+	if name == "" || !isPluginName(name) {
+		return gomerrors.NewOperationError("plugin.load", fmt.Sprintf("invalid plugin name %q", name), gomerrors.ErrInvalidArgument)
+	}
+	a.pluginMu.Lock()
+	defer a.pluginMu.Unlock()
+	if _, exists := a.plugins[name]; exists {
+		return gomerrors.NewOperationError("plugin.load", name, gomerrors.ErrAlreadyExists)
+	}
+	if a.plugins == nil {
+		a.plugins = map[string]*pluginProcess{}
+	}
+	a.plugins[name] = proc
+	return nil
+}
+
+func (a *Application) releasePlugin(name string) {
+	a.pluginMu.Lock()
+	defer a.pluginMu.Unlock()
+	delete(a.plugins, name)
+}
+
+// isPluginName, adın binding öneki olarak güvenli olup olmadığını söyler.
+// Yerleşik "gomad" isim alanı eklentilere kapalıdır.
+func isPluginName(name string) bool {
+	if name == "gomad" {
+		return false
+	}
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// pluginProcess, stdio üzerinden konuşan bir eklenti sürecidir.
+type pluginProcess struct {
+	app   *Application
+	name  string
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+	done  chan struct{}
+
+	writeMu sync.Mutex
+	mu      sync.Mutex
+	pending map[string]chan *bridge.Message
+	exited  bool
+	seq     atomic.Uint64
+}
+
+// pluginManifest, "plugin:register" olayının verisidir.
+type pluginManifest struct {
+	Methods map[string]int `json:"methods"`
+}
+
+// StartPluginProcess, command'ı bir eklenti süreci olarak başlatır ve
+// bildirdiği metodları "<name>.<method>" olarak bağlar. Süreç stderr'e
+// yazdıkları uygulama loguna aktarılır. Süreç çıkarsa metodları hata döner;
+// uygulama kapanırken süreç de kapatılır.
+//
+//	err := app.StartPluginProcess("pdf", "./plugins/pdf-plugin", "--quality", "high")
+func (a *Application) StartPluginProcess(name, command string, args ...string) error {
+	p := &pluginProcess{
+		app:     a,
+		name:    name,
+		cmd:     exec.Command(command, args...),
+		done:    make(chan struct{}),
+		pending: map[string]chan *bridge.Message{},
+	}
+	if err := a.claimPlugin(name, p); err != nil {
+		return err
+	}
+
+	manifest, err := p.start()
+	if err != nil {
+		a.releasePlugin(name)
+		return fmt.Errorf("plugin %q: %w", name, err)
+	}
+
+	host := &PluginHost{app: a, name: name}
+	for method, numIn := range manifest.Methods {
+		if err := host.Bind(method, bridge.RawFunc(numIn, p.caller(method))); err != nil {
+			p.stop()
+			host.unbindAll()
+			a.releasePlugin(name)
+			return fmt.Errorf("plugin %q: %w", name, err)
+		}
+	}
+	a.Logger().Info("plugin process started", "plugin", name, "pid", p.cmd.Process.Pid, "methods", len(manifest.Methods))
+	return nil
+}
+
+// start, süreci başlatır ve kayıt mesajını bekler.
+func (p *pluginProcess) start() (pluginManifest, error) {
+	var manifest pluginManifest
+	stdin, err := p.cmd.StdinPipe()
+	if err != nil {
+		return manifest, err
+	}
+	stdout, err := p.cmd.StdoutPipe()
+	if err != nil {
+		return manifest, err
+	}
+	stderr, err := p.cmd.StderrPipe()
+	if err != nil {
+		return manifest, err
+	}
+	p.stdin = stdin
+	if err := p.cmd.Start(); err != nil {
+		return manifest, err
+	}
+
+	logger := p.app.Logger().With("plugin", p.name)
+	go func() {
+		scanner := bufio.NewScanner(stderr)
+		for scanner.Scan() {
+			logger.Info(scanner.Text())
+		}
+	}()
+
+	registered := make(chan pluginManifest, 1)
+	go p.read(stdout, registered)
+
+	select {
+	case manifest = <-registered:
+		return manifest, nil
+	case <-p.done:
+		return manifest, errors.New("process exited before registering")
+	case <-time.After(pluginRegisterTimeout):
+		p.stop()
+		return manifest, fmt.Errorf("process did not register within %s", pluginRegisterTimeout)
+	}
+}
+
+// read, süreçten gelen mesajları işler. Süreç çıktığında bekleyen çağrılar
+// hata ile sonlanır.
+func (p *pluginProcess) read(stdout io.Reader, registered chan<- pluginManifest) {
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		msg, err := bridge.FromJSON(scanner.Bytes())
+		if err != nil {
+			p.app.Logger().Warn("plugin: invalid message", "plugin", p.name, "error", err)
+			continue
+		}
+		switch msg.Type {
+		case bridge.MessageTypeEvent:
+			if msg.Event == pluginRegisterEvent {
+				var manifest pluginManifest
+				if err := json.Unmarshal(msg.Data, &manifest); err != nil {
+					p.app.Logger().Warn("plugin: invalid manifest", "plugin", p.name, "error", err)
+					continue
+				}
+				select {
+				case registered <- manifest:
+				default:
+				}
+				continue
+			}
+			_ = p.app.Emit(p.name+":"+msg.Event, msg.Data)
+		case bridge.MessageTypeResult, bridge.MessageTypeError:
+			p.mu.Lock()
+			ch, ok := p.pending[msg.ID]
+			delete(p.pending, msg.ID)
+			p.mu.Unlock()
+			if ok {
+				ch <- msg
+			}
+		}
+	}
+
+	err := p.cmd.Wait()
+	p.mu.Lock()
+	p.exited = true
+	for id, ch := range p.pending {
+		ch <- bridge.NewErrorMessage(id, bridge.ErrCodeExecution, "plugin exited", "")
+		delete(p.pending, id)
+	}
+	p.mu.Unlock()
+	close(p.done)
+	p.app.Logger().Info("plugin process exited", "plugin", p.name, "error", err)
+}
+
+// caller, method'u sürece ileten binding gövdesini döner.
+func (p *pluginProcess) caller(method string) func(args []json.RawMessage) (interface{}, error) {
+	return func(args []json.RawMessage) (interface{}, error) {
+		id := "plugin_" + strconv.FormatUint(p.seq.Add(1), 10)
+		ch := make(chan *bridge.Message, 1)
+		p.mu.Lock()
+		if p.exited {
+			p.mu.Unlock()
+			return nil, gomerrors.NewOperationError(p.name+"."+method, "plugin process exited", gomerrors.ErrClosed)
+		}
+		p.pending[id] = ch
+		p.mu.Unlock()
+
+		msg, err := bridge.NewCallMessage(id, method, args)
+		if err == nil {
+			err = p.send(msg)
+		}
+		if err != nil {
+			p.mu.Lock()
+			delete(p.pending, id)
+			p.mu.Unlock()
+			return nil, err
+		}
+
+		resp := <-ch
+		if resp.Type == bridge.MessageTypeError {
+			if resp.Error == nil {
+				return nil, fmt.Errorf("%s.%s failed", p.name, method)
+			}
+			return nil, errors.New(resp.Error.Message)
+		}
+		return resp.Result, nil
+	}
+}
+
+// send, sürece bir mesaj yazar.
+func (p *pluginProcess) send(msg *bridge.Message) error {
+	data, err := msg.ToJSON()
+	if err != nil {
+		return err
+	}
+	p.writeMu.Lock()
+	defer p.writeMu.Unlock()
+	_, err = p.stdin.Write(append(data, '\n'))
+	return err
+}
+
+// stop, stdin'i kapatarak sürecin çıkmasını ister; çıkmazsa sonlandırır.
+func (p *pluginProcess) stop() {
+	p.stdin.Close()
+	select {
+	case <-p.done:
+	case <-time.After(pluginStopTimeout):
+		_ = p.cmd.Process.Kill()
+		<-p.done
+	}
+}
+
+// stopPlugins, çalışan eklenti süreçlerini kapatır.
+func (a *Application) stopPlugins() {
+	a.pluginMu.Lock()
+	procs := make([]*pluginProcess, 0, len(a.plugins))
+	for _, p := range a.plugins {
+		if p != nil {
+			procs = append(procs, p)
+		}
+	}
+	a.pluginMu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, p := range procs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.stop()
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,36 @@
+package gomad
+
+import (
+	"errors"
+	"testing"
+)
+
+// testPlugin, Register'ı bir fonksiyona devreden eklentidir.
+type testPlugin struct {
+	register func(h *PluginHost) error
+}
+
+func (testPlugin) Name() string                   { return "pdf" }
+func (p testPlugin) Register(h *PluginHost) error { return p.register(h) }
+
+func TestUseUnbindsOnRegisterFailure(t *testing.T) {
+	a := New()
+	failing := testPlugin{register: func(h *PluginHost) error {
+		if err := h.Bind("render", func() {}); err != nil {
+			return err
+		}
+		return errors.New("missing dependency")
+	}}
+	if err := a.Use(failing); err == nil {
+		t.Fatal("Use returned no error")
+	}
+	if _, bound := a.bindings["pdf.render"]; bound || len(a.Plugins()) != 0 {
+		t.Fatalf("failed plugin left bindings %v, plugins %v", a.bindOrder, a.Plugins())
+	}
+
+	// Aynı eklenti tekrar yüklenebilir
+	ok := testPlugin{register: func(h *PluginHost) error { return h.Bind("render", func() {}) }}
+	if err := a.Use(ok); err != nil {
+		t.Fatal(err)
+	}
+}
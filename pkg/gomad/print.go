@@ -0,0 +1,66 @@
+package gomad
+
+import "github.com/biyonik/gomad/pkg/printing"
+
+// PrintPage, WebView'de görüntülenen sayfayı yazdırır (window.print).
+//
+// Sistem yazdırma diyaloğu gösterilir; WebView sessiz sayfa yazdırmayı
+// sunmaz. Sessiz yazdırma için belge PDF olarak üretilip PrintPDF ile
+// gönderilmelidir.
+func (a *Application) PrintPage() error {
+	return a.Eval("window.print()")
+}
+
+// PrintFile, dosyayı diyalog göstermeden yazdırır. İşin durumu değiştikçe
+// JS'e "print:job" ({id, printer, document, status}) olayı emit edilir.
+func (a *Application) PrintFile(path string, opts printing.Options) (*printing.Job, error) {
+	job, err := printing.PrintFile(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	a.watchPrintJob(job)
+	return job, nil
+}
+
+// PrintPDF, bellekteki PDF verisini diyalog göstermeden yazdırır.
+// İş durumu PrintFile'daki gibi "print:job" olayıyla bildirilir.
+func (a *Application) PrintPDF(data []byte, opts printing.Options) (*printing.Job, error) {
+	job, err := printing.PrintPDF(data, opts)
+	if err != nil {
+		return nil, err
+	}
+	a.watchPrintJob(job)
+	return job, nil
+}
+
+// watchPrintJob, iş durumunu arka planda izler ve JS'e bildirir.
+func (a *Application) watchPrintJob(job *printing.Job) {
+	a.Go(func() {
+		printing.Watch(job, func(s printing.JobStatus) {
+			_ = a.Emit("print:job", map[string]string{
+				"id":       job.ID,
+				"printer":  job.Printer,
+				"document": job.Document,
+				"status":   string(s),
+			})
+		})
+	})
+}
+
+// printModule, yazdırmanın JS API'sidir (window.gomad.print).
+//
+//	const printers = await gomad.print.printers();
+//	const job = await gomad.print.pdf(base64Pdf, { printer: "Zebra ZD420", copies: 2 });
+//	gomad.on("print:job", ({ id, status }) => { ... });
+//	await gomad.print.page(); // sistem diyaloğu ile
+func (a *Application) printModule() builtinModule {
+	return builtinModule{
+		namespace: "print",
+		methods: map[string]interface{}{
+			"printers": printing.Printers,
+			"file":     a.PrintFile,
+			"pdf":      a.PrintPDF,
+			"page":     a.PrintPage,
+		},
+	}
+}
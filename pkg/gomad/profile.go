@@ -0,0 +1,230 @@
+package gomad
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// ============================================================================
+// Kullanıcı profilleri
+// Aynı makineyi paylaşan kullanıcılar (ya da bir kullanıcının "iş" ve "ev"
+// kurulumları) için her profil kendi ayarlarını, veritabanlarını ve WebView
+// oturumunu (çerezler, localStorage) tutar. Profil dizinleri standart
+// dizinlerin altındaki profiles/<ad> dizinleridir; varsayılan profil
+// ("default") profiller eklenmeden önceki dizinleri kullanır.
+//
+// Profil açılışta şu sırayla seçilir: --profile bayrağı, WithProfilePicker
+// ile verilen seçici, son kullanılan profil, "default". SwitchProfile
+// uygulamayı seçilen profille yeniden başlatır; çalışan süreç profil
+// değiştirmez, böylece açık dosya ve veritabanı bağlantıları karışmaz.
+//
+// Varsayılan dışındaki profillerde WebView ayrı bir veri dizininde çalışır.
+// Ayrı veri dizini desteklenmeyen platformlarda pencere açılmaz; profiller
+// ortak oturuma düşürülmez (bkz. WindowOptions.Partition).
+// ============================================================================
+
+// DefaultProfile, profil seçilmediğinde kullanılan profilin adıdır.
+const DefaultProfile = "default"
+
+// profileFlag, profil seçen komut satırı bayrağıdır.
+const profileFlag = "profile"
+
+// profilesDirName, profil dizinlerinin standart dizinler altındaki adıdır.
+const profilesDirName = "profiles"
+
+// profilesFile, profil listesinin (profil dışı) UserData dizinindeki dosyasıdır.
+const profilesFile = "profiles.json"
+
+// profileRegistry, bilinen profiller ve son kullanılan profildir.
+type profileRegistry struct {
+	Last     string   `json:"last,omitempty"`
+	Profiles []string `json:"profiles"`
+}
+
+// ProfilePicker, açılışta --profile verilmediğinde profili seçer (ör. paylaşılan
+// bir makinede native bir seçim penceresi gösterir). profiles bilinen
+// profillerdir, last son kullanılandır. Yeni bir ad dönmek profili oluşturur;
+// "" dönmek son kullanılan profili seçer. Seçici uygulamanın dizinlerini
+// (app.Paths) kullanmamalıdır; dizinler seçilen profile göre çözülür.
+type ProfilePicker func(profiles []string, last string) (string, error)
+
+// Profile, etkin profilin adını döner. İlk çağrıda profil seçilir (Run bunu
+// açılışta yapar).
+func (a *Application) Profile() string {
+	a.profileMu.Lock()
+	defer a.profileMu.Unlock()
+	if a.profile == "" {
+		a.profile = a.resolveProfile()
+		a.Logger().Info("profile selected", "profile", a.profile)
+	}
+	return a.profile
+}
+
+// Profiles, bu kurulumda kullanılmış profilleri döner; "default" her zaman
+// listededir.
+func (a *Application) Profiles() ([]string, error) {
+	reg, err := a.loadProfiles()
+	if err != nil {
+		return nil, err
+	}
+	return reg.Profiles, nil
+}
+
+// SwitchProfile, uygulamayı name profiliyle yeniden başlatır; profil yoksa
+// oluşturulur. Uygulama henüz çalışmıyorsa (ör. Run'dan önce) profil
+// doğrudan değiştirilir; bu durumda dizinler henüz kullanılmamış olmalıdır.
+//
+//	app.Bind("switchProfile", app.SwitchProfile)
+//	// JS: await gomad.profile.switch("work")
+func (a *Application) SwitchProfile(name string) error {
+	if !validStorageName(name) {
+		return gomerrors.NewOperationError("profile.switch", fmt.Sprintf("profile name %q", name), gomerrors.ErrInvalidArgument)
+	}
+	if err := a.rememberProfile(name); err != nil {
+		return err
+	}
+	if a.view() == nil {
+		a.profileMu.Lock()
+		a.profile = name
+		a.profileMu.Unlock()
+		return nil
+	}
+	if name == a.Profile() {
+		return nil
+	}
+
+	args := []string{"--" + profileFlag + "=" + name}
+	for i := 1; i < len(os.Args); i++ {
+		arg := os.Args[i]
+		switch {
+		case arg == "-"+profileFlag || arg == "--"+profileFlag:
+			i++ // Değer sonraki argümandadır
+		case strings.HasPrefix(arg, "-"+profileFlag+"=") || strings.HasPrefix(arg, "--"+profileFlag+"="):
+		default:
+			args = append(args, arg)
+		}
+	}
+	a.Logger().Info("switching profile", "from", a.Profile(), "to", name)
+	return a.Relaunch(args...)
+}
+
+// resolveProfile, açılıştaki profili seçer ve son kullanılan olarak kaydeder.
+// profileMu tutulmalıdır.
+func (a *Application) resolveProfile() string {
+	name := a.Args().String(profileFlag)
+	reg, err := a.loadProfiles()
+	if err != nil {
+		a.Logger().Warn("failed to read profiles", "error", err)
+	}
+	if name == "" && a.config.profilePicker != nil {
+		picked, err := a.config.profilePicker(reg.Profiles, reg.Last)
+		if err != nil {
+			a.Logger().Warn("profile picker failed", "error", err)
+		}
+		name = picked
+	}
+	if name == "" {
+		name = reg.Last
+	}
+	if name == "" {
+		name = DefaultProfile
+	}
+	if !validStorageName(name) {
+		a.Logger().Warn("invalid profile name, using default", "profile", name)
+		name = DefaultProfile
+	}
+	if err := a.rememberProfile(name); err != nil {
+		a.Logger().Warn("failed to save profiles", "error", err)
+	}
+	return name
+}
+
+// profileWebViewDir, etkin profilin WebView veri dizinidir; varsayılan
+// profilde motorun varsayılan dizini kullanılır ("").
+func (a *Application) profileWebViewDir() string {
+	if a.Profile() == DefaultProfile {
+		return ""
+	}
+	dir, err := a.Paths().UserData()
+	if err != nil {
+		a.Logger().Warn("failed to resolve profile directory", "error", err)
+		return ""
+	}
+	return filepath.Join(dir, "webview")
+}
+
+// loadProfiles, profil listesini okur. Liste profil dışı dizinde tutulur ve
+// şifrelenmez: seçici, profil ve anahtar henüz belli değilken çalışır.
+func (a *Application) loadProfiles() (profileRegistry, error) {
+	reg := profileRegistry{}
+	dir, err := Paths(a.config.appID).UserData()
+	if err == nil {
+		var data []byte
+		data, err = os.ReadFile(filepath.Join(dir, profilesFile))
+		if err == nil {
+			err = json.Unmarshal(data, &reg)
+		} else if os.IsNotExist(err) {
+			err = nil
+		}
+	}
+	if !slices.Contains(reg.Profiles, DefaultProfile) {
+		reg.Profiles = append([]string{DefaultProfile}, reg.Profiles...)
+	}
+	return reg, err
+}
+
+// rememberProfile, profili listeye ekler ve son kullanılan olarak işaretler.
+func (a *Application) rememberProfile(name string) error {
+	a.profilesMu.Lock()
+	defer a.profilesMu.Unlock()
+
+	reg, err := a.loadProfiles()
+	if err != nil {
+		return err
+	}
+	if reg.Last == name && slices.Contains(reg.Profiles, name) {
+		return nil
+	}
+	reg.Last = name
+	if !slices.Contains(reg.Profiles, name) {
+		reg.Profiles = append(reg.Profiles, name)
+	}
+	dir, err := Paths(a.config.appID).UserData()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(reg)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, profilesFile)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// profileModule, profillerin JS API'sidir (window.gomad.profile).
+//
+//	const current = await gomad.profile.current();   // "default"
+//	const all = await gomad.profile.list();          // ["default", "work"]
+//	await gomad.profile.switch("work");              // uygulama yeniden başlar
+func (a *Application) profileModule() builtinModule {
+	return builtinModule{
+		namespace: "profile",
+		methods: map[string]interface{}{
+			"current": func() (string, error) {
+				return a.Profile(), nil
+			},
+			"list":   a.Profiles,
+			"switch": a.SwitchProfile,
+		},
+	}
+}
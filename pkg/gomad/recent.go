@@ -0,0 +1,184 @@
+package gomad
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// maxRecentDocuments, saklanan son kullanılan doküman sayısıdır.
+const maxRecentDocuments = 10
+
+// recentFile, son kullanılan dokümanların Config dizinindeki dosya adıdır.
+const recentFile = "recent-documents.json"
+
+// AddRecentDocument, dosyayı son kullanılan dokümanlar listesinin başına ekler.
+// Dosya uygulamanın listesine ve işletim sisteminin listesine (Windows'ta
+// jump list "Recent" kategorisi) eklenir.
+//
+// Windows jump list'i yalnızca uygulamaya kayıtlı dosya türlerini gösterir;
+// buradan seçilen doküman uygulamayı yol argümanıyla başlatır ve
+// OnRecentDocument callback'i / "recent:open" olayı tetiklenir.
+func (a *Application) AddRecentDocument(path string) error {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+
+	a.recentMu.Lock()
+	defer a.recentMu.Unlock()
+
+	list := a.loadRecentDocuments()
+	list = slices.DeleteFunc(list, func(p string) bool { return p == abs })
+	list = append([]string{abs}, list...)
+	if len(list) > maxRecentDocuments {
+		list = list[:maxRecentDocuments]
+	}
+	if err := a.saveRecentDocuments(list); err != nil {
+		return err
+	}
+
+	addNativeRecentDocument(abs)
+	return nil
+}
+
+// ClearRecentDocuments, uygulamanın ve işletim sisteminin son kullanılan
+// doküman listesini temizler.
+func (a *Application) ClearRecentDocuments() error {
+	a.recentMu.Lock()
+	defer a.recentMu.Unlock()
+
+	if err := a.saveRecentDocuments(nil); err != nil {
+		return err
+	}
+	return clearNativeRecentDocuments()
+}
+
+// RecentDocuments, son kullanılan dokümanları en yeniden eskiye döner.
+func (a *Application) RecentDocuments() []string {
+	a.recentMu.Lock()
+	defer a.recentMu.Unlock()
+	return a.loadRecentDocuments()
+}
+
+// OnRecentDocument, kullanıcı son kullanılan bir dokümanı seçtiğinde
+// çağrılacak fonksiyonu ayarlar. fn ayrı bir goroutine'de çalışır.
+// Aynı anda JS'e "recent:open" ({path}) olayı emit edilir.
+func (a *Application) OnRecentDocument(fn func(path string)) {
+	a.recentMu.Lock()
+	defer a.recentMu.Unlock()
+	a.onRecentDocument = fn
+}
+
+// RecentDocumentsMenu, macOS "Open Recent" menüsünün karşılığı olan alt menü
+// öğelerini üretir. Menü çubuğuna eklenir ve liste değiştiğinde yeniden
+// kurulur:
+//
+//	app.SetMenuBar(&gomad.MenuItem{Label: "File", Submenu: []*gomad.MenuItem{
+//	    {Label: "Open Recent", Submenu: app.RecentDocumentsMenu()},
+//	}})
+func (a *Application) RecentDocumentsMenu() []*MenuItem {
+	docs := a.RecentDocuments()
+	items := make([]*MenuItem, 0, len(docs)+2)
+	for _, doc := range docs {
+		items = append(items, &MenuItem{
+			Label:   filepath.Base(doc),
+			OnClick: func(*MenuItem) { a.openRecentDocument(doc) },
+		})
+	}
+	if len(items) > 0 {
+		items = append(items, Separator())
+	}
+	items = append(items, &MenuItem{
+		Label:    "Clear Menu",
+		Disabled: len(docs) == 0,
+		OnClick: func(*MenuItem) {
+			if err := a.ClearRecentDocuments(); err != nil {
+				a.Logger().Warn("failed to clear recent documents", "error", err)
+			}
+		},
+	})
+	return items
+}
+
+// openRecentDocument, seçilen dokümanı Go callback'ine ve JS'e bildirir.
+func (a *Application) openRecentDocument(path string) {
+	a.recentMu.Lock()
+	fn := a.onRecentDocument
+	a.recentMu.Unlock()
+
+	if fn != nil {
+		a.Go(func() { fn(path) })
+	}
+	_ = a.Emit("recent:open", map[string]string{"path": path})
+}
+
+// checkRecentLaunch, uygulama jump list'ten bir dokümanla başlatıldıysa
+// (argüman listedeki bir yolsa) frontend hazır olduğunda bildirir.
+func (a *Application) checkRecentLaunch() {
+	docs := a.RecentDocuments()
+	for _, arg := range os.Args[1:] {
+		abs, err := filepath.Abs(arg)
+		if err != nil || !slices.Contains(docs, abs) {
+			continue
+		}
+		a.OnFrontendReady(func() { a.openRecentDocument(abs) })
+		return
+	}
+}
+
+// loadRecentDocuments, listeyi diskten okur. recentMu tutulmalıdır.
+func (a *Application) loadRecentDocuments() []string {
+	dir, err := a.Paths().Config()
+	if err != nil {
+		return nil
+	}
+	data, err := a.readAppFile(filepath.Join(dir, recentFile))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			a.Logger().Warn("failed to read recent documents", "error", err)
+		}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		a.Logger().Warn("invalid recent documents file", "error", err)
+		return nil
+	}
+	return list
+}
+
+// saveRecentDocuments, listeyi diske yazar. recentMu tutulmalıdır.
+func (a *Application) saveRecentDocuments(list []string) error {
+	dir, err := a.Paths().Config()
+	if err != nil {
+		return err
+	}
+	if list == nil {
+		list = []string{}
+	}
+	data, err := json.Marshal(list)
+	if err != nil {
+		return err
+	}
+	return a.writeAppFile(filepath.Join(dir, recentFile), data)
+}
+
+// recentModule, son kullanılan dokümanların JS API'sidir (window.gomad.recent).
+//
+//	await gomad.recent.add("C:\\Users\\me\\report.gmd");
+//	const docs = await gomad.recent.list();
+//	gomad.on("recent:open", ({ path }) => openDocument(path));
+func (a *Application) recentModule() builtinModule {
+	return builtinModule{
+		namespace: "recent",
+		methods: map[string]interface{}{
+			"add":   a.AddRecentDocument,
+			"clear": a.ClearRecentDocuments,
+			"list":  a.RecentDocuments,
+			"open":  a.openRecentDocument,
+		},
+	}
+}
@@ -0,0 +1,11 @@
+//go:build !windows
+
+package gomad
+
+// macOS "Open Recent" menüsü NSDocumentController gerektirir (cgo); bu
+// platformlarda liste yalnızca uygulama tarafında tutulur ve
+// RecentDocumentsMenu ile menüye eklenir.
+
+func addNativeRecentDocument(path string) {}
+
+func clearNativeRecentDocuments() error { return nil }
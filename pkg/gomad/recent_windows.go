@@ -0,0 +1,19 @@
+//go:build windows
+
+package gomad
+
+import (
+	"runtime"
+
+	"github.com/biyonik/gomad/internal/platform/windows"
+)
+
+func addNativeRecentDocument(path string) {
+	windows.AddRecentDocument(path)
+}
+
+func clearNativeRecentDocuments() error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return windows.ClearRecentDocuments()
+}
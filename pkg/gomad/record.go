@@ -0,0 +1,101 @@
+package gomad
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/biyonik/gomad/internal/bridge"
+	"github.com/biyonik/gomad/internal/webview"
+)
+
+// Kayıt ve tekrar oynatma ortam değişkenleri. Kullanıcılar GOMAD_RECORD ile
+// bir hata anındaki köprü trafiğini kaydedip rapora ekleyebilir;
+// "gomad replay" kaydı GOMAD_REPLAY ile uygulamaya geri verir.
+const (
+	recordEnv         = "GOMAD_RECORD"
+	replayEnv         = "GOMAD_REPLAY"
+	replayRealtimeEnv = "GOMAD_REPLAY_REALTIME"
+)
+
+// ReplayError, tekrar oynatılan kayıtta cevabı değişen çağrılar olduğunda
+// Run'ın döndüğü hatadır.
+type ReplayError struct {
+	Mismatches []bridge.ReplayMismatch
+}
+
+func (e *ReplayError) Error() string {
+	return fmt.Sprintf("replay: %d call(s) produced a different response", len(e.Mismatches))
+}
+
+// startRecording, WithBridgeRecording veya GOMAD_RECORD ile verilen dosyaya
+// köprü trafiğini yazmaya başlar.
+func (a *Application) startRecording(wv webview.View) (stop func()) {
+	path := a.config.recordPath
+	if env := os.Getenv(recordEnv); env != "" {
+		path = env
+	}
+	if path == "" {
+		return func() {}
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		a.Logger().Warn("failed to open bridge recording", "path", path, "error", err)
+		return func() {}
+	}
+	cancel := wv.Bridge().Observe(bridge.NewRecorder(f).Record)
+	a.Logger().Info("recording bridge traffic", "path", path)
+	return func() {
+		cancel()
+		f.Close()
+	}
+}
+
+// startReplay, GOMAD_REPLAY ile verilen kaydı olay döngüsü başladıktan sonra
+// köprüden tekrar geçirir, farkları stderr'e yazar ve uygulamayı kapatır.
+func (a *Application) startReplay(wv webview.View) {
+	path := os.Getenv(replayEnv)
+	if path == "" {
+		return
+	}
+	realtime := os.Getenv(replayRealtimeEnv) == "1"
+
+	a.RunOnUIThread(func() {
+		a.Go(func() {
+			defer a.Quit()
+
+			f, err := os.Open(path)
+			if err != nil {
+				a.setReplayErr(err)
+				return
+			}
+			recording, err := bridge.ReadRecording(f)
+			f.Close()
+			if err != nil {
+				a.setReplayErr(err)
+				return
+			}
+
+			a.Logger().Info("replaying bridge recording", "path", path, "messages", len(recording))
+			start := time.Now()
+			mismatches := wv.Bridge().Replay(recording, realtime)
+			for _, m := range mismatches {
+				report, _ := json.MarshalIndent(m, "", "  ")
+				fmt.Fprintf(os.Stderr, "replay mismatch: %s\n%s\n", m.Call.Method, report)
+			}
+			a.Logger().Info("replay finished", "mismatches", len(mismatches), "duration", time.Since(start))
+			if len(mismatches) > 0 {
+				a.setReplayErr(&ReplayError{Mismatches: mismatches})
+			}
+		})
+	})
+}
+
+// setReplayErr, tekrar oynatma sonucunu Run'ın dönmesi için saklar.
+func (a *Application) setReplayErr(err error) {
+	a.mu.Lock()
+	a.replayErr = err
+	a.mu.Unlock()
+}
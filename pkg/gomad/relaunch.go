@@ -0,0 +1,82 @@
+package gomad
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// relaunchRequest, Run bittikten sonra başlatılacak süreç bilgisini tutar.
+type relaunchRequest struct {
+	exe  string
+	args []string
+}
+
+// Relaunch, uygulamanın yeni bir instance'ını başlatır ve mevcut olanı temiz
+// şekilde kapatır. "Ayarları uygula ve yeniden başlat" ve otomatik güncelleme
+// akışları için kullanılır.
+//
+// args verilmezse mevcut komut satırı argümanları (os.Args[1:]) kullanılır.
+//
+// Uygulama çalışıyorsa olay döngüsü durdurulur, WebView temizlenir ve yeni
+// süreç ancak bundan sonra başlatılır; Run, başlatma hatasını döner. Böylece
+// dosya kilitleri ve tek-instance kontrolleri yeni süreçle çakışmaz.
+// Uygulama çalışmıyorsa süreç hemen başlatılır; çıkış çağırana bırakılır.
+//
+// Örnek:
+//
+//	app.Bind("applySettings", func(s Settings) error {
+//	    if err := save(s); err != nil {
+//	        return err
+//	    }
+//	    return app.Relaunch()
+//	})
+func (a *Application) Relaunch(args ...string) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to resolve executable: %w", err)
+	}
+	return a.RelaunchExecutable(exe, args...)
+}
+
+// RelaunchExecutable, Relaunch gibi çalışır ancak farklı bir çalıştırılabilir
+// dosyayı başlatır. Güncelleme yeni bir yola kurulduğunda kullanılır.
+func (a *Application) RelaunchExecutable(exe string, args ...string) error {
+	if exe == "" {
+		return fmt.Errorf("executable path cannot be empty")
+	}
+	if args == nil {
+		args = append([]string(nil), os.Args[1:]...)
+	}
+
+	a.relaunch = &relaunchRequest{exe: exe, args: args}
+
+	if a.view() == nil {
+		return a.spawnRelaunch()
+	}
+
+	a.Logger().Info("relaunch requested", "exe", exe)
+	a.Quit()
+	return nil
+}
+
+// spawnRelaunch, bekleyen yeniden başlatma isteğini çalıştırır.
+// Yeni süreç beklenmez; mevcut süreç çıktığında bağımsız olarak yaşamaya devam eder.
+func (a *Application) spawnRelaunch() error {
+	req := a.relaunch
+	a.relaunch = nil
+
+	cmd := exec.Command(req.exe, req.args...) // #nosec G204 -- kendi çalıştırılabilir dosyamız
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = os.Environ()
+
+	if err := cmd.Start(); err != nil {
+		a.Logger().Error("failed to relaunch", "exe", req.exe, "error", err)
+		return fmt.Errorf("failed to relaunch: %w", err)
+	}
+
+	a.Logger().Info("relaunched", "exe", req.exe, "pid", cmd.Process.Pid)
+	return cmd.Process.Release()
+}
@@ -0,0 +1,152 @@
+package gomad
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"time"
+)
+
+// ============================================================================
+// RUNTIME STATS
+// Uzun süre açık kalan masaüstü oturumlarındaki sızıntıları (bellek,
+// goroutine) teşhis etmek için Go çalışma zamanı bilgileri:
+//
+//	JS: const { heapAlloc, numGC } = await gomad.sys.memStats()
+//	JS: const n = await gomad.sys.goroutines()
+//	JS: gomad.on("system:stats", ({ goroutines, mem }) => chart.push(mem.heapAlloc))
+//	JS: const path = await gomad.sys.profile("heap")   // debug veya WithProfiling
+//
+// "system:stats" olayı yalnızca WithRuntimeStats ile bir aralık verildiğinde
+// gönderilir. Profiller pprof formatında Logs dizinine yazılır ve
+// "go tool pprof <dosya>" ile incelenir.
+// ============================================================================
+
+// MemStats, runtime.MemStats'ın sızıntı teşhisi için yeterli alt kümesidir.
+type MemStats struct {
+	HeapAlloc   uint64        `json:"heapAlloc"`   // Heap'te ayrılmış ve yaşayan baytlar
+	HeapInuse   uint64        `json:"heapInuse"`   // Kullanımdaki heap span baytları
+	HeapObjects uint64        `json:"heapObjects"` // Yaşayan nesne sayısı
+	TotalAlloc  uint64        `json:"totalAlloc"`  // Başlangıçtan beri ayrılan toplam bayt
+	Sys         uint64        `json:"sys"`         // İşletim sisteminden alınan toplam bellek
+	NumGC       uint32        `json:"numGC"`       // Tamamlanan GC döngüsü sayısı
+	PauseTotal  time.Duration `json:"pauseTotal"`  // GC duraklamalarının toplamı
+	LastGC      time.Time     `json:"lastGC"`      // Son GC'nin bittiği an
+}
+
+// RuntimeStats, "system:stats" olayının verisidir.
+type RuntimeStats struct {
+	Time       time.Time `json:"time"`
+	Goroutines int       `json:"goroutines"`
+	Mem        MemStats  `json:"mem"`
+}
+
+// profileKinds, sys.profile ile yazılabilen pprof profilleridir.
+var profileKinds = map[string]bool{
+	"heap": true, "allocs": true, "goroutine": true,
+	"block": true, "mutex": true, "threadcreate": true,
+}
+
+// ReadMemStats, Go çalışma zamanının bellek istatistiklerini döner.
+// runtime.ReadMemStats kısa bir dünya durdurması (stop-the-world) yapar;
+// sıkı döngülerde çağrılmamalıdır.
+func ReadMemStats() MemStats {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	stats := MemStats{
+		HeapAlloc:   m.HeapAlloc,
+		HeapInuse:   m.HeapInuse,
+		HeapObjects: m.HeapObjects,
+		TotalAlloc:  m.TotalAlloc,
+		Sys:         m.Sys,
+		NumGC:       m.NumGC,
+		PauseTotal:  time.Duration(m.PauseTotalNs),
+	}
+	if m.LastGC > 0 {
+		stats.LastGC = time.Unix(0, int64(m.LastGC))
+	}
+	return stats
+}
+
+// ReadRuntimeStats, goroutine sayısı ve bellek istatistiklerini döner.
+func ReadRuntimeStats() RuntimeStats {
+	return RuntimeStats{Time: time.Now(), Goroutines: runtime.NumGoroutine(), Mem: ReadMemStats()}
+}
+
+// WriteProfile, verilen pprof profilini (heap, allocs, goroutine, block,
+// mutex, threadcreate) uygulamanın Logs dizinine yazar ve dosya yolunu döner.
+//
+// block ve mutex profilleri yalnızca runtime.SetBlockProfileRate ve
+// runtime.SetMutexProfileFraction ile örnekleme açıldıysa veri içerir.
+//
+// Örnek:
+//
+//	path, err := app.WriteProfile("heap")
+//	// go tool pprof <path>
+func (a *Application) WriteProfile(kind string) (string, error) {
+	if !profileKinds[kind] {
+		return "", fmt.Errorf("unknown profile %q", kind)
+	}
+	dir, err := a.Paths().Logs()
+	if err != nil {
+		return "", err
+	}
+
+	name := fmt.Sprintf("%s-%s.pprof", kind, time.Now().Format("20060102-150405.000"))
+	path := filepath.Join(dir, name)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return "", err
+	}
+	if err := pprof.Lookup(kind).WriteTo(f, 0); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	a.Logger().Info("profile written", "kind", kind, "path", path)
+	return path, nil
+}
+
+// startRuntimeStats, WithRuntimeStats ile verilen aralıkta "system:stats"
+// olayını gönderir ve durdurma fonksiyonunu döner.
+func (a *Application) startRuntimeStats() (stop func()) {
+	if a.config.runtimeStatsInterval <= 0 {
+		return func() {}
+	}
+	ticker := time.NewTicker(a.config.runtimeStatsInterval)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				_ = a.Emit("system:stats", ReadRuntimeStats())
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		ticker.Stop()
+		close(done)
+	}
+}
+
+// sysModule, çalışma zamanı bilgilerinin JS API'sidir (window.gomad.sys).
+// Profil yazma yalnızca debug modunda veya WithProfiling ile açılır.
+func (a *Application) sysModule() builtinModule {
+	methods := map[string]interface{}{
+		"memStats":   ReadMemStats,
+		"goroutines": runtime.NumGoroutine,
+	}
+	if a.config.debug || a.config.profiling {
+		methods["profile"] = a.WriteProfile
+	}
+	return builtinModule{
+		namespace: "sys",
+		methods:   methods,
+	}
+}
@@ -0,0 +1,257 @@
+package gomad
+
+import (
+	"fmt"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/webview"
+	"github.com/biyonik/gomad/pkg/dialog"
+	"github.com/biyonik/gomad/pkg/serial"
+)
+
+// ============================================================================
+// Seri portlar
+// Sayfa bir portu açmadan önce kullanıcıya native bir soru kutusuyla izin
+// sorulur; izin çalışma boyunca port başına bir kez istenir (bkz.
+// WithSerialPermission). Yalnızca serial.Ports'un listelediği portlar
+// açılabilir. Açık porttan gelen veri portu açan pencereye "serial:data"
+// ({port, data}) olarak gönderilir; port kapanırsa ya da çıkarılırsa
+// "serial:closed" ({port, error}) gelir.
+//
+//	const ports = await gomad.serial.ports();
+//	const port = await gomad.serial.open("COM3", { baudRate: 115200 });
+//	port.onData((bytes) => terminal.write(bytes)); // Uint8Array
+//	await port.write("AT\r\n");
+//	await port.close();
+//
+// Go tarafında portlar doğrudan pkg/serial ile açılabilir; izin sorulmaz.
+// ============================================================================
+
+// serialReadSize, okuma tamponunun boyutudur.
+const serialReadSize = 4096
+
+// serialData, JS'e gönderilen port verisidir; Data base64 olarak kodlanır.
+type serialData struct {
+	Port string `json:"port"`
+	Data []byte `json:"data"`
+}
+
+// serialAllowed, sayfanın portu açmasına izin verilip verilmediğini döner.
+// İzin verilirse çalışma boyunca hatırlanır; reddedilirse sonraki denemede
+// yeniden sorulur.
+func (a *Application) serialAllowed(name string) bool {
+	a.serialPromptMu.Lock()
+	defer a.serialPromptMu.Unlock()
+
+	a.serialMu.Lock()
+	granted := a.serialGrants[name]
+	a.serialMu.Unlock()
+	if granted {
+		return true
+	}
+
+	allowed := false
+	switch {
+	case a.config.serialPermission != nil:
+		allowed = a.config.serialPermission(name)
+	case a.config.headless:
+		// Soru kutusu gösterilemez
+	default:
+		btn, err := dialog.ShowMessage(dialog.MessageOptions{
+			Kind:    dialog.KindQuestion,
+			Title:   a.config.title,
+			Text:    fmt.Sprintf("This application wants to access the serial port %s.\n\nAllow access?", name),
+			Buttons: dialog.ButtonsYesNo,
+			Owner:   a.ownerHandle(),
+		})
+		allowed = err == nil && btn == dialog.ButtonYes
+	}
+	if allowed {
+		a.serialMu.Lock()
+		if a.serialGrants == nil {
+			a.serialGrants = make(map[string]bool)
+		}
+		a.serialGrants[name] = true
+		a.serialMu.Unlock()
+	}
+	a.Logger().Info("serial port access", "port", name, "allowed", allowed)
+	return allowed
+}
+
+// openSerial, portu izin alarak açar ve gelen veriyi portu açan sayfaya (wv)
+// iletmeye başlar. Port adı izin sorulmadan önce ayrılır; aynı portu açan
+// eşzamanlı ikinci çağrı hata alır.
+func (a *Application) openSerial(wv webview.View, name string, cfg *serial.Config) error {
+	ports, err := serial.Ports()
+	if err != nil {
+		return err
+	}
+	known := false
+	for _, p := range ports {
+		if p.Name == name {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return gomerrors.NewDeviceError("serial", "unknown port "+name, gomerrors.ErrInvalidArgument)
+	}
+	a.serialMu.Lock()
+	_, open := a.serialPorts[name]
+	if open || a.serialOpening[name] {
+		a.serialMu.Unlock()
+		return gomerrors.NewDeviceError("serial", name+" is already open", gomerrors.ErrInvalidArgument)
+	}
+	if a.serialOpening == nil {
+		a.serialOpening = make(map[string]bool)
+	}
+	a.serialOpening[name] = true
+	a.serialMu.Unlock()
+
+	port, err := a.openSerialPort(name, cfg)
+	a.serialMu.Lock()
+	delete(a.serialOpening, name)
+	if err == nil {
+		if a.serialPorts == nil {
+			a.serialPorts = make(map[string]*serial.Port)
+		}
+		a.serialPorts[name] = port
+	}
+	a.serialMu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	go a.readSerial(port, wv)
+	return nil
+}
+
+// openSerialPort, izin alıp portu açar; ad openSerial'da ayrılmış olmalıdır.
+func (a *Application) openSerialPort(name string, cfg *serial.Config) (*serial.Port, error) {
+	if !a.serialAllowed(name) {
+		return nil, gomerrors.NewDeviceError("serial", "access to "+name+" was denied", gomerrors.ErrPermissionDenied)
+	}
+	var config serial.Config
+	if cfg != nil {
+		config = *cfg
+	}
+	return serial.Open(name, config)
+}
+
+// readSerial, porttan okuyup portu açan sayfaya "serial:data" gönderir.
+// Okuma hatasında port kapatılır; closeSerial ile kapatıldıysa
+// "serial:closed" hatasız gönderilir.
+func (a *Application) readSerial(port *serial.Port, wv webview.View) {
+	defer a.Recover()
+	for {
+		buf := make([]byte, serialReadSize)
+		n, err := port.Read(buf)
+		if n > 0 {
+			_ = wv.Emit("serial:data", serialData{Port: port.Name(), Data: buf[:n]})
+		}
+		if err == nil {
+			continue
+		}
+
+		a.serialMu.Lock()
+		current := a.serialPorts[port.Name()] == port
+		if current {
+			delete(a.serialPorts, port.Name())
+		}
+		a.serialMu.Unlock()
+
+		closed := map[string]string{"port": port.Name()}
+		if current {
+			// Kapatma isteği olmadan durdu: aygıt çıkarıldı ya da hata oluştu
+			port.Close()
+			closed["error"] = err.Error()
+			a.Logger().Warn("serial port closed", "port", port.Name(), "error", err)
+		}
+		_ = wv.Emit("serial:closed", closed)
+		return
+	}
+}
+
+// writeSerial, açık porta yazar.
+func (a *Application) writeSerial(name string, data []byte) error {
+	a.serialMu.Lock()
+	port := a.serialPorts[name]
+	a.serialMu.Unlock()
+	if port == nil {
+		return gomerrors.NewDeviceError("serial", name+" is not open", gomerrors.ErrInvalidArgument)
+	}
+	_, err := port.Write(data)
+	return err
+}
+
+// closeSerial, portu kapatır.
+func (a *Application) closeSerial(name string) error {
+	a.serialMu.Lock()
+	port := a.serialPorts[name]
+	delete(a.serialPorts, name)
+	a.serialMu.Unlock()
+	if port == nil {
+		return nil
+	}
+	return port.Close()
+}
+
+// closeSerialPorts, açık portları kapatır. Run temizliğinde çağrılır.
+func (a *Application) closeSerialPorts() {
+	a.serialMu.Lock()
+	ports := a.serialPorts
+	a.serialPorts = nil
+	a.serialMu.Unlock()
+
+	for _, port := range ports {
+		port.Close()
+	}
+}
+
+// serialJS, open'ın döndürdüğü port nesnesini ve bayt dönüşümlerini ekler.
+// write string (UTF-8), Uint8Array ya da sayı dizisi alır.
+const serialJS = `
+(function() {
+    const api = window.gomad.serial;
+    const openPort = api.open;
+    const encode = (data) => {
+        const bytes = typeof data === 'string' ? new TextEncoder().encode(data) : Uint8Array.from(data);
+        let bin = '';
+        for (let i = 0; i < bytes.length; i++) bin += String.fromCharCode(bytes[i]);
+        return btoa(bin);
+    };
+    const decode = (b64) => Uint8Array.from(atob(b64 || ''), c => c.charCodeAt(0));
+    const writePort = api.write;
+    api.write = (name, data) => writePort(name, encode(data));
+    api.open = async function(name, config) {
+        await openPort(name, config || null);
+        return {
+            name,
+            write: (data) => api.write(name, data),
+            close: () => api.close(name),
+            onData: (fn) => window.gomad.on('serial:data', (e) => { if (e.port === name) fn(decode(e.data)); }),
+            onClose: (fn) => window.gomad.on('serial:closed', (e) => { if (e.port === name) fn(e.error || null); }),
+        };
+    };
+})();
+`
+
+// serialModule, seri portların JS API'sidir (window.gomad.serial).
+func (a *Application) serialModule() builtinModule {
+	return builtinModule{
+		namespace: "serial",
+		methods: map[string]interface{}{
+			"ports": serial.Ports,
+			"write": a.writeSerial,
+			"close": a.closeSerial,
+		},
+		viewMethods: func(wv webview.View) map[string]interface{} {
+			return map[string]interface{}{
+				"open": func(name string, cfg *serial.Config) error {
+					return a.openSerial(wv, name, cfg)
+				},
+			}
+		},
+		init: serialJS,
+	}
+}
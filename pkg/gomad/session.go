@@ -0,0 +1,406 @@
+package gomad
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/pkg/credentials"
+)
+
+// sessionAccount, oturum jetonunun kimlik deposundaki hesap adıdır.
+const sessionAccount = "gomad.session"
+
+// Oturum olayları
+const (
+	// SessionRefreshedEvent, jeton yenilendiğinde veya Set ile yeni oturum
+	// açıldığında gönderilir; verisi SessionStatus'tur.
+	SessionRefreshedEvent = "session:refreshed"
+
+	// SessionExpiredEvent, oturum sona erdiğinde (yenileme başarısız, jetonun
+	// süresi doldu veya Clear) gönderilir; verisi {reason, error?} nesnesidir.
+	SessionExpiredEvent = "session:expired"
+)
+
+// SessionToken, bir kimlik doğrulama oturumudur.
+type SessionToken struct {
+	AccessToken  string    `json:"accessToken"`
+	RefreshToken string    `json:"refreshToken,omitempty"`
+	ExpiresAt    time.Time `json:"expiresAt,omitzero"`
+}
+
+// expired, jetonun now anında geçersiz olup olmadığını döner.
+func (t SessionToken) expired(now time.Time) bool {
+	return !t.ExpiresAt.IsZero() && !now.Before(t.ExpiresAt)
+}
+
+// SessionStatus, oturumun JS'e giden durumudur. Jetonlar bu yapıda yer almaz.
+type SessionStatus struct {
+	Authenticated bool      `json:"authenticated"`
+	ExpiresAt     time.Time `json:"expiresAt,omitzero"`
+}
+
+// SessionOptions, WithSession ayarlarıdır.
+type SessionOptions struct {
+	// Refresh, jetonu yeniler. Jetonun süresi dolmadan Leeway kadar önce
+	// arka planda çağrılır. nil ise süresi dolan oturum sona erer.
+	Refresh func(ctx context.Context, current SessionToken) (SessionToken, error)
+
+	// Leeway, yenilemenin süre dolmadan ne kadar önce yapılacağıdır.
+	// Varsayılan: 1 dakika
+	Leeway time.Duration
+
+	// ExposeToJS, gomad.session.accessToken() ile erişim jetonunun JS'e
+	// verilmesine izin verir. Sayfa API'lere doğrudan fetch yapıyorsa gerekir;
+	// mümkünse istekler Go binding'leri üzerinden yapılmalı ve jeton sayfaya
+	// hiç girmemelidir. Varsayılan: false
+	ExposeToJS bool
+}
+
+// Session, kimlik doğrulama jetonlarının yaşam döngüsünü yönetir: jeton
+// işletim sisteminin güvenli kimlik deposunda saklanır (bkz. pkg/credentials),
+// süresi dolmadan yenilenir ve değişiklikler JS'e olay olarak bildirilir.
+//
+//	app := gomad.New(gomad.WithSession(gomad.SessionOptions{
+//	    Refresh: func(ctx context.Context, t gomad.SessionToken) (gomad.SessionToken, error) {
+//	        return authClient.Refresh(ctx, t.RefreshToken)
+//	    },
+//	}))
+//
+//	app.Bind("login", func(user, pass string) error {
+//	    tok, err := authClient.Login(user, pass)
+//	    if err != nil {
+//	        return err
+//	    }
+//	    return app.Session().Set(tok)
+//	})
+//
+//	// Go'dan API çağrısı: süresi dolmuşsa önce yenilenir
+//	token, err := app.Session().AccessToken(ctx)
+//
+//	// JS: gomad.on("session:expired", () => router.navigate(["/login"]));
+//
+// Güvenli depo yoksa (ör. secret-tool kurulu olmayan Linux) oturum yalnızca
+// bellekte tutulur ve uygulama kapanınca kaybolur.
+type Session struct {
+	app  *Application
+	opts SessionOptions
+
+	mu      sync.Mutex
+	token   SessionToken
+	loaded  bool
+	timer   *time.Timer
+	refresh *sessionRefresh // devam eden yenileme
+	failed  int             // art arda başarısız yenileme sayısı
+	// gen, Set ve Clear'da artar; yenileme sürerken oturum değiştiyse
+	// yenilemenin sonucu atılır
+	gen uint64
+}
+
+// sessionRefresh, devam eden bir yenilemedir; bekleyen çağıranlar done
+// kapanınca aynı sonucu (err) alır.
+type sessionRefresh struct {
+	done chan struct{}
+	err  error
+}
+
+// Session, uygulamanın oturum yöneticisini döner.
+func (a *Application) Session() *Session {
+	a.sessionOnce.Do(func() {
+		opts := SessionOptions{}
+		if a.config.session != nil {
+			opts = *a.config.session
+		}
+		if opts.Leeway <= 0 {
+			opts.Leeway = time.Minute
+		}
+		a.session = &Session{app: a, opts: opts}
+	})
+	return a.session
+}
+
+// Set, yeni bir oturum açar (ör. giriş sonrası): jetonu saklar, yenilemeyi
+// zamanlar ve "session:refreshed" gönderir.
+func (s *Session) Set(token SessionToken) error {
+	if token.AccessToken == "" {
+		return gomerrors.NewOperationError("session.set", "empty access token", gomerrors.ErrInvalidArgument)
+	}
+	s.mu.Lock()
+	s.loadLocked()
+	s.token, s.failed = token, 0
+	s.gen++
+	s.persistLocked()
+	s.scheduleLocked()
+	s.mu.Unlock()
+
+	s.emit(SessionRefreshedEvent, s.Status())
+	return nil
+}
+
+// Token, geçerli oturum jetonunu döner. Oturum yoksa veya süresi dolduysa false döner.
+func (s *Session) Token() (SessionToken, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loadLocked()
+	if s.token.AccessToken == "" || s.token.expired(time.Now()) {
+		return SessionToken{}, false
+	}
+	return s.token, true
+}
+
+// AccessToken, geçerli erişim jetonunu döner. Jetonun süresi dolmuşsa ya da
+// Leeway içindeyse önce yenilenir; eşzamanlı çağrılar tek bir yenilemeyi
+// bekler. Oturum yoksa gomad.ErrNotReady sarmalayan bir hata döner.
+func (s *Session) AccessToken(ctx context.Context) (string, error) {
+	for {
+		s.mu.Lock()
+		s.loadLocked()
+		tok := s.token
+		var pending chan struct{}
+		if s.refresh != nil {
+			pending = s.refresh.done
+		}
+		s.mu.Unlock()
+
+		if tok.AccessToken == "" {
+			return "", gomerrors.NewOperationError("session.token", "not signed in", gomerrors.ErrNotReady)
+		}
+		if pending != nil {
+			select {
+			case <-pending:
+				continue
+			case <-ctx.Done():
+				return "", ctx.Err()
+			}
+		}
+		if tok.ExpiresAt.IsZero() || time.Until(tok.ExpiresAt) > s.opts.Leeway || s.opts.Refresh == nil {
+			if tok.expired(time.Now()) {
+				return "", gomerrors.NewOperationError("session.token", "session expired", gomerrors.ErrNotReady)
+			}
+			return tok.AccessToken, nil
+		}
+		if err := s.Refresh(ctx); err != nil {
+			if !tok.expired(time.Now()) {
+				return tok.AccessToken, nil // Leeway içinde; jeton hâlâ geçerli
+			}
+			return "", err
+		}
+	}
+}
+
+// Refresh, jetonu hemen yeniler. Başarılıysa "session:refreshed" gönderilir.
+// Başarısız olursa ve jetonun süresi dolmuşsa oturum sona erer
+// ("session:expired"); dolmamışsa yenileme kısa süre sonra tekrar denenir.
+// Yenileme sürerken Set veya Clear çağrılırsa sonuç atılır: çıkış geri
+// alınmaz, yeni oturumun jetonu ezilmez. Devam eden bir yenilemeye katılan
+// çağrı onun hatasını alır.
+func (s *Session) Refresh(ctx context.Context) error {
+	s.mu.Lock()
+	s.loadLocked()
+	if s.opts.Refresh == nil || s.token.AccessToken == "" {
+		s.mu.Unlock()
+		return gomerrors.NewOperationError("session.refresh", "no refresh function or session", gomerrors.ErrNotReady)
+	}
+	if pending := s.refresh; pending != nil {
+		s.mu.Unlock()
+		select {
+		case <-pending.done:
+			return pending.err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	run := &sessionRefresh{done: make(chan struct{})}
+	s.refresh = run
+	current, gen := s.token, s.gen
+	s.mu.Unlock()
+
+	next, err := s.opts.Refresh(ctx, current)
+	if err == nil && next.AccessToken == "" {
+		err = errors.New("refresh returned an empty access token")
+	}
+
+	s.mu.Lock()
+	if s.gen != gen {
+		err = gomerrors.NewOperationError("session.refresh", "session changed during refresh", gomerrors.ErrClosed)
+	}
+	if s.refresh == run {
+		s.refresh = nil
+	}
+	run.err = err
+	close(run.done)
+	if s.gen != gen {
+		s.mu.Unlock()
+		return err
+	}
+	if err != nil {
+		s.failed++
+		expired := current.expired(time.Now())
+		if expired {
+			s.clearLocked()
+		} else {
+			s.scheduleLocked()
+		}
+		s.mu.Unlock()
+
+		s.app.Logger().Warn("session refresh failed", "error", err, "expired", expired)
+		if expired {
+			s.emit(SessionExpiredEvent, map[string]string{"reason": "refresh-failed", "error": err.Error()})
+		}
+		return err
+	}
+	s.token, s.failed = next, 0
+	s.persistLocked()
+	s.scheduleLocked()
+	s.mu.Unlock()
+
+	s.emit(SessionRefreshedEvent, s.Status())
+	return nil
+}
+
+// Clear, oturumu kapatır (ör. çıkış): jeton depodan silinir ve
+// "session:expired" ({reason: "cleared"}) gönderilir.
+func (s *Session) Clear() error {
+	s.mu.Lock()
+	s.loadLocked()
+	had := s.token.AccessToken != ""
+	s.clearLocked()
+	s.mu.Unlock()
+	if had {
+		s.emit(SessionExpiredEvent, map[string]string{"reason": "cleared"})
+	}
+	return nil
+}
+
+// Status, oturumun JS'e gösterilebilir durumunu döner.
+func (s *Session) Status() SessionStatus {
+	tok, ok := s.Token()
+	return SessionStatus{Authenticated: ok, ExpiresAt: tok.ExpiresAt}
+}
+
+// loadLocked, kaydedilmiş oturumu depodan bir kez okur ve yenilemeyi
+// zamanlar. s.mu tutulmalıdır.
+func (s *Session) loadLocked() {
+	if s.loaded {
+		return
+	}
+	s.loaded = true
+	data, err := credentials.Get(s.app.config.appID, sessionAccount)
+	if err != nil {
+		if !errors.Is(err, credentials.ErrNotFound) {
+			s.app.Logger().Debug("session store unavailable", "error", err)
+		}
+		return
+	}
+	if err := json.Unmarshal([]byte(data), &s.token); err != nil {
+		s.app.Logger().Warn("discarding unreadable session", "error", err)
+		s.token = SessionToken{}
+		return
+	}
+	s.scheduleLocked()
+}
+
+// persistLocked, jetonu güvenli depoya yazar. s.mu tutulmalıdır.
+func (s *Session) persistLocked() {
+	data, err := json.Marshal(s.token)
+	if err != nil {
+		return
+	}
+	if err := credentials.Set(s.app.config.appID, sessionAccount, string(data)); err != nil {
+		s.app.Logger().Warn("session kept in memory only", "error", err)
+	}
+}
+
+// clearLocked, jetonu bellekten ve depodan siler. s.mu tutulmalıdır.
+func (s *Session) clearLocked() {
+	s.token = SessionToken{}
+	s.gen++
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	if err := credentials.Delete(s.app.config.appID, sessionAccount); err != nil && !errors.Is(err, credentials.ErrNotFound) {
+		s.app.Logger().Debug("failed to delete stored session", "error", err)
+	}
+}
+
+// scheduleLocked, bir sonraki yenilemeyi (veya yenileme yoksa oturumun sona
+// ermesini) zamanlar. Başarısız yenilemeler 5s'den 1 dakikaya kadar artan
+// aralıklarla, en geç jetonun süresi dolarken tekrar denenir. s.mu tutulmalıdır.
+func (s *Session) scheduleLocked() {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	if s.token.ExpiresAt.IsZero() {
+		return
+	}
+
+	var wait time.Duration
+	var fire func()
+	if s.opts.Refresh != nil {
+		wait = time.Until(s.token.ExpiresAt) - s.opts.Leeway
+		if s.failed > 0 {
+			// Son deneme süre dolarken yapılır; o da başarısızsa oturum biter
+			wait = min(5*time.Second<<min(s.failed-1, 4), time.Minute, time.Until(s.token.ExpiresAt))
+		}
+		fire = func() {
+			ctx, cancel := context.WithTimeout(s.app.Context(), 30*time.Second)
+			defer cancel()
+			_ = s.Refresh(ctx)
+		}
+	} else {
+		wait = time.Until(s.token.ExpiresAt)
+		fire = s.expire
+	}
+	s.timer = time.AfterFunc(max(wait, 0), fire)
+}
+
+// expire, yenilenemeyen oturumun süresi dolduğunda çağrılır.
+func (s *Session) expire() {
+	s.mu.Lock()
+	if s.token.AccessToken == "" || !s.token.expired(time.Now()) {
+		s.mu.Unlock()
+		return
+	}
+	s.clearLocked()
+	s.mu.Unlock()
+	s.emit(SessionExpiredEvent, map[string]string{"reason": "expired"})
+}
+
+// emit, oturum olayını tüm pencerelere gönderir; pencere yoksa sessizce atlanır.
+func (s *Session) emit(event string, data any) {
+	if s.app.view() != nil {
+		_ = s.app.Broadcast(event, data)
+	}
+}
+
+// sessionModule, oturumun JS API'sidir (window.gomad.session). Jetonlar
+// varsayılan olarak JS'e verilmez (bkz. SessionOptions.ExposeToJS).
+//
+//	const { authenticated, expiresAt } = await gomad.session.status();
+//	await gomad.session.clear(); // çıkış
+//	gomad.on("session:expired", ({ reason }) => showLogin(reason));
+func (a *Application) sessionModule() builtinModule {
+	methods := map[string]interface{}{
+		"status": func() (SessionStatus, error) {
+			return a.Session().Status(), nil
+		},
+		"clear": func() error {
+			return a.Session().Clear()
+		},
+	}
+	if a.config.session != nil && a.config.session.ExposeToJS {
+		// Yenileme ağ isteği yapabilir; takılan bir sunucu çağrıyı
+		// sonsuza dek bekletmesin diye süre sınırlıdır.
+		methods["accessToken"] = func() (string, error) {
+			ctx, cancel := context.WithTimeout(a.Context(), 10*time.Second)
+			defer cancel()
+			return a.Session().AccessToken(ctx)
+		}
+	}
+	return builtinModule{namespace: "session", methods: methods}
+}
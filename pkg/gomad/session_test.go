@@ -0,0 +1,44 @@
+package gomad
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSessionRefreshDroppedAfterClear(t *testing.T) {
+	t.Setenv("PATH", "") // Kimlik deposu yok; oturum bellekte tutulur
+
+	started, release := make(chan struct{}), make(chan struct{})
+	a := &Application{config: &config{appID: "com.example.session", session: &SessionOptions{
+		Refresh: func(ctx context.Context, cur SessionToken) (SessionToken, error) {
+			close(started)
+			<-release
+			return SessionToken{AccessToken: "refreshed", ExpiresAt: time.Now().Add(time.Hour)}, nil
+		},
+	}}}
+	s := a.Session()
+	if err := s.Set(SessionToken{AccessToken: "old", ExpiresAt: time.Now().Add(time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+
+	first, joined := make(chan error, 1), make(chan error, 1)
+	go func() { first <- s.Refresh(context.Background()) }()
+	<-started
+	go func() { joined <- s.Refresh(context.Background()) }()
+
+	// Yenileme sürerken çıkış yapılır; yenilenen jeton geri yazılmamalı
+	if err := s.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	close(release)
+	if err := <-first; err == nil {
+		t.Fatal("refresh after Clear returned no error")
+	}
+	if err := <-joined; err == nil {
+		t.Fatal("joined refresh returned no error")
+	}
+	if tok, ok := s.Token(); ok {
+		t.Fatalf("session restored after Clear: %+v", tok)
+	}
+}
@@ -0,0 +1,271 @@
+package gomad
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// settingsFile, ayarların Config dizinindeki dosya adıdır.
+const settingsFile = "settings.json"
+
+// SettingsMigration, ayarları bir şema sürümünden bir sonrakine taşır.
+// values yerinde değiştirilir; hata dönerse geçiş iptal edilir ve dosyaya
+// dokunulmaz.
+//
+//	// v1 → v2: "theme" → "appearance.theme"
+//	gomad.WithSettingsMigration(1, func(v map[string]json.RawMessage) error {
+//	    v["appearance.theme"] = v["theme"]
+//	    delete(v, "theme")
+//	    return nil
+//	})
+type SettingsMigration func(values map[string]json.RawMessage) error
+
+// Settings, uygulamanın kalıcı kullanıcı tercihleridir. Değerler Config
+// dizinindeki settings.json'da, WithSettingsVersion ile verilen şema
+// sürümüyle birlikte saklanır (WithEncryptedData açıksa şifreli).
+//
+// Dosya daha eski bir sürümle yazılmışsa başlangıçta kayıtlı geçişler sırayla
+// çalışır; önce eski dosya settings.v<N>.bak.json olarak yedeklenir. Bir geçiş
+// eksikse ya da hata dönerse Run başlamaz ve dosya olduğu gibi kalır.
+// Dosya daha yeni bir sürümle yazılmışsa (uygulama geri alınmışsa) ayarlar
+// okunur ama yazılmaz; yeni sürümün verisi kaybolmaz.
+type Settings struct {
+	app      *Application
+	mu       sync.Mutex
+	values   map[string]json.RawMessage
+	version  int
+	readOnly bool
+	loadErr  error
+}
+
+// settingsDocument, settings.json'un biçimidir.
+type settingsDocument struct {
+	Version int                        `json:"version"`
+	Values  map[string]json.RawMessage `json:"values"`
+}
+
+// settingsChange, "settings:changed" olayının verisidir.
+type settingsChange struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Settings, uygulamanın ayar deposunu döner; ilk çağrıda dosyayı yükler ve
+// gerekiyorsa geçişleri çalıştırır.
+func (a *Application) Settings() *Settings {
+	a.settingsOnce.Do(func() {
+		a.settings = &Settings{app: a, values: map[string]json.RawMessage{}, version: a.config.settingsVersion}
+		a.settings.loadErr = a.settings.load()
+		if a.settings.loadErr != nil {
+			// Geçiş yapılamayan dosyanın üzerine yazılmaz
+			a.settings.readOnly = true
+		}
+	})
+	return a.settings
+}
+
+// Get, key'in değerini out'a çözer. Anahtar yoksa false döner.
+func (s *Settings) Get(key string, out any) (bool, error) {
+	s.mu.Lock()
+	raw, ok := s.values[key]
+	s.mu.Unlock()
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(raw, out)
+}
+
+// Set, key'e v'yi yazar ve dosyayı kaydeder. JS'e "settings:changed" olayı
+// ({key, value}) emit edilir.
+func (s *Settings) Set(key string, v any) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return s.update(key, raw)
+}
+
+// Delete, key'i siler ve dosyayı kaydeder.
+func (s *Settings) Delete(key string) error {
+	return s.update(key, nil)
+}
+
+// Keys, kayıtlı anahtarları sıralı döner.
+func (s *Settings) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Sorted(maps.Keys(s.values))
+}
+
+// Version, yüklenen ayarların şema sürümüdür.
+func (s *Settings) Version() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.version
+}
+
+func (s *Settings) update(key string, raw json.RawMessage) error {
+	s.mu.Lock()
+	if s.readOnly {
+		s.mu.Unlock()
+		return gomerrors.NewOperationError("settings.set", fmt.Sprintf("settings schema v%d cannot be written by this version (v%d)", s.version, s.app.config.settingsVersion), gomerrors.ErrPermissionDenied)
+	}
+	next := maps.Clone(s.values)
+	if raw == nil {
+		delete(next, key)
+	} else {
+		next[key] = raw
+	}
+	if err := s.save(next); err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	s.values = next
+	s.mu.Unlock()
+
+	if s.app.view() != nil {
+		_ = s.app.Broadcast("settings:changed", settingsChange{Key: key, Value: raw})
+	}
+	return nil
+}
+
+// reload, ayarları diskten yeniden yükler (bkz. ImportData). Değişiklik
+// JS'e boş anahtarlı bir "settings:changed" olayıyla bildirilir.
+func (s *Settings) reload() error {
+	s.mu.Lock()
+	s.values, s.version, s.readOnly = map[string]json.RawMessage{}, s.app.config.settingsVersion, false
+	err := s.load()
+	if err != nil {
+		s.readOnly = true
+	}
+	s.mu.Unlock()
+
+	if s.app.view() != nil {
+		_ = s.app.Broadcast("settings:changed", settingsChange{})
+	}
+	return err
+}
+
+// load, dosyayı okur ve şema sürümü eskiyse geçişleri uygular.
+func (s *Settings) load() error {
+	dir, err := s.app.Paths().Config()
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, settingsFile)
+
+	data, err := s.app.readAppFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var doc settingsDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		// Bozuk dosya kenara alınır; kullanıcı verisi silinmez
+		aside := fmt.Sprintf("%s.corrupt-%d", path, time.Now().Unix())
+		s.app.Logger().Error("settings file is corrupt, starting with defaults", "moved_to", aside, "error", err)
+		return os.Rename(path, aside)
+	}
+	if doc.Values == nil {
+		doc.Values = map[string]json.RawMessage{}
+	}
+
+	current := s.app.config.settingsVersion
+	s.values, s.version = doc.Values, doc.Version
+	switch {
+	case doc.Version == current:
+		return nil
+	case doc.Version > current:
+		s.readOnly = true
+		s.app.Logger().Warn("settings were written by a newer version; opening read-only", "file_version", doc.Version, "version", current)
+		return nil
+	}
+
+	// Geçişler kopya üzerinde çalışır; yalnızca hepsi başarılıysa yazılır
+	values := maps.Clone(doc.Values)
+	for v := doc.Version; v < current; v++ {
+		migrate, ok := s.app.config.settingsMigrations[v]
+		if !ok {
+			return gomerrors.NewOperationError("settings.migrate", fmt.Sprintf("no migration from v%d to v%d", v, v+1), gomerrors.ErrNotFound)
+		}
+		if err := runSettingsMigration(migrate, values); err != nil {
+			return fmt.Errorf("settings migration v%d to v%d: %w", v, v+1, err)
+		}
+	}
+
+	backup := filepath.Join(dir, fmt.Sprintf("settings.v%d.bak.json", doc.Version))
+	if err := s.app.writeAppFile(backup, data); err != nil {
+		return fmt.Errorf("settings backup: %w", err)
+	}
+	s.version = current
+	if err := s.save(values); err != nil {
+		s.version = doc.Version
+		return err
+	}
+	s.values = values
+	s.app.Logger().Info("settings migrated", "from", doc.Version, "to", current, "backup", backup)
+	return nil
+}
+
+// save, values'u geçerli şema sürümüyle yazar. s.mu tutulurken çağrılır.
+func (s *Settings) save(values map[string]json.RawMessage) error {
+	dir, err := s.app.Paths().Config()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(settingsDocument{Version: s.version, Values: values}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return s.app.writeAppFile(filepath.Join(dir, settingsFile), data)
+}
+
+// runSettingsMigration, geçişteki panic'i hataya çevirir.
+func runSettingsMigration(fn SettingsMigration, values map[string]json.RawMessage) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return fn(values)
+}
+
+// settingsModule, ayarların JS API'sidir (window.gomad.settings).
+//
+//	const theme = await gomad.settings.get("appearance.theme");
+//	await gomad.settings.set("appearance.theme", "dark");
+//	gomad.on("settings:changed", ({ key, value }) => ...);
+func (a *Application) settingsModule() builtinModule {
+	return builtinModule{
+		namespace: "settings",
+		methods: map[string]interface{}{
+			"get": func(key string) (json.RawMessage, error) {
+				s := a.Settings()
+				s.mu.Lock()
+				defer s.mu.Unlock()
+				return s.values[key], nil
+			},
+			"set": func(key string, value json.RawMessage) error {
+				return a.Settings().update(key, value)
+			},
+			"all": func() (map[string]json.RawMessage, error) {
+				s := a.Settings()
+				s.mu.Lock()
+				defer s.mu.Unlock()
+				return maps.Clone(s.values), nil
+			},
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package gomad
+
+import (
+	"github.com/biyonik/gomad/pkg/shortcuts"
+)
+
+// shortcutsModule, global kısayolların JS API'sidir (window.gomad.shortcuts).
+// Go tarafında doğrudan shortcuts paketi kullanılır.
+//
+//	await gomad.shortcuts.register("CmdOrCtrl+Shift+Space");
+//	gomad.on("shortcut", ({ accelerator }) => openQuickCapture());
+//	await gomad.shortcuts.unregister("CmdOrCtrl+Shift+Space");
+//
+// Kısayol başka bir uygulamaya aitse register hata ile reddedilir.
+func (a *Application) shortcutsModule() builtinModule {
+	return builtinModule{
+		namespace: "shortcuts",
+		methods: map[string]interface{}{
+			"register": func(accelerator string) error {
+				return shortcuts.Register(accelerator, func() {
+					_ = a.Emit("shortcut", map[string]string{"accelerator": accelerator})
+				})
+			},
+			"unregister":   shortcuts.Unregister,
+			"isRegistered": shortcuts.IsRegistered,
+		},
+	}
+}
@@ -0,0 +1,223 @@
+package gomad
+
+import (
+	"context"
+	"time"
+
+	"github.com/biyonik/gomad/internal/bridge"
+	"github.com/biyonik/gomad/internal/webview"
+)
+
+// ============================================================================
+// Düzgün kapanış
+// Pencere kapatıldığında ya da Quit çağrıldığında uygulama hemen kapanmaz:
+//
+//  1. Tüm pencerelere "app:will-quit" gönderilir ve pencereler gizlenir;
+//     sayfalar kaydedilmemiş değişiklikleri gönderir (gomad.onWillQuit
+//     dinleyicilerinin promise'leri beklenir).
+//  2. Köprü yeni çağrıları reddeder (ErrCodeShuttingDown) ve çalışan
+//     handler'ların bitmesi beklenir.
+//  3. Kuyruktaki olaylar ve cevaplar sayfaya iletilir.
+//  4. Kök context iptal edilir (bkz. Context) ve olay döngüsü durur.
+//
+// Tüm adımlar WithShutdownTimeout süresiyle sınırlıdır; süre dolarsa
+// kalan işler context iptaliyle durdurulur.
+//
+//	gomad.onWillQuit(async () => {
+//	    await editor.saveDraft();   // Uygulama bu çağrı bitene kadar bekler
+//	});
+//
+// Kapatma olayını native olarak yakalayamayan platformlarda pencere hemen
+// kapanır; çalışan handler'lar yine de beklenir, ancak sayfaya olay
+// gönderilemez.
+// ============================================================================
+
+// willQuitEvent, kapanış başlarken pencerelere gönderilen olaydır;
+// capability'leri sınırlı sayfalara da iletilir (bkz. bridge.WillQuitEvent).
+const willQuitEvent = bridge.WillQuitEvent
+
+// defaultShutdownTimeout, kapanışta işlerin beklendiği varsayılan süredir.
+const defaultShutdownTimeout = 5 * time.Second
+
+// beginShutdown, kapanışı başlatır; birden fazla çağrılırsa yalnızca ilki
+// etkilidir. UI thread'inde çalışır.
+func (a *Application) beginShutdown() {
+	a.shutdownMu.Lock()
+	started := a.shuttingDown
+	a.shuttingDown = true
+	a.shutdownMu.Unlock()
+	if started {
+		return
+	}
+
+	// Pencereler gizlenmeden önce: sınırlar ve büyütülmüş hâl okunabilsin
+	a.saveWindowSession()
+
+	wv := a.view()
+	if wv == nil {
+		a.cancel()
+		return
+	}
+	timeout := a.config.shutdownTimeout
+	if timeout <= 0 {
+		a.cancel()
+		wv.Terminate()
+		return
+	}
+
+	a.Logger().Info("shutting down", "timeout", timeout)
+	views := a.liveViews()
+	// Her sayfa yalnızca bir kez sayılır; kapanıştan önce gelen bildirimler
+	// bu listeye girmez
+	a.shutdownMu.Lock()
+	a.quitPending = make(map[webview.View]bool, len(views))
+	for _, v := range views {
+		a.quitPending[v] = true
+	}
+	a.quitDone = make(chan struct{})
+	done := a.quitDone
+	a.shutdownMu.Unlock()
+
+	for _, v := range views {
+		if err := v.Emit(willQuitEvent, nil); err != nil {
+			a.Logger().Debug("failed to send will-quit", "error", err)
+		}
+		if native := v.NativeWindow(); native != nil {
+			native.Hide()
+		}
+	}
+	go a.drain(wv, views, done, timeout)
+}
+
+// drain, sayfaların hazır olmasını (done kapanana kadar), çalışan çağrıları
+// ve kuyruktaki olayları bekler; ardından context'i iptal edip olay
+// döngüsünü durdurur.
+func (a *Application) drain(wv webview.View, views []webview.View, done <-chan struct{}, timeout time.Duration) {
+	defer a.Recover()
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	// 1. Sayfaların onWillQuit dinleyicileri (headless modda sayfa yoktur)
+	if !a.config.headless {
+		select {
+		case <-done:
+		case <-ctx.Done():
+			a.shutdownMu.Lock()
+			ready := len(views) - len(a.quitPending)
+			a.shutdownMu.Unlock()
+			a.Logger().Warn("pages did not finish will-quit in time", "ready", ready, "windows", len(views))
+		}
+	}
+	// Geç gelen bildirimler yok sayılır
+	a.shutdownMu.Lock()
+	a.quitPending, a.quitDone = nil, nil
+	a.shutdownMu.Unlock()
+
+	// 2. Yeni çağrılar reddedilir, çalışanlar beklenir
+	for _, v := range views {
+		if err := v.Bridge().Drain(ctx); err != nil {
+			a.Logger().Warn("bridge calls still running at shutdown", "calls", v.Bridge().InFlight())
+		}
+	}
+
+	// 3. Kuyruktaki olaylar ve cevaplar: UI kuyruğu sırayla işlendiği için
+	// bu işaretten önceki Eval'ler sayfaya ulaşmıştır
+	flushed := make(chan struct{})
+	wv.Dispatch(func() { close(flushed) })
+	select {
+	case <-flushed:
+	case <-ctx.Done():
+	}
+
+	// 4. Kalan işler iptal edilir
+	a.Logger().Info("shutdown drained", "duration", time.Since(start).Round(time.Millisecond))
+	a.cancel()
+	wv.Terminate()
+}
+
+// drainCalls, olay döngüsü kapanışı başlatılmadan durduğunda (pencere
+// native olarak kapandı) çalışan çağrıları bekler.
+func (a *Application) drainCalls(wv webview.View) {
+	a.shutdownMu.Lock()
+	started := a.shuttingDown
+	a.shuttingDown = true
+	a.shutdownMu.Unlock()
+	if started || a.config.shutdownTimeout <= 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), a.config.shutdownTimeout)
+	defer cancel()
+	if err := wv.Bridge().Drain(ctx); err != nil {
+		a.Logger().Warn("bridge calls still running at shutdown", "calls", wv.Bridge().InFlight())
+	}
+}
+
+// quitReady, wv'deki sayfanın onWillQuit dinleyicilerinin bittiğini
+// kaydeder. Kapanış sürmüyorsa, sayfa kapanışın beklediği sayfalardan biri
+// değilse ya da daha önce bildirmişse yok sayılır.
+func (a *Application) quitReady(wv webview.View) {
+	a.shutdownMu.Lock()
+	defer a.shutdownMu.Unlock()
+	if !a.quitPending[wv] {
+		return
+	}
+	delete(a.quitPending, wv)
+	if len(a.quitPending) == 0 {
+		close(a.quitDone)
+	}
+}
+
+// liveViews, sayfa gösteren WebView'leri döner: ana pencere ve oluşmuş
+// ikincil pencereler (havuzdakiler hariç).
+func (a *Application) liveViews() []webview.View {
+	views := []webview.View{}
+	if wv := a.view(); wv != nil {
+		views = append(views, wv)
+	}
+	a.windowMu.Lock()
+	defer a.windowMu.Unlock()
+	for _, w := range a.windows {
+		w.mu.Lock()
+		if w.view != nil && !w.closed {
+			views = append(views, w.view)
+		}
+		w.mu.Unlock()
+	}
+	return views
+}
+
+// shutdownModule, kapanışın JS tarafıdır. gomad.onWillQuit dinleyicileri
+// "app:will-quit" olayında çalışır; promise'leri bitince Go'ya bildirilir.
+//
+//	const off = gomad.onWillQuit(async () => await store.flush());
+func (a *Application) shutdownModule() builtinModule {
+	return builtinModule{
+		namespace: "app",
+		viewMethods: func(wv webview.View) map[string]interface{} {
+			return map[string]interface{}{
+				"quitReady": func() error {
+					a.quitReady(wv)
+					return nil
+				},
+			}
+		},
+		init: `
+(function() {
+    const handlers = [];
+    window.gomad.onWillQuit = (fn) => {
+        handlers.push(fn);
+        return () => {
+            const i = handlers.indexOf(fn);
+            if (i > -1) handlers.splice(i, 1);
+        };
+    };
+    window.gomad.on('` + willQuitEvent + `', () => {
+        Promise.allSettled(handlers.map(fn => Promise.resolve().then(fn)))
+            .then(() => window.gomad.app.quitReady())
+            .catch(() => {});
+    });
+})();
+`,
+	}
+}
@@ -0,0 +1,420 @@
+package gomad
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/sse"
+	"github.com/biyonik/gomad/internal/ws"
+)
+
+// socketEventPrefix, soket olaylarının önekidir: "feed" soketinin mesajları
+// "socket:feed", durum değişiklikleri "socket:feed:status" olayıyla gelir.
+const socketEventPrefix = "socket:"
+
+// Soket durumları
+const (
+	SocketConnecting = "connecting"
+	SocketOpen       = "open"
+	SocketRetrying   = "retrying"
+	SocketClosed     = "closed"
+)
+
+// SocketOptions, OpenSocket ayarlarıdır.
+type SocketOptions struct {
+	// URL, bağlanılacak adrestir. ws:// ve wss:// WebSocket, http:// ve
+	// https:// Server-Sent Events bağlantısı açar.
+	URL string
+
+	// Header, her bağlantı isteğine eklenen başlıklardır.
+	Header http.Header
+
+	// Auth, her (yeniden) bağlanmadan önce çağrılır ve Header'a eklenecek
+	// kimlik başlıklarını döner. Süresi dolan jetonlar burada yenilenir;
+	// sunucu 401/403 dönerse sonraki denemede Auth tekrar çağrılır.
+	Auth func(ctx context.Context) (http.Header, error)
+
+	// Protocols, WebSocket alt protokolleridir (Sec-WebSocket-Protocol).
+	Protocols []string
+
+	// MinBackoff ve MaxBackoff, yeniden bağlanma beklemesinin sınırlarıdır.
+	// Bekleme her başarısız denemede ikiye katlanır (±%20 jitter) ve bağlantı
+	// açılınca sıfırlanır. Varsayılan: 500ms ve 30s
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// OnMessage, her mesajda Go tarafında da çağrılır (isteğe bağlı).
+	OnMessage func(SocketMessage)
+}
+
+// SocketMessage, "socket:<name>" olayının verisidir.
+type SocketMessage struct {
+	// Text, metin mesajıdır (WebSocket text, SSE data).
+	Text string `json:"text,omitempty"`
+
+	// Binary, WebSocket ikili mesajıdır; JS'e base64 olarak gider.
+	Binary []byte `json:"binary,omitempty"`
+
+	// Event ve ID, SSE olayının türü ve kimliğidir.
+	Event string `json:"event,omitempty"`
+	ID    string `json:"id,omitempty"`
+}
+
+// SocketStatus, "socket:<name>:status" olayının verisidir.
+type SocketStatus struct {
+	State   string `json:"state"`
+	Attempt int    `json:"attempt,omitempty"`
+	RetryMs int64  `json:"retryMs,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Socket, Go tarafında tutulan ve mesajlarını JS'e olay olarak ileten
+// kalıcı bir WebSocket/SSE bağlantısıdır. Kopan bağlantı üstel bekleme ile
+// yeniden kurulur; WebView bağlantı yönetimiyle uğraşmaz.
+type Socket struct {
+	app    *Application
+	name   string
+	opts   SocketOptions
+	cancel context.CancelFunc
+	done   chan struct{}
+	// websocket false ise soket SSE'dir ve yalnızca okunur
+	websocket bool
+
+	mu     sync.Mutex
+	conn   *ws.Conn
+	status SocketStatus
+}
+
+// OpenSocket, name adıyla bir bağlantı açar ve arka planda yönetir.
+//
+//	app.OpenSocket("feed", gomad.SocketOptions{
+//	    URL: "wss://api.example.com/feed",
+//	    Auth: func(ctx context.Context) (http.Header, error) {
+//	        tok, err := auth.Token(ctx)
+//	        return http.Header{"Authorization": {"Bearer " + tok}}, err
+//	    },
+//	})
+//
+//	// JS:
+//	// gomad.on("socket:feed", (m) => render(JSON.parse(m.text)));
+//	// gomad.on("socket:feed:status", ({ state }) => setOnline(state === "open"));
+//	// await gomad.socket.send("feed", JSON.stringify({ subscribe: "prices" }));
+//
+// Aynı adla ikinci bir soket açılamaz; önce Close edilmelidir. Run'dan önce
+// de açılabilir; pencere yokken gelen mesajlar yalnızca OnMessage'a iletilir.
+func (a *Application) OpenSocket(name string, opts SocketOptions) (*Socket, error) {
+	u, err := url.Parse(opts.URL)
+	if err != nil {
+		return nil, gomerrors.NewOperationError("socket.open", "invalid URL", err)
+	}
+	switch u.Scheme {
+	case "ws", "wss", "http", "https":
+	default:
+		return nil, gomerrors.NewOperationError("socket.open", fmt.Sprintf("unsupported scheme %q", u.Scheme), gomerrors.ErrInvalidArgument)
+	}
+	if opts.MinBackoff <= 0 {
+		opts.MinBackoff = 500 * time.Millisecond
+	}
+	if opts.MaxBackoff < opts.MinBackoff {
+		opts.MaxBackoff = max(30*time.Second, opts.MinBackoff)
+	}
+
+	a.socketMu.Lock()
+	defer a.socketMu.Unlock()
+	if _, exists := a.sockets[name]; exists {
+		return nil, gomerrors.NewOperationError("socket.open", fmt.Sprintf("socket %q is already open", name), gomerrors.ErrAlreadyExists)
+	}
+	if a.sockets == nil {
+		a.sockets = map[string]*Socket{}
+	}
+
+	ctx, cancel := context.WithCancel(a.Context())
+	s := &Socket{
+		app:       a,
+		name:      name,
+		opts:      opts,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+		websocket: u.Scheme == "ws" || u.Scheme == "wss",
+	}
+	a.sockets[name] = s
+	go func() {
+		defer a.Recover()
+		s.run(ctx)
+	}()
+	return s, nil
+}
+
+// Socket, name adıyla açık soketi döner; yoksa nil.
+func (a *Application) Socket(name string) *Socket {
+	a.socketMu.Lock()
+	defer a.socketMu.Unlock()
+	return a.sockets[name]
+}
+
+// closeSockets, Run biterken açık tüm soketleri kapatır.
+func (a *Application) closeSockets() {
+	a.socketMu.Lock()
+	sockets := make([]*Socket, 0, len(a.sockets))
+	for _, s := range a.sockets {
+		sockets = append(sockets, s)
+	}
+	a.socketMu.Unlock()
+	for _, s := range sockets {
+		s.Close()
+	}
+}
+
+// Status, soketin güncel durumunu döner.
+func (s *Socket) Status() SocketStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.status
+}
+
+// Send, WebSocket'e metin mesajı gönderir. SSE soketlerinde ve bağlantı
+// açık değilken hata döner; mesajlar kuyruğa alınmaz.
+func (s *Socket) Send(text string) error {
+	return s.write(ws.OpText, []byte(text))
+}
+
+// SendBinary, WebSocket'e ikili mesaj gönderir.
+func (s *Socket) SendBinary(data []byte) error {
+	return s.write(ws.OpBinary, data)
+}
+
+func (s *Socket) write(op int, data []byte) error {
+	if !s.websocket {
+		return gomerrors.NewOperationError("socket.send", s.name, errSSESend)
+	}
+	s.mu.Lock()
+	conn := s.conn
+	s.mu.Unlock()
+	if conn == nil {
+		return gomerrors.NewOperationError("socket.send", fmt.Sprintf("socket %q is not open", s.name), gomerrors.ErrNotReady)
+	}
+	return conn.WriteMessage(op, data)
+}
+
+// Close, bağlantıyı kapatır ve yeniden bağlanmayı durdurur.
+func (s *Socket) Close() error {
+	s.cancel()
+	s.mu.Lock()
+	if s.conn != nil {
+		s.conn.Close()
+	}
+	s.mu.Unlock()
+	<-s.done
+
+	s.app.socketMu.Lock()
+	if s.app.sockets[s.name] == s {
+		delete(s.app.sockets, s.name)
+	}
+	s.app.socketMu.Unlock()
+	return nil
+}
+
+// run, bağlantıyı kurar, mesajları iletir ve koptuğunda bekleyip yeniden bağlanır.
+func (s *Socket) run(ctx context.Context) {
+	defer close(s.done)
+	defer s.setStatus(SocketStatus{State: SocketClosed})
+
+	client := &http.Client{}
+	backoff := s.opts.MinBackoff
+	lastID := ""
+	for attempt := 1; ; attempt++ {
+		s.setStatus(SocketStatus{State: SocketConnecting, Attempt: attempt})
+
+		var opened bool
+		var retry time.Duration
+		header, err := s.header(ctx)
+		if err == nil {
+			if s.websocket {
+				opened, err = s.serveWebSocket(ctx, header)
+			} else {
+				opened, retry, err = s.serveSSE(ctx, client, header, &lastID)
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if opened {
+			backoff, attempt = s.opts.MinBackoff, 1
+		}
+
+		wait := jitter(backoff)
+		if retry > 0 {
+			wait = retry
+		}
+		status := SocketStatus{State: SocketRetrying, Attempt: attempt, RetryMs: wait.Milliseconds()}
+		if err != nil {
+			status.Error = err.Error()
+			s.app.Logger().Warn("socket disconnected", "socket", s.name, "error", err, "retry", wait)
+		}
+		s.setStatus(status)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+		backoff = min(backoff*2, s.opts.MaxBackoff)
+	}
+}
+
+// header, sabit başlıklarla Auth'un döndüğü başlıkları birleştirir.
+func (s *Socket) header(ctx context.Context) (http.Header, error) {
+	header := s.opts.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	if s.opts.Auth != nil {
+		auth, err := s.opts.Auth(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("auth: %w", err)
+		}
+		for k, v := range auth {
+			header[k] = v
+		}
+	}
+	return header, nil
+}
+
+// serveWebSocket, tek bir WebSocket bağlantısını kopana kadar okur.
+func (s *Socket) serveWebSocket(ctx context.Context, header http.Header) (opened bool, err error) {
+	dialCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	conn, err := ws.Dial(dialCtx, s.opts.URL, header, s.opts.Protocols)
+	cancel()
+	if err != nil {
+		return false, err
+	}
+
+	s.mu.Lock()
+	s.conn = conn
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.conn = nil
+		s.mu.Unlock()
+		conn.Close()
+	}()
+	s.setStatus(SocketStatus{State: SocketOpen})
+
+	for {
+		op, data, err := conn.ReadMessage()
+		if err != nil {
+			return true, err
+		}
+		if op == ws.OpBinary {
+			s.deliver(SocketMessage{Binary: data})
+		} else {
+			s.deliver(SocketMessage{Text: string(data)})
+		}
+	}
+}
+
+// serveSSE, tek bir SSE akışını kopana kadar okur. lastID yeniden
+// bağlanmalar arasında korunur; sunucu kaldığı yerden devam eder.
+func (s *Socket) serveSSE(ctx context.Context, client *http.Client, header http.Header, lastID *string) (opened bool, retry time.Duration, err error) {
+	stream, err := sse.Connect(ctx, client, s.opts.URL, header, *lastID)
+	if err != nil {
+		return false, 0, err
+	}
+	defer stream.Close()
+	s.setStatus(SocketStatus{State: SocketOpen})
+
+	for {
+		ev, err := stream.Next()
+		*lastID = stream.LastID
+		if err != nil {
+			return true, stream.Retry, err
+		}
+		s.deliver(SocketMessage{Text: ev.Data, Event: ev.Event, ID: ev.ID})
+	}
+}
+
+// deliver, mesajı OnMessage'a ve JS'e iletir.
+func (s *Socket) deliver(msg SocketMessage) {
+	if s.opts.OnMessage != nil {
+		s.opts.OnMessage(msg)
+	}
+	if wv := s.app.view(); wv != nil {
+		if err := wv.Emit(socketEventPrefix+s.name, msg); err != nil {
+			s.app.Logger().Warn("failed to forward socket message", "socket", s.name, "error", err)
+		}
+	}
+}
+
+// setStatus, durumu kaydeder ve değiştiyse JS'e bildirir.
+func (s *Socket) setStatus(status SocketStatus) {
+	s.mu.Lock()
+	changed := s.status != status
+	s.status = status
+	s.mu.Unlock()
+	if !changed {
+		return
+	}
+	if wv := s.app.view(); wv != nil {
+		_ = wv.Emit(socketEventPrefix+s.name+":status", status)
+	}
+}
+
+// jitter, d'yi ±%20 rastgele kaydırır; aynı anda kopan istemciler sunucuya
+// aynı anda yüklenmesin.
+func jitter(d time.Duration) time.Duration {
+	return time.Duration(float64(d) * (0.8 + 0.4*rand.Float64()))
+}
+
+// socketModule, soketlerin JS API'sidir (window.gomad.socket). Soketler
+// yalnızca Go'dan açılır; JS açık soketlere mesaj gönderebilir.
+//
+//	await gomad.socket.send("feed", JSON.stringify({ subscribe: "prices" }));
+//	const { state } = await gomad.socket.status("feed");
+func (a *Application) socketModule() builtinModule {
+	lookup := func(name string) (*Socket, error) {
+		if s := a.Socket(name); s != nil {
+			return s, nil
+		}
+		return nil, gomerrors.NewOperationError("socket.lookup", fmt.Sprintf("socket %q is not open", name), gomerrors.ErrNotFound)
+	}
+	return builtinModule{
+		namespace: "socket",
+		methods: map[string]interface{}{
+			"send": func(name, text string) error {
+				s, err := lookup(name)
+				if err != nil {
+					return err
+				}
+				return s.Send(text)
+			},
+			"status": func(name string) (SocketStatus, error) {
+				s, err := lookup(name)
+				if err != nil {
+					return SocketStatus{}, err
+				}
+				return s.Status(), nil
+			},
+			"list": func() ([]string, error) {
+				a.socketMu.Lock()
+				defer a.socketMu.Unlock()
+				names := make([]string, 0, len(a.sockets))
+				for name := range a.sockets {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+				return names, nil
+			},
+		},
+	}
+}
+
+// errSSESend, SSE soketlerine Send çağrıldığında döner.
+var errSSESend = errors.New("server-sent events are receive-only")
@@ -0,0 +1,55 @@
+package gomad
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/pkg/dialog"
+)
+
+// startupFailed, Run'ın pencere açılmadan önceki ölümcül hatalarını işler:
+// hatayı loglar, pencere/webview kaynaklıysa native bir hata kutusuyla
+// kullanıcıya gösterir ve sarmalanmış hatayı döner.
+//
+// Bu noktada WebView (ve dolayısıyla sayfa içi bir hata ekranı) henüz yoktur;
+// kullanıcı kodundaki log.Fatalf çoğu zaman görünmeyen bir terminale yazar.
+func (a *Application) startupFailed(stage string, err error) error {
+	a.Logger().Error("failed to "+stage, "error", err)
+	wrapped := fmt.Errorf("failed to %s: %w", stage, err)
+
+	var werr *gomerrors.WindowError
+	if a.config.crashDialog && !a.config.headless && (stage == "create webview" || errors.As(err, &werr)) {
+		a.showStartupError(wrapped)
+	}
+	return wrapped
+}
+
+// showStartupError, hatayı ve sarmaladığı neden zincirini native bir hata
+// kutusunda gösterir. Native kutu yoksa metin stderr'e yazılır.
+func (a *Application) showStartupError(err error) {
+	var b strings.Builder
+	b.WriteString("The application could not be started.\n\n")
+	b.WriteString(err.Error())
+	if root := rootCause(err); root != err {
+		b.WriteString("\n\nCause: " + root.Error())
+	}
+
+	title := a.config.title + " failed to start"
+	if _, derr := dialog.Message(dialog.KindError, title, b.String(), dialog.ButtonsOK); derr != nil {
+		fmt.Fprintf(os.Stderr, "%s\n\n%s\n", title, b.String())
+	}
+}
+
+// rootCause, Unwrap zincirinin en içteki hatasını döner.
+func rootCause(err error) error {
+	for {
+		next := errors.Unwrap(err)
+		if next == nil {
+			return err
+		}
+		err = next
+	}
+}
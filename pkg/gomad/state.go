@@ -0,0 +1,415 @@
+package gomad
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// stateEventPrefix, durum değişikliklerinin yayınlandığı olay önekidir:
+// "user" durumu "state:user" olayıyla gönderilir.
+const stateEventPrefix = "state:"
+
+// statePatchAttempts, patch doğrulanırken durum değişirse kaç kez yeniden
+// deneneceğidir.
+const statePatchAttempts = 3
+
+// StateStore, Go'nun sahip olduğu ve JS'e otomatik senkronize edilen
+// adlandırılmış durum nesneleridir. Her Emit/on çifti elle yazılmak yerine
+// durum Go'da güncellenir; abone olan JS store'ları yalnızca değişen alanları
+// (JSON Merge Patch, RFC 7396) alır.
+//
+//	app.State().Set("user", User{Name: "Ahmet", Plan: "pro"})
+//
+//	// JS:
+//	// const off = gomad.state.subscribe("user", (user) => render(user));
+//
+// Varsayılan olarak durumlar JS için salt okunurdur; OnPatch ile JS'ten gelen
+// değişiklikler kabul edilebilir.
+type StateStore struct {
+	app     *Application
+	mu      sync.Mutex
+	entries map[string]*stateEntry
+}
+
+// stateEntry, tek bir adlandırılmış durumdur. value JSON'dan çözülmüş genel
+// halidir (map[string]any, []any, string...); farklar bunun üzerinden hesaplanır.
+type stateEntry struct {
+	value   any
+	version uint64
+	onPatch func(value json.RawMessage) error
+}
+
+// stateSnapshot, bir durumun JS'e giden tam halidir.
+type stateSnapshot struct {
+	Version uint64 `json:"version"`
+	Value   any    `json:"value"`
+}
+
+// stateChange, "state:<name>" olayının verisidir. Patch doluysa JS onu önceki
+// sürümün üzerine uygular; Value doluysa durum tamamen değiştirilir.
+type stateChange struct {
+	Version uint64 `json:"version"`
+	Patch   any    `json:"patch,omitempty"`
+	Value   any    `json:"value,omitempty"`
+	Full    bool   `json:"full,omitempty"`
+}
+
+// State, uygulamanın durum deposunu döner.
+func (a *Application) State() *StateStore {
+	a.stateOnce.Do(func() {
+		a.state = &StateStore{app: a, entries: map[string]*stateEntry{}}
+	})
+	return a.state
+}
+
+// Set, name durumunu v ile değiştirir ve değişen kısmı abone JS store'larına
+// gönderir. v JSON-serializable olmalıdır. Herhangi bir goroutine'den
+// çağrılabilir; Run'dan önce yapılan Set'ler JS abone olduğunda okunur.
+func (s *StateStore) Set(name string, v any) error {
+	value, err := normalizeState(v)
+	if err != nil {
+		return fmt.Errorf("state %q: %w", name, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.setLocked(name, value)
+	return nil
+}
+
+// Get, name durumunu out'a çözer. Durum yoksa false döner.
+func (s *StateStore) Get(name string, out any) (bool, error) {
+	s.mu.Lock()
+	e, ok := s.entries[name]
+	var value any
+	if ok {
+		value = e.value
+	}
+	s.mu.Unlock()
+	if !ok {
+		return false, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return true, err
+	}
+	return true, json.Unmarshal(data, out)
+}
+
+// OnPatch, name durumunu JS'ten yazılabilir yapar. JS'in gönderdiği patch
+// mevcut değere uygulanır ve sonuç fn'e verilir; fn nil dönerse durum
+// güncellenir ve tüm store'lara yayınlanır, hata dönerse değişiklik reddedilir
+// ve hata JS'teki çağrıya iletilir.
+//
+// fn içinden Get ve başka durumlar için Set çağrılabilir; aynı durum için Set
+// çağrılmamalıdır. fn çalışırken durumun sürümü değişirse patch güncel değere
+// yeniden uygulanır ve fn tekrar çağrılır; fn'in kendi Set'i de bu değişiklik
+// sayılacağından patch her denemede çakışır ve hata ile reddedilir.
+//
+//	app.State().OnPatch("settings", func(v json.RawMessage) error {
+//	    var s Settings
+//	    if err := json.Unmarshal(v, &s); err != nil {
+//	        return err
+//	    }
+//	    return s.Validate()
+//	})
+func (s *StateStore) OnPatch(name string, fn func(value json.RawMessage) error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := s.entry(name)
+	e.onPatch = fn
+}
+
+// entry, name durumunu döner; yoksa boş olarak oluşturur.
+func (s *StateStore) entry(name string) *stateEntry {
+	e, ok := s.entries[name]
+	if !ok {
+		e = &stateEntry{}
+		s.entries[name] = e
+	}
+	return e
+}
+
+// setLocked, değeri yazar ve değişikliği yayınlar. s.mu tutulmalıdır; olaylar
+// kilit altında gönderilir ki JS sürümleri sırayla alsın.
+func (s *StateStore) setLocked(name string, value any) {
+	e := s.entry(name)
+	if e.version > 0 && reflect.DeepEqual(e.value, value) {
+		return
+	}
+
+	change := stateChange{Version: e.version + 1}
+	if patch, ok := mergeDiff(e.value, value); ok && e.version > 0 && smallerJSON(patch, value) {
+		change.Patch = patch
+	} else {
+		change.Value, change.Full = value, true
+	}
+	e.value, e.version = value, change.Version
+
+	if s.app.view() != nil {
+		if err := s.app.Broadcast(stateEventPrefix+name, change); err != nil {
+			s.app.Logger().Warn("failed to publish state", "state", name, "error", err)
+		}
+	}
+}
+
+// snapshot, JS store'unun ilk abonelikte okuduğu tam durumdur.
+func (s *StateStore) snapshot(name string) stateSnapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.entries[name]; ok {
+		return stateSnapshot{Version: e.version, Value: e.value}
+	}
+	return stateSnapshot{}
+}
+
+// patch, JS'ten gelen merge patch'i uygular (bkz. OnPatch) ve yeni sürümü döner.
+// fn kilit dışında çalıştığından arada değer değişirse patch güncel değere
+// yeniden uygulanır; böylece eşzamanlı bir Set ezilmez.
+func (s *StateStore) patch(name string, patch json.RawMessage) (uint64, error) {
+	var p any
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return 0, gomerrors.NewOperationError("state.patch", "invalid patch", gomerrors.ErrInvalidArgument)
+	}
+
+	for attempt := 0; attempt < statePatchAttempts; attempt++ {
+		s.mu.Lock()
+		e, ok := s.entries[name]
+		if !ok || e.onPatch == nil {
+			s.mu.Unlock()
+			return 0, gomerrors.NewOperationError("state.patch", fmt.Sprintf("state %q is read-only", name), gomerrors.ErrPermissionDenied)
+		}
+		value := applyMergePatch(deepCopyJSON(e.value), p)
+		onPatch, version := e.onPatch, e.version
+		s.mu.Unlock()
+
+		// fn kilit dışında çağrılır; içinden Get ve başka durumlar için Set
+		// yapılabilir (bkz. OnPatch)
+		data, err := json.Marshal(value)
+		if err != nil {
+			return 0, err
+		}
+		if err := onPatch(data); err != nil {
+			return 0, err
+		}
+
+		s.mu.Lock()
+		if e.version == version {
+			s.setLocked(name, value)
+			version = e.version
+			s.mu.Unlock()
+			return version, nil
+		}
+		s.mu.Unlock()
+	}
+	return 0, gomerrors.NewOperationError("state.patch", fmt.Sprintf("state %q changed while the patch was being applied", name), nil)
+}
+
+// stateModule, durum deposunun JS API'sidir (window.gomad.state).
+//
+//	const off = gomad.state.subscribe("user", (user) => render(user));
+//	await gomad.state.patch("settings", { theme: "dark" });
+//	const user = gomad.state.current("user");
+func (a *Application) stateModule() builtinModule {
+	return builtinModule{
+		namespace: "state",
+		methods: map[string]interface{}{
+			"snapshot": func(name string) (stateSnapshot, error) {
+				return a.State().snapshot(name), nil
+			},
+			"patch": func(name string, patch json.RawMessage) (uint64, error) {
+				return a.State().patch(name, patch)
+			},
+		},
+		init: stateJS,
+	}
+}
+
+// stateJS, abone olunan her durum için tek bir yerel store tutar. Sürüm
+// atlanırsa (ör. sayfa arkadayken kaçırılan olay) tam durum yeniden okunur.
+const stateJS = `
+(function() {
+    const stores = {};
+
+    const isObject = (v) => v !== null && typeof v === 'object' && !Array.isArray(v);
+    const merge = (target, patch) => {
+        if (!isObject(patch)) return patch;
+        const out = isObject(target) ? Object.assign({}, target) : {};
+        for (const key of Object.keys(patch)) {
+            if (patch[key] === null) delete out[key];
+            else out[key] = merge(out[key], patch[key]);
+        }
+        return out;
+    };
+
+    const notify = (store) => {
+        for (const cb of store.listeners) {
+            try { cb(store.value); } catch (e) { console.error('[gomad] state listener failed:', e); }
+        }
+    };
+
+    const refresh = (name, store) =>
+        window.gomad.state.snapshot(name).then((snap) => {
+            if (snap.version <= store.version) return;
+            store.version = snap.version;
+            store.value = snap.value;
+            notify(store);
+        }).catch((e) => console.error('[gomad] state snapshot failed:', e));
+
+    const open = (name) => {
+        let store = stores[name];
+        if (store) return store;
+        store = stores[name] = { version: -1, value: undefined, listeners: new Set(), off: null };
+        store.off = window.gomad.on('state:' + name, (change) => {
+            if (change.full) {
+                store.value = change.value;
+            } else if (change.version === store.version + 1) {
+                store.value = merge(store.value, change.patch);
+            } else {
+                refresh(name, store);
+                return;
+            }
+            store.version = change.version;
+            notify(store);
+        });
+        refresh(name, store);
+        return store;
+    };
+
+    window.gomad.state.subscribe = (name, callback) => {
+        const store = open(name);
+        store.listeners.add(callback);
+        if (store.version > 0) callback(store.value);
+        return () => {
+            store.listeners.delete(callback);
+            if (store.listeners.size === 0) {
+                store.off();
+                delete stores[name];
+            }
+        };
+    };
+
+    window.gomad.state.current = (name) => stores[name] ? stores[name].value : undefined;
+})();
+`
+
+// normalizeState, v'yi JSON üzerinden genel değere çevirir; böylece Go
+// tipleri ile JS'ten gelen değerler aynı biçimde karşılaştırılabilir.
+func normalizeState(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// deepCopyJSON, genel JSON değerinin kopyasını döner; patch uygulanırken
+// mevcut değerin değişmemesi için kullanılır.
+func deepCopyJSON(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(t))
+		for k, val := range t {
+			out[k] = deepCopyJSON(val)
+		}
+		return out
+	case []any:
+		out := make([]any, len(t))
+		for i, val := range t {
+			out[i] = deepCopyJSON(val)
+		}
+		return out
+	}
+	return v
+}
+
+// applyMergePatch, RFC 7396 JSON Merge Patch'i target'a uygular.
+func applyMergePatch(target, patch any) any {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]any)
+	if !ok {
+		t = map[string]any{}
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+		t[k] = applyMergePatch(t[k], v)
+	}
+	return t
+}
+
+// mergeDiff, old'u new'e dönüştüren merge patch'i üretir. Merge patch null
+// değerleri "alanı sil" olarak yorumladığından, yeni değer nesne içinde null
+// taşıyorsa fark ifade edilemez ve ok=false döner (tam değer gönderilir).
+func mergeDiff(old, new any) (patch any, ok bool) {
+	o, oIsMap := old.(map[string]any)
+	n, nIsMap := new.(map[string]any)
+	if !oIsMap || !nIsMap {
+		return new, !hasNullMember(new)
+	}
+
+	out := map[string]any{}
+	for k := range o {
+		if _, exists := n[k]; !exists {
+			out[k] = nil
+		}
+	}
+	for k, nv := range n {
+		ov, exists := o[k]
+		if exists && reflect.DeepEqual(ov, nv) {
+			continue
+		}
+		if nv == nil {
+			return nil, false
+		}
+		sub, ok := mergeDiff(ov, nv)
+		if !ok {
+			return nil, false
+		}
+		out[k] = sub
+	}
+	return out, true
+}
+
+// hasNullMember, v nesnesinin (veya iç nesnelerinin) null değerli bir alanı
+// olup olmadığını döner. Diziler merge patch'te bütün olarak yazıldığından
+// içlerine bakılmaz.
+func hasNullMember(v any) bool {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return false
+	}
+	for _, val := range m {
+		if val == nil || hasNullMember(val) {
+			return true
+		}
+	}
+	return false
+}
+
+// smallerJSON, patch'in tam değerden daha küçük kodlanıp kodlanmadığını döner.
+func smallerJSON(patch, value any) bool {
+	p, err := json.Marshal(patch)
+	if err != nil {
+		return false
+	}
+	v, err := json.Marshal(value)
+	if err != nil {
+		return true
+	}
+	return len(p) < len(v)
+}
@@ -0,0 +1,165 @@
+package gomad
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// jsonValue, testlerdeki JSON metnini genel değere çözer.
+func jsonValue(t *testing.T, s string) any {
+	t.Helper()
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestMergeDiffRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		patch    string // boşsa fark ifade edilemez (ok=false)
+	}{
+		{"changed field", `{"a":1,"b":2}`, `{"a":1,"b":3}`, `{"b":3}`},
+		{"added field", `{"a":1}`, `{"a":1,"b":{"c":true}}`, `{"b":{"c":true}}`},
+		{"removed field", `{"a":1,"b":2}`, `{"a":1}`, `{"b":null}`},
+		{"nested", `{"u":{"name":"a","plan":"free"}}`, `{"u":{"name":"a","plan":"pro"}}`, `{"u":{"plan":"pro"}}`},
+		{"array replaced", `{"l":[1,2]}`, `{"l":[1,2,3]}`, `{"l":[1,2,3]}`},
+		{"scalar", `1`, `"x"`, `"x"`},
+		{"null member", `{"a":1}`, `{"a":null}`, ""},
+		{"nested null member", `{"a":1}`, `{"a":1,"b":{"c":null}}`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old, new := jsonValue(t, tt.old), jsonValue(t, tt.new)
+			patch, ok := mergeDiff(old, new)
+			if tt.patch == "" {
+				if ok {
+					t.Fatalf("mergeDiff = %v, want ok=false", patch)
+				}
+				return
+			}
+			if !ok || !reflect.DeepEqual(patch, jsonValue(t, tt.patch)) {
+				t.Fatalf("mergeDiff = %v, %v, want %s", patch, ok, tt.patch)
+			}
+			if got := applyMergePatch(deepCopyJSON(old), patch); !reflect.DeepEqual(got, new) {
+				t.Fatalf("applyMergePatch = %v, want %v", got, new)
+			}
+		})
+	}
+}
+
+func TestStatePatch(t *testing.T) {
+	s := (&Application{}).State()
+	if err := s.Set("settings", map[string]any{"theme": "light", "size": 12}); err != nil {
+		t.Fatal(err)
+	}
+
+	// OnPatch olmadan durum JS için salt okunurdur
+	var opErr *gomerrors.OperationError
+	if _, err := s.patch("settings", json.RawMessage(`{"theme":"dark"}`)); !errors.As(err, &opErr) {
+		t.Fatalf("read-only patch error = %v, want OperationError", err)
+	}
+
+	s.OnPatch("settings", func(v json.RawMessage) error {
+		var cfg struct{ Size int }
+		if err := json.Unmarshal(v, &cfg); err != nil {
+			return err
+		}
+		if cfg.Size <= 0 {
+			return errors.New("size must be positive")
+		}
+		return nil
+	})
+	version, err := s.patch("settings", json.RawMessage(`{"theme":"dark"}`))
+	if err != nil || version != 2 {
+		t.Fatalf("patch = %d, %v, want version 2", version, err)
+	}
+	if _, err := s.patch("settings", json.RawMessage(`{"size":0}`)); err == nil {
+		t.Fatal("rejected patch returned no error")
+	}
+	if _, err := s.patch("settings", json.RawMessage(`{`)); !errors.As(err, &opErr) {
+		t.Fatalf("invalid patch error = %v, want OperationError", err)
+	}
+
+	var got map[string]any
+	if ok, err := s.Get("settings", &got); !ok || err != nil {
+		t.Fatalf("Get = %v, %v", ok, err)
+	}
+	if want := map[string]any{"theme": "dark", "size": 12.0}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("settings = %v, want %v", got, want)
+	}
+}
+
+func TestStatePatchConcurrentSet(t *testing.T) {
+	s := (&Application{}).State()
+	if err := s.Set("doc", map[string]any{"a": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Doğrulama sırasında yapılan Set ezilmez; patch güncel değere yeniden uygulanır
+	calls := 0
+	s.OnPatch("doc", func(json.RawMessage) error {
+		calls++
+		if calls == 1 {
+			return s.Set("doc", map[string]any{"a": 1, "b": 2})
+		}
+		return nil
+	})
+	if _, err := s.patch("doc", json.RawMessage(`{"c":3}`)); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Fatalf("onPatch called %d times, want 2", calls)
+	}
+	if snap := s.snapshot("doc"); snap.Version != 3 ||
+		!reflect.DeepEqual(snap.Value, map[string]any{"a": 1.0, "b": 2.0, "c": 3.0}) {
+		t.Fatalf("snapshot = %+v", snap)
+	}
+}
+
+func TestStatePatchSetInsideCallback(t *testing.T) {
+	s := (&Application{}).State()
+	if err := s.Set("doc", map[string]any{"a": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Başka bir durumun Set'i patch'i etkilemez
+	calls := 0
+	s.OnPatch("doc", func(v json.RawMessage) error {
+		calls++
+		return s.Set("lastPatch", v)
+	})
+	if version, err := s.patch("doc", json.RawMessage(`{"b":2}`)); err != nil || version != 2 {
+		t.Fatalf("patch = %d, %v, want version 2", version, err)
+	}
+	if calls != 1 {
+		t.Fatalf("onPatch called %d times, want 1", calls)
+	}
+	if snap := s.snapshot("lastPatch"); !reflect.DeepEqual(snap.Value, map[string]any{"a": 1.0, "b": 2.0}) {
+		t.Fatalf("lastPatch = %+v", snap)
+	}
+
+	// Aynı durumun Set'i her denemede çakışma sayılır; patch reddedilir ve
+	// fn'in yazdığı değer ezilmez
+	calls = 0
+	s.OnPatch("doc", func(json.RawMessage) error {
+		calls++
+		return s.Set("doc", map[string]any{"a": calls})
+	})
+	var opErr *gomerrors.OperationError
+	if _, err := s.patch("doc", json.RawMessage(`{"c":3}`)); !errors.As(err, &opErr) {
+		t.Fatalf("patch error = %v, want OperationError", err)
+	}
+	if calls != statePatchAttempts {
+		t.Fatalf("onPatch called %d times, want %d", calls, statePatchAttempts)
+	}
+	if snap := s.snapshot("doc"); !reflect.DeepEqual(snap.Value, map[string]any{"a": float64(statePatchAttempts)}) {
+		t.Fatalf("snapshot = %+v", snap)
+	}
+}
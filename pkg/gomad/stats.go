@@ -0,0 +1,49 @@
+package gomad
+
+import "github.com/biyonik/gomad/internal/bridge"
+
+// BridgeStats, köprünün çalışma zamanı sayaçlarıdır: çağrı/hata/olay sayıları,
+// taşınan baytlar, son çağrıların p50/p99 gecikmesi ve reflection ile typed
+// yolların kullanımı.
+//
+//	s := app.BridgeStats()
+//	log.Printf("%.0f call/s, p99=%s, %d B in", s.CallsPerSec, s.P99, s.BytesIn)
+type BridgeStats = bridge.Stats
+
+// TypedFunc, reflection kullanılmadan çağrılan bir binding'dir.
+// Func0..Func3 ile üretilir ve Bind'e verilir.
+type TypedFunc = bridge.TypedFunc
+
+// Func0, argümansız bir fonksiyonu reflection'sız binding'e çevirir.
+func Func0[R any](fn func() (R, error)) TypedFunc { return bridge.Func0(fn) }
+
+// Func1, tek argümanlı bir fonksiyonu reflection'sız binding'e çevirir.
+//
+//	app.Bind("greet", gomad.Func1(func(name string) (string, error) {
+//		return "Merhaba " + name, nil
+//	}))
+func Func1[A, R any](fn func(A) (R, error)) TypedFunc { return bridge.Func1(fn) }
+
+// Func2, iki argümanlı bir fonksiyonu reflection'sız binding'e çevirir.
+func Func2[A, B, R any](fn func(A, B) (R, error)) TypedFunc { return bridge.Func2(fn) }
+
+// Func3, üç argümanlı bir fonksiyonu reflection'sız binding'e çevirir.
+func Func3[A, B, C, R any](fn func(A, B, C) (R, error)) TypedFunc { return bridge.Func3(fn) }
+
+// BridgeStats, köprü sayaçlarının anlık görüntüsünü döner.
+// Uygulama çalışmıyorsa sıfır değer döner.
+//
+// JS: const stats = await gomad.bridgeStats()
+func (a *Application) BridgeStats() BridgeStats {
+	if wv := a.view(); wv != nil {
+		return wv.Bridge().Stats()
+	}
+	return BridgeStats{}
+}
+
+// ResetBridgeStats, köprü sayaçlarını sıfırlar (ör. bir ölçüm öncesinde).
+func (a *Application) ResetBridgeStats() {
+	if wv := a.view(); wv != nil {
+		wv.Bridge().ResetStats()
+	}
+}
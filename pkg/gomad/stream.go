@@ -0,0 +1,44 @@
+package gomad
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// SendStream, r'yi name adlı akış olarak JS'e parça parça gönderir; JS tarafı
+// veriyi ReadableStream olarak okur. JS'in okuma hızına uyar (backpressure),
+// bu yüzden büyük dosyalar belleğe alınmadan aktarılır. Akış bitene ya da JS
+// iptal edene kadar bloklar; bir goroutine'den çağrılmalıdır.
+//
+// Örnek:
+//
+//	app.Bind("exportLog", func() error {
+//	    f, err := os.Open(logPath)
+//	    if err != nil {
+//	        return err
+//	    }
+//	    go func() {
+//	        defer f.Close()
+//	        _ = app.SendStream("log", f)
+//	    }()
+//	    return nil
+//	})
+//
+// JS:
+//
+//	gomad.onStream("log", async (stream) => {
+//	    const blob = await new Response(stream).blob();
+//	});
+func (a *Application) SendStream(name string, r io.Reader) error {
+	return a.SendStreamContext(a.Context(), name, r)
+}
+
+// SendStreamContext, SendStream'in iptal edilebilir hâlidir.
+func (a *Application) SendStreamContext(ctx context.Context, name string, r io.Reader) error {
+	wv := a.view()
+	if wv == nil {
+		return fmt.Errorf("application is not running")
+	}
+	return wv.Bridge().SendStreamContext(ctx, name, r)
+}
@@ -0,0 +1,81 @@
+package gomad
+
+import (
+	"github.com/biyonik/gomad/pkg/appearance"
+	"github.com/biyonik/gomad/pkg/network"
+	"github.com/biyonik/gomad/pkg/power"
+)
+
+// startSystemWatchers, işletim sistemi olaylarını JS'e ileten izleyicileri
+// başlatır ve hepsini durduran fonksiyonu döner. Run tarafından çağrılır.
+// Platformun desteklemediği izleyiciler sessizce atlanır (debug log).
+func (a *Application) startSystemWatchers() (stop func()) {
+	var cancels []func()
+
+	// Güç olayları → "power:suspend", "power:resume", "power:source", "power:battery"
+	if cancel, err := power.Subscribe(func(e power.Event) {
+		_ = a.Broadcast("power:"+string(e.Type), e.Status)
+	}); err != nil {
+		a.Logger().Debug("power monitor unavailable", "error", err)
+	} else {
+		cancels = append(cancels, cancel)
+	}
+
+	// Ağ değişiklikleri → "system:network" ({type, status})
+	if cancel, err := network.Subscribe(func(e network.Event) {
+		_ = a.Broadcast("system:network", e)
+	}); err != nil {
+		a.Logger().Debug("network monitor unavailable", "error", err)
+	} else {
+		cancels = append(cancels, cancel)
+	}
+
+	// Görünüm değişiklikleri → "system:appearance" (Settings) ve
+	// erişilebilirlik değişiklikleri → "a11y:changed" (AccessibilityState)
+	a.watchAccessibility()
+	if cancel, err := appearance.Subscribe(func(s appearance.Settings) {
+		_ = a.Broadcast("system:appearance", s)
+		a.onAppearanceChanged(s)
+		a.onAccessibilityChanged(s)
+	}); err != nil {
+		a.Logger().Debug("appearance monitor unavailable", "error", err)
+	} else {
+		cancels = append(cancels, cancel)
+	}
+
+	// Çalışma zamanı istatistikleri → "system:stats" (bkz. WithRuntimeStats)
+	cancels = append(cancels, a.startRuntimeStats())
+
+	return func() {
+		for _, cancel := range cancels {
+			cancel()
+		}
+	}
+}
+
+// powerModule, güç durumunun JS API'sidir (window.gomad.power).
+//
+//	const { onBattery, batteryPercent } = await gomad.power.status();
+//	gomad.on("power:resume", () => reconnect());
+//	gomad.on("power:source", ({ onBattery }) => { ... });
+func (a *Application) powerModule() builtinModule {
+	return builtinModule{
+		namespace: "power",
+		methods: map[string]interface{}{
+			"status": power.GetStatus,
+		},
+	}
+}
+
+// networkModule, ağ durumunun JS API'sidir (window.gomad.network).
+//
+//	const { online } = await gomad.network.status();
+//	gomad.on("system:network", ({ type, status }) => store.setOnline(status.online));
+func (a *Application) networkModule() builtinModule {
+	return builtinModule{
+		namespace: "network",
+		methods: map[string]interface{}{
+			"status": network.GetStatus,
+		},
+	}
+}
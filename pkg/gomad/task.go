@@ -0,0 +1,480 @@
+package gomad
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// Görev durumları
+const (
+	TaskQueued    = "queued"
+	TaskRunning   = "running"
+	TaskPaused    = "paused"
+	TaskCompleted = "completed"
+	TaskFailed    = "failed"
+	TaskCancelled = "cancelled"
+)
+
+// Görev olayları. Hepsinin verisi TaskInfo'dur.
+const (
+	TaskQueuedEvent    = "task:queued"
+	TaskStartedEvent   = "task:started"
+	TaskProgressEvent  = "task:progress"
+	TaskPausedEvent    = "task:paused"
+	TaskResumedEvent   = "task:resumed"
+	TaskCompletedEvent = "task:completed"
+	TaskFailedEvent    = "task:failed"
+	TaskCancelledEvent = "task:cancelled"
+)
+
+// taskProgressInterval, ardışık task:progress olayları arasındaki en kısa süredir.
+const taskProgressInterval = 100 * time.Millisecond
+
+// taskHistory, listede tutulan en fazla bitmiş görev sayısıdır.
+const taskHistory = 50
+
+// TaskFunc, bir görevin gövdesidir. Uzun döngüler t.Checkpoint ile duraklatma
+// ve iptal isteklerine cevap verir; dönen değer TaskInfo.Result olarak JS'e gider.
+type TaskFunc func(t *Task) (any, error)
+
+// TaskInfo, bir görevin JS'e ve Tasks'a dönen anlık görüntüsüdür.
+type TaskInfo struct {
+	ID         string    `json:"id"`
+	Kind       string    `json:"kind"`
+	Title      string    `json:"title,omitempty"`
+	State      string    `json:"state"`
+	Done       float64   `json:"done"`
+	Total      float64   `json:"total,omitempty"`
+	Message    string    `json:"message,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	Result     any       `json:"result,omitempty"`
+	QueuedAt   time.Time `json:"queuedAt"`
+	StartedAt  time.Time `json:"startedAt,omitzero"`
+	FinishedAt time.Time `json:"finishedAt,omitzero"`
+}
+
+// Task, çalışan (veya sırada bekleyen) bir görevdir.
+type Task struct {
+	q      *taskQueue
+	fn     TaskFunc
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu           sync.Mutex
+	info         TaskInfo
+	resume       chan struct{} // duraklatılmışken kapanınca devam edilir
+	lastProgress time.Time
+}
+
+// taskQueue, görevleri sırayla ve eşzamanlılık sınırıyla çalıştırır.
+type taskQueue struct {
+	app   *Application
+	limit int
+
+	mu      sync.Mutex
+	running int
+	pending []*Task // başlama sırasını bekleyen görevler (FIFO)
+	tasks   map[string]*Task
+	order   []string
+	kinds   map[string]func(t *Task, args json.RawMessage) (any, error)
+	nextID  int
+}
+
+// tasks, uygulamanın görev kuyruğunu döner.
+func (a *Application) tasks() *taskQueue {
+	a.taskOnce.Do(func() {
+		a.taskQueue = &taskQueue{
+			app:   a,
+			limit: a.config.taskConcurrency,
+			tasks: map[string]*Task{},
+			kinds: map[string]func(*Task, json.RawMessage) (any, error){},
+		}
+	})
+	return a.taskQueue
+}
+
+// StartTask, fn'i kind türünde yeni bir görev olarak kuyruğa alır.
+// Eşzamanlılık sınırı (bkz. WithTaskConcurrency) doluysa görev sırada bekler.
+//
+//	app.StartTask("export", "Exporting 1.240 notes", func(t *gomad.Task) (any, error) {
+//	    for i, n := range notes {
+//	        if err := t.Checkpoint(); err != nil {
+//	            return nil, err // iptal edildi
+//	        }
+//	        write(n)
+//	        t.Progress(float64(i+1), float64(len(notes)), n.Title)
+//	    }
+//	    return path, nil
+//	})
+func (a *Application) StartTask(kind, title string, fn TaskFunc) *Task {
+	return a.tasks().start(kind, title, fn)
+}
+
+// RegisterTask, JS'in gomad.tasks.start(kind, args) ile başlatabileceği bir
+// görev türü kaydeder. args JS'ten gelen değerin ham JSON'udur.
+//
+//	app.RegisterTask("import", func(t *gomad.Task, args json.RawMessage) (any, error) {
+//	    var req struct{ Path string }
+//	    if err := json.Unmarshal(args, &req); err != nil {
+//	        return nil, err
+//	    }
+//	    return importFile(t, req.Path)
+//	})
+//
+//	// JS: const { id } = await gomad.tasks.start("import", { path });
+func (a *Application) RegisterTask(kind string, fn func(t *Task, args json.RawMessage) (any, error)) {
+	q := a.tasks()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.kinds[kind] = fn
+}
+
+// Tasks, çalışan, sırada bekleyen ve son bitmiş görevleri başlama sırasıyla döner.
+func (a *Application) Tasks() []TaskInfo {
+	q := a.tasks()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	out := make([]TaskInfo, 0, len(q.order))
+	for _, id := range q.order {
+		out = append(out, q.tasks[id].Info())
+	}
+	return out
+}
+
+// Task, id'li görevi döner; yoksa nil.
+func (a *Application) Task(id string) *Task {
+	q := a.tasks()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.tasks[id]
+}
+
+func (q *taskQueue) start(kind, title string, fn TaskFunc) *Task {
+	ctx, cancel := context.WithCancel(q.app.Context())
+
+	q.mu.Lock()
+	q.nextID++
+	t := &Task{
+		q:      q,
+		fn:     fn,
+		ctx:    ctx,
+		cancel: cancel,
+		info: TaskInfo{
+			ID:       fmt.Sprintf("task-%d", q.nextID),
+			Kind:     kind,
+			Title:    title,
+			State:    TaskQueued,
+			QueuedAt: time.Now(),
+		},
+	}
+	q.tasks[t.info.ID] = t
+	q.order = append(q.order, t.info.ID)
+	q.pending = append(q.pending, t)
+	q.pruneLocked()
+	q.mu.Unlock()
+
+	t.emit(TaskQueuedEvent)
+	q.dispatch()
+	return t
+}
+
+// dispatch, boş yer oldukça sıradaki görevleri başlatır.
+func (q *taskQueue) dispatch() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for q.running < q.limit && len(q.pending) > 0 {
+		t := q.pending[0]
+		q.pending = q.pending[1:]
+		q.running++
+		go t.run()
+	}
+}
+
+// dequeue, sırada bekleyen t'yi kuyruktan çıkarır. t zaten başladıysa false döner.
+func (q *taskQueue) dequeue(t *Task) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i, p := range q.pending {
+		if p == t {
+			q.pending = append(q.pending[:i], q.pending[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// pruneLocked, en eski bitmiş görevleri taskHistory sınırına kadar atar.
+func (q *taskQueue) pruneLocked() {
+	finished := 0
+	for _, id := range q.order {
+		if q.tasks[id].finished() {
+			finished++
+		}
+	}
+	if finished <= taskHistory {
+		return
+	}
+	kept := q.order[:0]
+	for _, id := range q.order {
+		if finished > taskHistory && q.tasks[id].finished() {
+			delete(q.tasks, id)
+			finished--
+			continue
+		}
+		kept = append(kept, id)
+	}
+	q.order = kept
+}
+
+// run, görevi çalıştırır, sonucunu kaydeder ve sıradakine yer açar.
+func (t *Task) run() {
+	defer func() {
+		t.q.mu.Lock()
+		t.q.running--
+		t.q.mu.Unlock()
+		t.q.dispatch()
+	}()
+
+	t.mu.Lock()
+	t.info.State = TaskRunning
+	t.info.StartedAt = time.Now()
+	t.mu.Unlock()
+	t.emit(TaskStartedEvent)
+
+	result, err := t.call()
+	t.finish(result, err)
+}
+
+// call, görev gövdesini çalıştırır; panic'i görev hatasına çevirir. Bağlı
+// fonksiyonlardaki gibi panic yığınıyla loglanır ve raporlanır.
+func (t *Task) call() (result any, err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			perr := gomerrors.NewPanicError(rec, debug.Stack())
+			t.q.app.Logger().Error("task panicked",
+				"task", t.ID(),
+				"panic", fmt.Sprint(perr.Value),
+				"stack", string(perr.Stack))
+			t.q.app.writeCrashReport(perr)
+			err = perr
+		}
+	}()
+	return t.fn(t)
+}
+
+// finish, görevi bitmiş duruma geçirir ve ilgili olayı gönderir.
+func (t *Task) finish(result any, err error) {
+	t.mu.Lock()
+	t.info.FinishedAt = time.Now()
+	var event string
+	switch {
+	case t.ctx.Err() != nil && (err == nil || errors.Is(err, context.Canceled)):
+		t.info.State, event = TaskCancelled, TaskCancelledEvent
+	case err != nil:
+		t.info.State, t.info.Error, event = TaskFailed, err.Error(), TaskFailedEvent
+	default:
+		t.info.State, t.info.Result, event = TaskCompleted, result, TaskCompletedEvent
+	}
+	if t.resume != nil {
+		close(t.resume)
+		t.resume = nil
+	}
+	t.mu.Unlock()
+	t.cancel()
+
+	if event == TaskFailedEvent {
+		t.q.app.Logger().Warn("task failed", "task", t.info.ID, "kind", t.info.Kind, "error", err)
+	}
+	t.emit(event)
+}
+
+// Context, görev iptal edildiğinde kapanan context'tir. Ağ ve dosya
+// işlemlerine verilmelidir.
+func (t *Task) Context() context.Context { return t.ctx }
+
+// ID, görevin kimliğidir.
+func (t *Task) ID() string { return t.info.ID }
+
+// Info, görevin anlık görüntüsünü döner.
+func (t *Task) Info() TaskInfo {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.info
+}
+
+// finished, görevin bitip bitmediğini döner.
+func (t *Task) finished() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	switch t.info.State {
+	case TaskCompleted, TaskFailed, TaskCancelled:
+		return true
+	}
+	return false
+}
+
+// Progress, ilerlemeyi bildirir. total 0 ise ilerleme belirsizdir.
+// task:progress olayları en fazla 100ms'de bir gönderilir; son adım
+// (done >= total) her zaman gönderilir.
+func (t *Task) Progress(done, total float64, message string) {
+	t.mu.Lock()
+	t.info.Done, t.info.Total, t.info.Message = done, total, message
+	now := time.Now()
+	last := total > 0 && done >= total
+	if !last && now.Sub(t.lastProgress) < taskProgressInterval {
+		t.mu.Unlock()
+		return
+	}
+	t.lastProgress = now
+	t.mu.Unlock()
+	t.emit(TaskProgressEvent)
+}
+
+// Checkpoint, görev duraklatılmışsa devam ettirilene kadar bekler. Görev
+// iptal edildiyse context.Canceled döner; gövde bu hatayı döndürerek çıkmalıdır.
+// Duraklatma işbirlikçidir: yalnızca Checkpoint çağrılan noktalarda etkilidir.
+func (t *Task) Checkpoint() error {
+	t.mu.Lock()
+	resume := t.resume
+	t.mu.Unlock()
+	if resume != nil {
+		select {
+		case <-resume:
+		case <-t.ctx.Done():
+		}
+	}
+	return t.ctx.Err()
+}
+
+// Pause, görevi bir sonraki Checkpoint'te duraklatır.
+func (t *Task) Pause() error {
+	t.mu.Lock()
+	if t.info.State != TaskRunning {
+		state := t.info.State
+		t.mu.Unlock()
+		return gomerrors.NewTaskError(t.info.ID, "pause", fmt.Sprintf("task is %s", state), gomerrors.ErrInvalidArgument)
+	}
+	t.info.State = TaskPaused
+	t.resume = make(chan struct{})
+	t.mu.Unlock()
+	t.emit(TaskPausedEvent)
+	return nil
+}
+
+// Resume, duraklatılmış görevi devam ettirir.
+func (t *Task) Resume() error {
+	t.mu.Lock()
+	if t.info.State != TaskPaused {
+		state := t.info.State
+		t.mu.Unlock()
+		return gomerrors.NewTaskError(t.info.ID, "resume", fmt.Sprintf("task is %s", state), gomerrors.ErrInvalidArgument)
+	}
+	t.info.State = TaskRunning
+	close(t.resume)
+	t.resume = nil
+	t.mu.Unlock()
+	t.emit(TaskResumedEvent)
+	return nil
+}
+
+// Cancel, görevi iptal eder. Sırada bekleyen görev hiç başlamaz; çalışan
+// görevin Context'i kapanır ve Checkpoint hata döner.
+func (t *Task) Cancel() {
+	dequeued := t.q.dequeue(t)
+	t.cancel()
+	if dequeued {
+		t.finish(nil, context.Canceled)
+	}
+}
+
+// emit, görevin anlık görüntüsünü event olayıyla tüm pencerelere gönderir.
+func (t *Task) emit(event string) {
+	if t.q.app.view() != nil {
+		if err := t.q.app.Broadcast(event, t.Info()); err != nil {
+			t.q.app.Logger().Warn("failed to emit task event", "event", event, "error", err)
+		}
+	}
+}
+
+// tasksModule, görev kuyruğunun JS API'sidir (window.gomad.tasks).
+//
+//	const { id } = await gomad.tasks.start("import", { path });
+//	gomad.on("task:progress", (t) => t.id === id && setProgress(t.done / t.total));
+//	await gomad.tasks.pause(id); await gomad.tasks.resume(id);
+//	await gomad.tasks.cancel(id);
+//	const tasks = await gomad.tasks.list();
+func (a *Application) tasksModule() builtinModule {
+	lookup := func(id string) (*Task, error) {
+		if t := a.Task(id); t != nil {
+			return t, nil
+		}
+		return nil, gomerrors.NewTaskError(id, "lookup", "task not found", gomerrors.ErrNotFound)
+	}
+	return builtinModule{
+		namespace: "tasks",
+		methods: map[string]interface{}{
+			"list": func() ([]TaskInfo, error) {
+				return a.Tasks(), nil
+			},
+			"get": func(id string) (TaskInfo, error) {
+				t, err := lookup(id)
+				if err != nil {
+					return TaskInfo{}, err
+				}
+				return t.Info(), nil
+			},
+			"kinds": func() ([]string, error) {
+				q := a.tasks()
+				q.mu.Lock()
+				defer q.mu.Unlock()
+				kinds := make([]string, 0, len(q.kinds))
+				for k := range q.kinds {
+					kinds = append(kinds, k)
+				}
+				sort.Strings(kinds)
+				return kinds, nil
+			},
+			"start": func(kind string, args json.RawMessage) (TaskInfo, error) {
+				q := a.tasks()
+				q.mu.Lock()
+				fn, ok := q.kinds[kind]
+				q.mu.Unlock()
+				if !ok {
+					return TaskInfo{}, gomerrors.NewTaskError("", "start", fmt.Sprintf("unknown task kind %q", kind), gomerrors.ErrNotFound)
+				}
+				t := q.start(kind, "", func(t *Task) (any, error) { return fn(t, args) })
+				return t.Info(), nil
+			},
+			"pause": func(id string) error {
+				t, err := lookup(id)
+				if err != nil {
+					return err
+				}
+				return t.Pause()
+			},
+			"resume": func(id string) error {
+				t, err := lookup(id)
+				if err != nil {
+					return err
+				}
+				return t.Resume()
+			},
+			"cancel": func(id string) error {
+				t, err := lookup(id)
+				if err != nil {
+					return err
+				}
+				t.Cancel()
+				return nil
+			},
+		},
+	}
+}
@@ -0,0 +1,371 @@
+package gomad
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+)
+
+const (
+	// telemetryFile, onay durumunun ve anonim kimliğin Config dizinindeki
+	// dosya adıdır.
+	telemetryFile = "telemetry.json"
+	// telemetryQueueFile, gönderilemeyen olayların Cache dizinindeki dosyasıdır.
+	telemetryQueueFile = "telemetry-queue.json"
+	// telemetryBatchSize, dolduğunda beklemeden gönderilen toplu iş boyutudur.
+	telemetryBatchSize = 50
+	// telemetryMaxQueue, çevrimdışıyken tutulan en fazla olay sayısıdır;
+	// aşılırsa en eski olaylar atılır.
+	telemetryMaxQueue = 1000
+	// telemetryInterval, kuyruğun düzenli gönderim aralığıdır.
+	telemetryInterval = 30 * time.Second
+)
+
+// TelemetryEvent, dışa aktarılan tek bir kullanım olayıdır. Kullanıcıyı
+// tanımlayan bilgi içermez: AnonymousID rastgele üretilir ve telemetri
+// kapatıldığında silinir, SessionID her çalıştırmada yenilenir.
+type TelemetryEvent struct {
+	Name        string         `json:"name"`
+	Props       map[string]any `json:"props,omitempty"`
+	Time        time.Time      `json:"time"`
+	AnonymousID string         `json:"anonymousId"`
+	SessionID   string         `json:"sessionId"`
+	AppVersion  string         `json:"appVersion"`
+	OS          string         `json:"os"`
+}
+
+// TelemetryExporter, toplu olayları bir analitik arka ucuna gönderir.
+// Hata dönerse olaylar kuyrukta kalır ve sonra yeniden denenir.
+type TelemetryExporter interface {
+	Export(ctx context.Context, events []TelemetryEvent) error
+}
+
+// TelemetryExporterFunc, bir fonksiyonu TelemetryExporter olarak kullanmayı
+// sağlar.
+type TelemetryExporterFunc func(ctx context.Context, events []TelemetryEvent) error
+
+// Export, f'i çağırır.
+func (f TelemetryExporterFunc) Export(ctx context.Context, events []TelemetryEvent) error {
+	return f(ctx, events)
+}
+
+// NewHTTPTelemetryExporter, olayları url'e {"events": [...]} JSON gövdesiyle
+// POST eden bir exporter oluşturur. 2xx dışındaki yanıtlar hata sayılır.
+func NewHTTPTelemetryExporter(url string) TelemetryExporter {
+	return TelemetryExporterFunc(func(ctx context.Context, events []TelemetryEvent) error {
+		body, err := json.Marshal(map[string][]TelemetryEvent{"events": events})
+		if err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode/100 != 2 {
+			return fmt.Errorf("telemetry export: %s", resp.Status)
+		}
+		return nil
+	})
+}
+
+// Telemetry, uygulamanın isteğe bağlı kullanım ölçümüdür. Kullanıcı açıkça
+// onay vermedikçe (SetEnabled(true)) hiçbir olay kaydedilmez; onay kalıcıdır.
+//
+//	app := gomad.New(gomad.WithTelemetry(gomad.NewHTTPTelemetryExporter("https://t.example.com/v1")))
+//	app.Telemetry().Track("export.pdf", map[string]any{"pages": 12})
+//
+//	// JS:
+//	// gomad.telemetry.track("sidebar.toggle", { open: true });
+//
+// Olaylar bellekte toplanır, dolunca ya da düzenli aralıklarla gönderilir.
+// Gönderilemeyen olaylar kapanışta diske yazılır ve sonraki çalıştırmada
+// yeniden denenir.
+type Telemetry struct {
+	app       *Application
+	exporter  TelemetryExporter
+	sessionID string
+
+	mu    sync.Mutex
+	state telemetryState
+	queue []TelemetryEvent
+	// gen, kuyruğun başı her kesildiğinde artar; süren gönderim bu durumda
+	// kuyruktan olay silmez
+	gen     uint64
+	flushMu sync.Mutex
+	kick    chan struct{}
+}
+
+// telemetryState, diskte saklanan onay durumudur.
+type telemetryState struct {
+	Enabled     bool   `json:"enabled"`
+	AnonymousID string `json:"anonymousId,omitempty"`
+}
+
+// Telemetry, uygulamanın telemetri modülünü döner. WithTelemetry verilmediyse
+// Track çağrıları etkisizdir.
+func (a *Application) Telemetry() *Telemetry {
+	a.telemetryOnce.Do(func() {
+		t := &Telemetry{
+			app:       a,
+			exporter:  a.config.telemetryExporter,
+			sessionID: randomHex(8),
+			kick:      make(chan struct{}, 1),
+		}
+		t.state = t.loadState()
+		if t.state.Enabled {
+			t.queue = t.loadQueue()
+		}
+		a.telemetry = t
+	})
+	return a.telemetry
+}
+
+// Enabled, kullanıcının telemetriye onay verip vermediğini döner.
+func (t *Telemetry) Enabled() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.state.Enabled && t.exporter != nil
+}
+
+// SetEnabled, kullanıcının onayını kaydeder. Kapatıldığında bekleyen olaylar
+// silinir ve anonim kimlik unutulur; yeniden açıldığında yeni kimlik üretilir.
+// JS'e "telemetry:changed" ({enabled}) olayı emit edilir.
+func (t *Telemetry) SetEnabled(enabled bool) error {
+	t.mu.Lock()
+	next := telemetryState{Enabled: enabled}
+	if enabled {
+		next.AnonymousID = t.state.AnonymousID
+		if next.AnonymousID == "" {
+			next.AnonymousID = randomHex(16)
+		}
+	}
+	if err := t.saveState(next); err != nil {
+		t.mu.Unlock()
+		return err
+	}
+	t.state = next
+	if !enabled {
+		t.queue = nil
+		t.gen++
+	}
+	t.mu.Unlock()
+
+	if !enabled {
+		t.saveQueue(nil)
+	}
+	_ = t.app.Broadcast("telemetry:changed", map[string]bool{"enabled": enabled})
+	return nil
+}
+
+// Track, name olayını props özellikleriyle kuyruğa ekler. Onay yoksa ya da
+// exporter ayarlanmadıysa olay atılır. props JSON-serializable olmalıdır.
+func (t *Telemetry) Track(name string, props map[string]any) {
+	t.mu.Lock()
+	if !t.state.Enabled || t.exporter == nil {
+		t.mu.Unlock()
+		return
+	}
+	t.queue = append(t.queue, TelemetryEvent{
+		Name:        name,
+		Props:       props,
+		Time:        time.Now().UTC(),
+		AnonymousID: t.state.AnonymousID,
+		SessionID:   t.sessionID,
+		AppVersion:  Build().Version,
+		OS:          runtime.GOOS,
+	})
+	if over := len(t.queue) - telemetryMaxQueue; over > 0 {
+		t.queue = t.queue[over:]
+		t.gen++
+	}
+	full := len(t.queue) >= telemetryBatchSize
+	t.mu.Unlock()
+
+	if full {
+		select {
+		case t.kick <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Flush, bekleyen olayları toplu işler halinde hemen gönderir. Başarısız
+// olan toplu iş ve sonrakiler kuyrukta kalır.
+func (t *Telemetry) Flush(ctx context.Context) error {
+	if t.exporter == nil {
+		return nil
+	}
+	t.flushMu.Lock()
+	defer t.flushMu.Unlock()
+
+	for {
+		t.mu.Lock()
+		n := min(len(t.queue), telemetryBatchSize)
+		batch := append([]TelemetryEvent(nil), t.queue[:n]...)
+		gen := t.gen
+		t.mu.Unlock()
+		if n == 0 {
+			return nil
+		}
+
+		if err := t.exporter.Export(ctx, batch); err != nil {
+			return err
+		}
+
+		t.mu.Lock()
+		// Gönderim sırasında kapatıldıysa kuyruk zaten boşaltılmıştır
+		if t.gen == gen {
+			t.queue = t.queue[n:]
+		}
+		t.mu.Unlock()
+	}
+}
+
+// startTelemetry, kuyruğu düzenli aralıklarla gönderen döngüyü başlatır.
+// Dönen fonksiyon döngüyü durdurur, son bir gönderim dener ve kalan olayları
+// sonraki çalıştırma için diske yazar.
+func (a *Application) startTelemetry() (stop func()) {
+	if a.config.telemetryExporter == nil {
+		return func() {}
+	}
+	t := a.Telemetry()
+	ctx, cancel := context.WithCancel(a.Context())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(telemetryInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-t.kick:
+			}
+			if err := t.Flush(ctx); err != nil {
+				a.Logger().Debug("telemetry export failed", "error", err)
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+		flushCtx, cancelFlush := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancelFlush()
+		if err := t.Flush(flushCtx); err != nil {
+			a.Logger().Debug("telemetry export failed", "error", err)
+		}
+		t.mu.Lock()
+		queue := t.queue
+		t.mu.Unlock()
+		t.saveQueue(queue)
+	}
+}
+
+func (t *Telemetry) loadState() telemetryState {
+	var state telemetryState
+	dir, err := t.app.Paths().Config()
+	if err != nil {
+		return state
+	}
+	data, err := t.app.readAppFile(filepath.Join(dir, telemetryFile))
+	if err == nil {
+		_ = json.Unmarshal(data, &state)
+	}
+	return state
+}
+
+func (t *Telemetry) saveState(state telemetryState) error {
+	dir, err := t.app.Paths().Config()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return t.app.writeAppFile(filepath.Join(dir, telemetryFile), data)
+}
+
+func (t *Telemetry) loadQueue() []TelemetryEvent {
+	dir, err := t.app.Paths().Cache()
+	if err != nil {
+		return nil
+	}
+	data, err := t.app.readAppFile(filepath.Join(dir, telemetryQueueFile))
+	if err != nil {
+		return nil
+	}
+	var queue []TelemetryEvent
+	_ = json.Unmarshal(data, &queue)
+	return queue
+}
+
+// saveQueue, bekleyen olayları diske yazar; kuyruk boşsa dosyayı siler.
+func (t *Telemetry) saveQueue(queue []TelemetryEvent) {
+	dir, err := t.app.Paths().Cache()
+	if err != nil {
+		return
+	}
+	path := filepath.Join(dir, telemetryQueueFile)
+	if len(queue) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			t.app.Logger().Debug("telemetry queue remove failed", "error", err)
+		}
+		return
+	}
+	data, err := json.Marshal(queue)
+	if err == nil {
+		err = t.app.writeAppFile(path, data)
+	}
+	if err != nil {
+		t.app.Logger().Debug("telemetry queue write failed", "error", err)
+	}
+}
+
+// randomHex, n rastgele baytın onaltılık gösterimini döner.
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// telemetryModule, telemetrinin JS API'sidir (window.gomad.telemetry).
+//
+//	await gomad.telemetry.track("sidebar.toggle", { open: true });
+//	if (!(await gomad.telemetry.enabled())) showConsentBanner();
+//	await gomad.telemetry.setEnabled(true); // kullanıcı onayı
+func (a *Application) telemetryModule() builtinModule {
+	return builtinModule{
+		namespace: "telemetry",
+		methods: map[string]interface{}{
+			"track": func(name string, props map[string]any) error {
+				a.Telemetry().Track(name, props)
+				return nil
+			},
+			"enabled": func() (bool, error) {
+				return a.Telemetry().Enabled(), nil
+			},
+			"setEnabled": func(enabled bool) error {
+				return a.Telemetry().SetEnabled(enabled)
+			},
+		},
+	}
+}
@@ -0,0 +1,242 @@
+package gomad
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/pkg/appearance"
+)
+
+// ThemeAuto, işletim sisteminin koyu/açık tercihini izleyen tema seçimidir:
+// koyu modda Dark=true olan ilk tema, açık modda Dark=false olan ilk tema
+// uygulanır ve sistem tercihi değişince tema da değişir.
+const ThemeAuto = "auto"
+
+// ThemeChangedEvent, etkin tema değiştiğinde gönderilen olaydır; verisi
+// ThemeState'tir. gomad.theme bu olayla CSS değişkenlerini kendisi günceller.
+const ThemeChangedEvent = "theme:changed"
+
+// Theme, uygulamanın adlandırılmış görünüm tanımıdır. Alanlar sayfaya CSS
+// custom property olarak enjekte edilir:
+//
+//	Colors["primary"] → --gomad-color-primary
+//	Radii["md"]       → --gomad-radius-md
+//	Fonts["body"]     → --gomad-font-body
+//	Vars["--header-h"] veya Vars["header-h"] → --header-h
+//
+// Angular stilleri doğrudan kullanabilir:
+//
+//	.btn-primary { background: var(--gomad-color-primary); border-radius: var(--gomad-radius-md); }
+type Theme struct {
+	Name   string            `json:"name"`
+	Dark   bool              `json:"dark"`
+	Colors map[string]string `json:"colors,omitempty"`
+	Radii  map[string]string `json:"radii,omitempty"`
+	Fonts  map[string]string `json:"fonts,omitempty"`
+	Vars   map[string]string `json:"vars,omitempty"`
+}
+
+// ThemeState, etkin temanın JS'e giden halidir.
+type ThemeState struct {
+	// Name, uygulanan temanın adıdır.
+	Name string `json:"name"`
+
+	// Mode, seçimdir: bir tema adı veya ThemeAuto.
+	Mode string `json:"mode"`
+
+	// Dark, temanın koyu olup olmadığıdır (CSS color-scheme).
+	Dark bool `json:"dark"`
+
+	// Vars, CSS değişken adı → değer eşlemesidir.
+	Vars map[string]string `json:"vars"`
+}
+
+// cssVars, temanın CSS değişkenlerini üretir.
+func (t Theme) cssVars() map[string]string {
+	vars := map[string]string{}
+	add := func(prefix string, m map[string]string) {
+		for k, v := range m {
+			vars[prefix+k] = v
+		}
+	}
+	add("--gomad-color-", t.Colors)
+	add("--gomad-radius-", t.Radii)
+	add("--gomad-font-", t.Fonts)
+	for k, v := range t.Vars {
+		vars["--"+strings.TrimPrefix(k, "--")] = v
+	}
+	return vars
+}
+
+// Themes, WithThemes ile tanımlanan temaların adlarını döner.
+func (a *Application) Themes() []string {
+	names := make([]string, 0, len(a.config.themes))
+	for _, t := range a.config.themes {
+		names = append(names, t.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Theme, etkin temanın durumunu döner.
+func (a *Application) Theme() ThemeState {
+	a.themeMu.Lock()
+	defer a.themeMu.Unlock()
+	return a.themeLocked()
+}
+
+// themeLocked, seçimi (Mode) çözüp uygulanacak temayı döner ve adını
+// themeApplied'a kaydeder. a.themeMu tutulmalıdır.
+func (a *Application) themeLocked() ThemeState {
+	mode := a.themeModeLocked()
+	if len(a.config.themes) == 0 {
+		return ThemeState{Mode: mode, Vars: map[string]string{}}
+	}
+
+	theme, ok := a.findTheme(mode)
+	if mode == ThemeAuto || !ok {
+		dark := false
+		if s, err := appearance.Get(); err == nil {
+			dark = s.DarkMode
+		}
+		theme, ok = a.autoTheme(dark)
+	}
+	a.themeApplied = theme.Name
+	return ThemeState{Name: theme.Name, Mode: mode, Dark: theme.Dark, Vars: theme.cssVars()}
+}
+
+// themeModeLocked, seçili modu döner; seçim yapılmadıysa WithTheme, o da
+// yoksa ThemeAuto. a.themeMu tutulmalıdır.
+func (a *Application) themeModeLocked() string {
+	switch {
+	case a.themeMode != "":
+		return a.themeMode
+	case a.config.theme != "":
+		return a.config.theme
+	}
+	return ThemeAuto
+}
+
+// findTheme, adı verilen temayı döner.
+func (a *Application) findTheme(name string) (Theme, bool) {
+	for _, t := range a.config.themes {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return Theme{}, false
+}
+
+// autoTheme, koyu/açık tercihine uyan ilk temayı, yoksa ilk temayı döner.
+func (a *Application) autoTheme(dark bool) (Theme, bool) {
+	for _, t := range a.config.themes {
+		if t.Dark == dark {
+			return t, true
+		}
+	}
+	if len(a.config.themes) > 0 {
+		return a.config.themes[0], true
+	}
+	return Theme{}, false
+}
+
+// SetTheme, etkin temayı değiştirir: bir tema adı veya ThemeAuto. Sayfadaki
+// CSS değişkenleri yeniden yükleme olmadan güncellenir ve "theme:changed"
+// olayı gönderilir.
+//
+//	app.SetTheme("dark")
+//	app.SetTheme(gomad.ThemeAuto) // sistemi izle
+func (a *Application) SetTheme(name string) error {
+	if _, ok := a.findTheme(name); !ok && name != ThemeAuto {
+		return gomerrors.NewOperationError("theme.set", fmt.Sprintf("unknown theme %q", name), gomerrors.ErrNotFound)
+	}
+	a.themeMu.Lock()
+	before := a.themeLocked()
+	a.themeMode = name
+	after := a.themeLocked()
+	a.themeMu.Unlock()
+
+	if before.Name == after.Name && before.Mode == after.Mode {
+		return nil
+	}
+	// Run öncesinde sayfa yoktur; ilk tema themeModule ile sayfaya gömülür
+	if a.view() != nil {
+		return a.Broadcast(ThemeChangedEvent, after)
+	}
+	return nil
+}
+
+// onAppearanceChanged, sistem görünümü değişince ThemeAuto modunda temayı
+// yeniden seçer. startSystemWatchers tarafından çağrılır.
+func (a *Application) onAppearanceChanged(s appearance.Settings) {
+	if len(a.config.themes) == 0 {
+		return
+	}
+	a.themeMu.Lock()
+	if a.themeModeLocked() != ThemeAuto {
+		a.themeMu.Unlock()
+		return
+	}
+	theme, _ := a.autoTheme(s.DarkMode)
+	changed := theme.Name != a.themeApplied
+	a.themeApplied = theme.Name
+	a.themeMu.Unlock()
+
+	if changed {
+		_ = a.Broadcast(ThemeChangedEvent, ThemeState{Name: theme.Name, Mode: ThemeAuto, Dark: theme.Dark, Vars: theme.cssVars()})
+	}
+}
+
+// themeModule, temanın JS API'sidir (window.gomad.theme).
+//
+//	const { name, dark } = await gomad.theme.get();
+//	await gomad.theme.set("dark");
+//	const names = await gomad.theme.list();
+//
+// Değişkenler sayfa yüklenirken (ilk boyamadan önce) uygulanır; tema
+// değiştiğinde window'da "gomad:themechange" olayı da tetiklenir.
+func (a *Application) themeModule() builtinModule {
+	initial, _ := json.Marshal(a.Theme())
+
+	return builtinModule{
+		namespace: "theme",
+		methods: map[string]interface{}{
+			"get": func() (ThemeState, error) {
+				return a.Theme(), nil
+			},
+			"set": a.SetTheme,
+			"list": func() ([]string, error) {
+				return a.Themes(), nil
+			},
+		},
+		init: "(function() { const initial = " + string(initial) + ";\n" + themeJS + "})();\n",
+	}
+}
+
+// themeJS, ThemeState'i :root'a uygular. Önceki temanın bu temada olmayan
+// değişkenleri kaldırılır.
+const themeJS = `
+    let applied = [];
+    const apply = (state) => {
+        if (!state || !state.vars) return;
+        const root = document.documentElement;
+        for (const name of applied) {
+            if (!(name in state.vars)) root.style.removeProperty(name);
+        }
+        for (const [name, value] of Object.entries(state.vars)) {
+            root.style.setProperty(name, value);
+        }
+        applied = Object.keys(state.vars);
+        if (state.name) root.dataset.gomadThemeName = state.name;
+        root.style.colorScheme = state.dark ? 'dark' : 'light';
+        window.gomad.theme.current = state;
+        window.dispatchEvent(new CustomEvent('gomad:themechange', { detail: state }));
+    };
+    apply(initial);
+    window.gomad.on('theme:changed', apply);
+    // Sayfa SetTheme'den sonra yeniden yüklendiyse gömülü ilk durum eskidir
+    window.gomad.theme.get().then((s) => { if (s.name !== initial.name) apply(s); }).catch(() => {});
+`
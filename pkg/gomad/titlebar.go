@@ -0,0 +1,172 @@
+package gomad
+
+import (
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// ============================================================================
+// Başlık çubuğu overlay'i
+// Native başlık düğmeleri yerinde kalırken sayfa başlık çubuğu alanına uzanır
+// (bkz. WithTitlebarOverlay). Sayfa kendi başlık çubuğunu çizer; düğmelerin
+// dışında kalan alan CSS değişkenleriyle verilir:
+//
+//	--gomad-titlebar-area-x, --gomad-titlebar-area-y,
+//	--gomad-titlebar-area-width, --gomad-titlebar-area-height
+//
+// Değerler CSS pikselidir ve overlay kapalıyken 0'dır. Tarayıcının
+// env(titlebar-area-*) değişkenleri scriptten tanımlanamadığı için aynı adlar
+// "--gomad-" önekiyle kullanılır:
+//
+//	header {
+//	    height: var(--gomad-titlebar-area-height, 32px);
+//	    width: var(--gomad-titlebar-area-width, 100%);
+//	    -webkit-app-region: drag;
+//	}
+//	header button { -webkit-app-region: no-drag; }
+//
+// Pencereyi sürükleyen bölgeler "-webkit-app-region: drag" (ya da
+// data-gomad-drag özniteliği) ile işaretlenir; çift tıklama pencereyi büyütür.
+// Butonlar, linkler ve form elemanları ile "no-drag" (data-gomad-no-drag)
+// işaretli elemanlar tıklanabilir kalır.
+// ============================================================================
+
+// TitlebarGeometry, başlık çubuğunda sayfaya kalan alandır; "titlebar:geometry"
+// olayının verisidir. Değerler client alanına göre fiziksel pikseldir.
+type TitlebarGeometry struct {
+	// Overlay, overlay modunun açık olup olmadığıdır; kapalıysa alan boştur.
+	Overlay bool `json:"overlay"`
+	X       int  `json:"x"`
+	Y       int  `json:"y"`
+	Width   int  `json:"width"`
+	Height  int  `json:"height"`
+}
+
+// SetTitlebarOverlay, başlık çubuğu overlay modunu açar ya da kapatır.
+// Herhangi bir goroutine'den çağrılabilir; desteklenmeyen platformlarda
+// standart başlık çubuğu kalır.
+func (a *Application) SetTitlebarOverlay(enabled bool) {
+	a.RunOnUIThread(func() {
+		host, ok := a.titlebarHost()
+		if !ok {
+			if enabled {
+				a.Logger().Warn("titlebar overlay unavailable",
+					"error", gomerrors.NewWindowError("titlebar", "titlebar overlay", gomerrors.ErrNotSupported))
+			}
+			return
+		}
+		host.OnTitlebarGeometry(func(platform.TitlebarGeometry) {
+			_ = a.Emit("titlebar:geometry", a.titlebarGeometry())
+		})
+		if err := host.SetTitlebarOverlay(enabled); err != nil {
+			a.Logger().Warn("failed to set titlebar overlay", "error", err)
+			return
+		}
+		_ = a.Emit("titlebar:geometry", a.titlebarGeometry())
+	})
+}
+
+// titlebarHost, native pencerenin overlay desteğini döner.
+func (a *Application) titlebarHost() (platform.TitlebarHost, bool) {
+	host, ok := a.nativeWindow().(platform.TitlebarHost)
+	return host, ok
+}
+
+// titlebarGeometry, güncel overlay alanını döner. UI thread'inde çalışır.
+func (a *Application) titlebarGeometry() TitlebarGeometry {
+	host, ok := a.titlebarHost()
+	if !ok {
+		return TitlebarGeometry{}
+	}
+	g, overlay := host.TitlebarGeometry()
+	if !overlay {
+		return TitlebarGeometry{}
+	}
+	return TitlebarGeometry{Overlay: true, X: g.X, Y: g.Y, Width: g.Width, Height: g.Height}
+}
+
+// titlebarModule, overlay'in JS tarafıdır (window.gomad.titlebar). CSS
+// değişkenleri ve sürükleme bölgeleri init scriptinde kurulur.
+//
+//	const { overlay, height } = await gomad.titlebar.geometry();
+//	gomad.on("titlebar:geometry", (g) => layout(g));
+func (a *Application) titlebarModule() builtinModule {
+	return builtinModule{
+		namespace: "titlebar",
+		methods: map[string]interface{}{
+			"geometry": func() (TitlebarGeometry, error) {
+				return a.titlebarGeometry(), nil
+			},
+			"drag": func() error {
+				host, ok := a.titlebarHost()
+				if !ok {
+					return gomerrors.NewWindowError("titlebar", "window drag", gomerrors.ErrNotSupported)
+				}
+				return host.DragWindow()
+			},
+			"toggleMaximize": func() error {
+				host, ok := a.titlebarHost()
+				if !ok {
+					return gomerrors.NewWindowError("titlebar", "maximize", gomerrors.ErrNotSupported)
+				}
+				host.ToggleMaximize()
+				return nil
+			},
+		},
+		init: titlebarJS,
+	}
+}
+
+// titlebarJS, overlay alanını CSS değişkenlerine yazar ve sürükleme
+// bölgelerinde fare basışını pencere sürüklemesine çevirir.
+const titlebarJS = `
+(function() {
+    const titlebar = window.gomad.titlebar;
+    let current = { overlay: false, x: 0, y: 0, width: 0, height: 0 };
+
+    const apply = (g) => {
+        current = Object.assign({ overlay: false, x: 0, y: 0, width: 0, height: 0 }, g || {});
+        const root = document.documentElement;
+        if (!root) return;
+        const ratio = window.devicePixelRatio || 1;
+        for (const key of ['x', 'y', 'width', 'height']) {
+            root.style.setProperty('--gomad-titlebar-area-' + key, (current[key] / ratio) + 'px');
+        }
+        root.classList.toggle('gomad-titlebar-overlay', current.overlay);
+    };
+    titlebar.current = () => Object.assign({}, current);
+    window.gomad.on('titlebar:geometry', apply);
+    window.addEventListener('resize', () => apply(current));
+
+    const load = () => titlebar.geometry().then(apply).catch(() => {});
+    if (document.readyState === 'loading') {
+        document.addEventListener('DOMContentLoaded', load, { once: true });
+    } else {
+        load();
+    }
+
+    const interactive = new Set(['BUTTON', 'A', 'INPUT', 'SELECT', 'TEXTAREA', 'LABEL']);
+    const region = (el) => {
+        for (; el && el.nodeType === 1; el = el.parentElement) {
+            if (el.hasAttribute('data-gomad-no-drag')) return false;
+            if (el.hasAttribute('data-gomad-drag')) return true;
+            const style = getComputedStyle(el);
+            const value = style.getPropertyValue('-webkit-app-region') || style.getPropertyValue('app-region');
+            if (value === 'no-drag') return false;
+            if (value === 'drag') return true;
+            if (interactive.has(el.tagName) || el.isContentEditable) return false;
+        }
+        return false;
+    };
+
+    window.addEventListener('mousedown', (e) => {
+        if (!current.overlay || e.button !== 0 || !region(e.target)) return;
+        e.preventDefault();
+        if (e.detail === 2) {
+            titlebar.toggleMaximize().catch(() => {});
+        } else {
+            titlebar.drag().catch(() => {});
+        }
+    }, true);
+})();
+`
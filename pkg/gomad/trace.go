@@ -0,0 +1,28 @@
+package gomad
+
+import (
+	"os"
+
+	"github.com/biyonik/gomad/internal/bridge"
+	"github.com/biyonik/gomad/internal/webview"
+)
+
+// Tracer, köprü çağrıları için span başlatır (bkz. WithTracer).
+type Tracer = bridge.Tracer
+
+// Span, tek bir köprü çağrısının izidir.
+type Span = bridge.Span
+
+// CallInfo, span başlatılan çağrının bilgileridir: id, metod, çağıran sayfa
+// ve frontend'in gönderdiği meta başlığı (ör. "traceparent").
+type CallInfo = bridge.CallInfo
+
+// startTracing, WithTracer ile verilen izleyiciyi köprüye bağlar. Hot-swap
+// backend sürecinde çağrılar pencere sürecinde zaten izlendiği için
+// bağlanmaz.
+func (a *Application) startTracing(wv webview.View) {
+	if a.config.tracer == nil || os.Getenv(hotBackendEnv) != "" {
+		return
+	}
+	wv.Bridge().SetTracer(a.config.tracer)
+}
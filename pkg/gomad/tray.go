@@ -0,0 +1,242 @@
+package gomad
+
+import (
+	"sync"
+
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+// Tray, sistem tepsisi (Windows bildirim alanı, macOS menü çubuğu) ikonudur.
+// app.Tray() ile alınır; ilk çağrıda ikon oluşturulur.
+//
+// Örnek:
+//
+//	tray := app.Tray()
+//	tray.SetIcon(iconICO)
+//	tray.SetTooltip("My App")
+//	tray.SetToggleWindowOnClick(true)
+//	tray.SetMenu(
+//	    &gomad.MenuItem{Label: "Çıkış", OnClick: func(*gomad.MenuItem) { app.Quit() }},
+//	)
+//
+// JS tarafı:
+//
+//	await gomad.tray.setTooltip("3 yeni mesaj");
+//	gomad.on("tray:menu", ({ id, checked }) => { ... });
+//
+// Tüm metodlar herhangi bir goroutine'den çağrılabilir; native işlemler UI
+// thread'ine taşınır. Run öncesi yapılan ayarlar, uygulama başladığında uygulanır.
+// Olaylar (tray:click, tray:dblclick, tray:menu) ayrıca JS'e emit edilir.
+type Tray struct {
+	app    *Application
+	native platform.Tray
+
+	icon    []byte
+	tooltip string
+	menu    []*MenuItem
+
+	toggleWindow  bool
+	onClick       func()
+	onRightClick  func()
+	onDoubleClick func()
+
+	mu sync.Mutex
+}
+
+// Tray, uygulamanın tray ikonunu döner; ilk çağrıda oluşturur.
+func (a *Application) Tray() *Tray {
+	a.trayOnce.Do(func() {
+		a.tray = &Tray{app: a}
+		a.RunOnUIThread(a.tray.create)
+	})
+	return a.tray
+}
+
+// create, native ikonu oluşturur ve birikmiş durumu uygular. UI thread'inde çalışır.
+func (t *Tray) create() {
+	native, err := newNativeTray()
+	if err != nil {
+		t.app.Logger().Warn("tray unavailable", "error", err)
+		return
+	}
+
+	native.OnClick(t.handleClick)
+	native.OnDoubleClick(t.handleDoubleClick)
+	native.OnMenuItem(t.handleMenuItem)
+
+	t.mu.Lock()
+	t.native = native
+	icon, tooltip, menu := t.icon, t.tooltip, t.menu
+	t.mu.Unlock()
+
+	if icon != nil {
+		t.logErr("set icon", native.SetIcon(icon))
+	}
+	if tooltip != "" {
+		t.logErr("set tooltip", native.SetTooltip(tooltip))
+	}
+	if menu != nil {
+		t.logErr("set menu", native.SetMenu(toPlatformMenu(menu)))
+	}
+}
+
+// SetIcon, ikonu değiştirir. Windows'ta .ico formatında veri beklenir.
+func (t *Tray) SetIcon(icon []byte) {
+	t.mu.Lock()
+	t.icon = icon
+	t.mu.Unlock()
+	t.apply(func(n platform.Tray) error { return n.SetIcon(icon) }, "set icon")
+}
+
+// SetTooltip, fare ikonun üzerine geldiğinde görünen metni ayarlar.
+func (t *Tray) SetTooltip(text string) {
+	t.mu.Lock()
+	t.tooltip = text
+	t.mu.Unlock()
+	t.apply(func(n platform.Tray) error { return n.SetTooltip(text) }, "set tooltip")
+}
+
+// SetMenu, sağ tıkta açılan menüyü değiştirir. Öğesiz çağrı menüyü kaldırır.
+// Menü dinamiktir; checkbox ve etiket değişiklikleri için tekrar çağrılır.
+// Geçersiz role/kısayolda hata döner ve mevcut menü değişmez.
+func (t *Tray) SetMenu(items ...*MenuItem) error {
+	if err := validateMenu(items); err != nil {
+		return err
+	}
+	assignMenuIDs(items, "tray.")
+
+	t.mu.Lock()
+	t.menu = items
+	t.mu.Unlock()
+	t.apply(func(n platform.Tray) error { return n.SetMenu(toPlatformMenu(items)) }, "set menu")
+	return nil
+}
+
+// SetToggleWindowOnClick, sol tıklamanın ana pencereyi gösterip gizlemesini sağlar.
+// "Tepsiye küçült" davranışı için kullanılır.
+func (t *Tray) SetToggleWindowOnClick(enabled bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.toggleWindow = enabled
+}
+
+// OnClick, sol tıklamada çağrılacak callback'i ayarlar.
+func (t *Tray) OnClick(fn func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.onClick = fn
+}
+
+// OnRightClick, sağ tıklamada (menü açılmadan önce) çağrılacak callback'i ayarlar.
+func (t *Tray) OnRightClick(fn func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.onRightClick = fn
+}
+
+// OnDoubleClick, çift tıklamada çağrılacak callback'i ayarlar.
+func (t *Tray) OnDoubleClick(fn func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.onDoubleClick = fn
+}
+
+// Remove, ikonu tepsiden kaldırır.
+func (t *Tray) Remove() {
+	t.app.RunOnUIThread(func() {
+		t.mu.Lock()
+		native := t.native
+		t.native = nil
+		t.mu.Unlock()
+		if native != nil {
+			native.Destroy()
+		}
+	})
+}
+
+// apply, native ikon varsa işlemi UI thread'inde uygular.
+// Yoksa durum saklanmıştır ve create sırasında uygulanır.
+func (t *Tray) apply(fn func(platform.Tray) error, op string) {
+	t.app.RunOnUIThread(func() {
+		t.mu.Lock()
+		native := t.native
+		t.mu.Unlock()
+		if native != nil {
+			t.logErr(op, fn(native))
+		}
+	})
+}
+
+func (t *Tray) logErr(op string, err error) {
+	if err != nil {
+		t.app.Logger().Warn("tray operation failed", "op", op, "error", err)
+	}
+}
+
+// handleClick, native tıklamayı callback'lere ve JS'e iletir. UI thread'inde çağrılır.
+func (t *Tray) handleClick(button platform.MouseButton) {
+	t.mu.Lock()
+	toggle := t.toggleWindow
+	fn := t.onClick
+	if button == platform.MouseButtonRight {
+		fn = t.onRightClick
+	}
+	t.mu.Unlock()
+
+	if toggle && button == platform.MouseButtonLeft {
+		t.app.ToggleWindow()
+	}
+	if fn != nil {
+		t.app.Go(fn)
+	}
+
+	side := "left"
+	if button == platform.MouseButtonRight {
+		side = "right"
+	}
+	_ = t.app.Emit("tray:click", map[string]string{"button": side})
+}
+
+func (t *Tray) handleDoubleClick() {
+	t.mu.Lock()
+	fn := t.onDoubleClick
+	t.mu.Unlock()
+
+	if fn != nil {
+		t.app.Go(fn)
+	}
+	_ = t.app.Emit("tray:dblclick", nil)
+}
+
+func (t *Tray) handleMenuItem(id string) {
+	t.mu.Lock()
+	item := findMenuItem(t.menu, id)
+	if item != nil && item.Checkable {
+		item.Checked = !item.Checked
+	}
+	menu := t.menu
+	t.mu.Unlock()
+
+	if item == nil {
+		return
+	}
+	if item.Checkable {
+		// Native menü bir sonraki açılışta yeni durumu göstersin
+		t.apply(func(n platform.Tray) error { return n.SetMenu(toPlatformMenu(menu)) }, "set menu")
+	}
+	t.app.activateMenuItem(item)
+	_ = t.app.Emit("tray:menu", map[string]interface{}{"id": item.ID, "checked": item.Checked})
+}
+
+// trayModule, tray'in JS API'sidir (window.gomad.tray).
+func (a *Application) trayModule() builtinModule {
+	return builtinModule{
+		namespace: "tray",
+		methods: map[string]interface{}{
+			"setIcon":    func(icon []byte) { a.Tray().SetIcon(icon) },
+			"setTooltip": func(text string) { a.Tray().SetTooltip(text) },
+			"setMenu":    func(items []*MenuItem) error { return a.Tray().SetMenu(items...) },
+			"remove":     func() { a.Tray().Remove() },
+		},
+	}
+}
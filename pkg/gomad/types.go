@@ -0,0 +1,123 @@
+package gomad
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/biyonik/gomad/internal/webview"
+)
+
+// ============================================================================
+// TYPESCRIPT TANIMLARI
+// Kullanıcı binding'lerinin @gomad/client tanımları (.d.ts) iki yoldan alınır:
+//
+//	GOMAD_DEV_TYPES=127.0.0.1:34115 → "gomad dev" altında uygulama bu adreste
+//	    GET /types.d.ts sunar. Frontend build eklentileri (@gomad/client/vite,
+//	    /webpack, Angular builder) uzun yoklama ile değişiklikleri alır ve
+//	    tanım dosyasını günceller.
+//	GOMAD_TYPES_OUT=<dosya> → "gomad types" uygulamayı bu değişkenle başlatır;
+//	    binding'ler bağlandıktan sonra tanımlar dosyaya yazılır ve Run döner.
+// ============================================================================
+
+const (
+	devTypesEnv = "GOMAD_DEV_TYPES"
+	typesOutEnv = "GOMAD_TYPES_OUT"
+
+	// typesWait, uzun yoklamada tanımlar değişmezse 304 dönmeden önceki bekleme süresidir.
+	typesWait = 25 * time.Second
+)
+
+// TypeScript, uygulamanın binding'leri için @gomad/client tanım dosyası (.d.ts)
+// içeriğini döner. Yerleşik "gomad.*" binding'leri dahil edilmez.
+// Uygulama çalışmıyorsa boş string döner.
+func (a *Application) TypeScript() string {
+	wv := a.view()
+	if wv == nil {
+		return ""
+	}
+	return typeScript(wv)
+}
+
+// typeScript, View'in kullanıcı binding'leri için tanımları üretir.
+func typeScript(wv webview.View) string {
+	return wv.Bridge().TypeScript(func(name string) bool {
+		return !strings.HasPrefix(name, builtinPrefix)
+	})
+}
+
+// writeTypes, GOMAD_TYPES_OUT ayarlıysa tanımları dosyaya yazar.
+// Yazıldıysa true döner; Run bu durumda olay döngüsünü başlatmaz.
+func writeTypes(wv webview.View) (bool, error) {
+	path := os.Getenv(typesOutEnv)
+	if path == "" {
+		return false, nil
+	}
+	return true, os.WriteFile(path, []byte(typeScript(wv)), 0o644)
+}
+
+// startTypesServer, GOMAD_DEV_TYPES ayarlıysa tanım uç noktasını başlatır.
+// Dönen fonksiyon sunucuyu kapatır.
+func (a *Application) startTypesServer(wv webview.View) (stop func()) {
+	addr := os.Getenv(devTypesEnv)
+	if addr == "" || hotShellMode() {
+		return func() {} // Hot-swap'te tanımları binding'lerin bulunduğu backend sunar
+	}
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		a.Logger().Warn("dev: types endpoint unavailable", "addr", addr, "error", err)
+		return func() {}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /types.d.ts", func(w http.ResponseWriter, r *http.Request) {
+		body, etag := typesWithETag(wv)
+		if r.URL.Query().Has("wait") && r.Header.Get("If-None-Match") == etag {
+			ticker := time.NewTicker(250 * time.Millisecond)
+			defer ticker.Stop()
+			deadline := time.After(typesWait)
+		wait:
+			for {
+				select {
+				case <-r.Context().Done():
+					return
+				case <-deadline:
+					break wait
+				case <-ticker.C:
+					if body, etag = typesWithETag(wv); etag != r.Header.Get("If-None-Match") {
+						break wait
+					}
+				}
+			}
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", "no-store")
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/typescript; charset=utf-8")
+		w.Write([]byte(body))
+	})
+
+	srv := &http.Server{Handler: mux}
+	go func() {
+		if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			a.Logger().Warn("dev: types endpoint stopped", "error", err)
+		}
+	}()
+	a.Logger().Debug("dev: serving binding types", "url", "http://"+ln.Addr().String()+"/types.d.ts")
+	return func() { srv.Close() }
+}
+
+// typesWithETag, tanımları ve içerik özetinden üretilen ETag'i döner.
+func typesWithETag(wv webview.View) (string, string) {
+	body := typeScript(wv)
+	sum := sha256.Sum256([]byte(body))
+	return body, `"` + hex.EncodeToString(sum[:8]) + `"`
+}
@@ -0,0 +1,365 @@
+package gomad
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/pkg/update"
+)
+
+// updateFile, güncelleme ayarlarının Config dizinindeki dosya adıdır.
+const updateFile = "update.json"
+
+// updateState, diskte saklanan güncelleme ayarlarıdır.
+type updateState struct {
+	Channel   update.Channel `json:"channel,omitempty"`
+	InstallID string         `json:"installId"`
+}
+
+// UpdateChannel, kurulumun abone olduğu yayın kanalını döner. Kullanıcı
+// kanal seçmediyse WithUpdateChannel ile verilen varsayılan döner.
+func (a *Application) UpdateChannel() update.Channel {
+	a.updateMu.Lock()
+	defer a.updateMu.Unlock()
+	return a.loadUpdateState().Channel
+}
+
+// SetUpdateChannel, yayın kanalını değiştirir ve kalıcı olarak saklar.
+// Değişiklik JS'e "update:channel" ({channel}) olayı olarak bildirilir;
+// sonraki CheckForUpdate yeni kanala göre sürüm seçer.
+func (a *Application) SetUpdateChannel(channel update.Channel) error {
+	channel, err := update.ParseChannel(string(channel))
+	if err != nil {
+		return gomerrors.NewOperationError("update.setChannel", err.Error(), gomerrors.ErrInvalidArgument)
+	}
+
+	a.updateMu.Lock()
+	state := a.loadUpdateState()
+	changed := state.Channel != channel
+	state.Channel = channel
+	err = a.saveUpdateState(state)
+	a.updateMu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if changed {
+		a.Logger().Info("update channel changed", "channel", channel)
+		_ = a.Broadcast("update:channel", map[string]update.Channel{"channel": channel})
+	}
+	return nil
+}
+
+// CheckForUpdate, feed'de (WithUpdateFeed) bu kurulumun alması gereken
+// daha yeni bir sürüm olup olmadığını kontrol eder. Kanal ve kademeli yayın
+// yüzdesi dikkate alınır; kurulum yayına henüz dahil değilse veya uygulama
+// günceldeyse nil döner. Bulunan sürüm JS'e sürüm notlarıyla birlikte sticky
+// "update:available" olayı olarak da bildirilir.
+func (a *Application) CheckForUpdate(ctx context.Context) (*update.Release, error) {
+	if a.config.updateFeed == "" {
+		return nil, gomerrors.NewOperationError("update.check", "no update feed configured (WithUpdateFeed)", gomerrors.ErrNotReady)
+	}
+	feed, err := update.Fetch(ctx, a.config.updateFeed)
+	if err != nil {
+		return nil, err
+	}
+
+	a.updateMu.Lock()
+	state := a.loadUpdateState()
+	a.updateMu.Unlock()
+
+	release, ok := feed.Latest(state.Channel, Build().Version, state.InstallID)
+	if !ok {
+		return nil, nil
+	}
+	a.updateMu.Lock()
+	known := a.updateRelease != nil && a.updateRelease.Version == release.Version
+	a.updateRelease = &release
+	a.updateMu.Unlock()
+	if !known {
+		a.Logger().Info("update available", "version", release.Version, "channel", release.Channel)
+		_ = a.BroadcastSticky("update:available", release)
+	}
+	return &release, nil
+}
+
+// updateProgress, "update:downloading" olayının verisidir.
+type updateProgress struct {
+	Version  string  `json:"version"`
+	Received int64   `json:"received"`
+	Total    int64   `json:"total"`   // Bilinmiyorsa -1
+	Percent  float64 `json:"percent"` // Total bilinmiyorsa -1
+}
+
+// DownloadUpdate, CheckForUpdate'in bulduğu sürümü (henüz kontrol
+// edilmediyse önce kontrol ederek) indirir ve kuruluma hazırlar. Önceki
+// sürümden bir delta yaması varsa yalnızca yama indirilir; yama bu dosyaya
+// uymazsa tam dosya indirilir. Feed'deki SHA-256 özetleri doğrulanır; özeti
+// olmayan bir sürüm indirilmez ve update.ErrNoChecksum döner.
+//
+// İlerleme JS'e "update:downloading" ({version, received, total, percent}),
+// tamamlanma sticky "update:ready" (sürüm ve notlarıyla), hata
+// "update:failed" ({version, error}) olarak bildirilir. Uygulama günceldeyse
+// nil döner. Kurulum InstallUpdate ile yapılır.
+//
+// Feed'deki URL uygulamanın çalıştırılabilir dosyasıdır (yamaların hedefi);
+// kurulum sihirbazları bu akışla kurulmaz.
+func (a *Application) DownloadUpdate(ctx context.Context) (*update.Release, error) {
+	a.updateMu.Lock()
+	release := a.updateRelease
+	a.updateMu.Unlock()
+	if release == nil {
+		var err error
+		if release, err = a.CheckForUpdate(ctx); err != nil || release == nil {
+			return nil, err
+		}
+	}
+
+	a.updateMu.Lock()
+	switch {
+	case a.updateStaged != nil && a.updateStaged.Version == release.Version:
+		a.updateMu.Unlock()
+		return release, nil
+	case a.updateDownloading:
+		a.updateMu.Unlock()
+		return nil, gomerrors.NewOperationError("update.download", "download already in progress", gomerrors.ErrNotReady)
+	}
+	a.updateDownloading = true
+	a.updateMu.Unlock()
+	defer func() {
+		a.updateMu.Lock()
+		a.updateDownloading = false
+		a.updateMu.Unlock()
+	}()
+
+	path, err := a.fetchUpdate(ctx, *release)
+	if err != nil {
+		a.Logger().Warn("update download failed", "version", release.Version, "error", err)
+		_ = a.Broadcast("update:failed", map[string]string{"version": release.Version, "error": err.Error()})
+		return nil, err
+	}
+
+	a.updateMu.Lock()
+	a.updateStaged, a.updateStagedPath = release, path
+	a.updateMu.Unlock()
+	a.Logger().Info("update ready", "version", release.Version)
+	_ = a.BroadcastSticky("update:ready", release)
+	return release, nil
+}
+
+// fetchUpdate, sürümün yeni çalıştırılabilir dosyasını Cache dizinine yazar.
+// Yamayla üretilen dosya da sürümün özetiyle doğrulanır.
+func (a *Application) fetchUpdate(ctx context.Context, release update.Release) (string, error) {
+	if release.SHA256 == "" {
+		return "", fmt.Errorf("%w: release %s", update.ErrNoChecksum, release.Version)
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	dir, err := a.Paths().Cache()
+	if err != nil {
+		return "", err
+	}
+
+	var last time.Time
+	progress := func(received, total int64) {
+		if now := time.Now(); now.Sub(last) >= 250*time.Millisecond || received == total {
+			last = now
+			p := updateProgress{Version: release.Version, Received: received, Total: total, Percent: -1}
+			if total > 0 {
+				p.Percent = float64(received) * 100 / float64(total)
+			}
+			_ = a.Broadcast("update:downloading", p)
+		}
+	}
+
+	var next []byte
+	if patch, ok := release.PatchFrom(Build().Version); ok {
+		current, err := os.ReadFile(exe)
+		if err == nil {
+			var data []byte
+			if data, err = update.Download(ctx, patch.URL, patch.SHA256, progress); err == nil {
+				next, err = update.Apply(current, data)
+			}
+			if err == nil {
+				err = update.Verify(next, release.SHA256)
+			}
+		}
+		if err != nil {
+			a.Logger().Info("delta update unavailable, downloading full release", "reason", err)
+			next = nil
+		}
+	}
+	if next == nil {
+		if next, err = update.Download(ctx, release.URL, release.SHA256, progress); err != nil {
+			return "", err
+		}
+	}
+
+	path := filepath.Join(dir, "update-"+release.Version+filepath.Ext(exe))
+	if err := os.WriteFile(path, next, 0o755); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// InstallUpdate, DownloadUpdate ile hazırlanan sürümü kurar ve uygulamayı
+// yeni sürümle yeniden başlatır (bkz. Relaunch). "Güncellemek için yeniden
+// başlat" düğmesinin Go karşılığıdır. Hazır bir güncelleme yoksa
+// ErrNotReady döner. Hazırlanan dosya kurulmadan önce feed'deki SHA-256
+// özetiyle yeniden doğrulanır; özet yoksa ya da eşleşmezse kurulmaz.
+func (a *Application) InstallUpdate() error {
+	a.updateMu.Lock()
+	release, path := a.updateStaged, a.updateStagedPath
+	a.updateMu.Unlock()
+	if release == nil {
+		return gomerrors.NewOperationError("update.install", "no update has been downloaded", gomerrors.ErrNotReady)
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := update.Verify(data, release.SHA256); err != nil {
+		os.Remove(path)
+		a.updateMu.Lock()
+		if a.updateStaged == release {
+			a.updateStaged, a.updateStagedPath = nil, ""
+		}
+		a.updateMu.Unlock()
+		a.ClearSticky("update:ready")
+		return fmt.Errorf("%w: release %s", err, release.Version)
+	}
+	if err := update.Install(exe, data); err != nil {
+		return err
+	}
+	os.Remove(path)
+	a.Logger().Info("update installed", "version", release.Version)
+	return a.RelaunchExecutable(exe)
+}
+
+// startUpdateChecks, WithAutoUpdate ayarlandıysa güncellemeleri açılışta ve
+// aralıklarla kontrol edip arka planda indirir. Önceki kurulumun kenara
+// aldığı dosya da burada silinir.
+func (a *Application) startUpdateChecks() (stop func()) {
+	if exe, err := os.Executable(); err == nil {
+		update.Cleanup(exe)
+	}
+	if a.config.updateInterval <= 0 || a.config.updateFeed == "" {
+		return func() {}
+	}
+	ctx, cancel := context.WithCancel(a.Context())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(a.config.updateInterval)
+		defer ticker.Stop()
+		for {
+			if _, err := a.DownloadUpdate(ctx); err != nil && ctx.Err() == nil {
+				a.Logger().Warn("automatic update failed", "error", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				// Yeni bir sürüm yayınlanmış olabilir
+				a.updateMu.Lock()
+				a.updateRelease = nil
+				a.updateMu.Unlock()
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// loadUpdateState, ayarları okur; kurulum kimliği yoksa üretip saklar.
+// updateMu tutulmalıdır.
+func (a *Application) loadUpdateState() updateState {
+	state := updateState{}
+	dir, err := a.Paths().Config()
+	if err == nil {
+		data, err := a.readAppFile(filepath.Join(dir, updateFile))
+		switch {
+		case err == nil:
+			if err := json.Unmarshal(data, &state); err != nil {
+				a.Logger().Warn("invalid update settings file", "error", err)
+			}
+		case !errors.Is(err, os.ErrNotExist):
+			a.Logger().Warn("failed to read update settings", "error", err)
+		}
+	}
+
+	if _, err := update.ParseChannel(string(state.Channel)); err != nil {
+		state.Channel = a.config.updateChannel
+	}
+	if state.InstallID == "" {
+		// Kademeli yayın kovası bu kimlikten türetilir; sabit kalmalıdır
+		id := make([]byte, 16)
+		rand.Read(id)
+		state.InstallID = hex.EncodeToString(id)
+		if err := a.saveUpdateState(state); err != nil {
+			a.Logger().Warn("failed to save update settings", "error", err)
+		}
+	}
+	return state
+}
+
+// saveUpdateState, ayarları diske yazar. updateMu tutulmalıdır.
+func (a *Application) saveUpdateState(state updateState) error {
+	dir, err := a.Paths().Config()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return a.writeAppFile(filepath.Join(dir, updateFile), data)
+}
+
+// updateModule, güncellemelerin JS API'sidir (window.gomad.update).
+//
+//	const channel = await gomad.update.channel();    // "stable"
+//	await gomad.update.setChannel("beta");
+//	const release = await gomad.update.check();      // null → güncel
+//	await gomad.update.download();                   // update:downloading → update:ready
+//	gomad.on("update:channel", ({ channel }) => settings.channel = channel);
+//
+//	// "Güncellemek için yeniden başlat" bandı:
+//	gomad.on("update:downloading", ({ percent }) => banner.progress(percent));
+//	gomad.on("update:ready", ({ version, notes }) => banner.show(version, notes));
+//	restartButton.onclick = () => gomad.update.install();
+func (a *Application) updateModule() builtinModule {
+	return builtinModule{
+		namespace: "update",
+		methods: map[string]interface{}{
+			"channel": a.UpdateChannel,
+			"setChannel": func(channel string) error {
+				return a.SetUpdateChannel(update.Channel(channel))
+			},
+			"check": func() (*update.Release, error) {
+				return a.CheckForUpdate(a.Context())
+			},
+			"download": func() (*update.Release, error) {
+				return a.DownloadUpdate(a.Context())
+			},
+			"install": a.InstallUpdate,
+		},
+	}
+}
@@ -0,0 +1,122 @@
+package gomad
+
+import (
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/platform"
+	"github.com/biyonik/gomad/internal/webview"
+)
+
+// Show, ana pencereyi gösterir; minimize edilmişse geri yükler ve öne getirir.
+// Herhangi bir goroutine'den çağrılabilir. Native pencere erişimi olmayan
+// platformlarda etkisizdir.
+func (a *Application) Show() {
+	a.RunOnUIThread(func() {
+		if win := a.nativeWindow(); win != nil {
+			win.Show()
+		}
+	})
+}
+
+// Hide, ana pencereyi kapatmadan gizler (ör. tepsiye küçültme).
+// Herhangi bir goroutine'den çağrılabilir.
+func (a *Application) Hide() {
+	a.RunOnUIThread(func() {
+		if win := a.nativeWindow(); win != nil {
+			win.Hide()
+		}
+	})
+}
+
+// Reload, ana penceredeki sayfayı yeniler (ör. hata ekranından kurtarma).
+// Herhangi bir goroutine'den çağrılabilir.
+func (a *Application) Reload() {
+	a.RunOnUIThread(func() {
+		if wv := a.view(); wv != nil {
+			wv.Reload()
+		}
+	})
+}
+
+// ReloadIgnoringCache, ana penceredeki sayfayı HTTP önbelleğini atlayarak
+// yeniler; güncellenen varlıkların eski kopyaları gösterilmez. Herhangi bir
+// goroutine'den çağrılabilir.
+func (a *Application) ReloadIgnoringCache() {
+	a.RunOnUIThread(func() {
+		if wv := a.view(); wv != nil {
+			wv.ReloadIgnoringCache()
+		}
+	})
+}
+
+// windowModule, çağıran pencerenin JS tarafıdır (window.gomad.window).
+// Metodlar ana pencerede ana pencereye, ikincil pencerelerde kendi
+// pencerelerine uygulanır.
+//
+//	retryButton.onclick = () => gomad.window.reload();
+//	await gomad.window.reloadIgnoringCache();
+//	const { canGoBack } = await gomad.window.navigation();
+func (a *Application) windowModule() builtinModule {
+	return builtinModule{
+		namespace: "window",
+		viewMethods: func(wv webview.View) map[string]interface{} {
+			// Yenileme, çağrının yanıtı sayfaya ulaştıktan sonra yapılır
+			return map[string]interface{}{
+				"reload": func() error {
+					a.RunOnUIThread(wv.Reload)
+					return nil
+				},
+				"reloadIgnoringCache": func() error {
+					a.RunOnUIThread(wv.ReloadIgnoringCache)
+					return nil
+				},
+				"goBack": func() error {
+					a.RunOnUIThread(wv.GoBack)
+					return nil
+				},
+				"goForward": func() error {
+					a.RunOnUIThread(wv.GoForward)
+					return nil
+				},
+				"navigation": func() (NavigationState, error) {
+					return navigationState(a.windowName(wv), wv.Bridge().Navigation()), nil
+				},
+			}
+		},
+	}
+}
+
+// ToggleWindow, ana pencere görünürse gizler, gizliyse gösterir.
+// Herhangi bir goroutine'den çağrılabilir.
+func (a *Application) ToggleWindow() {
+	a.RunOnUIThread(func() {
+		win := a.nativeWindow()
+		if win == nil {
+			return
+		}
+		if win.IsVisible() {
+			win.Hide()
+		} else {
+			win.Show()
+		}
+	})
+}
+
+// SetAllWorkspaces, ana pencerenin tüm sanal masaüstlerinde (Windows sanal
+// masaüstleri, macOS Spaces, X11 çalışma alanları) görünüp görünmeyeceğini
+// ayarlar; kullanıcıyı her masaüstünde izlemesi gereken yardımcı ve widget
+// pencereleri içindir (bkz. WithAllWorkspaces). Windows'ta pencere araç
+// penceresine dönüşür: görev çubuğu düğmesi ve Alt+Tab girdisi kalkar.
+// Herhangi bir goroutine'den çağrılabilir.
+func (a *Application) SetAllWorkspaces(enabled bool) {
+	a.RunOnUIThread(func() {
+		host, ok := a.nativeWindow().(platform.WorkspaceHost)
+		if !ok {
+			a.Logger().Warn("all-workspaces window unavailable",
+				"error", gomerrors.NewWindowError("workspaces", "all workspaces", gomerrors.ErrNotSupported))
+			return
+		}
+		if err := host.SetAllWorkspaces(enabled); err != nil {
+			a.Logger().Warn("failed to set all-workspaces window", "error", err)
+		}
+	})
+}
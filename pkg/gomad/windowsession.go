@@ -0,0 +1,261 @@
+package gomad
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/biyonik/gomad/internal/bridge"
+	"github.com/biyonik/gomad/internal/platform"
+	"github.com/biyonik/gomad/internal/webview"
+)
+
+// ============================================================================
+// Pencere oturumu
+// WithRestoreSession ile uygulama kapanırken açık pencereler (ana pencere ve
+// OpenWindow ile açılanlar) adresleri, sınırları ve monitörleriyle kaydedilir;
+// sonraki açılışta aynı pencereler aynı rotada ve aynı yerde açılır.
+// Tarayıcı ve IDE'lerden alışılan davranıştır.
+//
+// Uygulamanın kendi sayfalarının adresi rota olarak saklanır ("/#/settings");
+// gömülü dosyaların sunucusu her açılışta farklı bir portta başladığı için
+// rota o anki adrese göre çözülür. Yalnızca HTML ile açılmış ikincil
+// pencereler geri yüklenemez ve kaydedilmez.
+//
+// Oturum yalnızca düzgün kapanışta (pencereyi kapatma, Quit, Relaunch)
+// kaydedilir. Sınır ve monitör bilgisi native pencere erişimi olan
+// platformlarda tutulur; diğerlerinde yalnızca pencereler ve rotaları geri
+// gelir. Kaydedilen monitör artık bağlı değilse pencere varsayılan konumda
+// açılır.
+// ============================================================================
+
+// windowSessionFile, pencere oturumunun UserData dizinindeki dosyasıdır.
+const windowSessionFile = "windows.json"
+
+// windowSession, diskte saklanan pencere oturumudur.
+type windowSession struct {
+	Windows []savedWindow `json:"windows"`
+}
+
+// savedWindow, oturumdaki bir penceredir. Width 0 ise sınırlar bilinmiyordur.
+type savedWindow struct {
+	Name      string `json:"name"`
+	Title     string `json:"title,omitempty"`
+	URL       string `json:"url,omitempty"`
+	Partition string `json:"partition,omitempty"`
+	X         int    `json:"x,omitempty"`
+	Y         int    `json:"y,omitempty"`
+	Width     int    `json:"width,omitempty"`
+	Height    int    `json:"height,omitempty"`
+	Maximized bool   `json:"maximized,omitempty"`
+	Monitor   string `json:"monitor,omitempty"`
+}
+
+// loadWindowSession, önceki oturumu okur. Oturum geri yükleme kapalıysa ya
+// da kayıt yoksa nil döner.
+func (a *Application) loadWindowSession() *windowSession {
+	if !a.config.restoreSession || a.config.headless {
+		return nil
+	}
+	dir, err := a.Paths().UserData()
+	if err != nil {
+		a.Logger().Warn("failed to resolve window session directory", "error", err)
+		return nil
+	}
+	data, err := a.readAppFile(filepath.Join(dir, windowSessionFile))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			a.Logger().Warn("failed to read window session", "error", err)
+		}
+		return nil
+	}
+	var session windowSession
+	if err := json.Unmarshal(data, &session); err != nil {
+		a.Logger().Warn("invalid window session file", "error", err)
+		return nil
+	}
+	return &session
+}
+
+// main, oturumdaki ana pencereyi döner.
+func (s *windowSession) main() (savedWindow, bool) {
+	if s != nil {
+		for _, w := range s.Windows {
+			if w.Name == MainWindow {
+				return w, true
+			}
+		}
+	}
+	return savedWindow{}, false
+}
+
+// restoreMainOptions, ana pencerenin seçeneklerine kaydedilen rotayı ve
+// boyutu uygular. Yalnızca uygulama bir adresten yükleniyorsa rota geri
+// yüklenir; WithHTML içeriğinin rotası yoktur.
+func (a *Application) restoreMainOptions(opts webview.Options, session *windowSession) webview.Options {
+	saved, ok := session.main()
+	if !ok {
+		return opts
+	}
+	if saved.URL != "" && a.config.url != "" {
+		if resolved, err := a.resolveWindowURL(saved.URL); err == nil {
+			opts.URL = resolved
+		}
+	}
+	if saved.Width > 0 && saved.Height > 0 {
+		opts.Width, opts.Height = saved.Width, saved.Height
+	}
+	return opts
+}
+
+// restoreWindows, ana pencereyi kaydedilen yere taşır ve ikincil pencereleri
+// yeniden açar. Ana pencere oluşturulduktan sonra çağrılır.
+func (a *Application) restoreWindows(wv webview.View, session *windowSession) {
+	if session == nil {
+		return
+	}
+	for _, saved := range session.Windows {
+		if saved.Name == MainWindow {
+			// Kiosk modu pencerenin yerini kendisi belirler
+			if !a.IsKiosk() {
+				wv.Dispatch(func() { a.applyPlacement(wv, saved) })
+			}
+			continue
+		}
+		w, err := a.OpenWindow(WindowOptions{
+			Name:      saved.Name,
+			Title:     saved.Title,
+			URL:       saved.URL,
+			Width:     saved.Width,
+			Height:    saved.Height,
+			Partition: saved.Partition,
+		})
+		if err != nil {
+			a.Logger().Warn("failed to restore window", "name", saved.Name, "error", err)
+			continue
+		}
+		w.do(func(wv webview.View) { a.applyPlacement(wv, saved) })
+	}
+	a.Logger().Debug("window session restored", "windows", len(session.Windows))
+}
+
+// applyPlacement, kaydedilen sınırları native pencereye uygular. UI
+// thread'inde çalışır.
+func (a *Application) applyPlacement(wv webview.View, saved savedWindow) {
+	if saved.Width <= 0 || saved.Height <= 0 {
+		return
+	}
+	host, ok := wv.NativeWindow().(platform.PlacementHost)
+	if !ok {
+		return
+	}
+	placed := host.SetPlacement(platform.Placement{
+		X: saved.X, Y: saved.Y, Width: saved.Width, Height: saved.Height,
+		Maximized: saved.Maximized, Monitor: saved.Monitor,
+	})
+	if !placed {
+		a.Logger().Debug("saved window position is off-screen", "name", saved.Name, "monitor", saved.Monitor)
+	}
+}
+
+// saveWindowSession, açık pencereleri kaydeder. Pencereler henüz yok
+// edilmemişken UI thread'inde çağrılır (ana pencerenin kapanma isteği ya da
+// Quit); oturum başına bir kez yazılır.
+func (a *Application) saveWindowSession() {
+	if !a.config.restoreSession || a.config.headless {
+		return
+	}
+	a.windowMu.Lock()
+	if a.sessionSaved {
+		a.windowMu.Unlock()
+		return
+	}
+	a.sessionSaved = true
+	windows := make([]*Window, 0, len(a.windows))
+	for _, w := range a.windows {
+		windows = append(windows, w)
+	}
+	a.windowMu.Unlock()
+
+	session := windowSession{Windows: []savedWindow{}}
+	if wv := a.view(); wv != nil {
+		main := a.captureWindow(MainWindow, wv, "")
+		session.Windows = append(session.Windows, main)
+	}
+	sort.Slice(windows, func(i, j int) bool { return windows[i].name < windows[j].name })
+	for _, w := range windows {
+		w.mu.Lock()
+		wv, closed := w.view, w.closed
+		w.mu.Unlock()
+		if closed {
+			continue
+		}
+		saved := a.captureWindow(w.name, wv, w.opts.URL)
+		if saved.URL == "" {
+			continue // Yalnızca HTML içeriği geri yüklenemez
+		}
+		saved.Title, saved.Partition = w.opts.Title, w.opts.Partition
+		session.Windows = append(session.Windows, saved)
+	}
+
+	dir, err := a.Paths().UserData()
+	if err != nil {
+		a.Logger().Warn("failed to resolve window session directory", "error", err)
+		return
+	}
+	data, err := json.Marshal(session)
+	if err != nil {
+		return
+	}
+	if err := a.writeAppFile(filepath.Join(dir, windowSessionFile), data); err != nil {
+		a.Logger().Warn("failed to save window session", "error", err)
+		return
+	}
+	a.Logger().Debug("window session saved", "windows", len(session.Windows))
+}
+
+// captureWindow, pencerenin rotasını ve yerleşimini okur. wv nil ise (pencere
+// henüz oluşmadıysa) açılış adresi kullanılır.
+func (a *Application) captureWindow(name string, wv webview.View, fallback string) savedWindow {
+	saved := savedWindow{Name: name}
+	raw := fallback
+	if wv != nil {
+		if current := wv.Bridge().Navigation().URL; current != "" {
+			raw = current
+		}
+		if host, ok := wv.NativeWindow().(platform.PlacementHost); ok {
+			p := host.Placement()
+			saved.X, saved.Y, saved.Width, saved.Height = p.X, p.Y, p.Width, p.Height
+			saved.Maximized, saved.Monitor = p.Maximized, p.Monitor
+		}
+	}
+	saved.URL = a.sessionRoute(raw)
+	return saved
+}
+
+// sessionRoute, adresi oturumda saklanacak biçime çevirir: uygulamanın
+// kendi sayfaları origin'siz rota olur, diğer http(s) adresleri olduğu gibi
+// kalır. about:blank ve data: gibi geri yüklenemeyen adresler için "" döner.
+func (a *Application) sessionRoute(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "file") {
+		return ""
+	}
+	if a.config.url == "" || bridge.NormalizeOrigin(raw) != bridge.NormalizeOrigin(a.config.url) {
+		return raw
+	}
+	route := u.EscapedPath()
+	if route == "" {
+		route = "/"
+	}
+	if u.RawQuery != "" {
+		route += "?" + u.RawQuery
+	}
+	if u.Fragment != "" {
+		route += "#" + u.EscapedFragment()
+	}
+	return route
+}
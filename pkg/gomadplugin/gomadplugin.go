@@ -0,0 +1,105 @@
+// Package gomadplugin, GOMAD uygulamalarına ayrı süreç olarak bağlanan
+// eklentiler yazmak içindir (bkz. gomad.Application.StartPluginProcess).
+//
+// Eklenti sıradan bir Go programıdır; metodlarını Serve'e verir ve host
+// uygulama onları "<eklenti adı>.<metod>" olarak JS'e açar:
+//
+//	func main() {
+//	    err := gomadplugin.Serve(map[string]interface{}{
+//	        "render": func(path string, page int) ([]byte, error) { ... },
+//	    })
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	}
+//
+//	// Host:
+//	// app.StartPluginProcess("pdf", "./pdf-plugin")
+//	// JS: await gomad.call("pdf.render", "/tmp/a.pdf", 1)
+//
+// Host ile iletişim stdin/stdout üzerinden satır başına bir köprü mesajıdır.
+// Serve çalışırken os.Stdout stderr'e yönlendirilir; eklentinin yazdıkları
+// protokolü bozmaz ve host'un loguna düşer.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package gomadplugin
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/biyonik/gomad/internal/bridge"
+)
+
+var (
+	out     io.Writer = os.Stdout
+	writeMu sync.Mutex
+)
+
+// Serve, methods'u kaydeder, host'a bildirir ve stdin kapanana kadar gelen
+// çağrıları çalıştırır. Metodlar gomad.Bind ile aynı imzaları destekler ve
+// eşzamanlı çağrılabilir.
+func Serve(methods map[string]interface{}) error {
+	out = os.Stdout
+	os.Stdout = os.Stderr
+	return serve(os.Stdin, methods)
+}
+
+// serve, Serve'ün giriş kaynağından bağımsız gövdesidir.
+func serve(in io.Reader, methods map[string]interface{}) error {
+	registry := bridge.NewRegistry()
+	arity := make(map[string]int, len(methods))
+	for name, fn := range methods {
+		if err := registry.Register(name, fn); err != nil {
+			return err
+		}
+		arity[name], _ = registry.NumIn(name)
+	}
+
+	if err := Emit("plugin:register", map[string]interface{}{"methods": arity}); err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		msg, err := bridge.FromJSON(scanner.Bytes())
+		if err != nil || msg.Type != bridge.MessageTypeCall {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = write(registry.CallWithMessage(msg))
+		}()
+	}
+	wg.Wait()
+	return scanner.Err()
+}
+
+// Emit, host uygulamada JS'e "<eklenti adı>:<event>" olayını gönderir.
+func Emit(event string, data interface{}) error {
+	msg, err := bridge.NewEventMessage(event, data)
+	if err != nil {
+		return err
+	}
+	return write(msg)
+}
+
+// write, host'a bir mesaj yazar.
+func write(msg *bridge.Message) error {
+	data, err := msg.ToJSON()
+	if err != nil {
+		return err
+	}
+	writeMu.Lock()
+	defer writeMu.Unlock()
+	_, err = out.Write(append(data, '\n'))
+	return err
+}
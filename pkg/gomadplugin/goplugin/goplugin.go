@@ -0,0 +1,52 @@
+// Package goplugin, -buildmode=plugin ile derlenmiş Go eklentilerini bir
+// GOMAD uygulamasına yükler.
+//
+// Go'nun "plugin" paketi ayrı bir pakettedir: içe aktarıldığında linker cgo
+// ile derlenen Linux ve macOS binary'lerini dinamik bağlanabilir modda
+// üretir ve binary belirgin şekilde büyür. Eklenti yüklemeyen uygulamalar bu
+// paketi içe aktarmadığı için bu bedeli ödemez.
+//
+//	if err := goplugin.Load(app, "plugins/pdf.so"); err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// Eklenti "Plugin" adlı bir gomad.Plugin değişkeni dışa aktarır:
+//
+//	var Plugin gomad.Plugin = pdfPlugin{}
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package goplugin
+
+import (
+	"fmt"
+	"plugin"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/pkg/gomad"
+)
+
+// Load, path'teki Go eklentisini açar ve "Plugin" sembolünü app.Use ile
+// kaydeder. Go plugin'leri yalnızca cgo ile derlenen Linux ve macOS
+// binary'lerinde desteklenir; eklenti host ile aynı Go sürümü ve bağımlılık
+// sürümleriyle derlenmelidir. Taşınabilir eklentiler için
+// gomad.Application.StartPluginProcess kullanılır.
+func Load(app *gomad.Application, path string) error {
+	lib, err := plugin.Open(path)
+	if err != nil {
+		return gomerrors.NewOperationError("plugin.load", path+": "+err.Error(), gomerrors.ErrNotSupported)
+	}
+	sym, err := lib.Lookup("Plugin")
+	if err != nil {
+		return gomerrors.NewOperationError("plugin.load", path+": missing exported Plugin variable", gomerrors.ErrNotFound)
+	}
+	switch p := sym.(type) {
+	case *gomad.Plugin:
+		return app.Use(*p)
+	case gomad.Plugin:
+		return app.Use(p)
+	}
+	return gomerrors.NewOperationError("plugin.load", fmt.Sprintf("%s: Plugin has type %T, want gomad.Plugin", path, sym), gomerrors.ErrInvalidArgument)
+}
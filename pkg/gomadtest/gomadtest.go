@@ -0,0 +1,411 @@
+// Package gomadtest, GOMAD uygulamaları için uçtan uca test sürücüsüdür;
+// net/http/httptest'in masaüstü karşılığıdır.
+//
+// Sürücü gerçek uygulamayı (gerçek pencere ve WebView ile) başlatır, ona
+// bağlanır ve Go testlerinin DOM öğelerine tıklamasını, durumu okumasını ve
+// köprü trafiği üzerinde doğrulama yapmasını sağlar.
+//
+// Örnek:
+//
+//	func TestSave(t *testing.T) {
+//	    d := gomadtest.Start(t, gomadtest.Build(t, "./cmd/notes"))
+//	    ctx := context.Background()
+//
+//	    d.Type(ctx, "#title", "Shopping")
+//	    d.Click(ctx, "button.save")
+//
+//	    call, err := d.WaitTraffic(ctx, gomadtest.CallTo("saveNote"))
+//	    if err != nil {
+//	        t.Fatal(err)
+//	    }
+//	    text, _ := d.Text(ctx, ".status")
+//	    if text != "Saved" { ... }
+//	}
+//
+// Uygulama penceresi açılacağı için CI'da bir ekran sunucusu (ör. Xvfb)
+// gerekir. Pencere gerektirmeyen sözleşme testleri için gomad.WithHeadless
+// kullanılmalıdır.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package gomadtest
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/biyonik/gomad/internal/bridge"
+	"github.com/biyonik/gomad/internal/driver"
+)
+
+// Traffic, köprüden geçen tek bir mesajdır.
+type Traffic = bridge.Traffic
+
+// Trafik yönleri
+const (
+	In  = bridge.DirectionIn  // JS → Go
+	Out = bridge.DirectionOut // Go → JS
+)
+
+// ErrClosed, uygulama kapandıktan sonra yapılan çağrılarda döner.
+var ErrClosed = errors.New("gomadtest: application closed")
+
+// StartTimeout, Start'ın uygulamanın ilk sayfayı yüklemesini beklediği süredir.
+var StartTimeout = 30 * time.Second
+
+// Driver, çalışan bir uygulamayı yönetir.
+type Driver struct {
+	cmd    *exec.Cmd
+	conn   net.Conn
+	reader *bufio.Reader
+	enc    *json.Encoder
+	encMu  sync.Mutex
+
+	nextID  atomic.Int64
+	pending map[int64]chan driver.Message
+	mu      sync.Mutex
+
+	traffic  []Traffic
+	changed  chan struct{} // Yeni trafik geldiğinde kapanır ve yenilenir
+	loaded   chan struct{}
+	loadOnce sync.Once
+	done     chan struct{}
+	exited   chan error
+}
+
+// Build, main paketini geçici bir dizine derler ve çalıştırılabilirin yolunu
+// döner. Derleme hatası testi sonlandırır.
+func Build(t testing.TB, pkg string) string {
+	t.Helper()
+	out := filepath.Join(t.TempDir(), "app")
+	if runtime.GOOS == "windows" {
+		out += ".exe"
+	}
+	cmd := exec.Command("go", "build", "-o", out, pkg)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("gomadtest: build %s: %v\n%s", pkg, err, output)
+	}
+	return out
+}
+
+// Start, uygulamayı başlatır ve ilk sayfa yüklenene kadar bekler. Uygulama
+// test bitiminde kapatılır. Hata testi sonlandırır.
+func Start(t testing.TB, binary string, args ...string) *Driver {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), StartTimeout)
+	defer cancel()
+
+	d, err := Launch(ctx, binary, args...)
+	if err != nil {
+		t.Fatalf("gomadtest: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := d.Close(); err != nil {
+			t.Logf("gomadtest: close: %v", err)
+		}
+	})
+	return d
+}
+
+// Launch, uygulamayı başlatır ve ilk sayfa yüklenene kadar bekler.
+func Launch(ctx context.Context, binary string, args ...string) (*Driver, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	defer ln.Close()
+
+	tokenBytes := make([]byte, 16)
+	rand.Read(tokenBytes)
+	token := hex.EncodeToString(tokenBytes)
+
+	cmd := exec.Command(binary, args...)
+	cmd.Env = append(os.Environ(), driver.AddrEnv+"="+ln.Addr().String(), driver.TokenEnv+"="+token)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	d := &Driver{
+		cmd:     cmd,
+		pending: make(map[int64]chan driver.Message),
+		changed: make(chan struct{}),
+		loaded:  make(chan struct{}),
+		done:    make(chan struct{}),
+		exited:  make(chan error, 1),
+	}
+	go func() { d.exited <- cmd.Wait() }()
+
+	if err := d.accept(ctx, ln, token); err != nil {
+		cmd.Process.Kill()
+		return nil, err
+	}
+	d.enc = json.NewEncoder(d.conn)
+	go d.read()
+
+	select {
+	case <-d.loaded:
+		return d, nil
+	case <-d.done:
+		return nil, fmt.Errorf("application exited before loading a page")
+	case <-ctx.Done():
+		d.Close()
+		return nil, fmt.Errorf("waiting for first page load: %w", ctx.Err())
+	}
+}
+
+// accept, uygulamanın bağlanıp doğru jetonu göndermesini bekler.
+func (d *Driver) accept(ctx context.Context, ln net.Listener, token string) error {
+	type accepted struct {
+		conn net.Conn
+		err  error
+	}
+	ch := make(chan accepted, 1)
+	go func() {
+		conn, err := ln.Accept()
+		ch <- accepted{conn, err}
+	}()
+
+	select {
+	case a := <-ch:
+		if a.err != nil {
+			return a.err
+		}
+		reader := bufio.NewReader(a.conn)
+		line, err := reader.ReadBytes('\n')
+		var hello driver.Message
+		if err != nil || json.Unmarshal(line, &hello) != nil || hello.Type != driver.TypeHello || hello.Token != token {
+			a.conn.Close()
+			return fmt.Errorf("unexpected handshake from application")
+		}
+		d.conn, d.reader = a.conn, reader
+		return nil
+	case err := <-d.exited:
+		return fmt.Errorf("application exited before connecting (is it built with gomad?): %v", err)
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for application to connect: %w", ctx.Err())
+	}
+}
+
+// read, uygulamadan gelen mesajları dağıtır.
+func (d *Driver) read() {
+	defer close(d.done)
+	scanner := bufio.NewScanner(d.reader)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		var msg driver.Message
+		if err := json.Unmarshal(scanner.Bytes(), &msg); err != nil {
+			continue
+		}
+		switch msg.Type {
+		case driver.TypeLoaded:
+			d.loadOnce.Do(func() { close(d.loaded) })
+		case driver.TypeResult:
+			d.mu.Lock()
+			ch := d.pending[msg.ID]
+			delete(d.pending, msg.ID)
+			d.mu.Unlock()
+			if ch != nil {
+				ch <- msg
+			}
+		case driver.TypeTraffic:
+			if msg.Traffic != nil {
+				d.mu.Lock()
+				d.traffic = append(d.traffic, *msg.Traffic)
+				close(d.changed)
+				d.changed = make(chan struct{})
+				d.mu.Unlock()
+			}
+		}
+	}
+}
+
+// send, uygulamaya bir komut yazar.
+func (d *Driver) send(msg driver.Message) error {
+	d.encMu.Lock()
+	defer d.encMu.Unlock()
+	return d.enc.Encode(msg)
+}
+
+// Eval, js'i sayfada bir async fonksiyonun gövdesi olarak çalıştırır ve
+// dönen değeri result'a (nil değilse) çözer. Fırlatılan hata error döner.
+//
+//	var title string
+//	err := d.Eval(ctx, "return document.title", &title)
+func (d *Driver) Eval(ctx context.Context, js string, result interface{}) error {
+	id := d.nextID.Add(1)
+	ch := make(chan driver.Message, 1)
+	d.mu.Lock()
+	d.pending[id] = ch
+	d.mu.Unlock()
+	defer func() {
+		d.mu.Lock()
+		delete(d.pending, id)
+		d.mu.Unlock()
+	}()
+
+	if err := d.send(driver.Message{Type: driver.TypeEval, ID: id, JS: js}); err != nil {
+		return ErrClosed
+	}
+	select {
+	case msg := <-ch:
+		if msg.Error != "" {
+			return fmt.Errorf("gomadtest: %s", msg.Error)
+		}
+		if result == nil || len(msg.Value) == 0 {
+			return nil
+		}
+		return json.Unmarshal(msg.Value, result)
+	case <-d.done:
+		return ErrClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Call, sayfadan window.gomad.call ile bir binding çağırır; çağrı gerçek
+// köprüden geçer ve trafiğe düşer.
+func (d *Driver) Call(ctx context.Context, method string, result interface{}, args ...interface{}) error {
+	if args == nil {
+		args = []interface{}{}
+	}
+	payload, err := json.Marshal(append([]interface{}{method}, args...))
+	if err != nil {
+		return err
+	}
+	return d.Eval(ctx, "return await window.gomad.call(..."+string(payload)+");", result)
+}
+
+// Click, seçiciye uyan ilk öğeye tıklar.
+func (d *Driver) Click(ctx context.Context, selector string) error {
+	return d.Eval(ctx, element(selector)+"el.click();", nil)
+}
+
+// Type, seçiciye uyan input/textarea'nın değerini ayarlar ve input/change
+// olaylarını tetikler (Angular ve benzeri çerçevelerin form bağlamaları için).
+func (d *Driver) Type(ctx context.Context, selector, text string) error {
+	value, _ := json.Marshal(text)
+	return d.Eval(ctx, element(selector)+`
+el.focus();
+el.value = `+string(value)+`;
+el.dispatchEvent(new Event('input', { bubbles: true }));
+el.dispatchEvent(new Event('change', { bubbles: true }));`, nil)
+}
+
+// Text, seçiciye uyan ilk öğenin görünen metnini döner.
+func (d *Driver) Text(ctx context.Context, selector string) (string, error) {
+	var text string
+	err := d.Eval(ctx, element(selector)+"return el.innerText;", &text)
+	return text, err
+}
+
+// Exists, seçiciye uyan bir öğe olup olmadığını döner.
+func (d *Driver) Exists(ctx context.Context, selector string) (bool, error) {
+	sel, _ := json.Marshal(selector)
+	var ok bool
+	err := d.Eval(ctx, "return document.querySelector("+string(sel)+") !== null;", &ok)
+	return ok, err
+}
+
+// WaitFor, seçiciye uyan bir öğe görünene kadar bekler.
+func (d *Driver) WaitFor(ctx context.Context, selector string) error {
+	for {
+		ok, err := d.Exists(ctx, selector)
+		if err != nil || ok {
+			return err
+		}
+		select {
+		case <-time.After(50 * time.Millisecond):
+		case <-ctx.Done():
+			return fmt.Errorf("gomadtest: waiting for %q: %w", selector, ctx.Err())
+		}
+	}
+}
+
+// Traffic, şimdiye kadar köprüden geçen mesajları döner. Sürücünün kendi
+// mesajları (Eval sonuçları) dahil değildir; Call ile yapılan çağrılar dahildir.
+func (d *Driver) Traffic() []Traffic {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]Traffic(nil), d.traffic...)
+}
+
+// WaitTraffic, match'e uyan bir mesaj gelene kadar bekler. Daha önce gelmiş
+// mesajlar da aranır.
+func (d *Driver) WaitTraffic(ctx context.Context, match func(Traffic) bool) (Traffic, error) {
+	seen := 0
+	for {
+		d.mu.Lock()
+		list := d.traffic[seen:]
+		changed := d.changed
+		d.mu.Unlock()
+
+		for _, t := range list {
+			if match(t) {
+				return t, nil
+			}
+		}
+		seen += len(list)
+
+		select {
+		case <-changed:
+		case <-d.done:
+			return Traffic{}, ErrClosed
+		case <-ctx.Done():
+			return Traffic{}, ctx.Err()
+		}
+	}
+}
+
+// CallTo, method'a yapılan JS → Go çağrılarını eşleyen bir WaitTraffic filtresidir.
+func CallTo(method string) func(Traffic) bool {
+	return func(t Traffic) bool {
+		return t.Direction == In && t.Message.Type == bridge.MessageTypeCall && t.Message.Method == method
+	}
+}
+
+// EventNamed, Go → JS olaylarını eşleyen bir WaitTraffic filtresidir.
+func EventNamed(event string) func(Traffic) bool {
+	return func(t Traffic) bool {
+		return t.Direction == Out && t.Message.Type == bridge.MessageTypeEvent && t.Message.Event == event
+	}
+}
+
+// Close, uygulamayı kapatır; 5 saniye içinde kapanmazsa sonlandırır.
+func (d *Driver) Close() error {
+	_ = d.send(driver.Message{Type: driver.TypeQuit})
+	select {
+	case err := <-d.exited:
+		d.conn.Close()
+		return err
+	case <-time.After(5 * time.Second):
+		d.cmd.Process.Kill()
+		d.conn.Close()
+		return fmt.Errorf("gomadtest: application did not quit, killed")
+	}
+}
+
+// element, seçiciye uyan ilk öğeyi el değişkenine alan JS'i üretir.
+func element(selector string) string {
+	sel, _ := json.Marshal(selector)
+	msg, _ := json.Marshal("no element matches " + selector)
+	return "const el = document.querySelector(" + string(sel) + ");\n" +
+		"if (!el) throw new Error(" + string(msg) + ");\n"
+}
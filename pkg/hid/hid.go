@@ -0,0 +1,323 @@
+// Package hid, oyun kumandalarını ve HID aygıtlarını listeler ve girdilerini
+// okur.
+//
+// WebView'in Gamepad API'si platforma göre eksiktir (WebKitGTK'da yoktur,
+// WebView2'de yalnızca pencere odaktayken çalışır) ve kumanda dışındaki HID
+// aygıtlarına (barkod okuyucu, pedal, özel paneller) hiç erişemez. Kiosk,
+// simülasyon ve donanım yardımcı uygulamaları girdileri bu paketle doğrudan
+// işletim sisteminden alır:
+//
+//	cancel, err := hid.SubscribeGamepads(func(e hid.GamepadEvent) {
+//	    if e.Type == hid.EventButton && e.Index == 0 && e.Value > 0 {
+//	        jump()
+//	    }
+//	})
+//	defer cancel()
+//
+//	stop, err := hid.Listen(devices[0].ID, func(report []byte) {
+//	    scanner.Feed(report)
+//	})
+//	defer stop()
+//
+// Kumanda tuşları ve eksenleri W3C "standard gamepad" sırasındadır: 0-3 A B X
+// Y, 4-5 omuz tuşları, 6-7 tetikler (0..1), 8 Back, 9 Start, 10-11 çubuk
+// tuşları, 12-15 yön tuşları; eksenler sol X/Y ve sağ X/Y (-1..1, yukarı
+// negatif).
+//
+// Platform desteği:
+//   - Windows: kumandalar XInput (en fazla 4), HID aygıtları SetupAPI ve
+//     hid.dll. Klavye ve fare gibi sistemin ayırdığı aygıtlar listelenir
+//     ancak okunamaz.
+//   - Linux: kumandalar joystick arayüzü (/dev/input/js*; tuş sırası
+//     sürücüye bağlıdır), HID aygıtları hidraw (/dev/hidraw*). Okuma izni
+//     için genellikle bir udev kuralı gerekir.
+//   - Diğer: ErrNotSupported.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package hid
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// ErrNotSupported, platform kumanda ya da HID erişimini desteklemiyorsa döner.
+var ErrNotSupported = gomerrors.ErrNotSupported
+
+// ErrDeviceNotFound, aygıt kimliği geçersizse ya da aygıt çıkarıldıysa döner.
+var ErrDeviceNotFound = errors.New("hid device not found")
+
+// pollInterval, kumanda durumunun okunma sıklığıdır (~60 Hz).
+const pollInterval = 16 * time.Millisecond
+
+// axisThreshold, bir eksen değişikliğinin olay olarak gönderilmesi için
+// gereken en küçük farktır; analog gürültü olay seli üretmesin.
+const axisThreshold = 0.01
+
+// defaultReportSize, rapor uzunluğu bilinmeyen aygıtlar için okuma tamponudur.
+const defaultReportSize = 4096
+
+// Gamepad, bağlı bir oyun kumandasıdır.
+type Gamepad struct {
+	// Index, kumandanın sırasıdır (XInput slot'u, jsN numarası).
+	Index   int    `json:"index"`
+	ID      string `json:"id"` // "xinput:0", "js0"
+	Name    string `json:"name"`
+	Axes    int    `json:"axes"`
+	Buttons int    `json:"buttons"`
+}
+
+// GamepadEventType, kumanda olayının türüdür.
+type GamepadEventType string
+
+const (
+	EventConnected    GamepadEventType = "connected"
+	EventDisconnected GamepadEventType = "disconnected"
+	EventButton       GamepadEventType = "button"
+	EventAxis         GamepadEventType = "axis"
+)
+
+// GamepadEvent, bir kumanda olayıdır. Index tuşun ya da eksenin sırasıdır;
+// Value tuşlarda 0..1 (tetikler analogdur), eksenlerde -1..1 arasındadır.
+// Bağlanma olaylarında Index ve Value kullanılmaz.
+type GamepadEvent struct {
+	Type    GamepadEventType `json:"type"`
+	Gamepad Gamepad          `json:"gamepad"`
+	Index   int              `json:"index"`
+	Value   float64          `json:"value"`
+}
+
+// Device, bir HID aygıtıdır.
+type Device struct {
+	// ID, aygıtın platform yoludur; Listen'a verilir.
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Manufacturer string `json:"manufacturer,omitempty"`
+	VendorID     uint16 `json:"vendorId"`
+	ProductID    uint16 `json:"productId"`
+	// UsagePage ve Usage, aygıtın üst koleksiyonudur (ör. 0x01/0x05 kumanda).
+	UsagePage uint16 `json:"usagePage"`
+	Usage     uint16 `json:"usage"`
+	// InputReportLength, rapor ID'si dahil giriş raporu uzunluğudur; bilinmiyorsa 0.
+	InputReportLength int `json:"inputReportLength,omitempty"`
+}
+
+// gamepadState, bir kumandanın anlık tuş ve eksen değerleridir.
+type gamepadState struct {
+	Gamepad
+	axes    []float64
+	buttons []float64
+}
+
+var (
+	subscribers = make(map[int]func(GamepadEvent))
+	nextSub     int
+	subMu       sync.Mutex
+	started     bool
+)
+
+// Gamepads, bağlı kumandaları döner.
+func Gamepads() ([]Gamepad, error) {
+	states, err := readGamepads()
+	if err != nil {
+		return nil, err
+	}
+	pads := make([]Gamepad, 0, len(states))
+	for _, s := range states {
+		pads = append(pads, s.Gamepad)
+	}
+	return pads, nil
+}
+
+// SubscribeGamepads, kumanda olayları için bir dinleyici ekler ve kaldırma
+// fonksiyonu döner. fn, yoklama goroutine'inde çağrılır; uzun işler için yeni
+// goroutine başlatılmalıdır. İlk abonelikte yoklama başlar, son abonelik
+// kaldırılınca durur. Abonelik anında bağlı kumandalar için "connected"
+// olayı gönderilir.
+func SubscribeGamepads(fn func(GamepadEvent)) (cancel func(), err error) {
+	subMu.Lock()
+	defer subMu.Unlock()
+
+	if !started {
+		if _, err := readGamepads(); err != nil {
+			return nil, err
+		}
+		go poll()
+		started = true
+	}
+
+	id := nextSub
+	nextSub++
+	subscribers[id] = fn
+
+	return func() {
+		subMu.Lock()
+		defer subMu.Unlock()
+		delete(subscribers, id)
+	}, nil
+}
+
+// poll, kumanda durumunu periyodik okuyup farkları olay olarak yayınlar.
+// Abone kalmayınca durur.
+func poll() {
+	last := map[string]gamepadState{}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		subMu.Lock()
+		if len(subscribers) == 0 {
+			started = false
+			subMu.Unlock()
+			stopGamepads()
+			return
+		}
+		subMu.Unlock()
+
+		states, err := readGamepads()
+		if err != nil {
+			continue
+		}
+		var events []GamepadEvent
+		current := make(map[string]gamepadState, len(states))
+		for _, s := range states {
+			prev, ok := last[s.ID]
+			if !ok {
+				events = append(events, GamepadEvent{Type: EventConnected, Gamepad: s.Gamepad})
+				prev = gamepadState{Gamepad: s.Gamepad}
+			}
+			events = append(events, diff(prev, &s)...)
+			current[s.ID] = s
+		}
+		for id, prev := range last {
+			if _, ok := current[id]; !ok {
+				events = append(events, GamepadEvent{Type: EventDisconnected, Gamepad: prev.Gamepad})
+			}
+		}
+		last = current
+
+		if len(events) > 0 {
+			publish(events)
+		}
+	}
+}
+
+// diff, iki durum arasındaki tuş ve eksen değişikliklerini döner. Eşiğin
+// altında kalan eksen değişiklikleri gönderilmez; next'te önceki değer
+// korunur ki yavaş kaymalar da sonunda olay üretsin.
+func diff(prev gamepadState, next *gamepadState) []GamepadEvent {
+	var events []GamepadEvent
+	for i, v := range next.buttons {
+		if i >= len(prev.buttons) || prev.buttons[i] != v {
+			events = append(events, GamepadEvent{Type: EventButton, Gamepad: next.Gamepad, Index: i, Value: v})
+		}
+	}
+	for i, v := range next.axes {
+		if i < len(prev.axes) && math.Abs(prev.axes[i]-v) < axisThreshold {
+			next.axes[i] = prev.axes[i]
+			continue
+		}
+		if i >= len(prev.axes) && v == 0 {
+			continue
+		}
+		events = append(events, GamepadEvent{Type: EventAxis, Gamepad: next.Gamepad, Index: i, Value: v})
+	}
+	return events
+}
+
+// publish, olayları tüm dinleyicilere iletir.
+func publish(events []GamepadEvent) {
+	subMu.Lock()
+	fns := make([]func(GamepadEvent), 0, len(subscribers))
+	for _, fn := range subscribers {
+		fns = append(fns, fn)
+	}
+	subMu.Unlock()
+
+	for _, e := range events {
+		for _, fn := range fns {
+			fn(e)
+		}
+	}
+}
+
+// Devices, bağlı HID aygıtlarını döner.
+func Devices() ([]Device, error) {
+	devices, err := listDevices()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(devices, func(i, j int) bool { return devices[i].ID < devices[j].ID })
+	return devices, nil
+}
+
+// Listen, aygıtı açar ve her giriş raporunu fn'e verir. fn, okuma
+// goroutine'inde çağrılır ve rapor tamponu çağrıdan sonra yeniden
+// kullanılmaz. Aygıt çıkarılırsa okuma durur ve done (nil değilse) hatayla
+// çağrılır; stop ile durdurulduğunda done çağrılmaz.
+func Listen(id string, fn func(report []byte), done func(error)) (stop func(), err error) {
+	devices, err := listDevices()
+	if err != nil {
+		return nil, err
+	}
+	size := -1
+	for _, d := range devices {
+		if d.ID == id {
+			size = d.InputReportLength
+			break
+		}
+	}
+	if size < 0 {
+		return nil, ErrDeviceNotFound
+	}
+	if size == 0 {
+		size = defaultReportSize
+	}
+
+	r, err := openDevice(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		once    sync.Once
+		stopped bool
+		mu      sync.Mutex
+	)
+	stop = func() {
+		once.Do(func() {
+			mu.Lock()
+			stopped = true
+			mu.Unlock()
+			r.Close()
+		})
+	}
+
+	go func() {
+		for {
+			buf := make([]byte, size)
+			n, err := r.Read(buf)
+			if err != nil {
+				mu.Lock()
+				quiet := stopped
+				mu.Unlock()
+				stop()
+				if !quiet && done != nil {
+					done(err)
+				}
+				return
+			}
+			if n > 0 {
+				fn(buf[:n])
+			}
+		}
+	}()
+	return stop, nil
+}
@@ -0,0 +1,243 @@
+//go:build linux
+
+package hid
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// Joystick arayüzü olay tabanlıdır: her /dev/input/jsN için bir okuma
+// goroutine'i durumu günceller, readGamepads anlık kopyasını döner. Yeni
+// takılan kumandalar rescanInterval'da bir aranır.
+const rescanInterval = time.Second
+
+// js_event tipleri (linux/joystick.h)
+const (
+	jsEventButton = 0x01
+	jsEventAxis   = 0x02
+	jsEventInit   = 0x80
+)
+
+// joystick, açık bir /dev/input/jsN aygıtıdır.
+type joystick struct {
+	pad     Gamepad
+	f       *os.File
+	axes    []float64
+	buttons []float64
+	closed  bool
+}
+
+var (
+	joysticks   = map[string]*joystick{}
+	joystickMu  sync.Mutex
+	lastScanned time.Time
+)
+
+func readGamepads() ([]gamepadState, error) {
+	joystickMu.Lock()
+	defer joystickMu.Unlock()
+
+	if time.Since(lastScanned) >= rescanInterval {
+		scanJoysticks()
+		lastScanned = time.Now()
+	}
+	states := make([]gamepadState, 0, len(joysticks))
+	for id, js := range joysticks {
+		if js.closed {
+			delete(joysticks, id)
+			continue
+		}
+		s := gamepadState{
+			Gamepad: js.pad,
+			axes:    append([]float64(nil), js.axes...),
+			buttons: append([]float64(nil), js.buttons...),
+		}
+		s.Axes, s.Buttons = len(s.axes), len(s.buttons)
+		states = append(states, s)
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].Index < states[j].Index })
+	return states, nil
+}
+
+// scanJoysticks, henüz açılmamış kumandaları açar. joystickMu tutulurken
+// çağrılır; okuma izni olmayan aygıtlar atlanır.
+func scanJoysticks() {
+	paths, _ := filepath.Glob("/dev/input/js*")
+	for _, path := range paths {
+		id := filepath.Base(path)
+		if _, ok := joysticks[id]; ok {
+			continue
+		}
+		index, err := strconv.Atoi(strings.TrimPrefix(id, "js"))
+		if err != nil {
+			continue
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			continue
+		}
+		name := readSysfs("/sys/class/input/" + id + "/device/name")
+		if name == "" {
+			name = id
+		}
+		js := &joystick{pad: Gamepad{Index: index, ID: id, Name: name}, f: f}
+		joysticks[id] = js
+		go readJoystick(js)
+	}
+}
+
+// readJoystick, kumandanın olaylarını okuyup durumunu günceller. Aygıt
+// açıldığında sürücü tüm tuş ve eksenler için "init" olayları gönderir.
+func readJoystick(js *joystick) {
+	var event [8]byte // u32 time, s16 value, u8 type, u8 number
+	for {
+		if _, err := io.ReadFull(js.f, event[:]); err != nil {
+			joystickMu.Lock()
+			js.closed = true
+			joystickMu.Unlock()
+			js.f.Close()
+			return
+		}
+		value := float64(int16(binary.LittleEndian.Uint16(event[4:6])))
+		kind, number := event[6]&^jsEventInit, int(event[7])
+
+		joystickMu.Lock()
+		switch kind {
+		case jsEventButton:
+			js.buttons = grow(js.buttons, number)
+			js.buttons[number] = min(value, 1)
+		case jsEventAxis:
+			js.axes = grow(js.axes, number)
+			js.axes[number] = max(value/32767, -1)
+		}
+		joystickMu.Unlock()
+	}
+}
+
+// grow, s'yi i indeksini içerecek kadar büyütür.
+func grow(s []float64, i int) []float64 {
+	for len(s) <= i {
+		s = append(s, 0)
+	}
+	return s
+}
+
+// stopGamepads, açık kumandaları kapatır; okuma goroutine'leri sonlanır.
+func stopGamepads() {
+	joystickMu.Lock()
+	defer joystickMu.Unlock()
+	for id, js := range joysticks {
+		js.f.Close()
+		delete(joysticks, id)
+	}
+	lastScanned = time.Time{}
+}
+
+func listDevices() ([]Device, error) {
+	entries, err := os.ReadDir("/sys/class/hidraw")
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, gomerrors.NewDeviceError("hid", "hidraw is not available", ErrNotSupported)
+		}
+		return nil, err
+	}
+	devices := make([]Device, 0, len(entries))
+	for _, e := range entries {
+		dir := filepath.Join("/sys/class/hidraw", e.Name(), "device")
+		d := Device{ID: "/dev/" + e.Name()}
+		parseUevent(dir+"/uevent", &d)
+		if desc, err := os.ReadFile(dir + "/report_descriptor"); err == nil {
+			d.UsagePage, d.Usage = topUsage(desc)
+		}
+		devices = append(devices, d)
+	}
+	return devices, nil
+}
+
+// parseUevent, HID_ID ("0003:0000046D:0000C52B") ve HID_NAME alanlarını okur.
+func parseUevent(path string, d *Device) {
+	f, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, value, _ := strings.Cut(scanner.Text(), "=")
+		switch key {
+		case "HID_NAME":
+			d.Name = value
+		case "HID_ID":
+			parts := strings.Split(value, ":")
+			if len(parts) == 3 {
+				vendor, _ := strconv.ParseUint(parts[1], 16, 32)
+				product, _ := strconv.ParseUint(parts[2], 16, 32)
+				d.VendorID, d.ProductID = uint16(vendor), uint16(product)
+			}
+		}
+	}
+}
+
+// topUsage, rapor tanımlayıcısındaki ilk koleksiyonun Usage Page ve Usage
+// değerlerini döner (HID 1.11, 6.2.2 kısa öğeler).
+func topUsage(desc []byte) (page, usage uint16) {
+	for i := 0; i < len(desc); {
+		prefix := desc[i]
+		if prefix == 0xFE { // Uzun öğe
+			if i+1 >= len(desc) {
+				break
+			}
+			i += 3 + int(desc[i+1])
+			continue
+		}
+		size := int(prefix & 0x03)
+		if size == 3 {
+			size = 4
+		}
+		if i+1+size > len(desc) {
+			break
+		}
+		var value uint32
+		for b := 0; b < size; b++ {
+			value |= uint32(desc[i+1+b]) << (8 * b)
+		}
+		switch prefix &^ 0x03 {
+		case 0x04: // Usage Page (global)
+			page = uint16(value)
+		case 0x08: // Usage (local)
+			usage = uint16(value)
+		case 0xA0: // Collection
+			return page, usage
+		}
+		i += 1 + size
+	}
+	return page, usage
+}
+
+func openDevice(id string) (io.ReadCloser, error) {
+	f, err := os.Open(id)
+	if err != nil {
+		return nil, gomerrors.NewDeviceError("hid", "open "+id, err)
+	}
+	return f, nil
+}
+
+// readSysfs, bir sysfs dosyasını boşlukları kırparak okur.
+func readSysfs(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
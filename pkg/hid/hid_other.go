@@ -0,0 +1,23 @@
+//go:build !windows && !linux
+
+package hid
+
+import (
+	"io"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+func readGamepads() ([]gamepadState, error) {
+	return nil, gomerrors.NewDeviceError("hid", "gamepads", ErrNotSupported)
+}
+
+func stopGamepads() {}
+
+func listDevices() ([]Device, error) {
+	return nil, gomerrors.NewDeviceError("hid", "list devices", ErrNotSupported)
+}
+
+func openDevice(id string) (io.ReadCloser, error) {
+	return nil, gomerrors.NewDeviceError("hid", "open device", ErrNotSupported)
+}
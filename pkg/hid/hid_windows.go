@@ -0,0 +1,128 @@
+//go:build windows
+
+package hid
+
+import (
+	"fmt"
+	"io"
+	"syscall"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/platform/windows"
+)
+
+// XInput ölü bölgeleri ve tetik eşiği (XInput.h önerileri)
+const (
+	leftThumbDeadzone  = 7849
+	rightThumbDeadzone = 8689
+)
+
+// xinputButtons, standart düzendeki 0-5 ve 8-15 numaralı tuşların XInput
+// bitleridir; 6 ve 7 analog tetiklerdir.
+var xinputButtons = map[int]uint16{
+	0: windows.XINPUT_GAMEPAD_A, 1: windows.XINPUT_GAMEPAD_B,
+	2: windows.XINPUT_GAMEPAD_X, 3: windows.XINPUT_GAMEPAD_Y,
+	4: windows.XINPUT_GAMEPAD_LEFT_SHOULDER, 5: windows.XINPUT_GAMEPAD_RIGHT_SHOULDER,
+	8: windows.XINPUT_GAMEPAD_BACK, 9: windows.XINPUT_GAMEPAD_START,
+	10: windows.XINPUT_GAMEPAD_LEFT_THUMB, 11: windows.XINPUT_GAMEPAD_RIGHT_THUMB,
+	12: windows.XINPUT_GAMEPAD_DPAD_UP, 13: windows.XINPUT_GAMEPAD_DPAD_DOWN,
+	14: windows.XINPUT_GAMEPAD_DPAD_LEFT, 15: windows.XINPUT_GAMEPAD_DPAD_RIGHT,
+}
+
+func readGamepads() ([]gamepadState, error) {
+	if !windows.XInputAvailable() {
+		return nil, gomerrors.NewDeviceError("hid", "XInput is not available", ErrNotSupported)
+	}
+	var states []gamepadState
+	for slot := 0; slot < windows.XUSER_MAX_COUNT; slot++ {
+		state, ok := windows.XInputGetState(slot)
+		if !ok {
+			continue
+		}
+		pad := state.Gamepad
+		s := gamepadState{
+			Gamepad: Gamepad{
+				Index:   slot,
+				ID:      fmt.Sprintf("xinput:%d", slot),
+				Name:    "Xbox Controller (XInput)",
+				Axes:    4,
+				Buttons: 16,
+			},
+			axes: []float64{
+				thumb(pad.SThumbLX, leftThumbDeadzone),
+				-thumb(pad.SThumbLY, leftThumbDeadzone),
+				thumb(pad.SThumbRX, rightThumbDeadzone),
+				-thumb(pad.SThumbRY, rightThumbDeadzone),
+			},
+			buttons: make([]float64, 16),
+		}
+		for i, bit := range xinputButtons {
+			if pad.WButtons&bit != 0 {
+				s.buttons[i] = 1
+			}
+		}
+		s.buttons[6] = float64(pad.BLeftTrigger) / 255
+		s.buttons[7] = float64(pad.BRightTrigger) / 255
+		states = append(states, s)
+	}
+	return states, nil
+}
+
+// thumb, çubuk değerini ölü bölgeyi atlayarak -1..1 aralığına çevirir.
+func thumb(v int16, deadzone float64) float64 {
+	f := float64(v)
+	switch {
+	case f > deadzone:
+		return min((f-deadzone)/(32767-deadzone), 1)
+	case f < -deadzone:
+		return max((f+deadzone)/(32768-deadzone), -1)
+	}
+	return 0
+}
+
+func stopGamepads() {}
+
+func listDevices() ([]Device, error) {
+	found := windows.EnumHIDDevices()
+	devices := make([]Device, 0, len(found))
+	for _, d := range found {
+		devices = append(devices, Device{
+			ID:                d.Path,
+			Name:              d.Product,
+			Manufacturer:      d.Manufacturer,
+			VendorID:          d.VendorID,
+			ProductID:         d.ProductID,
+			UsagePage:         d.UsagePage,
+			Usage:             d.Usage,
+			InputReportLength: d.InputReportLength,
+		})
+	}
+	return devices, nil
+}
+
+// handleReader, ReadFile ile rapor okuyan aygıt tanıtıcısıdır.
+type handleReader struct {
+	h syscall.Handle
+}
+
+func (r *handleReader) Read(p []byte) (int, error) {
+	var n uint32
+	if err := syscall.ReadFile(r.h, p, &n, nil); err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
+
+// Close, bekleyen ReadFile'ı iptal eder ve tanıtıcıyı kapatır.
+func (r *handleReader) Close() error {
+	syscall.CancelIoEx(r.h, nil)
+	return syscall.CloseHandle(r.h)
+}
+
+func openDevice(id string) (io.ReadCloser, error) {
+	h, err := windows.OpenHID(id, syscall.GENERIC_READ)
+	if err != nil {
+		return nil, gomerrors.NewDeviceError("hid", "open "+id, err)
+	}
+	return &handleReader{h: h}, nil
+}
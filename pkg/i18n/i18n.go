@@ -0,0 +1,251 @@
+// Package i18n, GOMAD uygulamaları için yerelleştirme desteğidir: işletim
+// sisteminin yerel ayarını algılar, gömülü çeviri paketlerini yükler ve
+// Go ile JS'in aynı metinleri üretmesi için ortak bir biçimlendirme sunar.
+//
+// Çeviri paketleri, dizindeki "<yerel ayar>.json" dosyalarıdır. İç içe
+// nesneler noktalı anahtarlara düzleştirilir:
+//
+//	// locales/tr.json
+//	{
+//	  "greeting": "Merhaba {name}",
+//	  "files": { "count": { "zero": "Dosya yok", "one": "1 dosya", "other": "{count} dosya" } }
+//	}
+//
+//	bundle, err := i18n.Load(locales, "locales")
+//	bundle.T("tr-TR", "greeting", map[string]any{"name": "Ahmet"}) // "Merhaba Ahmet"
+//	bundle.T("tr", "files.count", map[string]any{"count": 3})       // "3 dosya"
+//
+// Bir anahtar istenen yerel ayarda yoksa sırasıyla dil kodu ("tr-TR" → "tr")
+// ve varsayılan yerel ayar denenir; hiçbirinde yoksa anahtarın kendisi döner.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Message, düzleştirilmiş tek bir çeviridir. Çoğul biçimleri olan
+// mesajlarda Text boştur ve Plural doludur.
+type Message struct {
+	Text   string            `json:"text,omitempty"`
+	Plural map[string]string `json:"plural,omitempty"`
+}
+
+// Bundle, yerel ayarlara göre gruplanmış çevirilerdir. Yüklendikten sonra
+// salt okunurdur; aynı anda birden fazla goroutine'den kullanılabilir.
+type Bundle struct {
+	messages map[string]map[string]Message
+
+	// Default, anahtar bulunamadığında denenen yerel ayardır. Load, "en"
+	// paketi varsa onu, yoksa alfabetik ilk paketi seçer.
+	Default string
+}
+
+// pluralForms, bir nesnenin çoğul biçim tablosu sayılması için anahtarlarıdır.
+var pluralForms = map[string]bool{"zero": true, "one": true, "two": true, "few": true, "many": true, "other": true}
+
+// Load, fsys'nin dir dizinindeki *.json dosyalarını yükler. Dosya adı yerel
+// ayar kodudur ("tr.json", "pt-BR.json").
+func Load(fsys fs.FS, dir string) (*Bundle, error) {
+	files, err := fs.Glob(fsys, path.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("i18n: no translation bundles in %q", dir)
+	}
+
+	b := &Bundle{messages: map[string]map[string]Message{}}
+	for _, file := range files {
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+		var tree map[string]any
+		if err := json.Unmarshal(data, &tree); err != nil {
+			return nil, fmt.Errorf("i18n: %s: %w", file, err)
+		}
+		locale := Normalize(strings.TrimSuffix(path.Base(file), ".json"))
+		msgs := map[string]Message{}
+		if err := flatten("", tree, msgs); err != nil {
+			return nil, fmt.Errorf("i18n: %s: %w", file, err)
+		}
+		b.messages[locale] = msgs
+	}
+
+	b.Default = b.Locales()[0]
+	if _, ok := b.messages["en"]; ok {
+		b.Default = "en"
+	}
+	return b, nil
+}
+
+// flatten, iç içe çeviri nesnesini noktalı anahtarlara açar.
+func flatten(prefix string, tree map[string]any, out map[string]Message) error {
+	for k, v := range tree {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		switch val := v.(type) {
+		case string:
+			out[key] = Message{Text: val}
+		case map[string]any:
+			if plural, ok := pluralTable(val); ok {
+				out[key] = Message{Plural: plural}
+				continue
+			}
+			if err := flatten(key, val, out); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("key %q: expected string or object, got %T", key, v)
+		}
+	}
+	return nil
+}
+
+// pluralTable, m yalnızca çoğul biçim anahtarlarından ("one", "other"...)
+// oluşuyorsa onu döner. "other" zorunludur.
+func pluralTable(m map[string]any) (map[string]string, bool) {
+	if _, ok := m["other"]; !ok {
+		return nil, false
+	}
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		s, ok := v.(string)
+		if !pluralForms[k] || !ok {
+			return nil, false
+		}
+		out[k] = s
+	}
+	return out, true
+}
+
+// Locales, yüklü yerel ayarları alfabetik sırayla döner.
+func (b *Bundle) Locales() []string {
+	out := make([]string, 0, len(b.messages))
+	for l := range b.messages {
+		out = append(out, l)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Match, istenen yerel ayara en yakın yüklü paketi döner: tam eşleşme, dil
+// kodu ("tr-TR" → "tr"), aynı dilin başka bir bölgesi ("pt-PT" → "pt-BR"),
+// yoksa Default.
+func (b *Bundle) Match(locale string) string {
+	locale = Normalize(locale)
+	if _, ok := b.messages[locale]; ok {
+		return locale
+	}
+	lang, _, _ := strings.Cut(locale, "-")
+	if _, ok := b.messages[lang]; ok {
+		return lang
+	}
+	for _, l := range b.Locales() {
+		if strings.HasPrefix(l, lang+"-") {
+			return l
+		}
+	}
+	return b.Default
+}
+
+// Messages, locale'e en yakın paketin mesajlarını, Default paketindeki eksik
+// anahtarlarla tamamlanmış olarak döner. JS tarafına tek seferde gönderilir.
+func (b *Bundle) Messages(locale string) map[string]Message {
+	out := map[string]Message{}
+	for k, m := range b.messages[b.Default] {
+		out[k] = m
+	}
+	for k, m := range b.messages[b.Match(locale)] {
+		out[k] = m
+	}
+	return out
+}
+
+// T, key'in locale'deki çevirisini args ile biçimlendirir. Metindeki {ad}
+// yer tutucuları args["ad"] ile değiştirilir; çoğul mesajlarda biçim
+// args["count"]'a göre seçilir.
+func (b *Bundle) T(locale, key string, args map[string]any) string {
+	msg, ok := b.messages[b.Match(locale)][key]
+	if !ok {
+		msg, ok = b.messages[b.Default][key]
+	}
+	if !ok {
+		return key
+	}
+	return Format(msg, args)
+}
+
+// Format, mesajı args ile biçimlendirir. JS tarafındaki gomad.i18n.t aynı
+// kuralları uygular.
+func Format(msg Message, args map[string]any) string {
+	text := msg.Text
+	if msg.Plural != nil {
+		text = msg.Plural[pluralForm(msg.Plural, args["count"])]
+	}
+	if len(args) == 0 || !strings.Contains(text, "{") {
+		return text
+	}
+	var sb strings.Builder
+	for {
+		start := strings.IndexByte(text, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(text[start:], '}')
+		if end < 0 {
+			break
+		}
+		name := text[start+1 : start+end]
+		sb.WriteString(text[:start])
+		if v, ok := args[name]; ok {
+			fmt.Fprint(&sb, v)
+		} else {
+			sb.WriteString(text[start : start+end+1])
+		}
+		text = text[start+end+1:]
+	}
+	sb.WriteString(text)
+	return sb.String()
+}
+
+// pluralForm, count için kullanılacak biçimi seçer: 0 → "zero", 1 → "one",
+// 2 → "two" (tabloda varsa), diğerleri "other". Dile özgü kurallar (ör.
+// Arapça "few"/"many") için uygulama count'u kendi hesaplayıp farklı
+// anahtarlar kullanabilir.
+func pluralForm(table map[string]string, count any) string {
+	var n float64
+	switch v := count.(type) {
+	case int:
+		n = float64(v)
+	case int64:
+		n = float64(v)
+	case float64:
+		n = v
+	default:
+		return "other"
+	}
+	for _, f := range []struct {
+		n    float64
+		form string
+	}{{0, "zero"}, {1, "one"}, {2, "two"}} {
+		if n == f.n {
+			if _, ok := table[f.form]; ok {
+				return f.form
+			}
+		}
+	}
+	return "other"
+}
@@ -0,0 +1,49 @@
+package i18n
+
+import (
+	"os"
+	"strings"
+)
+
+// Locale, işletim sisteminin kullanıcı yerel ayarını BCP 47 biçiminde
+// ("tr-TR", "en-US") döner. Algılanamazsa "en" döner.
+func Locale() string {
+	if l := envLocale(); l != "" {
+		return l
+	}
+	if l := systemLocale(); l != "" {
+		return Normalize(l)
+	}
+	return "en"
+}
+
+// envLocale, POSIX yerel ayar değişkenlerini öncelik sırasıyla okur.
+// "C" ve "POSIX" yerel ayar seçilmemiş sayılır.
+func envLocale() string {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		v := os.Getenv(name)
+		if v == "" || v == "C" || v == "POSIX" || strings.HasPrefix(v, "C.") {
+			continue
+		}
+		return Normalize(v)
+	}
+	return ""
+}
+
+// Normalize, POSIX ve Windows biçimlerindeki yerel ayar adlarını BCP 47'ye
+// çevirir: "tr_TR.UTF-8" → "tr-TR", "en_US@euro" → "en-US", "EN" → "en".
+func Normalize(locale string) string {
+	locale, _, _ = strings.Cut(locale, ".")
+	locale, _, _ = strings.Cut(locale, "@")
+	parts := strings.Split(strings.ReplaceAll(locale, "_", "-"), "-")
+	parts[0] = strings.ToLower(parts[0])
+	for i := 1; i < len(parts); i++ {
+		switch len(parts[i]) {
+		case 2:
+			parts[i] = strings.ToUpper(parts[i]) // bölge: TR
+		case 4:
+			parts[i] = strings.ToUpper(parts[i][:1]) + strings.ToLower(parts[i][1:]) // alfabe: Latn
+		}
+	}
+	return strings.Join(parts, "-")
+}
@@ -0,0 +1,28 @@
+//go:build darwin
+
+package i18n
+
+import (
+	"os/exec"
+	"strings"
+)
+
+// systemLocale, tercih edilen ilk dili (AppleLanguages) okur; GUI'den
+// başlatılan uygulamalarda LANG genellikle tanımlı değildir.
+func systemLocale() string {
+	out, err := exec.Command("defaults", "read", "-g", "AppleLanguages").Output()
+	if err == nil {
+		// ( "tr-TR", "en-US" )
+		for _, line := range strings.Split(string(out), "\n") {
+			line = strings.Trim(strings.TrimSpace(line), `(),"`)
+			if line != "" {
+				return line
+			}
+		}
+	}
+	out, err = exec.Command("defaults", "read", "-g", "AppleLocale").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
@@ -0,0 +1,6 @@
+//go:build !windows && !darwin
+
+package i18n
+
+// systemLocale, ortam değişkenleri dışında bir kaynak olmayan platformlarda boştur.
+func systemLocale() string { return "" }
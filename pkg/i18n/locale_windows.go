@@ -0,0 +1,13 @@
+//go:build windows
+
+package i18n
+
+import "github.com/biyonik/gomad/internal/platform/windows"
+
+func systemLocale() string {
+	name, err := windows.UserLocaleName()
+	if err != nil {
+		return ""
+	}
+	return name
+}
@@ -0,0 +1,100 @@
+// Package idle, kullanıcının sistem genelindeki hareketsizlik süresini ölçer.
+//
+// Süre yalnızca bu uygulamaya değil, tüm oturuma ait klavye/fare girişine
+// göre hesaplanır. UI kilitleme, zamanlayıcıları durdurma veya durumu
+// "uzakta" yapma gibi işler için kullanılır.
+//
+// Örnek:
+//
+//	cancel, err := idle.OnIdle(5*time.Minute,
+//	    func() { presence.Set("away") },
+//	    func() { presence.Set("online") },
+//	)
+//	defer cancel()
+//
+// Platform desteği:
+//   - Windows: GetLastInputInfo.
+//   - Linux (X11): xprintidle aracı kuruluysa.
+//   - Diğer: ErrNotSupported.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package idle
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// ErrNotSupported, platform hareketsizlik süresini veremiyorsa döner.
+var ErrNotSupported = gomerrors.ErrNotSupported
+
+// maxPollInterval, OnIdle'ın durumu en seyrek kontrol etme aralığıdır.
+const maxPollInterval = 5 * time.Second
+
+// Time, son kullanıcı girişinden bu yana geçen süreyi döner.
+func Time() (time.Duration, error) {
+	return idleTime()
+}
+
+// OnIdle, kullanıcı threshold kadar hareketsiz kaldığında onIdle'ı, sonra
+// tekrar etkinleştiğinde onActive'i çağırır. Her ikisi de nil olabilir.
+// Callback'ler izleyici goroutine'inde çağrılır. Dönen fonksiyon izlemeyi durdurur.
+func OnIdle(threshold time.Duration, onIdle, onActive func()) (cancel func(), err error) {
+	if threshold <= 0 {
+		return nil, fmt.Errorf("idle threshold must be positive")
+	}
+	if _, err := idleTime(); err != nil {
+		return nil, err
+	}
+
+	interval := threshold / 4
+	if interval > maxPollInterval {
+		interval = maxPollInterval
+	}
+	if interval < 100*time.Millisecond {
+		interval = 100 * time.Millisecond
+	}
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		isIdle := false
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			d, err := idleTime()
+			if err != nil {
+				continue
+			}
+			switch {
+			case !isIdle && d >= threshold:
+				isIdle = true
+				if onIdle != nil {
+					onIdle()
+				}
+			case isIdle && d < threshold:
+				isIdle = false
+				if onActive != nil {
+					onActive()
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+	}, nil
+}
@@ -0,0 +1,30 @@
+//go:build linux
+
+package idle
+
+import (
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// idleTime, X11 ekran koruyucu uzantısını sorgulayan xprintidle aracını kullanır.
+// Wayland oturumlarında bu bilgi uygulamalara açık değildir.
+func idleTime() (time.Duration, error) {
+	path, err := exec.LookPath("xprintidle")
+	if err != nil {
+		return 0, gomerrors.NewOperationError("idle.time", "xprintidle not found", ErrNotSupported)
+	}
+	out, err := exec.Command(path).Output()
+	if err != nil {
+		return 0, err
+	}
+	ms, err := strconv.ParseInt(strings.TrimSpace(string(out)), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(ms) * time.Millisecond, nil
+}
@@ -0,0 +1,13 @@
+//go:build !windows && !linux
+
+package idle
+
+import (
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+func idleTime() (time.Duration, error) {
+	return 0, gomerrors.NewOperationError("idle.time", "idle time", ErrNotSupported)
+}
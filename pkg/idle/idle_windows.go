@@ -0,0 +1,17 @@
+//go:build windows
+
+package idle
+
+import (
+	"time"
+
+	"github.com/biyonik/gomad/internal/platform/windows"
+)
+
+func idleTime() (time.Duration, error) {
+	ms, err := windows.IdleMilliseconds()
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(ms) * time.Millisecond, nil
+}
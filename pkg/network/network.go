@@ -0,0 +1,186 @@
+// Package network, işletim sistemi seviyesinde ağ bağlantı durumunu izler.
+//
+// Tarayıcının navigator.onLine değeri WebView içinde güvenilir değildir ve
+// Go tarafındaki senkronizasyon motorları ona erişemez. Bu paket ağ
+// arayüzlerini doğrudan işletim sisteminden okur; Go ve JS aynı durumu görür.
+//
+// Örnek:
+//
+//	cancel, err := network.Subscribe(func(e network.Event) {
+//	    if e.Status.Online { syncer.Resume() } else { syncer.Pause() }
+//	})
+//	defer cancel()
+//
+// "Online", en az bir fiziksel arayüzün açık ve yönlendirilebilir bir IP
+// adresine sahip olması demektir; internete erişimin garantisi değildir
+// (captive portal, firewall). Sanal arayüzler (Docker, VM köprüleri) sayılmaz.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package network
+
+import (
+	"net"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// pollInterval, arayüz durumunun okunma sıklığıdır.
+const pollInterval = 3 * time.Second
+
+// virtualPrefixes, bağlantı durumunu etkilemeyen sanal arayüz adı önekleridir.
+var virtualPrefixes = []string{"docker", "veth", "br-", "virbr", "vmnet", "vboxnet", "vEthernet"}
+
+// Interface, bağlantıya katkı veren bir ağ arayüzüdür.
+type Interface struct {
+	Name      string   `json:"name"`
+	Addresses []string `json:"addresses"`
+}
+
+// Status, ağın anlık durumudur.
+type Status struct {
+	Online     bool        `json:"online"`
+	Interfaces []Interface `json:"interfaces"`
+}
+
+// EventType, ağ olayının türüdür.
+type EventType string
+
+const (
+	EventOnline  EventType = "online"  // Bağlantı kuruldu
+	EventOffline EventType = "offline" // Bağlantı koptu
+	EventChanged EventType = "changed" // Bağlı kalındı ama arayüz/adres değişti (ör. Wi-Fi → Ethernet)
+)
+
+// Event, bir ağ değişikliğidir.
+type Event struct {
+	Type   EventType `json:"type"`
+	Status Status    `json:"status"`
+}
+
+var (
+	subscribers = make(map[int]func(Event))
+	nextSub     int
+	subMu       sync.Mutex
+
+	lastStatus Status
+	started    bool
+)
+
+// GetStatus, ağın anlık durumunu döner.
+func GetStatus() (Status, error) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return Status{}, err
+	}
+
+	status := Status{Interfaces: []Interface{}}
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagUp == 0 || iface.Flags&net.FlagLoopback != 0 || isVirtual(iface.Name) {
+			continue
+		}
+		addrs, err := iface.Addrs()
+		if err != nil {
+			continue
+		}
+
+		var routable []string
+		for _, addr := range addrs {
+			ipnet, ok := addr.(*net.IPNet)
+			if !ok || !ipnet.IP.IsGlobalUnicast() {
+				continue // link-local (169.254.x.x, fe80::) bağlantı sayılmaz
+			}
+			routable = append(routable, ipnet.IP.String())
+		}
+		if len(routable) == 0 {
+			continue
+		}
+		sort.Strings(routable)
+		status.Interfaces = append(status.Interfaces, Interface{Name: iface.Name, Addresses: routable})
+	}
+	status.Online = len(status.Interfaces) > 0
+	return status, nil
+}
+
+// Subscribe, ağ değişiklikleri için bir dinleyici ekler ve kaldırma fonksiyonu döner.
+// fn, izleyici goroutine'inde çağrılır. İlk abonelikte izleme başlatılır.
+func Subscribe(fn func(Event)) (cancel func(), err error) {
+	subMu.Lock()
+	defer subMu.Unlock()
+
+	if !started {
+		if lastStatus, err = GetStatus(); err != nil {
+			return nil, err
+		}
+		go poll()
+		started = true
+	}
+
+	id := nextSub
+	nextSub++
+	subscribers[id] = fn
+
+	return func() {
+		subMu.Lock()
+		defer subMu.Unlock()
+		delete(subscribers, id)
+	}, nil
+}
+
+// poll, arayüzleri periyodik okuyup değişiklikleri yayınlar.
+func poll() {
+	for {
+		time.Sleep(pollInterval)
+
+		status, err := GetStatus()
+		if err != nil {
+			continue
+		}
+
+		subMu.Lock()
+		prev := lastStatus
+		lastStatus = status
+		fns := make([]func(Event), 0, len(subscribers))
+		for _, fn := range subscribers {
+			fns = append(fns, fn)
+		}
+		subMu.Unlock()
+
+		var t EventType
+		switch {
+		case status.Online && !prev.Online:
+			t = EventOnline
+		case !status.Online && prev.Online:
+			t = EventOffline
+		case !sameInterfaces(status.Interfaces, prev.Interfaces):
+			t = EventChanged
+		default:
+			continue
+		}
+
+		e := Event{Type: t, Status: status}
+		for _, fn := range fns {
+			fn(e)
+		}
+	}
+}
+
+func sameInterfaces(a, b []Interface) bool {
+	return slices.EqualFunc(a, b, func(x, y Interface) bool {
+		return x.Name == y.Name && slices.Equal(x.Addresses, y.Addresses)
+	})
+}
+
+func isVirtual(name string) bool {
+	for _, prefix := range virtualPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
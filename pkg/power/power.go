@@ -0,0 +1,144 @@
+// Package power, güç kaynağı ve uyku/uyanma olaylarını izler.
+//
+// Uygulamalar pildeyken senkronizasyonu yavaşlatmak, uykudan uyanınca
+// bağlantıları yeniden kurmak gibi işler için bu olayları kullanır.
+//
+// Örnek:
+//
+//	cancel, err := power.Subscribe(func(e power.Event) {
+//	    switch e.Type {
+//	    case power.EventResume:
+//	        reconnect()
+//	    case power.EventPowerSource:
+//	        if e.Status.OnBattery { sync.SlowDown() }
+//	    }
+//	})
+//	defer cancel()
+//
+// Platform desteği:
+//   - Windows: WM_POWERBROADCAST (tüm olaylar).
+//   - Linux: /sys/class/power_supply periyodik okunur; uyanma, duvar saati ile
+//     monotonik saat arasındaki farktan tespit edilir. Uyku olayı bildirilemez.
+//   - Diğer: ErrNotSupported.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package power
+
+import (
+	"sync"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// ErrNotSupported, platform güç olaylarını desteklemiyorsa döner.
+var ErrNotSupported = gomerrors.ErrNotSupported
+
+// Status, güç kaynağının anlık durumudur.
+type Status struct {
+	// OnBattery, sistem pille çalışıyorsa true'dur.
+	OnBattery bool `json:"onBattery"`
+
+	// HasBattery, sistemde pil varsa true'dur (masaüstü bilgisayarlarda false).
+	HasBattery bool `json:"hasBattery"`
+
+	// BatteryPercent, pil doluluk oranıdır (0-100). Bilinmiyorsa -1.
+	BatteryPercent int `json:"batteryPercent"`
+}
+
+// EventType, güç olayının türüdür.
+type EventType string
+
+const (
+	// EventSuspend, sistem uykuya geçmek üzereyken gönderilir.
+	EventSuspend EventType = "suspend"
+
+	// EventResume, sistem uykudan uyandığında gönderilir.
+	EventResume EventType = "resume"
+
+	// EventPowerSource, AC ile pil arasında geçiş olduğunda gönderilir.
+	EventPowerSource EventType = "source"
+
+	// EventBatteryLevel, pil yüzdesi değiştiğinde gönderilir.
+	EventBatteryLevel EventType = "battery"
+)
+
+// Event, bir güç olayıdır. Status, olay anındaki durumdur.
+type Event struct {
+	Type   EventType `json:"type"`
+	Status Status    `json:"status"`
+}
+
+var (
+	subscribers = make(map[int]func(Event))
+	nextSub     int
+	subMu       sync.Mutex
+
+	lastStatus Status
+	started    bool
+)
+
+// GetStatus, güç kaynağının anlık durumunu döner.
+func GetStatus() (Status, error) {
+	return readStatus()
+}
+
+// Subscribe, güç olayları için bir dinleyici ekler ve kaldırma fonksiyonu döner.
+// fn, platform izleyicisinin goroutine'inde çağrılır; uzun işler için yeni
+// goroutine başlatılmalıdır. İlk abonelikte izleme başlatılır.
+func Subscribe(fn func(Event)) (cancel func(), err error) {
+	subMu.Lock()
+	defer subMu.Unlock()
+
+	if !started {
+		if lastStatus, err = readStatus(); err != nil {
+			return nil, err
+		}
+		if err := startMonitor(); err != nil {
+			return nil, err
+		}
+		started = true
+	}
+
+	id := nextSub
+	nextSub++
+	subscribers[id] = fn
+
+	return func() {
+		subMu.Lock()
+		defer subMu.Unlock()
+		delete(subscribers, id)
+	}, nil
+}
+
+// publish, olayı tüm dinleyicilere iletir.
+func publish(t EventType, status Status) {
+	subMu.Lock()
+	fns := make([]func(Event), 0, len(subscribers))
+	for _, fn := range subscribers {
+		fns = append(fns, fn)
+	}
+	subMu.Unlock()
+
+	e := Event{Type: t, Status: status}
+	for _, fn := range fns {
+		fn(e)
+	}
+}
+
+// statusChanged, yeni durumu öncekiyle karşılaştırıp uygun olayları yayınlar.
+func statusChanged(status Status) {
+	subMu.Lock()
+	prev := lastStatus
+	lastStatus = status
+	subMu.Unlock()
+
+	if status.OnBattery != prev.OnBattery {
+		publish(EventPowerSource, status)
+	}
+	if status.BatteryPercent != prev.BatteryPercent {
+		publish(EventBatteryLevel, status)
+	}
+}
@@ -0,0 +1,95 @@
+//go:build linux
+
+package power
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	powerSupplyDir = "/sys/class/power_supply"
+
+	// pollInterval, güç durumunun okunma sıklığıdır.
+	pollInterval = 10 * time.Second
+
+	// resumeThreshold, duvar saatinin monotonik saatten bu kadar ileri
+	// atlaması sistemin uykuda kaldığını gösterir.
+	resumeThreshold = 5 * time.Second
+)
+
+// startMonitor, güç durumunu periyodik okuyan bir goroutine başlatır.
+func startMonitor() error {
+	go func() {
+		prev := time.Now()
+		for {
+			time.Sleep(pollInterval)
+			now := time.Now()
+
+			// Monotonik saat uykuda ilerlemez; duvar saati ilerler
+			wall := now.Round(0).Sub(prev.Round(0))
+			mono := now.Sub(prev)
+			prev = now
+
+			status, err := readStatus()
+			if err != nil {
+				continue
+			}
+			if wall-mono > resumeThreshold {
+				publish(EventResume, status)
+			}
+			statusChanged(status)
+		}
+	}()
+	return nil
+}
+
+// readStatus, /sys/class/power_supply altındaki AC ve pil bilgilerini okur.
+func readStatus() (Status, error) {
+	status := Status{BatteryPercent: -1}
+
+	entries, err := os.ReadDir(powerSupplyDir)
+	if err != nil {
+		// power_supply yoksa (ör. sanal makine) AC ile çalışıldığı varsayılır
+		return status, nil
+	}
+
+	acOnline := false
+	hasAC := false
+	for _, e := range entries {
+		dir := filepath.Join(powerSupplyDir, e.Name())
+		switch readSysfs(dir, "type") {
+		case "Mains":
+			hasAC = true
+			if readSysfs(dir, "online") == "1" {
+				acOnline = true
+			}
+		case "Battery":
+			if readSysfs(dir, "scope") == "Device" {
+				continue // Fare, klavye gibi cihaz pilleri
+			}
+			status.HasBattery = true
+			if n, err := strconv.Atoi(readSysfs(dir, "capacity")); err == nil {
+				status.BatteryPercent = n
+			}
+			if readSysfs(dir, "status") == "Discharging" {
+				status.OnBattery = true
+			}
+		}
+	}
+	if hasAC {
+		status.OnBattery = status.HasBattery && !acOnline
+	}
+	return status, nil
+}
+
+func readSysfs(dir, name string) string {
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
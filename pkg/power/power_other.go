@@ -0,0 +1,15 @@
+//go:build !windows && !linux
+
+package power
+
+import (
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+func startMonitor() error {
+	return gomerrors.NewOperationError("power.monitor", "power monitor", ErrNotSupported)
+}
+
+func readStatus() (Status, error) {
+	return Status{BatteryPercent: -1}, gomerrors.NewOperationError("power.status", "power status", ErrNotSupported)
+}
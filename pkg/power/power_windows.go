@@ -0,0 +1,65 @@
+//go:build windows
+
+package power
+
+import (
+	"syscall"
+
+	"github.com/biyonik/gomad/internal/platform/windows"
+)
+
+var monitor *windows.MessageThread
+
+// startMonitor, WM_POWERBROADCAST dinleyen bir mesaj thread'i başlatır.
+func startMonitor() error {
+	t, err := windows.NewMessageThread("GomadPower",
+		func(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) (uintptr, bool) {
+			if msg != windows.WM_POWERBROADCAST {
+				return 0, false
+			}
+			handleBroadcast(wParam)
+			return 1, true // TRUE: isteği reddetme
+		})
+	if err != nil {
+		return err
+	}
+	monitor = t
+	return nil
+}
+
+// handleBroadcast, WM_POWERBROADCAST olayını çevirir. Mesaj thread'inde çalışır;
+// dinleyiciler subMu tutulmadan çağrılır.
+func handleBroadcast(event uintptr) {
+	status, err := readStatus()
+	if err != nil {
+		return
+	}
+
+	switch event {
+	case windows.PBT_APMSUSPEND:
+		publish(EventSuspend, status)
+	case windows.PBT_APMRESUMEAUTOMATIC:
+		// RESUMESUSPEND yalnızca kullanıcı etkileşimiyle uyanınca gelir;
+		// RESUMEAUTOMATIC her uyanışta gelir. Tekrarı önlemek için yalnızca bu kullanılır.
+		publish(EventResume, status)
+	case windows.PBT_APMPOWERSTATUSCHANGE:
+		statusChanged(status)
+	}
+}
+
+func readStatus() (Status, error) {
+	s, err := windows.GetSystemPowerStatus()
+	if err != nil {
+		return Status{}, err
+	}
+
+	status := Status{
+		OnBattery:      s.ACLineStatus == 0,
+		HasBattery:     s.BatteryFlag != windows.BATTERY_FLAG_NO_BATTERY && s.BatteryFlag != windows.BATTERY_FLAG_UNKNOWN,
+		BatteryPercent: -1,
+	}
+	if status.HasBattery && s.BatteryLifePercent <= 100 {
+		status.BatteryPercent = int(s.BatteryLifePercent)
+	}
+	return status, nil
+}
@@ -0,0 +1,177 @@
+// Package printing, yüklü yazıcıları listeler ve dosyaları (PDF, metin)
+// diyalog göstermeden yazdırır. Gönderilen işlerin durumu kuyruktan izlenir.
+//
+// POS, fatura ve etiket uygulamaları için tasarlanmıştır: belge Go tarafında
+// PDF olarak üretilir ve doğrudan seçilen yazıcıya gönderilir.
+//
+// Örnek:
+//
+//	job, err := printing.PrintPDF(invoicePDF, printing.Options{
+//	    Printer:   "Zebra ZD420",
+//	    PaperSize: "A6",
+//	})
+//	if err != nil { return err }
+//	printing.Watch(job, func(s printing.JobStatus) {
+//	    log.Println("job", job.ID, s)
+//	})
+//
+// Platform desteği:
+//   - Windows: winspool ile yazıcı/kuyruk; dosya, türünün kayıtlı
+//     uygulamasının "printto" fiiliyle gönderilir (PDF için bir PDF
+//     görüntüleyici kurulu olmalıdır). Landscape, PaperSize ve Pages bu yolda
+//     uygulanamaz; yazıcının varsayılanları kullanılır.
+//   - Linux, macOS: CUPS (lp, lpstat). Tüm seçenekler desteklenir.
+//   - Diğer: ErrNotSupported.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package printing
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// ErrNotSupported, platform yazdırmayı desteklemiyorsa döner.
+var ErrNotSupported = gomerrors.ErrNotSupported
+
+// ErrNoPrinter, yazıcı belirtilmediğinde ve varsayılan yazıcı yoksa döner.
+var ErrNoPrinter = errors.New("no printer available")
+
+// watchInterval, iş durumunun kuyruktan okunma sıklığıdır.
+const watchInterval = time.Second
+
+// Printer, yüklü bir yazıcıdır.
+type Printer struct {
+	Name    string `json:"name"`
+	Default bool   `json:"default"`
+}
+
+// Options, yazdırma ayarlarıdır. Sıfır değer: varsayılan yazıcı, 1 kopya.
+type Options struct {
+	Printer   string `json:"printer"`   // Boşsa varsayılan yazıcı
+	Copies    int    `json:"copies"`    // 0 → 1
+	Landscape bool   `json:"landscape"` // Yatay sayfa
+	PaperSize string `json:"paperSize"` // "A4", "Letter", "A6"...; boşsa yazıcı varsayılanı
+	Pages     string `json:"pages"`     // Sayfa aralığı, ör. "1-3,5"; boşsa tümü
+}
+
+// JobStatus, bir yazdırma işinin durumudur.
+type JobStatus string
+
+const (
+	JobQueued    JobStatus = "queued"    // Kuyrukta bekliyor
+	JobPrinting  JobStatus = "printing"  // Yazdırılıyor
+	JobCompleted JobStatus = "completed" // Kuyruktan çıktı
+	JobFailed    JobStatus = "failed"    // Yazıcı hatası (kağıt bitti, çevrimdışı...)
+)
+
+// Job, yazıcıya gönderilmiş bir iştir.
+type Job struct {
+	ID       string `json:"id"`
+	Printer  string `json:"printer"`
+	Document string `json:"document"`
+}
+
+// Printers, yüklü yazıcıları döner.
+func Printers() ([]Printer, error) {
+	return listPrinters()
+}
+
+// DefaultPrinter, varsayılan yazıcının adını döner; yoksa "".
+func DefaultPrinter() (string, error) {
+	printers, err := listPrinters()
+	if err != nil {
+		return "", err
+	}
+	for _, p := range printers {
+		if p.Default {
+			return p.Name, nil
+		}
+	}
+	return "", nil
+}
+
+// PrintFile, dosyayı diyalog göstermeden yazıcıya gönderir.
+func PrintFile(path string, opts Options) (*Job, error) {
+	if opts.Copies <= 0 {
+		opts.Copies = 1
+	}
+	if opts.Printer == "" {
+		name, err := DefaultPrinter()
+		if err != nil {
+			return nil, err
+		}
+		if name == "" {
+			return nil, ErrNoPrinter
+		}
+		opts.Printer = name
+	}
+	return printFile(path, opts)
+}
+
+// PrintPDF, bellekteki PDF verisini yazdırır. Veri geçici bir dosyaya yazılır;
+// dosya iş kuyruktan çıktıktan sonra silinir.
+func PrintPDF(data []byte, opts Options) (*Job, error) {
+	f, err := os.CreateTemp("", "gomad-print-*.pdf")
+	if err != nil {
+		return nil, err
+	}
+	path := f.Name()
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(path)
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(path)
+		return nil, err
+	}
+
+	job, err := PrintFile(path, opts)
+	if err != nil {
+		os.Remove(path)
+		return nil, err
+	}
+	if job.ID == "" {
+		// İş izlenemiyor; yazdıran uygulama dosyayı hâlâ okuyor olabilir,
+		// geçici dizinde bırakılır
+		return job, nil
+	}
+	go Watch(job, func(s JobStatus) {
+		if s == JobCompleted || s == JobFailed {
+			os.Remove(path)
+		}
+	})
+	return job, nil
+}
+
+// Status, işin anlık durumunu döner.
+func Status(job *Job) (JobStatus, error) {
+	return jobStatus(job)
+}
+
+// Watch, işin durumunu değiştikçe fn ile bildirir ve iş tamamlanana ya da
+// başarısız olana kadar bloklar. Ayrı bir goroutine'de çağrılmalıdır.
+func Watch(job *Job, fn func(JobStatus)) {
+	var last JobStatus
+	for {
+		status, err := jobStatus(job)
+		if err != nil {
+			status = JobFailed
+		}
+		if status != last {
+			fn(status)
+			last = status
+		}
+		if status == JobCompleted || status == JobFailed {
+			return
+		}
+		time.Sleep(watchInterval)
+	}
+}
@@ -0,0 +1,110 @@
+//go:build linux || darwin
+
+package printing
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// requestIDPattern, lp çıktısındaki iş kimliğini yakalar:
+// "request id is Office-42 (1 file(s))"
+var requestIDPattern = regexp.MustCompile(`request id is (\S+)`)
+
+// cups, CUPS komut satırı aracını çalıştırır.
+func cups(name string, args ...string) (string, error) {
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return "", gomerrors.NewOperationError("printing."+name, name+" not found (CUPS)", ErrNotSupported)
+	}
+	out, err := exec.Command(path, args...).Output()
+	if err != nil {
+		if ee, ok := err.(*exec.ExitError); ok && len(ee.Stderr) > 0 {
+			return "", fmt.Errorf("%s: %s", name, strings.TrimSpace(string(ee.Stderr)))
+		}
+		return "", err
+	}
+	return string(out), nil
+}
+
+func listPrinters() ([]Printer, error) {
+	out, err := cups("lpstat", "-e")
+	if err != nil {
+		return nil, err
+	}
+
+	// "system default destination: Office" (yoksa "no system default destination")
+	var def string
+	if d, err := cups("lpstat", "-d"); err == nil {
+		if _, name, ok := strings.Cut(strings.TrimSpace(d), ": "); ok {
+			def = name
+		}
+	}
+
+	var printers []Printer
+	for _, name := range strings.Fields(out) {
+		printers = append(printers, Printer{Name: name, Default: name == def})
+	}
+	return printers, nil
+}
+
+func printFile(path string, opts Options) (*Job, error) {
+	args := []string{"-d", opts.Printer, "-n", fmt.Sprint(opts.Copies)}
+	if opts.Landscape {
+		args = append(args, "-o", "landscape")
+	}
+	if opts.PaperSize != "" {
+		args = append(args, "-o", "media="+opts.PaperSize)
+	}
+	if opts.Pages != "" {
+		args = append(args, "-o", "page-ranges="+opts.Pages)
+	}
+	args = append(args, "--", path)
+
+	out, err := cups("lp", args...)
+	if err != nil {
+		return nil, err
+	}
+	m := requestIDPattern.FindStringSubmatch(out)
+	if m == nil {
+		return nil, fmt.Errorf("lp: unexpected output %q", strings.TrimSpace(out))
+	}
+	return &Job{ID: m[1], Printer: opts.Printer, Document: filepath.Base(path)}, nil
+}
+
+// jobStatus, işi "lpstat -o" kuyruğunda arar. Kuyrukta yoksa tamamlanmıştır;
+// yazıcı durduysa (kağıt bitti vb.) iş başarısız sayılır.
+func jobStatus(job *Job) (JobStatus, error) {
+	out, err := cups("lpstat", "-o", job.Printer)
+	if err != nil {
+		return "", err
+	}
+	queued := false
+	for _, line := range strings.Split(out, "\n") {
+		if fields := strings.Fields(line); len(fields) > 0 && fields[0] == job.ID {
+			queued = true
+			break
+		}
+	}
+	if !queued {
+		return JobCompleted, nil
+	}
+
+	state, err := cups("lpstat", "-p", job.Printer)
+	if err != nil {
+		return JobQueued, nil
+	}
+	switch {
+	case strings.Contains(state, "disabled"):
+		return JobFailed, nil
+	case strings.Contains(state, "now printing "+job.ID):
+		return JobPrinting, nil
+	default:
+		return JobQueued, nil
+	}
+}
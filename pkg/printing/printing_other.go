@@ -0,0 +1,17 @@
+//go:build !windows && !linux && !darwin
+
+package printing
+
+import gomerrors "github.com/biyonik/gomad/internal/errors"
+
+func listPrinters() ([]Printer, error) {
+	return nil, gomerrors.NewOperationError("printing.list", "list printers", ErrNotSupported)
+}
+
+func printFile(path string, opts Options) (*Job, error) {
+	return nil, gomerrors.NewOperationError("printing.print", "print file", ErrNotSupported)
+}
+
+func jobStatus(job *Job) (JobStatus, error) {
+	return "", gomerrors.NewOperationError("printing.status", "job status", ErrNotSupported)
+}
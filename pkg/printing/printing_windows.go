@@ -0,0 +1,97 @@
+//go:build windows
+
+package printing
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/biyonik/gomad/internal/platform/windows"
+)
+
+// spoolTimeout, "printto" sonrası işin kuyrukta görünmesinin beklendiği süredir.
+const spoolTimeout = 15 * time.Second
+
+func listPrinters() ([]Printer, error) {
+	names, err := windows.EnumPrinters()
+	if err != nil {
+		return nil, err
+	}
+	def := windows.DefaultPrinter()
+	printers := make([]Printer, 0, len(names))
+	for _, name := range names {
+		printers = append(printers, Printer{Name: name, Default: name == def})
+	}
+	return printers, nil
+}
+
+// printFile, dosyayı "printto" ile gönderir ve kuyrukta dosya adıyla eşleşen
+// işi bulana kadar bekler. Kopyalar için dosya tekrar gönderilir.
+func printFile(path string, opts Options) (*Job, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	document := filepath.Base(abs)
+
+	before, err := windows.EnumJobs(opts.Printer)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[uint32]bool, len(before))
+	for _, j := range before {
+		known[j.ID] = true
+	}
+
+	for i := 0; i < opts.Copies; i++ {
+		if err := windows.ShellPrintTo(abs, opts.Printer); err != nil {
+			return nil, err
+		}
+	}
+
+	deadline := time.Now().Add(spoolTimeout)
+	for time.Now().Before(deadline) {
+		jobs, err := windows.EnumJobs(opts.Printer)
+		if err != nil {
+			return nil, err
+		}
+		for _, j := range jobs {
+			if !known[j.ID] && strings.Contains(j.Document, document) {
+				return &Job{ID: strconv.FormatUint(uint64(j.ID), 10), Printer: opts.Printer, Document: document}, nil
+			}
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+
+	// İş kuyruğa girmeden tamamlanmış (hızlı yazıcı) ya da uygulama başka
+	// bir adla göndermiş olabilir; durum izlenemez ama hata sayılmaz.
+	return &Job{Printer: opts.Printer, Document: document}, nil
+}
+
+func jobStatus(job *Job) (JobStatus, error) {
+	if job.ID == "" {
+		return JobCompleted, nil
+	}
+	jobs, err := windows.EnumJobs(job.Printer)
+	if err != nil {
+		return "", err
+	}
+	for _, j := range jobs {
+		if strconv.FormatUint(uint64(j.ID), 10) != job.ID {
+			continue
+		}
+		switch {
+		case j.Status&(windows.JOB_STATUS_ERROR|windows.JOB_STATUS_OFFLINE|windows.JOB_STATUS_PAPEROUT|windows.JOB_STATUS_BLOCKED) != 0:
+			return JobFailed, nil
+		case j.Status&windows.JOB_STATUS_PRINTED != 0:
+			return JobCompleted, nil
+		case j.Status&windows.JOB_STATUS_PRINTING != 0:
+			return JobPrinting, nil
+		default:
+			return JobQueued, nil
+		}
+	}
+	return JobCompleted, nil
+}
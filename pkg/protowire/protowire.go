@@ -0,0 +1,370 @@
+// Package protowire, "gomad proto -binary" ile üretilen kodun kullandığı
+// protobuf ikili kodlama (wire format) yardımcılarıdır.
+//
+// Paket harici bir protobuf kütüphanesi gerektirmez; yalnızca üretilen
+// mesajların ihtiyaç duyduğu alt kümeyi içerir: varint, zigzag, fixed32/64,
+// uzunluk önekli alanlar ve packed tekrarlı alanlar. Elle kullanımı da
+// mümkündür:
+//
+//	b := protowire.AppendString(nil, 1, "hello")
+//	b = protowire.AppendVarint(b, 2, 42)
+//
+//	d := protowire.NewDecoder(b)
+//	for d.Next() {
+//	    switch d.Field() {
+//	    case 1:
+//	        title = d.String()
+//	    case 2:
+//	        count = int64(d.Varint())
+//	    }
+//	}
+//	if err := d.Err(); err != nil { ... }
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package protowire
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Number, alan numarasıdır.
+type Number int32
+
+// Type, alanın kodlama tipidir.
+type Type int8
+
+const (
+	VarintType  Type = 0
+	Fixed64Type Type = 1
+	BytesType   Type = 2
+	Fixed32Type Type = 5
+)
+
+// ErrMalformed, çözülemeyen ikili veride döner.
+var ErrMalformed = errors.New("protowire: malformed message")
+
+// Marshaler, üretilen mesajların kodlama metodudur.
+type Marshaler interface {
+	AppendProto(b []byte) []byte
+}
+
+// ============================================================================
+// Kodlama
+// ============================================================================
+
+// AppendTag, alan numarası ve tipini yazar.
+func AppendTag(b []byte, num Number, typ Type) []byte {
+	return AppendRawVarint(b, uint64(num)<<3|uint64(typ))
+}
+
+// AppendRawVarint, etiketsiz bir varint yazar (packed alanlar için).
+func AppendRawVarint(b []byte, v uint64) []byte {
+	return binary.AppendUvarint(b, v)
+}
+
+// AppendRawFixed32, etiketsiz 4 baytlık bir değer yazar.
+func AppendRawFixed32(b []byte, v uint32) []byte {
+	return binary.LittleEndian.AppendUint32(b, v)
+}
+
+// AppendRawFixed64, etiketsiz 8 baytlık bir değer yazar.
+func AppendRawFixed64(b []byte, v uint64) []byte {
+	return binary.LittleEndian.AppendUint64(b, v)
+}
+
+// AppendVarint, varint alanı yazar (int32, int64, uint32, uint64, bool, enum).
+func AppendVarint(b []byte, num Number, v uint64) []byte {
+	return AppendRawVarint(AppendTag(b, num, VarintType), v)
+}
+
+// AppendFixed32, fixed32 alanı yazar (fixed32, sfixed32, float).
+func AppendFixed32(b []byte, num Number, v uint32) []byte {
+	return AppendRawFixed32(AppendTag(b, num, Fixed32Type), v)
+}
+
+// AppendFixed64, fixed64 alanı yazar (fixed64, sfixed64, double).
+func AppendFixed64(b []byte, num Number, v uint64) []byte {
+	return AppendRawFixed64(AppendTag(b, num, Fixed64Type), v)
+}
+
+// AppendFloat, float alanı yazar.
+func AppendFloat(b []byte, num Number, v float32) []byte {
+	return AppendFixed32(b, num, math.Float32bits(v))
+}
+
+// AppendDouble, double alanı yazar.
+func AppendDouble(b []byte, num Number, v float64) []byte {
+	return AppendFixed64(b, num, math.Float64bits(v))
+}
+
+// AppendBytes, uzunluk önekli bir alan yazar.
+func AppendBytes(b []byte, num Number, v []byte) []byte {
+	b = AppendRawVarint(AppendTag(b, num, BytesType), uint64(len(v)))
+	return append(b, v...)
+}
+
+// AppendString, string alanı yazar.
+func AppendString(b []byte, num Number, v string) []byte {
+	b = AppendRawVarint(AppendTag(b, num, BytesType), uint64(len(v)))
+	return append(b, v...)
+}
+
+// AppendMessage, gömülü mesaj alanı yazar.
+func AppendMessage(b []byte, num Number, m Marshaler) []byte {
+	return AppendBytes(b, num, m.AppendProto(nil))
+}
+
+// AppendPacked, fill'in yazdığı değerleri tek bir packed alan olarak yazar.
+// fill hiçbir şey yazmazsa alan atlanır.
+func AppendPacked(b []byte, num Number, fill func(p []byte) []byte) []byte {
+	p := fill(nil)
+	if len(p) == 0 {
+		return b
+	}
+	return AppendBytes(b, num, p)
+}
+
+// EncodeBool, bool'un varint değeridir.
+func EncodeBool(v bool) uint64 {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+// ZigZag32, sint32 kodlamasıdır.
+func ZigZag32(v int32) uint64 { return uint64(uint32(v<<1) ^ uint32(v>>31)) }
+
+// ZigZag64, sint64 kodlamasıdır.
+func ZigZag64(v int64) uint64 { return uint64(v<<1) ^ uint64(v>>63) }
+
+// UnZigZag32, ZigZag32'nin tersidir.
+func UnZigZag32(v uint64) int32 { return int32(uint32(v)>>1) ^ -int32(v&1) }
+
+// UnZigZag64, ZigZag64'ün tersidir.
+func UnZigZag64(v uint64) int64 { return int64(v>>1) ^ -int64(v&1) }
+
+// ============================================================================
+// Çözme
+// ============================================================================
+
+// Decoder, bir mesajın alanlarını sırayla okur. Next ile alana geçilir,
+// alanın tipine uygun metodla değer okunur; okunmayan alanlar bir sonraki
+// Next'te atlanır (bilinmeyen alanlar bu sayede yok sayılır). İlk hata
+// saklanır ve Err ile döner; hatadan sonra Next false döner.
+type Decoder struct {
+	b        []byte
+	num      Number
+	typ      Type
+	consumed bool
+	err      error
+}
+
+// NewDecoder, b'yi okuyan bir Decoder döner.
+func NewDecoder(b []byte) *Decoder {
+	return &Decoder{b: b, consumed: true}
+}
+
+// Next, sıradaki alana geçer; veri bittiğinde ya da hata oluştuğunda false
+// döner.
+func (d *Decoder) Next() bool {
+	if d.err != nil {
+		return false
+	}
+	if !d.consumed {
+		d.Skip()
+		if d.err != nil {
+			return false
+		}
+	}
+	if len(d.b) == 0 {
+		return false
+	}
+	tag, n := binary.Uvarint(d.b)
+	if n <= 0 || tag>>3 == 0 || tag>>3 > math.MaxInt32 {
+		d.fail(fmt.Errorf("%w: invalid field tag", ErrMalformed))
+		return false
+	}
+	d.b = d.b[n:]
+	d.num, d.typ, d.consumed = Number(tag>>3), Type(tag&7), false
+	return true
+}
+
+// Field, geçerli alanın numarasıdır.
+func (d *Decoder) Field() Number { return d.num }
+
+// Varint, geçerli varint alanını okur.
+func (d *Decoder) Varint() uint64 {
+	if !d.expect(VarintType) {
+		return 0
+	}
+	return d.rawVarint()
+}
+
+// Fixed32, geçerli fixed32 alanını okur.
+func (d *Decoder) Fixed32() uint32 {
+	if !d.expect(Fixed32Type) {
+		return 0
+	}
+	return d.rawFixed32()
+}
+
+// Fixed64, geçerli fixed64 alanını okur.
+func (d *Decoder) Fixed64() uint64 {
+	if !d.expect(Fixed64Type) {
+		return 0
+	}
+	return d.rawFixed64()
+}
+
+// Float, geçerli float alanını okur.
+func (d *Decoder) Float() float32 { return math.Float32frombits(d.Fixed32()) }
+
+// Double, geçerli double alanını okur.
+func (d *Decoder) Double() float64 { return math.Float64frombits(d.Fixed64()) }
+
+// Bytes, geçerli uzunluk önekli alanı okur. Dönen dilim girdinin
+// parçasıdır; saklanacaksa kopyalanmalıdır.
+func (d *Decoder) Bytes() []byte {
+	if !d.expect(BytesType) {
+		return nil
+	}
+	return d.rawBytes()
+}
+
+// String, geçerli string alanını okur.
+func (d *Decoder) String() string { return string(d.Bytes()) }
+
+// Varints, geçerli tekrarlı varint alanını packed ya da tek tek kodlanmış
+// olmasından bağımsız okur.
+func (d *Decoder) Varints(fn func(uint64)) {
+	d.repeated(VarintType, func(sub *Decoder) { fn(sub.rawVarint()) })
+}
+
+// Fixed32s, Varints'in fixed32 karşılığıdır.
+func (d *Decoder) Fixed32s(fn func(uint32)) {
+	d.repeated(Fixed32Type, func(sub *Decoder) { fn(sub.rawFixed32()) })
+}
+
+// Fixed64s, Varints'in fixed64 karşılığıdır.
+func (d *Decoder) Fixed64s(fn func(uint64)) {
+	d.repeated(Fixed64Type, func(sub *Decoder) { fn(sub.rawFixed64()) })
+}
+
+// Skip, geçerli alanı okumadan atlar.
+func (d *Decoder) Skip() {
+	if d.consumed || d.err != nil {
+		return
+	}
+	d.consumed = true
+	switch d.typ {
+	case VarintType:
+		d.rawVarint()
+	case Fixed32Type:
+		d.rawFixed32()
+	case Fixed64Type:
+		d.rawFixed64()
+	case BytesType:
+		d.rawBytes()
+	default:
+		d.fail(fmt.Errorf("%w: unsupported wire type %d", ErrMalformed, d.typ))
+	}
+}
+
+// Fail, çözmeyi err ile sonlandırır (ör. gömülü mesaj çözülemedi).
+func (d *Decoder) Fail(err error) {
+	if err != nil {
+		d.fail(err)
+	}
+}
+
+// Err, ilk hatayı döner.
+func (d *Decoder) Err() error { return d.err }
+
+func (d *Decoder) fail(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+	d.consumed = true
+}
+
+// expect, geçerli alanın tipini doğrular ve alanı okunmuş sayar.
+func (d *Decoder) expect(typ Type) bool {
+	if d.err != nil || d.consumed {
+		return false
+	}
+	if d.typ != typ {
+		d.fail(fmt.Errorf("%w: field %d has wire type %d, want %d", ErrMalformed, d.num, d.typ, typ))
+		return false
+	}
+	d.consumed = true
+	return true
+}
+
+func (d *Decoder) repeated(typ Type, read func(sub *Decoder)) {
+	if d.err != nil || d.consumed {
+		return
+	}
+	if d.typ != BytesType {
+		if d.expect(typ) {
+			read(d)
+		}
+		return
+	}
+	d.consumed = true
+	sub := &Decoder{b: d.rawBytes()}
+	for d.err == nil && sub.err == nil && len(sub.b) > 0 {
+		read(sub)
+	}
+	d.Fail(sub.err)
+}
+
+func (d *Decoder) rawVarint() uint64 {
+	v, n := binary.Uvarint(d.b)
+	if n <= 0 {
+		d.fail(fmt.Errorf("%w: truncated varint", ErrMalformed))
+		return 0
+	}
+	d.b = d.b[n:]
+	return v
+}
+
+func (d *Decoder) rawFixed32() uint32 {
+	if len(d.b) < 4 {
+		d.fail(fmt.Errorf("%w: truncated fixed32", ErrMalformed))
+		return 0
+	}
+	v := binary.LittleEndian.Uint32(d.b)
+	d.b = d.b[4:]
+	return v
+}
+
+func (d *Decoder) rawFixed64() uint64 {
+	if len(d.b) < 8 {
+		d.fail(fmt.Errorf("%w: truncated fixed64", ErrMalformed))
+		return 0
+	}
+	v := binary.LittleEndian.Uint64(d.b)
+	d.b = d.b[8:]
+	return v
+}
+
+func (d *Decoder) rawBytes() []byte {
+	n := d.rawVarint()
+	if d.err != nil {
+		return nil
+	}
+	if n > uint64(len(d.b)) {
+		d.fail(fmt.Errorf("%w: truncated length-delimited field", ErrMalformed))
+		return nil
+	}
+	v := d.b[:n:n]
+	d.b = d.b[n:]
+	return v
+}
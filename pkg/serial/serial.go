@@ -0,0 +1,147 @@
+// Package serial, seri portları listeler, açar ve okuyup yazar.
+//
+// Aygıt yapılandırma araçları, mikrodenetleyici konsolları ve laboratuvar
+// cihazları seri port üzerinden konuşur; WebView'de Web Serial API yoktur.
+// Port açıldıktan sonra io.ReadWriteCloser gibi kullanılır:
+//
+//	ports, _ := serial.Ports()
+//	port, err := serial.Open(ports[0].Name, serial.Config{BaudRate: 115200})
+//	if err != nil {
+//	    return err
+//	}
+//	defer port.Close()
+//	port.Write([]byte("AT\r\n"))
+//	n, err := port.Read(buf)
+//
+// Portlar ham modda (satır düzenleme ve karakter dönüşümü olmadan), akış
+// kontrolü kapalı açılır. Close, bekleyen Read'i sonlandırır; Read ve Write
+// farklı goroutine'lerden aynı anda çağrılabilir.
+//
+// Platform desteği:
+//   - Windows: COM portları (registry'den listelenir, \\.\COMn ile açılır).
+//   - Linux: /sys/class/tty altındaki gerçek portlar (ttyUSB, ttyACM, ttyS);
+//     USB portlarda üretici ve ürün kimlikleri de verilir. Kullanıcının
+//     genellikle "dialout" grubunda olması gerekir.
+//   - macOS: /dev/cu.* portları.
+//   - Diğer: ErrNotSupported.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package serial
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// ErrNotSupported, platform seri portları desteklemiyorsa döner.
+var ErrNotSupported = gomerrors.ErrNotSupported
+
+// ErrInvalidConfig, port ayarları geçersizse ya da platform verilen hızı
+// desteklemiyorsa döner.
+var ErrInvalidConfig = errors.New("invalid serial port configuration")
+
+// Parity, parite bitidir.
+type Parity string
+
+const (
+	ParityNone Parity = "none"
+	ParityOdd  Parity = "odd"
+	ParityEven Parity = "even"
+)
+
+// PortInfo, listelenen bir seri porttur.
+type PortInfo struct {
+	// Name, Open'a verilen addır: "COM3", "/dev/ttyUSB0", "/dev/cu.usbserial-1410".
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// VendorID ve ProductID, USB portlarda onaltılık kimliklerdir ("0403").
+	VendorID     string `json:"vendorId,omitempty"`
+	ProductID    string `json:"productId,omitempty"`
+	Manufacturer string `json:"manufacturer,omitempty"`
+	SerialNumber string `json:"serialNumber,omitempty"`
+}
+
+// Config, port ayarlarıdır. Sıfır değerler varsayılanlarla doldurulur:
+// 9600 baud, 8 veri biti, parite yok, 1 durma biti (9600 8N1).
+type Config struct {
+	BaudRate int    `json:"baudRate,omitempty"`
+	DataBits int    `json:"dataBits,omitempty"` // 5-8
+	Parity   Parity `json:"parity,omitempty"`
+	StopBits int    `json:"stopBits,omitempty"` // 1 ya da 2
+}
+
+// normalize, varsayılanları doldurur ve ayarları doğrular.
+func (c Config) normalize() (Config, error) {
+	if c.BaudRate == 0 {
+		c.BaudRate = 9600
+	}
+	if c.DataBits == 0 {
+		c.DataBits = 8
+	}
+	if c.Parity == "" {
+		c.Parity = ParityNone
+	}
+	if c.StopBits == 0 {
+		c.StopBits = 1
+	}
+	switch {
+	case c.BaudRate < 0:
+		return c, fmt.Errorf("%w: baud rate %d", ErrInvalidConfig, c.BaudRate)
+	case c.DataBits < 5 || c.DataBits > 8:
+		return c, fmt.Errorf("%w: %d data bits", ErrInvalidConfig, c.DataBits)
+	case c.StopBits != 1 && c.StopBits != 2:
+		return c, fmt.Errorf("%w: %d stop bits", ErrInvalidConfig, c.StopBits)
+	}
+	switch c.Parity {
+	case ParityNone, ParityOdd, ParityEven:
+	default:
+		return c, fmt.Errorf("%w: parity %q", ErrInvalidConfig, c.Parity)
+	}
+	return c, nil
+}
+
+// Port, açık bir seri porttur.
+type Port struct {
+	name   string
+	config Config
+	conn   io.ReadWriteCloser
+}
+
+// Ports, sistemdeki seri portları döner.
+func Ports() ([]PortInfo, error) {
+	return listPorts()
+}
+
+// Open, portu verilen ayarlarla özel erişimle açar.
+func Open(name string, cfg Config) (*Port, error) {
+	cfg, err := cfg.normalize()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := openPort(name, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Port{name: name, config: cfg, conn: conn}, nil
+}
+
+// Name, portun adını döner.
+func (p *Port) Name() string { return p.name }
+
+// Config, portun (varsayılanlarla doldurulmuş) ayarlarını döner.
+func (p *Port) Config() Config { return p.config }
+
+// Read, gelen baytları okur; veri gelene ya da port kapanana kadar bloklar.
+func (p *Port) Read(b []byte) (int, error) { return p.conn.Read(b) }
+
+// Write, baytları porta yazar.
+func (p *Port) Write(b []byte) (int, error) { return p.conn.Write(b) }
+
+// Close, portu kapatır; bekleyen Read hata ile döner.
+func (p *Port) Close() error { return p.conn.Close() }
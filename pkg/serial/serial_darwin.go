@@ -0,0 +1,53 @@
+//go:build darwin
+
+package serial
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+const (
+	ioctlGetTermios = syscall.TIOCGETA
+	ioctlSetTermios = syscall.TIOCSETA
+
+	// sys/termios.h: CCTS_OFLOW | CRTS_IFLOW; syscall paketinde tanımlı değil
+	crtscts = 0x00030000
+
+	// sys/fcntl.h
+	fread  = 0x0001
+	fwrite = 0x0002
+)
+
+// macOS termios hızı doğrudan sayı olarak tutar; sürücü desteklemiyorsa
+// TIOCSETA hata döner.
+func setSpeed(t *syscall.Termios, baud int) error {
+	if baud <= 0 {
+		return fmt.Errorf("%w: unsupported baud rate %d", ErrInvalidConfig, baud)
+	}
+	t.Ispeed, t.Ospeed = uint64(baud), uint64(baud)
+	return nil
+}
+
+// flush, giriş ve çıkış tamponlarını temizler (TIOCFLUSH, FREAD|FWRITE).
+func flush(fd uintptr) error {
+	which := int32(fread | fwrite)
+	return ioctl(fd, syscall.TIOCFLUSH, uintptr(unsafe.Pointer(&which)))
+}
+
+// listPorts, arama yapan (callout) cu.* aygıtlarını döner; tty.* eşleri
+// taşıyıcı sinyali bekleyerek açıldığı için listelenmez.
+func listPorts() ([]PortInfo, error) {
+	paths, _ := filepath.Glob("/dev/cu.*")
+	ports := make([]PortInfo, 0, len(paths))
+	for _, path := range paths {
+		ports = append(ports, PortInfo{
+			Name:        path,
+			Description: strings.TrimPrefix(path, "/dev/cu."),
+		})
+	}
+	return ports, nil
+}
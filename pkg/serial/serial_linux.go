@@ -0,0 +1,105 @@
+//go:build linux
+
+package serial
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+const (
+	ioctlGetTermios = syscall.TCGETS
+	ioctlSetTermios = syscall.TCSETS
+
+	// linux/termbits.h; syscall paketinde tanımlı değiller
+	cbaud   = 0o010017
+	crtscts = 0x80000000
+	tcflsh  = 0x540B
+)
+
+// baudRates, termios'un desteklediği standart hızlardır.
+var baudRates = map[int]uint32{
+	50: syscall.B50, 75: syscall.B75, 110: syscall.B110, 134: syscall.B134,
+	150: syscall.B150, 200: syscall.B200, 300: syscall.B300, 600: syscall.B600,
+	1200: syscall.B1200, 1800: syscall.B1800, 2400: syscall.B2400, 4800: syscall.B4800,
+	9600: syscall.B9600, 19200: syscall.B19200, 38400: syscall.B38400, 57600: syscall.B57600,
+	115200: syscall.B115200, 230400: syscall.B230400, 460800: syscall.B460800,
+	500000: syscall.B500000, 576000: syscall.B576000, 921600: syscall.B921600,
+	1000000: syscall.B1000000, 1152000: syscall.B1152000, 1500000: syscall.B1500000,
+	2000000: syscall.B2000000, 2500000: syscall.B2500000, 3000000: syscall.B3000000,
+	3500000: syscall.B3500000, 4000000: syscall.B4000000,
+}
+
+func setSpeed(t *syscall.Termios, baud int) error {
+	speed, ok := baudRates[baud]
+	if !ok {
+		return fmt.Errorf("%w: unsupported baud rate %d", ErrInvalidConfig, baud)
+	}
+	t.Cflag = t.Cflag&^cbaud | speed
+	t.Ispeed, t.Ospeed = speed, speed
+	return nil
+}
+
+// flush, giriş ve çıkış tamponlarını temizler (tcflush TCIOFLUSH).
+func flush(fd uintptr) error {
+	return ioctl(fd, tcflsh, syscall.TCIOFLUSH)
+}
+
+func listPorts() ([]PortInfo, error) {
+	entries, err := os.ReadDir("/sys/class/tty")
+	if err != nil {
+		return nil, gomerrors.NewDeviceError("serial", "list ports", err)
+	}
+	ports := []PortInfo{}
+	for _, e := range entries {
+		dir := filepath.Join("/sys/class/tty", e.Name())
+		// Sanal terminallerin (tty1, pts) aygıt bağlantısı yoktur
+		device, err := filepath.EvalSymlinks(dir + "/device")
+		if err != nil {
+			continue
+		}
+		driver, _ := os.Readlink(device + "/driver")
+		// 8250 sürücüsü donanımı olmayan ttyS portları da kaydeder; tipi
+		// PORT_UNKNOWN (0) olanlar atlanır
+		if filepath.Base(driver) == "serial8250" && readSysfs(dir+"/type") == "0" {
+			continue
+		}
+		info := PortInfo{Name: "/dev/" + e.Name()}
+		usbInfo(device, &info)
+		ports = append(ports, info)
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i].Name < ports[j].Name })
+	return ports, nil
+}
+
+// usbInfo, portun üst USB aygıtını bulup kimliklerini okur. USB-seri
+// dönüştürücülerde tty aygıtı USB arayüzünün, arayüz de aygıtın altındadır.
+func usbInfo(device string, info *PortInfo) {
+	dir := device
+	for i := 0; i < 4 && dir != "/"; i++ {
+		if vendor := readSysfs(dir + "/idVendor"); vendor != "" {
+			info.VendorID = vendor
+			info.ProductID = readSysfs(dir + "/idProduct")
+			info.Manufacturer = readSysfs(dir + "/manufacturer")
+			info.Description = readSysfs(dir + "/product")
+			info.SerialNumber = readSysfs(dir + "/serial")
+			return
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+// readSysfs, bir sysfs dosyasını boşlukları kırparak okur.
+func readSysfs(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
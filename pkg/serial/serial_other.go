@@ -0,0 +1,17 @@
+//go:build !windows && !linux && !darwin
+
+package serial
+
+import (
+	"io"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+func listPorts() ([]PortInfo, error) {
+	return nil, gomerrors.NewDeviceError("serial", "list ports", ErrNotSupported)
+}
+
+func openPort(name string, cfg Config) (io.ReadWriteCloser, error) {
+	return nil, gomerrors.NewDeviceError("serial", "open "+name, ErrNotSupported)
+}
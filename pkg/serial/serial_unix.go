@@ -0,0 +1,96 @@
+//go:build linux || darwin
+
+package serial
+
+import (
+	"io"
+	"os"
+	"syscall"
+	"unsafe"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// openPort, portu bloklamayan modda açar (okuma Go'nun poller'ı üzerinden
+// bekler, Close bekleyen okumayı sonlandırır) ve termios ile yapılandırır.
+func openPort(name string, cfg Config) (io.ReadWriteCloser, error) {
+	f, err := os.OpenFile(name, os.O_RDWR|syscall.O_NOCTTY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return nil, gomerrors.NewDeviceError("serial", "open "+name, err)
+	}
+	rc, err := f.SyscallConn()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	var cerr error
+	// f.Fd() dosyayı bloklayan moda alacağı için ioctl'ler Control içinde yapılır
+	if err := rc.Control(func(fd uintptr) { cerr = configure(fd, cfg) }); err != nil {
+		cerr = err
+	}
+	if cerr != nil {
+		f.Close()
+		return nil, gomerrors.NewDeviceError("serial", "configure "+name, cerr)
+	}
+	return f, nil
+}
+
+// configure, portu ham moda alır ve hız, veri biti, parite ve durma bitini
+// ayarlar; ardından tamponları temizler.
+func configure(fd uintptr, cfg Config) error {
+	var t syscall.Termios
+	if err := ioctl(fd, ioctlGetTermios, uintptr(unsafe.Pointer(&t))); err != nil {
+		return err
+	}
+
+	// cfmakeraw
+	t.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP |
+		syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON | syscall.IXOFF | syscall.IXANY
+	t.Oflag &^= syscall.OPOST
+	t.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	t.Cflag &^= syscall.CSIZE | syscall.PARENB | syscall.PARODD | syscall.CSTOPB | crtscts
+	t.Cflag |= syscall.CREAD | syscall.CLOCAL
+	t.Cc[syscall.VMIN], t.Cc[syscall.VTIME] = 1, 0
+
+	switch cfg.DataBits {
+	case 5:
+		t.Cflag |= syscall.CS5
+	case 6:
+		t.Cflag |= syscall.CS6
+	case 7:
+		t.Cflag |= syscall.CS7
+	default:
+		t.Cflag |= syscall.CS8
+	}
+	switch cfg.Parity {
+	case ParityOdd:
+		t.Cflag |= syscall.PARENB | syscall.PARODD
+		t.Iflag |= syscall.INPCK
+	case ParityEven:
+		t.Cflag |= syscall.PARENB
+		t.Iflag |= syscall.INPCK
+	}
+	if cfg.StopBits == 2 {
+		t.Cflag |= syscall.CSTOPB
+	}
+	if err := setSpeed(&t, cfg.BaudRate); err != nil {
+		return err
+	}
+
+	if err := ioctl(fd, ioctlSetTermios, uintptr(unsafe.Pointer(&t))); err != nil {
+		return err
+	}
+	// Başka süreçlerin aynı portu açmasını engelle
+	if err := ioctl(fd, syscall.TIOCEXCL, 0); err != nil {
+		return err
+	}
+	return flush(fd)
+}
+
+// ioctl, başarısız olursa errno döner.
+func ioctl(fd, req, arg uintptr) error {
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, req, arg); errno != 0 {
+		return errno
+	}
+	return nil
+}
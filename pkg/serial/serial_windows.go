@@ -0,0 +1,88 @@
+//go:build windows
+
+package serial
+
+import (
+	"io"
+	"os"
+	"sync/atomic"
+	"syscall"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/platform/windows"
+)
+
+func listPorts() ([]PortInfo, error) {
+	found := windows.SerialPorts()
+	ports := make([]PortInfo, 0, len(found))
+	for _, p := range found {
+		ports = append(ports, PortInfo{Name: p.Name, Description: p.Device})
+	}
+	return ports, nil
+}
+
+func openPort(name string, cfg Config) (io.ReadWriteCloser, error) {
+	h, err := windows.OpenSerial(name)
+	if err != nil {
+		return nil, gomerrors.NewDeviceError("serial", "open "+name, err)
+	}
+	parity := byte(windows.NOPARITY)
+	switch cfg.Parity {
+	case ParityOdd:
+		parity = windows.ODDPARITY
+	case ParityEven:
+		parity = windows.EVENPARITY
+	}
+	stopBits := byte(windows.ONESTOPBIT)
+	if cfg.StopBits == 2 {
+		stopBits = windows.TWOSTOPBITS
+	}
+	if err := windows.ConfigureSerial(h, uint32(cfg.BaudRate), byte(cfg.DataBits), parity, stopBits); err != nil {
+		syscall.CloseHandle(h)
+		return nil, gomerrors.NewDeviceError("serial", "configure "+name, err)
+	}
+	return &comPort{h: h}, nil
+}
+
+// comPort, açık bir COM portudur. Okumalar kısa zaman aşımıyla döner (bkz.
+// windows.SerialReadTimeout); Read veri gelene kadar yeniden dener.
+type comPort struct {
+	h      syscall.Handle
+	closed atomic.Bool
+}
+
+func (p *comPort) Read(b []byte) (int, error) {
+	for {
+		if p.closed.Load() {
+			return 0, os.ErrClosed
+		}
+		var n uint32
+		if err := syscall.ReadFile(p.h, b, &n, nil); err != nil {
+			if p.closed.Load() {
+				return 0, os.ErrClosed
+			}
+			return 0, err
+		}
+		if n > 0 {
+			return int(n), nil
+		}
+	}
+}
+
+func (p *comPort) Write(b []byte) (int, error) {
+	if p.closed.Load() {
+		return 0, os.ErrClosed
+	}
+	var n uint32
+	err := syscall.WriteFile(p.h, b, &n, nil)
+	return int(n), err
+}
+
+// Close, bekleyen okumayı iptal eder ve tanıtıcıyı kapatır.
+func (p *comPort) Close() error {
+	if p.closed.Swap(true) {
+		return nil
+	}
+	syscall.CancelIoEx(p.h, nil)
+	return syscall.CloseHandle(p.h)
+}
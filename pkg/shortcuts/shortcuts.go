@@ -0,0 +1,156 @@
+// Package shortcuts, sistem genelinde (global) klavye kısayolları sunar.
+//
+// Menü kısayollarından farklı olarak global kısayollar uygulama odakta
+// değilken, hatta penceresi gizliyken de çalışır. Hızlı not alma penceresi,
+// "uygulamayı öne getir" gibi senaryolar için kullanılır.
+//
+// Örnek:
+//
+//	err := shortcuts.Register("Ctrl+Shift+Space", func() {
+//	    app.Show()
+//	})
+//	if errors.Is(err, shortcuts.ErrInUse) {
+//	    // Kısayol başka bir uygulamaya ait; kullanıcıya farklı bir kısayol önerin
+//	}
+//	defer shortcuts.UnregisterAll()
+//
+// Kısayol sözdizimi menü kısayollarıyla aynıdır: Ctrl, Alt, Shift,
+// Cmd/Super ve CmdOrCtrl modifier'ları ile A-Z, 0-9, F1-F24, Space, Enter vb.
+// Callback'ler ayrı bir goroutine'de çağrılır.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package shortcuts
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+	"github.com/biyonik/gomad/internal/platform"
+)
+
+var (
+	// ErrAlreadyRegistered, kısayol bu uygulama tarafından zaten kaydedilmişse döner.
+	ErrAlreadyRegistered = errors.New("shortcut already registered")
+
+	// ErrInUse, kısayol başka bir uygulama veya sistem tarafından kullanılıyorsa döner.
+	ErrInUse = errors.New("shortcut is in use by another application")
+
+	// ErrNotRegistered, kaydedilmemiş bir kısayol kaldırılmak istendiğinde döner.
+	ErrNotRegistered = errors.New("shortcut not registered")
+
+	// ErrNotSupported, platform global kısayolları desteklemiyorsa döner.
+	ErrNotSupported = gomerrors.ErrNotSupported
+)
+
+// entry, kayıtlı bir kısayoldur.
+type entry struct {
+	accel platform.Accelerator
+	fn    func()
+	id    int32 // platform kayıt kimliği
+}
+
+var (
+	registry = make(map[string]*entry) // combo → entry
+	mu       sync.Mutex
+)
+
+// Register, global bir kısayol kaydeder. fn, kısayol her basıldığında
+// ayrı bir goroutine'de çağrılır; basılı tutmak tekrar tetiklemez.
+func Register(accelerator string, fn func()) error {
+	if fn == nil {
+		return fmt.Errorf("shortcut %q: callback is nil", accelerator)
+	}
+	accel, err := platform.ParseAccelerator(accelerator)
+	if err != nil {
+		return err
+	}
+	if !accel.Ctrl && !accel.Alt && !accel.Meta && !isFunctionKey(accel.Key) {
+		// Modifier'sız global kısayol, o tuşu tüm sistemde kullanılamaz hale getirir
+		return fmt.Errorf("shortcut %q: needs Ctrl, Alt or Cmd unless it is a function key", accelerator)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	combo := accel.Combo()
+	if _, exists := registry[combo]; exists {
+		return fmt.Errorf("shortcut %q: %w", accelerator, ErrAlreadyRegistered)
+	}
+
+	e := &entry{accel: accel, fn: fn}
+	if err := register(e); err != nil {
+		return fmt.Errorf("shortcut %q: %w", accelerator, err)
+	}
+	registry[combo] = e
+	return nil
+}
+
+// Unregister, kısayolu kaldırır.
+func Unregister(accelerator string) error {
+	accel, err := platform.ParseAccelerator(accelerator)
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	combo := accel.Combo()
+	e, ok := registry[combo]
+	if !ok {
+		return fmt.Errorf("shortcut %q: %w", accelerator, ErrNotRegistered)
+	}
+	delete(registry, combo)
+	return unregister(e)
+}
+
+// UnregisterAll, bu uygulamanın tüm global kısayollarını kaldırır.
+func UnregisterAll() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	for combo, e := range registry {
+		_ = unregister(e)
+		delete(registry, combo)
+	}
+}
+
+// IsRegistered, kısayolun bu uygulama tarafından kayıtlı olup olmadığını döner.
+func IsRegistered(accelerator string) bool {
+	accel, err := platform.ParseAccelerator(accelerator)
+	if err != nil {
+		return false
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	_, ok := registry[accel.Combo()]
+	return ok
+}
+
+// trigger, platform katmanından gelen kısayol basımını callback'e iletir.
+// Platform katmanı tarafından ayrı bir goroutine'de çağrılır.
+func trigger(id int32) {
+	mu.Lock()
+	var fn func()
+	for _, e := range registry {
+		if e.id == id {
+			fn = e.fn
+			break
+		}
+	}
+	mu.Unlock()
+
+	if fn != nil {
+		fn()
+	}
+}
+
+func isFunctionKey(key string) bool {
+	return len(key) >= 2 && key[0] == 'f'
+}
@@ -0,0 +1,16 @@
+//go:build !windows
+
+package shortcuts
+
+import (
+	gomerrors "github.com/biyonik/gomad/internal/errors"
+)
+
+// register, global kısayol desteği henüz olmayan platformlarda ErrNotSupported döner.
+func register(e *entry) error {
+	return gomerrors.NewOperationError("shortcuts.register", "global shortcuts", ErrNotSupported)
+}
+
+func unregister(e *entry) error {
+	return nil
+}
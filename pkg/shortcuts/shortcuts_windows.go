@@ -0,0 +1,75 @@
+//go:build windows
+
+package shortcuts
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"syscall"
+
+	"github.com/biyonik/gomad/internal/platform/windows"
+)
+
+// Kısayollar, WebView'in UI thread'inden bağımsız bir mesaj thread'inde
+// kaydedilir; böylece uygulama Run'dan önce veya sonra da kullanılabilir.
+var (
+	thread     *windows.MessageThread
+	threadErr  error
+	threadOnce sync.Once
+	nextID     int32
+)
+
+func hotkeyThread() (*windows.MessageThread, error) {
+	threadOnce.Do(func() {
+		thread, threadErr = windows.NewMessageThread("GomadHotkeys",
+			func(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) (uintptr, bool) {
+				if msg == windows.WM_HOTKEY {
+					// mu, Register sırasında bu thread beklenirken tutuluyor olabilir
+					go trigger(int32(wParam))
+					return 0, true
+				}
+				return 0, false
+			})
+	})
+	return thread, threadErr
+}
+
+// register, RegisterHotKey ile kısayolu kaydeder. mu tutulurken çağrılır.
+func register(e *entry) error {
+	mods, vk, ok := windows.HotkeyCode(e.accel)
+	if !ok {
+		return fmt.Errorf("key %q has no virtual key code", e.accel.Key)
+	}
+
+	t, err := hotkeyThread()
+	if err != nil {
+		return err
+	}
+
+	nextID++
+	id := nextID
+	t.Do(func() {
+		err = windows.RegisterHotKey(t.Handle(), id, mods, vk)
+	})
+	if err != nil {
+		if errors.Is(err, windows.ERROR_HOTKEY_ALREADY_REGISTERED) {
+			return ErrInUse
+		}
+		return err
+	}
+	e.id = id
+	return nil
+}
+
+// unregister, UnregisterHotKey ile kısayolu kaldırır. mu tutulurken çağrılır.
+func unregister(e *entry) error {
+	t, err := hotkeyThread()
+	if err != nil {
+		return err
+	}
+	t.Do(func() {
+		err = windows.UnregisterHotKey(t.Handle(), e.id)
+	})
+	return err
+}
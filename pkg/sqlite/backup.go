@@ -0,0 +1,73 @@
+package sqlite
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/biyonik/gomad/pkg/gomad"
+)
+
+// stagedSuffix, geri yüklenen ve sonraki Open'da uygulanacak veritabanının
+// dosya sonekidir. Açık bir SQLite dosyası yerinde değiştirilemez.
+const stagedSuffix = ".import"
+
+// exportTo, veritabanının tutarlı bir kopyasını (VACUUM INTO) w'ya yazar;
+// eşzamanlı yazmalar kopyayı bozmaz.
+func (db *DB) exportTo(w io.Writer) error {
+	tmp := db.Path + ".export"
+	os.Remove(tmp)
+	defer os.Remove(tmp)
+	if _, err := db.DB.Exec("VACUUM INTO ?", tmp); err != nil {
+		return fmt.Errorf("sqlite: backup %s: %w", db.Path, err)
+	}
+	f, err := os.Open(tmp)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// stageImport, yedekteki veritabanını yanına yazar; Open bir sonraki
+// başlangıçta onu asıl dosyanın yerine koyar.
+func (db *DB) stageImport(r io.Reader) error {
+	staged := db.Path + stagedSuffix
+	f, err := os.Create(staged + ".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, r)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(staged+".tmp", staged)
+	}
+	if err != nil {
+		os.Remove(staged + ".tmp")
+	}
+	return err
+}
+
+// applyStagedImport, bekleyen bir geri yükleme varsa veritabanını onunla
+// değiştirir. Eski dosyanın WAL ve paylaşımlı bellek dosyaları silinir;
+// aksi halde SQLite onları yeni dosyaya uygulamaya çalışır.
+func applyStagedImport(app *gomad.Application, path string) error {
+	staged := path + stagedSuffix
+	if _, err := os.Stat(staged); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	for _, suffix := range []string{"-wal", "-shm", "-journal"} {
+		if err := os.Remove(path + suffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("sqlite: restore %s: %w", path, err)
+		}
+	}
+	if err := os.Rename(staged, path); err != nil {
+		return fmt.Errorf("sqlite: restore %s: %w", path, err)
+	}
+	app.Logger().Info("sqlite database restored from backup", "path", path)
+	return nil
+}
@@ -0,0 +1,83 @@
+package sqlite
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"time"
+)
+
+// migrationsTable, uygulanmış migration'ların kaydını tutar.
+const migrationsTable = "gomad_migrations"
+
+// migrate, fsys içindeki henüz uygulanmamış *.sql dosyalarını ad sırasıyla
+// çalıştırır ve uygulananların adlarını döner. Bir migration başarısız olursa
+// transaction'ı geri alınır ve sonraki migration'lar çalıştırılmaz.
+func (db *DB) migrate(fsys fs.FS) ([]string, error) {
+	if _, err := db.DB.Exec(`CREATE TABLE IF NOT EXISTS ` + migrationsTable + ` (
+		name TEXT PRIMARY KEY,
+		applied_at TEXT NOT NULL
+	)`); err != nil {
+		return nil, fmt.Errorf("sqlite: create %s: %w", migrationsTable, err)
+	}
+
+	files, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: list migrations: %w", err)
+	}
+	sort.Strings(files)
+
+	done := map[string]bool{}
+	rows, err := db.DB.Query(`SELECT name FROM ` + migrationsTable)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: read %s: %w", migrationsTable, err)
+	}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		done[name] = true
+	}
+	rows.Close()
+
+	var applied []string
+	for _, file := range files {
+		name := path.Base(file)
+		if done[name] {
+			continue
+		}
+		body, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return applied, fmt.Errorf("sqlite: migration %s: %w", name, err)
+		}
+		if err := db.apply(name, string(body)); err != nil {
+			return applied, err
+		}
+		applied = append(applied, name)
+	}
+	return applied, nil
+}
+
+// apply, tek bir migration'ı kaydıyla birlikte aynı transaction'da çalıştırır.
+func (db *DB) apply(name, body string) error {
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("sqlite: migration %s: %w", name, err)
+	}
+	if _, err := tx.Exec(body); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("sqlite: migration %s: %w", name, err)
+	}
+	if _, err := tx.Exec(`INSERT INTO `+migrationsTable+` (name, applied_at) VALUES (?, ?)`,
+		name, time.Now().UTC().Format(time.RFC3339)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("sqlite: migration %s: %w", name, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("sqlite: migration %s: %w", name, err)
+	}
+	return nil
+}
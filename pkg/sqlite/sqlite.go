@@ -0,0 +1,319 @@
+// Package sqlite, GOMAD uygulamaları için isteğe bağlı SQLite kalıcılık
+// modülüdür: veritabanını uygulamanın veri dizininde açar, gömülü
+// migration'ları çalıştırır ve JS'e parametreli sorgu binding'leri sunar.
+//
+// Paket bir SQLite sürücüsü içermez; database/sql sürücüsü uygulama
+// tarafından blank import ile eklenir (varsayılan sürücü adı "sqlite",
+// modernc.org/sqlite ile uyumludur; mattn/go-sqlite3 için WithDriver("sqlite3")).
+//
+// Örnek:
+//
+//	import _ "modernc.org/sqlite"
+//
+//	//go:embed migrations/*.sql
+//	var migrations embed.FS
+//
+//	db, err := sqlite.Open(app,
+//	    sqlite.WithMigrations(migrations, "migrations"),
+//	    sqlite.WithQueries(map[string]string{
+//	        "notes.list": "SELECT id, title FROM notes ORDER BY id DESC",
+//	        "notes.add":  "INSERT INTO notes (title) VALUES (?)",
+//	    }))
+//	if err != nil { ... }
+//	defer db.Close()
+//
+//	// JS:
+//	// const notes = await window.gomad.call('db.query', 'notes.list', [])
+//	// await window.gomad.call('db.exec', 'notes.add', ['Alışveriş'])
+//
+// JS hiçbir zaman SQL metni göndermez; yalnızca izin listesindeki sorguların
+// adını ve parametrelerini gönderir. Ham SQL yalnızca WithRawQueries ile
+// açıkça izin verildiğinde kabul edilir.
+//
+// Veritabanı app.ExportData yedeklerine otomatik olarak eklenir. ImportData
+// ile geri yüklenen veritabanı açık dosyanın yerine hemen konmaz; sonraki
+// Open'da uygulanır.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/biyonik/gomad/pkg/gomad"
+)
+
+// ErrQueryNotAllowed, JS'ten izin listesinde olmayan bir sorgu istendiğinde döner.
+var ErrQueryNotAllowed = errors.New("query not allowed")
+
+func init() {
+	gomad.RegisterSentinel("sqlite.ErrQueryNotAllowed", ErrQueryNotAllowed)
+}
+
+// Option, Open için fonksiyonel seçenektir.
+type Option func(*config)
+
+type config struct {
+	driver        string
+	file          string
+	namespace     string
+	migrations    fs.FS
+	migrationsErr error
+	queries       map[string]string
+	raw           bool
+	bind          bool
+}
+
+// WithDriver, database/sql sürücü adını ayarlar. Varsayılan: "sqlite"
+func WithDriver(name string) Option {
+	return func(c *config) {
+		c.driver = name
+	}
+}
+
+// WithFile, veritabanı dosyasının adını ayarlar. Göreli adlar uygulamanın
+// UserData dizinine göre çözülür. Varsayılan: "app.db"
+func WithFile(name string) Option {
+	return func(c *config) {
+		c.file = name
+	}
+}
+
+// WithMigrations, fsys içindeki dir dizininde bulunan *.sql dosyalarını
+// migration olarak kullanır. Dosyalar ada göre sıralanarak (ör. 001_init.sql,
+// 002_tags.sql) ve her biri kendi transaction'ında çalıştırılır; uygulananlar
+// gomad_migrations tablosunda tutulur ve bir daha çalıştırılmaz.
+func WithMigrations(fsys fs.FS, dir string) Option {
+	return func(c *config) {
+		c.migrations, c.migrationsErr = fs.Sub(fsys, dir)
+	}
+}
+
+// WithQueries, JS'in çalıştırabileceği adlandırılmış sorguların izin
+// listesidir. JS yalnızca sorgu adını ve parametrelerini gönderir.
+func WithQueries(queries map[string]string) Option {
+	return func(c *config) {
+		for name, q := range queries {
+			c.queries[name] = q
+		}
+	}
+}
+
+// WithRawQueries, JS'in izin listesi dışındaki SQL metinlerini de (yine
+// parametreli olarak) çalıştırmasına izin verir. Yalnızca geliştirme ve
+// dahili araçlar için uygundur. Varsayılan: false
+func WithRawQueries(enabled bool) Option {
+	return func(c *config) {
+		c.raw = enabled
+	}
+}
+
+// WithNamespace, JS binding'lerinin önekini ayarlar ("<ns>.query",
+// "<ns>.exec"). Boş bir değer binding'leri tamamen kapatır; veritabanı
+// yalnızca Go tarafından kullanılır. Varsayılan: "db"
+func WithNamespace(ns string) Option {
+	return func(c *config) {
+		c.namespace = ns
+		c.bind = ns != ""
+	}
+}
+
+// DB, migration'ları uygulanmış bir SQLite veritabanıdır. Gömülü *sql.DB
+// Go tarafında doğrudan kullanılabilir.
+type DB struct {
+	*sql.DB
+
+	// Path, veritabanı dosyasının tam yoludur.
+	Path string
+
+	queries map[string]string
+	raw     bool
+
+	unregisterBackup func()
+}
+
+// Result, bir exec çağrısının sonucudur.
+type Result struct {
+	RowsAffected int64 `json:"rowsAffected"`
+	LastInsertID int64 `json:"lastInsertId"`
+}
+
+// Open, veritabanını app'in UserData dizininde açar, migration'ları çalıştırır
+// ve JS binding'lerini kaydeder. Run'dan önce çağrılmalıdır.
+func Open(app *gomad.Application, opts ...Option) (*DB, error) {
+	cfg := &config{
+		driver:    "sqlite",
+		file:      "app.db",
+		namespace: "db",
+		queries:   map[string]string{},
+		bind:      true,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	if cfg.migrationsErr != nil {
+		return nil, fmt.Errorf("sqlite: migrations: %w", cfg.migrationsErr)
+	}
+
+	path := cfg.file
+	if !filepath.IsAbs(path) {
+		dir, err := app.Paths().UserData()
+		if err != nil {
+			return nil, fmt.Errorf("sqlite: data dir: %w", err)
+		}
+		path = filepath.Join(dir, path)
+	}
+
+	if err := applyStagedImport(app, path); err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(sql.Drivers(), cfg.driver) {
+		return nil, fmt.Errorf("sqlite: driver %q is not registered (missing blank import?)", cfg.driver)
+	}
+	sdb, err := sql.Open(cfg.driver, path)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: open %s: %w", path, err)
+	}
+	// SQLite tek yazıcılıdır; tek bağlantı "database is locked" hatalarını önler
+	sdb.SetMaxOpenConns(1)
+
+	db := &DB{DB: sdb, Path: path, queries: cfg.queries, raw: cfg.raw}
+
+	if cfg.migrations != nil {
+		applied, err := db.migrate(cfg.migrations)
+		if err != nil {
+			sdb.Close()
+			return nil, err
+		}
+		if len(applied) > 0 {
+			app.Logger().Info("sqlite migrations applied", "path", path, "migrations", applied)
+		}
+	}
+
+	if cfg.bind {
+		if err := db.bind(app, cfg.namespace); err != nil {
+			sdb.Close()
+			return nil, err
+		}
+	}
+
+	// app.ExportData veritabanının tutarlı bir kopyasını içerir
+	db.unregisterBackup, err = app.RegisterBackupSource("sqlite/"+filepath.Base(path), gomad.BackupSource{
+		Export: db.exportTo,
+		Import: db.stageImport,
+	})
+	if err != nil {
+		sdb.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// Close, veritabanını kapatır ve yedek kaynağını kaldırır.
+func (db *DB) Close() error {
+	if db.unregisterBackup != nil {
+		db.unregisterBackup()
+	}
+	return db.DB.Close()
+}
+
+// bind, "<ns>.query" ve "<ns>.exec" binding'lerini kaydeder.
+func (db *DB) bind(app *gomad.Application, ns string) error {
+	if err := app.Bind(ns+".query", func(name string, args []any) ([]map[string]any, error) {
+		return db.Query(name, args...)
+	}); err != nil {
+		return err
+	}
+	return app.Bind(ns+".exec", func(name string, args []any) (Result, error) {
+		return db.Exec(name, args...)
+	})
+}
+
+// resolve, sorgu adını SQL metnine çevirir. İzin listesinde yoksa ve ham
+// sorgulara izin verilmemişse ErrQueryNotAllowed döner.
+func (db *DB) resolve(name string) (string, error) {
+	if q, ok := db.queries[name]; ok {
+		return q, nil
+	}
+	if db.raw {
+		return name, nil
+	}
+	return "", fmt.Errorf("sqlite: %q: %w", name, ErrQueryNotAllowed)
+}
+
+// Query, izin listesindeki name sorgusunu args parametreleriyle çalıştırır ve
+// satırları sütun adı → değer map'leri olarak döner.
+func (db *DB) Query(name string, args ...any) ([]map[string]any, error) {
+	q, err := db.resolve(name)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.DB.Query(q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: %s: %w", name, err)
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	out := []map[string]any{}
+	for rows.Next() {
+		values := make([]any, len(cols))
+		ptrs := make([]any, len(cols))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, fmt.Errorf("sqlite: %s: %w", name, err)
+		}
+		row := make(map[string]any, len(cols))
+		for i, col := range cols {
+			row[col] = jsonValue(values[i])
+		}
+		out = append(out, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sqlite: %s: %w", name, err)
+	}
+	return out, nil
+}
+
+// Exec, izin listesindeki name komutunu args parametreleriyle çalıştırır.
+func (db *DB) Exec(name string, args ...any) (Result, error) {
+	q, err := db.resolve(name)
+	if err != nil {
+		return Result{}, err
+	}
+	res, err := db.DB.Exec(q, args...)
+	if err != nil {
+		return Result{}, fmt.Errorf("sqlite: %s: %w", name, err)
+	}
+	var r Result
+	r.RowsAffected, _ = res.RowsAffected()
+	r.LastInsertID, _ = res.LastInsertId()
+	return r, nil
+}
+
+// jsonValue, sürücünün döndürdüğü değeri JSON'a uygun hale getirir. Bazı
+// sürücüler TEXT sütunlarını []byte olarak döner; geçerli UTF-8 ise string'e
+// çevrilir. Değilse (BLOB) []byte olarak bırakılır; encoding/json onu
+// base64 dizesi olarak kodlar.
+func jsonValue(v any) any {
+	if b, ok := v.([]byte); ok && utf8.Valid(b) {
+		return string(b)
+	}
+	return v
+}
@@ -0,0 +1,255 @@
+package update
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ============================================================================
+// Delta yamaları
+// Eski dosya blocksize'lık hizalı bloklar hâlinde indekslenir; yeni dosya
+// rsync tarzı kayan (rolling) bir toplamla taranır. Eşleşen bölgeler "eski
+// dosyadan kopyala", kalanlar "ekle" komutu olarak yazılır ve gzip ile
+// sıkıştırılır. Derleyici çıktısında kod kaydığı için sabit ofsetli
+// karşılaştırma yerine içerik eşleştirme kullanılır.
+//
+// Format bsdiff/zstd değildir: zstd standart kütüphanede yoktur ve modül
+// cgo'suz bir bağımlılık eklememek için compress/gzip ile yetinir. bsdiff'in
+// fark (add) bölgeleri yerine yalnızca birebir kopyalar kullanıldığından,
+// küçük değişikliklerin çok yere dağıldığı dosyalarda yama bsdiff'inkinden
+// büyük olabilir. Format magic ile sürümlenir; başka bir algoritma yeni bir
+// magic ile eklenebilir.
+//
+// Format:
+//
+//	"GOMADDELTA1"  magic
+//	[32]byte       eski dosyanın SHA-256'sı
+//	[32]byte       yeni dosyanın SHA-256'sı
+//	gzip {
+//	    0 uvarint(offset) uvarint(len)   → eski dosyadan kopyala
+//	    1 uvarint(len) bytes             → veriyi ekle
+//	}
+// ============================================================================
+
+// ErrPatchMismatch, yama eldeki dosyaya ait değilse (ör. kullanıcı dosyayı
+// değiştirmiş veya farklı bir sürüm kurulu) ya da sonuç doğrulanamazsa döner.
+// Bu durumda tam paket indirilmelidir.
+var ErrPatchMismatch = errors.New("patch does not match the installed version")
+
+const (
+	deltaMagic = "GOMADDELTA1"
+	blockSize  = 64
+
+	opCopy   byte = 0
+	opInsert byte = 1
+)
+
+// Diff, old'dan new'e götüren bir yama üretir.
+func Diff(old, new []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	oldSum, newSum := sha256.Sum256(old), sha256.Sum256(new)
+	buf.WriteString(deltaMagic)
+	buf.Write(oldSum[:])
+	buf.Write(newSum[:])
+
+	zw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	w := bufio.NewWriter(zw)
+	var scratch [binary.MaxVarintLen64]byte
+	uvarint := func(v int) {
+		n := binary.PutUvarint(scratch[:], uint64(v))
+		w.Write(scratch[:n])
+	}
+	insert := func(data []byte) {
+		if len(data) == 0 {
+			return
+		}
+		w.WriteByte(opInsert)
+		uvarint(len(data))
+		w.Write(data)
+	}
+
+	index := make(map[uint32][]int)
+	for i := 0; i+blockSize <= len(old); i += blockSize {
+		h := checksum(old[i : i+blockSize])
+		index[h] = append(index[h], i)
+	}
+
+	pending, i := 0, 0
+	var a, b uint32
+	fresh := true
+	for i+blockSize <= len(new) {
+		if fresh {
+			a, b = sums(new[i : i+blockSize])
+			fresh = false
+		}
+		if offset, ok := lookup(index, a, b, old, new[i:i+blockSize]); ok {
+			n := blockSize
+			for i+n < len(new) && offset+n < len(old) && new[i+n] == old[offset+n] {
+				n++
+			}
+			insert(new[pending:i])
+			w.WriteByte(opCopy)
+			uvarint(offset)
+			uvarint(n)
+			i += n
+			pending, fresh = i, true
+			continue
+		}
+		// Pencereyi bir bayt kaydır
+		if i+blockSize < len(new) {
+			out, in := uint32(new[i]), uint32(new[i+blockSize])
+			a = (a - out + in) & 0xffff
+			b = (b - blockSize*out + a) & 0xffff
+		}
+		i++
+	}
+	insert(new[pending:])
+
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Apply, yamayı old'a uygular ve yeni dosyanın içeriğini döner. Hem girdi
+// hem çıktı SHA-256 ile doğrulanır.
+func Apply(old, patch []byte) ([]byte, error) {
+	header := len(deltaMagic) + 2*sha256.Size
+	if len(patch) < header || string(patch[:len(deltaMagic)]) != deltaMagic {
+		return nil, fmt.Errorf("update: not a gomad delta patch")
+	}
+	oldSum := sha256.Sum256(old)
+	if !bytes.Equal(oldSum[:], patch[len(deltaMagic):len(deltaMagic)+sha256.Size]) {
+		return nil, ErrPatchMismatch
+	}
+	want := patch[len(deltaMagic)+sha256.Size : header]
+
+	zr, err := gzip.NewReader(bytes.NewReader(patch[header:]))
+	if err != nil {
+		return nil, fmt.Errorf("update: corrupt patch: %w", err)
+	}
+	r := bufio.NewReader(zr)
+	var out bytes.Buffer
+	for {
+		op, err := r.ReadByte()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("update: corrupt patch: %w", err)
+		}
+		switch op {
+		case opCopy:
+			offset, err1 := binary.ReadUvarint(r)
+			n, err2 := binary.ReadUvarint(r)
+			// offset+n taşabileceği için sınırlar ayrı ayrı denetlenir
+			if err1 != nil || err2 != nil || n > uint64(len(old)) || offset > uint64(len(old))-n {
+				return nil, fmt.Errorf("update: corrupt patch: bad copy")
+			}
+			out.Write(old[offset : offset+n])
+		case opInsert:
+			n, err := binary.ReadUvarint(r)
+			if err != nil {
+				return nil, fmt.Errorf("update: corrupt patch: %w", err)
+			}
+			if _, err := io.CopyN(&out, r, int64(n)); err != nil {
+				return nil, fmt.Errorf("update: corrupt patch: %w", err)
+			}
+		default:
+			return nil, fmt.Errorf("update: corrupt patch: unknown op %d", op)
+		}
+	}
+
+	newSum := sha256.Sum256(out.Bytes())
+	if !bytes.Equal(newSum[:], want) {
+		return nil, ErrPatchMismatch
+	}
+	return out.Bytes(), nil
+}
+
+// DiffFile, iki dosya arasındaki yamayı patchPath'e yazar.
+func DiffFile(oldPath, newPath, patchPath string) error {
+	old, err := os.ReadFile(oldPath)
+	if err != nil {
+		return err
+	}
+	new, err := os.ReadFile(newPath)
+	if err != nil {
+		return err
+	}
+	patch, err := Diff(old, new)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(patchPath, patch, 0o644)
+}
+
+// ApplyFile, yamayı oldPath'e uygular ve sonucu outPath'e yazar. outPath
+// önce geçici bir dosyaya yazılıp yeniden adlandırılır; yarım kalan bir yama
+// çalışan sürümü bozmaz.
+func ApplyFile(oldPath, patchPath, outPath string) error {
+	old, err := os.ReadFile(oldPath)
+	if err != nil {
+		return err
+	}
+	patch, err := os.ReadFile(patchPath)
+	if err != nil {
+		return err
+	}
+	data, err := Apply(old, patch)
+	if err != nil {
+		return err
+	}
+
+	mode := os.FileMode(0o755)
+	if info, err := os.Stat(oldPath); err == nil {
+		mode = info.Mode().Perm()
+	}
+	tmp := outPath + ".tmp"
+	if err := os.WriteFile(tmp, data, mode); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, outPath); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+// sums, bloğun kayan toplamının iki bileşenini hesaplar.
+func sums(block []byte) (a, b uint32) {
+	for i, c := range block {
+		a += uint32(c)
+		b += uint32(len(block)-i) * uint32(c)
+	}
+	return a & 0xffff, b & 0xffff
+}
+
+// checksum, bloğun indeks anahtarıdır.
+func checksum(block []byte) uint32 {
+	a, b := sums(block)
+	return a | b<<16
+}
+
+// lookup, zayıf toplamı eşleşen ve içeriği birebir aynı olan eski bloğu arar.
+func lookup(index map[uint32][]int, a, b uint32, old, window []byte) (int, bool) {
+	for _, offset := range index[a|b<<16] {
+		if bytes.Equal(old[offset:offset+blockSize], window) {
+			return offset, true
+		}
+	}
+	return 0, false
+}
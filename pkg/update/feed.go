@@ -0,0 +1,255 @@
+package update
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Channel, bir yayın kanalıdır. Daha kararsız kanallar daha kararlı
+// kanalların sürümlerini de alır: nightly ⊃ beta ⊃ stable.
+type Channel string
+
+const (
+	Stable  Channel = "stable"
+	Beta    Channel = "beta"
+	Nightly Channel = "nightly"
+)
+
+// channelRank, kanalların kararlılık sırasıdır.
+var channelRank = map[Channel]int{Stable: 0, Beta: 1, Nightly: 2}
+
+// ParseChannel, kanal adını doğrular.
+func ParseChannel(s string) (Channel, error) {
+	ch := Channel(strings.ToLower(strings.TrimSpace(s)))
+	if _, ok := channelRank[ch]; !ok {
+		return "", fmt.Errorf("update: unknown channel %q", s)
+	}
+	return ch, nil
+}
+
+// Includes, bu kanala abone olan birinin other kanalındaki sürümleri alıp
+// almadığını döner. Boş kanal stable sayılır.
+func (c Channel) Includes(other Channel) bool {
+	return channelRank[c] >= channelRank[or(other, Stable)]
+}
+
+// Feed, güncelleme sunucusunun yayınladığı sürüm listesidir. url,
+// uygulamanın yeni çalıştırılabilir dosyasıdır ve kurulumda çalışan dosyanın
+// yerine konur; kurulum sihirbazları (setup.exe, .msi) yayınlanmamalıdır.
+// Her sürümün ve yamanın sha256 özeti zorunludur (bkz. Validate).
+//
+//	{"releases": [
+//	  {"version": "1.5.0", "channel": "stable", "rollout": 20,
+//	   "url": "https://example.com/notes-1.5.0-windows-amd64.exe",
+//	   "sha256": "…",
+//	   "patches": [{"from": "1.4.0", "url": "…/notes-1.5.0-windows-amd64.patch", "sha256": "…"}]},
+//	  {"version": "1.6.0-beta.1", "channel": "beta", "url": "…", "sha256": "…"}
+//	]}
+type Feed struct {
+	Releases []Release `json:"releases"`
+}
+
+// Release, feed'deki tek bir sürümdür.
+type Release struct {
+	Version string    `json:"version"`
+	Channel Channel   `json:"channel,omitempty"` // Boşsa stable
+	Date    time.Time `json:"date,omitempty"`
+	Notes   string    `json:"notes,omitempty"`
+	URL     string    `json:"url"`
+	SHA256  string    `json:"sha256"` // Zorunlu; hex SHA-256
+	Size    int64     `json:"size,omitempty"`
+	Patches []Patch   `json:"patches,omitempty"`
+
+	// Rollout, sürümü alacak kurulumların yüzdesidir (0-100). nil ise %100.
+	// Yüzde artırıldıkça önceden dahil olan kurulumlar dahil kalır.
+	Rollout *float64 `json:"rollout,omitempty"`
+}
+
+// Patch, bir önceki sürümden bu sürüme delta yamasıdır (bkz. Diff).
+type Patch struct {
+	From   string `json:"from"`
+	URL    string `json:"url"`
+	SHA256 string `json:"sha256"` // Zorunlu; hex SHA-256
+}
+
+// Fetch, feed'i indirir ve çözümler.
+func Fetch(ctx context.Context, url string) (*Feed, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("update: feed %s: %s", url, resp.Status)
+	}
+	var feed Feed
+	if err := json.NewDecoder(resp.Body).Decode(&feed); err != nil {
+		return nil, fmt.Errorf("update: invalid feed: %w", err)
+	}
+	if err := feed.Validate(); err != nil {
+		return nil, err
+	}
+	return &feed, nil
+}
+
+// versionPattern, CompareVersions'ın beklediği semver benzeri sürümlerdir:
+// isteğe bağlı "v", noktalı sayılar, ön sürüm ve derleme ekleri. Sürüm dosya
+// adlarında kullanıldığı için yol ayırıcıları ve ".." kabul edilmez.
+var versionPattern = regexp.MustCompile(`^v?[0-9]+(\.[0-9]+)*(-[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?(\+[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?$`)
+
+// Validate, her sürümün geçerli bir sürüm numarası, adresi ve SHA-256 özeti
+// olduğunu, her yamanın da özetinin geçerli olduğunu doğrular. Özeti olmayan
+// bir sürüm doğrulanamayacağı için feed bütünüyle reddedilir.
+func (f *Feed) Validate() error {
+	for _, r := range f.Releases {
+		switch {
+		case r.Version == "" || r.URL == "":
+			return fmt.Errorf("update: invalid feed: release %q has no version or url", r.Version)
+		case !versionPattern.MatchString(r.Version):
+			return fmt.Errorf("update: invalid feed: release %q has an invalid version", r.Version)
+		case !validSum(r.SHA256):
+			return fmt.Errorf("update: invalid feed: release %s: %w", r.Version, ErrNoChecksum)
+		}
+		for _, p := range r.Patches {
+			if !versionPattern.MatchString(p.From) {
+				return fmt.Errorf("update: invalid feed: release %s patch has an invalid version %q", r.Version, p.From)
+			}
+			if !validSum(p.SHA256) {
+				return fmt.Errorf("update: invalid feed: release %s patch from %s: %w", r.Version, p.From, ErrNoChecksum)
+			}
+		}
+	}
+	return nil
+}
+
+// validSum, s'nin hex kodlu bir SHA-256 özeti olup olmadığını döner.
+func validSum(s string) bool {
+	b, err := hex.DecodeString(s)
+	return err == nil && len(b) == sha256.Size
+}
+
+// Latest, current'tan yeni, kanala dahil ve kademeli yayında installID'yi
+// kapsayan en yüksek sürümü döner.
+func (f *Feed) Latest(channel Channel, current, installID string) (Release, bool) {
+	var best Release
+	found := false
+	for _, r := range f.Releases {
+		if !channel.Includes(r.Channel) || CompareVersions(r.Version, current) <= 0 {
+			continue
+		}
+		if r.Rollout != nil && !InRollout(installID, r.Version, *r.Rollout) {
+			continue
+		}
+		if !found || CompareVersions(r.Version, best.Version) > 0 {
+			best, found = r, true
+		}
+	}
+	return best, found
+}
+
+// PatchFrom, verilen sürümden bu sürüme bir yama varsa döner.
+func (r Release) PatchFrom(version string) (Patch, bool) {
+	for _, p := range r.Patches {
+		if CompareVersions(p.From, version) == 0 {
+			return p, true
+		}
+	}
+	return Patch{}, false
+}
+
+// InRollout, kurulumun sürümün kademeli yayınına dahil olup olmadığını döner.
+// Kurulum, kimliği ve sürümden türetilen sabit bir [0,100) kovaya düşer;
+// böylece her sürümde farklı bir kullanıcı grubu ilk sırada olur.
+func InRollout(installID, version string, percent float64) bool {
+	sum := sha256.Sum256([]byte(installID + "/" + version))
+	bucket := float64(binary.BigEndian.Uint32(sum[:4])) / (1 << 32) * 100
+	return bucket < percent
+}
+
+// CompareVersions, iki semver benzeri sürümü karşılaştırır (-1, 0, 1).
+// Baştaki "v" yok sayılır; ön sürümler ("1.2.0-beta.1") sürümden küçüktür.
+func CompareVersions(a, b string) int {
+	a, b = strings.TrimPrefix(a, "v"), strings.TrimPrefix(b, "v")
+	aCore, aPre, _ := strings.Cut(strings.SplitN(a, "+", 2)[0], "-")
+	bCore, bPre, _ := strings.Cut(strings.SplitN(b, "+", 2)[0], "-")
+
+	if c := compareDotted(aCore, bCore, true); c != 0 {
+		return c
+	}
+	switch {
+	case aPre == bPre:
+		return 0
+	case aPre == "":
+		return 1
+	case bPre == "":
+		return -1
+	}
+	return compareDotted(aPre, bPre, false)
+}
+
+// compareDotted, noktalı parçaları sayısal ya da alfabetik karşılaştırır.
+// numeric ise eksik parçalar 0 sayılır ("1.2" == "1.2.0").
+func compareDotted(a, b string, numeric bool) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		if i >= len(as) || i >= len(bs) {
+			if numeric {
+				x, y := "0", "0"
+				if i < len(as) {
+					x = as[i]
+				} else {
+					y = bs[i]
+				}
+				if c := compareIdent(x, y); c != 0 {
+					return c
+				}
+				continue
+			}
+			if i >= len(as) {
+				return -1
+			}
+			return 1
+		}
+		if c := compareIdent(as[i], bs[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// compareIdent, iki sürüm parçasını karşılaştırır; sayılar metinden küçüktür.
+func compareIdent(x, y string) int {
+	xn, xerr := strconv.Atoi(x)
+	yn, yerr := strconv.Atoi(y)
+	switch {
+	case xerr == nil && yerr == nil:
+		return cmp.Compare(xn, yn)
+	case xerr == nil:
+		return -1
+	case yerr == nil:
+		return 1
+	}
+	return strings.Compare(x, y)
+}
+
+// or, c boşsa def döner.
+func or(c, def Channel) Channel {
+	if c == "" {
+		return def
+	}
+	return c
+}
@@ -0,0 +1,114 @@
+package update
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+var (
+	// ErrChecksum, indirilen dosyanın SHA-256 özeti feed'dekiyle eşleşmediğinde döner.
+	ErrChecksum = errors.New("update: checksum mismatch")
+	// ErrNoChecksum, doğrulanacak SHA-256 özeti verilmediğinde döner;
+	// doğrulanmamış bir dosya indirilmez ve kurulmaz.
+	ErrNoChecksum = errors.New("update: missing sha256 checksum")
+)
+
+// Download, url'deki dosyayı indirir ve içeriği sum (hex SHA-256) ile
+// doğrular; sum boşsa hiçbir şey indirilmeden ErrNoChecksum döner. progress
+// nil değilse her okunan parçadan sonra alınan ve toplam bayt sayısıyla
+// çağrılır; sunucu boyut bildirmezse total -1'dir.
+func Download(ctx context.Context, url, sum string, progress func(received, total int64)) ([]byte, error) {
+	if sum == "" {
+		return nil, fmt.Errorf("%w: %s", ErrNoChecksum, url)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("update: download %s: %s", url, resp.Status)
+	}
+
+	var buf bytes.Buffer
+	if resp.ContentLength > 0 {
+		buf.Grow(int(resp.ContentLength))
+	}
+	chunk := make([]byte, 64<<10)
+	for {
+		n, err := resp.Body.Read(chunk)
+		buf.Write(chunk[:n])
+		if n > 0 && progress != nil {
+			progress(int64(buf.Len()), resp.ContentLength)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("update: download %s: %w", url, err)
+		}
+	}
+
+	if err := Verify(buf.Bytes(), sum); err != nil {
+		return nil, fmt.Errorf("%w: %s", err, url)
+	}
+	return buf.Bytes(), nil
+}
+
+// Verify, data'nın SHA-256 özetinin sum (hex) ile eşleştiğini doğrular. sum
+// boşsa ErrNoChecksum, eşleşmezse ErrChecksum döner.
+func Verify(data []byte, sum string) error {
+	if sum == "" {
+		return ErrNoChecksum
+	}
+	got := sha256.Sum256(data)
+	if !strings.EqualFold(hex.EncodeToString(got[:]), sum) {
+		return ErrChecksum
+	}
+	return nil
+}
+
+// Install, exe'yi data ile değiştirir. Yeni içerik önce exe'nin yanına
+// yazılır, çalışan dosya exe+".old" olarak kenara alınır (Windows çalışan
+// bir dosyanın silinmesine izin vermez, yeniden adlandırılmasına izin verir)
+// ve yeni dosya yerine taşınır. Bir adım başarısız olursa eski dosya geri
+// konur. Kenara alınan dosya yeni sürüm başladığında Cleanup ile silinir.
+func Install(exe string, data []byte) error {
+	info, err := os.Stat(exe)
+	if err != nil {
+		return err
+	}
+	next, old := exe+".new", exe+".old"
+	if err := os.WriteFile(next, data, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("update: write %s: %w", next, err)
+	}
+	os.Remove(old)
+	if err := os.Rename(exe, old); err != nil {
+		os.Remove(next)
+		return fmt.Errorf("update: move %s aside: %w", exe, err)
+	}
+	if err := os.Rename(next, exe); err != nil {
+		os.Rename(old, exe)
+		os.Remove(next)
+		return fmt.Errorf("update: replace %s: %w", exe, err)
+	}
+	return nil
+}
+
+// Cleanup, önceki Install'ın kenara aldığı dosyayı siler. Dosya yoksa ya da
+// eski süreç hâlâ kapanıyorsa sessizce geçer.
+func Cleanup(exe string) {
+	os.Remove(exe + ".old")
+}
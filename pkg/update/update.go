@@ -0,0 +1,37 @@
+// Package update, uygulama güncellemelerinin yapı taşlarını içerir.
+//
+// Yüzlerce MB'lık WebView uygulamalarının her sürümde baştan indirilmemesi
+// için sürümler arası ikili fark yamaları (delta) üretir ve uygular. Yamalar
+// "gomad package -delta-from <önceki dist>" ile paketleme sırasında üretilir;
+// format rsync tarzı kopyala/ekle komutlarının gzip ile sıkıştırılmış
+// hâlidir (bsdiff/zstd değil, bkz. Diff).
+//
+// Feed, sürümleri stable/beta/nightly kanallarına ve yüzdelik kademeli
+// yayınlara (staged rollout) göre listeler; Latest, kurulumun kanalına ve
+// kimliğine göre alınacak sürümü seçer.
+//
+// Örnek:
+//
+//	feed, _ := update.Fetch(ctx, "https://example.com/notes/feed.json")
+//	rel, ok := feed.Latest(update.Beta, "1.4.0", installID)
+//	if !ok {
+//	    return // Güncel
+//	}
+//	old, _ := os.ReadFile(exe)
+//	p, _ := rel.PatchFrom("1.4.0")
+//	patch, _ := update.Download(ctx, p.URL, p.SHA256, nil)
+//	next, err := update.Apply(old, patch)
+//	if errors.Is(err, update.ErrPatchMismatch) {
+//	    // Yerel dosya beklenen sürüm değil
+//	    next, err = update.Download(ctx, rel.URL, rel.SHA256, nil)
+//	}
+//
+// Download indirir ve özeti doğrular; Install çalışan dosyayı yenisiyle
+// değiştirir. pkg/gomad bu adımları Application.DownloadUpdate ve
+// Application.InstallUpdate ile birleştirir.
+//
+// @author Ahmet ALTUN
+// @github github.com/biyonik
+// @linkedin linkedin.com/in/biyonik
+// @email ahmet.altun60@gmail.com
+package update